@@ -6,6 +6,7 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"image/color"
@@ -13,6 +14,7 @@ import (
 	"math/rand"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -27,6 +29,7 @@ import (
 	"github.com/opd-ai/venture/pkg/procgen/recipe"
 	"github.com/opd-ai/venture/pkg/procgen/station"
 	"github.com/opd-ai/venture/pkg/procgen/terrain"
+	"github.com/opd-ai/venture/pkg/procgen/worldgen"
 	"github.com/opd-ai/venture/pkg/rendering/particles"
 	"github.com/opd-ai/venture/pkg/rendering/sprites"
 	"github.com/opd-ai/venture/pkg/saveload"
@@ -56,6 +59,13 @@ func (w *rotationSystemWrapper) Update(entities []*engine.Entity, deltaTime floa
 	w.system.Update(deltaTime)
 }
 
+// frameHook adapts a per-frame client task to the System interface
+type frameHook func(deltaTime float64)
+
+func (h frameHook) Update(entities []*engine.Entity, deltaTime float64) {
+	h(deltaTime)
+}
+
 var (
 	width            = flag.Int("width", 800, "Screen width")
 	height           = flag.Int("height", 600, "Screen height")
@@ -63,8 +73,8 @@ var (
 	genreID          = flag.String("genre", randomGenre(), "Genre ID (fantasy, scifi, horror, cyberpunk, postapoc)")
 	enableLighting   = flag.Bool("enable-lighting", false, "Enable dynamic lighting system (experimental)")
 	enableWeather    = flag.Bool("enable-weather", false, "Enable procedural weather effects (Phase 5.4)")
-	weatherType      = flag.String("weather", "", "Weather type (rain, snow, fog, dust, ash, neonrain, smog, radiation) - empty for genre-appropriate random")
-	weatherIntensity = flag.String("weather-intensity", "medium", "Weather intensity (light, medium, heavy, extreme)")
+	weatherType      = flag.String("weather", "", "Weather type (rain, snow, fog, dust, ash, neonrain, smog, radiation) - empty for the world's planned weather")
+	weatherIntensity = flag.String("weather-intensity", "", "Weather intensity (light, medium, heavy, extreme) - empty for the world's planned intensity")
 	verbose          = flag.Bool("verbose", false, "Enable verbose logging")
 	profile          = flag.Bool("profile", false, "Enable performance profiling with frame time tracking")
 	multiplayer      = flag.Bool("multiplayer", false, "Enable multiplayer mode (connect to server)")
@@ -73,7 +83,14 @@ var (
 	hostLAN          = flag.Bool("host-lan", false, "Bind server to 0.0.0.0 for LAN access (use with --host-and-play, default is localhost only)")
 	serverPort       = flag.Int("port", 8080, "Server port for --host-and-play mode (will try next 10 ports if occupied)")
 	serverPlayers    = flag.Int("max-players", 4, "Maximum players for --host-and-play mode")
+	serverQueue      = flag.Int("queue-slots", 0, "Players that may wait for a free slot in --host-and-play mode (0 = reject when full)")
 	serverTick       = flag.Int("tick-rate", 20, "Server tick rate for --host-and-play mode (updates per second)")
+	joinLAN          = flag.Bool("join-lan", false, "Discover a LAN server announced by --host-lan and connect to it")
+	deathMode        = flag.String("death-mode", "casual", "Player death handling (casual, roguelike, hardcore)")
+	difficultyName   = flag.String("difficulty", "normal", "Difficulty preset (story, normal, hard, nightmare)")
+	enemyRespawn     = flag.Float64("enemy-respawn", 0, "Seconds before cleared rooms respawn enemies (0 = rooms stay clear)")
+	sharedLives      = flag.Int("shared-lives", 0, "Co-op shared life pool; each player death costs one (0 = unlimited, requires -respawn-wave)")
+	respawnWave      = flag.Float64("respawn-wave", 0, "Seconds before dead co-op players respawn together (0 = revive only)")
 )
 
 // return a random seed
@@ -92,16 +109,16 @@ func randomGenre() string {
 }
 
 // spawnEnvironmentalLights creates atmospheric lighting throughout the dungeon.
-// Spawns wall torches, magical crystals, and genre-specific lights based on the world seed.
+// Spawns wall torches based on the world seed and a genre-specific crystal
+// at each room light planned by world generation.
 // This function is part of Phase 5.3: Dynamic Lighting System Integration.
-func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, seed int64, genreID string) int {
+func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, roomLights []worldgen.Spawn, seed int64, genreID string) int {
 	rng := rand.New(rand.NewSource(seed))
 	lightCount := 0
 
 	// Genre-specific light configurations
 	type lightConfig struct {
 		torchInterval int // Every N tiles along walls/corridors
-		crystalChance float64
 		torchColor    color.RGBA
 		crystalColor  color.RGBA
 		torchRadius   float64
@@ -113,7 +130,6 @@ func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, see
 	configs := map[string]lightConfig{
 		"fantasy": {
 			torchInterval: 5,
-			crystalChance: 0.15,
 			torchColor:    color.RGBA{255, 150, 80, 255},  // Warm torch light
 			crystalColor:  color.RGBA{150, 200, 255, 255}, // Blue magical crystal
 			torchRadius:   150,
@@ -123,7 +139,6 @@ func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, see
 		},
 		"scifi": {
 			torchInterval: 4,
-			crystalChance: 0.20,
 			torchColor:    color.RGBA{150, 200, 255, 255}, // Cool neon blue
 			crystalColor:  color.RGBA{0, 255, 200, 255},   // Cyan tech light
 			torchRadius:   180,
@@ -133,7 +148,6 @@ func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, see
 		},
 		"horror": {
 			torchInterval: 7,
-			crystalChance: 0.08,
 			torchColor:    color.RGBA{180, 140, 100, 255}, // Dim yellowish
 			crystalColor:  color.RGBA{120, 80, 80, 255},   // Faint reddish
 			torchRadius:   100,
@@ -143,7 +157,6 @@ func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, see
 		},
 		"cyberpunk": {
 			torchInterval: 3,
-			crystalChance: 0.25,
 			torchColor:    color.RGBA{255, 0, 150, 255}, // Neon pink
 			crystalColor:  color.RGBA{0, 255, 255, 255}, // Cyan hologram
 			torchRadius:   160,
@@ -153,7 +166,6 @@ func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, see
 		},
 		"postapoc": {
 			torchInterval: 6,
-			crystalChance: 0.10,
 			torchColor:    color.RGBA{200, 180, 140, 255}, // Dusty yellow
 			crystalColor:  color.RGBA{100, 255, 100, 255}, // Radioactive green
 			torchRadius:   120,
@@ -216,15 +228,17 @@ func spawnEnvironmentalLights(world *engine.World, terrain *terrain.Terrain, see
 				}
 			}
 		}
+	}
 
-		// Spawn magical crystals in room centers (boss rooms, treasure rooms)
-		if rng.Float64() < config.crystalChance {
-			cx, cy := room.Center()
-			worldX := float64(cx * 32)
-			worldY := float64(cy * 32)
-			spawnCrystalLight(world, worldX, worldY, config.crystalColor, config.crystalRadius, config.crystalPulse)
-			lightCount++
+	// Spawn magical crystals at the planned room lights
+	for _, light := range roomLights {
+		if light.RoomIndex == 0 {
+			continue
 		}
+		worldX := float64(light.X*32 + 16) // Tile center
+		worldY := float64(light.Y*32 + 16)
+		spawnCrystalLight(world, worldX, worldY, config.crystalColor, config.crystalRadius, config.crystalPulse)
+		lightCount++
 	}
 
 	return lightCount
@@ -263,12 +277,17 @@ func spawnCrystalLight(world *engine.World, x, y float64, color color.RGBA, radi
 
 // spawnWeather creates a weather effect entity.
 // Phase 5.4: Weather Particle System Integration
-func spawnWeather(world *engine.World, screenWidth, screenHeight int, seed int64, genreID, weatherTypeStr, intensityStr string) *engine.Entity {
+func spawnWeather(world *engine.World, screenWidth, screenHeight int, planned *worldgen.WeatherPlan, seed int64, genreID, weatherTypeStr, intensityStr string) *engine.Entity {
 	rng := rand.New(rand.NewSource(seed))
 
 	// Parse weather intensity
 	var intensity particles.WeatherIntensity
 	switch strings.ToLower(intensityStr) {
+	case "":
+		intensity = particles.IntensityMedium
+		if planned != nil {
+			intensity = planned.Intensity
+		}
 	case "light":
 		intensity = particles.IntensityLight
 	case "medium":
@@ -283,7 +302,11 @@ func spawnWeather(world *engine.World, screenWidth, screenHeight int, seed int64
 
 	// Determine weather type
 	var weatherType particles.WeatherType
-	if weatherTypeStr == "" {
+	if weatherTypeStr == "" && planned != nil {
+		// The world's planned weather, the same for everyone in this world
+		weatherType = planned.Type
+		seed = planned.Seed
+	} else if weatherTypeStr == "" {
 		// Select genre-appropriate random weather
 		genreWeathers := particles.GetGenreWeather(genreID)
 		if len(genreWeathers) > 0 {
@@ -513,11 +536,13 @@ func startEmbeddedServer(logger *logrus.Logger, seed int64, genreID string) (str
 	serverConfig := &hostplay.ServerConfig{
 		Port:       *serverPort,
 		MaxPlayers: *serverPlayers,
+		QueueSlots: *serverQueue,
 		BindLAN:    *hostLAN,
 		WorldSeed:  seed,
 		GenreID:    genreID,
 		Difficulty: 0.5,
 		TickRate:   *serverTick,
+		Announce:   *hostLAN,
 	}
 
 	// Create server manager
@@ -617,6 +642,27 @@ func main() {
 		*multiplayer = true
 
 		clientLogger.WithField("serverAddr", serverAddr).Info("embedded server started, connecting client")
+	} else if *joinLAN {
+		clientLogger.Info("searching for LAN servers")
+
+		servers, err := hostplay.DiscoverServers(2 * time.Second)
+		if err != nil {
+			clientLogger.WithError(err).Fatal("LAN discovery failed")
+		}
+		for _, info := range servers {
+			clientLogger.WithFields(logrus.Fields{
+				"name":    info.Name,
+				"address": info.Address,
+				"genre":   info.GenreID,
+				"players": fmt.Sprintf("%d/%d", info.Players, info.MaxPlayers),
+			}).Info("found LAN server")
+		}
+		if len(servers) == 0 {
+			clientLogger.Warn("no LAN servers found (host with --host-and-play --host-lan)")
+		} else {
+			*server = servers[0].Address
+			*multiplayer = true
+		}
 	}
 
 	// Initialize network client if multiplayer mode is enabled
@@ -638,6 +684,10 @@ func main() {
 		// Handle network errors in background
 		go func() {
 			for err := range networkClient.ReceiveError() {
+				if errors.Is(err, network.ErrServerFull) {
+					clientLogger.WithField("server", *server).Error("server is full, try again later")
+					continue
+				}
 				clientLogger.WithError(err).Error("network error")
 			}
 		}()
@@ -668,6 +718,21 @@ func main() {
 
 	combatSystem := engine.NewCombatSystemWithLogger(*seed, logger)
 
+	// Configure player death handling (casual respawn, roguelike restart, hardcore permadeath)
+	parsedDeathMode, err := engine.ParseDeathMode(*deathMode)
+	if err != nil {
+		clientLogger.WithError(err).Warn("invalid death mode, using casual")
+	}
+	deathModeHandler := engine.NewDeathModeHandler(parsedDeathMode)
+
+	// One difficulty preset tunes enemies, spawns, loot and death penalties
+	difficultyPreset, err := engine.ParseDifficultyPreset(*difficultyName)
+	if err != nil {
+		clientLogger.WithError(err).Warn("invalid difficulty, using normal")
+	}
+	difficulty := difficultyPreset.Settings()
+	difficulty.ApplyToDeathHandler(deathModeHandler)
+
 	// GAP-016 REPAIR: Initialize particle system for visual effects
 	particleSystem := engine.NewParticleSystem()
 
@@ -711,6 +776,15 @@ func main() {
 			return
 		}
 
+		// Apply the configured death mode to players. Casual deaths stay down until
+		// revived or respawned; a roguelike death ends the run, so nothing drops
+		if enemy.HasComponent("input") {
+			if outcome := deathModeHandler.HandlePlayerDeath(enemy); outcome.RestartRun {
+				enemy.AddComponent(engine.NewDeadComponent(float64(time.Now().Unix())))
+				return
+			}
+		}
+
 		// Get enemy position
 		posComp, hasPos := enemy.GetComponent("position")
 		if !hasPos {
@@ -799,7 +873,7 @@ func main() {
 		// Generate and spawn procedural loot drop (in addition to inventory items)
 		// This is for enemies that don't have inventory but should drop random loot
 		if !enemy.HasComponent("input") { // Only for NPCs/enemies, not players
-			lootEntity := engine.GenerateLootDrop(game.World, enemy, pos.X, pos.Y, *seed, *genreID)
+			lootEntity := engine.GenerateLootDropWithDifficulty(game.World, enemy, pos.X, pos.Y, *seed, *genreID, difficulty)
 			if lootEntity != nil {
 				// Add physics to procedural loot too
 				lootEntity.AddComponent(&engine.VelocityComponent{
@@ -812,6 +886,17 @@ func main() {
 				deadComp.AddDroppedItem(lootEntity.ID)
 			}
 
+			// Money and crafting materials roll separately from the gear drop
+			for _, bonusEntity := range engine.GenerateBonusLootDrops(game.World, enemy, pos.X, pos.Y, *seed, *genreID, difficulty) {
+				bonusEntity.AddComponent(&engine.VelocityComponent{
+					VX: (rand.Float64()*2.0 - 1.0) * 30.0,
+					VY: (rand.Float64()*2.0 - 1.0) * 30.0,
+				})
+				bonusEntity.AddComponent(engine.NewFrictionComponent(0.12))
+
+				deadComp.AddDroppedItem(bonusEntity.ID)
+			}
+
 			// Generate and spawn recipe drops (rarer than item drops)
 			recipeEntity := engine.GenerateRecipeDrop(recipeGen, game.World, enemy, pos.X, pos.Y, *seed, *genreID)
 			if recipeEntity != nil {
@@ -832,15 +917,33 @@ func main() {
 			}
 		}
 
-		// GAP-010 REPAIR: Play death sound effect
-		if err := audioManager.PlaySFX("death", time.Now().UnixNano()); err != nil {
+		// Spawn death particles and play the matching sound for the killing damage type
+		deathEffect := particleSystem.SpawnDeathEffect(game.World, enemy, *seed+int64(enemy.ID), *genreID)
+		enemyX, enemyY := 0.0, 0.0
+		if pos := enemy.GetPosition(); pos != nil {
+			enemyX, enemyY = pos.X, pos.Y
+		}
+		if err := audioManager.PlaySFXAt(string(deathEffect.SFX), time.Now().UnixNano(), enemyX, enemyY); err != nil {
 			if logger.GetLevel() >= logrus.WarnLevel {
 				logging.ComponentLogger(logger, "audio").WithError(err).Warn("failed to play death SFX")
 			}
 		}
+
+		// Explosive elites detonate on death
+		if hits := engine.TriggerEliteDeathExplosion(game.World, enemy); hits > 0 && *verbose {
+			clientLogger.WithFields(logrus.Fields{
+				"entityID": enemy.ID,
+				"hits":     hits,
+			}).Info("explosive elite detonated")
+		}
 	})
 
 	aiSystem := engine.NewAISystem(game.World)
+	npcScheduleSystem := engine.NewNPCScheduleSystem()
+	stealthSystem := engine.NewStealthSystem()
+	if game.LightingSystem != nil {
+		stealthSystem.SetLightSampler(game.LightingSystem)
+	}
 	progressionSystem := engine.NewProgressionSystem(game.World)
 	inventorySystem := engine.NewInventorySystem(game.World)
 
@@ -859,6 +962,9 @@ func main() {
 	audioManager = engine.NewAudioManager(44100, *seed) // 44.1kHz sample rate
 	audioManagerSystem := engine.NewAudioManagerSystem(audioManager)
 
+	// Positional sound effects are heard from the camera
+	audioManager.SetListener(engine.NewAudioListener(game.CameraSystem))
+
 	// Wire audio manager to game for settings integration
 	game.SetAudioManager(audioManager)
 
@@ -878,7 +984,11 @@ func main() {
 	statusEffectSystem := engine.NewStatusEffectSystem(game.World, statusEffectRNG)
 	spellCastingSystem := engine.NewSpellCastingSystem(game.World, statusEffectSystem)
 	playerSpellCastingSystem := engine.NewPlayerSpellCastingSystem(spellCastingSystem, game.World)
-	manaRegenSystem := &engine.ManaRegenSystem{} // GAP #2 REPAIR: Add player combat system to connect Space key to combat
+	manaRegenSystem := &engine.ManaRegenSystem{}
+	// Health regen pauses after damage; the combat damage callback resets its delay
+	healthRegenSystem := engine.NewHealthRegenSystem()
+	combatSystem.SetDamageCallback(healthRegenSystem.OnDamage)
+	// GAP #2 REPAIR: Add player combat system to connect Space key to combat
 	playerCombatSystem := engine.NewPlayerCombatSystem(combatSystem, game.World)
 
 	// GAP #3 REPAIR: Add player item use system to connect E key to inventory
@@ -910,7 +1020,7 @@ func main() {
 	// 12. Objective Tracker - updates quest progress
 	// 13. Item Pickup - collects nearby items
 	// 14. Spell Casting - executes spell effects
-	// 15. Mana Regen - regenerates mana
+	// 15. Mana/Health Regen - regenerates mana and out-of-combat health
 	// 16. Inventory - item management
 	// 17. Animation - updates sprite frames (before rendering)
 	// 18. Tutorial/Help - UI overlays
@@ -933,15 +1043,31 @@ func main() {
 	// Note: terrainChecker will be set after terrain generation
 	game.World.AddSystem(projectileSystem)
 
+	// Carry and throw: thrown objects share the projectile wall checks
+	throwSystem := engine.NewThrowSystem(game.World)
+	game.World.AddSystem(throwSystem)
+
 	game.World.AddSystem(combatSystem)
 	game.World.AddSystem(statusEffectSystem) // Process status effects after combat
 
 	// Add revival system for multiplayer death mechanics (Category 1.1)
 	// Allows living players to revive dead teammates through proximity interaction
 	revivalSystem := engine.NewRevivalSystem(game.World)
+	if err := revivalSystem.SetPartyRespawnPolicy(engine.PartyRespawnPolicy{
+		SharedLives:  *sharedLives,
+		WaveInterval: *respawnWave,
+	}); err != nil {
+		clientLogger.WithError(err).Warn("invalid party respawn options, using instant revives")
+	}
 	game.World.AddSystem(revivalSystem)
 
+	// Casual deaths respawn at the checkpoint once no revival is coming
+	deathModeHandler.SetRevivalSystem(revivalSystem)
+	game.World.AddSystem(deathModeHandler)
+
+	game.World.AddSystem(stealthSystem)
 	game.World.AddSystem(aiSystem)
+	game.World.AddSystem(npcScheduleSystem)
 	game.World.AddSystem(progressionSystem)
 
 	// Add skill progression system
@@ -959,8 +1085,12 @@ func main() {
 	game.World.AddSystem(objectiveTracker)
 
 	game.World.AddSystem(itemPickupSystem)
+
+	// Keys and locked doors placed by world generation
+	game.World.AddSystem(engine.NewLockSystem(game.World))
 	game.World.AddSystem(spellCastingSystem)
 	game.World.AddSystem(manaRegenSystem)
+	game.World.AddSystem(healthRegenSystem)
 	game.World.AddSystem(inventorySystem)
 
 	// Add commerce, dialog, and crafting systems (Category 1.3 - Commerce & NPC Integration)
@@ -1006,6 +1136,8 @@ func main() {
 
 	// Phase 10.3: Set camera reference on projectile system for impact shake
 	projectileSystem.SetCamera(game.CameraSystem)
+	throwSystem.SetCamera(game.CameraSystem)
+	spellCastingSystem.SetCamera(game.CameraSystem)
 
 	// Phase 10.2: Set genre and seed for projectile visual generation
 	projectileSystem.SetGenre(*genreID)
@@ -1032,27 +1164,28 @@ func main() {
 	// Generate initial world terrain
 	clientLogger.Info("generating procedural terrain")
 
-	terrainGen := terrain.NewBSPGeneratorWithLogger(logger) // Use BSP algorithm with logging
+	// Shared pipeline so the client builds the same world as the server
+	worldPipeline, err := worldgen.NewPipelineFromConfigWithLogger(worldgen.DefaultConfig(), logger)
+	if err != nil {
+		clientLogger.WithError(err).Fatal("failed to create world generation pipeline")
+	}
 	params := procgen.GenerationParams{
 		Difficulty: 0.5,
 		Depth:      1,
 		GenreID:    *genreID,
-		Custom: map[string]interface{}{
-			"width":  80,
-			"height": 50,
-		},
 	}
 
-	terrainResult, err := terrainGen.Generate(*seed, params)
+	worldPlan, err := worldPipeline.Run(*seed, params)
 	if err != nil {
 		clientLogger.WithError(err).Fatal("failed to generate terrain")
 	}
 
-	generatedTerrain := terrainResult.(*terrain.Terrain)
+	generatedTerrain := worldPlan.Terrain
 	clientLogger.WithFields(logrus.Fields{
 		"width":     generatedTerrain.Width,
 		"height":    generatedTerrain.Height,
 		"roomCount": len(generatedTerrain.Rooms),
+		"spawns":    len(worldPlan.Spawns),
 	}).Info("terrain generated")
 
 	// Initialize terrain rendering system
@@ -1061,7 +1194,6 @@ func main() {
 	}
 
 	terrainRenderSystem := engine.NewTerrainRenderSystem(32, 32, *genreID, *seed)
-	terrainRenderSystem.SetTerrain(generatedTerrain)
 	game.TerrainRenderSystem = terrainRenderSystem
 
 	if *verbose {
@@ -1080,13 +1212,24 @@ func main() {
 		}).Info("lighting system configured")
 	}
 
+	// In multiplayer the day/night clock follows the timestamp of the world
+	// state the network client last applied instead of running locally, so
+	// every player sees the same time of day
+	if networkClient != nil && game.DayNight != nil {
+		game.DayNight.HoursPerSecond = 0
+		game.World.AddSystem(frameHook(func(deltaTime float64) {
+			if world := networkClient.WorldSnapshot(); world != nil {
+				game.DayNight.SetTimeOfDay(engine.TimeOfDayAt(world.Timestamp, engine.DefaultDayLength))
+			}
+		}))
+	}
+
 	// GAP REPAIR: Initialize efficient terrain collision checking
 	if *verbose {
 		clientLogger.Info("initializing terrain collision system")
 	}
 
 	terrainChecker := engine.NewTerrainCollisionChecker(32, 32)
-	terrainChecker.SetTerrain(generatedTerrain)
 
 	// Connect terrain checker to collision system and projectile system
 	for _, system := range game.World.GetSystems() {
@@ -1096,6 +1239,9 @@ func main() {
 		if projSys, ok := system.(*engine.ProjectileSystem); ok {
 			projSys.SetTerrainChecker(terrainChecker)
 		}
+		if throwSys, ok := system.(*engine.ThrowSystem); ok {
+			throwSys.SetTerrainChecker(terrainChecker)
+		}
 	}
 
 	if *verbose {
@@ -1125,6 +1271,11 @@ func main() {
 	// TODO: Fix spatial partition population/query before re-enabling
 	game.RenderSystem.EnableCulling(false)
 
+	// Tint sprites by team so players on opposing teams are distinguishable
+	if *multiplayer {
+		game.RenderSystem.SetTeamTintPalette(engine.NewTeamTintPalette())
+	}
+
 	clientLogger.WithFields(logrus.Fields{
 		"worldWidth":  worldWidth,
 		"worldHeight": worldHeight,
@@ -1138,91 +1289,135 @@ func main() {
 		}).Info("spatial partition enabled")
 	}
 
-	// GAP-001 REPAIR: Connect terrain to MapUI for map functionality
-	if *verbose {
-		clientLogger.Info("connecting terrain to Map UI")
+	// setTerrain points every terrain consumer (rendering, collision, NPC
+	// schedules, stealth and the map) at the current world's terrain
+	setTerrain := func(terr *terrain.Terrain) {
+		terrainRenderSystem.SetTerrain(terr)
+		terrainChecker.SetTerrain(terr)
+		npcScheduleSystem.SetTerrain(terr, 32)
+		stealthSystem.SetTerrain(terr, 32)
+		// GAP-001 REPAIR: Connect terrain to MapUI for map functionality
+		game.MapUI.SetTerrain(terr)
 	}
-	game.MapUI.SetTerrain(generatedTerrain)
+	setTerrain(generatedTerrain)
 	if *verbose {
-		clientLogger.Info("Map UI configured with terrain data")
+		clientLogger.Info("terrain connected to rendering, collision and Map UI")
 	}
 
-	// GAP #1 REPAIR: Spawn enemies in terrain rooms
-	if *verbose {
-		clientLogger.Info("spawning enemies in dungeon rooms")
-	}
+	enemyParams := difficulty.GenerationParams(procgen.GenerationParams{
+		Depth:   1,
+		GenreID: *genreID,
+	})
 
-	enemyParams := procgen.GenerationParams{
-		Difficulty: 0.5,
-		Depth:      1,
-		GenreID:    *genreID,
+	// Enemy respawning in cleared rooms (permanent clears unless enabled)
+	respawnPolicy := engine.PermanentClearPolicy()
+	if *enemyRespawn > 0 {
+		respawnPolicy = engine.TimedRespawnPolicy(*enemyRespawn)
 	}
+	respawnSystem := engine.NewRespawnSystem(game.World, *seed+4000, enemyParams)
+	respawnSystem.SetDifficulty(difficulty)
+	game.World.AddSystem(respawnSystem)
 
-	enemyCount, err := engine.SpawnEnemiesInTerrain(game.World, generatedTerrain, *seed, enemyParams)
-	if err != nil {
-		clientLogger.WithError(err).Warn("failed to spawn enemies")
-	} else if *verbose {
-		clientLogger.WithFields(logrus.Fields{
-			"enemyCount": enemyCount,
-			"roomCount":  len(generatedTerrain.Rooms) - 1,
-		}).Info("spawned enemies")
-	}
+	stationGen := station.NewStationGenerator()
 
-	// GAP #4 REPAIR: Spawn merchants in dungeon
-	if *verbose {
-		clientLogger.Info("spawning merchants in dungeon")
-	}
+	// populateWorld spawns a generated world's content: the planned enemies,
+	// items, keys, locked doors, lights and weather, plus enemy respawn zones,
+	// merchants, crafting stations and shrines
+	populateWorld := func(plan *worldgen.World) {
+		worldSeed, terr := plan.Seed, plan.Terrain
 
-	merchantParams := procgen.GenerationParams{
-		Difficulty: 0.5,
-		Depth:      1,
-		GenreID:    *genreID,
-	}
+		// GAP #1 REPAIR: Spawn enemies and items at the planned spawn points
+		if *verbose {
+			clientLogger.Info("spawning planned world content")
+		}
 
-	merchantCount, err := engine.SpawnMerchantsInTerrain(game.World, generatedTerrain, *seed, merchantParams, 2) // Spawn 2 merchants per level
-	if err != nil {
-		clientLogger.WithError(err).Warn("failed to spawn merchants")
-	} else if *verbose {
-		clientLogger.WithField("merchantCount", merchantCount).Info("spawned merchants")
-	}
+		planCounts, err := engine.SpawnWorldPlan(game.World, plan, 32, difficulty)
+		if err != nil {
+			clientLogger.WithError(err).Warn("failed to spawn world content")
+		} else if *verbose {
+			clientLogger.WithFields(logrus.Fields{
+				"enemyCount":  planCounts.Enemies,
+				"itemCount":   planCounts.Items,
+				"lockedDoors": planCounts.LockedDoors,
+				"roomCount":   len(terr.Rooms) - 1,
+			}).Info("spawned world content")
+		}
 
-	// Spawn crafting stations in dungeon
-	if *verbose {
-		clientLogger.Info("spawning crafting stations in dungeon")
-	}
+		respawnSystem.ClearZones()
+		for _, zone := range engine.NewSpawnZonesFromTerrain(terr, 32, difficulty.SpawnCount(3), respawnPolicy) {
+			if err := respawnSystem.AddZone(zone); err != nil {
+				clientLogger.WithError(err).Warn("failed to register spawn zone")
+			}
+		}
 
-	stationGen := station.NewStationGenerator()
-	stationCount := engine.SpawnStationsInTerrain(game.World, stationGen, generatedTerrain, 32, *seed+1000, *genreID)
-	if *verbose {
-		clientLogger.WithField("stationCount", stationCount).Info("spawned crafting stations")
-	}
+		// GAP #4 REPAIR: Spawn merchants in dungeon
+		if *verbose {
+			clientLogger.Info("spawning merchants in dungeon")
+		}
 
-	// Phase 5.3: Spawn environmental lights in dungeon (if lighting enabled)
-	if *enableLighting {
+		merchantParams := procgen.GenerationParams{
+			Difficulty: 0.5,
+			Depth:      1,
+			GenreID:    *genreID,
+		}
+
+		merchantCount, err := engine.SpawnMerchantsInTerrain(game.World, terr, worldSeed, merchantParams, 2) // Spawn 2 merchants per level
+		if err != nil {
+			clientLogger.WithError(err).Warn("failed to spawn merchants")
+		} else if *verbose {
+			clientLogger.WithField("merchantCount", merchantCount).Info("spawned merchants")
+		}
+
+		// Spawn crafting stations in dungeon
 		if *verbose {
-			clientLogger.Info("spawning environmental lights in dungeon")
+			clientLogger.Info("spawning crafting stations in dungeon")
+		}
+
+		stationCount := engine.SpawnStationsInTerrain(game.World, stationGen, terr, 32, worldSeed+1000, *genreID, params.Depth)
+		if *verbose {
+			clientLogger.WithField("stationCount", stationCount).Info("spawned crafting stations")
+		}
+
+		// Place a shrine in every shrine room
+		shrineCount := engine.SpawnShrinesInTerrain(game.World, terr, 32, worldSeed+2000, *genreID, params.Depth)
+		if *verbose {
+			clientLogger.WithField("shrineCount", shrineCount).Info("spawned shrines")
 		}
-		lightCount := spawnEnvironmentalLights(game.World, generatedTerrain, *seed+2000, *genreID)
-		clientLogger.WithFields(logrus.Fields{
-			"lightCount": lightCount,
-			"genre":      *genreID,
-		}).Info("spawned environmental lights")
-	}
 
-	// Phase 5.4: Spawn weather effects (if enabled)
-	if *enableWeather {
+		// Scatter barrels for the player to pick up and throw
+		barrelCount := engine.SpawnBarrelsInTerrain(game.World, terr, 32, worldSeed+2500)
 		if *verbose {
-			clientLogger.Info("spawning weather effects")
+			clientLogger.WithField("barrelCount", barrelCount).Info("spawned barrels")
 		}
-		weatherEntity := spawnWeather(game.World, *width, *height, *seed+3000, *genreID, *weatherType, *weatherIntensity)
-		if weatherEntity != nil {
+
+		// Phase 5.3: Spawn environmental lights in dungeon (if lighting enabled)
+		if *enableLighting {
+			if *verbose {
+				clientLogger.Info("spawning environmental lights in dungeon")
+			}
+			lightCount := spawnEnvironmentalLights(game.World, terr, plan.SpawnsOfKind(worldgen.SpawnLight), worldSeed+2000, *genreID)
 			clientLogger.WithFields(logrus.Fields{
-				"type":      *weatherType,
-				"intensity": *weatherIntensity,
-				"genre":     *genreID,
-			}).Info("weather effects spawned")
+				"lightCount": lightCount,
+				"genre":      *genreID,
+			}).Info("spawned environmental lights")
+		}
+
+		// Phase 5.4: Spawn weather effects (if enabled)
+		if *enableWeather {
+			if *verbose {
+				clientLogger.Info("spawning weather effects")
+			}
+			weatherEntity := spawnWeather(game.World, *width, *height, plan.Weather, worldSeed+3000, *genreID, *weatherType, *weatherIntensity)
+			if weatherEntity != nil {
+				clientLogger.WithFields(logrus.Fields{
+					"type":      *weatherType,
+					"intensity": *weatherIntensity,
+					"genre":     *genreID,
+				}).Info("weather effects spawned")
+			}
 		}
 	}
+	populateWorld(worldPlan)
 
 	// Create player entity
 	if *verbose {
@@ -1235,26 +1430,30 @@ func main() {
 	playerEntity = player
 
 	// GAP #3 REPAIR: Calculate player spawn position from first room
-	var playerX, playerY float64
-	if len(generatedTerrain.Rooms) > 0 {
+	spawnPoint := func(terr *terrain.Terrain) (float64, float64) {
+		if len(terr.Rooms) == 0 {
+			// Fallback to default position if no rooms (shouldn't happen with valid terrain)
+			clientLogger.Warn("no rooms in terrain, using default spawn position")
+			return 400, 300
+		}
+
 		// Spawn in center of first room
-		firstRoom := generatedTerrain.Rooms[0]
-		cx, cy := firstRoom.Center()
-		playerX = float64(cx * 32) // Convert tile coordinates to world coordinates
-		playerY = float64(cy * 32)
+		cx, cy := terr.Rooms[0].Center()
+		x, y := float64(cx*32), float64(cy*32) // Convert tile coordinates to world coordinates
 		if *verbose {
 			clientLogger.WithFields(logrus.Fields{
 				"tileX":  cx,
 				"tileY":  cy,
-				"worldX": playerX,
-				"worldY": playerY,
+				"worldX": x,
+				"worldY": y,
 			}).Info("player spawning in first room")
 		}
-	} else {
-		// Fallback to default position if no rooms (shouldn't happen with valid terrain)
-		playerX, playerY = 400, 300
-		clientLogger.Warn("no rooms in terrain, using default spawn position")
+		return x, y
 	}
+	playerX, playerY := spawnPoint(generatedTerrain)
+
+	// Casual death mode respawns the player at the spawn point
+	deathModeHandler.SetCheckpoint(playerX, playerY)
 
 	// Add player components
 	player.AddComponent(&engine.PositionComponent{X: playerX, Y: playerY})
@@ -1421,6 +1620,9 @@ func main() {
 		Cooldown:   0.5,
 	})
 
+	// Queue attacks and spells pressed just before their cooldown ends
+	player.AddComponent(engine.NewAbilityBufferComponent(engine.DefaultAbilityBufferWindow))
+
 	// Add collision for player (28x28 to fit through 32px corridors)
 	player.AddComponent(&engine.ColliderComponent{
 		Width:     28,
@@ -1430,15 +1632,27 @@ func main() {
 		Layer:     1,
 		OffsetX:   -14, // Center the collider (28/2 = 14)
 		OffsetY:   -14,
+		Response:  engine.CollisionSlide, // Glide along walls instead of sticking
 	})
 
 	// GAP-012 REPAIR: Add visual feedback for hit flash
 	player.AddComponent(engine.NewVisualFeedbackComponent())
 
+	// Track how visible the player is to unaware enemies
+	player.AddComponent(&engine.StealthComponent{})
+
+	// Auto-pickup currency, materials and consumables; prompt for equipment
+	player.AddComponent(engine.NewPickupFilterComponent())
+
+	// Pick up and throw barrels
+	player.AddComponent(engine.NewCarrierComponent())
+
 	clientLogger.WithField("entityID", player.ID).Info("player entity created")
 
 	// Apply character class stats if character data is available
+	playerClass := engine.ClassWarrior // Without character data the player uses the default class
 	if charData := game.GetPendingCharacterData(); charData != nil {
+		playerClass = charData.Class
 		clientLogger.WithFields(logrus.Fields{
 			"name":  charData.Name,
 			"class": charData.Class.String(),
@@ -1452,6 +1666,9 @@ func main() {
 		// Future enhancement: Add NameComponent for multiplayer identification
 	}
 
+	// Out-of-combat health regen tuned by genre and class
+	player.AddComponent(engine.NewPlayerHealthRegen(*genreID, playerClass))
+
 	// Add starter items to inventory
 	clientLogger.Info("adding starter items to inventory")
 	addStarterItems(playerInventory, *seed, *genreID, logger)
@@ -1463,6 +1680,13 @@ func main() {
 	// Initialize save/load system (Phase 8.4)
 	clientLogger.Info("initializing save/load system")
 
+	// characterID ties together every save this character writes; loading
+	// a save continues the saved character
+	characterID := saveload.NewCharacterID()
+
+	// autoSaveMu is held while an autosave is written in the background
+	var autoSaveMu sync.Mutex
+
 	saveManager, err := saveload.NewSaveManager("./saves")
 	if err != nil {
 		clientLogger.WithError(err).Warn("failed to initialize save manager, save/load functionality will be unavailable")
@@ -1471,10 +1695,8 @@ func main() {
 			clientLogger.Info("save/load system initialized")
 		}
 
-		// Setup quick save callback (F5)
-		inputSystem.SetQuickSaveCallback(func() error {
-			clientLogger.Info("quick save (F5 pressed)")
-
+		// Snapshot the game for quick saves and autosaves
+		buildGameSave := func() *saveload.GameSave {
 			// Get player position
 			var posX, posY float64
 			if posComp, ok := player.GetComponent("position"); ok {
@@ -1588,6 +1810,7 @@ func main() {
 				Version: saveload.SaveVersion,
 				PlayerState: &saveload.PlayerState{
 					EntityID:       player.ID,
+					CharacterID:    characterID,
 					X:              posX,
 					Y:              posY,
 					CurrentHealth:  currentHealth,
@@ -1608,7 +1831,7 @@ func main() {
 					TutorialState:  tutorialStateData, // GAP-003 REPAIR: Tutorial persistence
 				},
 				WorldState: &saveload.WorldState{
-					Seed:       *seed,
+					Seed:       deathModeHandler.RunSeed(*seed),
 					GenreID:    *genreID,
 					Width:      generatedTerrain.Width,
 					Height:     generatedTerrain.Height,
@@ -1626,9 +1849,20 @@ func main() {
 					SFXVolume:    0.8,
 					KeyBindings:  make(map[string]string),
 				},
+				// Enemies, dropped items, merchants and stations
+				EntitiesState: saveManager.SnapshotEntities(engine.NewWorldSaveAdapter(game.World)),
 			}
 
-			if err := saveManager.SaveGame("quicksave", gameSave); err != nil {
+			// Active buffs, debuffs and cooldowns
+			engine.SnapshotCombatState(player, gameSave.PlayerState)
+			return gameSave
+		}
+
+		// Setup quick save callback (F5)
+		inputSystem.SetQuickSaveCallback(func() error {
+			clientLogger.Info("quick save (F5 pressed)")
+
+			if err := saveManager.SaveGame("quicksave", buildGameSave()); err != nil {
 				clientLogger.WithError(err).Error("failed to save game")
 				return err
 			}
@@ -1644,8 +1878,14 @@ func main() {
 			gameSave, err := saveManager.LoadGame("quicksave")
 			if err != nil {
 				clientLogger.WithError(err).Error("failed to load game")
+				if errors.Is(err, saveload.ErrSaveCorrupted) {
+					return fmt.Errorf("save corrupted, try a backup")
+				}
 				return err
 			}
+			if gameSave.PlayerState.CharacterID != "" {
+				characterID = gameSave.PlayerState.CharacterID
+			}
 
 			// Restore player position
 			if posComp, ok := player.GetComponent("position"); ok {
@@ -1752,6 +1992,10 @@ func main() {
 				}
 			}
 
+			// Restore active buffs, debuffs and cooldowns (after stats and
+			// spells, which they depend on)
+			engine.RestoreCombatState(player, gameSave.PlayerState)
+
 			// GAP-005 REPAIR: Restore fog of war exploration state
 			if game.MapUI != nil && gameSave.WorldState != nil && gameSave.WorldState.FogOfWar != nil {
 				game.MapUI.SetFogOfWar(gameSave.WorldState.FogOfWar)
@@ -1768,6 +2012,19 @@ func main() {
 				}
 			}
 
+			// Restore enemies, dropped items, merchants and stations; saves
+			// without entities keep the current world
+			if gameSave.EntitiesState != nil {
+				idMap, err := saveManager.RestoreEntities(engine.NewWorldSaveAdapter(game.World), gameSave.EntitiesState)
+				if err != nil {
+					clientLogger.WithError(err).Error("failed to restore entities")
+					return err
+				}
+				if *verbose {
+					clientLogger.WithField("entities", len(idMap)).Debug("restored world entities")
+				}
+			}
+
 			// GAP-003 REPAIR: Restore tutorial state
 			if game.TutorialSystem != nil && gameSave.PlayerState.TutorialState != nil {
 				tutState := gameSave.PlayerState.TutorialState
@@ -1793,8 +2050,86 @@ func main() {
 		if *verbose {
 			clientLogger.Info("quick save/load callbacks registered (F5/F9)")
 		}
+
+		// Periodic autosaves into the rotating autosave slots. The game is
+		// snapshotted on the game loop and written in the background; a due
+		// autosave waits while the previous one is still being written. A
+		// hardcore death deletes the character's saves, so autosaving stops
+		// there.
+		lastAutoSave := time.Now()
+		game.World.AddSystem(frameHook(func(float64) {
+			now := time.Now()
+			if deathModeHandler.IsSaveMarkedForDeletion() || player.HasComponent("dead") || !saveManager.AutoSaveConfig().Due(lastAutoSave, now) {
+				return
+			}
+			if !autoSaveMu.TryLock() {
+				return
+			}
+			lastAutoSave = now
+			gameSave := buildGameSave()
+			go func() {
+				defer autoSaveMu.Unlock()
+				if err := saveManager.AutoSave(gameSave); err != nil {
+					clientLogger.WithError(err).Warn("autosave failed")
+				}
+			}()
+		}))
 	}
 
+	// restartRun replaces the world with a new run's world and resets the
+	// player for it. Players and server-owned entities are kept.
+	restartRun := func() {
+		runSeed := deathModeHandler.RunSeed(*seed)
+		runPlan, err := worldPipeline.Run(runSeed, params)
+		if err != nil {
+			clientLogger.WithError(err).Error("failed to generate new run")
+			return
+		}
+
+		for _, entity := range game.World.GetEntities() {
+			if !entity.HasComponent("input") && !entity.HasComponent("network") {
+				game.World.RemoveEntity(entity.ID)
+			}
+		}
+
+		generatedTerrain = runPlan.Terrain
+		setTerrain(generatedTerrain)
+		populateWorld(runPlan)
+		deathModeHandler.SetCheckpoint(spawnPoint(generatedTerrain))
+		deathModeHandler.StartNewRun(player)
+
+		clientLogger.WithFields(logrus.Fields{
+			"run":  deathModeHandler.Run(),
+			"seed": runSeed,
+		}).Info("roguelike death: new run started, meta-progression kept")
+	}
+
+	// Hardcore deaths delete every save of the character; roguelike
+	// deaths restart the run on the next frame, outside the combat update
+	runRestartPending := false
+	deathModeHandler.SetOutcomeCallback(func(_ *engine.Entity, outcome engine.DeathOutcome) {
+		if outcome.DeleteSave && saveManager != nil {
+			// Let an autosave in progress finish so it is deleted too
+			autoSaveMu.Lock()
+			deleted, err := saveManager.DeleteCharacterSaves(characterID)
+			autoSaveMu.Unlock()
+			if err != nil {
+				clientLogger.WithError(err).Warn("failed to delete saves after hardcore death")
+			} else {
+				clientLogger.WithField("saves", deleted).Info("hardcore death: saves deleted")
+			}
+		}
+		if outcome.RestartRun {
+			runRestartPending = true
+		}
+	})
+	game.World.AddSystem(frameHook(func(deltaTime float64) {
+		if runRestartPending {
+			runRestartPending = false
+			restartRun()
+		}
+	}))
+
 	// Connect inventory system to UI for item actions
 	game.SetInventorySystem(inventorySystem)
 
@@ -1824,7 +2159,21 @@ func main() {
 		// Find closest merchant within interaction range (64 pixels)
 		merchant, dist := engine.FindClosestMerchant(game.World, pos.X, pos.Y, 64.0)
 		if merchant == nil {
-			// No merchant nearby
+			// No merchant nearby: activate a shrine
+			if shrine := engine.FindClosestShrine(game.World, pos.X, pos.Y, 64.0); shrine != nil {
+				effect, err := engine.ActivateShrine(shrine, player, statusEffectSystem)
+				if err != nil {
+					clientLogger.WithError(err).Warn("failed to activate shrine")
+					return
+				}
+				tutorialSystem.ShowNotification(effect.Description(), 3.0)
+				return
+			}
+
+			// Or pick up an item the pickup filter left behind
+			if itemPickupSystem.PickUpNearest(player) {
+				return
+			}
 			if *verbose {
 				clientLogger.Debug("no merchant nearby to interact with")
 			}
@@ -1853,6 +2202,16 @@ func main() {
 		}
 	})
 
+	// Pick up the closest barrel or throw the held one (G key)
+	inputSystem.SetCarryCallback(func() {
+		if player == nil || player.HasComponent("dead") {
+			return
+		}
+		if _, err := throwSystem.PickUpOrThrow(player); err != nil && *verbose {
+			clientLogger.WithError(err).Debug("nothing to pick up or throw")
+		}
+	})
+
 	if *verbose {
 		clientLogger.Info("merchant interaction registered (F key when near merchant)")
 	}
@@ -1934,6 +2293,7 @@ func main() {
 				Version: saveload.SaveVersion,
 				PlayerState: &saveload.PlayerState{
 					EntityID:      player.ID,
+					CharacterID:   characterID,
 					X:             posX,
 					Y:             posY,
 					CurrentHealth: currentHealth,
@@ -1948,7 +2308,7 @@ func main() {
 					Gold:          gold,
 				},
 				WorldState: &saveload.WorldState{
-					Seed:       *seed,
+					Seed:       deathModeHandler.RunSeed(*seed),
 					GenreID:    *genreID,
 					Width:      generatedTerrain.Width,
 					Height:     generatedTerrain.Height,
@@ -1967,6 +2327,9 @@ func main() {
 				},
 			}
 
+			// Active buffs, debuffs and cooldowns
+			engine.SnapshotCombatState(player, gameSave.PlayerState)
+
 			if err := saveManager.SaveGame(saveName, gameSave); err != nil {
 				clientLogger.WithError(err).WithField("saveName", saveName).Error("failed to save game")
 				return err
@@ -1985,8 +2348,14 @@ func main() {
 			gameSave, err := saveManager.LoadGame(saveName)
 			if err != nil {
 				clientLogger.WithError(err).WithField("saveName", saveName).Error("failed to load game")
+				if errors.Is(err, saveload.ErrSaveCorrupted) {
+					return fmt.Errorf("save corrupted, try a backup")
+				}
 				return err
 			}
+			if gameSave.PlayerState.CharacterID != "" {
+				characterID = gameSave.PlayerState.CharacterID
+			}
 
 			// Restore player position
 			if posComp, ok := player.GetComponent("position"); ok {
@@ -2017,6 +2386,9 @@ func main() {
 				exp.CurrentXP = gameSave.PlayerState.Experience
 			}
 
+			// Restore active buffs, debuffs and cooldowns
+			engine.RestoreCombatState(player, gameSave.PlayerState)
+
 			clientLogger.WithField("saveName", saveName).Info("game loaded successfully")
 			return nil
 		}
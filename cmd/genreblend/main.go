@@ -16,10 +16,16 @@ var (
 	secondaryID = flag.String("secondary", "scifi", "Secondary genre ID")
 	weight      = flag.Float64("weight", 0.5, "Blend weight (0.0=all primary, 1.0=all secondary)")
 	seed        = flag.Int64("seed", 12345, "Random seed for deterministic blending")
+	paletteW    = flag.Float64("palette-weight", -1, "Palette blend weight (-1 = use -weight)")
+	namingW     = flag.Float64("naming-weight", -1, "Naming blend weight (-1 = use -weight)")
+	terrainW    = flag.Float64("terrain-weight", -1, "Terrain blend weight (-1 = use -weight)")
+	themesW     = flag.Float64("themes-weight", -1, "Themes blend weight (-1 = use -weight)")
 	preset      = flag.String("preset", "", "Use a preset blend (e.g., 'sci-fi-horror', 'dark-fantasy')")
 	listPresets = flag.Bool("list-presets", false, "List all available preset blends")
 	listGenres  = flag.Bool("list-genres", false, "List all available base genres")
 	verbose     = flag.Bool("verbose", false, "Show detailed blend information")
+	genresFile  = flag.String("genres-file", "", "JSON file of custom genres to load alongside the built-ins")
+	forceGenres = flag.Bool("force-genres", false, "Let -genres-file replace built-in genres with the same ID")
 )
 
 func main() {
@@ -39,14 +45,22 @@ func main() {
 		return
 	}
 
+	// Load custom genres
+	registry := genre.DefaultRegistry()
+	if *genresFile != "" {
+		if err := registry.ImportFile(*genresFile, *forceGenres); err != nil {
+			logger.WithError(err).Fatal("failed to load genres")
+		}
+		logger.WithField("file", *genresFile).Info("custom genres loaded")
+	}
+
 	// List genres
 	if *listGenres {
-		showGenres(logger)
+		showGenres(registry, logger)
 		return
 	}
 
 	// Create blender
-	registry := genre.DefaultRegistry()
 	blender := genre.NewGenreBlender(registry)
 
 	var blended *genre.BlendedGenre
@@ -69,7 +83,7 @@ func main() {
 		}).Info("blending genres")
 		fmt.Printf("Blending %s + %s (weight: %.2f, seed: %d)\n\n",
 			*primaryID, *secondaryID, *weight, *seed)
-		blended, err = blender.Blend(*primaryID, *secondaryID, *weight, *seed)
+		blended, err = blender.BlendWithWeights(*primaryID, *secondaryID, aspectWeights(), *seed)
 	}
 
 	if err != nil {
@@ -85,8 +99,27 @@ func main() {
 	showBlendedGenre(blended, *verbose, logger)
 }
 
-func showGenres(logger *logrus.Logger) {
-	registry := genre.DefaultRegistry()
+// aspectWeights returns the per-aspect blend weights, defaulting each
+// unset aspect to -weight.
+func aspectWeights() genre.BlendWeights {
+	weights := genre.UniformBlendWeights(*weight)
+	for _, w := range []struct {
+		flag   float64
+		aspect *float64
+	}{
+		{*paletteW, &weights.Palette},
+		{*namingW, &weights.Naming},
+		{*terrainW, &weights.Terrain},
+		{*themesW, &weights.Themes},
+	} {
+		if w.flag >= 0 {
+			*w.aspect = w.flag
+		}
+	}
+	return weights
+}
+
+func showGenres(registry *genre.Registry, logger *logrus.Logger) {
 	fmt.Println("=== Available Base Genres ===")
 
 	for _, g := range registry.All() {
@@ -156,6 +189,10 @@ func showBlendedGenre(blended *genre.BlendedGenre, verbose bool, logger *logrus.
 			secondary.PrimaryColor, secondary.SecondaryColor, secondary.AccentColor)
 		fmt.Println()
 		fmt.Printf("Blend Weight: %.2f\n", blended.BlendWeight)
+		if !blended.Weights.IsUniform() {
+			fmt.Printf("  Palette: %.2f  Naming: %.2f  Terrain: %.2f  Themes: %.2f\n",
+				blended.Weights.Palette, blended.Weights.Naming, blended.Weights.Terrain, blended.Weights.Themes)
+		}
 		if blended.BlendWeight < 0.33 {
 			fmt.Printf("  (Primarily %s with %s elements)\n", primary.Name, secondary.Name)
 		} else if blended.BlendWeight > 0.67 {
@@ -19,6 +19,7 @@ func main() {
 	genreID := flag.String("genre", "fantasy", "Genre ID (fantasy, scifi, horror, cyberpunk, postapocalyptic)")
 	count := flag.Int("count", 5, "Number of puzzles to generate")
 	verbose := flag.Bool("verbose", false, "Show detailed puzzle information")
+	target := flag.Float64("target-difficulty", -1, "Target estimated difficulty (0.0-1.0, negative to disable)")
 
 	flag.Parse()
 
@@ -28,6 +29,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *target > 1.0 {
+		fmt.Fprintf(os.Stderr, "Error: target-difficulty must be at most 1.0\n")
+		os.Exit(1)
+	}
+
 	if *depth < 1 {
 		fmt.Fprintf(os.Stderr, "Error: depth must be at least 1\n")
 		os.Exit(1)
@@ -52,6 +58,9 @@ func main() {
 		Depth:      *depth,
 		GenreID:    *genreID,
 	}
+	if *target >= 0 {
+		params.Custom = map[string]interface{}{"target_difficulty": *target}
+	}
 
 	// Generate and display puzzles
 	for i := 0; i < *count; i++ {
@@ -93,7 +102,7 @@ func displayPuzzle(puz *puzzle.Puzzle, verbose bool) {
 	// Basic information
 	fmt.Printf("ID: %s\n", puz.ID)
 	fmt.Printf("Type: %s\n", puz.Type)
-	fmt.Printf("Difficulty: %d/10\n", puz.Difficulty)
+	fmt.Printf("Difficulty: %d/10 (estimated %.2f)\n", puz.Difficulty, puz.EstimateDifficulty())
 	fmt.Printf("Description: %s\n", puz.Description)
 	fmt.Printf("Hint: %s\n", puz.HintText)
 	fmt.Printf("Elements: %d\n", puz.ElementCount)
@@ -120,5 +129,10 @@ func displayPuzzle(puz *puzzle.Puzzle, verbose bool) {
 		for i, solID := range puz.Solution {
 			fmt.Printf("  %d. %s\n", i+1, solID)
 		}
+
+		fmt.Println("\nHints:")
+		for _, hint := range puz.GenerateHints() {
+			fmt.Printf("  %d. %s\n", hint.Level, hint.Text)
+		}
 	}
 }
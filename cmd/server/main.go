@@ -19,18 +19,24 @@ import (
 	"github.com/opd-ai/venture/pkg/procgen"
 	itemgen "github.com/opd-ai/venture/pkg/procgen/item"
 	"github.com/opd-ai/venture/pkg/procgen/terrain"
+	"github.com/opd-ai/venture/pkg/procgen/worldgen"
 	"github.com/opd-ai/venture/pkg/rendering/sprites"
 	"github.com/sirupsen/logrus"
 )
 
 var (
-	port          = flag.String("port", "8080", "Server port")
-	maxPlayers    = flag.Int("max-players", 4, "Maximum number of players")
-	seed          = flag.Int64("seed", 12345, "World generation seed")
-	genreID       = flag.String("genre", "fantasy", "Genre ID for world generation")
-	tickRate      = flag.Int("tick-rate", 20, "Server update rate (updates per second)")
-	verbose       = flag.Bool("verbose", false, "Enable verbose logging")
-	aerialSprites = flag.Bool("aerial-sprites", true, "Enable aerial-view perspective sprites for top-down gameplay")
+	port             = flag.String("port", "8080", "Server port")
+	maxPlayers       = flag.Int("max-players", 4, "Maximum number of players")
+	queueSlots       = flag.Int("queue-slots", 0, "Connections that may wait for a player slot when full (0 = reject)")
+	seed             = flag.Int64("seed", 12345, "World generation seed")
+	genreID          = flag.String("genre", "fantasy", "Genre ID for world generation")
+	tickRate         = flag.Int("tick-rate", 20, "Server update rate (updates per second)")
+	keyframeInterval = flag.Int("keyframe-interval", network.DefaultKeyframeInterval, "Send a full state snapshot every N ticks (0 = only the first)")
+	interestRadius   = flag.Float64("interest-radius", network.DefaultInterestRadius, "Only send each player entities within this many pixels (0 = send everything)")
+	sendBudget       = flag.Int("send-budget", network.DefaultSendBudget, "Bytes of state sent to each player per tick, nearby and important entities first (0 = unlimited)")
+	difficultyName   = flag.String("difficulty", "normal", "Difficulty preset (story, normal, hard, nightmare)")
+	verbose          = flag.Bool("verbose", false, "Enable verbose logging")
+	aerialSprites    = flag.Bool("aerial-sprites", true, "Enable aerial-view perspective sprites for top-down gameplay")
 )
 
 func main() {
@@ -90,6 +96,12 @@ func main() {
 	world.AddSystem(aiSystem)
 	world.AddSystem(progressionSystem)
 	world.AddSystem(inventorySystem)
+	world.AddSystem(engine.NewLockSystem(world))
+
+	// Health regen pauses after damage; the combat damage callback resets its delay
+	healthRegenSystem := engine.NewHealthRegenSystem()
+	combatSystem.SetDamageCallback(healthRegenSystem.OnDamage)
+	world.AddSystem(healthRegenSystem)
 
 	if logger.GetLevel() >= logrus.DebugLevel {
 		worldLogger.Debug("game systems initialized")
@@ -97,36 +109,54 @@ func main() {
 
 	// Generate initial world terrain
 	terrainLogger := logging.GeneratorLogger(logger, "terrain", *seed, *genreID)
+	worldConfig := worldgen.DefaultConfig()
 	if logger.GetLevel() >= logrus.DebugLevel {
 		terrainLogger.WithFields(logrus.Fields{
-			"width":  100,
-			"height": 100,
+			"width":  worldConfig.Width,
+			"height": worldConfig.Height,
 		}).Debug("generating world terrain")
 	}
 
-	terrainGen := terrain.NewBSPGeneratorWithLogger(logger) // Use BSP algorithm with logging
+	// Shared pipeline so clients with the same seed build an identical world
+	worldPipeline, err := worldgen.NewPipelineFromConfigWithLogger(worldConfig, logger)
+	if err != nil {
+		serverLogger.WithError(err).Fatal("failed to create world generation pipeline")
+	}
 	params := procgen.GenerationParams{
 		Difficulty: 0.5,
 		Depth:      1,
 		GenreID:    *genreID,
-		Custom: map[string]interface{}{
-			"width":  100,
-			"height": 100,
-		},
 	}
 
-	terrainResult, err := terrainGen.Generate(*seed, params)
+	worldPlan, err := worldPipeline.Run(*seed, params)
 	if err != nil {
 		serverLogger.WithError(err).Fatal("failed to generate terrain")
 	}
 
-	generatedTerrain := terrainResult.(*terrain.Terrain)
+	generatedTerrain := worldPlan.Terrain
 	terrainLogger.WithFields(logrus.Fields{
 		"width":     generatedTerrain.Width,
 		"height":    generatedTerrain.Height,
 		"roomCount": len(generatedTerrain.Rooms),
+		"spawns":    len(worldPlan.Spawns),
 	}).Info("world terrain generated")
 
+	// Spawn the planned content so clients see the world the pipeline built
+	difficultyPreset, err := engine.ParseDifficultyPreset(*difficultyName)
+	if err != nil {
+		serverLogger.WithError(err).Warn("invalid difficulty, using normal")
+	}
+	planCounts, err := engine.SpawnWorldPlan(world, worldPlan, 32, difficultyPreset.Settings())
+	if err != nil {
+		serverLogger.WithError(err).Fatal("failed to spawn world content")
+	}
+	terrainLogger.WithFields(logrus.Fields{
+		"enemies":     planCounts.Enemies,
+		"items":       planCounts.Items,
+		"keys":        planCounts.Keys,
+		"lockedDoors": planCounts.LockedDoors,
+	}).Info("world content spawned")
+
 	// Initialize network components
 	networkLogger := logger.WithFields(logrus.Fields{"system": "network"})
 	if logger.GetLevel() >= logrus.DebugLevel {
@@ -137,6 +167,7 @@ func main() {
 	serverConfig := network.DefaultServerConfig()
 	serverConfig.Address = ":" + *port
 	serverConfig.MaxPlayers = *maxPlayers
+	serverConfig.QueueSlots = *queueSlots
 	serverConfig.UpdateRate = *tickRate
 
 	// Create network server with logging
@@ -144,6 +175,26 @@ func main() {
 
 	// Create snapshot manager for state synchronization
 	snapshotManager := network.NewSnapshotManager(100)
+	deltaConfig := network.DefaultDeltaConfig()
+	if *keyframeInterval >= 0 {
+		deltaConfig.KeyframeInterval = uint32(*keyframeInterval)
+	}
+	snapshotManager.SetDeltaConfig(deltaConfig)
+
+	// Area-of-interest filtering queries a quadtree of the world rebuilt
+	// every tick
+	interestIndex := engine.NewQuadtree(engine.Bounds{
+		Width:  float64(generatedTerrain.Width * 32),
+		Height: float64(generatedTerrain.Height * 32),
+	}, 16)
+	interestManager := network.NewInterestManager(interestIndex, *interestRadius)
+
+	// State each client holds after its last update, which its deltas are
+	// diffed against so changes under the send threshold accumulate
+	clientBaselines := make(map[uint64]*network.WorldSnapshot)
+
+	// Ranks each player's entity updates to fit the send budget
+	prioritizer := network.NewUpdatePrioritizer()
 
 	// Create lag compensator
 	lagCompConfig := network.DefaultLagCompensationConfig()
@@ -152,6 +203,7 @@ func main() {
 	networkLogger.WithFields(logrus.Fields{
 		"address":    serverConfig.Address,
 		"maxPlayers": serverConfig.MaxPlayers,
+		"queueSlots": serverConfig.QueueSlots,
 		"updateRate": serverConfig.UpdateRate,
 	}).Info("network systems initialized")
 
@@ -259,6 +311,19 @@ func main() {
 		}
 	}()
 
+	// Handle reliable client messages in background. They arrive exactly
+	// once and in order per player, separate from the lossy input stream.
+	go func() {
+		for msg := range server.ReceiveReliable() {
+			networkLogger.WithFields(logrus.Fields{
+				"playerID": msg.PlayerID,
+				"type":     msg.Type,
+				"sequence": msg.Sequence,
+				"size":     len(msg.Data),
+			}).Debug("received reliable message")
+		}
+	}()
+
 	for {
 		select {
 		case <-ticker.C:
@@ -275,9 +340,49 @@ func main() {
 			snapshotManager.AddSnapshot(snapshot)
 			lagCompensator.RecordSnapshot(snapshot)
 
-			// Broadcast state to connected clients
-			stateUpdate := convertSnapshotToStateUpdate(snapshot)
-			server.BroadcastStateUpdate(stateUpdate)
+			// Send each player the changes in its area of interest
+			interestIndex.Rebuild(world.GetEntities())
+			latest := snapshotManager.GetLatestSnapshot()
+			attackers := attackersByTarget(world)
+			connected := make(map[uint64]bool)
+			for _, playerID := range server.GetPlayers() {
+				connected[playerID] = true
+
+				// Players without an entity yet get the whole world
+				view := *latest
+				playerEntitiesMu.RLock()
+				entity, exists := playerEntities[playerID]
+				playerEntitiesMu.RUnlock()
+				baseline := clientBaselines[playerID]
+				if exists {
+					view = interestManager.FilterSnapshot(*latest, entity.ID)
+					ctx := &network.PriorityContext{
+						ViewerEntityID: entity.ID,
+						ViewerPosition: latest.Entities[entity.ID].Position,
+						AttackerIDs:    attackers[entity.ID],
+					}
+					view = prioritizer.LimitView(playerID, ctx, baseline, view, deltaConfig, *sendBudget)
+				}
+				stateUpdate := convertSnapshotToStateUpdate(snapshotManager, baseline, view)
+				applied, err := network.ApplyStateUpdate(baseline, stateUpdate)
+				if err != nil {
+					networkLogger.WithError(err).WithField("playerID", playerID).Warn("failed to apply state delta, next update will be a keyframe")
+				}
+
+				// The baseline only advances once the update is queued for the
+				// player; after a drop the next update is a keyframe
+				if sendErr := server.SendStateUpdate(playerID, stateUpdate); sendErr != nil || err != nil {
+					delete(clientBaselines, playerID)
+				} else {
+					clientBaselines[playerID] = applied
+				}
+			}
+			for playerID := range clientBaselines {
+				if !connected[playerID] {
+					delete(clientBaselines, playerID)
+					prioritizer.ForgetRecipient(playerID)
+				}
+			}
 
 			// Periodic server metrics logging
 			if logger.GetLevel() >= logrus.DebugLevel && int(now.Unix())%10 == 0 {
@@ -292,6 +397,27 @@ func main() {
 	}
 }
 
+// attackersByTarget maps each entity to the set of entities attacking or
+// chasing it
+func attackersByTarget(world *engine.World) map[uint64]map[uint64]bool {
+	attackers := make(map[uint64]map[uint64]bool)
+	for _, entity := range world.GetEntities() {
+		aiComp, ok := entity.GetComponent("ai")
+		if !ok {
+			continue
+		}
+		ai := aiComp.(*engine.AIComponent)
+		if ai.Target == nil || (ai.State != engine.AIStateChase && ai.State != engine.AIStateAttack) {
+			continue
+		}
+		if attackers[ai.Target.ID] == nil {
+			attackers[ai.Target.ID] = make(map[uint64]bool)
+		}
+		attackers[ai.Target.ID][entity.ID] = true
+	}
+	return attackers
+}
+
 // buildWorldSnapshot creates a network snapshot from the current world state
 func buildWorldSnapshot(world *engine.World, timestamp time.Time) network.WorldSnapshot {
 	snapshot := network.WorldSnapshot{
@@ -324,15 +450,14 @@ func buildWorldSnapshot(world *engine.World, timestamp time.Time) network.WorldS
 	return snapshot
 }
 
-// convertSnapshotToStateUpdate converts a WorldSnapshot to a StateUpdate for broadcasting
-func convertSnapshotToStateUpdate(snapshot network.WorldSnapshot) *network.StateUpdate {
-	// For now, create a simple state update
-	// In a full implementation, this would serialize component data efficiently
-	update := &network.StateUpdate{
-		Timestamp: uint64(snapshot.Timestamp.UnixNano() / 1000000), // milliseconds
-		Priority:  128,                                             // Normal priority
+// convertSnapshotToStateUpdate converts a player's view of the world to a
+// delta-compressed StateUpdate against baseline, or to a full keyframe when
+// there is no baseline or one is due
+func convertSnapshotToStateUpdate(snapshotManager *network.SnapshotManager, baseline *network.WorldSnapshot, view network.WorldSnapshot) *network.StateUpdate {
+	if baseline == nil {
+		return network.Keyframe(view)
 	}
-	return update
+	return snapshotManager.Delta(*baseline, view)
 }
 
 // createPlayerEntity creates a player entity for a connected client
@@ -353,6 +478,7 @@ func createPlayerEntity(world *engine.World, terrain *terrain.Terrain, playerID
 	entity.AddComponent(&engine.PositionComponent{X: spawnX, Y: spawnY})
 	entity.AddComponent(&engine.VelocityComponent{VX: 0, VY: 0})
 	entity.AddComponent(&engine.HealthComponent{Current: 100, Max: 100})
+	entity.AddComponent(engine.NewPlayerHealthRegen(genreID, engine.ClassWarrior))
 	entity.AddComponent(&engine.TeamComponent{TeamID: 1}) // All players on team 1
 
 	// Add network component to mark as networked entity
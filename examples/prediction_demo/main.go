@@ -74,6 +74,10 @@ func demonstrateReconciliation() {
 	fmt.Printf("\n   After reconciliation (replaying inputs 4, 5):\n")
 	fmt.Printf("   Corrected Position: (%.2f, %.2f)\n", corrected.Position.X, corrected.Position.Y)
 	fmt.Printf("   ✓ Client adjusts smoothly to server authority\n")
+
+	stats := predictor.GetReconciliationStats()
+	fmt.Printf("   Reconciliation stats: %d correction(s), max error %.2f, %d input(s) replayed\n",
+		stats.Corrections, stats.MaxError, stats.RollbackFrames)
 }
 
 func demonstrateInterpolation() {
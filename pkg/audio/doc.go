@@ -3,4 +3,7 @@
 //
 // The audio system supports procedural music composition, sound effects generation,
 // and adaptive audio based on game context and genre.
+//
+// Spatialize turns a sound's position relative to the listener into a
+// stereo pan and distance gain, silencing sounds beyond a maximum distance.
 package audio
@@ -34,6 +34,25 @@ type AudioSample struct {
 
 	// Data contains the audio samples (-1.0 to 1.0)
 	Data []float64
+
+	// LoopStart and LoopEnd bound the samples [LoopStart, LoopEnd) repeated
+	// when the sample loops. A LoopEnd of 0 loops the whole sample.
+	LoopStart int
+	LoopEnd   int
+}
+
+// LoopBounds returns the sample range [start, end) to repeat when looping,
+// falling back to the whole sample for missing or invalid loop points.
+func (s *AudioSample) LoopBounds() (start, end int) {
+	end = s.LoopEnd
+	if end <= 0 || end > len(s.Data) {
+		end = len(s.Data)
+	}
+	start = s.LoopStart
+	if start < 0 || start >= end {
+		start = 0
+	}
+	return start, end
 }
 
 // Synthesizer generates audio waveforms.
@@ -397,3 +397,29 @@ func TestNote_FrequencyRange(t *testing.T) {
 		})
 	}
 }
+
+// TestAudioSample_LoopBounds verifies loop point fallbacks.
+func TestAudioSample_LoopBounds(t *testing.T) {
+	data := make([]float64, 100)
+	tests := []struct {
+		name               string
+		loopStart, loopEnd int
+		wantStart, wantEnd int
+	}{
+		{"no loop points", 0, 0, 0, 100},
+		{"valid loop points", 10, 90, 10, 90},
+		{"end past data", 10, 200, 10, 100},
+		{"start after end", 95, 90, 0, 90},
+		{"negative start", -5, 50, 0, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample := &AudioSample{SampleRate: 44100, Data: data, LoopStart: tt.loopStart, LoopEnd: tt.loopEnd}
+			start, end := sample.LoopBounds()
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("LoopBounds() = (%d, %d), want (%d, %d)", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
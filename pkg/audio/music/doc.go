@@ -1,4 +1,10 @@
 // Package music provides procedural music composition.
 // It generates background music using music theory principles and
 // genre-appropriate scales, rhythms, and instruments.
+//
+// GenerateLayered produces loopable music split into base, tension and
+// combat stems with independent gains, so intensity can follow game state.
+//
+// Generated tracks carry LoopStart/LoopEnd offsets (see FindLoopPoints) on
+// bar boundaries at zero-crossings, so looping playback has no audible seam.
 package music
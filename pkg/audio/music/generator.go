@@ -92,9 +92,14 @@ func (g *Generator) GenerateTrack(genre, context string, seed int64, duration fl
 		}).Info("music track generated")
 	}
 
+	// Loop between bars inside the fades so repeats are seamless
+	loopStart, loopEnd := FindLoopPoints(track, g.sampleRate, tempo, g.masterFadeSamples(len(track)))
+
 	return &audio.AudioSample{
 		SampleRate: g.sampleRate,
 		Data:       track,
+		LoopStart:  loopStart,
+		LoopEnd:    loopEnd,
 	}
 }
 
@@ -199,14 +204,21 @@ func (g *Generator) generateHarmony(track []float64, chords []Chord, rhythm Rhyt
 	}
 }
 
-// applyMasterEnvelope applies fade in/out to the entire track.
-func (g *Generator) applyMasterEnvelope(track []float64, duration float64) {
+// masterFadeSamples returns the length of the master fade in and out of a
+// track with numSamples samples.
+func (g *Generator) masterFadeSamples(numSamples int) int {
 	fadeDuration := 0.5 // seconds
 	fadeSamples := int(fadeDuration * float64(g.sampleRate))
 
-	if fadeSamples > len(track)/4 {
-		fadeSamples = len(track) / 4
+	if fadeSamples > numSamples/4 {
+		fadeSamples = numSamples / 4
 	}
+	return fadeSamples
+}
+
+// applyMasterEnvelope applies fade in/out to the entire track.
+func (g *Generator) applyMasterEnvelope(track []float64, duration float64) {
+	fadeSamples := g.masterFadeSamples(len(track))
 
 	// Fade in
 	for i := 0; i < fadeSamples && i < len(track); i++ {
@@ -0,0 +1,239 @@
+// Package music provides layered adaptive music.
+// This file generates music as separate stems (a base layer plus tension and
+// combat layers) sharing one tempo and chord progression, so game state can
+// fade layers in and out without switching tracks.
+package music
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/audio"
+	"github.com/opd-ai/venture/pkg/audio/synthesis"
+)
+
+// DefaultSampleRate is the sample rate GenerateLayered renders at.
+const DefaultSampleRate = 44100
+
+// LayerName identifies a stem of a layered track.
+type LayerName string
+
+// Layer names, from always-on to most intense.
+const (
+	// LayerBase holds the chord pads and bass line
+	LayerBase LayerName = "base"
+	// LayerTension holds an arpeggio for nearby danger
+	LayerTension LayerName = "tension"
+	// LayerCombat holds drums and a driving bass for fights
+	LayerCombat LayerName = "combat"
+)
+
+const (
+	// layeredTempo is shared by every layer so they stay in sync
+	layeredTempo = 90.0
+	// layeredProgressionRepeats is how often the chord progression plays per loop
+	layeredProgressionRepeats = 2
+	// beatsPerBar is the number of beats each chord lasts
+	beatsPerBar = 4
+	// layerPeak is the peak amplitude each layer is normalized to, leaving
+	// headroom when all layers play
+	layerPeak = 0.35
+)
+
+// Layer is one stem of a layered track.
+type Layer struct {
+	Name LayerName
+	Data []float64 // Samples, the same length for every layer
+	Gain float64   // Mix gain (0.0 to 1.0)
+}
+
+// LayeredTrack is a loopable piece of music split into stems. Every layer
+// spans a whole number of bars and note tails wrap around to the start, so
+// the mix loops seamlessly at any gains.
+type LayeredTrack struct {
+	SampleRate int
+	Tempo      float64 // Beats per minute
+	Bars       int
+	Layers     []*Layer // Base, tension and combat layers, in that order
+}
+
+// GenerateLayered generates a layered track for a genre at
+// DefaultSampleRate. Only the base layer is audible initially.
+func GenerateLayered(genreID string, seed int64) (*LayeredTrack, error) {
+	return NewGenerator(DefaultSampleRate, seed).GenerateLayered(genreID, seed)
+}
+
+// GenerateLayered generates a layered track for a genre. The result depends
+// only on the genre, seed and sample rate.
+func (g *Generator) GenerateLayered(genreID string, seed int64) (*LayeredTrack, error) {
+	if genreID == "" {
+		return nil, fmt.Errorf("genre ID is required")
+	}
+	if g.sampleRate <= 0 {
+		return nil, fmt.Errorf("sample rate must be positive, got %d", g.sampleRate)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	osc := synthesis.NewOscillator(g.sampleRate, seed)
+
+	scale := GetScaleForGenre(genreID)
+	rootNote := 48 + rng.Intn(12)
+	chords := GetChordProgression(genreID, rootNote)
+
+	bars := len(chords) * layeredProgressionRepeats
+	beatSamples := int(60.0 / layeredTempo * float64(g.sampleRate))
+	barSamples := beatSamples * beatsPerBar
+	length := bars * barSamples
+
+	track := &LayeredTrack{
+		SampleRate: g.sampleRate,
+		Tempo:      layeredTempo,
+		Bars:       bars,
+		Layers: []*Layer{
+			{Name: LayerBase, Data: make([]float64, length), Gain: 1},
+			{Name: LayerTension, Data: make([]float64, length)},
+			{Name: LayerCombat, Data: make([]float64, length)},
+		},
+	}
+	base, tension, combat := track.Layers[0].Data, track.Layers[1].Data, track.Layers[2].Data
+	beat := float64(beatSamples) / float64(g.sampleRate)
+
+	pad := synthesis.EnvelopePad().Scale(beat * beatsPerBar)
+	bassEnv := synthesis.EnvelopePluck().Scale(beat * 2)
+	arpEnv := synthesis.EnvelopeStab().Scale(beat / 2)
+	kickEnv := synthesis.EnvelopePercussive().Scale(beat * 2)
+	hatEnv := synthesis.EnvelopePercussive()
+	drivingEnv := synthesis.EnvelopeStab().Scale(beat / 4)
+
+	for bar := 0; bar < bars; bar++ {
+		chord := chords[bar%len(chords)]
+		barStart := bar * barSamples
+
+		// Base: a sustained pad of the chord and a bass note every two beats
+		for _, offset := range chord.Notes {
+			note := osc.Generate(audio.WaveformSine, NoteToFrequency(chord.Root+offset), beat*beatsPerBar)
+			pad.Apply(note.Data, g.sampleRate)
+			mixWrapped(base, note.Data, barStart, 1/float64(len(chord.Notes)))
+		}
+		for b := 0; b < beatsPerBar; b += 2 {
+			bass := osc.Generate(audio.WaveformTriangle, NoteToFrequency(chord.Root-12), beat*2)
+			bassEnv.Apply(bass.Data, g.sampleRate)
+			mixWrapped(base, bass.Data, barStart+b*beatSamples, 0.6)
+		}
+
+		// Tension: an eighth-note arpeggio over chord and scale tones
+		for step := 0; step < beatsPerBar*2; step++ {
+			offset := chord.Notes[step%len(chord.Notes)]
+			if rng.Float64() < 0.25 {
+				offset = scale.Intervals[rng.Intn(len(scale.Intervals))]
+			}
+			note := osc.Generate(audio.WaveformSquare, NoteToFrequency(chord.Root+12+offset), beat/2)
+			arpEnv.Apply(note.Data, g.sampleRate)
+			mixWrapped(tension, note.Data, barStart+step*beatSamples/2, 0.5)
+		}
+
+		// Combat: kick on every beat, hats on the off-beats and a sixteenth
+		// note bass
+		for b := 0; b < beatsPerBar; b++ {
+			kick := osc.Generate(audio.WaveformSine, 55, beat/2)
+			kickEnv.Apply(kick.Data, g.sampleRate)
+			mixWrapped(combat, kick.Data, barStart+b*beatSamples, 1)
+
+			hat := osc.Generate(audio.WaveformNoise, 0, beat/4)
+			synthesis.HighPass(hat.Data, g.sampleRate, 6000, synthesis.DefaultResonance)
+			hatEnv.Apply(hat.Data, g.sampleRate)
+			mixWrapped(combat, hat.Data, barStart+b*beatSamples+beatSamples/2, 0.4)
+		}
+		for step := 0; step < beatsPerBar*4; step++ {
+			bass := osc.Generate(audio.WaveformSawtooth, NoteToFrequency(chord.Root-12), beat/4)
+			drivingEnv.Apply(bass.Data, g.sampleRate)
+			synthesis.LowPass(bass.Data, g.sampleRate, 800, synthesis.DefaultResonance)
+			mixWrapped(combat, bass.Data, barStart+step*beatSamples/4, 0.5)
+		}
+	}
+
+	for _, layer := range track.Layers {
+		normalize(layer.Data, layerPeak)
+	}
+	return track, nil
+}
+
+// Layer returns the layer with the given name, or nil.
+func (t *LayeredTrack) Layer(name LayerName) *Layer {
+	for _, layer := range t.Layers {
+		if layer.Name == name {
+			return layer
+		}
+	}
+	return nil
+}
+
+// SetGain sets a layer's mix gain, clamped to [0, 1].
+func (t *LayeredTrack) SetGain(name LayerName, gain float64) error {
+	layer := t.Layer(name)
+	if layer == nil {
+		return fmt.Errorf("unknown layer: %s", name)
+	}
+	layer.Gain = math.Max(0, math.Min(1, gain))
+	return nil
+}
+
+// Gain returns a layer's mix gain, or 0 for unknown layers.
+func (t *LayeredTrack) Gain(name LayerName) float64 {
+	if layer := t.Layer(name); layer != nil {
+		return layer.Gain
+	}
+	return 0
+}
+
+// Duration returns the loop length in seconds.
+func (t *LayeredTrack) Duration() float64 {
+	if len(t.Layers) == 0 || t.SampleRate <= 0 {
+		return 0
+	}
+	return float64(len(t.Layers[0].Data)) / float64(t.SampleRate)
+}
+
+// Mix sums the layers at their current gains into one loopable sample.
+func (t *LayeredTrack) Mix() *audio.AudioSample {
+	var data []float64
+	if len(t.Layers) > 0 {
+		data = make([]float64, len(t.Layers[0].Data))
+	}
+	for _, layer := range t.Layers {
+		if layer.Gain == 0 {
+			continue
+		}
+		for i := range data {
+			data[i] += layer.Data[i] * layer.Gain
+		}
+	}
+	for i := range data {
+		data[i] = math.Max(-1, math.Min(1, data[i]))
+	}
+	return &audio.AudioSample{SampleRate: t.SampleRate, Data: data}
+}
+
+// mixWrapped adds src into dst starting at offset, wrapping past the end of
+// dst back to its start so looped playback keeps note tails.
+func mixWrapped(dst, src []float64, offset int, volume float64) {
+	for i, v := range src {
+		dst[(offset+i)%len(dst)] += v * volume
+	}
+}
+
+// normalize scales data so its peak amplitude is peak. Silent data is left
+// unchanged.
+func normalize(data []float64, peak float64) {
+	var loudest float64
+	for _, v := range data {
+		loudest = math.Max(loudest, math.Abs(v))
+	}
+	if loudest == 0 {
+		return
+	}
+	for i := range data {
+		data[i] *= peak / loudest
+	}
+}
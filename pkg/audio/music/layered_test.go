@@ -0,0 +1,115 @@
+package music
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGenerateLayered(t *testing.T) {
+	track, err := NewGenerator(11025, 1).GenerateLayered("fantasy", 42)
+	if err != nil {
+		t.Fatalf("GenerateLayered() error = %v", err)
+	}
+
+	if len(track.Layers) != 3 || track.Layer(LayerBase) == nil ||
+		track.Layer(LayerTension) == nil || track.Layer(LayerCombat) == nil {
+		t.Fatalf("unexpected layers: %d", len(track.Layers))
+	}
+
+	beatSamples := int(60.0 / track.Tempo * 11025)
+	wantLen := track.Bars * beatsPerBar * beatSamples
+	for _, layer := range track.Layers {
+		if len(layer.Data) != wantLen {
+			t.Errorf("%s has %d samples, want %d whole bars", layer.Name, len(layer.Data), wantLen)
+		}
+		var peak float64
+		for _, v := range layer.Data {
+			peak = math.Max(peak, math.Abs(v))
+		}
+		if math.Abs(peak-layerPeak) > 1e-9 {
+			t.Errorf("%s peak = %f, want %f", layer.Name, peak, layerPeak)
+		}
+	}
+
+	if track.Gain(LayerBase) != 1 || track.Gain(LayerTension) != 0 || track.Gain(LayerCombat) != 0 {
+		t.Error("only the base layer should be audible initially")
+	}
+
+	again, _ := NewGenerator(11025, 99).GenerateLayered("fantasy", 42)
+	for i, layer := range track.Layers {
+		for j := range layer.Data {
+			if layer.Data[j] != again.Layers[i].Data[j] {
+				t.Fatalf("%s sample %d not deterministic", layer.Name, j)
+			}
+		}
+	}
+}
+
+func TestLayeredTrackGainAndMix(t *testing.T) {
+	track, err := NewGenerator(11025, 1).GenerateLayered("horror", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := track.Mix()
+	for i, v := range base.Data {
+		if v != track.Layer(LayerBase).Data[i] {
+			t.Fatalf("base-only mix differs from the base layer at %d", i)
+		}
+	}
+
+	if err := track.SetGain(LayerCombat, 2); err != nil {
+		t.Fatalf("SetGain() error = %v", err)
+	}
+	if track.Gain(LayerCombat) != 1 {
+		t.Errorf("gain = %f, want clamped to 1", track.Gain(LayerCombat))
+	}
+	if err := track.SetGain("choir", 0.5); err == nil {
+		t.Error("expected error for unknown layer")
+	}
+
+	full := track.Mix()
+	if full.SampleRate != 11025 || len(full.Data) != len(base.Data) {
+		t.Fatalf("mix has rate %d and %d samples", full.SampleRate, len(full.Data))
+	}
+	if rms(full.Data) <= rms(base.Data) {
+		t.Error("adding the combat layer should raise the mix level")
+	}
+	if d := track.Duration(); math.Abs(d-float64(len(full.Data))/11025) > 1e-9 {
+		t.Errorf("Duration() = %f", d)
+	}
+}
+
+func TestLayeredTrackLoopsSeamlessly(t *testing.T) {
+	track, err := NewGenerator(11025, 1).GenerateLayered("scifi", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The jump from the last sample back to the first is no larger than the
+	// largest jump inside the base and tension layers
+	for _, name := range []LayerName{LayerBase, LayerTension} {
+		data := track.Layer(name).Data
+		var largest float64
+		for i := 1; i < len(data); i++ {
+			largest = math.Max(largest, math.Abs(data[i]-data[i-1]))
+		}
+		if seam := math.Abs(data[0] - data[len(data)-1]); seam > largest {
+			t.Errorf("%s loop seam jump %f exceeds largest internal jump %f", name, seam, largest)
+		}
+	}
+}
+
+func TestGenerateLayeredInvalid(t *testing.T) {
+	if _, err := GenerateLayered("", 1); err == nil {
+		t.Error("expected error for empty genre")
+	}
+}
+
+func rms(data []float64) float64 {
+	var sum float64
+	for _, v := range data {
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(len(data)))
+}
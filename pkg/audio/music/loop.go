@@ -0,0 +1,56 @@
+// Package music provides seamless loop points for generated tracks.
+// This file picks loop start and end offsets on bar boundaries, nudged to
+// nearby rising zero-crossings, so a looping track doesn't click at the seam.
+package music
+
+import "math"
+
+// FindLoopPoints returns the sample range [start, end) of data to repeat
+// when looping music at tempo (beats per minute). Both points lie within
+// margin samples of neither end (skipping fades), near bar boundaries, at
+// rising zero-crossings chosen so the jump from end-1 back to start is as
+// small as possible. Returns the whole of data if less than one bar fits.
+func FindLoopPoints(data []float64, sampleRate int, tempo float64, margin int) (start, end int) {
+	barSamples := int(60.0/tempo*float64(sampleRate)) * beatsPerBar
+	if barSamples <= 0 || len(data)-2*margin < barSamples {
+		return 0, len(data)
+	}
+
+	startBar := (margin + barSamples - 1) / barSamples * barSamples
+	endBar := (len(data) - margin) / barSamples * barSamples
+	if endBar-startBar < barSamples {
+		return 0, len(data)
+	}
+
+	// Search a 32nd of a bar around each boundary
+	window := barSamples / 32
+
+	start = startBar
+	best := math.Inf(1)
+	for _, i := range risingZeroCrossings(data, startBar, window) {
+		if cost := math.Abs(data[i-1]) + math.Abs(data[i]); cost < best {
+			start, best = i, cost
+		}
+	}
+
+	end = endBar
+	best = math.Inf(1)
+	for _, i := range risingZeroCrossings(data, endBar, window) {
+		if seam := math.Abs(data[i-1] - data[start]); seam < best {
+			end, best = i, seam
+		}
+	}
+	return start, end
+}
+
+// risingZeroCrossings returns the indices i within window samples of center
+// where data rises through zero between i-1 and i.
+func risingZeroCrossings(data []float64, center, window int) []int {
+	var crossings []int
+	for i := max(center-window, 1); i <= min(center+window, len(data)-1); i++ {
+		if data[i-1] <= 0 && data[i] >= 0 {
+			crossings = append(crossings, i)
+		}
+	}
+	return crossings
+}
@@ -0,0 +1,61 @@
+package music
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFindLoopPoints(t *testing.T) {
+	const sampleRate, tempo = 8000, 120.0
+	barSamples := int(60.0/tempo*sampleRate) * beatsPerBar
+
+	// A 5 Hz sine lasting 6 bars, with a 1000-sample margin
+	data := make([]float64, barSamples*6)
+	for i := range data {
+		data[i] = math.Sin(2*math.Pi*5*float64(i)/sampleRate + 0.3)
+	}
+	start, end := FindLoopPoints(data, sampleRate, tempo, 1000)
+
+	window := barSamples / 32
+	if start < 1000 || end > len(data)-1000 || start >= end {
+		t.Fatalf("loop points (%d, %d) outside margins", start, end)
+	}
+	if d := start % barSamples; d > window && barSamples-d > window {
+		t.Errorf("start %d is not near a bar boundary", start)
+	}
+	if d := end % barSamples; d > window && barSamples-d > window {
+		t.Errorf("end %d is not near a bar boundary", end)
+	}
+	if data[start-1] > 0 || data[start] < 0 || data[end-1] > 0 || data[end] < 0 {
+		t.Errorf("loop points (%d, %d) are not rising zero-crossings", start, end)
+	}
+
+	// Too short for a bar between the margins
+	if start, end := FindLoopPoints(data[:barSamples], sampleRate, tempo, 1000); start != 0 || end != barSamples {
+		t.Errorf("short track loop points = (%d, %d), want whole track", start, end)
+	}
+}
+
+func TestGenerateTrackLoopSeam(t *testing.T) {
+	gen := NewGenerator(22050, 12345)
+
+	for _, genre := range []string{"fantasy", "scifi", "horror"} {
+		for _, context := range []string{"exploration", "combat", "ambient"} {
+			track := gen.GenerateTrack(genre, context, 12345, 20)
+			start, end := track.LoopBounds()
+			if start == 0 || end == len(track.Data) {
+				t.Errorf("%s %s: loop (%d, %d) should skip the fades", genre, context, start, end)
+			}
+
+			// Jumping from the loop end back to its start is continuous
+			if seam := math.Abs(track.Data[start] - track.Data[end-1]); seam > 0.01 {
+				t.Errorf("%s %s: loop seam jump %f", genre, context, seam)
+			}
+
+			again := NewGenerator(22050, 1).GenerateTrack(genre, context, 12345, 20)
+			if again.LoopStart != track.LoopStart || again.LoopEnd != track.LoopEnd {
+				t.Errorf("%s %s: loop points not deterministic", genre, context)
+			}
+		}
+	}
+}
@@ -1,4 +1,8 @@
 // Package sfx provides procedural sound effect generation.
 // It creates various game sound effects (impacts, explosions, magic, etc.)
 // using waveform synthesis and audio processing techniques.
+//
+// GenerateVariations produces a deterministic set of subtly different
+// instances of one effect (pitch, filter, volume and fade jitter) so
+// frequently repeated sounds don't fatigue the player.
 package sfx
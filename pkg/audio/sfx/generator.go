@@ -26,6 +26,8 @@ const (
 	EffectJump      EffectType = "jump"
 	EffectDeath     EffectType = "death"
 	EffectPowerup   EffectType = "powerup"
+	EffectBurn      EffectType = "burn"
+	EffectShatter   EffectType = "shatter"
 )
 
 // Generator creates procedural sound effects.
@@ -99,6 +101,10 @@ func (g *Generator) GenerateWithGenre(effectType string, seed int64, genre strin
 		sample = g.generateDeath(localRng)
 	case EffectPowerup:
 		sample = g.generatePowerup(localRng)
+	case EffectBurn:
+		sample = g.generateBurn(localRng)
+	case EffectShatter:
+		sample = g.generateShatter(localRng)
 	default:
 		sample = g.generateImpact(localRng)
 	}
@@ -195,6 +201,9 @@ func (g *Generator) generateExplosion(rng *rand.Rand) *audio.AudioSample {
 	}
 	env.Apply(sample.Data, sample.SampleRate)
 
+	// Muffle the noise so the blast sounds deep rather than hissy
+	synthesis.LowPass(sample.Data, sample.SampleRate, 1200, synthesis.DefaultResonance)
+
 	// Add low-frequency rumble
 	rumble := g.osc.Generate(audio.WaveformSine, 40.0, duration)
 	g.mix(sample.Data, rumble.Data, 0.5)
@@ -367,6 +376,66 @@ func (g *Generator) generatePowerup(rng *rand.Rand) *audio.AudioSample {
 	return sample
 }
 
+// generateBurn creates a crackling fire sound.
+func (g *Generator) generateBurn(rng *rand.Rand) *audio.AudioSample {
+	duration := 0.6 + rng.Float64()*0.2
+
+	sample := g.osc.Generate(audio.WaveformNoise, 0, duration)
+
+	env := synthesis.Envelope{
+		Attack:  0.05,
+		Decay:   0.2,
+		Sustain: 0.4,
+		Release: 0.3,
+	}
+	env.Apply(sample.Data, sample.SampleRate)
+
+	// Random crackle pops over the hiss
+	popLength := sample.SampleRate / 200
+	for pops := 8 + rng.Intn(8); pops > 0; pops-- {
+		start := rng.Intn(len(sample.Data) - popLength)
+		for i := 0; i < popLength; i++ {
+			sample.Data[start+i] *= 2.0 * (1.0 - float64(i)/float64(popLength))
+		}
+	}
+
+	// Low roar underneath
+	roar := g.osc.Generate(audio.WaveformSine, 60.0+rng.Float64()*20.0, duration)
+	g.mix(sample.Data, roar.Data, 0.3)
+
+	return sample
+}
+
+// generateShatter creates a breaking glass/ice sound.
+func (g *Generator) generateShatter(rng *rand.Rand) *audio.AudioSample {
+	duration := 0.4 + rng.Float64()*0.1
+
+	sample := g.osc.Generate(audio.WaveformNoise, 0, duration)
+
+	env := synthesis.Envelope{
+		Attack:  0.001,
+		Decay:   0.05,
+		Sustain: 0.2,
+		Release: 0.2,
+	}
+	env.Apply(sample.Data, sample.SampleRate)
+
+	// High tinkling shards at inharmonic frequencies
+	for i := 0; i < 3; i++ {
+		shard := g.osc.Generate(audio.WaveformSine, 2000.0+rng.Float64()*2000.0, duration)
+		shardEnv := synthesis.Envelope{
+			Attack:  0.001,
+			Decay:   0.1,
+			Sustain: 0.1,
+			Release: 0.2,
+		}
+		shardEnv.Apply(shard.Data, shard.SampleRate)
+		g.mix(sample.Data, shard.Data, 0.25)
+	}
+
+	return sample
+}
+
 // applyPitchBend applies a pitch bend effect to the sample.
 func (g *Generator) applyPitchBend(data []float64, startRatio, endRatio float64) {
 	// Create a copy to read from while we modify
@@ -20,6 +20,8 @@ func TestGenerator_Generate(t *testing.T) {
 		{"jump", string(EffectJump), 12345, 8820},
 		{"death", string(EffectDeath), 12345, 35280},
 		{"powerup", string(EffectPowerup), 12345, 17640},
+		{"burn", string(EffectBurn), 12345, 26460},
+		{"shatter", string(EffectShatter), 12345, 17640},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,106 @@
+// Package sfx provides sound effect variations.
+// This file generates sets of subtly different instances of one sound
+// effect, so frequently repeated sounds such as hits can cycle through them
+// instead of playing the same buffer every time.
+package sfx
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/audio"
+	"github.com/opd-ai/venture/pkg/audio/synthesis"
+)
+
+// DefaultVariation is a variation amount that keeps instances recognizably
+// the same sound.
+const DefaultVariation = 0.3
+
+// Jitter applied at full variation (1.0).
+const (
+	maxPitchJitter = 0.08 // ±8% playback speed
+	maxGainJitter  = 0.15 // ±15% volume
+	maxFadeJitter  = 0.25 // Up to a quarter of the sound faded out early
+	minCutoffRatio = 0.15 // Low-pass cutoff down to 15% of Nyquist
+)
+
+// SFXConfig describes the base sound a set of variations is generated from.
+type SFXConfig struct {
+	Effect     EffectType
+	Genre      string // Genre modifications to apply ("" for none)
+	SampleRate int
+
+	// Variation scales how different instances are, from 0.0 (identical
+	// copies) to 1.0. See DefaultVariation.
+	Variation float64
+}
+
+// GenerateVariations generates count variations of the configured sound.
+// Every instance starts from the same base sound and gets its own pitch,
+// low-pass filter, volume and fade-out jitter. The result is deterministic
+// for a config and seed, so every client hears the same set.
+func GenerateVariations(config SFXConfig, count int, seed int64) ([]*audio.AudioSample, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("variation count must be positive, got %d", count)
+	}
+	if config.SampleRate <= 0 {
+		return nil, fmt.Errorf("sample rate must be positive, got %d", config.SampleRate)
+	}
+	if config.Variation < 0 || config.Variation > 1 {
+		return nil, fmt.Errorf("variation must be between 0 and 1, got %f", config.Variation)
+	}
+	if !isKnownEffect(config.Effect) {
+		return nil, fmt.Errorf("unknown effect type: %s", config.Effect)
+	}
+
+	gen := NewGenerator(config.SampleRate, seed)
+	base := gen.GenerateWithGenre(string(config.Effect), seed, config.Genre)
+
+	rng := rand.New(rand.NewSource(seed))
+	variations := make([]*audio.AudioSample, count)
+	for i := range variations {
+		data := make([]float64, len(base.Data))
+		copy(data, base.Data)
+		gen.applyVariation(data, config.Variation, rng)
+		variations[i] = &audio.AudioSample{SampleRate: base.SampleRate, Data: data}
+	}
+	return variations, nil
+}
+
+// applyVariation jitters the pitch, brightness, volume and length of a sound
+// in place, scaled by amount (0.0-1.0).
+func (g *Generator) applyVariation(data []float64, amount float64, rng *rand.Rand) {
+	// Draw every jitter up front so each instance uses the same number of
+	// random values, whatever the amount
+	pitch := 1 + amount*maxPitchJitter*(rng.Float64()*2-1)
+	cutoff := 1 - amount*(1-minCutoffRatio)*rng.Float64()
+	gain := 1 + amount*maxGainJitter*(rng.Float64()*2-1)
+	fade := amount * maxFadeJitter * rng.Float64()
+	if amount == 0 {
+		return
+	}
+
+	g.applyPitchBend(data, pitch, pitch)
+	synthesis.LowPass(data, g.sampleRate, cutoff*float64(g.sampleRate)/2, synthesis.DefaultResonance)
+
+	fadeStart := len(data) - int(fade*float64(len(data)))
+	for i := range data {
+		data[i] *= gain
+		if i >= fadeStart {
+			data[i] *= float64(len(data)-i) / float64(len(data)-fadeStart)
+		}
+		data[i] = math.Max(-1, math.Min(1, data[i]))
+	}
+}
+
+// isKnownEffect reports whether effect is one of the generated effect types.
+func isKnownEffect(effect EffectType) bool {
+	switch effect {
+	case EffectImpact, EffectExplosion, EffectMagic, EffectLaser, EffectPickup,
+		EffectHit, EffectJump, EffectDeath, EffectPowerup, EffectBurn, EffectShatter:
+		return true
+	default:
+		return false
+	}
+}
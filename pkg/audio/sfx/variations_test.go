@@ -0,0 +1,88 @@
+package sfx
+
+import (
+	"math"
+	"testing"
+)
+
+// difference returns the mean absolute difference between two buffers.
+func difference(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += math.Abs(a[i] - b[i])
+	}
+	return sum / float64(len(a))
+}
+
+func TestGenerateVariations(t *testing.T) {
+	config := SFXConfig{Effect: EffectHit, SampleRate: 22050, Variation: DefaultVariation}
+
+	variations, err := GenerateVariations(config, 4, 42)
+	if err != nil {
+		t.Fatalf("GenerateVariations() error = %v", err)
+	}
+	if len(variations) != 4 {
+		t.Fatalf("got %d variations, want 4", len(variations))
+	}
+
+	again, _ := GenerateVariations(config, 4, 42)
+	for i := range variations {
+		if variations[i].SampleRate != 22050 || len(variations[i].Data) == 0 {
+			t.Errorf("variation %d: rate %d, %d samples", i, variations[i].SampleRate, len(variations[i].Data))
+		}
+		if difference(variations[i].Data, again[i].Data) != 0 {
+			t.Errorf("variation %d not deterministic", i)
+		}
+		for j := range variations[i].Data {
+			if v := variations[i].Data[j]; v < -1 || v > 1 {
+				t.Fatalf("variation %d sample %d = %f out of range", i, j, v)
+			}
+		}
+	}
+
+	if difference(variations[0].Data, variations[1].Data) == 0 {
+		t.Error("variations should differ from each other")
+	}
+}
+
+func TestGenerateVariationsAmount(t *testing.T) {
+	spread := func(amount float64) float64 {
+		config := SFXConfig{Effect: EffectImpact, Genre: "scifi", SampleRate: 22050, Variation: amount}
+		variations, err := GenerateVariations(config, 6, 7)
+		if err != nil {
+			t.Fatalf("GenerateVariations() error = %v", err)
+		}
+		var total float64
+		for i := 1; i < len(variations); i++ {
+			total += difference(variations[0].Data, variations[i].Data)
+		}
+		return total
+	}
+
+	if none := spread(0); none != 0 {
+		t.Errorf("zero variation should produce identical copies, spread = %f", none)
+	}
+	if low, high := spread(0.1), spread(1.0); low >= high {
+		t.Errorf("spread at 0.1 (%f) should be below spread at 1.0 (%f)", low, high)
+	}
+}
+
+func TestGenerateVariationsInvalid(t *testing.T) {
+	valid := SFXConfig{Effect: EffectHit, SampleRate: 22050, Variation: 0.5}
+
+	tests := []struct {
+		name   string
+		config SFXConfig
+		count  int
+	}{
+		{"zero count", valid, 0},
+		{"zero sample rate", SFXConfig{Effect: EffectHit, Variation: 0.5}, 3},
+		{"variation above 1", SFXConfig{Effect: EffectHit, SampleRate: 22050, Variation: 1.5}, 3},
+		{"unknown effect", SFXConfig{Effect: "kazoo", SampleRate: 22050}, 3},
+	}
+	for _, tt := range tests {
+		if _, err := GenerateVariations(tt.config, tt.count, 1); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
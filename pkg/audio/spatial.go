@@ -0,0 +1,41 @@
+// Package audio provides positional audio helpers.
+// This file computes stereo pan and distance attenuation of a sound source
+// relative to a listener, so sounds can be heard from where they occur.
+package audio
+
+import "math"
+
+// SpatialAudio is how a sound source is heard by a listener.
+type SpatialAudio struct {
+	// Pan from -1 (fully left) to 1 (fully right)
+	Pan float64
+
+	// Gain from 0 (inaudible) to 1 (at the listener)
+	Gain float64
+}
+
+// ChannelGains returns the left and right channel gains for an
+// equal-power pan, scaled by Gain.
+func (s SpatialAudio) ChannelGains() (left, right float64) {
+	angle := (s.Pan + 1) * math.Pi / 4
+	return s.Gain * math.Cos(angle), s.Gain * math.Sin(angle)
+}
+
+// Spatialize returns how a source at (sourceX, sourceY) is heard from a
+// listener at (listenerX, listenerY). The source pans fully to one side at
+// panWidth horizontal distance, and its gain falls linearly to 0 at
+// maxDistance and stays there beyond it. A non-positive panWidth leaves the
+// sound centered; a non-positive maxDistance makes it inaudible.
+func Spatialize(sourceX, sourceY, listenerX, listenerY, panWidth, maxDistance float64) SpatialAudio {
+	dx := sourceX - listenerX
+	dy := sourceY - listenerY
+
+	var spatial SpatialAudio
+	if panWidth > 0 {
+		spatial.Pan = math.Max(-1, math.Min(1, dx/panWidth))
+	}
+	if maxDistance > 0 {
+		spatial.Gain = 1 - math.Min(math.Hypot(dx, dy)/maxDistance, 1)
+	}
+	return spatial
+}
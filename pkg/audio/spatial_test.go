@@ -0,0 +1,52 @@
+package audio
+
+import (
+	"math"
+	"testing"
+)
+
+// TestSpatialize verifies pan direction and distance attenuation.
+func TestSpatialize(t *testing.T) {
+	tests := []struct {
+		name              string
+		sourceX, sourceY  float64
+		wantPan, wantGain float64
+	}{
+		{"at the listener", 0, 0, 0, 1},
+		{"right, half pan width", 50, 0, 0.5, 0.75},
+		{"far left", -150, 0, -1, 0.25},
+		{"directly below", 0, 100, 0, 0.5},
+		{"beyond max distance", 300, 0, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Spatialize(tt.sourceX, tt.sourceY, 0, 0, 100, 200)
+			if math.Abs(got.Pan-tt.wantPan) > 1e-9 || math.Abs(got.Gain-tt.wantGain) > 1e-9 {
+				t.Errorf("Spatialize() = %+v, want pan %v gain %v", got, tt.wantPan, tt.wantGain)
+			}
+		})
+	}
+
+	// Only the offset from the listener matters
+	if a, b := Spatialize(10, 20, 0, 0, 100, 200), Spatialize(110, 120, 100, 100, 100, 200); a != b {
+		t.Errorf("moved listener: %+v != %+v", a, b)
+	}
+
+	if got := Spatialize(50, 0, 0, 0, 0, 0); got.Pan != 0 || got.Gain != 0 {
+		t.Errorf("zero ranges: %+v, want centered and silent", got)
+	}
+}
+
+// TestSpatialAudio_ChannelGains verifies equal-power panning.
+func TestSpatialAudio_ChannelGains(t *testing.T) {
+	left, right := SpatialAudio{Pan: 1, Gain: 1}.ChannelGains()
+	if math.Abs(left) > 1e-9 || math.Abs(right-1) > 1e-9 {
+		t.Errorf("full right: left %v right %v", left, right)
+	}
+
+	left, right = SpatialAudio{Pan: 0, Gain: 1}.ChannelGains()
+	if math.Abs(left*left+right*right-1) > 1e-9 || math.Abs(left-right) > 1e-9 {
+		t.Errorf("center: left %v right %v, want equal power", left, right)
+	}
+}
@@ -1,6 +1,11 @@
 // Package synthesis provides low-level audio waveform generation.
 // It implements oscillators for basic waveforms (sine, square, sawtooth, triangle, noise)
-// with ADSR envelopes for shaping sound over time.
+// with ADSR envelopes for shaping sound over time. Named envelope presets
+// (EnvelopePluck, EnvelopePad, EnvelopeStab, EnvelopeOrgan, ...) cover common
+// shapes, and Envelope.Scale fits them to a note length. Biquad filters
+// (LowPass, HighPass, BandPass) shape the spectrum of generated samples in
+// place. FMOscillator adds two-operator frequency modulation for bell,
+// metallic and alien timbres.
 //
 // All waveform generation is deterministic when using seeded random number generators,
 // ensuring consistent audio generation across network sessions.
@@ -0,0 +1,107 @@
+// Package synthesis provides biquad filters.
+// This file implements second-order IIR (biquad) low-pass, high-pass and
+// band-pass filters for shaping the spectrum of generated samples.
+package synthesis
+
+import "math"
+
+// FilterType selects the response of a biquad filter.
+type FilterType int
+
+const (
+	// FilterLowPass passes frequencies below the cutoff
+	FilterLowPass FilterType = iota
+	// FilterHighPass passes frequencies above the cutoff
+	FilterHighPass
+	// FilterBandPass passes frequencies around the cutoff
+	FilterBandPass
+)
+
+// DefaultResonance is the Q of a filter with a flat (Butterworth) response.
+const DefaultResonance = 0.7071
+
+// Filter is a biquad filter. It keeps the last two input and output samples,
+// so consecutive Process calls filter a continuous stream. Each Filter
+// holds its own state.
+type Filter struct {
+	Type      FilterType
+	Cutoff    float64 // Cutoff (or center) frequency in Hz
+	Resonance float64 // Q; higher values peak more sharply at the cutoff
+
+	// Normalized coefficients
+	b0, b1, b2, a1, a2 float64
+
+	// Previous inputs and outputs
+	x1, x2, y1, y2 float64
+}
+
+// NewFilter creates a biquad filter for the given sample rate. The cutoff is
+// clamped below the Nyquist frequency and a non-positive resonance uses
+// DefaultResonance.
+func NewFilter(filterType FilterType, cutoff, resonance float64, sampleRate int) *Filter {
+	if resonance <= 0 {
+		resonance = DefaultResonance
+	}
+	nyquist := float64(sampleRate) / 2
+	cutoff = math.Max(1, math.Min(cutoff, nyquist*0.99))
+
+	f := &Filter{Type: filterType, Cutoff: cutoff, Resonance: resonance}
+
+	// Coefficients from the RBJ audio EQ cookbook
+	w0 := 2 * math.Pi * cutoff / float64(sampleRate)
+	cosW0 := math.Cos(w0)
+	alpha := math.Sin(w0) / (2 * resonance)
+
+	var b0, b1, b2 float64
+	switch filterType {
+	case FilterHighPass:
+		b0 = (1 + cosW0) / 2
+		b1 = -(1 + cosW0)
+		b2 = (1 + cosW0) / 2
+	case FilterBandPass:
+		// Constant 0 dB peak gain
+		b0 = alpha
+		b1 = 0
+		b2 = -alpha
+	default:
+		b0 = (1 - cosW0) / 2
+		b1 = 1 - cosW0
+		b2 = (1 - cosW0) / 2
+	}
+	a0 := 1 + alpha
+
+	f.b0, f.b1, f.b2 = b0/a0, b1/a0, b2/a0
+	f.a1 = -2 * cosW0 / a0
+	f.a2 = (1 - alpha) / a0
+	return f
+}
+
+// Process filters data in place.
+func (f *Filter) Process(data []float64) {
+	for i, x := range data {
+		y := f.b0*x + f.b1*f.x1 + f.b2*f.x2 - f.a1*f.y1 - f.a2*f.y2
+		f.x2, f.x1 = f.x1, x
+		f.y2, f.y1 = f.y1, y
+		data[i] = y
+	}
+}
+
+// Reset clears the filter's state so the next Process starts from silence.
+func (f *Filter) Reset() {
+	f.x1, f.x2, f.y1, f.y2 = 0, 0, 0, 0
+}
+
+// LowPass attenuates frequencies above cutoff in data, in place.
+func LowPass(data []float64, sampleRate int, cutoff, resonance float64) {
+	NewFilter(FilterLowPass, cutoff, resonance, sampleRate).Process(data)
+}
+
+// HighPass attenuates frequencies below cutoff in data, in place.
+func HighPass(data []float64, sampleRate int, cutoff, resonance float64) {
+	NewFilter(FilterHighPass, cutoff, resonance, sampleRate).Process(data)
+}
+
+// BandPass attenuates frequencies away from center in data, in place.
+func BandPass(data []float64, sampleRate int, center, resonance float64) {
+	NewFilter(FilterBandPass, center, resonance, sampleRate).Process(data)
+}
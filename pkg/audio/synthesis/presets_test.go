@@ -0,0 +1,68 @@
+package synthesis
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEnvelopePresets(t *testing.T) {
+	names := EnvelopePresetNames()
+	if len(names) != len(envelopePresets) {
+		t.Fatalf("EnvelopePresetNames() returned %d names, want %d", len(names), len(envelopePresets))
+	}
+
+	for _, name := range names {
+		env, ok := EnvelopePreset(name)
+		if !ok {
+			t.Fatalf("EnvelopePreset(%q) not found", name)
+		}
+		if env.Attack < 0 || env.Decay < 0 || env.Release < 0 || env.Sustain < 0 || env.Sustain > 1 {
+			t.Errorf("%s has invalid values %+v", name, env)
+		}
+		if total := env.Attack + env.Decay + env.Release; total > EnvelopeReferenceDuration {
+			t.Errorf("%s phases last %.3fs, longer than the reference note", name, total)
+		}
+		if again, _ := EnvelopePreset(name); again != env {
+			t.Errorf("%s not deterministic", name)
+		}
+	}
+
+	if _, ok := EnvelopePreset("kazoo"); ok {
+		t.Error("unknown preset should not be found")
+	}
+}
+
+func TestEnvelopePresetShapes(t *testing.T) {
+	if pluck, pad := EnvelopePluck(), EnvelopePad(); pluck.Attack >= pad.Attack || pluck.Sustain >= pad.Sustain {
+		t.Errorf("pluck %+v should attack faster and sustain less than pad %+v", pluck, pad)
+	}
+	if organ := EnvelopeOrgan(); organ.Sustain != 1.0 {
+		t.Errorf("organ sustain = %f, want 1.0", organ.Sustain)
+	}
+}
+
+func TestEnvelopeScale(t *testing.T) {
+	pad := EnvelopePad()
+
+	half := pad.Scale(0.5)
+	if math.Abs(half.Attack-pad.Attack/2) > 1e-9 || math.Abs(half.Decay-pad.Decay/2) > 1e-9 ||
+		math.Abs(half.Release-pad.Release/2) > 1e-9 || half.Sustain != pad.Sustain {
+		t.Errorf("Scale(0.5) = %+v, want half of %+v", half, pad)
+	}
+
+	if got := pad.Scale(0); got != pad {
+		t.Errorf("Scale(0) = %+v, want unchanged", got)
+	}
+
+	// A scaled envelope shapes a note of that length the way the preset
+	// shapes a reference-length note
+	data := make([]float64, 22050)
+	for i := range data {
+		data[i] = 1
+	}
+	half.Apply(data, 44100)
+	sustainStart := int((half.Attack + half.Decay) * 44100)
+	if data[0] != 0 || data[sustainStart+100] != pad.Sustain || data[len(data)-1] > 0.01 {
+		t.Errorf("scaled pad envelope: start %f, sustain %f, end %f", data[0], data[sustainStart+100], data[len(data)-1])
+	}
+}
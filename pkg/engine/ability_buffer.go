@@ -0,0 +1,107 @@
+// Package engine provides ability input buffering.
+// This file implements AbilityBufferComponent, which remembers an ability
+// pressed shortly before its cooldown ends so the player combat and spell
+// casting systems can fire it as soon as it is ready instead of dropping
+// the input. Abilities use the names from AbilityCooldown ("attack",
+// "spell_1" .. "spell_5").
+//
+// Buffering depends only on cooldown values and input, never on wall-clock
+// time, so replaying the same inputs reproduces the same casts.
+package engine
+
+// DefaultAbilityBufferWindow is how long in seconds before an ability is
+// ready a press of it is buffered, unless overridden per ability.
+const DefaultAbilityBufferWindow = 0.2
+
+// AbilityBufferComponent queues one ability pressed within its buffer
+// window before its cooldown ends. A later buffered press replaces an
+// earlier one.
+type AbilityBufferComponent struct {
+	// DefaultWindow is the buffer window in seconds for abilities without
+	// their own entry in Windows (0 = no buffering)
+	DefaultWindow float64
+
+	// Windows overrides the buffer window per ability name
+	Windows map[string]float64
+
+	// Queued is the name of the buffered ability, or empty if none
+	Queued string
+}
+
+// Type returns the component type identifier.
+func (b *AbilityBufferComponent) Type() string {
+	return "ability_buffer"
+}
+
+// NewAbilityBufferComponent creates an ability buffer using defaultWindow
+// seconds for every ability.
+func NewAbilityBufferComponent(defaultWindow float64) *AbilityBufferComponent {
+	if defaultWindow < 0 {
+		defaultWindow = 0
+	}
+	return &AbilityBufferComponent{
+		DefaultWindow: defaultWindow,
+		Windows:       make(map[string]float64),
+	}
+}
+
+// SetWindow sets the buffer window in seconds for one ability. A window of
+// 0 disables buffering for it.
+func (b *AbilityBufferComponent) SetWindow(ability string, seconds float64) {
+	if seconds < 0 {
+		seconds = 0
+	}
+	if b.Windows == nil {
+		b.Windows = make(map[string]float64)
+	}
+	b.Windows[ability] = seconds
+}
+
+// Window returns the buffer window in seconds for an ability.
+func (b *AbilityBufferComponent) Window(ability string) float64 {
+	if window, ok := b.Windows[ability]; ok {
+		return window
+	}
+	return b.DefaultWindow
+}
+
+// Buffer queues a press of ability whose cooldown has remaining seconds
+// left. Returns true if the press was queued, which it is only within the
+// ability's window; presses earlier than that are dropped as before.
+func (b *AbilityBufferComponent) Buffer(ability string, remaining float64) bool {
+	window := b.Window(ability)
+	if window <= 0 || remaining > window {
+		return false
+	}
+	b.Queued = ability
+	return true
+}
+
+// IsQueued returns true if ability is waiting to fire.
+func (b *AbilityBufferComponent) IsQueued(ability string) bool {
+	return b.Queued != "" && b.Queued == ability
+}
+
+// Take consumes the queued press of ability, returning true if there was
+// one.
+func (b *AbilityBufferComponent) Take(ability string) bool {
+	if !b.IsQueued(ability) {
+		return false
+	}
+	b.Queued = ""
+	return true
+}
+
+// Clear drops any queued press.
+func (b *AbilityBufferComponent) Clear() {
+	b.Queued = ""
+}
+
+// getAbilityBuffer returns the entity's ability buffer, or nil if input
+// buffering is not enabled for it.
+func getAbilityBuffer(entity *Entity) *AbilityBufferComponent {
+	if comp, ok := entity.GetComponent("ability_buffer"); ok {
+		return comp.(*AbilityBufferComponent)
+	}
+	return nil
+}
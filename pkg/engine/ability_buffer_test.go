@@ -0,0 +1,108 @@
+package engine
+
+import "testing"
+
+func TestAbilityBufferComponent_Window(t *testing.T) {
+	buffer := NewAbilityBufferComponent(DefaultAbilityBufferWindow)
+	if buffer.Type() != "ability_buffer" {
+		t.Errorf("Type() = %q, want ability_buffer", buffer.Type())
+	}
+	if got := buffer.Window("attack"); got != DefaultAbilityBufferWindow {
+		t.Errorf("default window = %.2f, want %.2f", got, DefaultAbilityBufferWindow)
+	}
+
+	buffer.SetWindow("spell_1", 0.5)
+	buffer.SetWindow("spell_2", -1)
+	if got := buffer.Window("spell_1"); got != 0.5 {
+		t.Errorf("spell_1 window = %.2f, want 0.5", got)
+	}
+	if got := buffer.Window("spell_2"); got != 0 {
+		t.Errorf("negative window = %.2f, want clamped to 0", got)
+	}
+
+	if clamped := NewAbilityBufferComponent(-1); clamped.DefaultWindow != 0 {
+		t.Errorf("negative default = %.2f, want 0", clamped.DefaultWindow)
+	}
+}
+
+func TestAbilityBufferComponent_Buffer(t *testing.T) {
+	tests := []struct {
+		name      string
+		ability   string
+		remaining float64
+		want      bool
+	}{
+		{"inside default window", "attack", 0.1, true},
+		{"at window edge", "attack", 0.2, true},
+		{"too early", "attack", 0.3, false},
+		{"per-ability window", "spell_1", 0.4, true},
+		{"disabled ability", "spell_2", 0.01, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := NewAbilityBufferComponent(0.2)
+			buffer.SetWindow("spell_1", 0.5)
+			buffer.SetWindow("spell_2", 0)
+
+			if got := buffer.Buffer(tt.ability, tt.remaining); got != tt.want {
+				t.Errorf("Buffer(%q, %.2f) = %v, want %v", tt.ability, tt.remaining, got, tt.want)
+			}
+			if buffer.IsQueued(tt.ability) != tt.want {
+				t.Errorf("IsQueued(%q) = %v, want %v", tt.ability, !tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestAbilityBufferComponent_TakeAndReplace(t *testing.T) {
+	buffer := NewAbilityBufferComponent(0.2)
+	buffer.Buffer("attack", 0.1)
+	buffer.Buffer("spell_3", 0.1)
+
+	// The latest press wins
+	if buffer.IsQueued("attack") || !buffer.IsQueued("spell_3") {
+		t.Fatalf("queued = %q, want spell_3", buffer.Queued)
+	}
+	if buffer.Take("attack") {
+		t.Error("Take should fail for an ability that is not queued")
+	}
+	if !buffer.Take("spell_3") || buffer.Queued != "" {
+		t.Error("Take should consume the queued ability")
+	}
+	if buffer.Take("spell_3") {
+		t.Error("a queued press fires only once")
+	}
+
+	buffer.Buffer("attack", 0.1)
+	buffer.Clear()
+	if buffer.IsQueued("attack") {
+		t.Error("Clear should drop the queued press")
+	}
+}
+
+func TestFindBufferedSpellSlot(t *testing.T) {
+	buffer := NewAbilityBufferComponent(0.2)
+	if got := findBufferedSpellSlot(buffer, 5); got != -1 {
+		t.Errorf("empty buffer slot = %d, want -1", got)
+	}
+	buffer.Buffer("spell_4", 0.1)
+	if got := findBufferedSpellSlot(buffer, 5); got != 3 {
+		t.Errorf("spell_4 slot = %d, want 3", got)
+	}
+	buffer.Buffer("attack", 0.1)
+	if got := findBufferedSpellSlot(buffer, 5); got != -1 {
+		t.Errorf("attack slot = %d, want -1", got)
+	}
+}
+
+func TestGetAbilityBuffer(t *testing.T) {
+	entity := NewEntity(1)
+	if getAbilityBuffer(entity) != nil {
+		t.Error("entity without a buffer should return nil")
+	}
+	buffer := NewAbilityBufferComponent(0.2)
+	entity.AddComponent(buffer)
+	if getAbilityBuffer(entity) != buffer {
+		t.Error("expected the entity's buffer")
+	}
+}
@@ -0,0 +1,189 @@
+// Package engine provides unified ability cooldown queries.
+// This file exposes cooldown state from attacks, spell slots, and hotbar
+// slots through a single name-based API so the HUD can render radial
+// cooldown sweeps the same way for every ability type.
+//
+// Ability names:
+//   - "attack": the entity's basic attack (AttackComponent)
+//   - "spell_1" .. "spell_5": spell slots (SpellSlotComponent), or the spell's name
+//   - "hotbar_1" .. "hotbar_6": item quickslots (HotbarComponent)
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AbilityCooldownState describes one ability's cooldown for HUD rendering.
+type AbilityCooldownState struct {
+	// Name identifies the ability (see AbilityCooldown for the naming scheme)
+	Name string
+
+	// Remaining is the cooldown time left in seconds (0 = ready)
+	Remaining float64
+
+	// Total is the full cooldown duration in seconds
+	Total float64
+}
+
+// Progress returns the fraction of cooldown remaining (1.0 = just used, 0.0 = ready).
+// This maps directly to the size of a radial sweep overlay.
+func (s AbilityCooldownState) Progress() float64 {
+	if s.Total <= 0 {
+		return 0
+	}
+	return s.Remaining / s.Total
+}
+
+// IsReady returns true if the ability can be used.
+func (s AbilityCooldownState) IsReady() bool {
+	return s.Remaining <= 0
+}
+
+// AbilityCooldown returns the remaining and total cooldown in seconds for a
+// named ability. A ready ability returns remaining 0; unknown abilities
+// return (0, 0). Remaining is always clamped to [0, total].
+func (e *Entity) AbilityCooldown(name string) (remaining, total float64) {
+	switch {
+	case name == "attack":
+		if attack := e.GetAttack(); attack != nil {
+			return clampCooldown(attack.CooldownTimer, attack.Cooldown)
+		}
+	case strings.HasPrefix(name, "hotbar_"):
+		if slot, ok := parseAbilitySlot(name, "hotbar_", 6); ok {
+			if comp, has := e.GetComponent("hotbar"); has {
+				hotbar := comp.(*HotbarComponent)
+				return clampCooldown(hotbar.Cooldowns[slot], hotbar.MaxCooldowns[slot])
+			}
+		}
+	default:
+		comp, has := e.GetComponent("spell_slots")
+		if !has {
+			break
+		}
+		slots := comp.(*SpellSlotComponent)
+		slot, ok := parseAbilitySlot(name, "spell_", len(slots.Slots))
+		if !ok {
+			slot = findSpellSlotByName(slots, name)
+		}
+		if slot >= 0 {
+			var spellTotal float64
+			if spell := slots.Slots[slot]; spell != nil {
+				spellTotal = spell.Stats.Cooldown
+			}
+			return clampCooldown(slots.Cooldowns[slot], spellTotal)
+		}
+	}
+	return 0, 0
+}
+
+// SetAbilityCooldown sets the remaining cooldown of a named ability, e.g.
+// when loading a save. Attack and spell totals come from the weapon and
+// spell; total only replaces a hotbar slot's maximum. Returns false if the
+// entity has no such ability.
+func (e *Entity) SetAbilityCooldown(name string, remaining, total float64) bool {
+	if remaining < 0 {
+		remaining = 0
+	}
+	switch {
+	case name == "attack":
+		if attack := e.GetAttack(); attack != nil {
+			attack.CooldownTimer = remaining
+			return true
+		}
+	case strings.HasPrefix(name, "hotbar_"):
+		if slot, ok := parseAbilitySlot(name, "hotbar_", 6); ok {
+			if comp, has := e.GetComponent("hotbar"); has {
+				hotbar := comp.(*HotbarComponent)
+				hotbar.Cooldowns[slot] = remaining
+				if total > 0 {
+					hotbar.MaxCooldowns[slot] = total
+				}
+				return true
+			}
+		}
+	default:
+		comp, has := e.GetComponent("spell_slots")
+		if !has {
+			break
+		}
+		slots := comp.(*SpellSlotComponent)
+		slot, ok := parseAbilitySlot(name, "spell_", len(slots.Slots))
+		if !ok {
+			slot = findSpellSlotByName(slots, name)
+		}
+		if slot >= 0 {
+			slots.Cooldowns[slot] = remaining
+			return true
+		}
+	}
+	return false
+}
+
+// AbilityCooldowns returns the cooldown state of every ability the entity
+// has, in a stable order: attack, spell slots, then hotbar slots.
+// Empty spell and hotbar slots are omitted.
+func (e *Entity) AbilityCooldowns() []AbilityCooldownState {
+	var states []AbilityCooldownState
+	add := func(name string) {
+		remaining, total := e.AbilityCooldown(name)
+		states = append(states, AbilityCooldownState{Name: name, Remaining: remaining, Total: total})
+	}
+
+	if e.GetAttack() != nil {
+		add("attack")
+	}
+	if comp, has := e.GetComponent("spell_slots"); has {
+		slots := comp.(*SpellSlotComponent)
+		for i, spell := range slots.Slots {
+			if spell != nil {
+				add(fmt.Sprintf("spell_%d", i+1))
+			}
+		}
+	}
+	if comp, has := e.GetComponent("hotbar"); has {
+		hotbar := comp.(*HotbarComponent)
+		for i, itm := range hotbar.Slots {
+			if itm != nil {
+				add(fmt.Sprintf("hotbar_%d", i+1))
+			}
+		}
+	}
+	return states
+}
+
+// parseAbilitySlot parses a 1-based slot name like "spell_3" into a 0-based index.
+func parseAbilitySlot(name, prefix string, count int) (int, bool) {
+	if !strings.HasPrefix(name, prefix) {
+		return -1, false
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(name, prefix))
+	if err != nil || n < 1 || n > count {
+		return -1, false
+	}
+	return n - 1, true
+}
+
+// findSpellSlotByName returns the slot holding a spell with the given name, or -1.
+func findSpellSlotByName(slots *SpellSlotComponent, name string) int {
+	for i, spell := range slots.Slots {
+		if spell != nil && spell.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// clampCooldown normalizes a cooldown pair so 0 <= remaining <= total.
+// If remaining exceeds total (e.g. the slot contents changed mid-cooldown),
+// total is raised to remaining so the sweep never overflows.
+func clampCooldown(remaining, total float64) (float64, float64) {
+	if remaining < 0 {
+		remaining = 0
+	}
+	if total < remaining {
+		total = remaining
+	}
+	return remaining, total
+}
@@ -0,0 +1,116 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/item"
+	"github.com/opd-ai/venture/pkg/procgen/magic"
+)
+
+func newCooldownTestEntity() *Entity {
+	entity := NewEntity(1)
+	entity.AddComponent(&AttackComponent{Damage: 10, Cooldown: 1.0})
+
+	slots := &SpellSlotComponent{Casting: -1}
+	slots.SetSlot(0, &magic.Spell{Name: "Fire Bolt", Stats: magic.Stats{Cooldown: 4.0}})
+	entity.AddComponent(slots)
+
+	hotbar := NewHotbarComponent()
+	hotbar.SetSlot(0, &item.Item{Name: "Potion", Type: item.TypeConsumable})
+	entity.AddComponent(hotbar)
+	return entity
+}
+
+func TestAbilityCooldown_ReadyReturnsZero(t *testing.T) {
+	entity := newCooldownTestEntity()
+
+	for _, name := range []string{"attack", "spell_1", "Fire Bolt", "hotbar_1"} {
+		remaining, total := entity.AbilityCooldown(name)
+		if remaining != 0 {
+			t.Errorf("%s: remaining = %v, want 0 when ready", name, remaining)
+		}
+		if total <= 0 {
+			t.Errorf("%s: total = %v, want positive", name, total)
+		}
+	}
+}
+
+func TestAbilityCooldown_MidCooldown(t *testing.T) {
+	entity := newCooldownTestEntity()
+
+	entity.GetAttack().ResetCooldown()
+	entity.GetAttack().UpdateCooldown(0.25)
+
+	comp, _ := entity.GetComponent("spell_slots")
+	comp.(*SpellSlotComponent).Cooldowns[0] = 1.5
+
+	hotbarComp, _ := entity.GetComponent("hotbar")
+	hotbar := hotbarComp.(*HotbarComponent)
+	hotbar.TriggerCooldown(0)
+	hotbar.UpdateCooldowns(0.5)
+
+	tests := []struct {
+		name          string
+		wantRemaining float64
+		wantTotal     float64
+	}{
+		{"attack", 0.75, 1.0},
+		{"spell_1", 1.5, 4.0},
+		{"Fire Bolt", 1.5, 4.0},
+		{"hotbar_1", 1.5, 2.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remaining, total := entity.AbilityCooldown(tt.name)
+			if remaining != tt.wantRemaining || total != tt.wantTotal {
+				t.Errorf("AbilityCooldown(%q) = (%v, %v), want (%v, %v)",
+					tt.name, remaining, total, tt.wantRemaining, tt.wantTotal)
+			}
+			if remaining >= total {
+				t.Errorf("mid-cooldown remaining %v should be < total %v", remaining, total)
+			}
+		})
+	}
+}
+
+func TestAbilityCooldown_UnknownAndClamped(t *testing.T) {
+	entity := newCooldownTestEntity()
+
+	if r, tot := entity.AbilityCooldown("spell_9"); r != 0 || tot != 0 {
+		t.Errorf("out-of-range slot = (%v, %v), want (0, 0)", r, tot)
+	}
+	if r, tot := entity.AbilityCooldown("dash"); r != 0 || tot != 0 {
+		t.Errorf("unknown ability = (%v, %v), want (0, 0)", r, tot)
+	}
+
+	// Remaining larger than the spell's cooldown (slot swapped mid-cooldown)
+	comp, _ := entity.GetComponent("spell_slots")
+	comp.(*SpellSlotComponent).Cooldowns[0] = 10
+	if r, tot := entity.AbilityCooldown("spell_1"); r > tot {
+		t.Errorf("remaining %v exceeds total %v", r, tot)
+	}
+}
+
+func TestAbilityCooldowns_ListsAbilities(t *testing.T) {
+	entity := newCooldownTestEntity()
+	entity.GetAttack().ResetCooldown()
+
+	states := entity.AbilityCooldowns()
+	if len(states) != 3 {
+		t.Fatalf("AbilityCooldowns() returned %d states, want 3", len(states))
+	}
+
+	want := []string{"attack", "spell_1", "hotbar_1"}
+	for i, name := range want {
+		if states[i].Name != name {
+			t.Errorf("states[%d].Name = %q, want %q", i, states[i].Name, name)
+		}
+	}
+	if states[0].Progress() != 1.0 || states[0].IsReady() {
+		t.Errorf("attack progress = %v, want 1.0 and not ready", states[0].Progress())
+	}
+	if !states[1].IsReady() || states[1].Progress() != 0 {
+		t.Errorf("spell_1 should be ready with zero progress")
+	}
+}
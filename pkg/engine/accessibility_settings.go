@@ -2,6 +2,8 @@
 // Phase 10.3: Screen Shake & Impact Feedback accessibility
 package engine
 
+import "math"
+
 // AccessibilitySettings controls accessibility features for visual effects.
 // Phase 10.3: Allows players to customize or disable screen shake and other
 // potentially uncomfortable visual effects.
@@ -17,6 +19,10 @@ type AccessibilitySettings struct {
 
 	// Reduced motion mode (disables all camera effects)
 	ReducedMotion bool
+
+	// Global combat feedback multiplier applied to shake, hit-stop and flash
+	// (0.0 = all feedback disabled, 1.0 = normal)
+	FeedbackIntensity float64
 }
 
 // NewAccessibilitySettings creates default accessibility settings.
@@ -26,6 +32,7 @@ func NewAccessibilitySettings() *AccessibilitySettings {
 		HitStopEnabled:       true,  // Enabled by default
 		VisualFlashEnabled:   true,  // Enabled by default
 		ReducedMotion:        false, // Disabled by default
+		FeedbackIntensity:    1.0,   // Normal feedback by default
 	}
 }
 
@@ -35,25 +42,43 @@ func (a *AccessibilitySettings) ApplyShakeIntensity(baseIntensity float64) float
 	if a.ReducedMotion {
 		return 0.0
 	}
-	return baseIntensity * a.ScreenShakeIntensity
+	return baseIntensity * a.ScreenShakeIntensity * a.FeedbackIntensity
 }
 
 // ShouldApplyHitStop returns true if hit-stop should be applied.
 func (a *AccessibilitySettings) ShouldApplyHitStop() bool {
-	if a.ReducedMotion {
+	if a.ReducedMotion || a.FeedbackIntensity <= 0 {
 		return false
 	}
 	return a.HitStopEnabled
 }
 
+// ApplyHitStopDuration scales a hit-stop duration by the feedback
+// multiplier. Returns 0 if hit-stop should not be applied.
+func (a *AccessibilitySettings) ApplyHitStopDuration(baseDuration float64) float64 {
+	if !a.ShouldApplyHitStop() {
+		return 0.0
+	}
+	return baseDuration * a.FeedbackIntensity
+}
+
 // ShouldApplyVisualFlash returns true if visual flash should be applied.
 func (a *AccessibilitySettings) ShouldApplyVisualFlash() bool {
-	if a.ReducedMotion {
+	if a.ReducedMotion || a.FeedbackIntensity <= 0 {
 		return false
 	}
 	return a.VisualFlashEnabled
 }
 
+// ApplyFlashIntensity scales a flash intensity by the feedback multiplier,
+// capped at 1.0. Returns 0 if visual flash should not be applied.
+func (a *AccessibilitySettings) ApplyFlashIntensity(baseIntensity float64) float64 {
+	if !a.ShouldApplyVisualFlash() {
+		return 0.0
+	}
+	return math.Min(baseIntensity*a.FeedbackIntensity, 1.0)
+}
+
 // SetReducedMotion enables or disables reduced motion mode.
 // When enabled, disables all camera effects that could cause discomfort.
 func (a *AccessibilitySettings) SetReducedMotion(enabled bool) {
@@ -70,6 +95,16 @@ func (a *AccessibilitySettings) SetScreenShakeIntensity(intensity float64) {
 	a.ScreenShakeIntensity = intensity
 }
 
+// SetFeedbackIntensity sets the global combat feedback multiplier.
+// 0.0 disables shake, hit-stop and flash; values below 0.05, including
+// rounding leftovers from stepping the setting down, are snapped to 0.
+func (a *AccessibilitySettings) SetFeedbackIntensity(intensity float64) {
+	if intensity < 0.05 {
+		intensity = 0.0
+	}
+	a.FeedbackIntensity = intensity
+}
+
 // SetHitStopEnabled enables or disables hit-stop effects.
 func (a *AccessibilitySettings) SetHitStopEnabled(enabled bool) {
 	a.HitStopEnabled = enabled
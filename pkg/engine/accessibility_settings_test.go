@@ -288,3 +288,33 @@ func TestAccessibilitySettings_Integration(t *testing.T) {
 		t.Errorf("Reduced motion should disable shake, got %v", appliedShake)
 	}
 }
+
+func TestSetFeedbackIntensity(t *testing.T) {
+	settings := NewAccessibilitySettings()
+	if settings.FeedbackIntensity != 1.0 {
+		t.Errorf("Expected default FeedbackIntensity 1.0, got %v", settings.FeedbackIntensity)
+	}
+
+	settings.SetFeedbackIntensity(0.5)
+	if got := settings.ApplyShakeIntensity(10.0); got != 5.0 {
+		t.Errorf("Expected shake 5.0 at half feedback, got %v", got)
+	}
+	if got := settings.ApplyHitStopDuration(0.1); got != 0.05 {
+		t.Errorf("Expected hit-stop 0.05 at half feedback, got %v", got)
+	}
+	if got := settings.ApplyFlashIntensity(0.8); got != 0.4 {
+		t.Errorf("Expected flash 0.4 at half feedback, got %v", got)
+	}
+
+	settings.SetFeedbackIntensity(-1.0)
+	if settings.FeedbackIntensity != 0.0 {
+		t.Errorf("Expected negative intensity clamped to 0, got %v", settings.FeedbackIntensity)
+	}
+	if settings.ShouldApplyHitStop() || settings.ShouldApplyVisualFlash() || settings.ApplyShakeIntensity(10.0) != 0 {
+		t.Error("Expected zero feedback intensity to disable all feedback")
+	}
+	settings.SetFeedbackIntensity(1.4e-16)
+	if settings.FeedbackIntensity != 0.0 || settings.ShouldApplyHitStop() {
+		t.Errorf("Expected near-zero intensity snapped to 0, got %v", settings.FeedbackIntensity)
+	}
+}
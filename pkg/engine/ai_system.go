@@ -102,23 +102,30 @@ func (ai *AISystem) processAI(entity *Entity, aiComp *AIComponent, deltaTime flo
 
 // processIdle handles the idle state - look for targets.
 func (ai *AISystem) processIdle(entity *Entity, aiComp *AIComponent, pos *PositionComponent) {
+	ai.lookForTarget(entity, aiComp, pos)
+}
+
+// lookForTarget starts detecting the nearest enemy in range. Entities with a
+// detection meter are left to the StealthSystem, which aggroes them once the
+// meter fills. Returns true if a target was acquired.
+func (ai *AISystem) lookForTarget(entity *Entity, aiComp *AIComponent, pos *PositionComponent) bool {
+	if entity.HasComponent("detection") {
+		return false
+	}
+
 	// Look for enemies in range
 	target := ai.findNearestEnemy(entity, pos, aiComp.DetectionRange)
-
-	if target != nil {
-		aiComp.Target = target
-		aiComp.ChangeState(AIStateDetect)
+	if target == nil {
+		return false
 	}
+	aiComp.Target = target
+	aiComp.ChangeState(AIStateDetect)
+	return true
 }
 
 // processPatrol handles the patrol state - move between waypoints.
 func (ai *AISystem) processPatrol(entity *Entity, aiComp *AIComponent, pos *PositionComponent, deltaTime float64) {
-	// Look for enemies in range
-	target := ai.findNearestEnemy(entity, pos, aiComp.DetectionRange)
-
-	if target != nil {
-		aiComp.Target = target
-		aiComp.ChangeState(AIStateDetect)
+	if ai.lookForTarget(entity, aiComp, pos) {
 		return
 	}
 
@@ -40,6 +40,14 @@ const (
 	DirLeft
 	// DirRight represents facing right
 	DirRight
+	// DirUpLeft represents facing up and to the left (8-directional sprites)
+	DirUpLeft
+	// DirUpRight represents facing up and to the right (8-directional sprites)
+	DirUpRight
+	// DirDownLeft represents facing down and to the left (8-directional sprites)
+	DirDownLeft
+	// DirDownRight represents facing down and to the right (8-directional sprites)
+	DirDownRight
 )
 
 // String returns the string representation of the direction.
@@ -53,6 +61,14 @@ func (d Direction) String() string {
 		return "left"
 	case DirRight:
 		return "right"
+	case DirUpLeft:
+		return "up-left"
+	case DirUpRight:
+		return "up-right"
+	case DirDownLeft:
+		return "down-left"
+	case DirDownRight:
+		return "down-right"
 	default:
 		return "unknown"
 	}
@@ -354,6 +354,10 @@ func TestDirection_String(t *testing.T) {
 		{DirDown, "down"},
 		{DirLeft, "left"},
 		{DirRight, "right"},
+		{DirUpLeft, "up-left"},
+		{DirUpRight, "up-right"},
+		{DirDownLeft, "down-left"},
+		{DirDownRight, "down-right"},
 		{Direction(999), "unknown"},
 	}
 
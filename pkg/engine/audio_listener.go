@@ -0,0 +1,78 @@
+// Package engine provides positional sound.
+// This file implements AudioListener, the point sound effects are heard
+// from. The listener follows the active camera, so panning and attenuation
+// are computed from where a source appears on screen: zooming in pushes
+// sources further apart, and a camera deadzone moves the listener only when
+// the view moves.
+package engine
+
+import "github.com/opd-ai/venture/pkg/audio"
+
+// Default listener ranges, in on-screen pixels, used without a camera
+const (
+	DefaultAudibleRange = 800.0
+	DefaultPanWidth     = 400.0
+)
+
+// SpatialAudio is how a sound source is heard by the listener.
+type SpatialAudio = audio.SpatialAudio
+
+// AudioListener computes how sound sources are heard from the view.
+type AudioListener struct {
+	camera *CameraSystem
+
+	// Fallback position used when there is no active camera
+	x, y float64
+
+	// AudibleRange is the on-screen distance in pixels at which sounds fade
+	// to silence
+	AudibleRange float64
+
+	// PanWidth is the on-screen horizontal distance in pixels at which a
+	// sound is panned fully to one side
+	PanWidth float64
+}
+
+// NewAudioListener creates a listener that follows the active camera of
+// camera. Sounds pan fully at the screen edge and fade out one screen
+// width away. camera may be nil, in which case SetPosition places the
+// listener.
+func NewAudioListener(camera *CameraSystem) *AudioListener {
+	l := &AudioListener{
+		camera:       camera,
+		AudibleRange: DefaultAudibleRange,
+		PanWidth:     DefaultPanWidth,
+	}
+	if camera != nil && camera.ScreenWidth > 0 {
+		l.AudibleRange = float64(camera.ScreenWidth)
+		l.PanWidth = float64(camera.ScreenWidth) / 2
+	}
+	return l
+}
+
+// SetPosition sets the world position used while no camera is active.
+func (l *AudioListener) SetPosition(x, y float64) {
+	l.x, l.y = x, y
+}
+
+// Position returns the listener's world position and the zoom sounds are
+// scaled by.
+func (l *AudioListener) Position() (x, y, zoom float64) {
+	if l.camera != nil && l.camera.activeCamera != nil {
+		if cameraComp, ok := l.camera.activeCamera.GetComponent("camera"); ok {
+			camera := cameraComp.(*CameraComponent)
+			zoom = camera.Zoom
+			if zoom <= 0 {
+				zoom = 1
+			}
+			return camera.X, camera.Y, zoom
+		}
+	}
+	return l.x, l.y, 1
+}
+
+// Spatialize returns how a sound at the given world position is heard.
+func (l *AudioListener) Spatialize(sourceX, sourceY float64) SpatialAudio {
+	x, y, zoom := l.Position()
+	return audio.Spatialize((sourceX-x)*zoom, (sourceY-y)*zoom, 0, 0, l.PanWidth, l.AudibleRange)
+}
@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAudioListener_MovingListenerChangesPan(t *testing.T) {
+	listener := NewAudioListener(nil)
+	const sourceX, sourceY = 200.0, 0.0
+
+	centered := listener.Spatialize(sourceX, sourceY)
+	if centered.Pan <= 0 {
+		t.Fatalf("source to the right panned %v, want > 0", centered.Pan)
+	}
+
+	// Walk past the source: it is now on the left
+	listener.SetPosition(400, 0)
+	passed := listener.Spatialize(sourceX, sourceY)
+	if passed.Pan >= 0 {
+		t.Errorf("source to the left panned %v, want < 0", passed.Pan)
+	}
+
+	// Standing on the source: centered and at full volume
+	listener.SetPosition(sourceX, sourceY)
+	if at := listener.Spatialize(sourceX, sourceY); at.Pan != 0 || at.Gain != 1 {
+		t.Errorf("source at the listener: pan %v gain %v, want 0 and 1", at.Pan, at.Gain)
+	}
+}
+
+func TestAudioListener_FollowsCameraZoom(t *testing.T) {
+	cameraSystem := NewCameraSystem(800, 600)
+	cameraEntity := NewEntity(1)
+	camera := NewCameraComponent()
+	camera.X, camera.Y = 1000, 1000
+	cameraEntity.AddComponent(camera)
+	cameraSystem.SetActiveCamera(cameraEntity)
+
+	listener := NewAudioListener(cameraSystem)
+	listener.SetPosition(0, 0) // Ignored while the camera is active
+
+	// 200px right of the camera, half way to the screen edge
+	normal := listener.Spatialize(1200, 1000)
+	if math.Abs(normal.Pan-0.5) > 1e-9 || math.Abs(normal.Gain-0.75) > 1e-9 {
+		t.Errorf("at zoom 1: pan %v gain %v, want 0.5 and 0.75", normal.Pan, normal.Gain)
+	}
+
+	// Zoomed in 2x the source sits at the screen edge
+	camera.Zoom = 2
+	zoomed := listener.Spatialize(1200, 1000)
+	if zoomed.Pan != 1 || zoomed.Gain >= normal.Gain {
+		t.Errorf("at zoom 2: pan %v gain %v, want full pan and quieter", zoomed.Pan, zoomed.Gain)
+	}
+
+	// Out of earshot
+	if far := listener.Spatialize(5000, 1000); far.Gain != 0 {
+		t.Errorf("distant source gain %v, want 0", far.Gain)
+	}
+}
+
+func TestSpatialAudio_ChannelGains(t *testing.T) {
+	left, right := SpatialAudio{Pan: -1, Gain: 1}.ChannelGains()
+	if math.Abs(left-1) > 1e-9 || math.Abs(right) > 1e-9 {
+		t.Errorf("full left pan: left %v right %v", left, right)
+	}
+	left, right = SpatialAudio{Pan: 0, Gain: 0.5}.ChannelGains()
+	if math.Abs(left-right) > 1e-9 || math.Abs(left*left+right*right-0.25) > 1e-9 {
+		t.Errorf("centered pan should split power equally: left %v right %v", left, right)
+	}
+}
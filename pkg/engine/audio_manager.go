@@ -16,6 +16,7 @@ type AudioManager struct {
 	musicGen       *music.Generator
 	sfxGen         *sfx.Generator
 	currentTrack   *audio.AudioSample
+	musicPosition  int // Next sample of currentTrack to play
 	currentGenre   string
 	currentContext string
 	musicVolume    float64
@@ -26,6 +27,9 @@ type AudioManager struct {
 	// Track whether audio is enabled
 	musicEnabled bool
 	sfxEnabled   bool
+
+	// Listener for positional sound effects (nil = no spatialization)
+	listener *AudioListener
 }
 
 // NewAudioManager creates a new audio manager with the specified sample rate and seed.
@@ -115,6 +119,7 @@ func (am *AudioManager) PlayMusic(genre, context string) error {
 	scaledTrack := am.applyVolumeToTrack(track, am.musicVolume)
 
 	am.currentTrack = scaledTrack
+	am.musicPosition = 0
 	am.currentGenre = genre
 	am.currentContext = context
 
@@ -147,6 +152,53 @@ func (am *AudioManager) PlaySFX(effectType string, effectSeed int64) error {
 	return nil
 }
 
+// SetListener sets the listener used by PlaySFXAt.
+func (am *AudioManager) SetListener(listener *AudioListener) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.listener = listener
+}
+
+// GetListener returns the listener used by PlaySFXAt, or nil.
+func (am *AudioManager) GetListener() *AudioListener {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+	return am.listener
+}
+
+// PlaySFXAt plays a sound effect emitted at a world position, panned and
+// attenuated relative to the listener. Sounds out of earshot are skipped.
+// Without a listener it behaves like PlaySFX.
+func (am *AudioManager) PlaySFXAt(effectType string, effectSeed int64, x, y float64) error {
+	am.mu.RLock()
+	enabled := am.sfxEnabled
+	volume := am.sfxVolume
+	genre := am.currentGenre
+	listener := am.listener
+	am.mu.RUnlock()
+
+	if listener == nil {
+		return am.PlaySFX(effectType, effectSeed)
+	}
+	if !enabled {
+		return nil // SFX disabled, silently succeed
+	}
+
+	spatial := listener.Spatialize(x, y)
+	if spatial.Gain <= 0 {
+		return nil // Out of earshot
+	}
+
+	sample := am.sfxGen.GenerateWithGenre(effectType, effectSeed, genre)
+
+	// Apply volume and pan per channel (playback is not wired up yet, see PlaySFX)
+	left, right := spatial.ChannelGains()
+	_ = am.applyVolumeToTrack(sample, volume*left)
+	_ = am.applyVolumeToTrack(sample, volume*right)
+
+	return nil
+}
+
 // GetCurrentTrack returns the currently playing music track (if any).
 func (am *AudioManager) GetCurrentTrack() *audio.AudioSample {
 	am.mu.RLock()
@@ -154,6 +206,29 @@ func (am *AudioManager) GetCurrentTrack() *audio.AudioSample {
 	return am.currentTrack
 }
 
+// ReadMusic fills buf with the next samples of the current music track and
+// returns how many were written. Playback runs from the start of the track
+// into its loop, then repeats the loop between the track's loop points
+// rather than restarting from sample 0. Returns 0 when no music is playing.
+func (am *AudioManager) ReadMusic(buf []float64) int {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	if am.currentTrack == nil || len(am.currentTrack.Data) == 0 {
+		return 0
+	}
+
+	loopStart, loopEnd := am.currentTrack.LoopBounds()
+	for i := range buf {
+		if am.musicPosition >= loopEnd {
+			am.musicPosition = loopStart
+		}
+		buf[i] = am.currentTrack.Data[am.musicPosition]
+		am.musicPosition++
+	}
+	return len(buf)
+}
+
 // GetCurrentMusicInfo returns the genre and context of the currently playing music.
 func (am *AudioManager) GetCurrentMusicInfo() (genre, context string) {
 	am.mu.RLock()
@@ -166,6 +241,7 @@ func (am *AudioManager) StopMusic() {
 	am.mu.Lock()
 	defer am.mu.Unlock()
 	am.currentTrack = nil
+	am.musicPosition = 0
 	am.currentGenre = ""
 	am.currentContext = ""
 }
@@ -179,6 +255,8 @@ func (am *AudioManager) applyVolumeToTrack(track *audio.AudioSample, volume floa
 	return &audio.AudioSample{
 		SampleRate: track.SampleRate,
 		Data:       scaledData,
+		LoopStart:  track.LoopStart,
+		LoopEnd:    track.LoopEnd,
 	}
 }
 
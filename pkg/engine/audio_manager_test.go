@@ -2,6 +2,8 @@ package engine
 
 import (
 	"testing"
+
+	"github.com/opd-ai/venture/pkg/audio"
 )
 
 func TestNewAudioManager(t *testing.T) {
@@ -338,3 +340,43 @@ func TestGetSFXVolume(t *testing.T) {
 		t.Errorf("Expected SFX volume 0.85, got %f", vol)
 	}
 }
+
+func TestReadMusic_LoopsBetweenLoopPoints(t *testing.T) {
+	am := NewAudioManager(44100, 12345)
+
+	buf := make([]float64, 4)
+	if n := am.ReadMusic(buf); n != 0 {
+		t.Errorf("ReadMusic with no music = %d, want 0", n)
+	}
+
+	am.currentTrack = &audio.AudioSample{
+		SampleRate: 44100,
+		Data:       []float64{0, 1, 2, 3, 4, 5},
+		LoopStart:  2,
+		LoopEnd:    5,
+	}
+
+	buf = make([]float64, 10)
+	if n := am.ReadMusic(buf); n != len(buf) {
+		t.Fatalf("ReadMusic() = %d, want %d", n, len(buf))
+	}
+	want := []float64{0, 1, 2, 3, 4, 2, 3, 4, 2, 3}
+	for i := range want {
+		if buf[i] != want[i] {
+			t.Fatalf("ReadMusic() = %v, want %v", buf, want)
+		}
+	}
+}
+
+func TestPlayMusic_SetsLoopPoints(t *testing.T) {
+	am := NewAudioManager(44100, 12345)
+	if err := am.PlayMusic("fantasy", "exploration"); err != nil {
+		t.Fatalf("PlayMusic failed: %v", err)
+	}
+
+	track := am.GetCurrentTrack()
+	start, end := track.LoopBounds()
+	if start <= 0 || end >= len(track.Data) || start >= end {
+		t.Errorf("loop points (%d, %d) should skip the fades of a %d sample track", start, end, len(track.Data))
+	}
+}
@@ -0,0 +1,78 @@
+// Package engine provides carryable barrels.
+// This file places barrels in generated rooms for players to pick up and
+// throw with the ThrowSystem. Barrels break on impact.
+package engine
+
+import (
+	"image/color"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+const (
+	// BarrelImpactDamage is the damage a thrown barrel deals on a hit
+	BarrelImpactDamage = 20.0
+
+	// barrelRoomChance is the chance a room holds a barrel
+	barrelRoomChance = 0.5
+
+	// barrelSize is the width and height of a barrel in pixels
+	barrelSize = 16.0
+)
+
+// SpawnBarrel creates a breakable, carryable barrel at the given world position.
+func SpawnBarrel(world *World, x, y float64) *Entity {
+	barrel := world.CreateEntity()
+	barrel.AddComponent(&PositionComponent{X: x, Y: y})
+
+	sprite := NewSpriteComponent(barrelSize, barrelSize, color.RGBA{140, 90, 50, 255}) // Wood brown
+	sprite.Layer = 4
+	barrel.AddComponent(sprite)
+
+	barrel.AddComponent(&ColliderComponent{
+		Width:   barrelSize,
+		Height:  barrelSize,
+		Solid:   true,
+		Layer:   1,
+		OffsetX: -barrelSize / 2,
+		OffsetY: -barrelSize / 2,
+	})
+	barrel.AddComponent(NewCarryableComponent(BarrelImpactDamage, true))
+	return barrel
+}
+
+// SpawnBarrelsInTerrain places a barrel on a random floor tile of about
+// half the normal and treasure rooms, away from the room center where
+// other content spawns. Placement is deterministic for a given seed.
+// Returns the number of barrels spawned.
+func SpawnBarrelsInTerrain(world *World, terrainData *terrain.Terrain, tileSize int, seed int64) int {
+	if world == nil || terrainData == nil {
+		return 0
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	count := 0
+	for _, room := range terrainData.Rooms {
+		if room.Type != terrain.RoomNormal && room.Type != terrain.RoomTreasure {
+			continue
+		}
+		if rng.Float64() >= barrelRoomChance || room.Width < 3 || room.Height < 3 {
+			continue
+		}
+
+		// Stay off the walls and the center tile
+		cx, cy := room.Center()
+		tx := room.X + 1 + rng.Intn(room.Width-2)
+		ty := room.Y + 1 + rng.Intn(room.Height-2)
+		if (tx == cx && ty == cy) || !terrainData.IsWalkable(tx, ty) {
+			continue
+		}
+
+		x := float64(tx*tileSize + tileSize/2)
+		y := float64(ty*tileSize + tileSize/2)
+		SpawnBarrel(world, x, y)
+		count++
+	}
+	return count
+}
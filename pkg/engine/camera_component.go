@@ -232,3 +232,31 @@ func CalculateShakeDuration(intensity, baseDuration, additionalDuration, maxInte
 	ratio := math.Min(intensity/maxIntensity, 1.0)
 	return baseDuration + ratio*additionalDuration
 }
+
+// Severity-scaled hit-stop parameters (severity = damage / max HP)
+const (
+	HitStopSeverityThreshold  = 0.15 // Minimum severity that triggers hit-stop
+	HitStopBaseDuration       = 0.03 // Hit-stop at the threshold (seconds)
+	HitStopAdditionalDuration = 0.09 // Additional hit-stop at full severity (seconds)
+)
+
+// CalculateHitSeverity returns how hard a hit landed as damage relative to
+// the target's max HP, clamped to 0-1.
+func CalculateHitSeverity(damage, maxHP float64) float64 {
+	if maxHP <= 0 {
+		maxHP = 100 // Default to avoid division by zero
+	}
+	return math.Max(0, math.Min(damage/maxHP, 1.0))
+}
+
+// CalculateHitStopDuration returns the hit-stop for a hit of the given
+// severity. Hits below HitStopSeverityThreshold get none; above it the
+// duration grows linearly from HitStopBaseDuration to
+// HitStopBaseDuration+HitStopAdditionalDuration at full severity.
+func CalculateHitStopDuration(severity float64) float64 {
+	if severity < HitStopSeverityThreshold {
+		return 0
+	}
+	ratio := (severity - HitStopSeverityThreshold) / (1 - HitStopSeverityThreshold)
+	return HitStopBaseDuration + math.Min(ratio, 1.0)*HitStopAdditionalDuration
+}
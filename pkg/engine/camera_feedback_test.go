@@ -0,0 +1,87 @@
+package engine
+
+import "testing"
+
+// feedbackTestCamera returns a camera system whose active camera has shake
+// and hit-stop components.
+func feedbackTestCamera() (*CameraSystem, *ScreenShakeComponent, *HitStopComponent) {
+	world := NewWorld()
+	player := world.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 100, Y: 100})
+	player.AddComponent(NewCameraComponent())
+	shake := NewScreenShakeComponent()
+	hitStop := NewHitStopComponent()
+	player.AddComponent(shake)
+	player.AddComponent(hitStop)
+
+	system := NewCameraSystem(800, 600)
+	system.SetActiveCamera(player)
+	return system, shake, hitStop
+}
+
+func TestCameraSystem_HitFeedbackScalesWithSeverity(t *testing.T) {
+	light, lightShake, lightStop := feedbackTestCamera()
+	heavy, heavyShake, heavyStop := feedbackTestCamera()
+
+	light.HitFeedback(20, 100, false)
+	heavy.HitFeedback(80, 100, false)
+
+	if heavyShake.Intensity <= lightShake.Intensity || heavyShake.Duration <= lightShake.Duration {
+		t.Errorf("heavy shake %v/%vs should exceed light shake %v/%vs",
+			heavyShake.Intensity, heavyShake.Duration, lightShake.Intensity, lightShake.Duration)
+	}
+	if !lightStop.IsActive() || heavyStop.Duration <= lightStop.Duration {
+		t.Errorf("heavy hit-stop %vs should exceed light hit-stop %vs", heavyStop.Duration, lightStop.Duration)
+	}
+
+	grazed, _, grazeStop := feedbackTestCamera()
+	grazed.HitFeedback(5, 100, false)
+	if grazeStop.IsActive() {
+		t.Error("hits below the severity threshold should not hit-stop")
+	}
+	grazed.HitFeedback(5, 100, true)
+	if grazeStop.Duration != CriticalHitStopDuration {
+		t.Errorf("critical graze hit-stop = %v, want %v", grazeStop.Duration, CriticalHitStopDuration)
+	}
+}
+
+func TestCameraSystem_HitFeedbackZeroIntensityDisables(t *testing.T) {
+	system, shake, hitStop := feedbackTestCamera()
+	system.Accessibility.SetFeedbackIntensity(0)
+
+	system.HitFeedback(100, 100, true)
+	if shake.IsShaking() || hitStop.IsActive() {
+		t.Error("zero feedback intensity should disable shake and hit-stop")
+	}
+	if system.Accessibility.ShouldApplyVisualFlash() {
+		t.Error("zero feedback intensity should disable flash")
+	}
+}
+
+func TestCameraSystem_HitFeedbackIntensityMultiplier(t *testing.T) {
+	normal, normalShake, normalStop := feedbackTestCamera()
+	boosted, boostedShake, boostedStop := feedbackTestCamera()
+	boosted.Accessibility.SetFeedbackIntensity(2.0)
+
+	normal.HitFeedback(50, 100, false)
+	boosted.HitFeedback(50, 100, false)
+
+	if boostedShake.Intensity != 2*normalShake.Intensity {
+		t.Errorf("boosted shake = %v, want %v", boostedShake.Intensity, 2*normalShake.Intensity)
+	}
+	if boostedStop.Duration != 2*normalStop.Duration {
+		t.Errorf("boosted hit-stop = %v, want %v", boostedStop.Duration, 2*normalStop.Duration)
+	}
+}
+
+func TestCalculateHitStopDuration(t *testing.T) {
+	if d := CalculateHitStopDuration(HitStopSeverityThreshold / 2); d != 0 {
+		t.Errorf("below threshold = %v, want 0", d)
+	}
+	if d := CalculateHitStopDuration(HitStopSeverityThreshold); d != HitStopBaseDuration {
+		t.Errorf("at threshold = %v, want %v", d, HitStopBaseDuration)
+	}
+	if d := CalculateHitStopDuration(CalculateHitSeverity(500, 100)); d != HitStopBaseDuration+HitStopAdditionalDuration {
+		t.Errorf("overkill = %v, want %v", d, HitStopBaseDuration+HitStopAdditionalDuration)
+	}
+}
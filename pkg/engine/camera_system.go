@@ -66,6 +66,13 @@ type CameraSystem struct {
 
 	// Phase 10.3: Accessibility settings for screen shake and effects
 	Accessibility *AccessibilitySettings
+
+	// Transitions played on level changes and teleports
+	LevelTransition    CameraTransitionConfig
+	TeleportTransition CameraTransitionConfig
+
+	// In-progress transition of the active camera (nil when idle)
+	transition *cameraTransition
 }
 
 // NewCameraSystem creates a new camera system.
@@ -74,6 +81,9 @@ func NewCameraSystem(screenWidth, screenHeight int) *CameraSystem {
 		ScreenWidth:   screenWidth,
 		ScreenHeight:  screenHeight,
 		Accessibility: NewAccessibilitySettings(), // Phase 10.3: Default accessibility
+
+		LevelTransition:    DefaultLevelTransition(),
+		TeleportTransition: DefaultTeleportTransition(),
 	}
 }
 
@@ -97,6 +107,12 @@ func (s *CameraSystem) Update(entities []*Entity, deltaTime float64) {
 		}
 		pos := posComp.(*PositionComponent)
 
+		// Transitions drive the active camera in real time (unaffected by hit-stop)
+		if entity == s.activeCamera && s.updateTransition(camera, deltaTime) {
+			s.updateAdvancedShake(entity, effectiveDeltaTime)
+			continue
+		}
+
 		// Calculate target camera position (entity position + offset)
 		targetX := pos.X + camera.OffsetX
 		targetY := pos.Y + camera.OffsetY
@@ -364,8 +380,9 @@ func (s *CameraSystem) TriggerHitStop(duration, timeScale float64) {
 		return
 	}
 
-	// Phase 10.3: Check accessibility settings
-	if !s.Accessibility.ShouldApplyHitStop() {
+	// Phase 10.3: Check accessibility settings and scale by feedback intensity
+	duration = s.Accessibility.ApplyHitStopDuration(duration)
+	if duration <= 0 {
 		return // Hit-stop disabled via accessibility
 	}
 
@@ -378,6 +395,30 @@ func (s *CameraSystem) TriggerHitStop(duration, timeScale float64) {
 	hitStop.TriggerHitStop(duration, timeScale)
 }
 
+// HitFeedback triggers screen shake and hit-stop scaled by hit severity
+// (damage relative to the target's max HP): bigger hits shake harder and
+// longer, and hits above HitStopSeverityThreshold freeze time in proportion
+// to their severity. Critical hits amplify both. Respects accessibility
+// settings, including the global feedback intensity.
+func (s *CameraSystem) HitFeedback(damage, maxHP float64, critical bool) {
+	shakeIntensity := CalculateShakeIntensity(damage, maxHP,
+		CombatShakeScaleFactor, CombatShakeMinIntensity, CombatShakeMaxIntensity)
+	shakeDuration := CalculateShakeDuration(shakeIntensity,
+		CombatShakeBaseDuration, CombatShakeAdditionalDuration, CombatShakeMaxIntensity)
+	hitStopDuration := CalculateHitStopDuration(CalculateHitSeverity(damage, maxHP))
+
+	if critical {
+		shakeIntensity *= CriticalHitShakeMultiplier
+		shakeDuration *= CriticalHitDurationMultiplier
+		hitStopDuration = math.Max(CriticalHitStopDuration, hitStopDuration*CriticalHitDurationMultiplier)
+	}
+
+	if hitStopDuration > 0 {
+		s.TriggerHitStop(hitStopDuration, 0.0)
+	}
+	s.ShakeAdvanced(shakeIntensity, shakeDuration)
+}
+
 // IsHitStopActive returns true if hit-stop is currently active.
 // Phase 10.3: For systems that need to know if time is dilated.
 func (s *CameraSystem) IsHitStopActive() bool {
@@ -0,0 +1,222 @@
+// Package engine provides camera transitions.
+// This file implements fade and pan transitions the CameraSystem plays on
+// level changes and teleports so the view never snaps to a new location.
+package engine
+
+import "fmt"
+
+// CameraTransitionKind selects how the camera moves to a new location.
+type CameraTransitionKind int
+
+const (
+	// TransitionFade fades to black, repositions the camera, then fades in
+	TransitionFade CameraTransitionKind = iota
+	// TransitionPan slides the camera to the new location
+	TransitionPan
+	// TransitionCut repositions the camera instantly
+	TransitionCut
+)
+
+// String returns the string representation of a transition kind.
+func (k CameraTransitionKind) String() string {
+	switch k {
+	case TransitionFade:
+		return "fade"
+	case TransitionPan:
+		return "pan"
+	case TransitionCut:
+		return "cut"
+	default:
+		return "unknown"
+	}
+}
+
+// CameraTransitionConfig configures the transition used for one event.
+type CameraTransitionConfig struct {
+	// Kind of transition
+	Kind CameraTransitionKind
+
+	// Duration in seconds of the whole transition (fade out plus fade in)
+	Duration float64
+}
+
+// DefaultLevelTransition fades out and in over 0.8 seconds.
+func DefaultLevelTransition() CameraTransitionConfig {
+	return CameraTransitionConfig{Kind: TransitionFade, Duration: 0.8}
+}
+
+// DefaultTeleportTransition pans quickly to the destination.
+func DefaultTeleportTransition() CameraTransitionConfig {
+	return CameraTransitionConfig{Kind: TransitionPan, Duration: 0.35}
+}
+
+// Validate checks that the configuration is usable.
+func (c CameraTransitionConfig) Validate() error {
+	if c.Kind < TransitionFade || c.Kind > TransitionCut {
+		return fmt.Errorf("unknown transition kind: %d", c.Kind)
+	}
+	if c.Kind != TransitionCut && c.Duration <= 0 {
+		return fmt.Errorf("transition duration must be positive, got %f", c.Duration)
+	}
+	return nil
+}
+
+// cameraTransition is an in-progress transition.
+type cameraTransition struct {
+	kind     CameraTransitionKind
+	duration float64
+	elapsed  float64
+
+	fromX, fromY float64
+	toX, toY     float64
+
+	// onMidpoint runs once when the camera reaches the destination
+	// (at full black for fades, at the start for pans)
+	onMidpoint   func()
+	midpointDone bool
+}
+
+// StartTransition begins moving the active camera to (x, y), the camera
+// position the target entity will have at its new location. onMidpoint
+// (optional) runs once when the view is hidden or moving, and is where the
+// caller should swap levels or move the entity. Starting a transition
+// replaces any transition already in progress.
+func (s *CameraSystem) StartTransition(config CameraTransitionConfig, x, y float64, onMidpoint func()) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if s.activeCamera == nil {
+		return fmt.Errorf("no active camera")
+	}
+	cameraComp, ok := s.activeCamera.GetComponent("camera")
+	if !ok {
+		return fmt.Errorf("active camera has no camera component")
+	}
+	camera := cameraComp.(*CameraComponent)
+
+	// Reduced motion replaces pans with fades
+	kind := config.Kind
+	if kind == TransitionPan && s.Accessibility != nil && s.Accessibility.ReducedMotion {
+		kind = TransitionFade
+	}
+
+	s.transition = &cameraTransition{
+		kind:       kind,
+		duration:   config.Duration,
+		fromX:      camera.X,
+		fromY:      camera.Y,
+		toX:        x,
+		toY:        y,
+		onMidpoint: onMidpoint,
+	}
+
+	// Cuts and pans move the world immediately; the camera catches up
+	if kind != TransitionFade {
+		s.transition.reachMidpoint()
+	}
+	if kind == TransitionCut {
+		camera.X, camera.Y = x, y
+		s.transition = nil
+	}
+	return nil
+}
+
+// BeginLevelTransition plays the level-change transition toward the new
+// spawn point. onMidpoint should load the level and place the player.
+func (s *CameraSystem) BeginLevelTransition(spawnX, spawnY float64, onMidpoint func()) error {
+	return s.StartTransition(s.LevelTransition, spawnX, spawnY, onMidpoint)
+}
+
+// BeginTeleport moves entity to (x, y) using the teleport transition. The
+// entity is moved when the view is hidden (fade) or immediately (pan, cut).
+func (s *CameraSystem) BeginTeleport(entity *Entity, x, y float64) error {
+	posComp, ok := entity.GetComponent("position")
+	if !ok {
+		return fmt.Errorf("entity %d has no position", entity.ID)
+	}
+	pos := posComp.(*PositionComponent)
+
+	camX, camY := x, y
+	if cameraComp, ok := entity.GetComponent("camera"); ok {
+		camera := cameraComp.(*CameraComponent)
+		camX += camera.OffsetX
+		camY += camera.OffsetY
+	}
+
+	return s.StartTransition(s.TeleportTransition, camX, camY, func() {
+		pos.X, pos.Y = x, y
+	})
+}
+
+// IsTransitioning reports whether a camera transition is in progress.
+func (s *CameraSystem) IsTransitioning() bool {
+	return s.transition != nil
+}
+
+// FadeAlpha returns the opacity (0-1) of the black fade overlay the
+// renderer should draw over the scene.
+func (s *CameraSystem) FadeAlpha() float64 {
+	t := s.transition
+	if t == nil || t.kind != TransitionFade {
+		return 0
+	}
+	half := t.duration / 2
+	if t.elapsed < half {
+		return t.elapsed / half
+	}
+	return clamp01(1 - (t.elapsed-half)/half)
+}
+
+// updateTransition advances the transition and positions the camera.
+// Returns true if the transition controlled the camera this frame.
+func (s *CameraSystem) updateTransition(camera *CameraComponent, deltaTime float64) bool {
+	t := s.transition
+	if t == nil {
+		return false
+	}
+	t.elapsed += deltaTime
+
+	switch t.kind {
+	case TransitionFade:
+		// Hold the old view while fading out, then show the destination
+		if t.elapsed >= t.duration/2 {
+			t.reachMidpoint()
+			camera.X, camera.Y = t.toX, t.toY
+		} else {
+			camera.X, camera.Y = t.fromX, t.fromY
+		}
+	case TransitionPan:
+		p := clamp01(t.elapsed / t.duration)
+		p = p * p * (3 - 2*p) // Smoothstep
+		camera.X = t.fromX + (t.toX-t.fromX)*p
+		camera.Y = t.fromY + (t.toY-t.fromY)*p
+	}
+
+	if t.elapsed >= t.duration {
+		camera.X, camera.Y = t.toX, t.toY
+		s.transition = nil
+	}
+	return true
+}
+
+// reachMidpoint runs the midpoint callback once.
+func (t *cameraTransition) reachMidpoint() {
+	if t.midpointDone {
+		return
+	}
+	t.midpointDone = true
+	if t.onMidpoint != nil {
+		t.onMidpoint()
+	}
+}
+
+// clamp01 clamps v to [0, 1].
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
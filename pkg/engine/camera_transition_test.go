@@ -0,0 +1,161 @@
+package engine
+
+import (
+	"math"
+	"testing"
+)
+
+func transitionTestSetup() (*CameraSystem, *Entity, *CameraComponent, *PositionComponent) {
+	world := NewWorld()
+	player := world.CreateEntity()
+	pos := &PositionComponent{X: 100, Y: 100}
+	camera := NewCameraComponent()
+	camera.Smoothing = 0
+	camera.X, camera.Y = 100, 100
+	player.AddComponent(pos)
+	player.AddComponent(camera)
+
+	system := NewCameraSystem(800, 600)
+	system.SetActiveCamera(player)
+	return system, player, camera, pos
+}
+
+func TestCameraSystem_LevelTransitionFade(t *testing.T) {
+	system, player, camera, pos := transitionTestSetup()
+	system.LevelTransition = CameraTransitionConfig{Kind: TransitionFade, Duration: 1.0}
+	entities := []*Entity{player}
+
+	levelLoaded := 0
+	err := system.BeginLevelTransition(2000, 1500, func() {
+		levelLoaded++
+		pos.X, pos.Y = 2000, 1500
+	})
+	if err != nil {
+		t.Fatalf("BeginLevelTransition failed: %v", err)
+	}
+
+	// Fading out: old view held, screen darkening, level not yet swapped
+	system.Update(entities, 0.25)
+	if a := system.FadeAlpha(); math.Abs(a-0.5) > 1e-9 {
+		t.Errorf("FadeAlpha at 0.25s = %v, want 0.5", a)
+	}
+	if camera.X != 100 || camera.Y != 100 || levelLoaded != 0 {
+		t.Errorf("camera moved or level loaded before fully faded: (%v,%v) loaded=%d", camera.X, camera.Y, levelLoaded)
+	}
+
+	// Midpoint: fully black, level swapped, camera at the new spawn
+	system.Update(entities, 0.25)
+	if a := system.FadeAlpha(); math.Abs(a-1) > 1e-9 {
+		t.Errorf("FadeAlpha at midpoint = %v, want 1", a)
+	}
+	if levelLoaded != 1 {
+		t.Errorf("midpoint callback ran %d times, want 1", levelLoaded)
+	}
+	if camera.X != 2000 || camera.Y != 1500 {
+		t.Errorf("camera at midpoint = (%v,%v), want (2000,1500)", camera.X, camera.Y)
+	}
+
+	// Fading back in over the second half of the configured duration
+	system.Update(entities, 0.25)
+	if a := system.FadeAlpha(); math.Abs(a-0.5) > 1e-9 {
+		t.Errorf("FadeAlpha at 0.75s = %v, want 0.5", a)
+	}
+	system.Update(entities, 0.25)
+	if system.IsTransitioning() || system.FadeAlpha() != 0 {
+		t.Error("transition should be finished after the configured duration")
+	}
+	if levelLoaded != 1 {
+		t.Errorf("midpoint callback ran %d times, want 1", levelLoaded)
+	}
+
+	// Normal following resumes without a jump
+	system.Update(entities, 0.016)
+	if camera.X != 2000 || camera.Y != 1500 {
+		t.Errorf("camera after transition = (%v,%v), want (2000,1500)", camera.X, camera.Y)
+	}
+}
+
+func TestCameraSystem_TeleportPan(t *testing.T) {
+	system, player, camera, pos := transitionTestSetup()
+	system.TeleportTransition = CameraTransitionConfig{Kind: TransitionPan, Duration: 0.4}
+	entities := []*Entity{player}
+
+	if err := system.BeginTeleport(player, 500, 100); err != nil {
+		t.Fatalf("BeginTeleport failed: %v", err)
+	}
+	if pos.X != 500 {
+		t.Errorf("pan teleport should move the entity immediately, X = %v", pos.X)
+	}
+
+	system.Update(entities, 0.2)
+	if camera.X != 300 {
+		t.Errorf("camera halfway through pan X = %v, want 300", camera.X)
+	}
+	if system.FadeAlpha() != 0 {
+		t.Error("pans should not fade the screen")
+	}
+
+	system.Update(entities, 0.2)
+	if system.IsTransitioning() || camera.X != 500 {
+		t.Errorf("pan should finish at destination, camera X = %v", camera.X)
+	}
+}
+
+func TestCameraSystem_TeleportReducedMotionFades(t *testing.T) {
+	system, player, _, pos := transitionTestSetup()
+	system.Accessibility.ReducedMotion = true
+
+	if err := system.BeginTeleport(player, 900, 900); err != nil {
+		t.Fatalf("BeginTeleport failed: %v", err)
+	}
+	if pos.X != 100 {
+		t.Error("fade teleport should wait for the midpoint to move the entity")
+	}
+
+	system.Update([]*Entity{player}, system.TeleportTransition.Duration/2)
+	if pos.X != 900 || pos.Y != 900 {
+		t.Errorf("entity at midpoint = (%v,%v), want (900,900)", pos.X, pos.Y)
+	}
+}
+
+func TestCameraSystem_TransitionCut(t *testing.T) {
+	system, _, camera, _ := transitionTestSetup()
+
+	called := false
+	err := system.StartTransition(CameraTransitionConfig{Kind: TransitionCut}, 50, 60, func() { called = true })
+	if err != nil {
+		t.Fatalf("StartTransition failed: %v", err)
+	}
+	if !called || camera.X != 50 || camera.Y != 60 || system.IsTransitioning() {
+		t.Errorf("cut should apply instantly: called=%v camera=(%v,%v)", called, camera.X, camera.Y)
+	}
+}
+
+func TestCameraSystem_StartTransitionErrors(t *testing.T) {
+	system := NewCameraSystem(800, 600)
+	if err := system.StartTransition(DefaultLevelTransition(), 0, 0, nil); err == nil {
+		t.Error("expected error without an active camera")
+	}
+
+	system, _, _, _ = transitionTestSetup()
+	if err := system.StartTransition(CameraTransitionConfig{Kind: TransitionFade, Duration: 0}, 0, 0, nil); err == nil {
+		t.Error("expected error for zero-length fade")
+	}
+	if err := system.StartTransition(CameraTransitionConfig{Kind: CameraTransitionKind(9), Duration: 1}, 0, 0, nil); err == nil {
+		t.Error("expected error for unknown kind")
+	}
+}
+
+func TestCameraTransitionKind_String(t *testing.T) {
+	kinds := map[CameraTransitionKind]string{
+		TransitionFade:          "fade",
+		TransitionPan:           "pan",
+		TransitionCut:           "cut",
+		CameraTransitionKind(9): "unknown",
+	}
+	for kind, want := range kinds {
+		if got := kind.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", kind, got, want)
+		}
+	}
+}
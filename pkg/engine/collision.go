@@ -309,45 +309,70 @@ func (s *CollisionSystem) resolveCollision(e1, e2 *Entity) {
 	overlapX := math.Min(max1X-min2X, max2X-min1X)
 	overlapY := math.Min(max1Y-min2Y, max2Y-min1Y)
 
-	// Separate along the axis with minimum overlap
+	// Separate along the axis with minimum overlap; (nx, ny) points from e1 to e2
+	var nx, ny, overlap float64
 	if overlapX < overlapY {
-		// Separate horizontally
-		if pos1.X < pos2.X {
-			pos1.X -= overlapX / 2
-			pos2.X += overlapX / 2
-		} else {
-			pos1.X += overlapX / 2
-			pos2.X -= overlapX / 2
-		}
-
-		// Stop horizontal velocity
-		if e1.HasComponent("velocity") {
-			vel1, _ := e1.GetComponent("velocity")
-			vel1.(*VelocityComponent).VX = 0
-		}
-		if e2.HasComponent("velocity") {
-			vel2, _ := e2.GetComponent("velocity")
-			vel2.(*VelocityComponent).VX = 0
+		overlap, nx = overlapX, 1
+		if pos1.X >= pos2.X {
+			nx = -1
 		}
 	} else {
-		// Separate vertically
-		if pos1.Y < pos2.Y {
-			pos1.Y -= overlapY / 2
-			pos2.Y += overlapY / 2
-		} else {
-			pos1.Y += overlapY / 2
-			pos2.Y -= overlapY / 2
+		overlap, ny = overlapY, 1
+		if pos1.Y >= pos2.Y {
+			ny = -1
 		}
+	}
 
-		// Stop vertical velocity
-		if e1.HasComponent("velocity") {
-			vel1, _ := e1.GetComponent("velocity")
-			vel1.(*VelocityComponent).VY = 0
-		}
-		if e2.HasComponent("velocity") {
-			vel2, _ := e2.GetComponent("velocity")
-			vel2.(*VelocityComponent).VY = 0
-		}
+	share1, share2, pushing1, pushing2 := separationShares(collider1, collider2)
+	pos1.X -= nx * overlap * share1
+	pos1.Y -= ny * overlap * share1
+	pos2.X += nx * overlap * share2
+	pos2.Y += ny * overlap * share2
+
+	applyContactVelocity(e1, collider1.Response, nx, ny, pushing1, pushing2)
+	applyContactVelocity(e2, collider2.Response, -nx, -ny, pushing2, pushing1)
+}
+
+// separationShares returns the fraction of the overlap each collider is
+// moved by. A push collider that is heavier than the other shoves it aside,
+// splitting the overlap by inverse mass; otherwise both move half.
+func separationShares(c1, c2 *ColliderComponent) (share1, share2 float64, pushing1, pushing2 bool) {
+	m1, m2 := c1.GetMass(), c2.GetMass()
+	pushing1 = c1.Response == CollisionPush && m1 > m2
+	pushing2 = c2.Response == CollisionPush && m2 > m1
+	if !pushing1 && !pushing2 {
+		return 0.5, 0.5, false, false
+	}
+	return m2 / (m1 + m2), m1 / (m1 + m2), pushing1, pushing2
+}
+
+// applyContactVelocity updates an entity's velocity after a contact whose
+// direction toward the other entity is (nx, ny). Pushers keep moving; sliding
+// and pushed entities only lose the velocity heading into the contact;
+// blocking entities stop along the contact axis.
+func applyContactVelocity(e *Entity, response CollisionResponse, nx, ny float64, pushing, pushed bool) {
+	vel := e.GetVelocity()
+	if vel == nil || pushing {
+		return
+	}
+	if response == CollisionSlide || pushed {
+		removeVelocityInto(vel, nx, ny)
+		return
+	}
+	if nx != 0 {
+		vel.VX = 0
+	}
+	if ny != 0 {
+		vel.VY = 0
+	}
+}
+
+// removeVelocityInto removes the part of a velocity heading along the unit
+// vector (nx, ny), keeping the tangential part.
+func removeVelocityInto(vel *VelocityComponent, nx, ny float64) {
+	if dot := vel.VX*nx + vel.VY*ny; dot > 0 {
+		vel.VX -= dot * nx
+		vel.VY -= dot * ny
 	}
 }
 
@@ -363,6 +388,10 @@ func (s *CollisionSystem) resolveTerrainCollision(entity *Entity) {
 	pos := posComp.(*PositionComponent)
 	collider := colliderComp.(*ColliderComponent)
 
+	if collider.Response == CollisionSlide && s.slideOutOfTerrain(entity, pos, collider) {
+		return
+	}
+
 	// Try to find a valid position by moving away from walls
 	// Check 8 directions around the entity
 	directions := []struct{ dx, dy float64 }{
@@ -408,6 +437,37 @@ func (s *CollisionSystem) resolveTerrainCollision(entity *Entity) {
 	}
 }
 
+// maxSlideCorrection is the farthest (in pixels) a sliding entity is moved
+// out of a wall in one frame.
+const maxSlideCorrection = 8.0
+
+// slideOutOfTerrain moves an entity out of the walls it overlaps along the
+// wall's surface normal and removes only the velocity heading into the
+// wall. Returns false if no normal or clear position was found.
+func (s *CollisionSystem) slideOutOfTerrain(entity *Entity, pos *PositionComponent, collider *ColliderComponent) bool {
+	layer := entityTerrainLayer(entity)
+	nx, ny, ok := s.terrainChecker.ContactNormal(
+		pos.X-collider.Width/2, pos.Y-collider.Height/2,
+		pos.X+collider.Width/2, pos.Y+collider.Height/2, layer)
+	if !ok {
+		return false
+	}
+
+	for step := 1.0; step <= maxSlideCorrection; step++ {
+		testX, testY := pos.X+nx*step, pos.Y+ny*step
+		if s.terrainChecker.CheckCollisionWithLayer(testX, testY, collider.Width, collider.Height, layer) {
+			continue
+		}
+		pos.X, pos.Y = testX, testY
+		if vel := entity.GetVelocity(); vel != nil {
+			// The normal points out of the wall
+			removeVelocityInto(vel, -nx, -ny)
+		}
+		return true
+	}
+	return false
+}
+
 // CheckCollision checks if two entities are colliding.
 func CheckCollision(e1, e2 *Entity) bool {
 	if !e1.HasComponent("position") || !e1.HasComponent("collider") ||
@@ -1,7 +1,10 @@
 package engine
 
 import (
+	"math"
 	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
 )
 
 func TestCollisionSystemCreation(t *testing.T) {
@@ -396,3 +399,126 @@ func BenchmarkMovementSystem(b *testing.B) {
 		system.Update(entities, 0.016)
 	}
 }
+
+// collisionTestEntity creates a 16x16 centered collider at (x, y).
+func collisionTestEntity(world *World, x, y, vx float64, response CollisionResponse, mass float64) *Entity {
+	e := world.CreateEntity()
+	e.AddComponent(&PositionComponent{X: x, Y: y})
+	e.AddComponent(&VelocityComponent{VX: vx})
+	e.AddComponent(&ColliderComponent{
+		Width: 16, Height: 16, Solid: true,
+		OffsetX: -8, OffsetY: -8,
+		Response: response, Mass: mass,
+	})
+	return e
+}
+
+func TestCollisionSystemPushResponse(t *testing.T) {
+	world := NewWorld()
+	system := NewCollisionSystem(32.0)
+	heavy := collisionTestEntity(world, 0, 0, 50, CollisionPush, 10)
+	light := collisionTestEntity(world, 10, 0, 0, CollisionBlock, 1)
+	world.Update(0)
+
+	system.Update(world.GetEntities(), 0.016)
+
+	// 6px overlap split by inverse mass: the light entity takes 10/11 of it
+	heavyPos, lightPos := heavy.GetPosition(), light.GetPosition()
+	if math.Abs(lightPos.X-(10+6*10.0/11)) > 1e-9 {
+		t.Errorf("light entity X = %f, want %f", lightPos.X, 10+6*10.0/11)
+	}
+	if math.Abs(heavyPos.X-(-6*1.0/11)) > 1e-9 {
+		t.Errorf("heavy entity X = %f, want %f", heavyPos.X, -6*1.0/11)
+	}
+	if heavy.GetVelocity().VX != 50 {
+		t.Errorf("pusher VX = %f, want it to keep moving at 50", heavy.GetVelocity().VX)
+	}
+	if gap := lightPos.X - heavyPos.X; gap < 16-1e-9 {
+		t.Errorf("entities still overlap after the push: centers %f apart", gap)
+	}
+}
+
+func TestCollisionSystemPushNeedsMoreMass(t *testing.T) {
+	world := NewWorld()
+	system := NewCollisionSystem(32.0)
+	pusher := collisionTestEntity(world, 0, 0, 50, CollisionPush, 1)
+	boulder := collisionTestEntity(world, 10, 0, 0, CollisionBlock, 5)
+	world.Update(0)
+
+	system.Update(world.GetEntities(), 0.016)
+
+	// Lighter pushers block like everyone else
+	if pusher.GetPosition().X != -3 || boulder.GetPosition().X != 13 {
+		t.Errorf("positions = %f, %f; want an even split", pusher.GetPosition().X, boulder.GetPosition().X)
+	}
+	if pusher.GetVelocity().VX != 0 {
+		t.Errorf("blocked pusher VX = %f, want 0", pusher.GetVelocity().VX)
+	}
+}
+
+func TestCollisionSystemSlideAlongDiagonalWall(t *testing.T) {
+	terr := terrain.NewTerrain(4, 4, 1)
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			terr.SetTile(x, y, terrain.TileFloor)
+		}
+	}
+	terr.SetTile(1, 1, terrain.TileWallNE) // Solid where x+y > 96
+
+	run := func(response CollisionResponse) (*PositionComponent, *VelocityComponent) {
+		checker := NewTerrainCollisionChecker(32, 32)
+		checker.SetTerrain(terr)
+		system := NewCollisionSystem(32.0)
+		system.SetTerrainChecker(checker)
+
+		world := NewWorld()
+		e := world.CreateEntity()
+		e.AddComponent(&PositionComponent{X: 46, Y: 46})
+		e.AddComponent(&VelocityComponent{VX: 100})
+		e.AddComponent(&ColliderComponent{Width: 8, Height: 8, Solid: true, Response: response})
+		world.Update(0)
+		system.Update(world.GetEntities(), 0.016)
+		return e.GetPosition(), e.GetVelocity()
+	}
+
+	pos, vel := run(CollisionSlide)
+	checker := NewTerrainCollisionChecker(32, 32)
+	checker.SetTerrain(terr)
+	if checker.CheckCollision(pos.X, pos.Y, 8, 8) {
+		t.Fatalf("sliding entity still inside the wall at (%f, %f)", pos.X, pos.Y)
+	}
+
+	// Moving right into a / wall: the part along the wall (up-right) is kept
+	tangent := (vel.VX - vel.VY) / math.Sqrt2
+	normal := -(vel.VX + vel.VY) / math.Sqrt2
+	if math.Abs(tangent-100/math.Sqrt2) > 1e-9 {
+		t.Errorf("tangential speed = %f, want %f", tangent, 100/math.Sqrt2)
+	}
+	if math.Abs(normal) > 1e-9 {
+		t.Errorf("speed into the wall = %f, want 0", normal)
+	}
+
+	if _, blocked := run(CollisionBlock); blocked.VX != 0 || blocked.VY != 0 {
+		t.Errorf("blocking entity velocity = (%f, %f), want stopped", blocked.VX, blocked.VY)
+	}
+}
+
+func TestTerrainCollisionChecker_ContactNormal(t *testing.T) {
+	terr := terrain.NewTerrain(3, 3, 1)
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 3; x++ {
+			terr.SetTile(x, y, terrain.TileFloor)
+		}
+	}
+	terr.SetTile(2, 1, terrain.TileWall)
+	checker := NewTerrainCollisionChecker(32, 32)
+	checker.SetTerrain(terr)
+
+	nx, ny, ok := checker.ContactNormal(56, 40, 68, 52, 0)
+	if !ok || nx != -1 || ny != 0 {
+		t.Errorf("normal of a wall to the right = (%f, %f, %v), want (-1, 0)", nx, ny, ok)
+	}
+	if _, _, ok := checker.ContactNormal(36, 36, 44, 44, 0); ok {
+		t.Error("box on open floor should have no contact normal")
+	}
+}
@@ -9,6 +9,10 @@ import "github.com/opd-ai/venture/pkg/combat"
 type HealthComponent struct {
 	Current float64
 	Max     float64
+
+	// LastDamageType is the type of the most recent typed damage taken,
+	// used to pick a death effect for the killing blow
+	LastDamageType combat.DamageType
 }
 
 // Type returns the component type identifier.
@@ -42,6 +46,12 @@ func (h *HealthComponent) TakeDamage(amount float64) {
 	}
 }
 
+// TakeTypedDamage reduces health like TakeDamage and records the damage type.
+func (h *HealthComponent) TakeTypedDamage(amount float64, damageType combat.DamageType) {
+	h.LastDamageType = damageType
+	h.TakeDamage(amount)
+}
+
 // StatsComponent contains combat statistics for an entity.
 type StatsComponent struct {
 	// Base stats
@@ -141,6 +151,13 @@ type StatusEffectComponent struct {
 	// Duration remaining in seconds
 	Duration float64
 
+	// MaxDuration is the full duration of the current application in seconds,
+	// used to draw remaining-duration bars
+	MaxDuration float64
+
+	// Stacks is the number of times the effect has been applied while active
+	Stacks int
+
 	// Effect magnitude (meaning depends on effect type)
 	Magnitude float64
 
@@ -149,6 +166,13 @@ type StatusEffectComponent struct {
 
 	// Time until next tick
 	NextTick float64
+
+	// StackPolicy decides how reapplying the effect combines with it
+	StackPolicy StackPolicy
+
+	// Applications holds each application's share when stacked with
+	// StackIndependent; empty otherwise
+	Applications []StatusEffectApplication
 }
 
 // Type returns the component type identifier.
@@ -161,9 +185,11 @@ func (s *StatusEffectComponent) IsExpired() bool {
 	return s.Duration <= 0
 }
 
-// Update updates the effect duration and tick timer.
+// Update updates the effect duration and tick timer. Independent
+// applications that expire drop their share of the magnitude.
 func (s *StatusEffectComponent) Update(deltaTime float64) bool {
 	s.Duration -= deltaTime
+	s.updateApplications(deltaTime)
 
 	if s.TickInterval > 0 {
 		s.NextTick -= deltaTime
@@ -176,15 +202,45 @@ func (s *StatusEffectComponent) Update(deltaTime float64) bool {
 	return false // No tick
 }
 
+// updateApplications ages independent applications, dropping expired ones
+// and setting the magnitude and stack count from those still active.
+func (s *StatusEffectComponent) updateApplications(deltaTime float64) {
+	if len(s.Applications) == 0 {
+		return
+	}
+
+	active := s.Applications[:0]
+	magnitude := 0.0
+	for _, app := range s.Applications {
+		app.Duration -= deltaTime
+		if app.Duration > 0 {
+			active = append(active, app)
+			magnitude += app.Magnitude
+		}
+	}
+	s.Applications = active
+
+	// Once all have expired the effect itself expires, keeping its last
+	// magnitude so stat modifiers are removed correctly
+	if len(active) > 0 {
+		s.Magnitude = magnitude
+		s.Stacks = len(active)
+	}
+}
+
 // Reset clears the component state for reuse from the pool.
 // This method should be called before returning to the pool to prevent
 // memory leaks and ensure clean state for next use.
 func (s *StatusEffectComponent) Reset() {
 	s.EffectType = ""
 	s.Duration = 0
+	s.MaxDuration = 0
+	s.Stacks = 0
 	s.Magnitude = 0
 	s.TickInterval = 0
 	s.NextTick = 0
+	s.StackPolicy = StackRefresh
+	s.Applications = nil
 }
 
 // TeamComponent identifies which team an entity belongs to.
@@ -0,0 +1,108 @@
+// Package engine provides combat state save support.
+// This file implements saving and restoring an entity's active status
+// effects, shield and ability cooldowns, so reloading mid-fight neither
+// clears debuffs and cooldowns nor loses buffs.
+package engine
+
+import (
+	"github.com/opd-ai/venture/pkg/saveload"
+)
+
+// SnapshotCombatState records e's active status effects, shield and ability
+// cooldowns in state. Abilities that are ready are not recorded.
+func SnapshotCombatState(e *Entity, state *saveload.PlayerState) {
+	state.StatusEffects = nil
+	for _, comp := range e.Components {
+		if effect, ok := comp.(*StatusEffectComponent); ok && !effect.IsExpired() {
+			data := saveload.StatusEffectData{
+				EffectType:   effect.EffectType,
+				Duration:     effect.Duration,
+				MaxDuration:  effect.MaxDuration,
+				Stacks:       effect.Stacks,
+				Magnitude:    effect.Magnitude,
+				TickInterval: effect.TickInterval,
+				NextTick:     effect.NextTick,
+				StackPolicy:  effect.StackPolicy.String(),
+			}
+			for _, app := range effect.Applications {
+				data.Applications = append(data.Applications, saveload.StatusEffectApplicationData{
+					Magnitude: app.Magnitude,
+					Duration:  app.Duration,
+				})
+			}
+			state.StatusEffects = append(state.StatusEffects, data)
+		}
+	}
+
+	state.Shield = nil
+	if shieldComp, ok := e.GetComponent("shield"); ok {
+		if shield := shieldComp.(*ShieldComponent); shield.IsActive() {
+			state.Shield = &saveload.ShieldData{
+				Amount:      shield.Amount,
+				MaxAmount:   shield.MaxAmount,
+				Duration:    shield.Duration,
+				MaxDuration: shield.MaxDuration,
+			}
+		}
+	}
+
+	state.Cooldowns = nil
+	for _, cooldown := range e.AbilityCooldowns() {
+		if !cooldown.IsReady() {
+			state.Cooldowns = append(state.Cooldowns, saveload.CooldownData{
+				Ability:   cooldown.Name,
+				Remaining: cooldown.Remaining,
+				Total:     cooldown.Total,
+			})
+		}
+	}
+}
+
+// RestoreCombatState replaces e's status effects, shield and ability
+// cooldowns with those recorded in state. Abilities absent from state
+// become ready.
+//
+// Stat modifiers of the restored effects are not reapplied: saved stats
+// already include them, so call this after restoring stats. Spells and
+// hotbar items must be restored first for their cooldowns to apply.
+func RestoreCombatState(e *Entity, state *saveload.PlayerState) {
+	if comp, ok := e.GetComponent("status_effect"); ok {
+		e.RemoveComponent("status_effect")
+		ReleaseStatusEffect(comp.(*StatusEffectComponent))
+	}
+	for _, data := range state.StatusEffects {
+		effect := NewStatusEffectComponent(data.EffectType, data.Magnitude, data.Duration, data.TickInterval)
+		effect.MaxDuration = data.MaxDuration
+		if data.Stacks > 0 {
+			effect.Stacks = data.Stacks
+		}
+		effect.NextTick = data.NextTick
+		if policy, err := ParseStackPolicy(data.StackPolicy); err == nil {
+			effect.StackPolicy = policy
+		}
+		for _, app := range data.Applications {
+			effect.Applications = append(effect.Applications, StatusEffectApplication{
+				Magnitude: app.Magnitude,
+				Duration:  app.Duration,
+			})
+		}
+		e.AddComponent(effect)
+	}
+
+	e.RemoveComponent("shield")
+	if data := state.Shield; data != nil {
+		e.AddComponent(&ShieldComponent{
+			Amount:      data.Amount,
+			MaxAmount:   data.MaxAmount,
+			Duration:    data.Duration,
+			MaxDuration: data.MaxDuration,
+		})
+	}
+
+	for _, cooldown := range e.AbilityCooldowns() {
+		e.SetAbilityCooldown(cooldown.Name, 0, 0)
+	}
+	for _, data := range state.Cooldowns {
+		e.SetAbilityCooldown(data.Ability, data.Remaining, data.Total)
+	}
+}
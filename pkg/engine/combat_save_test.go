@@ -0,0 +1,125 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/saveload"
+)
+
+func TestCombatState_SaveLoadRoundTrip(t *testing.T) {
+	world := NewWorld()
+	player := newCooldownTestEntity()
+	stats := NewStatsComponent()
+	stats.Attack = 10
+	player.AddComponent(stats)
+
+	effects := NewStatusEffectSystem(world, rand.New(rand.NewSource(1)))
+	effects.ApplyStatusEffect(player, "strength", 0.5, 10.0, 0)
+	effects.ApplyShield(player, 40, 8.0)
+	effects.Update([]*Entity{player}, 3.0)
+
+	attack := player.GetAttack()
+	attack.ResetCooldown()
+	attack.UpdateCooldown(0.25)
+	slotsComp, _ := player.GetComponent("spell_slots")
+	slotsComp.(*SpellSlotComponent).Cooldowns[0] = 2.5
+
+	manager, err := saveload.NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager: %v", err)
+	}
+	state := &saveload.PlayerState{Attack: stats.Attack}
+	SnapshotCombatState(player, state)
+	save := &saveload.GameSave{
+		Version:     saveload.SaveVersion,
+		PlayerState: state,
+		WorldState:  &saveload.WorldState{},
+		Settings:    &saveload.GameSettings{},
+	}
+	if err := manager.SaveGame("combat", save); err != nil {
+		t.Fatalf("SaveGame: %v", err)
+	}
+	loaded, err := manager.LoadGame("combat")
+	if err != nil {
+		t.Fatalf("LoadGame: %v", err)
+	}
+
+	// Restore onto a fresh player with stats taken from the save
+	restored := newCooldownTestEntity()
+	restoredStats := NewStatsComponent()
+	restoredStats.Attack = loaded.PlayerState.Attack
+	restored.AddComponent(restoredStats)
+	RestoreCombatState(restored, loaded.PlayerState)
+
+	effectComp, ok := restored.GetComponent("status_effect")
+	if !ok {
+		t.Fatal("status effect not restored")
+	}
+	effect := effectComp.(*StatusEffectComponent)
+	if effect.EffectType != "strength" || effect.Duration != 7.0 || effect.MaxDuration != 10.0 || effect.Magnitude != 0.5 {
+		t.Errorf("restored effect = %+v, want strength with 7s of 10s left", effect)
+	}
+	if restoredStats.Attack != 15 {
+		t.Errorf("Attack = %f, want 15 (modifier applied once)", restoredStats.Attack)
+	}
+
+	shieldComp, ok := restored.GetComponent("shield")
+	if !ok || shieldComp.(*ShieldComponent).Duration != 5.0 || shieldComp.(*ShieldComponent).Amount != 40 {
+		t.Errorf("shield not restored with 5s remaining: %+v", shieldComp)
+	}
+
+	if remaining, total := restored.AbilityCooldown("attack"); remaining != 0.75 || total != 1.0 {
+		t.Errorf("attack cooldown = %v/%v, want 0.75/1", remaining, total)
+	}
+	if remaining, _ := restored.AbilityCooldown("spell_1"); remaining != 2.5 {
+		t.Errorf("spell cooldown = %v, want 2.5", remaining)
+	}
+
+	// Expiry continues from the remaining duration and removes the buff
+	effects.Update([]*Entity{restored}, 7.5)
+	if restored.HasComponent("status_effect") {
+		t.Error("restored effect should expire after its remaining duration")
+	}
+	if restoredStats.Attack != 10 {
+		t.Errorf("Attack after expiry = %f, want 10", restoredStats.Attack)
+	}
+}
+
+func TestRestoreCombatState_ClearsCurrentState(t *testing.T) {
+	world := NewWorld()
+	player := newCooldownTestEntity()
+	effects := NewStatusEffectSystem(world, rand.New(rand.NewSource(1)))
+	effects.ApplyStatusEffect(player, "poisoned", 2, 5.0, 1.0)
+	effects.ApplyShield(player, 20, 5.0)
+	player.GetAttack().ResetCooldown()
+
+	RestoreCombatState(player, &saveload.PlayerState{})
+
+	if player.HasComponent("status_effect") || player.HasComponent("shield") {
+		t.Error("effects absent from the save should be removed")
+	}
+	if remaining, _ := player.AbilityCooldown("attack"); remaining != 0 {
+		t.Errorf("attack cooldown = %v, want ready", remaining)
+	}
+}
+
+func TestSetAbilityCooldown(t *testing.T) {
+	entity := newCooldownTestEntity()
+
+	if !entity.SetAbilityCooldown("hotbar_1", 3, 6) {
+		t.Fatal("hotbar_1 should exist")
+	}
+	if remaining, total := entity.AbilityCooldown("hotbar_1"); remaining != 3 || total != 6 {
+		t.Errorf("hotbar_1 = %v/%v, want 3/6", remaining, total)
+	}
+	if !entity.SetAbilityCooldown("Fire Bolt", 1, 0) {
+		t.Fatal("spells should be settable by name")
+	}
+	if remaining, _ := entity.AbilityCooldown("spell_1"); remaining != 1 {
+		t.Errorf("spell_1 = %v, want 1", remaining)
+	}
+	if entity.SetAbilityCooldown("spell_9", 1, 0) || entity.SetAbilityCooldown("dash", 1, 0) {
+		t.Error("unknown abilities should report false")
+	}
+}
@@ -158,9 +158,11 @@ func (s *CombatSystem) applyStatusEffectTick(entity *Entity, effect *StatusEffec
 	health := healthComp.(*HealthComponent)
 
 	switch effect.EffectType {
-	case "poison", "burn":
+	case "poison":
 		// Damage over time
-		health.TakeDamage(effect.Magnitude)
+		health.TakeTypedDamage(effect.Magnitude, combat.DamagePoison)
+	case "burn":
+		health.TakeTypedDamage(effect.Magnitude, combat.DamageFire)
 	case "regeneration":
 		// Healing over time
 		health.Heal(effect.Magnitude)
@@ -313,7 +315,7 @@ func (s *CombatSystem) Attack(attacker, target *Entity) bool {
 	}
 
 	// Apply remaining damage to health
-	health.TakeDamage(finalDamage)
+	health.TakeTypedDamage(finalDamage, attack.DamageType)
 
 	// Trigger attack animation for attacker
 	if animComp, hasAnim := attacker.GetComponent("animation"); hasAnim {
@@ -405,37 +407,18 @@ func (s *CombatSystem) Attack(attacker, target *Entity) bool {
 			if flashIntensity > 1.0 {
 				flashIntensity = 1.0
 			}
-			feedback.TriggerFlash(flashIntensity)
+			feedback.TriggerFlash(s.camera.Accessibility.ApplyFlashIntensity(flashIntensity))
 		}
 	}
 
 	// GAP-012 REPAIR: Trigger screen shake on damage
-	// Phase 10.3: Enhanced shake with procedural intensity and duration
+	// Phase 10.3: Shake and hit-stop scale with hit severity
 	if s.camera != nil {
-		// Use advanced shake if available (with duration control)
-		targetHealthComp, _ := target.GetComponent("health")
 		var maxHP float64 = 100 // Default
-		if targetHealthComp != nil {
+		if targetHealthComp, ok := target.GetComponent("health"); ok {
 			maxHP = targetHealthComp.(*HealthComponent).Max
 		}
-
-		// Calculate shake intensity based on damage relative to max HP
-		shakeIntensity := CalculateShakeIntensity(finalDamage, maxHP,
-			CombatShakeScaleFactor, CombatShakeMinIntensity, CombatShakeMaxIntensity)
-		shakeDuration := CalculateShakeDuration(shakeIntensity,
-			CombatShakeBaseDuration, CombatShakeAdditionalDuration, CombatShakeMaxIntensity)
-
-		// Critical hits get extra shake and hit-stop
-		if isCrit {
-			shakeIntensity *= CriticalHitShakeMultiplier
-			shakeDuration *= CriticalHitDurationMultiplier
-
-			// Trigger hit-stop on critical hits
-			s.camera.TriggerHitStop(CriticalHitStopDuration, 0.0)
-		}
-
-		// Use advanced shake if available, fallback to basic
-		s.camera.ShakeAdvanced(shakeIntensity, shakeDuration)
+		s.camera.HitFeedback(finalDamage, maxHP, isCrit)
 	}
 
 	// Reset cooldown
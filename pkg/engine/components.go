@@ -42,6 +42,47 @@ type ColliderComponent struct {
 
 	// Offset from position (for centered colliders)
 	OffsetX, OffsetY float64
+
+	// Response to solid contacts (default: block)
+	Response CollisionResponse
+
+	// Mass used by push responses (0 = 1)
+	Mass float64
+}
+
+// CollisionResponse selects how a solid collider reacts to contacts.
+type CollisionResponse int
+
+const (
+	// CollisionBlock stops movement along the contact axis
+	CollisionBlock CollisionResponse = iota
+	// CollisionPush shoves lighter colliders aside instead of stopping
+	CollisionPush
+	// CollisionSlide keeps the velocity tangent to the contact surface,
+	// including along diagonal walls
+	CollisionSlide
+)
+
+// String returns the string representation of a collision response.
+func (r CollisionResponse) String() string {
+	switch r {
+	case CollisionBlock:
+		return "block"
+	case CollisionPush:
+		return "push"
+	case CollisionSlide:
+		return "slide"
+	default:
+		return "unknown"
+	}
+}
+
+// GetMass returns the collider's mass, treating unset mass as 1.
+func (c *ColliderComponent) GetMass() float64 {
+	if c.Mass <= 0 {
+		return 1
+	}
+	return c.Mass
 }
 
 // Type returns the component type identifier.
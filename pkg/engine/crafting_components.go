@@ -217,6 +217,16 @@ type CraftingStationComponent struct {
 
 	// Available indicates if station is usable (not in use by another player)
 	Available bool
+
+	// Tier is the station's quality tier (1-3); the crafting system scales
+	// the bonuses above by tier. Zero is treated as tier 1.
+	Tier int
+
+	// MaxCharges limits crafts before the station must be refilled with
+	// FuelItem (0 = unlimited). Each craft started here uses one of Charges.
+	MaxCharges int
+	Charges    int
+	FuelItem   string
 }
 
 // Type returns the component type identifier.
@@ -231,6 +241,7 @@ func NewCraftingStationComponent(stationType RecipeType) *CraftingStationCompone
 		BonusSuccessChance:  0.05, // 5% bonus when using station
 		CraftTimeMultiplier: 0.75, // 25% faster at station
 		Available:           true,
+		Tier:                1,
 	}
 }
 
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"math"
 	"testing"
 
 	"github.com/opd-ai/venture/pkg/procgen/item"
@@ -265,6 +266,31 @@ func TestNewCraftingStationComponent(t *testing.T) {
 	}
 }
 
+// TestCraftingStationTierScaling tests that higher tiers scale station bonuses.
+func TestCraftingStationTierScaling(t *testing.T) {
+	tests := []struct {
+		tier           int
+		wantBonus      float64
+		wantMultiplier float64
+	}{
+		{0, 0.05, 0.75},
+		{1, 0.05, 0.75},
+		{2, 0.075, 0.625},
+		{3, 0.10, 0.5},
+	}
+
+	for _, tt := range tests {
+		station := NewCraftingStationComponent(RecipeEnchanting)
+		station.Tier = tt.tier
+		if got := stationSuccessBonus(station); math.Abs(got-tt.wantBonus) > 1e-9 {
+			t.Errorf("tier %d success bonus = %v, want %v", tt.tier, got, tt.wantBonus)
+		}
+		if got := stationTimeMultiplier(station); math.Abs(got-tt.wantMultiplier) > 1e-9 {
+			t.Errorf("tier %d time multiplier = %v, want %v", tt.tier, got, tt.wantMultiplier)
+		}
+	}
+}
+
 // TestNewCraftingProgressComponent tests crafting progress creation.
 func TestNewCraftingProgressComponent(t *testing.T) {
 	recipe := &Recipe{
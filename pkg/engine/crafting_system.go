@@ -13,6 +13,7 @@ package engine
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 
 	"github.com/opd-ai/venture/pkg/procgen"
@@ -169,6 +170,11 @@ func (s *CraftingSystem) StartCraft(entityID uint64, recipe *Recipe, stationID u
 		return nil, fmt.Errorf("failed to consume materials: %w", err)
 	}
 
+	// The craft is committed, so it uses one of the station's charges
+	if stationID != 0 {
+		s.consumeStationCharge(stationID)
+	}
+
 	// Calculate actual craft time (modified by station)
 	actualCraftTime := recipe.CraftTimeSec * craftTimeMultiplier
 
@@ -222,7 +228,7 @@ func (s *CraftingSystem) completeCraft(entityID uint64, progressComp *CraftingPr
 	if progressComp.UsingStationID != 0 {
 		if station, ok := s.world.GetEntity(progressComp.UsingStationID); ok {
 			if stationComp, err := s.getCraftingStationComponent(station); err == nil {
-				stationBonus = stationComp.BonusSuccessChance
+				stationBonus = stationSuccessBonus(stationComp)
 			}
 		}
 	}
@@ -490,10 +496,15 @@ func (s *CraftingSystem) validateAndReserveStation(stationID uint64, recipeType
 		return 0, 1.0, fmt.Errorf("station in use")
 	}
 
+	// Check fuel
+	if stationComp.MaxCharges > 0 && stationComp.Charges <= 0 {
+		return 0, 1.0, fmt.Errorf("station out of charges: refill with %s", stationComp.FuelItem)
+	}
+
 	// Reserve station
 	stationComp.Available = false
 
-	return stationComp.BonusSuccessChance, stationComp.CraftTimeMultiplier, nil
+	return stationSuccessBonus(stationComp), stationTimeMultiplier(stationComp), nil
 }
 
 // releaseStation marks a station as available.
@@ -515,6 +526,78 @@ func (s *CraftingSystem) releaseStation(stationID uint64) {
 	stationComp.Available = true
 }
 
+// consumeStationCharge uses one charge of a station with limited charges.
+func (s *CraftingSystem) consumeStationCharge(stationID uint64) {
+	station, ok := s.world.GetEntity(stationID)
+	if !ok {
+		return
+	}
+
+	stationComp, err := s.getCraftingStationComponent(station)
+	if err != nil || stationComp.MaxCharges == 0 {
+		return
+	}
+
+	if stationComp.Charges > 0 {
+		stationComp.Charges--
+	}
+}
+
+// RefillStation consumes one of the station's fuel items from the entity's
+// inventory and restores the station to full charges.
+func (s *CraftingSystem) RefillStation(entityID, stationID uint64) error {
+	entity, ok := s.world.GetEntity(entityID)
+	if !ok {
+		return fmt.Errorf("entity %d not found", entityID)
+	}
+
+	station, ok := s.world.GetEntity(stationID)
+	if !ok {
+		return fmt.Errorf("station not found")
+	}
+
+	stationComp, err := s.getCraftingStationComponent(station)
+	if err != nil {
+		return err
+	}
+	if stationComp.MaxCharges == 0 {
+		return fmt.Errorf("station does not use charges")
+	}
+	if stationComp.Charges >= stationComp.MaxCharges {
+		return fmt.Errorf("station already fully charged")
+	}
+
+	invComp, err := s.getInventoryComponent(entity)
+	if err != nil {
+		return err
+	}
+
+	fuelIndex := -1
+	for i, itm := range invComp.Items {
+		if itm != nil && itm.Name == stationComp.FuelItem {
+			fuelIndex = i
+			break
+		}
+	}
+	if fuelIndex < 0 {
+		return fmt.Errorf("missing %s", stationComp.FuelItem)
+	}
+	invComp.RemoveItem(fuelIndex)
+
+	stationComp.Charges = stationComp.MaxCharges
+
+	if s.logger != nil && s.logger.Logger.GetLevel() >= logrus.DebugLevel {
+		s.logger.WithFields(logrus.Fields{
+			"entityID":  entityID,
+			"stationID": stationID,
+			"fuelItem":  stationComp.FuelItem,
+			"charges":   stationComp.Charges,
+		}).Debug("refilled crafting station")
+	}
+
+	return nil
+}
+
 // Helper methods for component access
 
 func (s *CraftingSystem) getInventoryComponent(entity *Entity) (*InventoryComponent, error) {
@@ -529,6 +612,29 @@ func (s *CraftingSystem) getInventoryComponent(entity *Entity) (*InventoryCompon
 	return invComp, nil
 }
 
+// stationTierScale returns how strongly a station's tier scales its bonuses.
+func stationTierScale(station *CraftingStationComponent) float64 {
+	switch {
+	case station.Tier >= 3:
+		return 2.0
+	case station.Tier == 2:
+		return 1.5
+	default:
+		return 1.0
+	}
+}
+
+// stationSuccessBonus returns a station's success chance bonus scaled by tier.
+func stationSuccessBonus(station *CraftingStationComponent) float64 {
+	return station.BonusSuccessChance * stationTierScale(station)
+}
+
+// stationTimeMultiplier returns a station's craft time multiplier scaled by
+// tier: a tier 3 station saves twice the time of a tier 1 station.
+func stationTimeMultiplier(station *CraftingStationComponent) float64 {
+	return math.Max(1-(1-station.CraftTimeMultiplier)*stationTierScale(station), 0.1)
+}
+
 func (s *CraftingSystem) getCraftingStationComponent(entity *Entity) (*CraftingStationComponent, error) {
 	comp, ok := entity.GetComponent("crafting_station")
 	if !ok {
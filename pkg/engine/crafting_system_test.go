@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/item"
+)
+
+// TestCraftingSystem_StationCharges tests that crafts use station charges and
+// that depleted stations refuse crafts until refilled.
+func TestCraftingSystem_StationCharges(t *testing.T) {
+	world := NewWorld()
+	player := world.CreateEntity()
+	station := world.CreateEntity()
+
+	stationComp := NewCraftingStationComponent(RecipePotion)
+	stationComp.MaxCharges = 1
+	stationComp.Charges = 1
+	stationComp.FuelItem = "Power Cell"
+	station.AddComponent(stationComp)
+
+	craftingSystem := NewCraftingSystem(world, NewInventorySystem(world), item.NewItemGenerator())
+
+	inv := NewInventoryComponent(20, 100)
+	inv.Gold = 100
+	for i := 0; i < 4; i++ {
+		inv.Items = append(inv.Items, &item.Item{Name: "Healing Herb", Type: item.TypeConsumable})
+	}
+	player.AddComponent(inv)
+	knowledge := NewRecipeKnowledgeComponent(0)
+	player.AddComponent(knowledge)
+	player.AddComponent(NewCraftingSkillComponent())
+	world.Update(0.0)
+
+	recipe := &Recipe{
+		ID:                "potion_recipe",
+		Name:              "Healing Potion",
+		Type:              RecipePotion,
+		Materials:         []MaterialRequirement{{ItemName: "Healing Herb", Quantity: 1}},
+		BaseSuccessChance: 0.75,
+		CraftTimeSec:      4.0,
+		OutputItemType:    item.TypeConsumable,
+		GenreID:           "fantasy",
+	}
+	knowledge.LearnRecipe(recipe)
+
+	result, err := craftingSystem.StartCraft(player.ID, recipe, station.ID)
+	if err != nil || !result.Success {
+		t.Fatalf("first craft failed: %v %+v", err, result)
+	}
+	if stationComp.Charges != 0 {
+		t.Errorf("Charges = %d after craft, want 0", stationComp.Charges)
+	}
+
+	// Finish with the station so it is available again
+	player.RemoveComponent("crafting_progress")
+	craftingSystem.releaseStation(station.ID)
+
+	result, err = craftingSystem.StartCraft(player.ID, recipe, station.ID)
+	if err != nil || result.Success || !strings.Contains(result.ErrorMessage, "out of charges") {
+		t.Fatalf("craft at depleted station = %v %+v, want out of charges", err, result)
+	}
+	if !stationComp.Available {
+		t.Error("refused craft should not reserve the station")
+	}
+
+	if err := craftingSystem.RefillStation(player.ID, station.ID); err == nil {
+		t.Error("refill without fuel should fail")
+	}
+
+	inv.Items = append(inv.Items, &item.Item{Name: "Power Cell", Type: item.TypeConsumable})
+	if err := craftingSystem.RefillStation(player.ID, station.ID); err != nil {
+		t.Fatalf("RefillStation() error = %v", err)
+	}
+	if stationComp.Charges != 1 {
+		t.Errorf("Charges = %d after refill, want 1", stationComp.Charges)
+	}
+	for _, itm := range inv.Items {
+		if itm != nil && itm.Name == "Power Cell" {
+			t.Error("refill did not consume the fuel item")
+		}
+	}
+	if err := craftingSystem.RefillStation(player.ID, station.ID); err == nil {
+		t.Error("refilling a full station should fail")
+	}
+}
@@ -147,6 +147,11 @@ func (ui *CraftingUI) Update(entities []*Entity, deltaTime float64) {
 	}
 	ui.showingProgress = false
 
+	// Refill a station that runs on charges (T for Top up)
+	if inpututil.IsKeyJustPressed(ebiten.KeyT) && ui.stationEntity != nil {
+		ui.refillStation()
+	}
+
 	// Get player's known recipes
 	knowledgeComp, hasKnowledge := ui.playerEntity.GetComponent("recipe_knowledge")
 	if !hasKnowledge {
@@ -267,16 +272,30 @@ func (ui *CraftingUI) attemptCraft(recipe *Recipe) {
 		return
 	}
 
-	// Crafting started successfully
+	// Crafting started successfully; the progress component holds the
+	// craft time after station bonuses
 	craftTime := recipe.CraftTimeSec
-	if stationID != 0 {
-		// Apply station speed bonus
-		craftTime *= 0.75 // 25% faster
+	if progressComp, ok := ui.playerEntity.GetComponent("crafting_progress"); ok {
+		craftTime = progressComp.(*CraftingProgressComponent).RequiredTimeSec
 	}
 	ui.showMessage(fmt.Sprintf("Crafting %s... (%.1fs)", recipe.Name, craftTime))
 	ui.showingProgress = true
 }
 
+// refillStation refills the current station's charges with fuel from the
+// player's inventory.
+func (ui *CraftingUI) refillStation() {
+	if ui.craftingSystem == nil || ui.playerEntity == nil {
+		return
+	}
+
+	if err := ui.craftingSystem.RefillStation(ui.playerEntity.ID, ui.stationEntity.ID); err != nil {
+		ui.showMessage(fmt.Sprintf("Cannot refill: %v", err))
+		return
+	}
+	ui.showMessage("Station refilled")
+}
+
 // showMessage displays a crafting message for 4 seconds.
 func (ui *CraftingUI) showMessage(message string) {
 	ui.craftingMessage = message
@@ -332,7 +351,11 @@ func (ui *CraftingUI) Draw(screen interface{}) {
 	if ui.stationEntity != nil {
 		if stationComp, ok := ui.stationEntity.GetComponent("crafting_station"); ok {
 			station := stationComp.(*CraftingStationComponent)
-			titleText = fmt.Sprintf("CRAFTING - %s Station (+5%% success, 25%% faster)", station.StationType.String())
+			titleText = fmt.Sprintf("CRAFTING - %s Station (+%.0f%% success, %.0f%% faster)", station.StationType.String(),
+				stationSuccessBonus(station)*100, (1-stationTimeMultiplier(station))*100)
+			if station.MaxCharges > 0 {
+				titleText += fmt.Sprintf(" - %d/%d charges, [T] refill with %s", station.Charges, station.MaxCharges, station.FuelItem)
+			}
 		}
 	}
 	ebitenutil.DebugPrintAt(img, titleText, windowX+10, windowY+10)
@@ -435,12 +458,13 @@ func (ui *CraftingUI) Draw(screen interface{}) {
 				station := stationComp.(*CraftingStationComponent)
 				// Check if station type matches recipe type
 				if station.StationType == recipe.Type {
-					bonusChance := successChance + station.BonusSuccessChance
+					stationBonus := stationSuccessBonus(station)
+					bonusChance := successChance + stationBonus
 					if bonusChance > 0.95 {
 						bonusChance = 0.95 // Cap at 95%
 					}
 					successText = fmt.Sprintf("Success: %.0f%% → %.0f%% (station +%.0f%%)",
-						successChance*100, bonusChance*100, station.BonusSuccessChance*100)
+						successChance*100, bonusChance*100, stationBonus*100)
 				}
 			}
 		}
@@ -0,0 +1,220 @@
+// Package engine provides the day/night lighting cycle.
+// This file implements DayNightController, which shifts the lighting
+// system's ambient color and intensity over an in-game clock using
+// genre-specific dawn, day, dusk and night keyframes.
+//
+// Design Philosophy:
+// - Deterministic: lighting depends only on the clock value, never on frame timing
+// - Genre-aware: each genre has its own palette for the cycle
+// - Integration: drives the existing LightingSystem through its config
+package engine
+
+import (
+	"image/color"
+	"math"
+	"sort"
+	"time"
+)
+
+// HoursPerDay is the length of the in-game day on the lighting clock.
+const HoursPerDay = 24.0
+
+// DefaultDayLength is how long an in-game day lasts in real seconds.
+const DefaultDayLength = 20 * 60.0
+
+// DayNightKeyframe is the ambient light at one hour of the day.
+type DayNightKeyframe struct {
+	// Hour is the time of day (0-24)
+	Hour float64
+
+	// AmbientColor is the ambient light color at this hour
+	AmbientColor color.RGBA
+
+	// AmbientIntensity is the ambient light level at this hour (0.0-1.0)
+	AmbientIntensity float64
+}
+
+// DayNightController interpolates ambient lighting through the day.
+// Each update it writes the ambient color and intensity for the current
+// time of day into the lighting system's config.
+type DayNightController struct {
+	system    *LightingSystem
+	keyframes []DayNightKeyframe
+	timeOfDay float64
+
+	// HoursPerSecond advances the clock on each Update. Leave it at 0 when
+	// the clock is set externally, for example from the server in
+	// multiplayer, so clients never drift apart.
+	HoursPerSecond float64
+}
+
+// NewDayNightController creates a controller for the lighting system using
+// the genre's keyframes, starting at noon.
+func NewDayNightController(system *LightingSystem, genreID string) *DayNightController {
+	c := &DayNightController{system: system}
+	c.SetKeyframes(DayNightKeyframesForGenre(genreID))
+	c.SetTimeOfDay(12)
+	return c
+}
+
+// DayNightKeyframesForGenre returns the night, dawn, day and dusk keyframes
+// for a genre, at midnight, 6:00, noon and 18:00.
+func DayNightKeyframesForGenre(genreID string) []DayNightKeyframe {
+	switch genreID {
+	case "fantasy":
+		return []DayNightKeyframe{
+			{Hour: 0, AmbientColor: color.RGBA{50, 60, 100, 255}, AmbientIntensity: 0.15},
+			{Hour: 6, AmbientColor: color.RGBA{200, 140, 110, 255}, AmbientIntensity: 0.45},
+			{Hour: 12, AmbientColor: color.RGBA{255, 245, 220, 255}, AmbientIntensity: 0.9},
+			{Hour: 18, AmbientColor: color.RGBA{230, 130, 80, 255}, AmbientIntensity: 0.5},
+		}
+	case "scifi", "sci-fi": // Support both canonical and hyphenated form
+		return []DayNightKeyframe{
+			{Hour: 0, AmbientColor: color.RGBA{40, 60, 110, 255}, AmbientIntensity: 0.2},
+			{Hour: 6, AmbientColor: color.RGBA{150, 170, 210, 255}, AmbientIntensity: 0.5},
+			{Hour: 12, AmbientColor: color.RGBA{220, 235, 255, 255}, AmbientIntensity: 0.85},
+			{Hour: 18, AmbientColor: color.RGBA{170, 140, 200, 255}, AmbientIntensity: 0.5},
+		}
+	case "horror":
+		return []DayNightKeyframe{
+			{Hour: 0, AmbientColor: color.RGBA{30, 30, 45, 255}, AmbientIntensity: 0.05},
+			{Hour: 6, AmbientColor: color.RGBA{110, 100, 110, 255}, AmbientIntensity: 0.25},
+			{Hour: 12, AmbientColor: color.RGBA{160, 160, 165, 255}, AmbientIntensity: 0.5},
+			{Hour: 18, AmbientColor: color.RGBA{140, 70, 60, 255}, AmbientIntensity: 0.25},
+		}
+	case "cyberpunk":
+		return []DayNightKeyframe{
+			{Hour: 0, AmbientColor: color.RGBA{90, 40, 130, 255}, AmbientIntensity: 0.25},
+			{Hour: 6, AmbientColor: color.RGBA{180, 110, 160, 255}, AmbientIntensity: 0.45},
+			{Hour: 12, AmbientColor: color.RGBA{200, 200, 210, 255}, AmbientIntensity: 0.7},
+			{Hour: 18, AmbientColor: color.RGBA{220, 80, 160, 255}, AmbientIntensity: 0.45},
+		}
+	case "postapoc", "post-apocalyptic": // Support both canonical and hyphenated form
+		return []DayNightKeyframe{
+			{Hour: 0, AmbientColor: color.RGBA{50, 50, 60, 255}, AmbientIntensity: 0.12},
+			{Hour: 6, AmbientColor: color.RGBA{190, 150, 110, 255}, AmbientIntensity: 0.45},
+			{Hour: 12, AmbientColor: color.RGBA{240, 220, 170, 255}, AmbientIntensity: 0.9},
+			{Hour: 18, AmbientColor: color.RGBA{210, 120, 70, 255}, AmbientIntensity: 0.5},
+		}
+	default:
+		return []DayNightKeyframe{
+			{Hour: 0, AmbientColor: color.RGBA{50, 55, 90, 255}, AmbientIntensity: 0.15},
+			{Hour: 6, AmbientColor: color.RGBA{190, 150, 130, 255}, AmbientIntensity: 0.45},
+			{Hour: 12, AmbientColor: color.RGBA{250, 250, 240, 255}, AmbientIntensity: 0.85},
+			{Hour: 18, AmbientColor: color.RGBA{220, 130, 90, 255}, AmbientIntensity: 0.5},
+		}
+	}
+}
+
+// SetKeyframes replaces the keyframes of the cycle. They are copied and
+// sorted by hour; an empty slice is ignored.
+func (c *DayNightController) SetKeyframes(keyframes []DayNightKeyframe) {
+	if len(keyframes) == 0 {
+		return
+	}
+	c.keyframes = make([]DayNightKeyframe, len(keyframes))
+	for i, k := range keyframes {
+		k.Hour = wrapHour(k.Hour)
+		c.keyframes[i] = k
+	}
+	sort.SliceStable(c.keyframes, func(i, j int) bool { return c.keyframes[i].Hour < c.keyframes[j].Hour })
+}
+
+// SetTimeOfDay sets the clock (wrapped into 0-24) and applies the lighting
+// for that hour immediately.
+func (c *DayNightController) SetTimeOfDay(hour float64) {
+	c.timeOfDay = wrapHour(hour)
+	c.apply()
+}
+
+// TimeOfDay returns the current clock value (0-24).
+func (c *DayNightController) TimeOfDay() float64 {
+	return c.timeOfDay
+}
+
+// Update advances the clock by HoursPerSecond and applies the ambient light
+// for the new time of day to the lighting system.
+func (c *DayNightController) Update(entities []*Entity, deltaTime float64) {
+	if c.HoursPerSecond != 0 {
+		c.timeOfDay = wrapHour(c.timeOfDay + c.HoursPerSecond*deltaTime)
+	}
+	c.apply()
+}
+
+// TimeOfDayAt returns the hour of the day at wall-clock time t for a day
+// lasting dayLength real seconds. Clients in multiplayer derive it from the
+// server's timestamps so they all share the server's clock.
+func TimeOfDayAt(t time.Time, dayLength float64) float64 {
+	if dayLength <= 0 {
+		return 12
+	}
+	seconds := float64(t.UnixMilli()) / 1000
+	return wrapHour(seconds / dayLength * HoursPerDay)
+}
+
+// AmbientAt returns the ambient color and intensity for an hour of the day,
+// easing between the surrounding keyframes and wrapping past midnight.
+func (c *DayNightController) AmbientAt(hour float64) (color.RGBA, float64) {
+	if len(c.keyframes) == 0 {
+		return color.RGBA{}, 0
+	}
+	if len(c.keyframes) == 1 {
+		return c.keyframes[0].AmbientColor, c.keyframes[0].AmbientIntensity
+	}
+	hour = wrapHour(hour)
+
+	// Find the keyframes either side, wrapping around midnight
+	next := sort.Search(len(c.keyframes), func(i int) bool { return c.keyframes[i].Hour > hour })
+	prev := next - 1
+	prevHour, nextHour := 0.0, 0.0
+	if prev < 0 {
+		prev = len(c.keyframes) - 1
+		prevHour = c.keyframes[prev].Hour - HoursPerDay
+	} else {
+		prevHour = c.keyframes[prev].Hour
+	}
+	if next == len(c.keyframes) {
+		next = 0
+		nextHour = c.keyframes[next].Hour + HoursPerDay
+	} else {
+		nextHour = c.keyframes[next].Hour
+	}
+
+	t := 0.0
+	if span := nextHour - prevHour; span > 0 {
+		t = (hour - prevHour) / span
+	}
+	// Smoothstep so light changes ease in and out of each keyframe
+	t = t * t * (3 - 2*t)
+
+	a, b := c.keyframes[prev], c.keyframes[next]
+	lerp := func(x, y uint8) uint8 {
+		return uint8(math.Round(float64(x) + (float64(y)-float64(x))*t))
+	}
+	ambient := color.RGBA{
+		R: lerp(a.AmbientColor.R, b.AmbientColor.R),
+		G: lerp(a.AmbientColor.G, b.AmbientColor.G),
+		B: lerp(a.AmbientColor.B, b.AmbientColor.B),
+		A: lerp(a.AmbientColor.A, b.AmbientColor.A),
+	}
+	return ambient, a.AmbientIntensity + (b.AmbientIntensity-a.AmbientIntensity)*t
+}
+
+// apply writes the ambient light for the current time into the lighting
+// system's config.
+func (c *DayNightController) apply() {
+	if c.system == nil || c.system.GetConfig() == nil {
+		return
+	}
+	config := c.system.GetConfig()
+	config.AmbientColor, config.AmbientIntensity = c.AmbientAt(c.timeOfDay)
+}
+
+// wrapHour wraps an hour into the range [0, 24).
+func wrapHour(hour float64) float64 {
+	hour = math.Mod(hour, HoursPerDay)
+	if hour < 0 {
+		hour += HoursPerDay
+	}
+	return hour
+}
@@ -0,0 +1,172 @@
+package engine
+
+import (
+	"image/color"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDayNightController_Keyframes(t *testing.T) {
+	system := NewLightingSystem(NewWorld(), NewLightingConfig())
+	c := NewDayNightController(system, "fantasy")
+	keyframes := DayNightKeyframesForGenre("fantasy")
+
+	// At a keyframe the ambient light matches it exactly
+	for _, k := range keyframes {
+		ambient, intensity := c.AmbientAt(k.Hour)
+		if ambient != k.AmbientColor || math.Abs(intensity-k.AmbientIntensity) > 1e-9 {
+			t.Errorf("hour %.0f = %v/%.2f, want %v/%.2f",
+				k.Hour, ambient, intensity, k.AmbientColor, k.AmbientIntensity)
+		}
+	}
+
+	_, noon := c.AmbientAt(12)
+	_, midnight := c.AmbientAt(0)
+	if noon <= midnight {
+		t.Errorf("noon intensity %.2f should exceed midnight %.2f", noon, midnight)
+	}
+}
+
+func TestDayNightController_Interpolation(t *testing.T) {
+	c := NewDayNightController(nil, "")
+	c.SetKeyframes([]DayNightKeyframe{
+		{Hour: 6, AmbientColor: color.RGBA{0, 0, 0, 255}, AmbientIntensity: 0.2},
+		{Hour: 18, AmbientColor: color.RGBA{200, 100, 50, 255}, AmbientIntensity: 0.8},
+	})
+
+	// Halfway between keyframes is the midpoint
+	ambient, intensity := c.AmbientAt(12)
+	if ambient != (color.RGBA{100, 50, 25, 255}) || math.Abs(intensity-0.5) > 1e-9 {
+		t.Errorf("midpoint = %v/%.2f, want {100 50 25 255}/0.50", ambient, intensity)
+	}
+
+	// Eased: a quarter of the way along moves less than a quarter
+	if _, i := c.AmbientAt(9); i <= 0.2 || i >= 0.35 {
+		t.Errorf("intensity at 9:00 = %.3f, want eased between 0.2 and 0.35", i)
+	}
+
+	// The cycle wraps through midnight from 18:00 back to 6:00
+	_, late := c.AmbientAt(23)
+	_, early := c.AmbientAt(1)
+	if late >= 0.8 || early <= 0.2 || late <= early {
+		t.Errorf("wrap: 23:00 = %.3f, 1:00 = %.3f; want falling from 0.8 to 0.2", late, early)
+	}
+	if _, i := c.AmbientAt(0); math.Abs(i-0.5) > 1e-9 {
+		t.Errorf("midnight = %.3f, want 0.5 halfway through the night", i)
+	}
+}
+
+func TestDayNightController_Continuous(t *testing.T) {
+	c := NewDayNightController(nil, "horror")
+
+	// Small steps in time never cause jumps in light
+	prevColor, prevIntensity := c.AmbientAt(0)
+	for step := 1; step <= 24*60; step++ {
+		ambient, intensity := c.AmbientAt(float64(step) / 60)
+		if math.Abs(intensity-prevIntensity) > 0.01 {
+			t.Fatalf("intensity jumped at minute %d: %.3f -> %.3f", step, prevIntensity, intensity)
+		}
+		if d := int(ambient.R) - int(prevColor.R); d > 2 || d < -2 {
+			t.Fatalf("red jumped at minute %d: %d -> %d", step, prevColor.R, ambient.R)
+		}
+		prevColor, prevIntensity = ambient, intensity
+	}
+}
+
+func TestDayNightController_DrivesLightingSystem(t *testing.T) {
+	config := NewLightingConfig()
+	system := NewLightingSystem(NewWorld(), config)
+	c := NewDayNightController(system, "scifi")
+
+	c.SetTimeOfDay(0)
+	wantColor, wantIntensity := c.AmbientAt(0)
+	if config.AmbientColor != wantColor || config.AmbientIntensity != wantIntensity {
+		t.Errorf("config = %v/%.2f, want %v/%.2f",
+			config.AmbientColor, config.AmbientIntensity, wantColor, wantIntensity)
+	}
+
+	// Update advances the clock and applies the new lighting
+	c.HoursPerSecond = 2
+	c.Update(nil, 1.5)
+	if math.Abs(c.TimeOfDay()-3) > 1e-9 {
+		t.Errorf("TimeOfDay = %.2f, want 3", c.TimeOfDay())
+	}
+	wantColor, wantIntensity = c.AmbientAt(3)
+	if config.AmbientColor != wantColor || config.AmbientIntensity != wantIntensity {
+		t.Error("Update should apply the lighting for the new time")
+	}
+
+	// The clock wraps past midnight
+	c.SetTimeOfDay(-2)
+	if c.TimeOfDay() != 22 {
+		t.Errorf("TimeOfDay = %.2f, want 22", c.TimeOfDay())
+	}
+}
+
+func TestDayNightController_Deterministic(t *testing.T) {
+	// Clients given the same clock value agree, however they got there
+	a := NewDayNightController(nil, "cyberpunk")
+	b := NewDayNightController(nil, "cyberpunk")
+	a.HoursPerSecond = 1
+	for i := 0; i < 100; i++ {
+		a.Update(nil, 0.07)
+	}
+	b.SetTimeOfDay(a.TimeOfDay())
+
+	colorA, intensityA := a.AmbientAt(a.TimeOfDay())
+	colorB, intensityB := b.AmbientAt(b.TimeOfDay())
+	if colorA != colorB || intensityA != intensityB {
+		t.Errorf("same clock gave %v/%.3f and %v/%.3f", colorA, intensityA, colorB, intensityB)
+	}
+}
+
+func TestDayNightKeyframesForGenre(t *testing.T) {
+	for _, genreID := range []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc", "unknown"} {
+		keyframes := DayNightKeyframesForGenre(genreID)
+		if len(keyframes) != 4 {
+			t.Errorf("%s: got %d keyframes, want 4", genreID, len(keyframes))
+		}
+	}
+	if DayNightKeyframesForGenre("sci-fi")[2] != DayNightKeyframesForGenre("scifi")[2] {
+		t.Error("hyphenated genre ID should match the canonical one")
+	}
+	if DayNightKeyframesForGenre("horror")[0].AmbientIntensity >= DayNightKeyframesForGenre("fantasy")[0].AmbientIntensity {
+		t.Error("horror nights should be darker than fantasy nights")
+	}
+}
+
+func TestTimeOfDayAt(t *testing.T) {
+	serverTime := time.Unix(1_700_000_000, 0)
+	hour := TimeOfDayAt(serverTime, DefaultDayLength)
+	if hour < 0 || hour >= HoursPerDay {
+		t.Fatalf("TimeOfDayAt = %.2f, want within a day", hour)
+	}
+
+	// A quarter of a day later the clock is six hours on
+	later := serverTime.Add(time.Duration(DefaultDayLength/4) * time.Second)
+	if got := wrapHour(TimeOfDayAt(later, DefaultDayLength) - hour); math.Abs(got-6) > 1e-6 {
+		t.Errorf("quarter day advanced the clock %.4f hours, want 6", got)
+	}
+
+	if got := TimeOfDayAt(serverTime, 0); got != 12 {
+		t.Errorf("zero day length = %.2f, want noon", got)
+	}
+}
+
+func TestEbitenGame_LightingPresetKeepsDayNight(t *testing.T) {
+	game := NewEbitenGame(320, 240)
+	if game.DayNight == nil {
+		t.Fatal("game should create a day/night controller with the lighting system")
+	}
+	game.EnableLighting(true)
+	game.DayNight.SetTimeOfDay(0)
+
+	game.SetLightingGenrePreset("horror")
+	config := game.LightingSystem.GetConfig()
+	wantColor, wantIntensity := NewDayNightController(nil, "horror").AmbientAt(0)
+	if config.AmbientColor != wantColor || config.AmbientIntensity != wantIntensity {
+		t.Errorf("ambient after preset = %v/%.2f, want horror midnight %v/%.2f",
+			config.AmbientColor, config.AmbientIntensity, wantColor, wantIntensity)
+	}
+}
@@ -0,0 +1,142 @@
+// Package engine provides damage-type death effects.
+// This file chooses the particles and sound played when an entity dies based
+// on the damage type of the killing blow: fire leaves ash and flames, ice
+// shatters, physical damage sprays blood, and so on.
+package engine
+
+import (
+	"github.com/opd-ai/venture/pkg/audio/sfx"
+	"github.com/opd-ai/venture/pkg/combat"
+	"github.com/opd-ai/venture/pkg/rendering/particles"
+)
+
+// DeathEffect describes the particles and sound for one kind of death.
+type DeathEffect struct {
+	// Particles is the primary burst spawned at the corpse
+	Particles particles.ParticleType
+
+	// Secondary is an optional follow-up burst (e.g. ash after flames)
+	Secondary particles.ParticleType
+
+	// HasSecondary reports whether Secondary should be spawned
+	HasSecondary bool
+
+	// Count is the number of particles in the primary burst
+	Count int
+
+	// Gravity applied to the primary burst (negative floats upward)
+	Gravity float64
+
+	// SFX is the sound effect type played on death
+	SFX sfx.EffectType
+}
+
+// deathEffects maps killing damage types to their death effects.
+var deathEffects = map[combat.DamageType]DeathEffect{
+	combat.DamagePhysical: {
+		Particles: particles.ParticleBlood,
+		Count:     30,
+		Gravity:   300.0,
+		SFX:       sfx.EffectDeath,
+	},
+	combat.DamageMagical: {
+		Particles: particles.ParticleMagic,
+		Count:     30,
+		Gravity:   -50.0,
+		SFX:       sfx.EffectMagic,
+	},
+	combat.DamageFire: {
+		Particles:    particles.ParticleFlame,
+		Secondary:    particles.ParticleSmoke, // Ash
+		HasSecondary: true,
+		Count:        25,
+		Gravity:      -80.0,
+		SFX:          sfx.EffectBurn,
+	},
+	combat.DamageIce: {
+		Particles: particles.ParticleSpark, // Ice shards
+		Count:     35,
+		Gravity:   250.0,
+		SFX:       sfx.EffectShatter,
+	},
+	combat.DamageLightning: {
+		Particles:    particles.ParticleSpark,
+		Secondary:    particles.ParticleSmoke,
+		HasSecondary: true,
+		Count:        25,
+		Gravity:      0,
+		SFX:          sfx.EffectLaser,
+	},
+	combat.DamagePoison: {
+		Particles: particles.ParticleSmoke,
+		Count:     20,
+		Gravity:   -30.0,
+		SFX:       sfx.EffectDeath,
+	},
+}
+
+// DeathEffectFor returns the death effect for a killing damage type.
+// Unknown damage types fall back to the physical effect.
+func DeathEffectFor(damageType combat.DamageType) DeathEffect {
+	if effect, ok := deathEffects[damageType]; ok {
+		return effect
+	}
+	return deathEffects[combat.DamagePhysical]
+}
+
+// KillingDamageType returns the damage type of the blow that killed an
+// entity, or DamagePhysical if it has no health component.
+func KillingDamageType(entity *Entity) combat.DamageType {
+	if healthComp, ok := entity.GetComponent("health"); ok {
+		return healthComp.(*HealthComponent).LastDamageType
+	}
+	return combat.DamagePhysical
+}
+
+// SpawnDeathEffect spawns the death particles for an entity at its position
+// and returns the effect so the caller can play its SFX. The particle
+// system may be nil, in which case only the effect is returned.
+func (ps *ParticleSystem) SpawnDeathEffect(world *World, entity *Entity, seed int64, genreID string) DeathEffect {
+	effect := DeathEffectFor(KillingDamageType(entity))
+	if ps == nil || world == nil {
+		return effect
+	}
+
+	posComp, ok := entity.GetComponent("position")
+	if !ok {
+		return effect
+	}
+	pos := posComp.(*PositionComponent)
+
+	ps.SpawnParticles(world, particles.Config{
+		Type:     effect.Particles,
+		Count:    effect.Count,
+		GenreID:  genreID,
+		Seed:     seed,
+		Duration: 1.2,
+		SpreadX:  140.0,
+		SpreadY:  140.0,
+		Gravity:  effect.Gravity,
+		MinSize:  2.0,
+		MaxSize:  5.0,
+		Custom:   make(map[string]interface{}),
+	}, pos.X, pos.Y)
+
+	if effect.HasSecondary {
+		ps.SpawnParticles(world, particles.Config{
+			Type:     effect.Secondary,
+			Count:    effect.Count / 2,
+			GenreID:  genreID,
+			Seed:     seed + 1,
+			Duration: 2.0,
+			SpreadX:  60.0,
+			SpreadY:  60.0,
+			Gravity:  -20.0, // Drifts upward
+			MinSize:  2.0,
+			MaxSize:  4.0,
+			Custom:   make(map[string]interface{}),
+		}, pos.X, pos.Y)
+	}
+
+	return effect
+}
@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/audio/sfx"
+	"github.com/opd-ai/venture/pkg/combat"
+	"github.com/opd-ai/venture/pkg/rendering/particles"
+)
+
+// killWith creates an entity and kills it with damage of the given type.
+func killWith(world *World, damageType combat.DamageType) *Entity {
+	entity := world.CreateEntity()
+	entity.AddComponent(&PositionComponent{X: 50, Y: 80})
+	health := &HealthComponent{Current: 10, Max: 10}
+	entity.AddComponent(health)
+	health.TakeTypedDamage(25, damageType)
+	return entity
+}
+
+// spawnedParticleTypes returns the particle types of all emitters in the world.
+func spawnedParticleTypes(world *World) map[particles.ParticleType]bool {
+	types := make(map[particles.ParticleType]bool)
+	for _, entity := range world.GetEntities() {
+		if comp, ok := entity.GetComponent("particle_emitter"); ok {
+			types[comp.(*ParticleEmitterComponent).EmitConfig.Type] = true
+		}
+	}
+	return types
+}
+
+func TestHealthComponent_TakeTypedDamage(t *testing.T) {
+	health := &HealthComponent{Current: 10, Max: 10}
+	health.TakeTypedDamage(4, combat.DamageIce)
+	if health.Current != 6 || health.LastDamageType != combat.DamageIce {
+		t.Errorf("health = %v (%v), want 6 (ice)", health.Current, health.LastDamageType)
+	}
+
+	health.TakeTypedDamage(20, combat.DamageFire)
+	if health.Current != 0 || health.LastDamageType != combat.DamageFire {
+		t.Errorf("health = %v (%v), want 0 (fire)", health.Current, health.LastDamageType)
+	}
+}
+
+func TestSpawnDeathEffect_Fire(t *testing.T) {
+	world := NewWorld()
+	ps := NewParticleSystem()
+	entity := killWith(world, combat.DamageFire)
+
+	effect := ps.SpawnDeathEffect(world, entity, 42, "fantasy")
+	world.Update(0)
+
+	if effect.SFX != sfx.EffectBurn {
+		t.Errorf("SFX = %q, want %q", effect.SFX, sfx.EffectBurn)
+	}
+	types := spawnedParticleTypes(world)
+	if !types[particles.ParticleFlame] || !types[particles.ParticleSmoke] {
+		t.Errorf("fire death spawned %v, want flame and ash (smoke)", types)
+	}
+	if types[particles.ParticleBlood] {
+		t.Error("fire death should not spawn blood")
+	}
+}
+
+func TestSpawnDeathEffect_Physical(t *testing.T) {
+	world := NewWorld()
+	ps := NewParticleSystem()
+	entity := killWith(world, combat.DamagePhysical)
+
+	effect := ps.SpawnDeathEffect(world, entity, 42, "fantasy")
+	world.Update(0)
+
+	if effect.SFX != sfx.EffectDeath {
+		t.Errorf("SFX = %q, want %q", effect.SFX, sfx.EffectDeath)
+	}
+	types := spawnedParticleTypes(world)
+	if !types[particles.ParticleBlood] {
+		t.Errorf("physical death spawned %v, want blood", types)
+	}
+	if types[particles.ParticleFlame] {
+		t.Error("physical death should not spawn flames")
+	}
+}
+
+func TestSpawnDeathEffect_Ice(t *testing.T) {
+	world := NewWorld()
+	entity := killWith(world, combat.DamageIce)
+
+	effect := NewParticleSystem().SpawnDeathEffect(world, entity, 42, "scifi")
+	if effect.SFX != sfx.EffectShatter {
+		t.Errorf("SFX = %q, want %q", effect.SFX, sfx.EffectShatter)
+	}
+}
+
+func TestSpawnDeathEffect_NilParticleSystem(t *testing.T) {
+	world := NewWorld()
+	entity := killWith(world, combat.DamageFire)
+
+	var ps *ParticleSystem
+	effect := ps.SpawnDeathEffect(world, entity, 42, "fantasy")
+	world.Update(0)
+
+	if effect.SFX != sfx.EffectBurn {
+		t.Errorf("SFX = %q, want %q", effect.SFX, sfx.EffectBurn)
+	}
+	if len(spawnedParticleTypes(world)) != 0 {
+		t.Error("nil particle system should not spawn particles")
+	}
+}
+
+func TestDeathEffectFor_UnknownFallsBackToPhysical(t *testing.T) {
+	if got := DeathEffectFor(combat.DamageType(99)); got != DeathEffectFor(combat.DamagePhysical) {
+		t.Errorf("unknown damage type effect = %+v, want physical", got)
+	}
+}
@@ -0,0 +1,312 @@
+// Package engine provides configurable player death handling.
+// This file implements DeathModeHandler which decides what happens when a
+// player dies: hardcore (permadeath, save deleted), roguelike (run restarts
+// while meta-progression is kept), or casual (respawn at checkpoint with a
+// small penalty).
+//
+// In casual mode a dead player stays down like any other death (loot is
+// dropped and a DeadComponent added), so RevivalSystem can let teammates
+// revive them and apply shared lives and respawn waves. The handler only
+// respawns the player at the checkpoint once nobody else can bring them
+// back.
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+// DeathMode selects how player death is handled.
+type DeathMode int
+
+const (
+	// DeathModeCasual respawns the player at the last checkpoint with a small
+	// penalty unless a teammate or respawn wave brings them back first
+	DeathModeCasual DeathMode = iota
+	// DeathModeRoguelike ends the current run but keeps meta-progression
+	DeathModeRoguelike
+	// DeathModeHardcore is permadeath: the save is flagged for deletion
+	DeathModeHardcore
+)
+
+// String returns the string representation of a death mode.
+func (m DeathMode) String() string {
+	switch m {
+	case DeathModeCasual:
+		return "casual"
+	case DeathModeRoguelike:
+		return "roguelike"
+	case DeathModeHardcore:
+		return "hardcore"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseDeathMode converts a string (casual, roguelike, hardcore) to a DeathMode.
+func ParseDeathMode(s string) (DeathMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "casual", "":
+		return DeathModeCasual, nil
+	case "roguelike":
+		return DeathModeRoguelike, nil
+	case "hardcore":
+		return DeathModeHardcore, nil
+	default:
+		return DeathModeCasual, fmt.Errorf("unknown death mode: %q", s)
+	}
+}
+
+// DefaultCheckpointRespawnDelay is how long in seconds a casual-mode player
+// stays dead before respawning at the checkpoint.
+const DefaultCheckpointRespawnDelay = 3.0
+
+// DeathOutcome describes the result of handling a player death.
+type DeathOutcome struct {
+	// Mode that produced this outcome
+	Mode DeathMode
+
+	// AwaitRespawn is true if the player stays dead until revived by a
+	// teammate, a respawn wave, or the checkpoint respawn (casual)
+	AwaitRespawn bool
+
+	// RestartRun is true if the current run should end and a new one begin (roguelike)
+	RestartRun bool
+
+	// DeleteSave is true if the character's saves should be deleted (hardcore)
+	DeleteSave bool
+}
+
+// DeathModeHandler applies the configured death mode to dying players.
+// It is also a System: in casual mode its Update respawns dead players at
+// the checkpoint.
+type DeathModeHandler struct {
+	// Mode is the active death mode
+	Mode DeathMode
+
+	// GoldPenalty is the fraction of gold lost on casual respawn (0.0-1.0)
+	// Default: 0.1 (10% gold)
+	GoldPenalty float64
+
+	// RespawnDelay is how long in seconds a casual-mode player stays dead
+	// before the checkpoint respawn. Default: DefaultCheckpointRespawnDelay
+	RespawnDelay float64
+
+	// Checkpoint position used for casual respawn
+	checkpointX, checkpointY float64
+	hasCheckpoint            bool
+
+	// saveMarkedForDeletion is set once a hardcore death occurs
+	saveMarkedForDeletion bool
+
+	// revival, if set, gets the first chance to bring dead players back
+	revival *RevivalSystem
+
+	// deadTime tracks how long each dead player has been down, by entity ID
+	deadTime map[uint64]float64
+
+	// run counts roguelike runs started after the first
+	run int
+
+	// onOutcome is invoked after each handled death
+	onOutcome func(player *Entity, outcome DeathOutcome)
+}
+
+// NewDeathModeHandler creates a death handler for the given mode.
+func NewDeathModeHandler(mode DeathMode) *DeathModeHandler {
+	return &DeathModeHandler{
+		Mode:         mode,
+		GoldPenalty:  0.1,
+		RespawnDelay: DefaultCheckpointRespawnDelay,
+		deadTime:     make(map[uint64]float64),
+	}
+}
+
+// SetCheckpoint records the position where casual-mode players respawn.
+func (h *DeathModeHandler) SetCheckpoint(x, y float64) {
+	h.checkpointX = x
+	h.checkpointY = y
+	h.hasCheckpoint = true
+}
+
+// GetCheckpoint returns the current checkpoint and whether one has been set.
+func (h *DeathModeHandler) GetCheckpoint() (x, y float64, ok bool) {
+	return h.checkpointX, h.checkpointY, h.hasCheckpoint
+}
+
+// SetRevivalSystem hands dead players to revival first: casual-mode
+// checkpoint respawns wait while a teammate is alive to revive them or a
+// respawn wave is pending, and never happen once the party's shared lives
+// have run out.
+func (h *DeathModeHandler) SetRevivalSystem(revival *RevivalSystem) {
+	h.revival = revival
+}
+
+// SetOutcomeCallback sets a function called after each handled player death.
+// Use it to delete saves (hardcore) or start a new run (roguelike).
+func (h *DeathModeHandler) SetOutcomeCallback(callback func(player *Entity, outcome DeathOutcome)) {
+	h.onOutcome = callback
+}
+
+// IsSaveMarkedForDeletion returns true once a hardcore death has occurred.
+func (h *DeathModeHandler) IsSaveMarkedForDeletion() bool {
+	return h.saveMarkedForDeletion
+}
+
+// HandlePlayerDeath applies the configured death mode to a player who has
+// just died. Callers should run their normal death processing (dropping
+// loot, adding a DeadComponent) unless RestartRun is set, in which case the
+// outcome callback has replaced the run.
+func (h *DeathModeHandler) HandlePlayerDeath(player *Entity) DeathOutcome {
+	outcome := DeathOutcome{Mode: h.Mode}
+
+	switch h.Mode {
+	case DeathModeHardcore:
+		h.saveMarkedForDeletion = true
+		outcome.DeleteSave = true
+	case DeathModeRoguelike:
+		h.run++
+		outcome.RestartRun = true
+	default:
+		h.deadTime[player.ID] = 0
+		outcome.AwaitRespawn = true
+	}
+
+	if h.onOutcome != nil {
+		h.onOutcome(player, outcome)
+	}
+
+	return outcome
+}
+
+// Update respawns casual-mode players at the checkpoint once they have been
+// dead for RespawnDelay seconds and revival cannot bring them back.
+func (h *DeathModeHandler) Update(entities []*Entity, deltaTime float64) {
+	if h.Mode != DeathModeCasual {
+		return
+	}
+
+	dead := make(map[uint64]bool, len(h.deadTime))
+	for _, entity := range entities {
+		if !IsPlayerRevivable(entity) {
+			continue
+		}
+		dead[entity.ID] = true
+
+		h.deadTime[entity.ID] += deltaTime
+		if h.deadTime[entity.ID] < h.RespawnDelay || !h.checkpointRespawnAllowed(entity, entities) {
+			continue
+		}
+		h.respawnAtCheckpoint(entity)
+		delete(h.deadTime, entity.ID)
+	}
+
+	// Players revived by teammates or waves, or removed from the world
+	for id := range h.deadTime {
+		if !dead[id] {
+			delete(h.deadTime, id)
+		}
+	}
+}
+
+// checkpointRespawnAllowed reports whether a dead player should respawn at
+// the checkpoint rather than wait for revival.
+func (h *DeathModeHandler) checkpointRespawnAllowed(player *Entity, entities []*Entity) bool {
+	if h.revival == nil {
+		return true
+	}
+	if h.revival.TimeUntilWave() > 0 || !h.revival.CanBeRevived(player) {
+		return false
+	}
+	for _, entity := range entities {
+		if entity != player && entity.HasComponent("input") && !entity.HasComponent("dead") {
+			return false // A living teammate can revive them
+		}
+	}
+	return true
+}
+
+// respawnAtCheckpoint restores a player at the checkpoint and applies the
+// gold penalty.
+func (h *DeathModeHandler) respawnAtCheckpoint(player *Entity) {
+	if health := player.GetHealth(); health != nil {
+		health.Current = health.Max
+	}
+	h.moveToCheckpoint(player)
+	player.RemoveComponent("dead")
+
+	if inv := player.GetInventory(); inv != nil && h.GoldPenalty > 0 {
+		inv.Gold -= int(float64(inv.Gold) * h.GoldPenalty)
+	}
+}
+
+// moveToCheckpoint places a player at the checkpoint, if one is set, and
+// stops them.
+func (h *DeathModeHandler) moveToCheckpoint(player *Entity) {
+	if h.hasCheckpoint {
+		if pos := player.GetPosition(); pos != nil {
+			pos.X = h.checkpointX
+			pos.Y = h.checkpointY
+		}
+	}
+
+	if vel := player.GetVelocity(); vel != nil {
+		vel.VX = 0
+		vel.VY = 0
+	}
+}
+
+// Run returns the number of roguelike runs restarted so far (0 for the
+// first run).
+func (h *DeathModeHandler) Run() int {
+	return h.run
+}
+
+// RunSeed returns the world seed for the current run, derived from the
+// game's base seed so every restarted run gets a new but reproducible
+// world.
+func (h *DeathModeHandler) RunSeed(baseSeed int64) int64 {
+	if h.run == 0 {
+		return baseSeed
+	}
+	return procgen.NewSeedGenerator(baseSeed).GetSeed("run", h.run)
+}
+
+// StartNewRun resets a player for a fresh roguelike run at the checkpoint.
+// Meta-progression survives: experience, level, skills, known spells and
+// learned recipes are kept. Everything gathered during the run is lost:
+// inventory, gold and equipment, along with active status effects. Health
+// and mana are refilled. Set the new run's checkpoint first.
+func (h *DeathModeHandler) StartNewRun(player *Entity) {
+	if inv := player.GetInventory(); inv != nil {
+		inv.Clear()
+		inv.Gold = 0
+	}
+	if equipComp, ok := player.GetComponent("equipment"); ok {
+		equipComp.(*EquipmentComponent).UnequipAll()
+	}
+
+	// Expire effects so the status effect system removes their modifiers
+	if effectComp, ok := player.GetComponent("status_effect"); ok {
+		effect := effectComp.(*StatusEffectComponent)
+		effect.Duration = 0
+		for i := range effect.Applications {
+			effect.Applications[i].Duration = 0
+		}
+	}
+	player.RemoveComponent("shield")
+
+	if health := player.GetHealth(); health != nil {
+		health.Current = health.Max
+	}
+	if manaComp, ok := player.GetComponent("mana"); ok {
+		mana := manaComp.(*ManaComponent)
+		mana.Current = mana.Max
+	}
+
+	h.moveToCheckpoint(player)
+	player.RemoveComponent("dead")
+	delete(h.deadTime, player.ID)
+}
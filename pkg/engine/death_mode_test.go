@@ -0,0 +1,196 @@
+package engine
+
+import "testing"
+
+func newDyingPlayer() *Entity {
+	player := NewEntity(1)
+	player.AddComponent(&EbitenInput{})
+	player.AddComponent(&PositionComponent{X: 500, Y: 400})
+	player.AddComponent(&VelocityComponent{VX: 10, VY: -5})
+	player.AddComponent(&HealthComponent{Current: 0, Max: 100})
+	inventory := NewInventoryComponent(20, 100)
+	inventory.Gold = 200
+	player.AddComponent(inventory)
+	player.AddComponent(NewDeadComponent(0))
+	return player
+}
+
+func TestParseDeathMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    DeathMode
+		wantErr bool
+	}{
+		{"casual", DeathModeCasual, false},
+		{"", DeathModeCasual, false},
+		{"Roguelike", DeathModeRoguelike, false},
+		{"hardcore", DeathModeHardcore, false},
+		{"nightmare", DeathModeCasual, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDeathMode(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDeathMode(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDeathMode(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeathModeHandler_HardcoreFlagsSaveDeletion(t *testing.T) {
+	handler := NewDeathModeHandler(DeathModeHardcore)
+
+	var callbackOutcome *DeathOutcome
+	handler.SetOutcomeCallback(func(player *Entity, outcome DeathOutcome) {
+		callbackOutcome = &outcome
+	})
+
+	player := newDyingPlayer()
+	outcome := handler.HandlePlayerDeath(player)
+
+	if !outcome.DeleteSave {
+		t.Error("hardcore death should flag save for deletion")
+	}
+	if !handler.IsSaveMarkedForDeletion() {
+		t.Error("IsSaveMarkedForDeletion() = false after hardcore death")
+	}
+	if outcome.AwaitRespawn {
+		t.Error("hardcore death should not respawn")
+	}
+	if !player.HasComponent("dead") {
+		t.Error("hardcore player should remain dead")
+	}
+	if callbackOutcome == nil || !callbackOutcome.DeleteSave {
+		t.Error("outcome callback not invoked with DeleteSave")
+	}
+}
+
+func TestDeathModeHandler_CasualRespawnsAtCheckpoint(t *testing.T) {
+	handler := NewDeathModeHandler(DeathModeCasual)
+	handler.SetCheckpoint(64, 96)
+
+	player := newDyingPlayer()
+	outcome := handler.HandlePlayerDeath(player)
+
+	if !outcome.AwaitRespawn {
+		t.Fatal("casual death should wait for a respawn")
+	}
+	if outcome.DeleteSave || handler.IsSaveMarkedForDeletion() {
+		t.Error("casual death should not delete the save")
+	}
+
+	// The player stays down for the respawn delay first
+	handler.Update([]*Entity{player}, DefaultCheckpointRespawnDelay/2)
+	if !player.HasComponent("dead") {
+		t.Fatal("player respawned before the respawn delay")
+	}
+
+	handler.Update([]*Entity{player}, DefaultCheckpointRespawnDelay)
+	pos := player.GetPosition()
+	if pos.X != 64 || pos.Y != 96 {
+		t.Errorf("position = (%v, %v), want checkpoint (64, 96)", pos.X, pos.Y)
+	}
+	if health := player.GetHealth(); health.Current != health.Max {
+		t.Errorf("health = %v, want %v", health.Current, health.Max)
+	}
+	if player.HasComponent("dead") {
+		t.Error("dead component should be removed on respawn")
+	}
+	if gold := player.GetInventory().Gold; gold != 180 {
+		t.Errorf("gold = %d, want 180 after the 10%% penalty", gold)
+	}
+}
+
+func TestDeathModeHandler_CasualDefersToRevival(t *testing.T) {
+	world := NewWorld()
+	revival := NewRevivalSystem(world)
+	if err := revival.SetPartyRespawnPolicy(PartyRespawnPolicy{SharedLives: 1, WaveInterval: 30}); err != nil {
+		t.Fatal(err)
+	}
+	handler := NewDeathModeHandler(DeathModeCasual)
+	handler.SetRevivalSystem(revival)
+
+	player := newDyingPlayer()
+	teammate := NewEntity(2)
+	teammate.AddComponent(&EbitenInput{})
+	teammate.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	entities := []*Entity{player, teammate}
+
+	// A living teammate can revive, so no checkpoint respawn
+	handler.HandlePlayerDeath(player)
+	revival.Update(entities, 0)
+	handler.Update(entities, DefaultCheckpointRespawnDelay*2)
+	if !player.HasComponent("dead") {
+		t.Fatal("player respawned while a teammate could revive them")
+	}
+
+	// Alone, the player waits for the pending respawn wave
+	handler.Update([]*Entity{player}, DefaultCheckpointRespawnDelay)
+	if !player.HasComponent("dead") {
+		t.Fatal("player respawned at the checkpoint while a wave was pending")
+	}
+	revival.Update([]*Entity{player}, 30)
+	if player.HasComponent("dead") {
+		t.Fatal("the respawn wave should bring the player back")
+	}
+
+	// With the pool empty the next death stays down until the next wave
+	player.GetHealth().Current = 0
+	player.AddComponent(NewDeadComponent(0))
+	handler.HandlePlayerDeath(player)
+	revival.Update([]*Entity{player}, 0)
+	handler.Update([]*Entity{player}, DefaultCheckpointRespawnDelay*2)
+	if !player.HasComponent("dead") {
+		t.Error("player respawned with no shared lives left")
+	}
+	revival.Update([]*Entity{player}, 30)
+	if player.HasComponent("dead") {
+		t.Error("a player out of shared lives should return with the next wave")
+	}
+}
+
+func TestDeathModeHandler_RoguelikeRestartsRun(t *testing.T) {
+	handler := NewDeathModeHandler(DeathModeRoguelike)
+	handler.SetCheckpoint(32, 32)
+	firstSeed := handler.RunSeed(42)
+
+	player := newDyingPlayer()
+	player.GetInventory().Items = append(player.GetInventory().Items, nil)
+	exp := NewExperienceComponent()
+	exp.Level = 5
+	player.AddComponent(exp)
+	player.AddComponent(&ManaComponent{Current: 0, Max: 50})
+
+	outcome := handler.HandlePlayerDeath(player)
+	if !outcome.RestartRun {
+		t.Error("roguelike death should restart the run")
+	}
+	if outcome.DeleteSave || outcome.AwaitRespawn {
+		t.Errorf("unexpected roguelike outcome: %+v", outcome)
+	}
+	if handler.Run() != 1 || handler.RunSeed(42) == firstSeed || handler.RunSeed(42) != handler.RunSeed(42) {
+		t.Errorf("run %d seed %d: want run 1 with a new, stable seed", handler.Run(), handler.RunSeed(42))
+	}
+
+	handler.StartNewRun(player)
+	inv := player.GetInventory()
+	if len(inv.Items) != 0 || inv.Gold != 0 {
+		t.Errorf("inventory = %d items, %d gold; want emptied", len(inv.Items), inv.Gold)
+	}
+	if player.HasComponent("dead") || player.GetHealth().Current != 100 {
+		t.Error("player should start the new run alive at full health")
+	}
+	if comp, _ := player.GetComponent("mana"); comp.(*ManaComponent).Current != 50 {
+		t.Error("mana should be refilled")
+	}
+	if exp.Level != 5 {
+		t.Errorf("level = %d, want meta-progression kept at 5", exp.Level)
+	}
+	if pos := player.GetPosition(); pos.X != 32 || pos.Y != 32 {
+		t.Errorf("position = (%v, %v), want the new checkpoint", pos.X, pos.Y)
+	}
+}
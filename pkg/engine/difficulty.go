@@ -0,0 +1,194 @@
+// Package engine provides difficulty presets.
+// This file implements named difficulty presets (Story, Normal, Hard,
+// Nightmare). Each preset is one coherent set of tuning values that enemy
+// spawning, respawning, loot drops and death handling read from, so the
+// whole game changes difficulty from a single setting.
+package engine
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+// DifficultyPreset selects a named difficulty.
+type DifficultyPreset int
+
+const (
+	// DifficultyStory is for players who want the story: weaker, fewer
+	// enemies, generous loot and no death penalty
+	DifficultyStory DifficultyPreset = iota
+	// DifficultyNormal is the intended experience
+	DifficultyNormal
+	// DifficultyHard has tougher, more numerous enemies and harsher deaths
+	DifficultyHard
+	// DifficultyNightmare is for experts: elite-heavy hordes and scarce loot
+	DifficultyNightmare
+)
+
+// String returns the string representation of a difficulty preset.
+func (p DifficultyPreset) String() string {
+	switch p {
+	case DifficultyStory:
+		return "story"
+	case DifficultyNormal:
+		return "normal"
+	case DifficultyHard:
+		return "hard"
+	case DifficultyNightmare:
+		return "nightmare"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseDifficultyPreset converts a string (story, normal, hard, nightmare)
+// to a DifficultyPreset.
+func ParseDifficultyPreset(s string) (DifficultyPreset, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "story":
+		return DifficultyStory, nil
+	case "normal", "":
+		return DifficultyNormal, nil
+	case "hard":
+		return DifficultyHard, nil
+	case "nightmare":
+		return DifficultyNightmare, nil
+	default:
+		return DifficultyNormal, fmt.Errorf("unknown difficulty: %q", s)
+	}
+}
+
+// DifficultySettings are the tuning values of a difficulty preset.
+type DifficultySettings struct {
+	// Preset these settings were created from
+	Preset DifficultyPreset
+
+	// GenerationDifficulty is passed to procedural generators (0.0-1.0)
+	GenerationDifficulty float64
+
+	// Enemy stat multipliers
+	EnemyHealthMultiplier  float64
+	EnemyDamageMultiplier  float64
+	EnemyDefenseMultiplier float64
+
+	// SpawnBudgetMultiplier scales how many enemies spawn per room
+	SpawnBudgetMultiplier float64
+
+	// EliteChance is the chance (0-1) that a spawned enemy is an elite
+	EliteChance float64
+
+	// LootDropMultiplier scales enemy loot drop chances
+	LootDropMultiplier float64
+
+	// LootRarityBonus shifts dropped item rarity (negative = fewer rare items)
+	LootRarityBonus float64
+
+	// GoldPenalty is the fraction of gold lost on a casual death (0.0-1.0)
+	GoldPenalty float64
+}
+
+// Settings returns the tuning values of the preset. Unknown presets get
+// Normal settings.
+func (p DifficultyPreset) Settings() DifficultySettings {
+	switch p {
+	case DifficultyStory:
+		return DifficultySettings{
+			Preset:                 DifficultyStory,
+			GenerationDifficulty:   0.2,
+			EnemyHealthMultiplier:  0.6,
+			EnemyDamageMultiplier:  0.5,
+			EnemyDefenseMultiplier: 0.8,
+			SpawnBudgetMultiplier:  0.6,
+			EliteChance:            0.03,
+			LootDropMultiplier:     1.5,
+			LootRarityBonus:        0.1,
+			GoldPenalty:            0,
+		}
+	case DifficultyHard:
+		return DifficultySettings{
+			Preset:                 DifficultyHard,
+			GenerationDifficulty:   0.7,
+			EnemyHealthMultiplier:  1.4,
+			EnemyDamageMultiplier:  1.3,
+			EnemyDefenseMultiplier: 1.2,
+			SpawnBudgetMultiplier:  1.3,
+			EliteChance:            0.2,
+			LootDropMultiplier:     0.9,
+			LootRarityBonus:        0,
+			GoldPenalty:            0.25,
+		}
+	case DifficultyNightmare:
+		return DifficultySettings{
+			Preset:                 DifficultyNightmare,
+			GenerationDifficulty:   0.9,
+			EnemyHealthMultiplier:  2.0,
+			EnemyDamageMultiplier:  1.7,
+			EnemyDefenseMultiplier: 1.5,
+			SpawnBudgetMultiplier:  1.6,
+			EliteChance:            0.35,
+			LootDropMultiplier:     0.7,
+			LootRarityBonus:        -0.1,
+			GoldPenalty:            0.5,
+		}
+	default:
+		return DifficultySettings{
+			Preset:                 DifficultyNormal,
+			GenerationDifficulty:   0.5,
+			EnemyHealthMultiplier:  1,
+			EnemyDamageMultiplier:  1,
+			EnemyDefenseMultiplier: 1,
+			SpawnBudgetMultiplier:  1,
+			EliteChance:            DefaultEliteAffixConfig().Chance,
+			LootDropMultiplier:     1,
+			LootRarityBonus:        0,
+			GoldPenalty:            0.1,
+		}
+	}
+}
+
+// GenerationParams returns params with the difficulty's generation
+// difficulty.
+func (s DifficultySettings) GenerationParams(params procgen.GenerationParams) procgen.GenerationParams {
+	params.Difficulty = s.GenerationDifficulty
+	return params
+}
+
+// SpawnCount scales a base enemy count by the spawn budget, never going
+// below one.
+func (s DifficultySettings) SpawnCount(base int) int {
+	return int(math.Max(1, math.Round(float64(base)*s.SpawnBudgetMultiplier)))
+}
+
+// EliteConfig returns config with the difficulty's elite chance.
+func (s DifficultySettings) EliteConfig(config EliteAffixConfig) EliteAffixConfig {
+	config.Chance = s.EliteChance
+	return config
+}
+
+// LootDropChance scales a base drop chance, capped at 1.
+func (s DifficultySettings) LootDropChance(base float64) float64 {
+	return math.Min(1, base*s.LootDropMultiplier)
+}
+
+// ScaleEnemy applies the enemy stat multipliers to a spawned enemy.
+func (s DifficultySettings) ScaleEnemy(enemy *Entity) {
+	if health := enemy.GetHealth(); health != nil {
+		health.Max *= s.EnemyHealthMultiplier
+		health.Current *= s.EnemyHealthMultiplier
+	}
+	if stats := enemy.GetStats(); stats != nil {
+		stats.Attack *= s.EnemyDamageMultiplier
+		stats.Defense *= s.EnemyDefenseMultiplier
+	}
+	if attackComp, ok := enemy.GetComponent("attack"); ok {
+		attackComp.(*AttackComponent).Damage *= s.EnemyDamageMultiplier
+	}
+}
+
+// ApplyToDeathHandler sets the death handler's gold penalty.
+func (s DifficultySettings) ApplyToDeathHandler(handler *DeathModeHandler) {
+	handler.GoldPenalty = s.GoldPenalty
+}
@@ -0,0 +1,199 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+func TestParseDifficultyPreset(t *testing.T) {
+	for _, preset := range []DifficultyPreset{DifficultyStory, DifficultyNormal, DifficultyHard, DifficultyNightmare} {
+		parsed, err := ParseDifficultyPreset(preset.String())
+		if err != nil || parsed != preset {
+			t.Errorf("ParseDifficultyPreset(%q) = %v, %v", preset.String(), parsed, err)
+		}
+	}
+
+	if parsed, err := ParseDifficultyPreset(" Nightmare "); err != nil || parsed != DifficultyNightmare {
+		t.Errorf("parsing should ignore case and spaces, got %v, %v", parsed, err)
+	}
+	if parsed, err := ParseDifficultyPreset(""); err != nil || parsed != DifficultyNormal {
+		t.Errorf("empty difficulty should be normal, got %v, %v", parsed, err)
+	}
+	if parsed, err := ParseDifficultyPreset("impossible"); err == nil || parsed != DifficultyNormal {
+		t.Errorf("unknown difficulty should error and fall back to normal, got %v, %v", parsed, err)
+	}
+}
+
+func TestDifficultySettings_PresetsOrdered(t *testing.T) {
+	presets := []DifficultySettings{
+		DifficultyStory.Settings(),
+		DifficultyNormal.Settings(),
+		DifficultyHard.Settings(),
+		DifficultyNightmare.Settings(),
+	}
+
+	for i := 1; i < len(presets); i++ {
+		easier, harder := presets[i-1], presets[i]
+		if harder.EnemyHealthMultiplier <= easier.EnemyHealthMultiplier ||
+			harder.EnemyDamageMultiplier <= easier.EnemyDamageMultiplier ||
+			harder.SpawnBudgetMultiplier <= easier.SpawnBudgetMultiplier ||
+			harder.EliteChance <= easier.EliteChance ||
+			harder.GoldPenalty <= easier.GoldPenalty {
+			t.Errorf("%v should be harder than %v", harder.Preset, easier.Preset)
+		}
+		if harder.LootDropMultiplier >= easier.LootDropMultiplier {
+			t.Errorf("%v should drop less loot than %v", harder.Preset, easier.Preset)
+		}
+		if err := harder.EliteConfig(DefaultEliteAffixConfig()).Validate(); err != nil {
+			t.Errorf("%v elite config invalid: %v", harder.Preset, err)
+		}
+	}
+}
+
+func TestDifficultySettings_NormalIsNeutral(t *testing.T) {
+	normal := DifficultyNormal.Settings()
+
+	if normal.SpawnCount(3) != 3 {
+		t.Errorf("Normal SpawnCount(3) = %d, want 3", normal.SpawnCount(3))
+	}
+	if normal.LootDropChance(0.3) != 0.3 {
+		t.Errorf("Normal LootDropChance(0.3) = %f, want 0.3", normal.LootDropChance(0.3))
+	}
+	if normal.EliteConfig(DefaultEliteAffixConfig()) != DefaultEliteAffixConfig() {
+		t.Error("Normal elite config should match the default")
+	}
+
+	world := NewWorld()
+	enemy := world.CreateEntity()
+	enemy.AddComponent(&HealthComponent{Current: 50, Max: 50})
+	enemy.AddComponent(&AttackComponent{Damage: 10})
+	normal.ScaleEnemy(enemy)
+	if enemy.GetHealth().Max != 50 {
+		t.Errorf("Normal should not scale health, got %f", enemy.GetHealth().Max)
+	}
+}
+
+func TestDifficultySettings_NightmareVsNormal(t *testing.T) {
+	normal := DifficultyNormal.Settings()
+	nightmare := DifficultyNightmare.Settings()
+
+	newEnemy := func() *Entity {
+		enemy := NewWorld().CreateEntity()
+		enemy.AddComponent(&HealthComponent{Current: 100, Max: 100})
+		stats := NewStatsComponent()
+		stats.Attack = 10
+		stats.Defense = 5
+		enemy.AddComponent(stats)
+		enemy.AddComponent(&AttackComponent{Damage: 10})
+		return enemy
+	}
+
+	normalEnemy, nightmareEnemy := newEnemy(), newEnemy()
+	normal.ScaleEnemy(normalEnemy)
+	nightmare.ScaleEnemy(nightmareEnemy)
+
+	if nightmareEnemy.GetHealth().Max <= normalEnemy.GetHealth().Max {
+		t.Error("Nightmare enemies should have more health")
+	}
+	if nightmareEnemy.GetHealth().Current != nightmareEnemy.GetHealth().Max {
+		t.Error("scaled enemies should start at full health")
+	}
+	if nightmareEnemy.GetStats().Attack <= normalEnemy.GetStats().Attack ||
+		nightmareEnemy.GetStats().Defense <= normalEnemy.GetStats().Defense {
+		t.Error("Nightmare enemies should have higher attack and defense")
+	}
+	nightmareAttack, _ := nightmareEnemy.GetComponent("attack")
+	if nightmareAttack.(*AttackComponent).Damage <= 10 {
+		t.Error("Nightmare enemies should deal more damage")
+	}
+
+	if nightmare.SpawnCount(3) <= normal.SpawnCount(3) {
+		t.Errorf("Nightmare spawn budget %d should exceed Normal %d", nightmare.SpawnCount(3), normal.SpawnCount(3))
+	}
+	if nightmare.LootDropChance(0.3) >= normal.LootDropChance(0.3) {
+		t.Error("Nightmare should drop loot less often")
+	}
+	if nightmare.LootRarityBonus >= normal.LootRarityBonus {
+		t.Error("Nightmare should drop fewer rare items")
+	}
+
+	handler := NewDeathModeHandler(DeathModeCasual)
+	nightmare.ApplyToDeathHandler(handler)
+	if handler.GoldPenalty != nightmare.GoldPenalty {
+		t.Errorf("GoldPenalty = %f, want %f", handler.GoldPenalty, nightmare.GoldPenalty)
+	}
+}
+
+func TestDifficultySettings_SpawnCountMinimum(t *testing.T) {
+	story := DifficultyStory.Settings()
+	if story.SpawnCount(1) != 1 {
+		t.Errorf("SpawnCount should never drop below 1, got %d", story.SpawnCount(1))
+	}
+}
+
+// difficultyTestTerrain returns a terrain with several open rooms.
+func difficultyTestTerrain() *terrain.Terrain {
+	terr := terrain.NewTerrain(80, 20, 1)
+	for i := 0; i < 6; i++ {
+		room := &terrain.Room{X: 1 + i*13, Y: 2, Width: 10, Height: 10}
+		for y := room.Y; y < room.Y+room.Height; y++ {
+			for x := room.X; x < room.X+room.Width; x++ {
+				terr.SetTile(x, y, terrain.TileFloor)
+			}
+		}
+		terr.Rooms = append(terr.Rooms, room)
+	}
+	return terr
+}
+
+func TestSpawnEnemiesInTerrainWithDifficulty(t *testing.T) {
+	params := procgen.GenerationParams{Depth: 1, GenreID: "fantasy"}
+
+	spawn := func(preset DifficultyPreset) (int, float64) {
+		world := NewWorld()
+		count, err := SpawnEnemiesInTerrainWithDifficulty(world, difficultyTestTerrain(), 42, params, preset.Settings())
+		if err != nil {
+			t.Fatalf("%v: spawn failed: %v", preset, err)
+		}
+		world.Update(0)
+		totalHealth := 0.0
+		for _, e := range world.GetEntities() {
+			if h := e.GetHealth(); h != nil {
+				totalHealth += h.Max
+			}
+		}
+		return count, totalHealth / float64(count)
+	}
+
+	normalCount, normalHealth := spawn(DifficultyNormal)
+	nightmareCount, nightmareHealth := spawn(DifficultyNightmare)
+
+	if nightmareCount <= normalCount {
+		t.Errorf("Nightmare spawned %d enemies, Normal %d; want more on Nightmare", nightmareCount, normalCount)
+	}
+	if nightmareHealth <= normalHealth {
+		t.Errorf("Nightmare average health %f should exceed Normal %f", nightmareHealth, normalHealth)
+	}
+}
+
+func TestGenerateLootDropWithDifficulty(t *testing.T) {
+	drops := func(preset DifficultyPreset) int {
+		world := NewWorld()
+		count := 0
+		for i := 0; i < 200; i++ {
+			enemy := world.CreateEntity()
+			enemy.AddComponent(&PositionComponent{X: 100, Y: 100})
+			if GenerateLootDropWithDifficulty(world, enemy, 100, 100, 7, "fantasy", preset.Settings()) != nil {
+				count++
+			}
+		}
+		return count
+	}
+
+	normal, nightmare, story := drops(DifficultyNormal), drops(DifficultyNightmare), drops(DifficultyStory)
+	if !(nightmare < normal && normal < story) {
+		t.Errorf("loot drops should fall with difficulty: story=%d normal=%d nightmare=%d", story, normal, nightmare)
+	}
+}
@@ -0,0 +1,271 @@
+// Package engine provides elite enemy affixes.
+// This file implements seeded rolling of elite modifiers (shielded, fast,
+// explosive) at spawn time, the stat and behavior changes they grant, and
+// the marker color the renderer uses to distinguish elites.
+package engine
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/combat"
+)
+
+// EliteAffix is a modifier rolled onto an elite enemy.
+type EliteAffix int
+
+const (
+	// AffixShielded grants a damage-absorbing shield that lasts until depleted
+	AffixShielded EliteAffix = iota
+	// AffixFast increases movement and attack speed
+	AffixFast
+	// AffixExplosive detonates when the elite dies, damaging nearby foes
+	AffixExplosive
+)
+
+// eliteAffixCount is the number of defined affixes.
+const eliteAffixCount = 3
+
+// Elite tuning constants
+const (
+	// EliteHealthMultiplier scales the health of every elite
+	EliteHealthMultiplier = 1.5
+	// EliteSizeMultiplier scales elite sprites and colliders
+	EliteSizeMultiplier = 1.2
+	// EliteShieldFraction is the shield strength as a fraction of max health
+	EliteShieldFraction = 0.5
+	// EliteFastSpeedMultiplier scales AI movement speeds of fast elites
+	EliteFastSpeedMultiplier = 1.5
+	// EliteFastCooldownMultiplier scales the attack cooldown of fast elites
+	EliteFastCooldownMultiplier = 0.7
+	// EliteExplosionRadius is the radius of the explosive death blast
+	EliteExplosionRadius = 96.0
+	// EliteExplosionDamageMultiplier scales the blast damage from attack damage
+	EliteExplosionDamageMultiplier = 2.0
+)
+
+// String returns the string representation of an affix.
+func (a EliteAffix) String() string {
+	switch a {
+	case AffixShielded:
+		return "shielded"
+	case AffixFast:
+		return "fast"
+	case AffixExplosive:
+		return "explosive"
+	default:
+		return "unknown"
+	}
+}
+
+// MarkerColor returns the color of the ring drawn around elites with this
+// affix.
+func (a EliteAffix) MarkerColor() color.RGBA {
+	switch a {
+	case AffixShielded:
+		return color.RGBA{80, 200, 255, 255} // Cyan
+	case AffixFast:
+		return color.RGBA{255, 230, 60, 255} // Yellow
+	case AffixExplosive:
+		return color.RGBA{255, 110, 20, 255} // Orange
+	default:
+		return color.RGBA{255, 255, 255, 255}
+	}
+}
+
+// EliteAffixConfig configures how often elites spawn and how many affixes
+// they carry.
+type EliteAffixConfig struct {
+	// Chance (0-1) that a spawned enemy is an elite
+	Chance float64
+
+	// MaxAffixes is the maximum number of affixes per elite (at least 1)
+	MaxAffixes int
+}
+
+// DefaultEliteAffixConfig makes one enemy in ten an elite with up to two
+// affixes.
+func DefaultEliteAffixConfig() EliteAffixConfig {
+	return EliteAffixConfig{Chance: 0.1, MaxAffixes: 2}
+}
+
+// Validate checks that the configuration is usable.
+func (c EliteAffixConfig) Validate() error {
+	if c.Chance < 0 || c.Chance > 1 {
+		return fmt.Errorf("elite chance must be between 0 and 1, got %f", c.Chance)
+	}
+	if c.MaxAffixes < 1 || c.MaxAffixes > eliteAffixCount {
+		return fmt.Errorf("max affixes must be between 1 and %d, got %d", eliteAffixCount, c.MaxAffixes)
+	}
+	return nil
+}
+
+// EliteComponent marks an enemy as an elite and records its affixes.
+type EliteComponent struct {
+	// Affixes carried by the elite, in roll order
+	Affixes []EliteAffix
+
+	// MarkerColor is the ring color drawn around the elite
+	MarkerColor color.RGBA
+
+	// Exploded is set once the explosive affix has detonated
+	Exploded bool
+}
+
+// Type returns the component type identifier.
+func (e *EliteComponent) Type() string {
+	return "elite"
+}
+
+// HasAffix reports whether the elite carries affix.
+func (e *EliteComponent) HasAffix(affix EliteAffix) bool {
+	for _, a := range e.Affixes {
+		if a == affix {
+			return true
+		}
+	}
+	return false
+}
+
+// RollEliteAffixes decides whether an enemy is an elite and picks its
+// distinct affixes. Returns nil for ordinary enemies. The result depends
+// only on rng, so seeded spawns roll the same elites.
+func RollEliteAffixes(rng *rand.Rand, config EliteAffixConfig) []EliteAffix {
+	if config.Validate() != nil || rng.Float64() >= config.Chance {
+		return nil
+	}
+
+	count := 1 + rng.Intn(config.MaxAffixes)
+	order := rng.Perm(eliteAffixCount)
+	affixes := make([]EliteAffix, count)
+	for i := range affixes {
+		affixes[i] = EliteAffix(order[i])
+	}
+	return affixes
+}
+
+// ApplyEliteAffixes turns enemy into an elite carrying affixes: it gains
+// bonus health, a larger marked sprite, and each affix's modifier. Returns
+// nil if affixes is empty.
+func ApplyEliteAffixes(enemy *Entity, affixes []EliteAffix) *EliteComponent {
+	if len(affixes) == 0 {
+		return nil
+	}
+
+	elite := &EliteComponent{
+		Affixes:     append([]EliteAffix(nil), affixes...),
+		MarkerColor: affixes[0].MarkerColor(),
+	}
+	enemy.AddComponent(elite)
+
+	maxHealth := 0.0
+	if healthComp, ok := enemy.GetComponent("health"); ok {
+		health := healthComp.(*HealthComponent)
+		health.Max *= EliteHealthMultiplier
+		health.Current = health.Max
+		maxHealth = health.Max
+	}
+
+	if spriteComp, ok := enemy.GetComponent("sprite"); ok {
+		sprite := spriteComp.(*EbitenSprite)
+		sprite.Width *= EliteSizeMultiplier
+		sprite.Height *= EliteSizeMultiplier
+		sprite.Color = elite.MarkerColor
+	}
+	if colliderComp, ok := enemy.GetComponent("collider"); ok {
+		collider := colliderComp.(*ColliderComponent)
+		collider.Width *= EliteSizeMultiplier
+		collider.Height *= EliteSizeMultiplier
+		collider.OffsetX *= EliteSizeMultiplier
+		collider.OffsetY *= EliteSizeMultiplier
+	}
+
+	for _, affix := range affixes {
+		switch affix {
+		case AffixShielded:
+			enemy.AddComponent(&ShieldComponent{
+				Amount:      maxHealth * EliteShieldFraction,
+				MaxAmount:   maxHealth * EliteShieldFraction,
+				Duration:    math.Inf(1), // Lasts until depleted
+				MaxDuration: math.Inf(1),
+			})
+		case AffixFast:
+			if aiComp, ok := enemy.GetComponent("ai"); ok {
+				ai := aiComp.(*AIComponent)
+				ai.PatrolSpeed *= EliteFastSpeedMultiplier
+				ai.ChaseSpeed *= EliteFastSpeedMultiplier
+			}
+			if attackComp, ok := enemy.GetComponent("attack"); ok {
+				attack := attackComp.(*AttackComponent)
+				attack.Cooldown *= EliteFastCooldownMultiplier
+			}
+		case AffixExplosive:
+			// Handled on death by TriggerEliteDeathExplosion
+		}
+	}
+
+	return elite
+}
+
+// TriggerEliteDeathExplosion detonates a dead explosive elite, dealing fire
+// damage with linear falloff to enemies of its team within
+// EliteExplosionRadius. It detonates at most once and returns the number of
+// entities hit.
+func TriggerEliteDeathExplosion(world *World, entity *Entity) int {
+	eliteComp, ok := entity.GetComponent("elite")
+	if !ok {
+		return 0
+	}
+	elite := eliteComp.(*EliteComponent)
+	if elite.Exploded || !elite.HasAffix(AffixExplosive) {
+		return 0
+	}
+	elite.Exploded = true
+
+	posComp, ok := entity.GetComponent("position")
+	if !ok {
+		return 0
+	}
+	pos := posComp.(*PositionComponent)
+
+	baseDamage := 10.0
+	if attackComp, ok := entity.GetComponent("attack"); ok {
+		baseDamage = attackComp.(*AttackComponent).Damage
+	}
+	blastDamage := baseDamage * EliteExplosionDamageMultiplier
+
+	var team *TeamComponent
+	if teamComp, ok := entity.GetComponent("team"); ok {
+		team = teamComp.(*TeamComponent)
+	}
+
+	hits := 0
+	for _, other := range world.GetEntitiesWith("position", "health") {
+		if other.ID == entity.ID || other.HasComponent("dead") {
+			continue
+		}
+		if team != nil {
+			otherTeam, ok := other.GetComponent("team")
+			if !ok || !team.IsEnemy(otherTeam.(*TeamComponent).TeamID) {
+				continue
+			}
+		}
+
+		otherPosComp, _ := other.GetComponent("position")
+		otherPos := otherPosComp.(*PositionComponent)
+		dx := otherPos.X - pos.X
+		dy := otherPos.Y - pos.Y
+		dist := math.Sqrt(dx*dx + dy*dy)
+		if dist > EliteExplosionRadius {
+			continue
+		}
+
+		healthComp, _ := other.GetComponent("health")
+		health := healthComp.(*HealthComponent)
+		health.TakeTypedDamage(blastDamage*(1-dist/EliteExplosionRadius), combat.DamageFire)
+		hits++
+	}
+	return hits
+}
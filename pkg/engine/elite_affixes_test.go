@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"image/color"
+	"math/rand"
+	"testing"
+)
+
+func eliteTestEnemy(world *World) *Entity {
+	enemy := world.CreateEntity()
+	enemy.AddComponent(&PositionComponent{X: 100, Y: 100})
+	enemy.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+	enemy.AddComponent(&AttackComponent{Damage: 10, Range: 50, Cooldown: 1.0})
+	enemy.AddComponent(NewAIComponent(100, 100))
+	enemy.AddComponent(&EbitenSprite{Width: 32, Height: 32, Visible: true})
+	enemy.AddComponent(&ColliderComponent{Width: 32, Height: 32, OffsetX: -16, OffsetY: -16})
+	return enemy
+}
+
+func TestApplyEliteAffixes_Shielded(t *testing.T) {
+	enemy := eliteTestEnemy(NewWorld())
+	elite := ApplyEliteAffixes(enemy, []EliteAffix{AffixShielded})
+
+	health := enemy.GetHealth()
+	if health.Max != 100*EliteHealthMultiplier || health.Current != health.Max {
+		t.Errorf("elite health = %v/%v, want %v", health.Current, health.Max, 100*EliteHealthMultiplier)
+	}
+
+	shieldComp, ok := enemy.GetComponent("shield")
+	if !ok {
+		t.Fatal("shielded elite has no shield")
+	}
+	shield := shieldComp.(*ShieldComponent)
+	shield.Update(60)
+	if !shield.IsActive() || shield.Amount != health.Max*EliteShieldFraction {
+		t.Errorf("shield = %+v, want %v absorption that does not expire", shield, health.Max*EliteShieldFraction)
+	}
+
+	spriteComp, _ := enemy.GetComponent("sprite")
+	sprite := spriteComp.(*EbitenSprite)
+	if sprite.Color != AffixShielded.MarkerColor() || elite.MarkerColor != AffixShielded.MarkerColor() {
+		t.Errorf("marker color = %v, want %v", sprite.Color, AffixShielded.MarkerColor())
+	}
+	if sprite.Width != 32*EliteSizeMultiplier {
+		t.Errorf("elite sprite width = %v, want %v", sprite.Width, 32*EliteSizeMultiplier)
+	}
+}
+
+func TestApplyEliteAffixes_Fast(t *testing.T) {
+	enemy := eliteTestEnemy(NewWorld())
+	aiComp, _ := enemy.GetComponent("ai")
+	ai := aiComp.(*AIComponent)
+	baseChase := ai.ChaseSpeed
+
+	ApplyEliteAffixes(enemy, []EliteAffix{AffixFast})
+
+	if ai.ChaseSpeed != baseChase*EliteFastSpeedMultiplier {
+		t.Errorf("ChaseSpeed = %v, want %v", ai.ChaseSpeed, baseChase*EliteFastSpeedMultiplier)
+	}
+	attack := enemy.GetAttack()
+	if attack.Cooldown != EliteFastCooldownMultiplier {
+		t.Errorf("Cooldown = %v, want %v", attack.Cooldown, EliteFastCooldownMultiplier)
+	}
+	if enemy.HasComponent("shield") {
+		t.Error("fast elite should not be shielded")
+	}
+}
+
+func TestApplyEliteAffixes_None(t *testing.T) {
+	enemy := eliteTestEnemy(NewWorld())
+	if ApplyEliteAffixes(enemy, nil) != nil || enemy.HasComponent("elite") {
+		t.Error("no affixes should leave the enemy ordinary")
+	}
+}
+
+func TestTriggerEliteDeathExplosion(t *testing.T) {
+	world := NewWorld()
+	elite := eliteTestEnemy(world)
+	ApplyEliteAffixes(elite, []EliteAffix{AffixExplosive})
+
+	player := world.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 100 + EliteExplosionRadius/2, Y: 100})
+	player.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	player.AddComponent(&TeamComponent{TeamID: 1})
+
+	ally := eliteTestEnemy(world)
+	far := world.CreateEntity()
+	far.AddComponent(&PositionComponent{X: 1000, Y: 1000})
+	far.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	far.AddComponent(&TeamComponent{TeamID: 1})
+	world.Update(0)
+
+	if hits := TriggerEliteDeathExplosion(world, elite); hits != 1 {
+		t.Errorf("explosion hit %d entities, want 1", hits)
+	}
+
+	// Half the radius away: half of 2x attack damage
+	playerHealth := player.GetHealth()
+	if playerHealth.Current != 90 {
+		t.Errorf("player health = %v, want 90", playerHealth.Current)
+	}
+	if ally.GetHealth().Current != 100 {
+		t.Error("explosion should not damage allies")
+	}
+	if far.GetHealth().Current != 100 {
+		t.Error("explosion should not reach beyond its radius")
+	}
+
+	if hits := TriggerEliteDeathExplosion(world, elite); hits != 0 {
+		t.Error("an elite should only explode once")
+	}
+	if hits := TriggerEliteDeathExplosion(world, ally); hits != 0 {
+		t.Error("non-explosive elites should not explode")
+	}
+}
+
+func TestRollEliteAffixes(t *testing.T) {
+	config := EliteAffixConfig{Chance: 1, MaxAffixes: 3}
+	for seed := int64(0); seed < 50; seed++ {
+		affixes := RollEliteAffixes(rand.New(rand.NewSource(seed)), config)
+		if len(affixes) < 1 || len(affixes) > 3 {
+			t.Fatalf("seed %d: rolled %d affixes", seed, len(affixes))
+		}
+		seen := map[EliteAffix]bool{}
+		for _, affix := range affixes {
+			if seen[affix] {
+				t.Fatalf("seed %d: duplicate affix %v", seed, affix)
+			}
+			seen[affix] = true
+		}
+
+		again := RollEliteAffixes(rand.New(rand.NewSource(seed)), config)
+		if len(again) != len(affixes) || again[0] != affixes[0] {
+			t.Fatalf("seed %d: rolls are not deterministic", seed)
+		}
+	}
+
+	if RollEliteAffixes(rand.New(rand.NewSource(1)), EliteAffixConfig{Chance: 0, MaxAffixes: 1}) != nil {
+		t.Error("zero chance should never roll an elite")
+	}
+}
+
+func TestEliteAffix_StringAndMarkers(t *testing.T) {
+	names := map[EliteAffix]string{
+		AffixShielded:  "shielded",
+		AffixFast:      "fast",
+		AffixExplosive: "explosive",
+		EliteAffix(9):  "unknown",
+	}
+	for affix, want := range names {
+		if got := affix.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", affix, got, want)
+		}
+	}
+
+	markers := map[color.RGBA]bool{}
+	for _, affix := range []EliteAffix{AffixShielded, AffixFast, AffixExplosive} {
+		if markers[affix.MarkerColor()] {
+			t.Errorf("%v shares its marker color with another affix", affix)
+		}
+		markers[affix.MarkerColor()] = true
+	}
+}
@@ -14,8 +14,33 @@ import (
 
 // SpawnEnemiesInTerrain spawns procedurally generated enemies into terrain rooms.
 // It generates entities using the entity generator and places them at room centers.
+// Elites are rolled with DefaultEliteAffixConfig.
 // Returns the number of enemies spawned.
 func SpawnEnemiesInTerrain(world *World, terr *terrain.Terrain, seed int64, params procgen.GenerationParams) (int, error) {
+	return SpawnEnemiesInTerrainWithElites(world, terr, seed, params, DefaultEliteAffixConfig())
+}
+
+// SpawnEnemiesInTerrainWithElites spawns enemies like SpawnEnemiesInTerrain,
+// rolling elite affixes for each enemy with the given configuration.
+func SpawnEnemiesInTerrainWithElites(world *World, terr *terrain.Terrain, seed int64, params procgen.GenerationParams, eliteConfig EliteAffixConfig) (int, error) {
+	return spawnEnemiesInTerrain(world, terr, seed, params, eliteConfig, DifficultyNormal.Settings())
+}
+
+// SpawnEnemiesInTerrainWithDifficulty spawns enemies like
+// SpawnEnemiesInTerrain, with the generation difficulty, enemy count, enemy
+// stats and elite chance of the given difficulty settings.
+func SpawnEnemiesInTerrainWithDifficulty(world *World, terr *terrain.Terrain, seed int64, params procgen.GenerationParams, difficulty DifficultySettings) (int, error) {
+	eliteConfig := difficulty.EliteConfig(DefaultEliteAffixConfig())
+	return spawnEnemiesInTerrain(world, terr, seed, difficulty.GenerationParams(params), eliteConfig, difficulty)
+}
+
+// spawnEnemiesInTerrain spawns enemies into terrain rooms, scaling counts
+// and stats by difficulty.
+func spawnEnemiesInTerrain(world *World, terr *terrain.Terrain, seed int64, params procgen.GenerationParams, eliteConfig EliteAffixConfig, difficulty DifficultySettings) (int, error) {
+	if err := eliteConfig.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid elite config: %w", err)
+	}
+
 	if terr == nil {
 		return 0, fmt.Errorf("terrain cannot be nil")
 	}
@@ -37,9 +62,12 @@ func SpawnEnemiesInTerrain(world *World, terr *terrain.Terrain, seed int64, para
 	rng := rand.New(rand.NewSource(seed))
 	totalEnemies := 0
 	for range spawnRooms {
-		totalEnemies += 1 + rng.Intn(3) // 1-3 enemies per room
+		totalEnemies += difficulty.SpawnCount(1 + rng.Intn(3)) // 1-3 enemies per room on Normal
 	}
 
+	// Elites roll from their own stream so placement stays unchanged
+	eliteRng := rand.New(rand.NewSource(seed + 2000))
+
 	// Update params with entity count
 	params.Custom = make(map[string]interface{})
 	params.Custom["count"] = totalEnemies
@@ -59,13 +87,16 @@ func SpawnEnemiesInTerrain(world *World, terr *terrain.Terrain, seed int64, para
 	entityIndex := 0
 	spawned := 0
 
-	for _, room := range spawnRooms {
+	for roomOffset, room := range spawnRooms {
 		if entityIndex >= len(generatedEntities) {
 			break
 		}
 
-		// Number of enemies for this room (1-3)
-		roomEnemyCount := 1 + rng.Intn(3)
+		// Room index in terr.Rooms, used as the spawn zone ID
+		zoneID := roomOffset + len(terr.Rooms) - len(spawnRooms)
+
+		// Number of enemies for this room (1-3 on Normal)
+		roomEnemyCount := difficulty.SpawnCount(1 + rng.Intn(3))
 		if roomEnemyCount > len(generatedEntities)-entityIndex {
 			roomEnemyCount = len(generatedEntities) - entityIndex
 		}
@@ -85,109 +116,153 @@ func SpawnEnemiesInTerrain(world *World, terr *terrain.Terrain, seed int64, para
 			spawnX := float64(cx*32) + offsetX
 			spawnY := float64(cy*32) + offsetY
 
-			// Create ECS entity
-			enemy := world.CreateEntity()
-
-			// Position
-			enemy.AddComponent(&PositionComponent{
-				X: spawnX,
-				Y: spawnY,
-			})
-
-			// Health (scale from procgen entity stats)
-			maxHealth := float64(genEntity.Stats.Health)
-			enemy.AddComponent(&HealthComponent{
-				Current: maxHealth,
-				Max:     maxHealth,
-			})
-
-			// Stats
-			stats := NewStatsComponent()
-			stats.Attack = float64(genEntity.Stats.Damage)
-			stats.Defense = float64(genEntity.Stats.Defense)
-			enemy.AddComponent(stats)
-
-			// Team (enemy team = 2, player team = 1)
-			enemy.AddComponent(&TeamComponent{TeamID: 2})
-
-			// Velocity (required for movement)
-			enemy.AddComponent(&VelocityComponent{VX: 0, VY: 0})
-
-			// Attack capability
-			attackRange := 50.0 // Base melee range
-			if genEntity.Size == entity.SizeLarge || genEntity.Size == entity.SizeHuge {
-				attackRange = 70.0 // Larger enemies have longer reach
-			}
+			spawnGeneratedEnemy(world, genEntity, spawnX, spawnY, zoneID, seed, params.GenreID, difficulty, RollEliteAffixes(eliteRng, eliteConfig))
+			spawned++
+		}
+	}
 
-			enemy.AddComponent(&AttackComponent{
-				Damage:     float64(genEntity.Stats.Damage),
-				DamageType: 0, // Physical damage
-				Range:      attackRange,
-				Cooldown:   1.0, // 1 second between attacks
-			})
-
-			// AI behavior
-			aiComp := NewAIComponent(spawnX, spawnY)
-			aiComp.DetectionRange = 200.0 // Can detect player from 200 pixels
-
-			// Boss entities are more aggressive with wider detection
-			if genEntity.Type == entity.TypeBoss {
-				aiComp.DetectionRange = 300.0
-				aiComp.ChaseSpeed = 0.8 // Slower but tankier
-			} else if genEntity.Type == entity.TypeMinion {
-				aiComp.ChaseSpeed = 1.2 // Faster but weaker
-			}
+	return spawned, nil
+}
 
-			enemy.AddComponent(aiComp)
-
-			// Collision
-			enemySize := 32.0
-			if genEntity.Size == entity.SizeTiny {
-				enemySize = 16.0
-			} else if genEntity.Size == entity.SizeSmall {
-				enemySize = 24.0
-			} else if genEntity.Size == entity.SizeLarge {
-				enemySize = 48.0
-			} else if genEntity.Size == entity.SizeHuge {
-				enemySize = 64.0
-			}
+// EnemyIdentityComponent records what a generated enemy was spawned from,
+// so saves can respawn the same kind of enemy.
+type EnemyIdentityComponent struct {
+	// Name is the generated entity's name
+	Name string
+	// EntityType is the generated entity's type (monster, boss, minion)
+	EntityType entity.EntityType
+	// Size is the generated entity's size category
+	Size entity.EntitySize
+	// GenreID is the genre the enemy was generated for
+	GenreID string
+}
 
-			enemy.AddComponent(&ColliderComponent{
-				Width:     enemySize,
-				Height:    enemySize,
-				Solid:     true,
-				IsTrigger: false,
-				Layer:     1,
-				OffsetX:   -enemySize / 2,
-				OffsetY:   -enemySize / 2,
-			})
-
-			// Visual sprite (procedurally generated, animated)
-			enemySprite := &EbitenSprite{
-				Width:   enemySize,
-				Height:  enemySize,
-				Visible: true,
-				Layer:   5, // Enemies drawn below player (layer 10)
-			}
-			enemy.AddComponent(enemySprite)
+// Type returns the component type identifier.
+func (e *EnemyIdentityComponent) Type() string {
+	return "enemy_identity"
+}
 
-			// GAP-018 REPAIR: Add animation component for enemy animations
-			enemyAnim := NewAnimationComponent(seed + int64(enemy.ID))
-			enemyAnim.CurrentState = AnimationStateIdle
-			enemyAnim.FrameTime = 0.2 // Slightly slower than player (~5 FPS)
-			enemyAnim.Loop = true
-			enemyAnim.Playing = true
-			enemyAnim.FrameCount = 4
-			enemy.AddComponent(enemyAnim)
+// spawnGeneratedEnemy creates a hostile ECS entity from a generated entity
+// at (x, y) in spawn zone zoneID, scaled by difficulty with the rolled
+// elite affixes applied last so they modify the final stats.
+func spawnGeneratedEnemy(world *World, genEntity *entity.Entity, x, y float64, zoneID int, seed int64, genreID string, difficulty DifficultySettings, affixes []EliteAffix) *Entity {
+	// Create ECS entity
+	enemy := world.CreateEntity()
 
-			// GAP-012 REPAIR: Add visual feedback for hit flash
-			enemy.AddComponent(NewVisualFeedbackComponent())
+	// Position
+	enemy.AddComponent(&PositionComponent{
+		X: x,
+		Y: y,
+	})
 
-			spawned++
-		}
+	// Health (scale from procgen entity stats)
+	maxHealth := float64(genEntity.Stats.Health)
+	enemy.AddComponent(&HealthComponent{
+		Current: maxHealth,
+		Max:     maxHealth,
+	})
+
+	// Stats
+	stats := NewStatsComponent()
+	stats.Attack = float64(genEntity.Stats.Damage)
+	stats.Defense = float64(genEntity.Stats.Defense)
+	enemy.AddComponent(stats)
+
+	// Team (enemy team = 2, player team = 1)
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+
+	// Velocity (required for movement)
+	enemy.AddComponent(&VelocityComponent{VX: 0, VY: 0})
+
+	// Attack capability
+	attackRange := 50.0 // Base melee range
+	if genEntity.Size == entity.SizeLarge || genEntity.Size == entity.SizeHuge {
+		attackRange = 70.0 // Larger enemies have longer reach
 	}
 
-	return spawned, nil
+	enemy.AddComponent(&AttackComponent{
+		Damage:     float64(genEntity.Stats.Damage),
+		DamageType: 0, // Physical damage
+		Range:      attackRange,
+		Cooldown:   1.0, // 1 second between attacks
+	})
+
+	// AI behavior
+	aiComp := NewAIComponent(x, y)
+	aiComp.DetectionRange = 200.0 // Can detect player from 200 pixels
+
+	// Boss entities are more aggressive with wider detection
+	if genEntity.Type == entity.TypeBoss {
+		aiComp.DetectionRange = 300.0
+		aiComp.ChaseSpeed = 0.8 // Slower but tankier
+	} else if genEntity.Type == entity.TypeMinion {
+		aiComp.ChaseSpeed = 1.2 // Faster but weaker
+	}
+
+	enemy.AddComponent(aiComp)
+
+	// Collision
+	enemySize := 32.0
+	if genEntity.Size == entity.SizeTiny {
+		enemySize = 16.0
+	} else if genEntity.Size == entity.SizeSmall {
+		enemySize = 24.0
+	} else if genEntity.Size == entity.SizeLarge {
+		enemySize = 48.0
+	} else if genEntity.Size == entity.SizeHuge {
+		enemySize = 64.0
+	}
+
+	enemy.AddComponent(&ColliderComponent{
+		Width:     enemySize,
+		Height:    enemySize,
+		Solid:     true,
+		IsTrigger: false,
+		Layer:     1,
+		OffsetX:   -enemySize / 2,
+		OffsetY:   -enemySize / 2,
+	})
+
+	// Visual sprite (procedurally generated, animated)
+	enemySprite := &EbitenSprite{
+		Width:   enemySize,
+		Height:  enemySize,
+		Visible: true,
+		Layer:   5, // Enemies drawn below player (layer 10)
+	}
+	enemy.AddComponent(enemySprite)
+
+	// GAP-018 REPAIR: Add animation component for enemy animations
+	enemyAnim := NewAnimationComponent(seed + int64(enemy.ID))
+	enemyAnim.CurrentState = AnimationStateIdle
+	enemyAnim.FrameTime = 0.2 // Slightly slower than player (~5 FPS)
+	enemyAnim.Loop = true
+	enemyAnim.Playing = true
+	enemyAnim.FrameCount = 4
+	enemy.AddComponent(enemyAnim)
+
+	// GAP-012 REPAIR: Add visual feedback for hit flash
+	enemy.AddComponent(NewVisualFeedbackComponent())
+
+	// Spawn zone membership for RespawnSystem
+	enemy.AddComponent(&SpawnZoneComponent{ZoneID: zoneID})
+
+	enemy.AddComponent(&EnemyIdentityComponent{
+		Name:       genEntity.Name,
+		EntityType: genEntity.Type,
+		Size:       genEntity.Size,
+		GenreID:    genreID,
+	})
+
+	// Difficulty scaling, then elite affixes (applied last so they
+	// modify the final stats)
+	difficulty.ScaleEnemy(enemy)
+	ApplyEliteAffixes(enemy, affixes)
+
+	// Out-of-combat regen, sized to the final max health
+	addEnemyHealthRegen(enemy, genreID)
+
+	return enemy
 }
 
 // getEnemyColor determines sprite color based on entity properties.
@@ -84,6 +84,17 @@ func TestSpawnEnemiesInTerrain_Success(t *testing.T) {
 				t.Error("Enemy has invalid detection range")
 			}
 
+			// Check out-of-combat regen, sized to max health
+			regenComp, ok := e.GetComponent("health_regen")
+			if !ok {
+				t.Error("Enemy missing health regen component")
+				continue
+			}
+			health, _ := e.GetComponent("health")
+			if want := NewEnemyHealthRegen("fantasy", health.(*HealthComponent).Max); *regenComp.(*HealthRegenComponent) != *want {
+				t.Errorf("enemy regen = %+v, want %+v", *regenComp.(*HealthRegenComponent), *want)
+			}
+
 			break
 		}
 	}
@@ -323,3 +334,69 @@ func TestSpawnEnemiesInTerrain_MultipleRooms(t *testing.T) {
 		t.Errorf("Expected 4-12 enemies (1-3 per 4 rooms), got %d", len(entities))
 	}
 }
+
+// TestSpawnEnemiesInTerrainWithElites verifies spawned elites carry an affix,
+// its modifier, and a distinct visual marker.
+func TestSpawnEnemiesInTerrainWithElites(t *testing.T) {
+	terrainGen := terrain.NewBSPGenerator()
+	params := procgen.GenerationParams{
+		Difficulty: 0.5,
+		Depth:      1,
+		GenreID:    "fantasy",
+		Custom:     map[string]interface{}{"width": 40, "height": 30},
+	}
+	result, err := terrainGen.Generate(12345, params)
+	if err != nil {
+		t.Fatalf("Failed to generate terrain: %v", err)
+	}
+	terr := result.(*terrain.Terrain)
+
+	enemyParams := procgen.GenerationParams{Difficulty: 0.5, Depth: 1, GenreID: "fantasy"}
+	world := NewWorld()
+	count, err := SpawnEnemiesInTerrainWithElites(world, terr, 12345, enemyParams, EliteAffixConfig{Chance: 1, MaxAffixes: 1})
+	if err != nil {
+		t.Fatalf("SpawnEnemiesInTerrainWithElites failed: %v", err)
+	}
+	world.Update(0)
+
+	elites := world.GetEntitiesWith("elite")
+	if count == 0 || len(elites) != count {
+		t.Fatalf("got %d elites out of %d enemies, want all elite", len(elites), count)
+	}
+
+	for _, enemy := range elites {
+		eliteComp, _ := enemy.GetComponent("elite")
+		elite := eliteComp.(*EliteComponent)
+		if len(elite.Affixes) != 1 {
+			t.Fatalf("elite has %d affixes, want 1", len(elite.Affixes))
+		}
+
+		spriteComp, _ := enemy.GetComponent("sprite")
+		if spriteComp.(*EbitenSprite).Color != elite.Affixes[0].MarkerColor() {
+			t.Errorf("elite sprite is not marked with its %v color", elite.Affixes[0])
+		}
+
+		switch elite.Affixes[0] {
+		case AffixShielded:
+			if !enemy.HasComponent("shield") {
+				t.Error("shielded elite spawned without a shield")
+			}
+		case AffixFast:
+			aiComp, _ := enemy.GetComponent("ai")
+			if aiComp.(*AIComponent).ChaseSpeed <= 1.0 {
+				t.Error("fast elite spawned without a speed bonus")
+			}
+		}
+	}
+
+	// Elites must not disturb the seeded placement of ordinary spawns
+	plain := NewWorld()
+	plainCount, _ := SpawnEnemiesInTerrainWithElites(plain, terr, 12345, enemyParams, EliteAffixConfig{Chance: 0, MaxAffixes: 1})
+	if plainCount != count {
+		t.Errorf("elite rolls changed the enemy count: %d vs %d", count, plainCount)
+	}
+
+	if _, err := SpawnEnemiesInTerrainWithElites(NewWorld(), terr, 1, enemyParams, EliteAffixConfig{Chance: 2, MaxAffixes: 1}); err == nil {
+		t.Error("expected error for invalid elite config")
+	}
+}
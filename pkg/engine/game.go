@@ -6,9 +6,11 @@ package engine
 
 import (
 	"fmt"
+	"image/color"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 	"github.com/sirupsen/logrus"
 )
 
@@ -40,8 +42,9 @@ type EbitenGame struct {
 	CameraSystem        *CameraSystem
 	RenderSystem        *EbitenRenderSystem
 	TerrainRenderSystem *TerrainRenderSystem
-	LightingSystem      *LightingSystem // Dynamic lighting system (Phase 5.3)
-	sceneBuffer         *ebiten.Image   // Reusable buffer for lighting post-processing
+	LightingSystem      *LightingSystem     // Dynamic lighting system (Phase 5.3)
+	DayNight            *DayNightController // Day/night cycle driving the lighting ambient
+	sceneBuffer         *ebiten.Image       // Reusable buffer for lighting post-processing
 	HUDSystem           *EbitenHUDSystem
 	TutorialSystem      *EbitenTutorialSystem
 	HelpSystem          *EbitenHelpSystem
@@ -144,6 +147,10 @@ func NewEbitenGameWithLogger(screenWidth, screenHeight int, logger *logrus.Logge
 	lightingConfig.Enabled = false // Disabled by default, enable via flag
 	lightingSystem := NewLightingSystemWithLogger(world, lightingConfig, logger)
 
+	// Day/night cycle shifts the lighting ambient through the day
+	dayNight := NewDayNightController(lightingSystem, "")
+	dayNight.HoursPerSecond = HoursPerDay / DefaultDayLength
+
 	// Create reusable scene buffer for lighting post-processing
 	// Allocated once to avoid per-frame allocations (60+ FPS)
 	sceneBuffer := ebiten.NewImage(screenWidth, screenHeight)
@@ -165,6 +172,7 @@ func NewEbitenGameWithLogger(screenWidth, screenHeight int, logger *logrus.Logge
 		CameraSystem:       cameraSystem,
 		RenderSystem:       renderSystem,
 		LightingSystem:     lightingSystem,
+		DayNight:           dayNight,
 		sceneBuffer:        sceneBuffer,
 		HUDSystem:          hudSystem,
 		MenuSystem:         menuSystem,
@@ -771,9 +779,17 @@ func (g *EbitenGame) Update() error {
 		g.World.Update(deltaTime)
 	}
 
+	// Advance the day/night cycle while lighting is on
+	if g.DayNight != nil && g.LightingSystem != nil && g.LightingSystem.IsEnabled() {
+		g.DayNight.Update(nil, deltaTime)
+	}
+
 	// Update camera system
 	g.CameraSystem.Update(g.World.GetEntities(), deltaTime)
 
+	// Prepare team-tinted sprites before they are drawn
+	g.RenderSystem.Update(g.World.GetEntities(), deltaTime)
+
 	return nil
 }
 
@@ -870,6 +886,15 @@ func (g *EbitenGame) Draw(screen *ebiten.Image) {
 		g.RenderSystem.Draw(screen, g.World.GetEntities())
 	}
 
+	// Fade the scene during camera transitions (level changes, teleports)
+	if g.CameraSystem != nil {
+		if alpha := g.CameraSystem.FadeAlpha(); alpha > 0 {
+			bounds := screen.Bounds()
+			vector.DrawFilledRect(screen, 0, 0, float32(bounds.Dx()), float32(bounds.Dy()),
+				color.RGBA{0, 0, 0, uint8(alpha * 255)}, false)
+		}
+	}
+
 	// Render HUD overlay
 	g.HUDSystem.Draw(screen)
 
@@ -1097,6 +1122,11 @@ func (g *EbitenGame) ApplySettings() error {
 		ebiten.SetFullscreen(settings.Fullscreen)
 	}
 
+	// Apply combat feedback intensity (shake, hit-stop, flash)
+	if g.CameraSystem != nil {
+		g.CameraSystem.Accessibility.SetFeedbackIntensity(settings.FeedbackIntensity)
+	}
+
 	// Graphics quality and ShowFPS are informational for now
 	// Future: could affect particle counts, sprite quality, etc.
 
@@ -1178,6 +1208,12 @@ func (g *EbitenGame) SetLightingGenrePreset(genreID string) {
 			config.SetGenrePreset(genreID)
 			g.LightingSystem.SetConfig(config)
 
+			// The preset's ambient is replaced by the genre's day/night cycle
+			if g.DayNight != nil {
+				g.DayNight.SetKeyframes(DayNightKeyframesForGenre(genreID))
+				g.DayNight.SetTimeOfDay(g.DayNight.TimeOfDay())
+			}
+
 			if g.logger != nil {
 				g.logger.WithField("genre", genreID).Info("lighting genre preset applied")
 			}
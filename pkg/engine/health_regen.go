@@ -0,0 +1,174 @@
+// Package engine provides passive health regeneration.
+// This file implements HealthRegenComponent and HealthRegenSystem, which
+// restore health over time once an entity has been out of combat for a
+// while. Works the same for players and AI-controlled entities.
+package engine
+
+// HealthRegenComponent gives an entity passive health regeneration that
+// pauses for Delay seconds after it takes damage.
+type HealthRegenComponent struct {
+	// RegenPerSecond is the health restored per second while out of combat
+	RegenPerSecond float64
+
+	// Delay is how long in seconds after taking damage before regen resumes
+	Delay float64
+
+	// TimeSinceDamage is the time in seconds since damage was last taken
+	TimeSinceDamage float64
+
+	// lastHealth is the health seen on the previous update, used to notice
+	// damage from sources that bypass the combat system's damage callback
+	lastHealth float64
+	tracked    bool
+}
+
+// Type returns the component type identifier.
+func (h *HealthRegenComponent) Type() string {
+	return "health_regen"
+}
+
+// NewHealthRegenComponent creates a regen component that is ready to heal
+// immediately.
+func NewHealthRegenComponent(regenPerSecond, delay float64) *HealthRegenComponent {
+	if regenPerSecond < 0 {
+		regenPerSecond = 0
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return &HealthRegenComponent{
+		RegenPerSecond:  regenPerSecond,
+		Delay:           delay,
+		TimeSinceDamage: delay,
+	}
+}
+
+// NotifyDamage restarts the out-of-combat delay.
+func (h *HealthRegenComponent) NotifyDamage() {
+	h.TimeSinceDamage = 0
+}
+
+// InCombat returns true while regen is paused after recent damage.
+func (h *HealthRegenComponent) InCombat() bool {
+	return h.TimeSinceDamage < h.Delay
+}
+
+// healthRegenProfile is a genre's regeneration tuning.
+type healthRegenProfile struct {
+	// PlayerPerSecond is the player's base regen in health per second
+	PlayerPerSecond float64
+	// EnemyFraction is the share of max health enemies regen per second
+	EnemyFraction float64
+	// Delay is the player's out-of-combat delay in seconds
+	Delay float64
+}
+
+// healthRegenProfiles maps genre IDs to regen tuning. Harsher genres heal
+// slower and wait longer after damage.
+var healthRegenProfiles = map[string]healthRegenProfile{
+	"fantasy":   {PlayerPerSecond: 2.0, EnemyFraction: 0.02, Delay: 5.0},
+	"scifi":     {PlayerPerSecond: 2.5, EnemyFraction: 0.02, Delay: 4.0},
+	"cyberpunk": {PlayerPerSecond: 2.0, EnemyFraction: 0.015, Delay: 4.0},
+	"horror":    {PlayerPerSecond: 1.0, EnemyFraction: 0.03, Delay: 8.0},
+	"postapoc":  {PlayerPerSecond: 1.0, EnemyFraction: 0.01, Delay: 7.0},
+}
+
+// enemyRegenDelayBonus is how much longer enemies wait than players
+// before regenerating, so kiting a wounded enemy is not pointless.
+const enemyRegenDelayBonus = 3.0
+
+// getHealthRegenProfile returns the tuning for genreID, defaulting to fantasy.
+func getHealthRegenProfile(genreID string) healthRegenProfile {
+	if profile, ok := healthRegenProfiles[genreID]; ok {
+		return profile
+	}
+	return healthRegenProfiles["fantasy"]
+}
+
+// NewPlayerHealthRegen creates a player's regen component for a genre and
+// class. Warriors regenerate faster and mages slower; rogues recover from
+// combat sooner.
+func NewPlayerHealthRegen(genreID string, class CharacterClass) *HealthRegenComponent {
+	profile := getHealthRegenProfile(genreID)
+	perSecond, delay := profile.PlayerPerSecond, profile.Delay
+	switch class {
+	case ClassWarrior:
+		perSecond *= 1.5
+	case ClassMage:
+		perSecond *= 0.75
+	case ClassRogue:
+		delay *= 0.75
+	}
+	return NewHealthRegenComponent(perSecond, delay)
+}
+
+// NewEnemyHealthRegen creates an enemy's regen component for a genre,
+// scaled to the enemy's max health.
+func NewEnemyHealthRegen(genreID string, maxHealth float64) *HealthRegenComponent {
+	profile := getHealthRegenProfile(genreID)
+	return NewHealthRegenComponent(maxHealth*profile.EnemyFraction, profile.Delay+enemyRegenDelayBonus)
+}
+
+// addEnemyHealthRegen gives an enemy regen sized to its current max health.
+func addEnemyHealthRegen(enemy *Entity, genreID string) {
+	if healthComp, ok := enemy.GetComponent("health"); ok {
+		enemy.AddComponent(NewEnemyHealthRegen(genreID, healthComp.(*HealthComponent).Max))
+	}
+}
+
+// HealthRegenSystem restores health for entities with a health_regen
+// component once they have gone Delay seconds without taking damage.
+type HealthRegenSystem struct{}
+
+// NewHealthRegenSystem creates a new health regeneration system.
+func NewHealthRegenSystem() *HealthRegenSystem {
+	return &HealthRegenSystem{}
+}
+
+// Update advances regen timers and heals entities that are out of combat,
+// capped at max health. Dead entities do not regenerate.
+func (s *HealthRegenSystem) Update(entities []*Entity, deltaTime float64) {
+	for _, entity := range entities {
+		regenComp, ok := entity.GetComponent("health_regen")
+		if !ok {
+			continue
+		}
+		healthComp, ok := entity.GetComponent("health")
+		if !ok {
+			continue
+		}
+		regen := regenComp.(*HealthRegenComponent)
+		health := healthComp.(*HealthComponent)
+
+		if health.IsDead() || entity.HasComponent("dead") {
+			regen.tracked = false
+			continue
+		}
+
+		// Health lost since the last update also counts as damage, which
+		// covers damage over time and other sources outside Attack
+		if regen.tracked && health.Current < regen.lastHealth {
+			regen.NotifyDamage()
+		}
+
+		regen.TimeSinceDamage += deltaTime
+		if !regen.InCombat() && health.Current < health.Max {
+			health.Heal(regen.RegenPerSecond * deltaTime)
+		}
+
+		regen.lastHealth = health.Current
+		regen.tracked = true
+	}
+}
+
+// OnDamage restarts the target's regen delay. Its signature matches the
+// combat system's damage callback, so it can be passed to
+// CombatSystem.SetDamageCallback.
+func (s *HealthRegenSystem) OnDamage(attacker, target *Entity, damage float64) {
+	if target == nil || damage <= 0 {
+		return
+	}
+	if regenComp, ok := target.GetComponent("health_regen"); ok {
+		regenComp.(*HealthRegenComponent).NotifyDamage()
+	}
+}
@@ -0,0 +1,199 @@
+package engine
+
+import (
+	"math"
+	"testing"
+)
+
+// newRegenEntity returns an entity with the given health and regen.
+func newRegenEntity(id uint64, current, maxHealth, regenPerSecond, delay float64) (*Entity, *HealthComponent, *HealthRegenComponent) {
+	entity := NewEntity(id)
+	health := &HealthComponent{Current: current, Max: maxHealth}
+	regen := NewHealthRegenComponent(regenPerSecond, delay)
+	entity.AddComponent(health)
+	entity.AddComponent(regen)
+	return entity, health, regen
+}
+
+func TestNewHealthRegenComponent(t *testing.T) {
+	regen := NewHealthRegenComponent(5, 3)
+	if regen.Type() != "health_regen" {
+		t.Errorf("Type() = %q, want health_regen", regen.Type())
+	}
+	if regen.InCombat() {
+		t.Error("new component should be ready to regenerate")
+	}
+
+	regen.NotifyDamage()
+	if !regen.InCombat() {
+		t.Error("component should be in combat right after damage")
+	}
+
+	if clamped := NewHealthRegenComponent(-1, -2); clamped.RegenPerSecond != 0 || clamped.Delay != 0 {
+		t.Errorf("negative values = %.1f/%.1f, want 0/0", clamped.RegenPerSecond, clamped.Delay)
+	}
+}
+
+func TestHealthRegenSystem_Regenerates(t *testing.T) {
+	system := NewHealthRegenSystem()
+	entity, health, _ := newRegenEntity(1, 50, 100, 10, 2)
+
+	system.Update([]*Entity{entity}, 1.5)
+	if math.Abs(health.Current-65) > 1e-9 {
+		t.Errorf("health = %.2f, want 65", health.Current)
+	}
+
+	// Clamped to max health
+	for i := 0; i < 10; i++ {
+		system.Update([]*Entity{entity}, 1)
+	}
+	if health.Current != 100 {
+		t.Errorf("health = %.2f, want clamped to 100", health.Current)
+	}
+}
+
+func TestHealthRegenSystem_DelayAfterDamage(t *testing.T) {
+	system := NewHealthRegenSystem()
+	entity, health, regen := newRegenEntity(1, 80, 100, 10, 3)
+	entities := []*Entity{entity}
+
+	// Damage through the combat callback pauses regen
+	health.TakeDamage(20)
+	system.OnDamage(nil, entity, 20)
+	system.Update(entities, 1)
+	system.Update(entities, 1)
+	if health.Current != 60 {
+		t.Errorf("health during delay = %.2f, want 60", health.Current)
+	}
+	if !regen.InCombat() {
+		t.Error("should still be in combat during the delay")
+	}
+
+	// Once the delay passes, regen resumes
+	system.Update(entities, 1)
+	system.Update(entities, 1)
+	if health.Current <= 60 {
+		t.Errorf("health after delay = %.2f, want regenerating", health.Current)
+	}
+}
+
+func TestHealthRegenSystem_DetectsOtherDamage(t *testing.T) {
+	system := NewHealthRegenSystem()
+	entity, health, regen := newRegenEntity(1, 100, 100, 10, 5)
+	entities := []*Entity{entity}
+	system.Update(entities, 1)
+
+	// Damage over time bypasses the callback but still resets the delay
+	health.TakeDamage(10)
+	system.Update(entities, 1)
+	if !regen.InCombat() || health.Current != 90 {
+		t.Errorf("after DoT: in combat = %v, health = %.2f; want true, 90", regen.InCombat(), health.Current)
+	}
+}
+
+func TestHealthRegenSystem_SkipsDeadAndMissing(t *testing.T) {
+	system := NewHealthRegenSystem()
+	dead, deadHealth, _ := newRegenEntity(1, 0, 100, 10, 0)
+
+	corpse, corpseHealth, _ := newRegenEntity(2, 50, 100, 10, 0)
+	corpse.AddComponent(NewDeadComponent(0))
+
+	noRegen := NewEntity(3)
+	noRegenHealth := &HealthComponent{Current: 50, Max: 100}
+	noRegen.AddComponent(noRegenHealth)
+
+	system.Update([]*Entity{dead, corpse, noRegen}, 1)
+
+	if deadHealth.Current != 0 || corpseHealth.Current != 50 {
+		t.Error("dead entities should not regenerate")
+	}
+	if noRegenHealth.Current != 50 {
+		t.Error("entities without regen should not heal")
+	}
+
+	// OnDamage tolerates targets without regen
+	system.OnDamage(nil, noRegen, 5)
+	system.OnDamage(nil, nil, 5)
+}
+
+func TestHealthRegenSystem_Deterministic(t *testing.T) {
+	run := func() float64 {
+		system := NewHealthRegenSystem()
+		entity, health, _ := newRegenEntity(1, 10, 100, 7, 1.5)
+		for i := 0; i < 100; i++ {
+			if i == 30 {
+				health.TakeDamage(15)
+				system.OnDamage(nil, entity, 15)
+			}
+			system.Update([]*Entity{entity}, 0.05)
+		}
+		return health.Current
+	}
+	if a, b := run(), run(); a != b {
+		t.Errorf("runs diverged: %.4f vs %.4f", a, b)
+	}
+}
+
+func TestHealthRegenSystem_CombatAttackResetsDelay(t *testing.T) {
+	regenSystem := NewHealthRegenSystem()
+	combat := NewCombatSystem(1)
+	combat.SetDamageCallback(regenSystem.OnDamage)
+
+	attacker := NewEntity(1)
+	attacker.AddComponent(&AttackComponent{Damage: 20, Range: 50, Cooldown: 1})
+
+	target, health, regen := newRegenEntity(2, 100, 100, 10, 3)
+	entities := []*Entity{target}
+
+	// Fully out of combat before the hit
+	regenSystem.Update(entities, 5)
+	if regen.InCombat() {
+		t.Fatal("target should be out of combat before the attack")
+	}
+
+	if !combat.Attack(attacker, target) {
+		t.Fatal("attack should hit")
+	}
+	if regen.TimeSinceDamage != 0 {
+		t.Errorf("TimeSinceDamage after attack = %.2f, want 0", regen.TimeSinceDamage)
+	}
+
+	// No regen until the delay passes
+	wounded := health.Current
+	regenSystem.Update(entities, 2)
+	if health.Current != wounded {
+		t.Errorf("health during delay = %.2f, want %.2f", health.Current, wounded)
+	}
+	regenSystem.Update(entities, 2)
+	if health.Current <= wounded {
+		t.Errorf("health after delay = %.2f, want regenerating", health.Current)
+	}
+}
+
+func TestNewPlayerHealthRegen_GenreAndClass(t *testing.T) {
+	warrior := NewPlayerHealthRegen("fantasy", ClassWarrior)
+	mage := NewPlayerHealthRegen("fantasy", ClassMage)
+	rogue := NewPlayerHealthRegen("fantasy", ClassRogue)
+	if warrior.RegenPerSecond <= mage.RegenPerSecond {
+		t.Errorf("warrior regen %.2f should exceed mage regen %.2f", warrior.RegenPerSecond, mage.RegenPerSecond)
+	}
+	if rogue.Delay >= warrior.Delay {
+		t.Errorf("rogue delay %.2f should be shorter than warrior delay %.2f", rogue.Delay, warrior.Delay)
+	}
+
+	horror := NewPlayerHealthRegen("horror", ClassWarrior)
+	if horror.RegenPerSecond >= warrior.RegenPerSecond || horror.Delay <= warrior.Delay {
+		t.Error("horror should regenerate slower and later than fantasy")
+	}
+	if unknown := NewPlayerHealthRegen("unknown", ClassWarrior); *unknown != *warrior {
+		t.Error("unknown genre should fall back to fantasy")
+	}
+
+	enemy := NewEnemyHealthRegen("fantasy", 200)
+	if math.Abs(enemy.RegenPerSecond-200*healthRegenProfiles["fantasy"].EnemyFraction) > 1e-9 {
+		t.Errorf("enemy regen = %.2f, want a share of max health", enemy.RegenPerSecond)
+	}
+	if enemy.Delay <= warrior.Delay {
+		t.Error("enemies should wait longer than players before regenerating")
+	}
+}
@@ -57,6 +57,7 @@ func createDefaultHelpTopics() map[string]HelpTopic {
 			"Actions:",
 			"  SPACE - Attack / Interact",
 			"  E - Use item",
+			"  G - Pick up / throw objects",
 			"  Q/R/F - Quick spells",
 			"",
 			"Interface:",
@@ -59,6 +59,9 @@ func (h *EbitenHUDSystem) Draw(screen interface{}) {
 	// Draw health bar
 	h.drawHealthBar()
 
+	// Draw active buffs/debuffs below the health bar
+	h.drawStatusEffects()
+
 	// Draw stats panel
 	h.drawStatsPanel()
 
@@ -101,6 +104,39 @@ func (h *EbitenHUDSystem) drawHealthBar() {
 	h.drawText(healthText, int(barX+barWidth/2-30), int(barY+5), color.White)
 }
 
+// drawStatusEffects lists the player's active status effects below the
+// health bar, each with a remaining-duration bar and stack count.
+func (h *EbitenHUDSystem) drawStatusEffects() {
+	entries := CollectStatusHUDEntries(h.playerEntity)
+	if len(entries) == 0 {
+		return
+	}
+
+	x := float32(20)
+	y := float32(48)
+	width := float32(160)
+	height := float32(16)
+	spacing := float32(4)
+
+	for _, entry := range entries {
+		fill := color.RGBA{60, 160, 80, 220} // Buff: green
+		if entry.Debuff {
+			fill = color.RGBA{180, 60, 60, 220} // Debuff: red
+		}
+
+		// Background, remaining-duration fill, and border
+		vector.DrawFilledRect(h.screen, x, y, width, height,
+			color.RGBA{20, 20, 30, 200}, false)
+		vector.DrawFilledRect(h.screen, x, y, width*float32(entry.Progress()), height,
+			fill, false)
+		vector.StrokeRect(h.screen, x, y, width, height, 1,
+			color.RGBA{255, 255, 255, 128}, false)
+
+		h.drawText(entry.Label(), int(x)+4, int(y)+1, color.White)
+		y += height + spacing
+	}
+}
+
 // drawStatsPanel draws the player's stats in the top right.
 func (h *EbitenHUDSystem) drawStatsPanel() {
 	statsComp, hasStats := h.playerEntity.GetComponent("stats")
@@ -221,6 +221,7 @@ type InputSystem struct {
 	KeyAction   ebiten.Key
 	KeyUseItem  ebiten.Key
 	KeyInteract ebiten.Key // F key for interacting with NPCs/merchants
+	KeyCarry    ebiten.Key // G key for picking up and throwing objects
 
 	// GAP-002 REPAIR: Spell casting key bindings (keys 1-5)
 	KeySpell1 ebiten.Key
@@ -271,6 +272,7 @@ type InputSystem struct {
 	onCycleTargets  func()
 	onMenuToggle    func() // Callback for ESC menu toggle
 	onInteract      func() // Callback for F key NPC/merchant interaction
+	onCarry         func() // Callback for G key pick up/throw
 
 	// Priority 2.3: Game state for input filtering
 	currentState GameState
@@ -294,6 +296,7 @@ func NewInputSystem() *InputSystem {
 		KeyAction:   ebiten.KeySpace,
 		KeyUseItem:  ebiten.KeyE,
 		KeyInteract: ebiten.KeyF, // F key for interaction with NPCs/merchants
+		KeyCarry:    ebiten.KeyG, // G key for picking up and throwing objects
 
 		// GAP-002 REPAIR: Spell casting keys (1-5)
 		KeySpell1: ebiten.Key1,
@@ -330,11 +333,19 @@ func NewInputSystem() *InputSystem {
 }
 
 // InitializeVirtualControls sets up virtual controls for mobile platforms and WASM/browser.
-// Should be called after screen size is known.
+// Should be called after screen size is known, and again when it changes to
+// reposition existing controls.
 func (s *InputSystem) InitializeVirtualControls(screenWidth, screenHeight int) {
 	// Initialize virtual controls for any touch-capable platform (mobile or WASM)
 	if s.useTouchInput {
-		s.virtualControls = mobile.NewVirtualControlsLayout(screenWidth, screenHeight)
+		if s.virtualControls != nil {
+			s.virtualControls.Resize(screenWidth, screenHeight)
+		} else {
+			s.virtualControls = mobile.NewVirtualControlsLayout(screenWidth, screenHeight)
+		}
+	}
+	if s.touchHandler != nil {
+		s.touchHandler.SetScreenSize(screenWidth, screenHeight)
 	}
 }
 
@@ -470,6 +481,11 @@ func (s *InputSystem) Update(entities []*Entity, deltaTime float64) {
 		s.onInteract()
 	}
 
+	// Handle picking up and throwing objects (G key)
+	if inpututil.IsKeyJustPressed(s.KeyCarry) && s.onCarry != nil {
+		s.onCarry()
+	}
+
 	// Handle target cycling
 	if inpututil.IsKeyJustPressed(s.KeyCycleTargets) && s.onCycleTargets != nil {
 		s.onCycleTargets()
@@ -766,6 +782,11 @@ func (s *InputSystem) SetInteractCallback(callback func()) {
 	s.onInteract = callback
 }
 
+// SetCarryCallback sets the callback function for picking up and throwing objects (G key).
+func (s *InputSystem) SetCarryCallback(callback func()) {
+	s.onCarry = callback
+}
+
 // SetMenuSystem connects the menu system for ESC key toggling.
 // Deprecated: Use SetMenuToggleCallback instead for better decoupling.
 func (s *InputSystem) SetMenuSystem(menuSystem *EbitenMenuSystem) {
@@ -904,7 +925,7 @@ func (s *InputSystem) GetMouseWheel() (deltaX, deltaY float64) {
 
 // SetKeyBinding sets a specific key binding by action name.
 // BUG-019 fix: Comprehensive key binding API supporting all 18 keys.
-// Valid action names: "up", "down", "left", "right", "action", "useitem",
+// Valid action names: "up", "down", "left", "right", "action", "useitem", "carry",
 // "inventory", "character", "skills", "quests", "map", "crafting",
 // "help", "quicksave", "quickload", "cycletargets"
 func (s *InputSystem) SetKeyBinding(action string, key ebiten.Key) bool {
@@ -923,6 +944,8 @@ func (s *InputSystem) SetKeyBinding(action string, key ebiten.Key) bool {
 		s.KeyAction = key
 	case "useitem":
 		s.KeyUseItem = key
+	case "carry":
+		s.KeyCarry = key
 	// UI
 	case "inventory":
 		s.KeyInventory = key
@@ -970,6 +993,8 @@ func (s *InputSystem) GetKeyBinding(action string) (ebiten.Key, bool) {
 		return s.KeyAction, true
 	case "useitem":
 		return s.KeyUseItem, true
+	case "carry":
+		return s.KeyCarry, true
 	// UI
 	case "inventory":
 		return s.KeyInventory, true
@@ -1009,6 +1034,7 @@ func (s *InputSystem) GetAllKeyBindings() map[string]ebiten.Key {
 		// Actions
 		"action":  s.KeyAction,
 		"useitem": s.KeyUseItem,
+		"carry":   s.KeyCarry,
 		// UI
 		"inventory": s.KeyInventory,
 		"character": s.KeyCharacter,
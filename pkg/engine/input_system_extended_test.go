@@ -278,10 +278,10 @@ func TestInputSystem_GetAllKeyBindings(t *testing.T) {
 
 	bindings := inputSys.GetAllKeyBindings()
 
-	// Should have all 16 actions
+	// Should have all 17 actions
 	expectedActions := []string{
 		"up", "down", "left", "right",
-		"action", "useitem",
+		"action", "useitem", "carry",
 		"inventory", "character", "skills", "quests", "map", "crafting",
 		"help", "quicksave", "quickload", "cycletargets",
 	}
@@ -65,10 +65,26 @@ func SpawnItemInWorld(world *World, itm *item.Item, x, y float64) *Entity {
 	return itemEntity
 }
 
+const (
+	// lootCurrencyChance is the chance an enemy also drops money, rolled
+	// separately from the gear drop
+	lootCurrencyChance = 0.25
+
+	// lootMaterialChance is the chance an enemy also drops a crafting
+	// material, rolled separately from the gear drop
+	lootMaterialChance = 0.25
+)
+
 // GenerateLootDrop creates a random item appropriate for the enemy's level and drops it.
 // Uses the procedural item generator with scaling based on enemy difficulty.
 // Returns nil if no loot should be dropped (based on drop chance).
 func GenerateLootDrop(world *World, enemy *Entity, x, y float64, seed int64, genreID string) *Entity {
+	return GenerateLootDropWithDifficulty(world, enemy, x, y, seed, genreID, DifficultyNormal.Settings())
+}
+
+// GenerateLootDropWithDifficulty creates a loot drop like GenerateLootDrop,
+// with the drop chance and item rarity of the given difficulty settings.
+func GenerateLootDropWithDifficulty(world *World, enemy *Entity, x, y float64, seed int64, genreID string, difficulty DifficultySettings) *Entity {
 	// Calculate drop chance based on enemy type
 	dropChance := 0.3 // 30% base drop chance
 
@@ -79,6 +95,7 @@ func GenerateLootDrop(world *World, enemy *Entity, x, y float64, seed int64, gen
 			dropChance = 0.7 // 70% for strong enemies
 		}
 	}
+	dropChance = difficulty.LootDropChance(dropChance)
 
 	// Roll for drop
 	rng := rand.New(rand.NewSource(seed + int64(enemy.ID)))
@@ -86,6 +103,44 @@ func GenerateLootDrop(world *World, enemy *Entity, x, y float64, seed int64, gen
 		return nil // No drop
 	}
 
+	itm := generateLootItem(enemy, seed+int64(enemy.ID)+100, genreID, difficulty, "")
+	if itm == nil {
+		return nil
+	}
+
+	// Spawn the item in the world
+	return SpawnItemInWorld(world, itm, x, y)
+}
+
+// GenerateBonusLootDrops rolls the money and crafting material an enemy
+// drops in addition to the gear of GenerateLootDropWithDifficulty. Each
+// has its own chance, scaled by the difficulty's loot drop multiplier.
+// Returns the spawned item entities, possibly none.
+func GenerateBonusLootDrops(world *World, enemy *Entity, x, y float64, seed int64, genreID string, difficulty DifficultySettings) []*Entity {
+	rng := rand.New(rand.NewSource(seed + int64(enemy.ID) + 300))
+
+	var drops []*Entity
+	for i, bonus := range []struct {
+		itemType string
+		chance   float64
+	}{
+		{"currency", lootCurrencyChance},
+		{"material", lootMaterialChance},
+	} {
+		if rng.Float64() > difficulty.LootDropChance(bonus.chance) {
+			continue
+		}
+		itm := generateLootItem(enemy, seed+int64(enemy.ID)+300+int64(i), genreID, difficulty, bonus.itemType)
+		if itm != nil {
+			drops = append(drops, SpawnItemInWorld(world, itm, x, y))
+		}
+	}
+	return drops
+}
+
+// generateLootItem generates one item scaled to the enemy's level, of the
+// given item generator type, or any gear type if itemType is empty.
+func generateLootItem(enemy *Entity, seed int64, genreID string, difficulty DifficultySettings, itemType string) *item.Item {
 	// Determine item depth from enemy stats
 	depth := 1
 	if expComp, ok := enemy.GetComponent("experience"); ok {
@@ -100,11 +155,15 @@ func GenerateLootDrop(world *World, enemy *Entity, x, y float64, seed int64, gen
 		Depth:      depth,
 		GenreID:    genreID,
 		Custom: map[string]interface{}{
-			"count": 1,
+			"count":        1,
+			"rarity_bonus": difficulty.LootRarityBonus,
 		},
 	}
+	if itemType != "" {
+		params.Custom["type"] = itemType
+	}
 
-	result, err := itemGen.Generate(seed+int64(enemy.ID)+100, params)
+	result, err := itemGen.Generate(seed, params)
 	if err != nil {
 		return nil
 	}
@@ -113,9 +172,7 @@ func GenerateLootDrop(world *World, enemy *Entity, x, y float64, seed int64, gen
 	if len(items) == 0 {
 		return nil
 	}
-
-	// Spawn the item in the world
-	return SpawnItemInWorld(world, items[0], x, y)
+	return items[0]
 }
 
 // GenerateRecipeDrop creates a random recipe appropriate for the enemy's level and drops it.
@@ -188,6 +245,10 @@ func getItemColor(itm *item.Item) color.RGBA {
 		baseColor = color.RGBA{200, 100, 100, 255} // Red-ish for potions
 	case item.TypeAccessory:
 		baseColor = color.RGBA{200, 200, 100, 255} // Gold-ish for accessories
+	case item.TypeMaterial:
+		baseColor = color.RGBA{140, 110, 80, 255} // Brown for materials
+	case item.TypeCurrency:
+		baseColor = color.RGBA{230, 190, 40, 255} // Bright gold for money
 	default:
 		baseColor = color.RGBA{150, 150, 150, 255} // Gray default
 	}
@@ -232,6 +293,10 @@ type ItemPickupSystem struct {
 	world        *World
 	pickupRadius float64 // How close player needs to be to auto-pickup
 
+	// prompted holds item entities the player has been told they can pick
+	// up, so the prompt is not repeated every frame
+	prompted map[uint64]bool
+
 	// GAP-015 REPAIR: System references for feedback
 	audioManager   *AudioManager
 	tutorialSystem *EbitenTutorialSystem
@@ -242,6 +307,7 @@ func NewItemPickupSystem(world *World) *ItemPickupSystem {
 	return &ItemPickupSystem{
 		world:        world,
 		pickupRadius: 32.0, // Default pickup radius (one tile)
+		prompted:     make(map[uint64]bool),
 	}
 }
 
@@ -272,6 +338,17 @@ func (s *ItemPickupSystem) getTutorialSystem() *EbitenTutorialSystem {
 	return s.tutorialSystem
 }
 
+// interactKeyName returns the display name of the key bound to interact,
+// which also picks up prompted items.
+func (s *ItemPickupSystem) interactKeyName() string {
+	for _, sys := range s.world.GetSystems() {
+		if inputSys, ok := sys.(*InputSystem); ok {
+			return KeyName(inputSys.KeyInteract)
+		}
+	}
+	return "F" // InputSystem default
+}
+
 // Update checks for item-player collisions and handles pickup.
 func (s *ItemPickupSystem) Update(entities []*Entity, deltaTime float64) {
 	// Find player entities (those with input component)
@@ -323,31 +400,20 @@ func (s *ItemPickupSystem) Update(entities []*Entity, deltaTime float64) {
 
 			// Check distance for pickup (32 pixels = 1 tile)
 			distance := GetDistance(player, itemEntity)
-			if distance <= 32.0 {
-				// Attempt to add item to inventory
-				if inventory.CanAddItem(itemData.Item) {
-					inventory.Items = append(inventory.Items, itemData.Item)
-
-					// Remove item entity from world
-					s.world.RemoveEntity(itemEntity.ID)
-
-					// GAP-015 REPAIR: Play pickup sound effect
-					if audioSys := s.getAudioManager(); audioSys != nil {
-						if err := audioSys.PlaySFX("pickup", int64(itemEntity.ID)); err != nil {
-							// Audio failure is non-critical, log and continue
-							_ = err
-						}
-					}
+			if distance > 32.0 {
+				delete(s.prompted, itemEntity.ID)
+				continue
+			}
 
-					// GAP-015 REPAIR: Show pickup notification
-					if tutorialSys := s.getTutorialSystem(); tutorialSys != nil {
-						notifText := fmt.Sprintf("Picked up: %s", itemData.Item.Name)
-						tutorialSys.ShowNotification(notifText, 2.0)
-					}
-				} else {
-					// GAP-015 REPAIR: Show "inventory full" message
+			switch pickupActionFor(player, itemData.Item) {
+			case PickupAuto:
+				s.pickUpItem(inventory, itemEntity, itemData)
+			case PickupPrompt:
+				// Remind the player once each time they step onto the item
+				if !s.prompted[itemEntity.ID] {
+					s.prompted[itemEntity.ID] = true
 					if tutorialSys := s.getTutorialSystem(); tutorialSys != nil {
-						tutorialSys.ShowNotification("Inventory full!", 2.0)
+						tutorialSys.ShowNotification(fmt.Sprintf("Press %s to pick up: %s", s.interactKeyName(), itemData.Item.Name), 2.0)
 					}
 				}
 			}
@@ -428,6 +494,74 @@ func (s *ItemPickupSystem) Update(entities []*Entity, deltaTime float64) {
 	}
 }
 
+// pickUpItem moves an item entity into inventory, returning false if the
+// inventory has no room for it.
+func (s *ItemPickupSystem) pickUpItem(inventory *InventoryComponent, itemEntity *Entity, itemData *ItemEntityComponent) bool {
+	notifText := fmt.Sprintf("Picked up: %s", itemData.Item.Name)
+	if IsCurrencyItem(itemData.Item) {
+		// Money goes straight to gold and takes no inventory slot
+		inventory.Gold += itemData.Item.Stats.Value
+		notifText = fmt.Sprintf("Picked up %d gold", itemData.Item.Stats.Value)
+	} else {
+		if !inventory.CanAddItem(itemData.Item) {
+			// GAP-015 REPAIR: Show "inventory full" message
+			if tutorialSys := s.getTutorialSystem(); tutorialSys != nil {
+				tutorialSys.ShowNotification("Inventory full!", 2.0)
+			}
+			return false
+		}
+		inventory.Items = append(inventory.Items, itemData.Item)
+	}
+
+	// Remove item entity from world
+	s.world.RemoveEntity(itemEntity.ID)
+	delete(s.prompted, itemEntity.ID)
+
+	// GAP-015 REPAIR: Play pickup sound effect
+	if audioSys := s.getAudioManager(); audioSys != nil {
+		if err := audioSys.PlaySFX("pickup", int64(itemEntity.ID)); err != nil {
+			// Audio failure is non-critical, log and continue
+			_ = err
+		}
+	}
+
+	// GAP-015 REPAIR: Show pickup notification
+	if tutorialSys := s.getTutorialSystem(); tutorialSys != nil {
+		tutorialSys.ShowNotification(notifText, 2.0)
+	}
+	return true
+}
+
+// PickUpNearest picks up the closest item within reach of player regardless
+// of their pickup filter. Used for items the filter only prompts for or
+// ignores. Returns true if an item was picked up.
+func (s *ItemPickupSystem) PickUpNearest(player *Entity) bool {
+	if player.GetPosition() == nil {
+		return false
+	}
+	inventoryComp, ok := player.GetComponent("inventory")
+	if !ok {
+		return false
+	}
+
+	var nearest *Entity
+	nearestDist := s.pickupRadius
+	for _, entity := range s.world.GetEntities() {
+		if !entity.HasComponent("item_entity") || entity.GetPosition() == nil {
+			continue
+		}
+		if dist := GetDistance(player, entity); dist <= nearestDist {
+			nearest, nearestDist = entity, dist
+		}
+	}
+	if nearest == nil {
+		return false
+	}
+
+	itemComp, _ := nearest.GetComponent("item_entity")
+	return s.pickUpItem(inventoryComp.(*InventoryComponent), nearest, itemComp.(*ItemEntityComponent))
+}
+
 // RecipeEntityComponent marks an entity as representing a collectable recipe in the world.
 // When the player collides with this entity, the recipe is learned.
 type RecipeEntityComponent struct {
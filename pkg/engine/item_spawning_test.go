@@ -331,6 +331,45 @@ func TestGenerateLootDrop_NoDrop(t *testing.T) {
 	_ = loot
 }
 
+// TestGenerateBonusLootDrops tests that money and materials drop on top of
+// gear instead of taking its place.
+func TestGenerateBonusLootDrops(t *testing.T) {
+	world := NewWorld()
+	enemy := world.CreateEntity()
+	enemy.AddComponent(&ExperienceComponent{Level: 3})
+	enemy.AddComponent(NewStatsComponent())
+
+	const kills = 400
+	gear, currency, material := 0, 0, 0
+	for i := int64(0); i < kills; i++ {
+		if loot := GenerateLootDrop(world, enemy, 0, 0, i, "fantasy"); loot != nil {
+			itemComp, _ := loot.GetComponent("item_entity")
+			if itm := itemComp.(*ItemEntityComponent).Item; itm.Type == item.TypeCurrency || itm.Type == item.TypeMaterial {
+				t.Fatalf("gear drop was %v", itm.Type)
+			}
+			gear++
+		}
+		for _, bonus := range GenerateBonusLootDrops(world, enemy, 0, 0, i, "fantasy", DifficultyNormal.Settings()) {
+			itemComp, _ := bonus.GetComponent("item_entity")
+			switch itm := itemComp.(*ItemEntityComponent).Item; itm.Type {
+			case item.TypeCurrency:
+				currency++
+			case item.TypeMaterial:
+				material++
+			default:
+				t.Fatalf("bonus drop was %v", itm.Type)
+			}
+		}
+	}
+
+	// 30% gear, and 25% each for money and materials
+	for name, count := range map[string]int{"gear": gear, "currency": currency, "material": material} {
+		if rate := float64(count) / kills; rate < 0.15 || rate > 0.4 {
+			t.Errorf("%s drop rate = %.2f", name, rate)
+		}
+	}
+}
+
 // TestItemPickupSystem_FullInventory tests the inventory full case.
 func TestItemPickupSystem_FullInventory(t *testing.T) {
 	world := NewWorld()
@@ -21,6 +21,7 @@ const (
 	ActionAttack
 	ActionUseItem
 	ActionSecondary
+	ActionCarry
 
 	// Spell casting actions
 	ActionCastSpell1
@@ -63,6 +64,8 @@ func (a Action) String() string {
 		return "Use Item"
 	case ActionSecondary:
 		return "Secondary Action"
+	case ActionCarry:
+		return "Pick Up/Throw"
 	case ActionCastSpell1:
 		return "Cast Spell 1"
 	case ActionCastSpell2:
@@ -123,6 +126,7 @@ func (r *KeyBindingRegistry) loadDefaults() {
 	r.bindings[ActionAttack] = ebiten.KeySpace
 	r.bindings[ActionUseItem] = ebiten.KeyE
 	r.bindings[ActionSecondary] = ebiten.KeyShiftLeft
+	r.bindings[ActionCarry] = ebiten.KeyG
 
 	// Spells
 	r.bindings[ActionCastSpell1] = ebiten.Key1
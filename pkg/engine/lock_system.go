@@ -0,0 +1,169 @@
+// Package engine provides locked doors and their keys.
+// This file implements the keys and locked doors placed by world
+// generation: a player collects a key by walking over it, and a locked
+// door opens when a player holding its key walks up to it.
+package engine
+
+import "image/color"
+
+// DefaultLockRadius is how close, in pixels, a player must be to a key to
+// collect it or to a locked door to open it.
+const DefaultLockRadius = 40.0
+
+// KeyComponent marks a collectible key entity.
+type KeyComponent struct {
+	// Tag pairs the key with the doors it opens
+	Tag string
+}
+
+// Type returns the component type identifier.
+func (k *KeyComponent) Type() string {
+	return "key"
+}
+
+// LockedDoorComponent marks a door entity that blocks movement until a
+// player holding the key with the same tag opens it.
+type LockedDoorComponent struct {
+	// Tag of the key that opens the door
+	Tag string
+}
+
+// Type returns the component type identifier.
+func (d *LockedDoorComponent) Type() string {
+	return "locked_door"
+}
+
+// KeyringComponent holds the tags of the keys a player has collected.
+type KeyringComponent struct {
+	Keys map[string]bool
+}
+
+// NewKeyringComponent creates an empty keyring.
+func NewKeyringComponent() *KeyringComponent {
+	return &KeyringComponent{Keys: make(map[string]bool)}
+}
+
+// Type returns the component type identifier.
+func (k *KeyringComponent) Type() string {
+	return "keyring"
+}
+
+// Has reports whether the keyring holds the key with the given tag.
+func (k *KeyringComponent) Has(tag string) bool {
+	return k.Keys[tag]
+}
+
+// SpawnKey creates a key entity at the given world position.
+func SpawnKey(world *World, tag string, x, y float64) *Entity {
+	key := world.CreateEntity()
+	key.AddComponent(&PositionComponent{X: x, Y: y})
+
+	sprite := NewSpriteComponent(16, 16, color.RGBA{255, 215, 0, 255}) // Gold
+	sprite.Layer = 3                                                   // Drawn with items
+	key.AddComponent(sprite)
+
+	key.AddComponent(&KeyComponent{Tag: tag})
+	return key
+}
+
+// SpawnLockedDoor creates a locked door filling the tile whose center is
+// at the given world position.
+func SpawnLockedDoor(world *World, tag string, x, y, tileSize float64) *Entity {
+	door := world.CreateEntity()
+	door.AddComponent(&PositionComponent{X: x, Y: y})
+
+	sprite := NewSpriteComponent(tileSize, tileSize, color.RGBA{120, 80, 40, 255}) // Wood brown
+	sprite.Layer = 4
+	door.AddComponent(sprite)
+
+	door.AddComponent(&ColliderComponent{
+		Width:   tileSize,
+		Height:  tileSize,
+		Solid:   true,
+		Layer:   1,
+		OffsetX: -tileSize / 2,
+		OffsetY: -tileSize / 2,
+	})
+
+	door.AddComponent(&LockedDoorComponent{Tag: tag})
+	return door
+}
+
+// LockSystem lets players collect keys and open locked doors.
+type LockSystem struct {
+	world *World
+
+	// Radius is how close a player must be to a key or door, in pixels
+	Radius float64
+}
+
+// NewLockSystem creates a lock system with DefaultLockRadius.
+func NewLockSystem(world *World) *LockSystem {
+	return &LockSystem{
+		world:  world,
+		Radius: DefaultLockRadius,
+	}
+}
+
+// Update collects keys and opens doors within reach of living players.
+func (s *LockSystem) Update(entities []*Entity, deltaTime float64) {
+	var players []*Entity
+	for _, entity := range entities {
+		if entity.HasComponent("input") && entity.HasComponent("position") && !entity.HasComponent("dead") {
+			players = append(players, entity)
+		}
+	}
+	if len(players) == 0 {
+		return
+	}
+
+	for _, entity := range entities {
+		if keyComp, ok := entity.GetComponent("key"); ok {
+			if player := s.playerInReach(players, entity, ""); player != nil {
+				keyring := playerKeyring(player)
+				keyring.Keys[keyComp.(*KeyComponent).Tag] = true
+
+				// Removal is deferred; drop the key now so it is only collected once
+				entity.RemoveComponent("key")
+				s.world.RemoveEntity(entity.ID)
+			}
+		}
+
+		if doorComp, ok := entity.GetComponent("locked_door"); ok {
+			if s.playerInReach(players, entity, doorComp.(*LockedDoorComponent).Tag) != nil {
+				entity.RemoveComponent("locked_door")
+				s.world.RemoveEntity(entity.ID)
+			}
+		}
+	}
+}
+
+// playerInReach returns the first player within Radius of target that
+// holds the key with the given tag, or any player if tag is empty.
+func (s *LockSystem) playerInReach(players []*Entity, target *Entity, tag string) *Entity {
+	if !target.HasComponent("position") {
+		return nil
+	}
+	for _, player := range players {
+		if GetDistance(player, target) > s.Radius {
+			continue
+		}
+		if tag == "" {
+			return player
+		}
+		if keyringComp, ok := player.GetComponent("keyring"); ok && keyringComp.(*KeyringComponent).Has(tag) {
+			return player
+		}
+	}
+	return nil
+}
+
+// playerKeyring returns the player's keyring, adding one if needed.
+func playerKeyring(player *Entity) *KeyringComponent {
+	if keyringComp, ok := player.GetComponent("keyring"); ok {
+		return keyringComp.(*KeyringComponent)
+	}
+	keyring := NewKeyringComponent()
+	player.AddComponent(keyring)
+	return keyring
+}
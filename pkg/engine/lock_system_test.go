@@ -0,0 +1,44 @@
+package engine
+
+import "testing"
+
+func TestLockSystem_KeyOpensMatchingDoor(t *testing.T) {
+	world := NewWorld()
+	locks := NewLockSystem(world)
+
+	player := world.CreateEntity()
+	player.AddComponent(&EbitenInput{})
+	player.AddComponent(&PositionComponent{X: 0, Y: 0})
+
+	key := SpawnKey(world, "key_1", 10, 0)
+	door := SpawnLockedDoor(world, "key_1", 200, 0, 32)
+	otherDoor := SpawnLockedDoor(world, "key_2", 200, 20, 32)
+	world.Update(0)
+
+	locks.Update(world.GetEntities(), 0)
+	world.Update(0)
+	if _, ok := world.GetEntity(key.ID); ok {
+		t.Fatal("key within reach should be collected")
+	}
+	keyringComp, ok := player.GetComponent("keyring")
+	if !ok || !keyringComp.(*KeyringComponent).Has("key_1") {
+		t.Fatal("collected key missing from the player's keyring")
+	}
+
+	// The door stays shut until the player walks up to it
+	locks.Update(world.GetEntities(), 0)
+	world.Update(0)
+	if _, ok := world.GetEntity(door.ID); !ok {
+		t.Fatal("door opened from across the room")
+	}
+
+	player.GetPosition().X = 180
+	locks.Update(world.GetEntities(), 0)
+	world.Update(0)
+	if _, ok := world.GetEntity(door.ID); ok {
+		t.Error("door should open for the holder of its key")
+	}
+	if _, ok := world.GetEntity(otherDoor.ID); !ok {
+		t.Error("door opened without its key")
+	}
+}
@@ -32,8 +32,10 @@ type EbitenMapUI struct {
 	offsetY        float64       // Pan offset Y
 
 	// Minimap settings
-	minimapSize    int // Size in pixels (square)
-	minimapPadding int // Distance from screen edge
+	minimapSize    int           // Size in pixels (square)
+	minimapPadding int           // Distance from screen edge
+	minimapRange   float64       // World pixels from player to minimap edge
+	minimapBlips   []MinimapBlip // Entity blips, refreshed every Update
 }
 
 // NewMapUI creates a new map UI system.
@@ -54,6 +56,7 @@ func NewEbitenMapUI(world *World, screenWidth, screenHeight int) *EbitenMapUI {
 		scale:          1.0,
 		minimapSize:    150,
 		minimapPadding: 10,
+		minimapRange:   DefaultMinimapRange,
 		mapNeedsUpdate: true,
 	}
 }
@@ -87,6 +90,19 @@ func (ui *EbitenMapUI) SetTerrain(terrain *terrain.Terrain) {
 	ui.mapNeedsUpdate = true
 }
 
+// SetMinimapRange sets the distance in world pixels from the player to the
+// minimap edge. Non-positive values are ignored.
+func (ui *EbitenMapUI) SetMinimapRange(worldRange float64) {
+	if worldRange > 0 {
+		ui.minimapRange = worldRange
+	}
+}
+
+// MinimapBlips returns the entity blips collected on the last Update.
+func (ui *EbitenMapUI) MinimapBlips() []MinimapBlip {
+	return ui.minimapBlips
+}
+
 // GAP-005 REPAIR: Add fog of war getter for save/load system
 // GetFogOfWar returns a copy of the fog of war exploration state.
 // Returns: 2D boolean array where true = explored
@@ -165,6 +181,12 @@ func (ui *EbitenMapUI) Update(entities []*Entity, deltaTime float64) {
 	// Always update fog of war (even when not visible)
 	ui.updateFogOfWar()
 
+	// Refresh minimap blips from the live entity list
+	if entities == nil && ui.world != nil {
+		entities = ui.world.GetEntities()
+	}
+	ui.minimapBlips = CollectMinimapBlips(ui.playerEntity, entities, ui.minimapRange, ui.minimapSize)
+
 	if !ui.visible {
 		return
 	}
@@ -267,14 +289,27 @@ func (ui *EbitenMapUI) drawMinimap(screen *ebiten.Image) {
 		float32(ui.minimapSize), float32(ui.minimapSize), 2,
 		color.RGBA{255, 255, 255, 255}, false)
 
-	// Calculate tile scaling
-	scaleX := float64(ui.minimapSize) / float64(ui.terrain.Width)
-	scaleY := float64(ui.minimapSize) / float64(ui.terrain.Height)
-	tileScale := math.Min(scaleX, scaleY)
+	posComp, ok := ui.playerEntity.GetComponent("position")
+	if !ok {
+		return
+	}
+	pos := posComp.(*PositionComponent)
+
+	// The minimap is centered on the player and spans minimapRange in each
+	// direction (assuming 32px tiles)
+	centerX := float64(mapX) + float64(ui.minimapSize)/2
+	centerY := float64(mapY) + float64(ui.minimapSize)/2
+	tileScale := float64(ui.minimapSize) / 2 / ui.minimapRange * 32
+	radius := int(math.Ceil(ui.minimapRange / 32))
+	playerTileX := int(pos.X / 32)
+	playerTileY := int(pos.Y / 32)
 
 	// Draw terrain tiles
-	for y := 0; y < ui.terrain.Height; y++ {
-		for x := 0; x < ui.terrain.Width; x++ {
+	for y := playerTileY - radius; y <= playerTileY+radius; y++ {
+		for x := playerTileX - radius; x <= playerTileX+radius; x++ {
+			if x < 0 || x >= ui.terrain.Width || y < 0 || y >= ui.terrain.Height {
+				continue
+			}
 			if !ui.fogOfWar[y][x] {
 				continue // Skip unexplored tiles
 			}
@@ -282,34 +317,29 @@ func (ui *EbitenMapUI) drawMinimap(screen *ebiten.Image) {
 			tileType := ui.terrain.GetTile(x, y)
 			tileColor := ui.getTileColor(tileType, true)
 
-			pixelX := float32(mapX) + float32(float64(x)*tileScale)
-			pixelY := float32(mapY) + float32(float64(y)*tileScale)
-			pixelSize := float32(tileScale)
-
-			if pixelSize < 1 {
-				pixelSize = 1
+			// Clip tiles to the minimap square
+			left := math.Max(centerX+(float64(x*32)-pos.X)/32*tileScale, float64(mapX))
+			top := math.Max(centerY+(float64(y*32)-pos.Y)/32*tileScale, float64(mapY))
+			right := math.Min(centerX+(float64((x+1)*32)-pos.X)/32*tileScale, float64(mapX+ui.minimapSize))
+			bottom := math.Min(centerY+(float64((y+1)*32)-pos.Y)/32*tileScale, float64(mapY+ui.minimapSize))
+			if right <= left || bottom <= top {
+				continue
 			}
 
-			vector.DrawFilledRect(screen, pixelX, pixelY, pixelSize, pixelSize, tileColor, false)
+			vector.DrawFilledRect(screen, float32(left), float32(top),
+				float32(math.Max(right-left, 1)), float32(math.Max(bottom-top, 1)), tileColor, false)
 		}
 	}
 
-	// Draw player icon
-	if posComp, ok := ui.playerEntity.GetComponent("position"); ok {
-		pos := posComp.(*PositionComponent)
-		// Convert world position to tile coordinates (assuming 32px tiles)
-		tileX := int(pos.X / 32)
-		tileY := int(pos.Y / 32)
-
-		if tileX >= 0 && tileX < ui.terrain.Width && tileY >= 0 && tileY < ui.terrain.Height {
-			pixelX := float32(mapX) + float32(float64(tileX)*tileScale)
-			pixelY := float32(mapY) + float32(float64(tileY)*tileScale)
-
-			// Draw player as blue circle
-			vector.DrawFilledCircle(screen, pixelX, pixelY, 3, color.RGBA{100, 150, 255, 255}, false)
-		}
+	// Draw entity blips
+	for _, blip := range ui.minimapBlips {
+		vector.DrawFilledCircle(screen, float32(centerX+blip.X), float32(centerY+blip.Y),
+			2, blip.Kind.Color(), false)
 	}
 
+	// Draw player as blue circle at the center
+	vector.DrawFilledCircle(screen, float32(centerX), float32(centerY), 3, color.RGBA{100, 150, 255, 255}, false)
+
 	// Draw compass rose (N indicator)
 	compassText := "N"
 	text.Draw(screen, compassText, basicfont.Face7x13,
@@ -14,6 +14,12 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// Merchant wander radii in pixels.
+const (
+	FixedMerchantWanderRadius   = 48.0
+	NomadicMerchantWanderRadius = 128.0
+)
+
 // SpawnMerchantFromData converts procedural MerchantData into an engine entity.
 // This function creates the entity, adds all required components (position, sprite,
 // collider, merchant, dialog), and registers it with the world.
@@ -30,7 +36,7 @@ func SpawnMerchantFromData(world *World, merchantData *procgenEntity.MerchantDat
 	// Add position
 	merchant.AddComponent(&PositionComponent{X: x, Y: y})
 
-	// Add velocity (merchants are stationary by default)
+	// Add velocity (driven by the merchant's wander schedule)
 	merchant.AddComponent(&VelocityComponent{VX: 0, VY: 0})
 
 	// Add health (merchants are non-combatants)
@@ -102,6 +108,14 @@ func SpawnMerchantFromData(world *World, merchantData *procgenEntity.MerchantDat
 	dialogComp := NewDialogComponent(dialogProvider)
 	merchant.AddComponent(dialogComp)
 
+	// Wander around the stall: fixed merchants pace their shop, nomadic
+	// merchants roam a little further
+	wanderRadius := FixedMerchantWanderRadius
+	if engineMerchantType == MerchantNomadic {
+		wanderRadius = NomadicMerchantWanderRadius
+	}
+	merchant.AddComponent(NewWanderSchedule(x, y, wanderRadius, merchantData.Entity.Seed))
+
 	return merchant
 }
 
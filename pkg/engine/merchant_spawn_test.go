@@ -96,6 +96,17 @@ func TestSpawnMerchantFromData(t *testing.T) {
 				t.Errorf("position = (%.0f, %.0f), want (%.0f, %.0f)", pos.X, pos.Y, tt.x, tt.y)
 			}
 
+			// Verify the merchant wanders around its spawn point
+			scheduleComp, ok := result.GetComponent("npc_schedule")
+			if !ok {
+				t.Fatal("merchant missing npc_schedule component")
+			}
+			schedule := scheduleComp.(*NPCScheduleComponent)
+			if schedule.HomeX != tt.x || schedule.HomeY != tt.y || schedule.Radius != FixedMerchantWanderRadius {
+				t.Errorf("schedule area = (%.0f, %.0f) r%.0f, want (%.0f, %.0f) r%.0f",
+					schedule.HomeX, schedule.HomeY, schedule.Radius, tt.x, tt.y, FixedMerchantWanderRadius)
+			}
+
 			// Verify merchant component
 			merchComp, ok := result.GetComponent("merchant")
 			if !ok {
@@ -0,0 +1,169 @@
+// Package engine provides minimap entity blips.
+// This file classifies live entities for the minimap and projects them
+// into minimap pixel offsets relative to the player.
+package engine
+
+import (
+	"image/color"
+	"math"
+)
+
+// DefaultMinimapRange is the distance in world pixels from the player to the
+// minimap edge (20 tiles at 32px per tile).
+const DefaultMinimapRange = 640.0
+
+// MinimapBlipKind identifies what a minimap blip represents.
+type MinimapBlipKind int
+
+const (
+	// BlipEnemy marks a hostile entity
+	BlipEnemy MinimapBlipKind = iota
+	// BlipMerchant marks a merchant NPC
+	BlipMerchant
+	// BlipQuestTarget marks an entity tagged as a quest target
+	BlipQuestTarget
+	// BlipPlayer marks another player
+	BlipPlayer
+)
+
+// String returns the string representation of a blip kind.
+func (k MinimapBlipKind) String() string {
+	switch k {
+	case BlipEnemy:
+		return "enemy"
+	case BlipMerchant:
+		return "merchant"
+	case BlipQuestTarget:
+		return "quest_target"
+	case BlipPlayer:
+		return "player"
+	default:
+		return "unknown"
+	}
+}
+
+// Color returns the minimap color for a blip kind.
+func (k MinimapBlipKind) Color() color.RGBA {
+	switch k {
+	case BlipEnemy:
+		return color.RGBA{255, 60, 60, 255}
+	case BlipMerchant:
+		return color.RGBA{255, 215, 0, 255}
+	case BlipQuestTarget:
+		return color.RGBA{200, 100, 255, 255}
+	case BlipPlayer:
+		return color.RGBA{80, 220, 120, 255}
+	default:
+		return color.RGBA{150, 150, 150, 255}
+	}
+}
+
+// QuestTargetComponent marks an entity as the target of a quest objective
+// so it can be highlighted on the minimap. ObjectiveTrackerSystem attaches
+// it to enemies and items named by active objectives.
+type QuestTargetComponent struct {
+	// QuestID is the quest this entity belongs to
+	QuestID string
+}
+
+// Type returns the component type identifier.
+func (q *QuestTargetComponent) Type() string {
+	return "quest_target"
+}
+
+// MinimapBlip is an entity marker on the minimap.
+type MinimapBlip struct {
+	// EntityID of the entity the blip represents
+	EntityID uint64
+
+	// Kind determines the blip color
+	Kind MinimapBlipKind
+
+	// X and Y are the offset in minimap pixels from the minimap center
+	X, Y float64
+}
+
+// ClassifyMinimapBlip reports which kind of blip, if any, an entity shows as
+// from the viewpoint of player. Quest targets take priority over merchants,
+// merchants over players, and players over enemies. Dead entities, the
+// player itself, and entities on the player's team (or neutral) are hidden.
+func ClassifyMinimapBlip(entity, player *Entity) (MinimapBlipKind, bool) {
+	if entity == nil || entity == player || entity.HasComponent("dead") {
+		return 0, false
+	}
+	if entity.HasComponent("quest_target") {
+		return BlipQuestTarget, true
+	}
+	if entity.HasComponent("merchant") {
+		return BlipMerchant, true
+	}
+	if entity.HasComponent("input") {
+		return BlipPlayer, true
+	}
+	if netComp, ok := entity.GetComponent("network"); ok {
+		if netComp.(*NetworkComponent).PlayerID != 0 {
+			return BlipPlayer, true
+		}
+	}
+
+	teamComp, ok := entity.GetComponent("team")
+	if !ok {
+		return 0, false
+	}
+	team := teamComp.(*TeamComponent)
+
+	// Players without a team component count as team 1
+	playerTeam := 1
+	if player != nil {
+		if pt, ok := player.GetComponent("team"); ok {
+			playerTeam = pt.(*TeamComponent).TeamID
+		}
+	}
+	if team.IsEnemy(playerTeam) {
+		return BlipEnemy, true
+	}
+	return 0, false
+}
+
+// CollectMinimapBlips returns blips for every classified entity within
+// worldRange pixels of the player on both axes, projected so that
+// worldRange maps to the minimap edge (minimapSize/2 pixels from center).
+// Returns nil if the player has no position.
+func CollectMinimapBlips(player *Entity, entities []*Entity, worldRange float64, minimapSize int) []MinimapBlip {
+	if player == nil || worldRange <= 0 || minimapSize <= 0 {
+		return nil
+	}
+	playerPosComp, ok := player.GetComponent("position")
+	if !ok {
+		return nil
+	}
+	playerPos := playerPosComp.(*PositionComponent)
+	scale := float64(minimapSize) / 2 / worldRange
+
+	var blips []MinimapBlip
+	for _, entity := range entities {
+		kind, ok := ClassifyMinimapBlip(entity, player)
+		if !ok {
+			continue
+		}
+		posComp, ok := entity.GetComponent("position")
+		if !ok {
+			continue
+		}
+		pos := posComp.(*PositionComponent)
+
+		dx := pos.X - playerPos.X
+		dy := pos.Y - playerPos.Y
+		if math.Abs(dx) > worldRange || math.Abs(dy) > worldRange {
+			continue
+		}
+
+		blips = append(blips, MinimapBlip{
+			EntityID: entity.ID,
+			Kind:     kind,
+			X:        dx * scale,
+			Y:        dy * scale,
+		})
+	}
+	return blips
+}
@@ -0,0 +1,146 @@
+package engine
+
+import (
+	"math"
+	"testing"
+)
+
+func minimapTestPlayer(world *World, x, y float64) *Entity {
+	player := world.CreateEntity()
+	player.AddComponent(&PositionComponent{X: x, Y: y})
+	player.AddComponent(&EbitenInput{}) // Mark as player
+	player.AddComponent(&TeamComponent{TeamID: 1})
+	return player
+}
+
+func TestClassifyMinimapBlip(t *testing.T) {
+	world := NewWorld()
+	player := minimapTestPlayer(world, 0, 0)
+
+	enemy := world.CreateEntity()
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+
+	ally := world.CreateEntity()
+	ally.AddComponent(&TeamComponent{TeamID: 1})
+
+	merchant := world.CreateEntity()
+	merchant.AddComponent(&MerchantComponent{})
+
+	target := world.CreateEntity()
+	target.AddComponent(&TeamComponent{TeamID: 2})
+	target.AddComponent(&QuestTargetComponent{QuestID: "q1"})
+
+	other := world.CreateEntity()
+	other.AddComponent(&NetworkComponent{PlayerID: 7})
+
+	corpse := world.CreateEntity()
+	corpse.AddComponent(&TeamComponent{TeamID: 2})
+	corpse.AddComponent(NewDeadComponent(0))
+
+	item := world.CreateEntity()
+
+	tests := []struct {
+		name   string
+		entity *Entity
+		want   MinimapBlipKind
+		shown  bool
+	}{
+		{"enemy", enemy, BlipEnemy, true},
+		{"ally", ally, 0, false},
+		{"merchant", merchant, BlipMerchant, true},
+		{"quest target", target, BlipQuestTarget, true},
+		{"remote player", other, BlipPlayer, true},
+		{"dead", corpse, 0, false},
+		{"no team", item, 0, false},
+		{"self", player, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, shown := ClassifyMinimapBlip(tt.entity, player)
+			if shown != tt.shown || (shown && kind != tt.want) {
+				t.Errorf("ClassifyMinimapBlip = %v, %v; want %v, %v", kind, shown, tt.want, tt.shown)
+			}
+		})
+	}
+}
+
+func TestCollectMinimapBlips_RelativePosition(t *testing.T) {
+	world := NewWorld()
+	player := minimapTestPlayer(world, 1000, 1000)
+
+	enemy := world.CreateEntity()
+	enemy.AddComponent(&PositionComponent{X: 1320, Y: 840})
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+
+	entities := []*Entity{player, enemy}
+	blips := CollectMinimapBlips(player, entities, 640, 160)
+	if len(blips) != 1 {
+		t.Fatalf("got %d blips, want 1", len(blips))
+	}
+
+	// 640 world pixels map to 80 minimap pixels, so the scale is 1/8
+	blip := blips[0]
+	if blip.EntityID != enemy.ID || blip.Kind != BlipEnemy {
+		t.Errorf("blip = %+v, want enemy %d", blip, enemy.ID)
+	}
+	if math.Abs(blip.X-40) > 1e-9 || math.Abs(blip.Y+20) > 1e-9 {
+		t.Errorf("blip offset = (%v,%v), want (40,-20)", blip.X, blip.Y)
+	}
+	if blip.Kind.Color() != BlipEnemy.Color() {
+		t.Error("blip color should come from its kind")
+	}
+}
+
+func TestCollectMinimapBlips_LeavingRange(t *testing.T) {
+	world := NewWorld()
+	player := minimapTestPlayer(world, 0, 0)
+
+	enemy := world.CreateEntity()
+	enemyPos := &PositionComponent{X: 600, Y: 0}
+	enemy.AddComponent(enemyPos)
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+	entities := []*Entity{player, enemy}
+
+	if blips := CollectMinimapBlips(player, entities, 640, 160); len(blips) != 1 {
+		t.Fatalf("enemy in range: got %d blips, want 1", len(blips))
+	}
+
+	enemyPos.X = 700
+	if blips := CollectMinimapBlips(player, entities, 640, 160); len(blips) != 0 {
+		t.Errorf("enemy out of range: got %d blips, want 0", len(blips))
+	}
+
+	// Moving the player back toward the enemy brings it into range again
+	playerPos, _ := player.GetComponent("position")
+	playerPos.(*PositionComponent).X = 100
+	if blips := CollectMinimapBlips(player, entities, 640, 160); len(blips) != 1 {
+		t.Errorf("player moved closer: got %d blips, want 1", len(blips))
+	}
+}
+
+func TestCollectMinimapBlips_NoPlayer(t *testing.T) {
+	if blips := CollectMinimapBlips(nil, nil, 640, 160); blips != nil {
+		t.Errorf("expected nil blips without a player, got %v", blips)
+	}
+
+	world := NewWorld()
+	player := world.CreateEntity()
+	if blips := CollectMinimapBlips(player, []*Entity{player}, 640, 160); blips != nil {
+		t.Errorf("expected nil blips for player without position, got %v", blips)
+	}
+}
+
+func TestMinimapBlipKind_String(t *testing.T) {
+	kinds := map[MinimapBlipKind]string{
+		BlipEnemy:       "enemy",
+		BlipMerchant:    "merchant",
+		BlipQuestTarget: "quest_target",
+		BlipPlayer:      "player",
+		99:              "unknown",
+	}
+	for kind, want := range kinds {
+		if got := kind.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", kind, got, want)
+		}
+	}
+}
@@ -0,0 +1,370 @@
+// Package engine provides NPC schedules.
+// This file implements NPCScheduleSystem, which moves non-combat NPCs
+// around their designated area: merchants pace their shop, guards walk a
+// patrol route. Movement follows tile paths and is driven by a seeded RNG,
+// so the same seed produces the same wandering.
+package engine
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// NPC schedule tuning constants
+const (
+	// DefaultNPCSpeed is the walking speed of scheduled NPCs in pixels/second
+	DefaultNPCSpeed = 40.0
+	// DefaultNPCPauseRadius is how close a player must be for an NPC to stop
+	// and wait (slightly beyond merchant interaction range)
+	DefaultNPCPauseRadius = 80.0
+	// npcArriveDistance is how close an NPC must get to a path point to
+	// move on to the next
+	npcArriveDistance = 4.0
+	// npcStuckTimeout is how long an NPC may make no progress before
+	// abandoning its path and choosing again
+	npcStuckTimeout = 2.0
+	// npcWanderAttempts is how many random points a wander tries before
+	// waiting and trying again next frame
+	npcWanderAttempts = 8
+)
+
+// NPCScheduleKind selects how a scheduled NPC moves.
+type NPCScheduleKind int
+
+const (
+	// ScheduleWander walks to random points within the area, pausing at each
+	ScheduleWander NPCScheduleKind = iota
+	// SchedulePatrol walks a fixed loop of waypoints
+	SchedulePatrol
+)
+
+// String returns the string representation of a schedule kind.
+func (k NPCScheduleKind) String() string {
+	switch k {
+	case ScheduleWander:
+		return "wander"
+	case SchedulePatrol:
+		return "patrol"
+	default:
+		return "unknown"
+	}
+}
+
+// NPCScheduleComponent gives an NPC a movement schedule within an area.
+type NPCScheduleComponent struct {
+	// Kind of schedule
+	Kind NPCScheduleKind
+
+	// HomeX, HomeY is the center of the NPC's area
+	HomeX, HomeY float64
+
+	// Radius of the area; an NPC found outside it walks back home
+	Radius float64
+
+	// Waypoints of a patrol route, walked in a loop
+	Waypoints []PatrolWaypoint
+
+	// Speed in pixels per second
+	Speed float64
+
+	// MinPause and MaxPause bound the wait between wander moves (seconds)
+	MinPause, MaxPause float64
+
+	// PauseRadius stops the NPC while a player is this close (0 disables)
+	PauseRadius float64
+
+	// Paused stops the schedule entirely
+	Paused bool
+
+	rng           *rand.Rand
+	path          []pathPoint
+	pathIndex     int
+	waitTimer     float64
+	waypointIndex int
+	returning     bool
+	stuckTimer    float64
+	lastX, lastY  float64
+}
+
+// pathPoint is a world-space point on an NPC's current path.
+type pathPoint struct {
+	X, Y float64
+}
+
+// Type returns the component type identifier.
+func (n *NPCScheduleComponent) Type() string {
+	return "npc_schedule"
+}
+
+// NewWanderSchedule creates a schedule that wanders within radius of
+// (homeX, homeY), seeded for deterministic movement.
+func NewWanderSchedule(homeX, homeY, radius float64, seed int64) *NPCScheduleComponent {
+	return &NPCScheduleComponent{
+		Kind:        ScheduleWander,
+		HomeX:       homeX,
+		HomeY:       homeY,
+		Radius:      radius,
+		Speed:       DefaultNPCSpeed,
+		MinPause:    1.0,
+		MaxPause:    3.0,
+		PauseRadius: DefaultNPCPauseRadius,
+		rng:         rand.New(rand.NewSource(seed)),
+	}
+}
+
+// NewPatrolSchedule creates a schedule that loops through waypoints. The
+// area is centered on the waypoints and reaches just past the farthest one.
+func NewPatrolSchedule(waypoints []PatrolWaypoint, seed int64) *NPCScheduleComponent {
+	schedule := &NPCScheduleComponent{
+		Kind:        SchedulePatrol,
+		Waypoints:   append([]PatrolWaypoint(nil), waypoints...),
+		Speed:       DefaultNPCSpeed,
+		PauseRadius: 0, // Guards keep walking
+		rng:         rand.New(rand.NewSource(seed)),
+	}
+
+	if len(waypoints) > 0 {
+		for _, wp := range waypoints {
+			schedule.HomeX += wp.X
+			schedule.HomeY += wp.Y
+		}
+		schedule.HomeX /= float64(len(waypoints))
+		schedule.HomeY /= float64(len(waypoints))
+		for _, wp := range waypoints {
+			schedule.Radius = math.Max(schedule.Radius, math.Hypot(wp.X-schedule.HomeX, wp.Y-schedule.HomeY))
+		}
+	}
+	schedule.Radius += 32 // One tile of slack around the route
+	return schedule
+}
+
+// InArea reports whether (x, y) lies within the NPC's area.
+func (n *NPCScheduleComponent) InArea(x, y float64) bool {
+	return math.Hypot(x-n.HomeX, y-n.HomeY) <= n.Radius
+}
+
+// IsReturning reports whether the NPC is walking back into its area.
+func (n *NPCScheduleComponent) IsReturning() bool {
+	return n.returning
+}
+
+// NPCScheduleSystem moves NPCs with an NPCScheduleComponent.
+type NPCScheduleSystem struct {
+	terrain  *terrain.Terrain
+	tileSize float64
+}
+
+// NewNPCScheduleSystem creates a new NPC schedule system. Without terrain,
+// NPCs walk in straight lines.
+func NewNPCScheduleSystem() *NPCScheduleSystem {
+	return &NPCScheduleSystem{tileSize: 32}
+}
+
+// SetTerrain sets the terrain used for pathfinding and wander targets.
+func (s *NPCScheduleSystem) SetTerrain(terr *terrain.Terrain, tileSize float64) {
+	s.terrain = terr
+	if tileSize > 0 {
+		s.tileSize = tileSize
+	}
+}
+
+// Update advances the schedules of all scheduled NPCs.
+func (s *NPCScheduleSystem) Update(entities []*Entity, deltaTime float64) {
+	var players []*PositionComponent
+	for _, entity := range entities {
+		if entity.HasComponent("input") {
+			if posComp, ok := entity.GetComponent("position"); ok {
+				players = append(players, posComp.(*PositionComponent))
+			}
+		}
+	}
+
+	for _, entity := range entities {
+		scheduleComp, ok := entity.GetComponent("npc_schedule")
+		if !ok || entity.HasComponent("dead") {
+			continue
+		}
+		posComp, hasPos := entity.GetComponent("position")
+		velComp, hasVel := entity.GetComponent("velocity")
+		if !hasPos || !hasVel {
+			continue
+		}
+		schedule := scheduleComp.(*NPCScheduleComponent)
+		pos := posComp.(*PositionComponent)
+		vel := velComp.(*VelocityComponent)
+
+		// Combat AI takes over while the NPC is fighting or fleeing
+		if aiComp, ok := entity.GetComponent("ai"); ok && aiComp.(*AIComponent).IsAggressiveState() {
+			continue
+		}
+
+		if schedule.Paused || playerWithin(players, pos, schedule.PauseRadius) {
+			vel.VX, vel.VY = 0, 0
+			continue
+		}
+
+		s.updateSchedule(schedule, pos, vel, deltaTime)
+	}
+}
+
+// updateSchedule advances one NPC's schedule.
+func (s *NPCScheduleSystem) updateSchedule(schedule *NPCScheduleComponent, pos *PositionComponent, vel *VelocityComponent, deltaTime float64) {
+	if schedule.rng == nil {
+		schedule.rng = rand.New(rand.NewSource(0))
+	}
+
+	// Displaced (pushed, knocked back, teleported): head home first. Half a
+	// tile of slack lets paths round corners at the edge of the area.
+	if !schedule.returning && math.Hypot(pos.X-schedule.HomeX, pos.Y-schedule.HomeY) > schedule.Radius+s.tileSize/2 {
+		schedule.returning = true
+		schedule.waitTimer = 0
+		schedule.path = nil
+	}
+	if schedule.returning && schedule.path == nil {
+		schedule.path = s.planPath(pos.X, pos.Y, schedule.HomeX, schedule.HomeY, true)
+		schedule.pathIndex = 0
+		schedule.stuckTimer = 0
+	}
+
+	if schedule.path == nil {
+		vel.VX, vel.VY = 0, 0
+		if schedule.waitTimer > 0 {
+			schedule.waitTimer -= deltaTime
+			return
+		}
+		s.chooseNextTarget(schedule, pos)
+		if schedule.path == nil {
+			return
+		}
+	}
+
+	s.followPath(schedule, pos, vel, deltaTime)
+}
+
+// chooseNextTarget plans the path to the NPC's next destination.
+func (s *NPCScheduleSystem) chooseNextTarget(schedule *NPCScheduleComponent, pos *PositionComponent) {
+	schedule.pathIndex = 0
+	schedule.stuckTimer = 0
+
+	switch schedule.Kind {
+	case SchedulePatrol:
+		if len(schedule.Waypoints) == 0 {
+			return
+		}
+		wp := schedule.Waypoints[schedule.waypointIndex]
+		schedule.path = s.planPath(pos.X, pos.Y, wp.X, wp.Y, true)
+	default:
+		for attempt := 0; attempt < npcWanderAttempts; attempt++ {
+			angle := schedule.rng.Float64() * 2 * math.Pi
+			dist := math.Sqrt(schedule.rng.Float64()) * schedule.Radius
+			x := schedule.HomeX + math.Cos(angle)*dist
+			y := schedule.HomeY + math.Sin(angle)*dist
+			if path := s.planPath(pos.X, pos.Y, x, y, false); path != nil {
+				schedule.path = path
+				return
+			}
+		}
+		// No reachable spot this time; wait briefly before trying again
+		schedule.waitTimer = schedule.MinPause
+	}
+}
+
+// followPath steers the NPC along its current path.
+func (s *NPCScheduleSystem) followPath(schedule *NPCScheduleComponent, pos *PositionComponent, vel *VelocityComponent, deltaTime float64) {
+	// Abandon paths the NPC cannot make progress on (blocked by an entity)
+	if math.Hypot(pos.X-schedule.lastX, pos.Y-schedule.lastY) < schedule.Speed*deltaTime*0.1 {
+		schedule.stuckTimer += deltaTime
+	} else {
+		schedule.stuckTimer = 0
+	}
+	schedule.lastX, schedule.lastY = pos.X, pos.Y
+	if schedule.stuckTimer > npcStuckTimeout {
+		schedule.path = nil
+		schedule.stuckTimer = 0
+		vel.VX, vel.VY = 0, 0
+		return
+	}
+
+	for schedule.pathIndex < len(schedule.path) {
+		target := schedule.path[schedule.pathIndex]
+		dx, dy := target.X-pos.X, target.Y-pos.Y
+		dist := math.Hypot(dx, dy)
+		if dist > npcArriveDistance {
+			speed := schedule.Speed
+			// Don't overshoot the final point
+			if step := speed * deltaTime; step > dist && deltaTime > 0 {
+				speed = dist / deltaTime
+			}
+			vel.VX = dx / dist * speed
+			vel.VY = dy / dist * speed
+			return
+		}
+		schedule.pathIndex++
+	}
+
+	// Arrived
+	vel.VX, vel.VY = 0, 0
+	schedule.path = nil
+	if schedule.returning {
+		schedule.returning = false
+		return
+	}
+	switch schedule.Kind {
+	case SchedulePatrol:
+		schedule.waitTimer = schedule.Waypoints[schedule.waypointIndex].WaitTime
+		schedule.waypointIndex = (schedule.waypointIndex + 1) % len(schedule.Waypoints)
+	default:
+		schedule.waitTimer = schedule.MinPause + schedule.rng.Float64()*(schedule.MaxPause-schedule.MinPause)
+	}
+}
+
+// planPath returns the world-space path from (fromX, fromY) to (toX, toY).
+// Without terrain the path is a straight line. With terrain it follows
+// walkable tiles; if none exists, required paths fall back to a straight
+// line while optional ones return nil.
+func (s *NPCScheduleSystem) planPath(fromX, fromY, toX, toY float64, required bool) []pathPoint {
+	if s.terrain == nil {
+		return []pathPoint{{X: toX, Y: toY}}
+	}
+
+	start := terrain.Point{X: int(fromX / s.tileSize), Y: int(fromY / s.tileSize)}
+	goal := terrain.Point{X: int(toX / s.tileSize), Y: int(toY / s.tileSize)}
+	tiles := FindTilePath(s.terrain, start, goal, DefaultPathSearchLimit)
+	if tiles == nil {
+		if required {
+			return []pathPoint{{X: toX, Y: toY}}
+		}
+		return nil
+	}
+
+	// Walk through tile centers, skipping the tile the NPC stands on, and
+	// finish at the exact target
+	path := make([]pathPoint, 0, len(tiles))
+	for _, tile := range tiles[1:] {
+		path = append(path, pathPoint{
+			X: (float64(tile.X) + 0.5) * s.tileSize,
+			Y: (float64(tile.Y) + 0.5) * s.tileSize,
+		})
+	}
+	if len(path) > 0 {
+		path[len(path)-1] = pathPoint{X: toX, Y: toY}
+	} else {
+		path = append(path, pathPoint{X: toX, Y: toY})
+	}
+	return path
+}
+
+// playerWithin reports whether any player position is within radius of pos.
+func playerWithin(players []*PositionComponent, pos *PositionComponent, radius float64) bool {
+	if radius <= 0 {
+		return false
+	}
+	for _, player := range players {
+		if math.Hypot(player.X-pos.X, player.Y-pos.Y) <= radius {
+			return true
+		}
+	}
+	return false
+}
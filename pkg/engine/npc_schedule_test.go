@@ -0,0 +1,194 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// npcTestEntity creates a scheduled NPC at (x, y).
+func npcTestEntity(world *World, x, y float64, schedule *NPCScheduleComponent) (*Entity, *PositionComponent) {
+	npc := world.CreateEntity()
+	pos := &PositionComponent{X: x, Y: y}
+	npc.AddComponent(pos)
+	npc.AddComponent(&VelocityComponent{})
+	npc.AddComponent(schedule)
+	return npc, pos
+}
+
+// stepNPCs runs the schedule system and integrates velocities for steps
+// frames of dt seconds, calling check after each frame.
+func stepNPCs(system *NPCScheduleSystem, entities []*Entity, steps int, dt float64, check func()) {
+	for i := 0; i < steps; i++ {
+		system.Update(entities, dt)
+		for _, entity := range entities {
+			if velComp, ok := entity.GetComponent("velocity"); ok {
+				vel := velComp.(*VelocityComponent)
+				pos := entity.GetPosition()
+				pos.X += vel.VX * dt
+				pos.Y += vel.VY * dt
+			}
+		}
+		if check != nil {
+			check()
+		}
+	}
+}
+
+func TestNPCScheduleSystem_WanderStaysInArea(t *testing.T) {
+	world := NewWorld()
+	schedule := NewWanderSchedule(500, 500, 64, 42)
+	npc, pos := npcTestEntity(world, 500, 500, schedule)
+	system := NewNPCScheduleSystem()
+
+	maxDist := 0.0
+	positions := map[[2]int]bool{}
+	stepNPCs(system, []*Entity{npc}, 1200, 1.0/60, func() {
+		maxDist = math.Max(maxDist, math.Hypot(pos.X-500, pos.Y-500))
+		positions[[2]int{int(pos.X), int(pos.Y)}] = true
+	})
+
+	if len(positions) < 10 {
+		t.Errorf("NPC visited %d distinct positions in 20s, expected it to wander", len(positions))
+	}
+	if maxDist > 64 {
+		t.Errorf("NPC wandered %.1f from home, area radius is 64", maxDist)
+	}
+	if schedule.IsReturning() {
+		t.Error("wandering NPC should never need to return")
+	}
+}
+
+func TestNPCScheduleSystem_ReturnsWhenDisplaced(t *testing.T) {
+	world := NewWorld()
+	schedule := NewWanderSchedule(500, 500, 64, 7)
+	npc, pos := npcTestEntity(world, 500, 500, schedule)
+	system := NewNPCScheduleSystem()
+	stepNPCs(system, []*Entity{npc}, 60, 1.0/60, nil)
+
+	// Knocked far out of the shop
+	pos.X, pos.Y = 800, 500
+	stepNPCs(system, []*Entity{npc}, 1, 1.0/60, nil)
+	if !schedule.IsReturning() {
+		t.Fatal("displaced NPC should start returning")
+	}
+
+	stepNPCs(system, []*Entity{npc}, 600, 1.0/60, nil)
+	if !schedule.InArea(pos.X, pos.Y) {
+		t.Errorf("NPC at (%.1f,%.1f) did not return to its area", pos.X, pos.Y)
+	}
+	if schedule.IsReturning() {
+		t.Error("NPC should resume its schedule after returning")
+	}
+}
+
+func TestNPCScheduleSystem_Deterministic(t *testing.T) {
+	run := func() (float64, float64) {
+		world := NewWorld()
+		npc, pos := npcTestEntity(world, 300, 300, NewWanderSchedule(300, 300, 96, 1234))
+		stepNPCs(NewNPCScheduleSystem(), []*Entity{npc}, 900, 1.0/60, nil)
+		return pos.X, pos.Y
+	}
+	x1, y1 := run()
+	x2, y2 := run()
+	if x1 != x2 || y1 != y2 {
+		t.Errorf("same seed gave (%v,%v) and (%v,%v)", x1, y1, x2, y2)
+	}
+}
+
+func TestNPCScheduleSystem_PatrolVisitsWaypoints(t *testing.T) {
+	world := NewWorld()
+	waypoints := []PatrolWaypoint{{X: 100, Y: 100}, {X: 200, Y: 100, WaitTime: 0.5}}
+	schedule := NewPatrolSchedule(waypoints, 1)
+	npc, pos := npcTestEntity(world, 100, 100, schedule)
+
+	reachedFar := false
+	stepNPCs(NewNPCScheduleSystem(), []*Entity{npc}, 600, 1.0/60, func() {
+		if math.Hypot(pos.X-200, pos.Y-100) <= npcArriveDistance {
+			reachedFar = true
+		}
+		if !schedule.InArea(pos.X, pos.Y) {
+			t.Fatalf("guard left its patrol area at (%.1f,%.1f)", pos.X, pos.Y)
+		}
+	})
+	if !reachedFar {
+		t.Error("guard never reached the second waypoint")
+	}
+}
+
+func TestNPCScheduleSystem_PausesNearPlayer(t *testing.T) {
+	world := NewWorld()
+	schedule := NewWanderSchedule(500, 500, 64, 3)
+	npc, pos := npcTestEntity(world, 500, 500, schedule)
+
+	player := world.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 520, Y: 500})
+	player.AddComponent(&EbitenInput{})
+
+	stepNPCs(NewNPCScheduleSystem(), []*Entity{npc, player}, 300, 1.0/60, nil)
+	if pos.X != 500 || pos.Y != 500 {
+		t.Errorf("NPC moved to (%.1f,%.1f) while a player stood next to it", pos.X, pos.Y)
+	}
+}
+
+func TestNPCScheduleSystem_PathsAroundWalls(t *testing.T) {
+	// 7x5 room with a wall splitting it except for a gap at the bottom
+	terr := terrain.NewTerrain(7, 5, 1)
+	for y := 1; y < 4; y++ {
+		for x := 1; x < 6; x++ {
+			terr.SetTile(x, y, terrain.TileFloor)
+		}
+	}
+	terr.SetTile(3, 1, terrain.TileWall)
+	terr.SetTile(3, 2, terrain.TileWall)
+
+	path := FindTilePath(terr, terrain.Point{X: 1, Y: 1}, terrain.Point{X: 5, Y: 1}, 0)
+	if path == nil {
+		t.Fatal("expected a path through the gap")
+	}
+	for _, p := range path {
+		if !terr.IsWalkable(p.X, p.Y) {
+			t.Fatalf("path crosses unwalkable tile %v", p)
+		}
+	}
+	if path[0] != (terrain.Point{X: 1, Y: 1}) || path[len(path)-1] != (terrain.Point{X: 5, Y: 1}) {
+		t.Errorf("path endpoints = %v..%v", path[0], path[len(path)-1])
+	}
+	if len(path) != 9 {
+		t.Errorf("path length = %d, want 9 (shortest route through the gap)", len(path))
+	}
+
+	if FindTilePath(terr, terrain.Point{X: 1, Y: 1}, terrain.Point{X: 0, Y: 0}, 0) != nil {
+		t.Error("expected no path to a wall tile")
+	}
+
+	// An NPC displaced to the far side walks back through the gap
+	world := NewWorld()
+	schedule := NewWanderSchedule(1.5*32, 1.5*32, 20, 5)
+	npc, pos := npcTestEntity(world, 5.5*32, 1.5*32, schedule)
+	system := NewNPCScheduleSystem()
+	system.SetTerrain(terr, 32)
+
+	stepNPCs(system, []*Entity{npc}, 900, 1.0/60, func() {
+		if !terr.IsWalkable(int(pos.X/32), int(pos.Y/32)) {
+			t.Fatalf("NPC walked into a wall at (%.1f,%.1f)", pos.X, pos.Y)
+		}
+	})
+	if !schedule.InArea(pos.X, pos.Y) {
+		t.Errorf("NPC at (%.1f,%.1f) did not find its way home", pos.X, pos.Y)
+	}
+}
+
+func TestNPCScheduleKind_String(t *testing.T) {
+	kinds := map[NPCScheduleKind]string{
+		ScheduleWander:     "wander",
+		SchedulePatrol:     "patrol",
+		NPCScheduleKind(9): "unknown",
+	}
+	for kind, want := range kinds {
+		if got := kind.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", kind, got, want)
+		}
+	}
+}
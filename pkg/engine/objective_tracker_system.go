@@ -56,6 +56,88 @@ func (s *ObjectiveTrackerSystem) Update(entities []*Entity, deltaTime float64) {
 		// Check for newly completed quests
 		s.checkQuestCompletion(entity)
 	}
+
+	s.updateQuestTargets(entities)
+}
+
+// updateQuestTargets tags enemies and dropped items named by an unfinished
+// objective of an active quest with a QuestTargetComponent, so the minimap
+// highlights them, and untags them once no objective needs them. Generic
+// targets such as "enemy" or "item" tag nothing.
+func (s *ObjectiveTrackerSystem) updateQuestTargets(entities []*Entity) {
+	type questObjective struct {
+		questID string
+		context string
+		target  string
+	}
+	var objectives []questObjective
+	for _, entity := range entities {
+		comp, ok := entity.GetComponent("questtracker")
+		if !ok {
+			continue
+		}
+		for _, tracked := range comp.(*QuestTrackerComponent).ActiveQuests {
+			if tracked.Status != QuestStatusActive {
+				continue
+			}
+			context := ""
+			switch tracked.Quest.Type {
+			case quest.TypeKill, quest.TypeBoss:
+				context = "kill"
+			case quest.TypeCollect:
+				context = "collect"
+			default:
+				continue
+			}
+			for _, obj := range tracked.Quest.Objectives {
+				if !obj.IsComplete() && !isGenericObjectiveTarget(obj.Target) {
+					objectives = append(objectives, questObjective{tracked.Quest.ID, context, obj.Target})
+				}
+			}
+		}
+	}
+
+	for _, entity := range entities {
+		var name, context string
+		if comp, ok := entity.GetComponent("enemy_identity"); ok {
+			name, context = comp.(*EnemyIdentityComponent).Name, "kill"
+		} else if comp, ok := entity.GetComponent("item_entity"); ok && comp.(*ItemEntityComponent).Item != nil {
+			name, context = comp.(*ItemEntityComponent).Item.Name, "collect"
+		} else {
+			continue
+		}
+
+		questID := ""
+		for _, obj := range objectives {
+			if obj.context == context && name != "" && s.matchesTarget(obj.target, name, context) {
+				questID = obj.questID
+				break
+			}
+		}
+
+		if questID == "" {
+			if entity.HasComponent("quest_target") {
+				entity.RemoveComponent("quest_target")
+			}
+			continue
+		}
+		if comp, ok := entity.GetComponent("quest_target"); ok {
+			comp.(*QuestTargetComponent).QuestID = questID
+		} else {
+			entity.AddComponent(&QuestTargetComponent{QuestID: questID})
+		}
+	}
+}
+
+// isGenericObjectiveTarget reports whether an objective target matches any
+// enemy or item rather than naming one.
+func isGenericObjectiveTarget(target string) bool {
+	switch strings.ToLower(target) {
+	case "enemy", "enemies", "monster", "item", "items":
+		return true
+	default:
+		return false
+	}
 }
 
 // OnEnemyKilled should be called by combat system when an enemy dies.
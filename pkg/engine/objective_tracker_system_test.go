@@ -678,3 +678,45 @@ func TestObjectiveTrackerSystem_Integration(t *testing.T) {
 		t.Errorf("Gold = %d, want 50", inv.Gold)
 	}
 }
+
+// TestUpdateQuestTargets tests that enemies and items named by an active
+// objective are tagged as quest targets until the quest is done
+func TestUpdateQuestTargets(t *testing.T) {
+	sys := NewObjectiveTrackerSystem()
+
+	player := NewEntity(1)
+	tracker := NewQuestTrackerComponent(10)
+	player.AddComponent(tracker)
+	tracker.AcceptQuest(&quest.Quest{
+		ID:         "goblins",
+		Type:       quest.TypeKill,
+		Objectives: []quest.Objective{{Target: "Goblin", Required: 1}},
+	}, 0)
+	tracker.AcceptQuest(&quest.Quest{
+		ID:         "any",
+		Type:       quest.TypeKill,
+		Objectives: []quest.Objective{{Target: "enemy", Required: 3}},
+	}, 0)
+
+	goblin := NewEntity(2)
+	goblin.AddComponent(&EnemyIdentityComponent{Name: "Goblin Shaman"})
+	wolf := NewEntity(3)
+	wolf.AddComponent(&EnemyIdentityComponent{Name: "Wolf"})
+	entities := []*Entity{player, goblin, wolf}
+
+	sys.Update(entities, 0.016)
+	comp, ok := goblin.GetComponent("quest_target")
+	if !ok || comp.(*QuestTargetComponent).QuestID != "goblins" {
+		t.Fatal("goblin should be tagged as a target of the goblin quest")
+	}
+	if wolf.HasComponent("quest_target") {
+		t.Error("a generic kill objective should not tag every enemy")
+	}
+
+	// Finishing the objective removes the tag
+	tracker.IncrementProgress("goblins", 0, 1)
+	sys.Update(entities, 0.016)
+	if goblin.HasComponent("quest_target") {
+		t.Error("goblin should be untagged once the objective is complete")
+	}
+}
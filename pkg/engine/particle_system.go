@@ -91,11 +91,13 @@ func (ps *ParticleSystem) Update(entities []*Entity, deltaTime float64) {
 }
 
 // offsetParticles positions all particles in a system at the given world coordinates.
+// Looping systems also spawn subsequent particles at this position.
 func (ps *ParticleSystem) offsetParticles(system *particles.ParticleSystem, x, y float64) {
 	for i := range system.Particles {
 		system.Particles[i].X += x
 		system.Particles[i].Y += y
 	}
+	system.SetOrigin(x, y)
 }
 
 // SpawnParticles creates a one-shot particle effect at the given position.
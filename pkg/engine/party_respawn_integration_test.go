@@ -0,0 +1,93 @@
+// Package engine provides integration tests for co-op party respawning.
+// Combat deaths flow through the death callback, death mode handler and
+// revival system wired together the way the client wires them.
+package engine
+
+import "testing"
+
+// TestPartyRespawnIntegration tests that a combat death spends a shared
+// life and that the respawn wave brings the player back.
+func TestPartyRespawnIntegration(t *testing.T) {
+	world := NewWorld()
+
+	revivalSystem := NewRevivalSystem(world)
+	if err := revivalSystem.SetPartyRespawnPolicy(PartyRespawnPolicy{SharedLives: 1, WaveInterval: 5}); err != nil {
+		t.Fatalf("SetPartyRespawnPolicy failed: %v", err)
+	}
+	deathHandler := NewDeathModeHandler(DeathModeCasual)
+	deathHandler.SetRevivalSystem(revivalSystem)
+
+	combatSystem := NewCombatSystem(12345)
+	combatSystem.SetDeathCallback(func(entity *Entity) {
+		if entity.HasComponent("dead") {
+			return
+		}
+		if entity.HasComponent("input") {
+			deathHandler.HandlePlayerDeath(entity)
+		}
+		entity.AddComponent(NewDeadComponent(0))
+	})
+
+	world.AddSystem(revivalSystem)
+	world.AddSystem(combatSystem)
+	world.AddSystem(deathHandler)
+
+	teammate := world.CreateEntity()
+	teammate.AddComponent(&PositionComponent{X: 100, Y: 100})
+	teammate.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	teammate.AddComponent(&EbitenInput{})
+
+	player := world.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 110, Y: 100})
+	player.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	player.AddComponent(&EbitenInput{})
+
+	enemy := world.CreateEntity()
+	enemy.AddComponent(&PositionComponent{X: 120, Y: 100})
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+	enemy.AddComponent(&AttackComponent{Damage: 1000, Range: 50, Cooldown: 1})
+	world.Update(0)
+
+	// The killing blow: combat marks the player dead, then the revival
+	// system charges the death to the shared pool
+	if !combatSystem.Attack(enemy, player) {
+		t.Fatal("enemy attack should land")
+	}
+	world.Update(0.5)
+	world.Update(0.5)
+	if !player.HasComponent("dead") {
+		t.Fatal("player should be dead after the killing blow")
+	}
+	if revivalSystem.LivesRemaining() != 0 {
+		t.Fatalf("lives remaining = %d, want 0", revivalSystem.LivesRemaining())
+	}
+
+	// No checkpoint respawn while the wave is pending
+	for i := 0; i < 8; i++ {
+		world.Update(0.5)
+	}
+	if !player.HasComponent("dead") {
+		t.Fatal("player respawned before the wave timer elapsed")
+	}
+
+	world.Update(0.5)
+	if player.HasComponent("dead") {
+		t.Fatal("the respawn wave should bring the player back")
+	}
+	if health := player.GetHealth(); health.Current != 20 {
+		t.Errorf("respawned health = %f, want 20", health.Current)
+	}
+	if pos := player.GetPosition(); pos.X != 100 || pos.Y != 100 {
+		t.Errorf("wave respawn at (%f,%f), want the teammate's position", pos.X, pos.Y)
+	}
+
+	// With the pool empty, the next death cannot be revived
+	if !combatSystem.Attack(enemy, player) {
+		t.Fatal("enemy attack should land again once off cooldown")
+	}
+	world.Update(0.5)
+	world.Update(0.5)
+	if !player.HasComponent("dead") || revivalSystem.CanBeRevived(player) {
+		t.Error("player who died with no shared lives left should wait for a wave")
+	}
+}
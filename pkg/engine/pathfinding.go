@@ -0,0 +1,123 @@
+// Package engine provides tile pathfinding.
+// This file implements A* search over terrain tiles, used by NPC schedules
+// to walk around walls instead of into them.
+package engine
+
+import (
+	"container/heap"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// DefaultPathSearchLimit caps the tiles A* expands before giving up, so a
+// single unreachable goal cannot stall a frame.
+const DefaultPathSearchLimit = 2048
+
+// FindTilePath returns the walkable tiles from start to goal (inclusive of
+// both) using 4-directional A*, or nil if goal is unreachable within
+// maxNodes expanded tiles. maxNodes <= 0 uses DefaultPathSearchLimit. Ties
+// are broken by insertion order, so paths are deterministic.
+func FindTilePath(terr *terrain.Terrain, start, goal terrain.Point, maxNodes int) []terrain.Point {
+	if terr == nil || !terr.IsWalkable(goal.X, goal.Y) {
+		return nil
+	}
+	if start == goal {
+		return []terrain.Point{start}
+	}
+	if maxNodes <= 0 {
+		maxNodes = DefaultPathSearchLimit
+	}
+
+	open := &pathQueue{}
+	cameFrom := map[terrain.Point]terrain.Point{}
+	cost := map[terrain.Point]int{start: 0}
+	heap.Push(open, &pathNode{point: start, priority: manhattan(start, goal)})
+
+	neighbors := [4]terrain.Point{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}}
+	expanded := 0
+	for open.Len() > 0 && expanded < maxNodes {
+		current := heap.Pop(open).(*pathNode).point
+		if current == goal {
+			return reconstructPath(cameFrom, start, goal)
+		}
+		expanded++
+
+		for _, d := range neighbors {
+			next := terrain.Point{X: current.X + d.X, Y: current.Y + d.Y}
+			if !terr.IsWalkable(next.X, next.Y) {
+				continue
+			}
+			nextCost := cost[current] + 1
+			if known, ok := cost[next]; ok && known <= nextCost {
+				continue
+			}
+			cost[next] = nextCost
+			cameFrom[next] = current
+			heap.Push(open, &pathNode{point: next, priority: nextCost + manhattan(next, goal)})
+		}
+	}
+	return nil
+}
+
+// reconstructPath walks cameFrom back from goal to start.
+func reconstructPath(cameFrom map[terrain.Point]terrain.Point, start, goal terrain.Point) []terrain.Point {
+	path := []terrain.Point{goal}
+	for current := goal; current != start; {
+		current = cameFrom[current]
+		path = append(path, current)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// manhattan returns the 4-directional distance between two tiles.
+func manhattan(a, b terrain.Point) int {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy < 0 {
+		dy = -dy
+	}
+	return dx + dy
+}
+
+// pathNode is an entry in the A* open set.
+type pathNode struct {
+	point    terrain.Point
+	priority int
+	order    int
+}
+
+// pathQueue is a min-heap of pathNodes ordered by priority, then by
+// insertion order.
+type pathQueue struct {
+	nodes []*pathNode
+	next  int
+}
+
+func (q *pathQueue) Len() int { return len(q.nodes) }
+
+func (q *pathQueue) Less(i, j int) bool {
+	if q.nodes[i].priority != q.nodes[j].priority {
+		return q.nodes[i].priority < q.nodes[j].priority
+	}
+	return q.nodes[i].order < q.nodes[j].order
+}
+
+func (q *pathQueue) Swap(i, j int) { q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i] }
+
+func (q *pathQueue) Push(x interface{}) {
+	node := x.(*pathNode)
+	node.order = q.next
+	q.next++
+	q.nodes = append(q.nodes, node)
+}
+
+func (q *pathQueue) Pop() interface{} {
+	last := q.nodes[len(q.nodes)-1]
+	q.nodes = q.nodes[:len(q.nodes)-1]
+	return last
+}
@@ -0,0 +1,119 @@
+// Package engine provides auto-pickup filtering.
+// This file implements PickupFilterComponent, which lets each player choose
+// which dropped items ItemPickupSystem collects automatically, which it only
+// offers to pick up, and which it leaves on the ground.
+package engine
+
+import (
+	"github.com/opd-ai/venture/pkg/procgen/item"
+)
+
+// PickupAction is what happens when a player walks over an item.
+type PickupAction int
+
+const (
+	// PickupAuto collects the item immediately
+	PickupAuto PickupAction = iota
+	// PickupPrompt leaves the item on the ground and tells the player they
+	// can pick it up with the interact key
+	PickupPrompt
+	// PickupIgnore leaves the item on the ground silently
+	PickupIgnore
+)
+
+// String returns the string representation of a pickup action.
+func (a PickupAction) String() string {
+	switch a {
+	case PickupAuto:
+		return "auto"
+	case PickupPrompt:
+		return "prompt"
+	case PickupIgnore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
+
+// Item tags that mark currency and crafting materials. The item generator
+// tags its currency and material items with them.
+const (
+	ItemTagCurrency = "currency"
+	ItemTagMaterial = "material"
+)
+
+// PickupFilterComponent holds a player's auto-pickup preferences. Players
+// without one auto-pick everything.
+type PickupFilterComponent struct {
+	// EquipmentAction applies to weapons, armor and accessories
+	EquipmentAction PickupAction
+
+	// OtherAction applies to consumables and anything else that is not
+	// equipment, currency or a material
+	OtherAction PickupAction
+
+	// MinRarity ignores items of lower rarity. Currency and materials are
+	// always picked up.
+	MinRarity item.Rarity
+}
+
+// Type returns the component type identifier.
+func (f *PickupFilterComponent) Type() string {
+	return "pickup_filter"
+}
+
+// NewPickupFilterComponent creates a filter that auto-picks currency,
+// materials and consumables and prompts for equipment.
+func NewPickupFilterComponent() *PickupFilterComponent {
+	return &PickupFilterComponent{
+		EquipmentAction: PickupPrompt,
+		OtherAction:     PickupAuto,
+		MinRarity:       item.RarityCommon,
+	}
+}
+
+// Decide returns what to do with itm.
+func (f *PickupFilterComponent) Decide(itm *item.Item) PickupAction {
+	if itm == nil {
+		return PickupIgnore
+	}
+	if IsCurrencyItem(itm) || IsMaterialItem(itm) {
+		return PickupAuto
+	}
+	if itm.Rarity < f.MinRarity {
+		return PickupIgnore
+	}
+	if itm.IsEquippable() {
+		return f.EquipmentAction
+	}
+	return f.OtherAction
+}
+
+// IsCurrencyItem reports whether itm is currency by type or tag.
+func IsCurrencyItem(itm *item.Item) bool {
+	return itm.Type == item.TypeCurrency || hasItemTag(itm, ItemTagCurrency)
+}
+
+// IsMaterialItem reports whether itm is a crafting material by type or tag.
+func IsMaterialItem(itm *item.Item) bool {
+	return itm.Type == item.TypeMaterial || hasItemTag(itm, ItemTagMaterial)
+}
+
+// hasItemTag reports whether itm carries tag.
+func hasItemTag(itm *item.Item, tag string) bool {
+	for _, t := range itm.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// pickupActionFor returns what to do when player walks over itm.
+func pickupActionFor(player *Entity, itm *item.Item) PickupAction {
+	filterComp, ok := player.GetComponent("pickup_filter")
+	if !ok {
+		return PickupAuto
+	}
+	return filterComp.(*PickupFilterComponent).Decide(itm)
+}
@@ -0,0 +1,143 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/item"
+)
+
+func TestPickupFilterComponent_Decide(t *testing.T) {
+	filter := NewPickupFilterComponent()
+	filter.MinRarity = item.RarityRare
+
+	tests := []struct {
+		name string
+		itm  *item.Item
+		want PickupAction
+	}{
+		{"common currency", &item.Item{Rarity: item.RarityCommon, Tags: []string{ItemTagCurrency}}, PickupAuto},
+		{"common material", &item.Item{Type: item.TypeWeapon, Tags: []string{ItemTagMaterial}}, PickupAuto},
+		{"uncommon potion below threshold", &item.Item{Type: item.TypeConsumable, Rarity: item.RarityUncommon}, PickupIgnore},
+		{"rare potion", &item.Item{Type: item.TypeConsumable, Rarity: item.RarityRare}, PickupAuto},
+		{"epic sword", &item.Item{Type: item.TypeWeapon, Rarity: item.RarityEpic}, PickupPrompt},
+		{"nil item", nil, PickupIgnore},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filter.Decide(tt.itm); got != tt.want {
+				t.Errorf("Decide() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// pickupFilterTestWorld creates a player with a pickup filter standing on a
+// common and an uncommon potion.
+func pickupFilterTestWorld(filter *PickupFilterComponent) (*World, *Entity, *InventoryComponent) {
+	world := NewWorld()
+	player := world.CreateEntity()
+	player.AddComponent(&EbitenInput{})
+	player.AddComponent(&PositionComponent{X: 100, Y: 100})
+	inventory := NewInventoryComponent(20, 100)
+	player.AddComponent(inventory)
+	player.AddComponent(filter)
+
+	SpawnItemInWorld(world, &item.Item{Name: "Common Potion", Type: item.TypeConsumable, Rarity: item.RarityCommon}, 100, 100)
+	SpawnItemInWorld(world, &item.Item{Name: "Uncommon Potion", Type: item.TypeConsumable, Rarity: item.RarityUncommon}, 110, 100)
+	world.Update(0)
+	return world, player, inventory
+}
+
+func TestItemPickupSystem_FilterIgnoresBelowRarity(t *testing.T) {
+	filter := NewPickupFilterComponent()
+	filter.MinRarity = item.RarityUncommon
+	world, _, inventory := pickupFilterTestWorld(filter)
+
+	NewItemPickupSystem(world).Update(world.GetEntities(), 0.016)
+
+	if len(inventory.Items) != 1 || inventory.Items[0].Name != "Uncommon Potion" {
+		t.Errorf("inventory = %v, want only the uncommon potion", inventory.Items)
+	}
+	world.Update(0)
+	remaining := 0
+	for _, e := range world.GetEntities() {
+		if e.HasComponent("item_entity") {
+			remaining++
+		}
+	}
+	if remaining != 1 {
+		t.Errorf("%d items left on the ground, want the common potion", remaining)
+	}
+}
+
+func TestItemPickupSystem_PromptedItemPickedUpManually(t *testing.T) {
+	filter := NewPickupFilterComponent()
+	filter.OtherAction = PickupPrompt
+	world, player, inventory := pickupFilterTestWorld(filter)
+	system := NewItemPickupSystem(world)
+
+	system.Update(world.GetEntities(), 0.016)
+	if len(inventory.Items) != 0 {
+		t.Fatalf("prompted items were auto-picked: %v", inventory.Items)
+	}
+
+	if !system.PickUpNearest(player) {
+		t.Fatal("PickUpNearest found nothing in reach")
+	}
+	if len(inventory.Items) != 1 || inventory.Items[0].Name != "Common Potion" {
+		t.Errorf("inventory = %v, want the nearest (common) potion", inventory.Items)
+	}
+}
+
+func TestItemPickupSystem_NoFilterPicksEverything(t *testing.T) {
+	world, player, inventory := pickupFilterTestWorld(NewPickupFilterComponent())
+	player.RemoveComponent("pickup_filter")
+
+	NewItemPickupSystem(world).Update(world.GetEntities(), 0.016)
+	if len(inventory.Items) != 2 {
+		t.Errorf("picked up %d items without a filter, want 2", len(inventory.Items))
+	}
+}
+
+func TestItemPickupSystem_GeneratedCurrencyAndMaterials(t *testing.T) {
+	generate := func(typeName string) *item.Item {
+		result, err := item.NewItemGenerator().Generate(7, procgen.GenerationParams{
+			Depth:   1,
+			GenreID: "fantasy",
+			Custom:  map[string]interface{}{"count": 1, "type": typeName},
+		})
+		if err != nil {
+			t.Fatalf("Generate(%s) failed: %v", typeName, err)
+		}
+		return result.([]*item.Item)[0]
+	}
+	coins := generate("currency")
+	material := generate("material")
+
+	// A filter that ignores everything else still collects both
+	filter := NewPickupFilterComponent()
+	filter.EquipmentAction = PickupIgnore
+	filter.OtherAction = PickupIgnore
+	filter.MinRarity = item.RarityLegendary
+
+	world := NewWorld()
+	player := world.CreateEntity()
+	player.AddComponent(&EbitenInput{})
+	player.AddComponent(&PositionComponent{X: 100, Y: 100})
+	inventory := NewInventoryComponent(20, 100)
+	player.AddComponent(inventory)
+	player.AddComponent(filter)
+	SpawnItemInWorld(world, coins, 100, 100)
+	SpawnItemInWorld(world, material, 105, 100)
+	world.Update(0)
+
+	NewItemPickupSystem(world).Update(world.GetEntities(), 0.016)
+
+	if inventory.Gold != coins.Stats.Value {
+		t.Errorf("gold = %d, want the coins' value %d", inventory.Gold, coins.Stats.Value)
+	}
+	if len(inventory.Items) != 1 || inventory.Items[0] != material {
+		t.Errorf("inventory = %v, want only the material", inventory.Items)
+	}
+}
@@ -60,8 +60,11 @@ func (s *PlayerCombatSystem) Update(entities []*Entity, deltaTime float64) {
 			continue // Not an InputProvider
 		}
 
-		// Check if player pressed attack button
-		if !input.IsActionPressed() {
+		// Check if player pressed attack button, or pressed it just before
+		// the cooldown ended and it was buffered
+		buffer := getAbilityBuffer(entity)
+		pressed := input.IsActionPressed()
+		if !pressed && (buffer == nil || !buffer.IsQueued("attack")) {
 			continue
 		}
 
@@ -74,6 +77,10 @@ func (s *PlayerCombatSystem) Update(entities []*Entity, deltaTime float64) {
 
 		// Check if attack is ready (cooldown)
 		if !attack.CanAttack() {
+			// Queue presses made within the buffer window so they fire when ready
+			if pressed && buffer != nil && buffer.Buffer("attack", attack.CooldownTimer) {
+				input.SetActionPressed(false)
+			}
 			if s.logger != nil && s.logger.Logger.GetLevel() >= logrus.DebugLevel {
 				s.logger.WithFields(logrus.Fields{
 					"entityID":          entity.ID,
@@ -85,6 +92,9 @@ func (s *PlayerCombatSystem) Update(entities []*Entity, deltaTime float64) {
 
 		// Consume the input immediately to prevent multiple triggers
 		input.SetActionPressed(false)
+		if buffer != nil {
+			buffer.Take("attack")
+		}
 
 		if s.logger != nil && s.logger.Logger.GetLevel() >= logrus.DebugLevel {
 			s.logger.WithFields(logrus.Fields{
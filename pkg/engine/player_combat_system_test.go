@@ -257,6 +257,61 @@ func TestPlayerCombatSystem_DeadEnemy(t *testing.T) {
 	playerCombatSys.Update(world.GetEntities(), 0.016)
 }
 
+// TestPlayerCombatSystem_BufferedAttack tests that an attack pressed within
+// the buffer window fires exactly once after the cooldown ends, while one
+// pressed earlier is dropped.
+func TestPlayerCombatSystem_BufferedAttack(t *testing.T) {
+	tests := []struct {
+		name      string
+		pressAt   float64 // cooldown remaining when the button is pressed
+		wantFires int
+	}{
+		{"inside window", 0.15, 1},
+		{"outside window", 0.45, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			world := NewWorld()
+			combatSys := NewCombatSystem(12345)
+			playerCombatSys := NewPlayerCombatSystem(combatSys, world)
+
+			player := world.CreateEntity()
+			player.AddComponent(&PositionComponent{X: 100, Y: 100})
+			input := &StubInput{}
+			player.AddComponent(input)
+			attack := &AttackComponent{Damage: 15, Range: 50, Cooldown: 5.0, CooldownTimer: 0.6}
+			player.AddComponent(attack)
+			player.AddComponent(NewAbilityBufferComponent(DefaultAbilityBufferWindow))
+			world.Update(0)
+
+			const deltaTime = 0.05
+			fires := 0
+			pressed := false
+			for frame := 0; frame < 30; frame++ {
+				combatSys.Update(world.GetEntities(), deltaTime)
+
+				// A press lasts a single frame, as the input system reports it
+				input.ActionPressed = !pressed && attack.CooldownTimer <= tt.pressAt
+				pressed = pressed || input.ActionPressed
+
+				before := attack.CooldownTimer
+				playerCombatSys.Update(world.GetEntities(), deltaTime)
+				if attack.CooldownTimer > before {
+					fires++
+				}
+			}
+
+			if !pressed {
+				t.Fatal("attack was never pressed")
+			}
+			if fires != tt.wantFires {
+				t.Errorf("attacks fired = %d, want %d", fires, tt.wantFires)
+			}
+		})
+	}
+}
+
 // Benchmark for player combat system performance
 func BenchmarkPlayerCombatSystem(b *testing.B) {
 	world := NewWorld()
@@ -1,6 +1,10 @@
 package engine
 
-import "github.com/hajimehoshi/ebiten/v2"
+import (
+	"strconv"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
 
 // PlayerSpellCastingSystem handles player spell casting from input.
 type PlayerSpellCastingSystem struct {
@@ -85,8 +89,50 @@ func (s *PlayerSpellCastingSystem) Update(entities []*Entity, deltaTime float64)
 		slotIndex = 4
 	}
 
-	// Attempt to cast spell
-	if slotIndex >= 0 {
-		s.castingSystem.StartCast(player, slotIndex)
+	buffer := getAbilityBuffer(player)
+	if slotIndex < 0 {
+		// Fire a spell buffered just before its cooldown ended once ready
+		if buffer == nil || buffer.Queued == "" {
+			return
+		}
+		queued := findBufferedSpellSlot(buffer, len(slots.Slots))
+		if queued < 0 || slots.IsOnCooldown(queued) {
+			return
+		}
+		slotIndex = queued
+	}
+
+	// Queue presses made within the buffer window so they fire when ready
+	ability := spellAbilityName(slotIndex)
+	if slots.IsOnCooldown(slotIndex) {
+		if buffer != nil {
+			remaining, _ := player.AbilityCooldown(ability)
+			buffer.Buffer(ability, remaining)
+		}
+		return
+	}
+
+	// Attempt to cast spell; a buffered press is used up either way so a
+	// spell that cannot be cast (e.g. no mana) does not retry forever
+	if buffer != nil {
+		buffer.Take(ability)
+	}
+	s.castingSystem.StartCast(player, slotIndex)
+}
+
+// spellAbilityName returns the ability name of a spell slot index, as used
+// by AbilityCooldown and the ability buffer.
+func spellAbilityName(slotIndex int) string {
+	return "spell_" + strconv.Itoa(slotIndex+1)
+}
+
+// findBufferedSpellSlot returns the spell slot index queued in buffer, or -1
+// if the queued ability is not a spell slot.
+func findBufferedSpellSlot(buffer *AbilityBufferComponent, slotCount int) int {
+	for i := 0; i < slotCount; i++ {
+		if buffer.IsQueued(spellAbilityName(i)) {
+			return i
+		}
 	}
+	return -1
 }
@@ -271,3 +271,70 @@ func TestPlayerSpellCastingSystem_Update_EmptySlot(t *testing.T) {
 		t.Error("Should not be casting from empty slot")
 	}
 }
+
+// TestPlayerSpellCastingSystem_Update_BufferedCast tests that a spell pressed
+// within the buffer window is cast exactly once after its cooldown ends,
+// while one pressed earlier is dropped.
+func TestPlayerSpellCastingSystem_Update_BufferedCast(t *testing.T) {
+	tests := []struct {
+		name      string
+		pressAt   float64 // cooldown remaining when the key is pressed
+		wantCasts int
+	}{
+		{"inside window", 0.15, 1},
+		{"outside window", 0.45, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			world := NewWorld()
+			rng := rand.New(rand.NewSource(12345))
+			statusEffectSys := NewStatusEffectSystem(world, rng)
+			castingSystem := NewSpellCastingSystem(world, statusEffectSys)
+			playerCastingSystem := NewPlayerSpellCastingSystem(castingSystem, world)
+
+			player := NewEntity(1)
+			input := &EbitenInput{}
+			player.AddComponent(input)
+			player.AddComponent(&PositionComponent{X: 100, Y: 100})
+			player.AddComponent(&ManaComponent{Current: 100, Max: 100})
+			player.AddComponent(NewAbilityBufferComponent(DefaultAbilityBufferWindow))
+
+			slots := &SpellSlotComponent{Casting: -1}
+			slots.SetSlot(0, &magic.Spell{
+				Name:  "Test Ward",
+				Type:  magic.TypeDefensive,
+				Stats: magic.Stats{ManaCost: 10, CastTime: 0.1, Cooldown: 5.0},
+			})
+			slots.Cooldowns[0] = 0.6
+			player.AddComponent(slots)
+
+			entities := []*Entity{player}
+			world.Update(0)
+
+			const deltaTime = 0.05
+			casts := 0
+			pressed := false
+			for frame := 0; frame < 30; frame++ {
+				castingSystem.Update(entities, deltaTime)
+
+				// A press lasts a single frame, as the input system reports it
+				input.Spell1Pressed = !pressed && slots.Cooldowns[0] <= tt.pressAt
+				pressed = pressed || input.Spell1Pressed
+
+				wasCasting := slots.IsCasting()
+				playerCastingSystem.Update(entities, deltaTime)
+				if !wasCasting && slots.IsCasting() {
+					casts++
+				}
+			}
+
+			if !pressed {
+				t.Fatal("spell was never pressed")
+			}
+			if casts != tt.wantCasts {
+				t.Errorf("casts started = %d, want %d", casts, tt.wantCasts)
+			}
+		})
+	}
+}
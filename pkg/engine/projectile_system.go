@@ -4,6 +4,7 @@ import (
 	"image/color"
 	"math"
 
+	"github.com/opd-ai/venture/pkg/combat"
 	"github.com/opd-ai/venture/pkg/rendering/particles"
 	"github.com/opd-ai/venture/pkg/rendering/sprites"
 )
@@ -254,7 +255,7 @@ func (s *ProjectileSystem) handleEntityHit(projEntity, hitEntity *Entity, projCo
 	if ok {
 		health, ok := healthComp.(*HealthComponent)
 		if ok {
-			health.Current -= projComp.Damage
+			health.TakeTypedDamage(projComp.Damage, combat.DamagePhysical)
 			projComp.HasHit = true
 
 			// Phase 10.3: Trigger screen shake on projectile hit
@@ -333,7 +334,7 @@ func (s *ProjectileSystem) handleExplosion(projEntity *Entity, posComp *Position
 					// Full damage at center, 0 at edge
 					damageFactor := 1.0 - (dist / proj.ExplosionRadius)
 					damage := proj.Damage * damageFactor
-					health.Current -= damage
+					health.TakeTypedDamage(damage, combat.DamageFire)
 				}
 			}
 		}
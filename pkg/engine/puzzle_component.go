@@ -56,6 +56,8 @@ const (
 	PuzzleTypeMemoryPattern PuzzleType = "memory_pattern"
 	// PuzzleTypeColorMatching requires matching colors/symbols
 	PuzzleTypeColorMatching PuzzleType = "color_matching"
+	// PuzzleTypePipeConnection requires rotating pipe tiles to connect a source to a sink
+	PuzzleTypePipeConnection PuzzleType = "pipe_connection"
 )
 
 // PuzzleComponent tracks puzzle state and solution.
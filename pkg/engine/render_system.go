@@ -10,6 +10,7 @@ import (
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/opd-ai/venture/pkg/rendering/sprites"
 )
 
 // EbitenSprite holds visual representation data for an entity (Ebiten implementation).
@@ -39,6 +40,11 @@ type EbitenSprite struct {
 
 	// Layer for rendering order (higher = drawn on top)
 	Layer int
+
+	// Team-tinted copies of the sprite's images keyed by base image, built
+	// for teamColor by the render system's Update when team tinting is on
+	teamColor  color.RGBA
+	teamImages map[*ebiten.Image]*ebiten.Image
 }
 
 // Type returns the component type identifier (implements Component).
@@ -152,6 +158,9 @@ type EbitenRenderSystem struct {
 	batches        map[*ebiten.Image][]*Entity // Group entities by sprite image
 	batchPool      []map[*ebiten.Image][]*Entity
 
+	// Team/faction tinting (nil disables)
+	teamTints *TeamTintPalette
+
 	// Debug rendering flags
 	ShowColliders bool
 	ShowGrid      bool
@@ -211,11 +220,16 @@ func (r *EbitenRenderSystem) GetStats() RenderStats {
 	return r.stats
 }
 
-// Update is called every frame but doesn't modify entities.
-// Actual rendering happens in Draw which is called by ebiten.
+// Update is called every frame. Actual rendering happens in Draw which is
+// called by ebiten; Update only prepares team-tinted sprites so that Draw
+// never has to recolor them.
 func (r *EbitenRenderSystem) Update(entities []*Entity, deltaTime float64) {
-	// RenderSystem doesn't need to update entity state
-	// Rendering is handled in the Draw call
+	if r.teamTints == nil {
+		return
+	}
+	for _, entity := range entities {
+		r.prepareTeamSprite(entity)
+	}
 }
 
 // Draw renders all visible entities to the screen (implements RenderingSystem interface).
@@ -293,8 +307,10 @@ func (r *EbitenRenderSystem) drawBatched(entities []*Entity) {
 			continue
 		}
 
-		// Group by sprite image pointer (entities with same sprite are batched)
-		batches[sprite.Image] = append(batches[sprite.Image], entity)
+		// Group by team-tinted sprite image pointer (entities with same sprite
+		// on the same team are batched)
+		batchImage := r.teamSprite(entity, sprite.Image)
+		batches[batchImage] = append(batches[batchImage], entity)
 	}
 
 	r.stats.BatchCount = len(batches)
@@ -322,6 +338,9 @@ func (r *EbitenRenderSystem) drawBatch(entities []*Entity) {
 		return
 	}
 	batchSpriteImage := firstSprite.(*EbitenSprite).Image
+	if batchSpriteImage != nil {
+		batchSpriteImage = r.teamSprite(entities[0], batchSpriteImage)
+	}
 	if batchSpriteImage == nil {
 		// No sprite image, draw entities individually
 		for _, entity := range entities {
@@ -378,6 +397,9 @@ func (r *EbitenRenderSystem) drawBatch(entities []*Entity) {
 		} else {
 			actualSpriteImage = sprite.Image
 		}
+		if actualSpriteImage != nil {
+			actualSpriteImage = r.teamSprite(entity, actualSpriteImage)
+		}
 
 		// Skip if no image or image doesn't match batch
 		if actualSpriteImage == nil || actualSpriteImage != batchSpriteImage {
@@ -386,8 +408,8 @@ func (r *EbitenRenderSystem) drawBatch(entities []*Entity) {
 			continue
 		}
 
-		// Convert world position to screen position
-		screenX, screenY := r.cameraSystem.WorldToScreen(pos.X, pos.Y)
+		// Convert world position to screen position, raising carried and thrown objects
+		screenX, screenY := r.cameraSystem.WorldToScreen(pos.X, pos.Y-Elevation(entity))
 
 		// Check if entity is visible on screen (per-entity culling for batched rendering)
 		if !r.cameraSystem.IsVisible(pos.X, pos.Y, sprite.Width) {
@@ -395,13 +417,7 @@ func (r *EbitenRenderSystem) drawBatch(entities []*Entity) {
 		}
 
 		// GAP-012 REPAIR: Apply visual feedback effects (hit flash, tints)
-		var flashAlpha float64
-		var tintR, tintG, tintB, tintA float64 = 1.0, 1.0, 1.0, 1.0
-		if feedbackComp, ok := entity.GetComponent("visual_feedback"); ok {
-			feedback := feedbackComp.(*VisualFeedbackComponent)
-			flashAlpha = feedback.GetFlashAlpha()
-			tintR, tintG, tintB, tintA = feedback.TintR, feedback.TintG, feedback.TintB, feedback.TintA
-		}
+		flashAlpha, tintR, tintG, tintB, tintA := r.spriteColorScale(entity)
 
 		// Calculate sprite corners in screen space
 		halfW := sprite.Width / 2
@@ -576,8 +592,8 @@ func (r *EbitenRenderSystem) drawEntity(entity *Entity) {
 		sprite.Rotation = rotation.Angle
 	}
 
-	// Convert world position to screen position
-	screenX, screenY := r.cameraSystem.WorldToScreen(pos.X, pos.Y)
+	// Convert world position to screen position, raising carried and thrown objects
+	screenX, screenY := r.cameraSystem.WorldToScreen(pos.X, pos.Y-Elevation(entity))
 
 	// Check if entity is visible on screen (per-entity culling)
 	if !r.cameraSystem.IsVisible(pos.X, pos.Y, sprite.Width) {
@@ -585,13 +601,7 @@ func (r *EbitenRenderSystem) drawEntity(entity *Entity) {
 	}
 
 	// GAP-012 REPAIR: Apply visual feedback effects (hit flash, tints)
-	var flashAlpha float64
-	var tintR, tintG, tintB, tintA float64 = 1.0, 1.0, 1.0, 1.0
-	if feedbackComp, ok := entity.GetComponent("visual_feedback"); ok {
-		feedback := feedbackComp.(*VisualFeedbackComponent)
-		flashAlpha = feedback.GetFlashAlpha()
-		tintR, tintG, tintB, tintA = feedback.TintR, feedback.TintG, feedback.TintB, feedback.TintA
-	}
+	flashAlpha, tintR, tintG, tintB, tintA := r.spriteColorScale(entity)
 
 	// Draw sprite or colored rectangle
 	// Phase 2: Support directional sprites with fallback to single image
@@ -610,6 +620,7 @@ func (r *EbitenRenderSystem) drawEntity(entity *Entity) {
 	}
 
 	if spriteImage != nil {
+		spriteImage = r.teamSprite(entity, spriteImage)
 		// Draw procedural sprite
 		opts := &ebiten.DrawImageOptions{}
 
@@ -649,6 +660,110 @@ func (r *EbitenRenderSystem) drawEntity(entity *Entity) {
 
 	// GAP-013 REPAIR: Draw health bar for damaged enemies and bosses
 	r.drawHealthBar(entity, screenX, screenY, sprite.Width, sprite.Height)
+
+	r.drawEliteMarker(entity, screenX, screenY, sprite.Width, sprite.Height)
+}
+
+// drawEliteMarker draws a ring in the elite's affix color around elites.
+func (r *EbitenRenderSystem) drawEliteMarker(entity *Entity, screenX, screenY, spriteWidth, spriteHeight float64) {
+	eliteComp, ok := entity.GetComponent("elite")
+	if !ok {
+		return
+	}
+	elite := eliteComp.(*EliteComponent)
+
+	radius := math.Max(spriteWidth, spriteHeight)/2 + 3
+	vector.StrokeCircle(r.screen, float32(screenX), float32(screenY), float32(radius), 2, elite.MarkerColor, true)
+}
+
+// spriteColorScale returns the flash intensity and multiplicative RGBA tint
+// for an entity from its visual feedback effects.
+func (r *EbitenRenderSystem) spriteColorScale(entity *Entity) (flashAlpha, tintR, tintG, tintB, tintA float64) {
+	tintR, tintG, tintB, tintA = 1.0, 1.0, 1.0, 1.0
+	if feedbackComp, ok := entity.GetComponent("visual_feedback"); ok {
+		feedback := feedbackComp.(*VisualFeedbackComponent)
+		flashAlpha = feedback.GetFlashAlpha()
+		tintR, tintG, tintB, tintA = feedback.TintR, feedback.TintG, feedback.TintB, feedback.TintA
+	}
+	return flashAlpha, tintR, tintG, tintB, tintA
+}
+
+// SetTeamTintPalette enables team tinting using the given palette: sprites
+// of entities with a TeamComponent are recolored toward their team color.
+// Tinting is off by default; pass nil to disable it again.
+func (r *EbitenRenderSystem) SetTeamTintPalette(palette *TeamTintPalette) {
+	r.teamTints = palette
+}
+
+// prepareTeamSprite builds team-tinted copies of any of the entity's sprite
+// images that do not have one yet. Copies are rebuilt when the team color
+// changes and dropped once their image is no longer used by the sprite or
+// its animation.
+func (r *EbitenRenderSystem) prepareTeamSprite(entity *Entity) {
+	spriteComp, ok := entity.GetComponent("sprite")
+	if !ok {
+		return
+	}
+	sprite := spriteComp.(*EbitenSprite)
+	teamColor, ok := r.teamTints.EntityTeamColor(entity)
+	if !ok {
+		sprite.teamImages = nil
+		return
+	}
+	if sprite.teamImages == nil || sprite.teamColor != teamColor {
+		sprite.teamImages = make(map[*ebiten.Image]*ebiten.Image)
+		sprite.teamColor = teamColor
+	}
+
+	images := []*ebiten.Image{sprite.Image}
+	for _, img := range sprite.DirectionalImages {
+		images = append(images, img)
+	}
+	added := false
+	for _, img := range images {
+		if img == nil {
+			continue
+		}
+		if _, ok := sprite.teamImages[img]; !ok {
+			sprite.teamImages[img] = sprites.TintByTeam(img, teamColor)
+			added = true
+		}
+	}
+	if !added {
+		return
+	}
+
+	// Forget copies of images the entity no longer uses
+	live := make(map[*ebiten.Image]bool, len(images))
+	for _, img := range images {
+		live[img] = true
+	}
+	if animComp, ok := entity.GetComponent("animation"); ok {
+		for _, frame := range animComp.(*AnimationComponent).Frames {
+			live[frame] = true
+		}
+	}
+	for img := range sprite.teamImages {
+		if !live[img] {
+			delete(sprite.teamImages, img)
+		}
+	}
+}
+
+// teamSprite returns the team-tinted copy of img prepared by Update, or img
+// itself when tinting is off or no copy exists.
+func (r *EbitenRenderSystem) teamSprite(entity *Entity, img *ebiten.Image) *ebiten.Image {
+	if r.teamTints == nil {
+		return img
+	}
+	spriteComp, ok := entity.GetComponent("sprite")
+	if !ok {
+		return img
+	}
+	if tinted, ok := spriteComp.(*EbitenSprite).teamImages[img]; ok {
+		return tinted
+	}
+	return img
 }
 
 // drawHealthBar renders a health bar above an entity if appropriate.
@@ -0,0 +1,341 @@
+// Package engine provides configurable enemy respawning.
+// This file implements RespawnSystem, which tracks enemies per spawn zone
+// (usually one zone per terrain room) and refills cleared zones after a
+// timer when the zone's respawn policy allows it. Game modes choose between
+// permanent clears and timed respawns for grinding.
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/entity"
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// RespawnPolicy controls whether and when a cleared spawn zone refills.
+type RespawnPolicy struct {
+	// Enabled allows the zone to respawn; false makes clears permanent
+	Enabled bool
+
+	// Interval is the delay in seconds between clearing and respawning
+	Interval float64
+
+	// MaxRespawns caps how many times the zone refills (0 = unlimited)
+	MaxRespawns int
+
+	// WaitForEmpty pauses the timer while a player is inside the zone
+	WaitForEmpty bool
+}
+
+// PermanentClearPolicy returns a policy under which cleared zones stay clear.
+func PermanentClearPolicy() RespawnPolicy {
+	return RespawnPolicy{}
+}
+
+// TimedRespawnPolicy returns a policy that refills a zone the given number
+// of seconds after it is cleared, once no player is inside.
+func TimedRespawnPolicy(interval float64) RespawnPolicy {
+	return RespawnPolicy{
+		Enabled:      true,
+		Interval:     interval,
+		WaitForEmpty: true,
+	}
+}
+
+// Validate checks that the policy is usable.
+func (p RespawnPolicy) Validate() error {
+	if p.Interval < 0 {
+		return fmt.Errorf("respawn interval must be non-negative, got %f", p.Interval)
+	}
+	if p.MaxRespawns < 0 {
+		return fmt.Errorf("max respawns must be non-negative, got %d", p.MaxRespawns)
+	}
+	return nil
+}
+
+// SpawnZone is an area whose enemies are tracked and respawned together.
+type SpawnZone struct {
+	// ID identifies the zone (the room index for terrain zones)
+	ID int
+
+	// Bounds in world pixels
+	X, Y, Width, Height float64
+
+	// Budget is the number of enemies spawned per respawn wave
+	Budget int
+
+	// Policy controls respawning for this zone
+	Policy RespawnPolicy
+
+	// Respawns counts how many times the zone has refilled
+	Respawns int
+
+	populated bool    // zone has had living enemies
+	cleared   bool    // all enemies are dead
+	timer     float64 // seconds until respawn once cleared
+}
+
+// Contains reports whether a world position is inside the zone.
+func (z *SpawnZone) Contains(x, y float64) bool {
+	return x >= z.X && x < z.X+z.Width && y >= z.Y && y < z.Y+z.Height
+}
+
+// IsCleared reports whether every enemy in a populated zone is dead.
+func (z *SpawnZone) IsCleared() bool {
+	return z.cleared
+}
+
+// TimeUntilRespawn returns the seconds left before a cleared zone refills,
+// or 0 if it is not waiting to respawn.
+func (z *SpawnZone) TimeUntilRespawn() float64 {
+	if !z.cleared || !z.canRespawn() {
+		return 0
+	}
+	return z.timer
+}
+
+// canRespawn reports whether the policy and budget allow another wave.
+func (z *SpawnZone) canRespawn() bool {
+	if !z.Policy.Enabled || z.Budget <= 0 {
+		return false
+	}
+	return z.Policy.MaxRespawns == 0 || z.Respawns < z.Policy.MaxRespawns
+}
+
+// SpawnZoneComponent links an enemy to the spawn zone it belongs to.
+type SpawnZoneComponent struct {
+	// ZoneID is the owning SpawnZone's ID
+	ZoneID int
+}
+
+// Type returns the component type identifier.
+func (s *SpawnZoneComponent) Type() string {
+	return "spawn_zone"
+}
+
+// EnemySpawnFunc creates one enemy at the given position for a zone.
+// It returns nil if no enemy could be created.
+type EnemySpawnFunc func(world *World, zone *SpawnZone, x, y float64, rng *rand.Rand) *Entity
+
+// NewSpawnZonesFromTerrain creates one zone per room, skipping the first
+// room (player spawn), matching the rooms used by SpawnEnemiesInTerrain.
+func NewSpawnZonesFromTerrain(terr *terrain.Terrain, tileSize float64, budget int, policy RespawnPolicy) []*SpawnZone {
+	if terr == nil || len(terr.Rooms) < 2 {
+		return nil
+	}
+
+	zones := make([]*SpawnZone, 0, len(terr.Rooms)-1)
+	for i := 1; i < len(terr.Rooms); i++ {
+		room := terr.Rooms[i]
+		zones = append(zones, &SpawnZone{
+			ID:     i,
+			X:      float64(room.X) * tileSize,
+			Y:      float64(room.Y) * tileSize,
+			Width:  float64(room.Width) * tileSize,
+			Height: float64(room.Height) * tileSize,
+			Budget: budget,
+			Policy: policy,
+		})
+	}
+	return zones
+}
+
+// RespawnSystem refills cleared spawn zones according to their policies.
+type RespawnSystem struct {
+	world     *World
+	zones     []*SpawnZone
+	zoneIndex map[int]*SpawnZone
+	spawn     EnemySpawnFunc
+	rng       *rand.Rand
+
+	// Used by the default spawn function
+	entityGen *entity.EntityGenerator
+	params    procgen.GenerationParams
+
+	// Difficulty scaling applied to every respawned enemy
+	difficulty DifficultySettings
+
+	// Scratch buffer for living enemy counts per zone
+	alive map[int]int
+}
+
+// NewRespawnSystem creates a respawn system. Enemies are generated with the
+// entity generator using params unless SetSpawnFunc overrides it.
+func NewRespawnSystem(world *World, seed int64, params procgen.GenerationParams) *RespawnSystem {
+	s := &RespawnSystem{
+		world:      world,
+		zoneIndex:  make(map[int]*SpawnZone),
+		rng:        rand.New(rand.NewSource(seed)),
+		entityGen:  entity.NewEntityGenerator(),
+		params:     params,
+		difficulty: DifficultyNormal.Settings(),
+		alive:      make(map[int]int),
+	}
+	s.spawn = s.spawnFromGenerator
+	return s
+}
+
+// SetDifficulty sets the difficulty respawned enemies are generated and
+// scaled with. Zone budgets are not changed; size them with
+// DifficultySettings.SpawnCount when creating the zones.
+func (s *RespawnSystem) SetDifficulty(difficulty DifficultySettings) {
+	s.difficulty = difficulty
+	s.params = difficulty.GenerationParams(s.params)
+}
+
+// SetSpawnFunc replaces the function used to create respawned enemies.
+func (s *RespawnSystem) SetSpawnFunc(fn EnemySpawnFunc) {
+	if fn == nil {
+		fn = s.spawnFromGenerator
+	}
+	s.spawn = fn
+}
+
+// AddZone registers a spawn zone. Zone IDs must be unique.
+func (s *RespawnSystem) AddZone(zone *SpawnZone) error {
+	if zone == nil {
+		return fmt.Errorf("zone cannot be nil")
+	}
+	if _, exists := s.zoneIndex[zone.ID]; exists {
+		return fmt.Errorf("zone %d already registered", zone.ID)
+	}
+	if err := zone.Policy.Validate(); err != nil {
+		return fmt.Errorf("zone %d: %w", zone.ID, err)
+	}
+	s.zones = append(s.zones, zone)
+	s.zoneIndex[zone.ID] = zone
+	return nil
+}
+
+// Zone returns the zone with the given ID.
+func (s *RespawnSystem) Zone(id int) (*SpawnZone, bool) {
+	zone, ok := s.zoneIndex[id]
+	return zone, ok
+}
+
+// Zones returns all registered zones in registration order.
+func (s *RespawnSystem) Zones() []*SpawnZone {
+	return s.zones
+}
+
+// ClearZones unregisters every zone, e.g. before registering the zones of
+// a newly generated level.
+func (s *RespawnSystem) ClearZones() {
+	s.zones = nil
+	s.zoneIndex = make(map[int]*SpawnZone)
+}
+
+// SetPolicy applies a respawn policy to every registered zone, for
+// switching game modes at runtime.
+func (s *RespawnSystem) SetPolicy(policy RespawnPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	for _, zone := range s.zones {
+		zone.Policy = policy
+	}
+	return nil
+}
+
+// Update counts living enemies per zone, starts timers for newly cleared
+// zones, and respawns zones whose timers have elapsed.
+func (s *RespawnSystem) Update(entities []*Entity, deltaTime float64) {
+	if s.world == nil || len(s.zones) == 0 {
+		return
+	}
+
+	for id := range s.alive {
+		delete(s.alive, id)
+	}
+	for _, e := range entities {
+		comp, ok := e.GetComponent("spawn_zone")
+		if !ok || !isAliveEnemy(e) {
+			continue
+		}
+		s.alive[comp.(*SpawnZoneComponent).ZoneID]++
+	}
+
+	for _, zone := range s.zones {
+		if s.alive[zone.ID] > 0 {
+			zone.populated = true
+			zone.cleared = false
+			continue
+		}
+		if !zone.populated {
+			continue // Nothing has lived here yet, so nothing was cleared
+		}
+		if !zone.cleared {
+			zone.cleared = true
+			zone.timer = zone.Policy.Interval
+		}
+		if !zone.canRespawn() {
+			continue
+		}
+		if zone.Policy.WaitForEmpty && playerInZone(entities, zone) {
+			continue
+		}
+
+		zone.timer -= deltaTime
+		if zone.timer <= 0 {
+			s.respawnZone(zone)
+		}
+	}
+}
+
+// respawnZone spawns a wave of enemies at random positions inside the zone.
+func (s *RespawnSystem) respawnZone(zone *SpawnZone) {
+	for i := 0; i < zone.Budget; i++ {
+		// Keep spawns away from the zone edges (walls)
+		x := zone.X + zone.Width*(0.25+0.5*s.rng.Float64())
+		y := zone.Y + zone.Height*(0.25+0.5*s.rng.Float64())
+		if enemy := s.spawn(s.world, zone, x, y, s.rng); enemy != nil {
+			s.difficulty.ScaleEnemy(enemy)
+			addEnemyHealthRegen(enemy, s.params.GenreID)
+			enemy.AddComponent(&SpawnZoneComponent{ZoneID: zone.ID})
+		}
+	}
+	zone.Respawns++
+	zone.cleared = false
+}
+
+// spawnFromGenerator is the default EnemySpawnFunc.
+func (s *RespawnSystem) spawnFromGenerator(world *World, zone *SpawnZone, x, y float64, rng *rand.Rand) *Entity {
+	params := s.params
+	params.Custom = map[string]interface{}{"count": 1}
+
+	result, err := s.entityGen.Generate(rng.Int63(), params)
+	if err != nil {
+		return nil
+	}
+	generated, ok := result.([]*entity.Entity)
+	if !ok || len(generated) == 0 {
+		return nil
+	}
+	return SpawnEnemyFromTemplate(world, generated[0], x, y)
+}
+
+// isAliveEnemy reports whether an entity counts toward its zone's population.
+func isAliveEnemy(e *Entity) bool {
+	if e.HasComponent("dead") {
+		return false
+	}
+	if comp, ok := e.GetComponent("health"); ok {
+		return comp.(*HealthComponent).Current > 0
+	}
+	return true
+}
+
+// playerInZone reports whether any living player stands inside the zone.
+func playerInZone(entities []*Entity, zone *SpawnZone) bool {
+	for _, e := range entities {
+		if !e.HasComponent("input") || e.HasComponent("dead") {
+			continue
+		}
+		if pos := e.GetPosition(); pos != nil && zone.Contains(pos.X, pos.Y) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// testRespawnSystem creates a system with one zone holding a single enemy.
+// Respawned enemies are plain entities with position and health.
+func testRespawnSystem(t *testing.T, policy RespawnPolicy) (*World, *RespawnSystem, *Entity) {
+	t.Helper()
+	w := NewWorld()
+	sys := NewRespawnSystem(w, 1, procgen.GenerationParams{GenreID: "fantasy"})
+	sys.SetSpawnFunc(func(world *World, zone *SpawnZone, x, y float64, rng *rand.Rand) *Entity {
+		e := world.CreateEntity()
+		e.AddComponent(&PositionComponent{X: x, Y: y})
+		e.AddComponent(&HealthComponent{Current: 10, Max: 10})
+		return e
+	})
+
+	zone := &SpawnZone{ID: 1, X: 0, Y: 0, Width: 320, Height: 320, Budget: 2, Policy: policy}
+	if err := sys.AddZone(zone); err != nil {
+		t.Fatalf("AddZone failed: %v", err)
+	}
+
+	enemy := w.CreateEntity()
+	enemy.AddComponent(&PositionComponent{X: 100, Y: 100})
+	enemy.AddComponent(&HealthComponent{Current: 10, Max: 10})
+	enemy.AddComponent(&SpawnZoneComponent{ZoneID: 1})
+	w.Update(0)
+	return w, sys, enemy
+}
+
+// stepRespawn advances the world and respawn system by dt seconds per step.
+func stepRespawn(w *World, sys *RespawnSystem, steps int, dt float64) {
+	for i := 0; i < steps; i++ {
+		w.Update(0)
+		sys.Update(w.GetEntities(), dt)
+	}
+	w.Update(0)
+}
+
+// zonePopulation counts living entities tagged with the zone ID.
+func zonePopulation(w *World, id int) int {
+	count := 0
+	for _, e := range w.GetEntities() {
+		if comp, ok := e.GetComponent("spawn_zone"); ok && comp.(*SpawnZoneComponent).ZoneID == id && isAliveEnemy(e) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestRespawnSystem_RespawnsAfterInterval(t *testing.T) {
+	w, sys, enemy := testRespawnSystem(t, RespawnPolicy{Enabled: true, Interval: 5})
+	zone, _ := sys.Zone(1)
+
+	stepRespawn(w, sys, 1, 0.1)
+	if zone.IsCleared() {
+		t.Fatal("zone with a living enemy should not be cleared")
+	}
+
+	// Kill the enemy
+	enemy.AddComponent(NewDeadComponent(0))
+	stepRespawn(w, sys, 1, 0.1)
+	if !zone.IsCleared() {
+		t.Fatal("zone should be cleared after its enemy dies")
+	}
+
+	// Not yet: 4 seconds in
+	stepRespawn(w, sys, 39, 0.1)
+	if n := zonePopulation(w, 1); n != 0 {
+		t.Fatalf("zone respawned early with %d enemies", n)
+	}
+
+	// After the interval the budget is respawned inside the zone
+	stepRespawn(w, sys, 11, 0.1)
+	if n := zonePopulation(w, 1); n != zone.Budget {
+		t.Fatalf("zone population = %d, want %d", n, zone.Budget)
+	}
+	if zone.IsCleared() || zone.Respawns != 1 {
+		t.Errorf("cleared=%v respawns=%d, want false/1", zone.IsCleared(), zone.Respawns)
+	}
+	for _, e := range w.GetEntities() {
+		if pos := e.GetPosition(); pos != nil && !zone.Contains(pos.X, pos.Y) {
+			t.Errorf("respawned enemy at (%f,%f) outside zone", pos.X, pos.Y)
+		}
+	}
+}
+
+func TestRespawnSystem_PermanentClear(t *testing.T) {
+	w, sys, enemy := testRespawnSystem(t, PermanentClearPolicy())
+	zone, _ := sys.Zone(1)
+
+	stepRespawn(w, sys, 1, 0.1)
+	enemy.Components["health"].(*HealthComponent).Current = 0
+	stepRespawn(w, sys, 600, 0.1)
+
+	if !zone.IsCleared() {
+		t.Error("zone should stay cleared")
+	}
+	if n := zonePopulation(w, 1); n != 0 {
+		t.Errorf("zone population = %d, want 0", n)
+	}
+	if zone.TimeUntilRespawn() != 0 {
+		t.Error("permanent clear should not report a respawn timer")
+	}
+}
+
+func TestRespawnSystem_MaxRespawns(t *testing.T) {
+	w, sys, enemy := testRespawnSystem(t, RespawnPolicy{Enabled: true, Interval: 1, MaxRespawns: 1})
+	zone, _ := sys.Zone(1)
+
+	stepRespawn(w, sys, 1, 0.1)
+	w.RemoveEntity(enemy.ID)
+	stepRespawn(w, sys, 20, 0.1)
+	if zone.Respawns != 1 {
+		t.Fatalf("respawns = %d, want 1", zone.Respawns)
+	}
+
+	// Clear the respawned wave; the budget of respawns is spent
+	for _, e := range w.GetEntities() {
+		e.AddComponent(NewDeadComponent(0))
+	}
+	stepRespawn(w, sys, 50, 0.1)
+	if zone.Respawns != 1 || zonePopulation(w, 1) != 0 {
+		t.Errorf("zone respawned past its limit: respawns=%d", zone.Respawns)
+	}
+}
+
+func TestRespawnSystem_WaitsForPlayerToLeave(t *testing.T) {
+	w, sys, enemy := testRespawnSystem(t, TimedRespawnPolicy(1))
+	zone, _ := sys.Zone(1)
+
+	player := w.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 50, Y: 50})
+	player.AddComponent(&EbitenInput{}) // Mark as player
+
+	stepRespawn(w, sys, 1, 0.1)
+	enemy.AddComponent(NewDeadComponent(0))
+	stepRespawn(w, sys, 30, 0.1)
+	if zone.Respawns != 0 {
+		t.Fatal("zone respawned with the player inside")
+	}
+
+	player.GetPosition().X = 1000
+	stepRespawn(w, sys, 11, 0.1)
+	if zone.Respawns != 1 {
+		t.Errorf("zone should respawn once the player leaves, respawns=%d", zone.Respawns)
+	}
+}
+
+func TestRespawnSystem_AddZoneErrors(t *testing.T) {
+	sys := NewRespawnSystem(NewWorld(), 1, procgen.GenerationParams{})
+
+	if err := sys.AddZone(nil); err == nil {
+		t.Error("expected error for nil zone")
+	}
+	if err := sys.AddZone(&SpawnZone{ID: 1}); err != nil {
+		t.Fatalf("AddZone failed: %v", err)
+	}
+	if err := sys.AddZone(&SpawnZone{ID: 1}); err == nil {
+		t.Error("expected error for duplicate zone ID")
+	}
+	if err := sys.AddZone(&SpawnZone{ID: 2, Policy: RespawnPolicy{Interval: -1}}); err == nil {
+		t.Error("expected error for negative interval")
+	}
+	if err := sys.SetPolicy(TimedRespawnPolicy(30)); err != nil {
+		t.Fatalf("SetPolicy failed: %v", err)
+	}
+	if zone, _ := sys.Zone(1); !zone.Policy.Enabled {
+		t.Error("SetPolicy should update registered zones")
+	}
+}
+
+func TestRespawnSystem_ClearZones(t *testing.T) {
+	sys := NewRespawnSystem(NewWorld(), 1, procgen.GenerationParams{})
+	if err := sys.AddZone(&SpawnZone{ID: 1}); err != nil {
+		t.Fatalf("AddZone failed: %v", err)
+	}
+
+	sys.ClearZones()
+	if len(sys.Zones()) != 0 {
+		t.Errorf("%d zones left after ClearZones", len(sys.Zones()))
+	}
+	if _, ok := sys.Zone(1); ok {
+		t.Error("cleared zone still found by ID")
+	}
+	if err := sys.AddZone(&SpawnZone{ID: 1}); err != nil {
+		t.Errorf("zone IDs should be reusable after ClearZones: %v", err)
+	}
+}
+
+func TestNewSpawnZonesFromTerrain(t *testing.T) {
+	terr := terrain.NewTerrain(40, 40, 1)
+	terr.Rooms = []*terrain.Room{
+		{X: 1, Y: 1, Width: 5, Height: 5},
+		{X: 10, Y: 10, Width: 6, Height: 4},
+	}
+
+	zones := NewSpawnZonesFromTerrain(terr, 32, 3, PermanentClearPolicy())
+	if len(zones) != 1 {
+		t.Fatalf("got %d zones, want 1 (player room skipped)", len(zones))
+	}
+	z := zones[0]
+	if z.ID != 1 || z.X != 320 || z.Y != 320 || z.Width != 192 || z.Height != 128 || z.Budget != 3 {
+		t.Errorf("unexpected zone %+v", *z)
+	}
+}
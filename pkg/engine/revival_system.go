@@ -4,7 +4,10 @@
 // Priority 1.5: Multiplayer Revival System
 package engine
 
-import "math"
+import (
+	"fmt"
+	"math"
+)
 
 // RevivalSystem handles player revival mechanics in multiplayer.
 // Living players can revive dead teammates by standing nearby and pressing
@@ -25,6 +28,52 @@ type RevivalSystem struct {
 	// Default: 0.0 (instant revival)
 	// Future enhancement: could add channeling time
 	RevivalTime float64
+
+	// Party respawn rules (shared lives and respawn waves)
+	policy         PartyRespawnPolicy
+	livesRemaining int
+	waveTimer      float64
+
+	// downed tracks dead players by entity ID. The value is true if the
+	// death was paid for with a shared life (or lives are not in use), so
+	// teammates may revive the player; false means the player must wait
+	// for a respawn wave.
+	downed map[uint64]bool
+}
+
+// PartyRespawnPolicy configures co-op respawning beyond instant revives.
+// The zero value keeps plain proximity revival.
+type PartyRespawnPolicy struct {
+	// SharedLives is the party's pool of lives; every player death costs
+	// one. A player who dies with the pool empty cannot be revived and
+	// waits for the next respawn wave, so shared lives need a WaveInterval.
+	// 0 disables shared lives.
+	SharedLives int
+
+	// WaveInterval is the delay in seconds before every dead player
+	// respawns together. The timer starts when the first player goes down.
+	// 0 disables timed waves; TriggerRespawnWave still works.
+	WaveInterval float64
+
+	// WavesOnly stops teammates from reviving; players only return in waves
+	WavesOnly bool
+}
+
+// Validate checks that the policy is usable.
+func (p PartyRespawnPolicy) Validate() error {
+	if p.SharedLives < 0 {
+		return fmt.Errorf("shared lives must be non-negative, got %d", p.SharedLives)
+	}
+	if p.WaveInterval < 0 {
+		return fmt.Errorf("respawn wave interval must be non-negative, got %f", p.WaveInterval)
+	}
+	if p.WavesOnly && p.WaveInterval == 0 {
+		return fmt.Errorf("waves-only respawning needs a wave interval")
+	}
+	if p.SharedLives > 0 && p.WaveInterval == 0 {
+		return fmt.Errorf("shared lives need a wave interval, or players who die with none left stay dead")
+	}
+	return nil
 }
 
 // NewRevivalSystem creates a new revival system with default parameters.
@@ -34,12 +83,103 @@ func NewRevivalSystem(world *World) *RevivalSystem {
 		RevivalRange:  32.0, // One tile range
 		RevivalAmount: 0.2,  // 20% health restoration
 		RevivalTime:   0.0,  // Instant revival (no channeling)
+		downed:        make(map[uint64]bool),
+	}
+}
+
+// SetPartyRespawnPolicy applies co-op respawn rules and refills the shared
+// life pool.
+func (s *RevivalSystem) SetPartyRespawnPolicy(policy PartyRespawnPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	s.policy = policy
+	s.livesRemaining = policy.SharedLives
+	s.waveTimer = policy.WaveInterval
+	return nil
+}
+
+// PartyRespawnPolicy returns the active co-op respawn rules.
+func (s *RevivalSystem) PartyRespawnPolicy() PartyRespawnPolicy {
+	return s.policy
+}
+
+// LivesRemaining returns the shared lives left, or -1 if shared lives are
+// not in use.
+func (s *RevivalSystem) LivesRemaining() int {
+	if s.policy.SharedLives == 0 {
+		return -1
+	}
+	return s.livesRemaining
+}
+
+// AddSharedLives returns lives to the pool, e.g. as a reward. The pool
+// never grows past the policy's SharedLives.
+func (s *RevivalSystem) AddSharedLives(n int) {
+	if s.policy.SharedLives == 0 || n <= 0 {
+		return
+	}
+	s.livesRemaining += n
+	if s.livesRemaining > s.policy.SharedLives {
+		s.livesRemaining = s.policy.SharedLives
+	}
+}
+
+// TimeUntilWave returns the seconds left before the next respawn wave, or 0
+// if no timed wave is pending.
+func (s *RevivalSystem) TimeUntilWave() float64 {
+	if s.policy.WaveInterval == 0 || len(s.downed) == 0 {
+		return 0
+	}
+	return s.waveTimer
+}
+
+// CanBeRevived reports whether teammates may revive a dead player under
+// the party respawn policy.
+func (s *RevivalSystem) CanBeRevived(player *Entity) bool {
+	if !IsPlayerRevivable(player) || s.policy.WavesOnly {
+		return false
+	}
+	covered, tracked := s.downed[player.ID]
+	return !tracked || covered
+}
+
+// TriggerRespawnWave immediately respawns every dead player, for game
+// conditions such as clearing a floor. Returns the number respawned.
+func (s *RevivalSystem) TriggerRespawnWave(entities []*Entity) int {
+	var anchor *PositionComponent
+	for _, entity := range entities {
+		if entity.HasComponent("input") && !entity.HasComponent("dead") {
+			if anchor = entity.GetPosition(); anchor != nil {
+				break
+			}
+		}
 	}
+
+	count := 0
+	for _, entity := range entities {
+		if !IsPlayerRevivable(entity) {
+			continue
+		}
+		// Rejoin the party at a living teammate, or in place if all are down
+		if pos := entity.GetPosition(); pos != nil && anchor != nil {
+			pos.X, pos.Y = anchor.X, anchor.Y
+		}
+		s.revivePlayer(entity)
+		count++
+	}
+	s.waveTimer = s.policy.WaveInterval
+	return count
 }
 
 // Update processes revival inputs and handles revival logic.
 // Checks for living players pressing revival key near dead players.
 func (s *RevivalSystem) Update(entities []*Entity, deltaTime float64) {
+	s.trackDeaths(entities)
+	if s.updateWave(entities, deltaTime) {
+		return
+	}
+
 	// Find all living player entities (have input and not dead)
 	var livingPlayers []*Entity
 	for _, entity := range entities {
@@ -56,7 +196,7 @@ func (s *RevivalSystem) Update(entities []*Entity, deltaTime float64) {
 	// Find all dead player entities
 	var deadPlayers []*Entity
 	for _, entity := range entities {
-		if entity.HasComponent("input") && entity.HasComponent("dead") {
+		if entity.HasComponent("input") && entity.HasComponent("dead") && s.CanBeRevived(entity) {
 			deadPlayers = append(deadPlayers, entity)
 		}
 	}
@@ -131,11 +271,59 @@ func (s *RevivalSystem) revivePlayer(deadPlayer *Entity) {
 
 	// Remove dead component to restore full functionality
 	deadPlayer.RemoveComponent("dead")
+	delete(s.downed, deadPlayer.ID)
 
 	// Future enhancement: play revival sound effect, show particles, etc.
 	// This would integrate with audio and particle systems
 }
 
+// trackDeaths charges a shared life for each newly dead player and forgets
+// players who are no longer dead or have left the world.
+func (s *RevivalSystem) trackDeaths(entities []*Entity) {
+	dead := make(map[uint64]bool, len(s.downed))
+	for _, entity := range entities {
+		if !entity.HasComponent("input") || !entity.HasComponent("dead") {
+			continue
+		}
+		dead[entity.ID] = true
+		if _, tracked := s.downed[entity.ID]; tracked {
+			continue
+		}
+
+		covered := true
+		if s.policy.SharedLives > 0 {
+			covered = s.livesRemaining > 0
+			if covered {
+				s.livesRemaining--
+			}
+		}
+		if len(s.downed) == 0 {
+			s.waveTimer = s.policy.WaveInterval
+		}
+		s.downed[entity.ID] = covered
+	}
+
+	// Players revived elsewhere (e.g. death mode handling) or removed
+	for id := range s.downed {
+		if !dead[id] {
+			delete(s.downed, id)
+		}
+	}
+}
+
+// updateWave advances the respawn wave timer while players are down and
+// respawns them when it elapses. Returns true if a wave respawned.
+func (s *RevivalSystem) updateWave(entities []*Entity, deltaTime float64) bool {
+	if s.policy.WaveInterval == 0 || len(s.downed) == 0 {
+		return false
+	}
+	s.waveTimer -= deltaTime
+	if s.waveTimer > 0 {
+		return false
+	}
+	return s.TriggerRespawnWave(entities) > 0
+}
+
 // IsPlayerRevivable checks if a specific entity can be revived.
 // Returns true if entity is a dead player with health component.
 func IsPlayerRevivable(entity *Entity) bool {
@@ -409,3 +409,119 @@ func TestRevivalWithDroppedItems(t *testing.T) {
 	// Note: Dropped items remain in world - players need to pick them back up
 	// This is intentional game design - revival doesn't auto-restore inventory
 }
+
+// partyTestPlayers creates two players next to each other, both alive.
+// The first is pressing the revive key.
+func partyTestPlayers(world *World) (*Entity, *Entity) {
+	reviver := world.CreateEntity()
+	reviver.AddComponent(&PositionComponent{X: 100, Y: 100})
+	reviver.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	reviver.AddComponent(&EbitenInput{UseItemPressed: true})
+
+	other := world.CreateEntity()
+	other.AddComponent(&PositionComponent{X: 110, Y: 100})
+	other.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	other.AddComponent(&EbitenInput{})
+	world.Update(0)
+	return reviver, other
+}
+
+// killPlayer marks a player dead the way the combat death handler does.
+func killPlayer(player *Entity) {
+	player.GetHealth().Current = 0
+	player.AddComponent(NewDeadComponent(0))
+}
+
+// TestRevivalSystemSharedLives tests that deaths drain the shared pool and
+// that a death with the pool empty blocks revival until a respawn wave.
+func TestRevivalSystemSharedLives(t *testing.T) {
+	world := NewWorld()
+	revivalSystem := NewRevivalSystem(world)
+	if err := revivalSystem.SetPartyRespawnPolicy(PartyRespawnPolicy{SharedLives: 1, WaveInterval: 10}); err != nil {
+		t.Fatalf("SetPartyRespawnPolicy failed: %v", err)
+	}
+	_, other := partyTestPlayers(world)
+
+	// First death spends the last life and can still be revived
+	killPlayer(other)
+	revivalSystem.Update(world.GetEntities(), 0.016)
+	if revivalSystem.LivesRemaining() != 0 {
+		t.Fatalf("lives remaining = %d, want 0", revivalSystem.LivesRemaining())
+	}
+	if other.HasComponent("dead") {
+		t.Fatal("player whose death was covered by a life should be revived")
+	}
+
+	// Second death finds the pool empty: no revival
+	killPlayer(other)
+	revivalSystem.Update(world.GetEntities(), 0.016)
+	if !other.HasComponent("dead") || revivalSystem.CanBeRevived(other) {
+		t.Fatal("player who died with no shared lives left should not be revivable")
+	}
+	if revivalSystem.LivesRemaining() != 0 {
+		t.Errorf("lives remaining = %d, want 0", revivalSystem.LivesRemaining())
+	}
+
+	// The respawn wave brings them back
+	for i := 0; i < 9; i++ {
+		revivalSystem.Update(world.GetEntities(), 1)
+	}
+	if !other.HasComponent("dead") {
+		t.Fatal("player respawned before the wave timer elapsed")
+	}
+	revivalSystem.Update(world.GetEntities(), 1.1)
+	if other.HasComponent("dead") {
+		t.Fatal("player should respawn when the wave timer elapses")
+	}
+	if pos := other.GetPosition(); pos.X != 100 || pos.Y != 100 {
+		t.Errorf("wave respawn at (%f,%f), want the living teammate's position", pos.X, pos.Y)
+	}
+}
+
+// TestRevivalSystemWavesOnly tests that waves-only mode ignores the revive
+// key and that TriggerRespawnWave respawns immediately.
+func TestRevivalSystemWavesOnly(t *testing.T) {
+	world := NewWorld()
+	revivalSystem := NewRevivalSystem(world)
+	if err := revivalSystem.SetPartyRespawnPolicy(PartyRespawnPolicy{WaveInterval: 30, WavesOnly: true}); err != nil {
+		t.Fatalf("SetPartyRespawnPolicy failed: %v", err)
+	}
+	_, other := partyTestPlayers(world)
+
+	killPlayer(other)
+	revivalSystem.Update(world.GetEntities(), 1)
+	if !other.HasComponent("dead") {
+		t.Fatal("teammate revived a player in waves-only mode")
+	}
+	if got := revivalSystem.TimeUntilWave(); got != 29 {
+		t.Errorf("time until wave = %f, want 29", got)
+	}
+	if revivalSystem.LivesRemaining() != -1 {
+		t.Errorf("lives remaining = %d, want -1 without shared lives", revivalSystem.LivesRemaining())
+	}
+
+	if n := revivalSystem.TriggerRespawnWave(world.GetEntities()); n != 1 {
+		t.Fatalf("TriggerRespawnWave respawned %d players, want 1", n)
+	}
+	if other.HasComponent("dead") || other.GetHealth().Current != 20 {
+		t.Errorf("respawned player: dead=%v health=%f", other.HasComponent("dead"), other.GetHealth().Current)
+	}
+}
+
+// TestPartyRespawnPolicyValidate tests policy validation.
+func TestPartyRespawnPolicyValidate(t *testing.T) {
+	invalid := []PartyRespawnPolicy{
+		{SharedLives: -1},
+		{WaveInterval: -5},
+		{WavesOnly: true},
+		{SharedLives: 3},
+	}
+	for _, policy := range invalid {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("policy %+v should be invalid", policy)
+		}
+	}
+	if err := (PartyRespawnPolicy{}).Validate(); err != nil {
+		t.Errorf("zero policy should be valid: %v", err)
+	}
+}
@@ -131,6 +131,22 @@ func (r *RotationComponent) GetCardinalDirection() int {
 	return sector % 8
 }
 
+// GetFacingDirection returns the sprite facing nearest to the current angle,
+// choosing among 4 cardinal directions or, with directions set to 8, the
+// diagonals as well. Matches the keys of 4- and 8-directional sprite sheets.
+func (r *RotationComponent) GetFacingDirection(directions int) Direction {
+	if directions == 8 {
+		// Sectors from GetCardinalDirection, starting at right and turning clockwise
+		return [8]Direction{
+			DirRight, DirDownRight, DirDown, DirDownLeft,
+			DirLeft, DirUpLeft, DirUp, DirUpRight,
+		}[r.GetCardinalDirection()]
+	}
+
+	sector := int((r.Angle+math.Pi/4)/(math.Pi/2)) % 4
+	return [4]Direction{DirRight, DirDown, DirLeft, DirUp}[sector]
+}
+
 // normalizeAngle constrains an angle to the range [0, 2π)
 func normalizeAngle(angle float64) float64 {
 	angle = math.Mod(angle, 2*math.Pi)
@@ -267,6 +267,39 @@ func TestRotationComponent_GetCardinalDirection(t *testing.T) {
 	}
 }
 
+// TestRotationComponent_GetFacingDirection tests sprite facing selection
+func TestRotationComponent_GetFacingDirection(t *testing.T) {
+	tests := []struct {
+		name       string
+		angle      float64
+		directions int
+		want       Direction
+	}{
+		{"right", 0, 4, DirRight},
+		{"down", math.Pi / 2, 4, DirDown},
+		{"left", math.Pi, 4, DirLeft},
+		{"up", 3 * math.Pi / 2, 4, DirUp},
+		{"just below down-right", math.Pi/4 - 0.1, 4, DirRight},
+		{"just past down-right", math.Pi/4 + 0.1, 4, DirDown},
+		{"near right from above", 2*math.Pi - 0.1, 4, DirRight},
+		{"right 8", 0, 8, DirRight},
+		{"down-right", math.Pi / 4, 8, DirDownRight},
+		{"down-left", 3 * math.Pi / 4, 8, DirDownLeft},
+		{"up-left", 5 * math.Pi / 4, 8, DirUpLeft},
+		{"up 8", 3 * math.Pi / 2, 8, DirUp},
+		{"up-right", 7 * math.Pi / 4, 8, DirUpRight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := NewRotationComponent(tt.angle, 3.0)
+			if got := comp.GetFacingDirection(tt.directions); got != tt.want {
+				t.Errorf("GetFacingDirection(%d) = %v, want %v", tt.directions, got, tt.want)
+			}
+		})
+	}
+}
+
 // TestNormalizeAngle tests angle normalization
 func TestNormalizeAngle(t *testing.T) {
 	tests := []struct {
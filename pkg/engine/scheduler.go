@@ -0,0 +1,162 @@
+// Package engine provides cooperative frame-budget scheduling.
+// This file implements Scheduler which spreads expensive procedural work
+// (sprite generation, terrain chunks, etc.) across multiple frames so that
+// no single frame spends more than a fixed time budget on deferred tasks.
+//
+// Design Philosophy:
+// - Cooperative: tasks are small units of work that run to completion
+// - Budgeted: a task only starts if its estimated cost fits the remaining budget
+// - Prioritized: higher priority tasks run first, FIFO within equal priority
+package engine
+
+import (
+	"sort"
+	"time"
+)
+
+// ScheduledTask is a unit of deferred work executed by the Scheduler.
+type ScheduledTask func()
+
+// DefaultSchedulerBudget is the default per-frame time budget (2ms of a 16.67ms frame).
+const DefaultSchedulerBudget = 2 * time.Millisecond
+
+// scheduledEntry wraps a task with its ordering information.
+type scheduledEntry struct {
+	task     ScheduledTask
+	priority int
+	sequence uint64
+}
+
+// Scheduler executes queued tasks within a per-frame time budget.
+// Work that does not fit in the current frame is deferred to subsequent frames.
+// The scheduler implements System so it can be added to a World directly.
+type Scheduler struct {
+	budget   time.Duration
+	queue    []scheduledEntry
+	sequence uint64
+
+	// estimatedCost is a moving average of observed task durations, used to
+	// decide whether the next task fits in the remaining budget.
+	estimatedCost time.Duration
+
+	// now returns the current time; replaceable for deterministic testing.
+	now func() time.Time
+
+	// Statistics from the most recent frame
+	lastFrameTasks   int
+	lastFrameElapsed time.Duration
+}
+
+// NewScheduler creates a new scheduler with the given per-frame budget.
+// A non-positive budget uses DefaultSchedulerBudget.
+func NewScheduler(budget time.Duration) *Scheduler {
+	if budget <= 0 {
+		budget = DefaultSchedulerBudget
+	}
+	return &Scheduler{
+		budget: budget,
+		queue:  make([]scheduledEntry, 0, 32),
+		now:    time.Now,
+	}
+}
+
+// Enqueue adds a task to the scheduler. Higher priority tasks run first;
+// tasks with equal priority run in the order they were enqueued.
+func (s *Scheduler) Enqueue(task ScheduledTask, priority int) {
+	if task == nil {
+		return
+	}
+
+	entry := scheduledEntry{
+		task:     task,
+		priority: priority,
+		sequence: s.sequence,
+	}
+	s.sequence++
+
+	// Insert after all entries with priority >= the new one to keep FIFO order
+	idx := sort.Search(len(s.queue), func(i int) bool {
+		return s.queue[i].priority < priority
+	})
+	s.queue = append(s.queue, scheduledEntry{})
+	copy(s.queue[idx+1:], s.queue[idx:])
+	s.queue[idx] = entry
+}
+
+// RunFrame executes queued tasks until the budget is exhausted or the queue
+// is empty. A task is only started if its estimated cost fits in the
+// remaining budget, except that the first task of a frame always runs so the
+// queue is guaranteed to make progress. Returns the number of tasks executed.
+func (s *Scheduler) RunFrame() int {
+	start := s.now()
+	executed := 0
+	var elapsed time.Duration
+
+	for len(s.queue) > 0 {
+		if executed > 0 && elapsed+s.estimatedCost > s.budget {
+			break
+		}
+
+		entry := s.queue[0]
+		s.queue[0] = scheduledEntry{}
+		s.queue = s.queue[1:]
+
+		taskStart := s.now()
+		entry.task()
+		cost := s.now().Sub(taskStart)
+		s.recordCost(cost)
+
+		executed++
+		elapsed = s.now().Sub(start)
+	}
+
+	s.lastFrameTasks = executed
+	s.lastFrameElapsed = elapsed
+	return executed
+}
+
+// recordCost updates the moving estimate of task cost.
+func (s *Scheduler) recordCost(cost time.Duration) {
+	if s.estimatedCost == 0 {
+		s.estimatedCost = cost
+		return
+	}
+	// Exponential moving average weighted 3:1 toward history
+	s.estimatedCost = (s.estimatedCost*3 + cost) / 4
+}
+
+// Update implements System, running one frame of scheduled work.
+func (s *Scheduler) Update(entities []*Entity, deltaTime float64) {
+	s.RunFrame()
+}
+
+// Pending returns the number of tasks waiting to run.
+func (s *Scheduler) Pending() int {
+	return len(s.queue)
+}
+
+// Budget returns the per-frame time budget.
+func (s *Scheduler) Budget() time.Duration {
+	return s.budget
+}
+
+// SetBudget changes the per-frame time budget. Non-positive values are ignored.
+func (s *Scheduler) SetBudget(budget time.Duration) {
+	if budget > 0 {
+		s.budget = budget
+	}
+}
+
+// LastFrameStats returns the number of tasks executed and the time spent
+// during the most recent RunFrame call.
+func (s *Scheduler) LastFrameStats() (tasks int, elapsed time.Duration) {
+	return s.lastFrameTasks, s.lastFrameElapsed
+}
+
+// Clear discards all pending tasks.
+func (s *Scheduler) Clear() {
+	for i := range s.queue {
+		s.queue[i] = scheduledEntry{}
+	}
+	s.queue = s.queue[:0]
+}
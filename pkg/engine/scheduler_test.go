@@ -0,0 +1,122 @@
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock provides deterministic time for scheduler tests.
+type fakeClock struct {
+	current time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.current
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.current = c.current.Add(d)
+}
+
+func newTestScheduler(budget time.Duration) (*Scheduler, *fakeClock) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	s := NewScheduler(budget)
+	s.now = clock.Now
+	return s, clock
+}
+
+func TestNewScheduler_DefaultBudget(t *testing.T) {
+	s := NewScheduler(0)
+	if s.Budget() != DefaultSchedulerBudget {
+		t.Errorf("Budget() = %v, want %v", s.Budget(), DefaultSchedulerBudget)
+	}
+}
+
+func TestScheduler_DefersWorkBeyondBudget(t *testing.T) {
+	s, clock := newTestScheduler(10 * time.Millisecond)
+
+	const taskCount = 10
+	completed := 0
+	for i := 0; i < taskCount; i++ {
+		s.Enqueue(func() {
+			clock.Advance(3 * time.Millisecond)
+			completed++
+		}, 0)
+	}
+
+	frames := 0
+	for s.Pending() > 0 {
+		frames++
+		if frames > taskCount {
+			t.Fatal("scheduler failed to drain queue")
+		}
+
+		s.RunFrame()
+		tasks, elapsed := s.LastFrameStats()
+		if elapsed > s.Budget() {
+			t.Errorf("frame %d: elapsed %v exceeds budget %v", frames, elapsed, s.Budget())
+		}
+		if tasks == 0 {
+			t.Errorf("frame %d: no tasks executed", frames)
+		}
+	}
+
+	if completed != taskCount {
+		t.Errorf("completed = %d, want %d", completed, taskCount)
+	}
+	if frames < 2 {
+		t.Errorf("frames = %d, expected work to be spread across multiple frames", frames)
+	}
+}
+
+func TestScheduler_PriorityOrder(t *testing.T) {
+	s, _ := newTestScheduler(time.Second)
+
+	var order []string
+	s.Enqueue(func() { order = append(order, "low") }, 0)
+	s.Enqueue(func() { order = append(order, "high-a") }, 10)
+	s.Enqueue(func() { order = append(order, "mid") }, 5)
+	s.Enqueue(func() { order = append(order, "high-b") }, 10)
+
+	s.RunFrame()
+
+	want := []string{"high-a", "high-b", "mid", "low"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %s, want %s", i, order[i], want[i])
+		}
+	}
+}
+
+func TestScheduler_AlwaysMakesProgress(t *testing.T) {
+	s, clock := newTestScheduler(time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		s.Enqueue(func() { clock.Advance(5 * time.Millisecond) }, 0)
+	}
+
+	if n := s.RunFrame(); n != 1 {
+		t.Errorf("RunFrame() = %d, want 1 for oversized tasks", n)
+	}
+	if s.Pending() != 2 {
+		t.Errorf("Pending() = %d, want 2", s.Pending())
+	}
+}
+
+func TestScheduler_ClearAndNilTask(t *testing.T) {
+	s := NewScheduler(time.Millisecond)
+	s.Enqueue(nil, 0)
+	if s.Pending() != 0 {
+		t.Errorf("nil task should be ignored, Pending() = %d", s.Pending())
+	}
+
+	s.Enqueue(func() {}, 0)
+	s.Enqueue(func() {}, 1)
+	s.Clear()
+	if s.Pending() != 0 {
+		t.Errorf("Pending() after Clear = %d, want 0", s.Pending())
+	}
+}
@@ -29,6 +29,10 @@ type GameSettings struct {
 
 	// Gameplay settings
 	ShowTutorials bool `json:"show_tutorials"`
+
+	// FeedbackIntensity scales combat screen shake, hit-stop and flash
+	// (0.0 = off, 1.0 = normal, 2.0 = maximum)
+	FeedbackIntensity float64 `json:"feedback_intensity"`
 }
 
 // DefaultSettings returns game settings with default values.
@@ -51,7 +55,8 @@ func DefaultSettings() GameSettings {
 		ShowFPS:         false,
 
 		// Gameplay defaults
-		ShowTutorials: true,
+		ShowTutorials:     true,
+		FeedbackIntensity: 1.0,
 	}
 }
 
@@ -84,6 +89,12 @@ func (s *GameSettings) Validate() bool {
 		corrected = true
 	}
 
+	// Validate feedback intensity (0.0-2.0)
+	if s.FeedbackIntensity < 0.0 || s.FeedbackIntensity > 2.0 {
+		s.FeedbackIntensity = 1.0
+		corrected = true
+	}
+
 	// Validate graphics quality
 	validQualities := map[string]bool{"low": true, "medium": true, "high": true}
 	if !validQualities[s.GraphicsQuality] {
@@ -135,7 +146,9 @@ func (sm *SettingsManager) LoadSettings() error {
 		return fmt.Errorf("failed to read settings file: %w", err)
 	}
 
-	var loaded GameSettings
+	// Start from defaults so settings added since the file was written
+	// keep their default values
+	loaded := DefaultSettings()
 	if err := json.Unmarshal(data, &loaded); err != nil {
 		return fmt.Errorf("failed to parse settings file: %w", err)
 	}
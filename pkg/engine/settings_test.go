@@ -366,6 +366,29 @@ func TestSettingsManager_LoadCorruptedSettings(t *testing.T) {
 	}
 }
 
+func TestSettingsManager_LoadOlderSettingsKeepsFeedbackDefault(t *testing.T) {
+	tempDir := t.TempDir()
+	settingsPath := filepath.Join(tempDir, "settings.json")
+
+	// Settings written before feedback intensity existed
+	data := []byte(`{"master_volume": 0.5, "graphics_quality": "high"}`)
+	if err := os.WriteFile(settingsPath, data, 0o644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	sm := &SettingsManager{settingsPath: settingsPath}
+	if err := sm.LoadSettings(); err != nil {
+		t.Fatalf("LoadSettings failed: %v", err)
+	}
+
+	if sm.settings.FeedbackIntensity != 1.0 {
+		t.Errorf("Expected FeedbackIntensity default 1.0, got %v", sm.settings.FeedbackIntensity)
+	}
+	if sm.settings.MasterVolume != 0.5 {
+		t.Errorf("Expected MasterVolume 0.5, got %v", sm.settings.MasterVolume)
+	}
+}
+
 func TestSettingsManager_GetSettings(t *testing.T) {
 	sm := &SettingsManager{
 		settings: DefaultSettings(),
@@ -5,6 +5,7 @@ package engine
 import (
 	"fmt"
 	"image/color"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -22,6 +23,7 @@ const (
 	SettingsOptionVSync
 	SettingsOptionShowFPS
 	SettingsOptionFullscreen
+	SettingsOptionFeedbackIntensity
 	SettingsOptionBack
 )
 
@@ -42,6 +44,8 @@ func (o SettingsOption) String() string {
 		return "Show FPS"
 	case SettingsOptionFullscreen:
 		return "Fullscreen"
+	case SettingsOptionFeedbackIntensity:
+		return "Combat Feedback"
 	case SettingsOptionBack:
 		return "Back"
 	default:
@@ -89,6 +93,7 @@ func NewSettingsUI(screenWidth, screenHeight int, settingsManager *SettingsManag
 			SettingsOptionVSync,
 			SettingsOptionShowFPS,
 			SettingsOptionFullscreen,
+			SettingsOptionFeedbackIntensity,
 			SettingsOptionBack,
 		},
 		visible: false,
@@ -212,9 +217,18 @@ func (s *SettingsUI) decreaseValue(option SettingsOption) {
 		s.currentSettings.ShowFPS = !s.currentSettings.ShowFPS
 	case SettingsOptionFullscreen:
 		s.currentSettings.Fullscreen = !s.currentSettings.Fullscreen
+	case SettingsOptionFeedbackIntensity:
+		s.currentSettings.FeedbackIntensity = stepFeedbackIntensity(s.currentSettings.FeedbackIntensity, -0.1)
 	}
 }
 
+// stepFeedbackIntensity moves the feedback intensity by step, rounded to
+// the nearest tenth so repeated steps land exactly on 0 and clamped to 0-2.
+func stepFeedbackIntensity(intensity, step float64) float64 {
+	intensity = math.Round((intensity+step)*10) / 10
+	return math.Max(0, math.Min(intensity, 2.0))
+}
+
 // increaseValue increases the value of the selected setting.
 func (s *SettingsUI) increaseValue(option SettingsOption) {
 	switch option {
@@ -249,6 +263,8 @@ func (s *SettingsUI) increaseValue(option SettingsOption) {
 		s.currentSettings.ShowFPS = !s.currentSettings.ShowFPS
 	case SettingsOptionFullscreen:
 		s.currentSettings.Fullscreen = !s.currentSettings.Fullscreen
+	case SettingsOptionFeedbackIntensity:
+		s.currentSettings.FeedbackIntensity = stepFeedbackIntensity(s.currentSettings.FeedbackIntensity, 0.1)
 	}
 }
 
@@ -346,6 +362,8 @@ func (s *SettingsUI) getValueString(option SettingsOption) string {
 			return "ON"
 		}
 		return "OFF"
+	case SettingsOptionFeedbackIntensity:
+		return fmt.Sprintf("%.0f%%", s.currentSettings.FeedbackIntensity*100)
 	case SettingsOptionBack:
 		return ""
 	default:
@@ -20,6 +20,7 @@ func TestSettingsOptionString(t *testing.T) {
 		{SettingsOptionVSync, "VSync"},
 		{SettingsOptionShowFPS, "Show FPS"},
 		{SettingsOptionFullscreen, "Fullscreen"},
+		{SettingsOptionFeedbackIntensity, "Combat Feedback"},
 		{SettingsOptionBack, "Back"},
 		{SettingsOption(999), "Unknown"},
 	}
@@ -59,8 +60,8 @@ func TestNewSettingsUI(t *testing.T) {
 		t.Errorf("Expected selectedIdx 0, got %d", ui.selectedIdx)
 	}
 
-	if len(ui.options) != 8 {
-		t.Errorf("Expected 8 options, got %d", len(ui.options))
+	if len(ui.options) != 9 {
+		t.Errorf("Expected 9 options, got %d", len(ui.options))
 	}
 
 	if ui.visible {
@@ -307,6 +308,22 @@ func TestSettingsUI_IncreaseValue(t *testing.T) {
 	}
 }
 
+func TestStepFeedbackIntensity_ReachesZero(t *testing.T) {
+	intensity := 1.0
+	for i := 0; i < 10; i++ {
+		intensity = stepFeedbackIntensity(intensity, -0.1)
+	}
+	if intensity != 0 {
+		t.Errorf("ten steps down from 1.0 = %v, want exactly 0", intensity)
+	}
+	if got := stepFeedbackIntensity(0, -0.1); got != 0 {
+		t.Errorf("step below 0 = %v, want 0", got)
+	}
+	if got := stepFeedbackIntensity(2.0, 0.1); got != 2.0 {
+		t.Errorf("step above 2 = %v, want 2", got)
+	}
+}
+
 func TestSettingsUI_ActivateOption(t *testing.T) {
 	tempDir := t.TempDir()
 	sm := &SettingsManager{
@@ -0,0 +1,322 @@
+// Package engine provides shrine interactables.
+// This file implements shrines: one-use altars placed in shrine rooms that
+// grant a temporary blessing, a permanent boon, or a risk/reward curse.
+// Each shrine's effect is generated deterministically from its seed and
+// flavored by genre.
+package engine
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// ShrineEffectKind classifies what a shrine does to whoever activates it.
+type ShrineEffectKind int
+
+const (
+	// ShrineBlessing applies a temporary buff
+	ShrineBlessing ShrineEffectKind = iota
+	// ShrineBoon permanently raises a stat
+	ShrineBoon
+	// ShrineCurse applies a debuff and pays out gold
+	ShrineCurse
+)
+
+// String returns the string representation of a shrine effect kind.
+func (k ShrineEffectKind) String() string {
+	switch k {
+	case ShrineBlessing:
+		return "blessing"
+	case ShrineBoon:
+		return "boon"
+	case ShrineCurse:
+		return "curse"
+	default:
+		return "unknown"
+	}
+}
+
+// Stats a shrine boon can raise
+const (
+	ShrineStatAttack    = "attack"
+	ShrineStatDefense   = "defense"
+	ShrineStatMaxHealth = "max_health"
+)
+
+// ShrineEffect is the generated effect of one shrine.
+type ShrineEffect struct {
+	Kind ShrineEffectKind
+
+	// Name is the genre-flavored name shown on activation
+	Name string
+
+	// Status effect applied by blessings and curses
+	EffectType   string
+	Magnitude    float64
+	Duration     float64
+	TickInterval float64
+
+	// Stat and Amount raised permanently by boons
+	Stat   string
+	Amount float64
+
+	// RewardGold paid out by curses
+	RewardGold int
+}
+
+// Description summarizes the effect for notifications.
+func (e ShrineEffect) Description() string {
+	switch e.Kind {
+	case ShrineBoon:
+		return fmt.Sprintf("%s: +%.0f %s", e.Name, e.Amount, e.Stat)
+	case ShrineCurse:
+		return fmt.Sprintf("%s: %s for %.0fs, +%d gold", e.Name, e.EffectType, e.Duration, e.RewardGold)
+	default:
+		return fmt.Sprintf("%s: %s for %.0fs", e.Name, e.EffectType, e.Duration)
+	}
+}
+
+// shrineTheme is the genre flavor of shrines.
+type shrineTheme struct {
+	name    string
+	patrons []string
+}
+
+// shrineThemes maps genre IDs to shrine flavor.
+var shrineThemes = map[string]shrineTheme{
+	"fantasy":   {"Shrine", []string{"Aurelia", "the Green Warden", "the Moon"}},
+	"scifi":     {"Augment Altar", []string{"the Archive", "Helix Core", "the Navigator"}},
+	"horror":    {"Bone Altar", []string{"the Hollow One", "the Weeping Saint", "the Pale King"}},
+	"cyberpunk": {"Neural Shrine", []string{"Ghost Protocol", "the Black Market", "Chrome Saint"}},
+	"postapoc":  {"Scrap Totem", []string{"the Wasteland", "Old Rust", "the Last Radio"}},
+}
+
+// getShrineTheme returns the flavor for genreID, defaulting to fantasy.
+func getShrineTheme(genreID string) shrineTheme {
+	if theme, ok := shrineThemes[genreID]; ok {
+		return theme
+	}
+	return shrineThemes["fantasy"]
+}
+
+// GenerateShrineEffect deterministically generates a shrine effect from a
+// seed. Deeper shrines grant stronger effects and pay more for curses.
+func GenerateShrineEffect(seed int64, genreID string, depth int) ShrineEffect {
+	rng := rand.New(rand.NewSource(seed))
+	theme := getShrineTheme(genreID)
+	patron := theme.patrons[rng.Intn(len(theme.patrons))]
+	scale := 1.0 + 0.1*math.Max(0, float64(depth-1))
+
+	var effect ShrineEffect
+	switch roll := rng.Float64(); {
+	case roll < 0.5:
+		effect.Kind = ShrineBlessing
+		effect.Name = "Blessing of " + patron
+		switch rng.Intn(3) {
+		case 0:
+			effect.EffectType = "strength"
+			effect.Magnitude = 0.2 + rng.Float64()*0.3
+		case 1:
+			effect.EffectType = "fortify"
+			effect.Magnitude = 0.2 + rng.Float64()*0.3
+		default:
+			effect.EffectType = "regeneration"
+			effect.Magnitude = (2 + rng.Float64()*3) * scale
+			effect.TickInterval = 1.0
+		}
+		effect.Duration = 60 + rng.Float64()*60
+	case roll < 0.7:
+		effect.Kind = ShrineBoon
+		effect.Name = "Gift of " + patron
+		switch rng.Intn(3) {
+		case 0:
+			effect.Stat = ShrineStatAttack
+			effect.Amount = math.Round((2 + rng.Float64()*3) * scale)
+		case 1:
+			effect.Stat = ShrineStatDefense
+			effect.Amount = math.Round((2 + rng.Float64()*3) * scale)
+		default:
+			effect.Stat = ShrineStatMaxHealth
+			effect.Amount = math.Round((10 + rng.Float64()*15) * scale)
+		}
+	default:
+		effect.Kind = ShrineCurse
+		effect.Name = "Bargain with " + patron
+		switch rng.Intn(3) {
+		case 0:
+			effect.EffectType = "weakness"
+			effect.Magnitude = 0.6 + rng.Float64()*0.2
+			effect.Duration = 45 + rng.Float64()*45
+		case 1:
+			effect.EffectType = "vulnerability"
+			effect.Magnitude = 0.6 + rng.Float64()*0.2
+			effect.Duration = 45 + rng.Float64()*45
+		default:
+			effect.EffectType = "poisoned"
+			effect.Magnitude = (1 + rng.Float64()*2) * scale
+			effect.TickInterval = 1.0
+			effect.Duration = 10 + rng.Float64()*10
+		}
+		effect.RewardGold = int((50 + rng.Float64()*100) * scale)
+	}
+	return effect
+}
+
+// ShrineComponent marks an entity as a shrine.
+type ShrineComponent struct {
+	// Name is the genre-flavored shrine name
+	Name string
+
+	// Seed the effect was generated from
+	Seed int64
+
+	// Effect granted on activation
+	Effect ShrineEffect
+
+	// Used is set once the shrine has been activated
+	Used bool
+}
+
+// Type returns the component type identifier.
+func (s *ShrineComponent) Type() string {
+	return "shrine"
+}
+
+// NewShrineComponent creates a shrine with an effect generated from seed.
+func NewShrineComponent(seed int64, genreID string, depth int) *ShrineComponent {
+	return &ShrineComponent{
+		Name:   getShrineTheme(genreID).name,
+		Seed:   seed,
+		Effect: GenerateShrineEffect(seed, genreID, depth),
+	}
+}
+
+// SpawnShrine creates a shrine entity at the given world position.
+func SpawnShrine(world *World, seed int64, genreID string, depth int, x, y float64) *Entity {
+	shrine := world.CreateEntity()
+	shrine.AddComponent(&PositionComponent{X: x, Y: y})
+
+	sprite := NewSpriteComponent(28, 28, color.RGBA{120, 220, 220, 255})
+	sprite.Layer = 9 // Same layer as crafting stations
+	shrine.AddComponent(sprite)
+
+	shrine.AddComponent(&ColliderComponent{
+		Width:   28,
+		Height:  28,
+		Solid:   true,
+		Layer:   1,
+		OffsetX: -14,
+		OffsetY: -14,
+	})
+	shrine.AddComponent(NewShrineComponent(seed, genreID, depth))
+	return shrine
+}
+
+// SpawnShrinesInTerrain places a shrine at the center of every shrine room.
+// Each shrine's seed is derived from seed and its room index. Returns the
+// number of shrines spawned.
+func SpawnShrinesInTerrain(world *World, terrainData *terrain.Terrain, tileSize int, seed int64, genreID string, depth int) int {
+	if world == nil || terrainData == nil {
+		return 0
+	}
+
+	count := 0
+	for i, room := range terrainData.Rooms {
+		if room.Type != terrain.RoomShrine {
+			continue
+		}
+		cx, cy := room.Center()
+		x := float64(cx*tileSize + tileSize/2)
+		y := float64(cy*tileSize + tileSize/2)
+		SpawnShrine(world, seed+int64(i), genreID, depth, x, y)
+		count++
+	}
+	return count
+}
+
+// FindClosestShrine returns the closest unused shrine within radius of
+// (x, y), or nil.
+func FindClosestShrine(world *World, x, y, radius float64) *Entity {
+	var closest *Entity
+	minDistSq := radius * radius
+	for _, entity := range world.GetEntities() {
+		shrineComp, ok := entity.GetComponent("shrine")
+		if !ok || shrineComp.(*ShrineComponent).Used {
+			continue
+		}
+		pos := entity.GetPosition()
+		if pos == nil {
+			continue
+		}
+		dx, dy := pos.X-x, pos.Y-y
+		if distSq := dx*dx + dy*dy; distSq <= minDistSq {
+			minDistSq = distSq
+			closest = entity
+		}
+	}
+	return closest
+}
+
+// ActivateShrine applies a shrine's effect to target and marks the shrine
+// used. Status effects go through effects. Returns the applied effect.
+func ActivateShrine(shrine, target *Entity, effects *StatusEffectSystem) (ShrineEffect, error) {
+	shrineComp, ok := shrine.GetComponent("shrine")
+	if !ok {
+		return ShrineEffect{}, fmt.Errorf("entity %d is not a shrine", shrine.ID)
+	}
+	sc := shrineComp.(*ShrineComponent)
+	if sc.Used {
+		return ShrineEffect{}, fmt.Errorf("shrine %d has already been used", shrine.ID)
+	}
+	effect := sc.Effect
+
+	switch effect.Kind {
+	case ShrineBoon:
+		if err := applyShrineBoon(target, effect); err != nil {
+			return ShrineEffect{}, err
+		}
+	default:
+		if effects == nil {
+			return ShrineEffect{}, fmt.Errorf("no status effect system to apply %s", effect.EffectType)
+		}
+		effects.ApplyStatusEffect(target, effect.EffectType, effect.Magnitude, effect.Duration, effect.TickInterval)
+		if effect.RewardGold > 0 {
+			if invComp, ok := target.GetComponent("inventory"); ok {
+				invComp.(*InventoryComponent).Gold += effect.RewardGold
+			}
+		}
+	}
+
+	sc.Used = true
+	return effect, nil
+}
+
+// applyShrineBoon permanently raises the boon's stat on target.
+func applyShrineBoon(target *Entity, effect ShrineEffect) error {
+	switch effect.Stat {
+	case ShrineStatAttack, ShrineStatDefense:
+		stats := target.GetStats()
+		if stats == nil {
+			return fmt.Errorf("entity %d has no stats", target.ID)
+		}
+		if effect.Stat == ShrineStatAttack {
+			stats.Attack += effect.Amount
+		} else {
+			stats.Defense += effect.Amount
+		}
+	case ShrineStatMaxHealth:
+		health := target.GetHealth()
+		if health == nil {
+			return fmt.Errorf("entity %d has no health", target.ID)
+		}
+		health.Max += effect.Amount
+		health.Current += effect.Amount
+	default:
+		return fmt.Errorf("unknown shrine stat %q", effect.Stat)
+	}
+	return nil
+}
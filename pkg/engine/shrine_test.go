@@ -0,0 +1,132 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// shrineTestPlayer creates a player with stats, health and gold to receive
+// shrine effects.
+func shrineTestPlayer(world *World) *Entity {
+	player := world.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 100, Y: 100})
+	player.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	player.AddComponent(&StatsComponent{Attack: 10, Defense: 10})
+	player.AddComponent(NewInventoryComponent(20, 100))
+	return player
+}
+
+// activateShrineResult activates a fresh shrine generated from seed and
+// returns the player's resulting state.
+func activateShrineResult(t *testing.T, seed int64) (ShrineEffect, StatsComponent, HealthComponent, int, *StatusEffectComponent) {
+	t.Helper()
+	world := NewWorld()
+	effects := NewStatusEffectSystem(world, rand.New(rand.NewSource(1)))
+	player := shrineTestPlayer(world)
+	shrine := SpawnShrine(world, seed, "fantasy", 3, 100, 100)
+	world.Update(0)
+
+	effect, err := ActivateShrine(shrine, player, effects)
+	if err != nil {
+		t.Fatalf("ActivateShrine failed: %v", err)
+	}
+	inv, _ := player.GetComponent("inventory")
+	var status *StatusEffectComponent
+	if comp, ok := player.GetComponent("status_effect"); ok {
+		status = comp.(*StatusEffectComponent)
+	}
+	return effect, *player.GetStats(), *player.GetHealth(), inv.(*InventoryComponent).Gold, status
+}
+
+func TestActivateShrine_ReproducibleFromSeed(t *testing.T) {
+	kinds := make(map[ShrineEffectKind]bool)
+	for seed := int64(1); seed <= 40; seed++ {
+		effect1, stats1, health1, gold1, status1 := activateShrineResult(t, seed)
+		effect2, stats2, health2, gold2, status2 := activateShrineResult(t, seed)
+		kinds[effect1.Kind] = true
+
+		if effect1 != effect2 || stats1.Attack != stats2.Attack || stats1.Defense != stats2.Defense ||
+			health1.Max != health2.Max || gold1 != gold2 {
+			t.Fatalf("seed %d produced different results: %+v vs %+v", seed, effect1, effect2)
+		}
+
+		switch effect1.Kind {
+		case ShrineBoon:
+			if stats1.Attack == 10 && stats1.Defense == 10 && health1.Max == 100 {
+				t.Errorf("seed %d: boon %+v raised no stat", seed, effect1)
+			}
+		case ShrineBlessing, ShrineCurse:
+			if status1 == nil || status1.EffectType != effect1.EffectType || status1.Magnitude != status2.Magnitude {
+				t.Errorf("seed %d: status effect %+v not applied for %+v", seed, status1, effect1)
+			}
+			if effect1.Kind == ShrineCurse && gold1 != effect1.RewardGold {
+				t.Errorf("seed %d: curse paid %d gold, want %d", seed, gold1, effect1.RewardGold)
+			}
+		}
+	}
+	if len(kinds) != 3 {
+		t.Errorf("40 shrines produced only kinds %v, want blessings, boons and curses", kinds)
+	}
+}
+
+func TestActivateShrine_OneUse(t *testing.T) {
+	world := NewWorld()
+	effects := NewStatusEffectSystem(world, rand.New(rand.NewSource(1)))
+	player := shrineTestPlayer(world)
+	shrine := SpawnShrine(world, 7, "horror", 1, 100, 100)
+	world.Update(0)
+
+	if FindClosestShrine(world, 110, 100, 64) != shrine {
+		t.Fatal("shrine in range not found")
+	}
+	if _, err := ActivateShrine(shrine, player, effects); err != nil {
+		t.Fatalf("ActivateShrine failed: %v", err)
+	}
+	if _, err := ActivateShrine(shrine, player, effects); err == nil {
+		t.Error("expected an error activating a used shrine")
+	}
+	if FindClosestShrine(world, 110, 100, 64) != nil {
+		t.Error("used shrine should not be offered again")
+	}
+	if _, err := ActivateShrine(player, player, effects); err == nil {
+		t.Error("expected an error activating a non-shrine")
+	}
+}
+
+func TestGenerateShrineEffect_GenreFlavor(t *testing.T) {
+	fantasy := NewShrineComponent(3, "fantasy", 1)
+	scifi := NewShrineComponent(3, "scifi", 1)
+	if fantasy.Name == scifi.Name || fantasy.Effect.Name == scifi.Effect.Name {
+		t.Errorf("genres share flavor: %q/%q vs %q/%q", fantasy.Name, fantasy.Effect.Name, scifi.Name, scifi.Effect.Name)
+	}
+	if fantasy.Effect.Kind != scifi.Effect.Kind {
+		t.Error("genre should change only flavor, not the rolled effect")
+	}
+	if unknown := NewShrineComponent(3, "unknown", 1); unknown.Name != fantasy.Name {
+		t.Errorf("unknown genre shrine = %q, want fantasy default", unknown.Name)
+	}
+}
+
+func TestSpawnShrinesInTerrain(t *testing.T) {
+	terr := terrain.NewTerrain(20, 20, 1)
+	terr.Rooms = []*terrain.Room{
+		{X: 1, Y: 1, Width: 4, Height: 4, Type: terrain.RoomSpawn},
+		{X: 8, Y: 8, Width: 4, Height: 4, Type: terrain.RoomShrine},
+	}
+	world := NewWorld()
+
+	if count := SpawnShrinesInTerrain(world, terr, 32, 99, "fantasy", 1); count != 1 {
+		t.Fatalf("spawned %d shrines, want 1", count)
+	}
+	world.Update(0)
+	shrine := FindClosestShrine(world, 10*32+16, 10*32+16, 1)
+	if shrine == nil {
+		t.Fatal("shrine not placed at the room center")
+	}
+	comp, _ := shrine.GetComponent("shrine")
+	if comp.(*ShrineComponent).Seed != 100 {
+		t.Errorf("shrine seed = %d, want seed + room index", comp.(*ShrineComponent).Seed)
+	}
+}
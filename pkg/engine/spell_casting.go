@@ -4,6 +4,7 @@ import (
 	"image/color"
 	"math"
 
+	"github.com/opd-ai/venture/pkg/combat"
 	"github.com/opd-ai/venture/pkg/procgen"
 	"github.com/opd-ai/venture/pkg/procgen/magic"
 	"github.com/opd-ai/venture/pkg/rendering/particles"
@@ -69,6 +70,7 @@ type SpellCastingSystem struct {
 	particleSys     *ParticleSystem       // For visual effects
 	audioMgr        *AudioManager         // For sound effects
 	tutorialSys     *EbitenTutorialSystem // For notifications
+	camera          *CameraSystem         // For teleport transitions
 }
 
 // NewSpellCastingSystem creates a new spell casting system.
@@ -93,6 +95,12 @@ func (s *SpellCastingSystem) SetParticleSystem(particleSys *ParticleSystem) {
 	s.particleSys = particleSys
 }
 
+// SetCamera sets the camera system so teleports play the teleport transition
+// when the caster is the active camera.
+func (s *SpellCastingSystem) SetCamera(camera *CameraSystem) {
+	s.camera = camera
+}
+
 // SetTutorialSystem sets the tutorial system for notifications.
 // This allows displaying feedback messages to the player.
 func (s *SpellCastingSystem) SetTutorialSystem(tutorialSys *EbitenTutorialSystem) {
@@ -229,10 +237,7 @@ func (s *SpellCastingSystem) castOffensiveSpell(caster *Entity, spell *magic.Spe
 		}
 		health := healthComp.(*HealthComponent)
 
-		health.Current -= float64(spell.Stats.Damage)
-		if health.Current < 0 {
-			health.Current = 0
-		}
+		health.TakeTypedDamage(float64(spell.Stats.Damage), spellDamageType(spell.Element))
 
 		// Apply elemental effects based on spell element
 		if s.statusEffectSys != nil {
@@ -460,10 +465,7 @@ func (s *SpellCastingSystem) castDebuffSpell(caster *Entity, spell *magic.Spell,
 			healthComp, hasHealth := target.GetComponent("health")
 			if hasHealth {
 				health := healthComp.(*HealthComponent)
-				health.Current -= float64(spell.Stats.Damage)
-				if health.Current < 0 {
-					health.Current = 0
-				}
+				health.TakeTypedDamage(float64(spell.Stats.Damage), spellDamageType(spell.Element))
 			}
 		}
 
@@ -490,6 +492,20 @@ func (s *SpellCastingSystem) castDebuffSpell(caster *Entity, spell *magic.Spell,
 	}
 }
 
+// spellDamageType maps a spell element to the damage type it deals.
+func spellDamageType(element magic.ElementType) combat.DamageType {
+	switch element {
+	case magic.ElementFire:
+		return combat.DamageFire
+	case magic.ElementIce:
+		return combat.DamageIce
+	case magic.ElementLightning:
+		return combat.DamageLightning
+	default:
+		return combat.DamageMagical
+	}
+}
+
 // applyElementalEffect applies status effects based on spell element.
 func (s *SpellCastingSystem) applyElementalEffect(target *Entity, spell *magic.Spell) {
 	switch spell.Element {
@@ -587,9 +603,11 @@ func (s *SpellCastingSystem) castTeleportSpell(caster *Entity, spell *magic.Spel
 
 	// Validate landing position (check collision)
 	if s.isPositionWalkable(targetX, targetY, caster) {
-		// Teleport successful
-		pos.X = targetX
-		pos.Y = targetY
+		// Teleport successful; the camera transition moves the caster if it is being followed
+		if s.camera == nil || s.camera.GetActiveCamera() != caster || s.camera.BeginTeleport(caster, targetX, targetY) != nil {
+			pos.X = targetX
+			pos.Y = targetY
+		}
 
 		// Spawn teleport visual effect at departure
 		if s.particleSys != nil {
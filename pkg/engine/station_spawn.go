@@ -22,6 +22,10 @@ type StationData struct {
 	SpawnX      float64
 	SpawnY      float64
 	Seed        int64
+	Tier        int // 1-3, scales crafting bonuses (0 = tier 1)
+	MaxCharges  int // Crafts allowed between refills (0 = unlimited)
+	Charges     int
+	FuelItem    string // Item that refills the station's charges
 }
 
 // SpawnStationFromData converts procedural StationData into an engine entity.
@@ -85,6 +89,12 @@ func SpawnStationFromData(world *World, stationData *StationData, x, y float64)
 
 	// Add crafting station component with bonuses
 	stationComp := NewCraftingStationComponent(recipeType)
+	if stationData.Tier > 1 {
+		stationComp.Tier = stationData.Tier
+	}
+	stationComp.MaxCharges = stationData.MaxCharges
+	stationComp.Charges = stationData.Charges
+	stationComp.FuelItem = stationData.FuelItem
 	stationEntity.AddComponent(stationComp)
 
 	return stationEntity
@@ -101,9 +111,10 @@ func SpawnStationFromData(world *World, stationData *StationData, x, y float64)
 //   - tileSize: Size of a single tile in pixels
 //   - seed: Seed for deterministic generation
 //   - genreID: Genre for themed station names
+//   - depth: Dungeon depth; deeper levels yield higher-tier stations more often
 //
 // Returns the number of stations spawned.
-func SpawnStationsInTerrain(world *World, stationGen procgen.Generator, terrainData *terrain.Terrain, tileSize int, seed int64, genreID string) int {
+func SpawnStationsInTerrain(world *World, stationGen procgen.Generator, terrainData *terrain.Terrain, tileSize int, seed int64, genreID string, depth int) int {
 	if world == nil || stationGen == nil || terrainData == nil {
 		return 0
 	}
@@ -111,7 +122,7 @@ func SpawnStationsInTerrain(world *World, stationGen procgen.Generator, terrainD
 	// Generate stations
 	params := procgen.GenerationParams{
 		Difficulty: 0.5,
-		Depth:      1,
+		Depth:      depth,
 		GenreID:    genreID,
 	}
 
@@ -188,6 +199,10 @@ func SpawnStationsInTerrain(world *World, stationGen procgen.Generator, terrainD
 			SpawnX:      point.X,
 			SpawnY:      point.Y,
 			Seed:        stationData.Seed,
+			Tier:        stationData.Tier,
+			MaxCharges:  stationData.MaxCharges,
+			Charges:     stationData.Charges,
+			FuelItem:    stationData.FuelItem,
 		}
 
 		// Spawn station at validated position
@@ -380,5 +395,8 @@ func GetStationInteractionPrompt(stationEntity *Entity) string {
 		stationName = "Workbench"
 	}
 
+	if station.MaxCharges > 0 {
+		return fmt.Sprintf("[U] Use %s (%s) [%d/%d charges]", stationName, recipeTypeName, station.Charges, station.MaxCharges)
+	}
 	return fmt.Sprintf("[U] Use %s (%s)", stationName, recipeTypeName)
 }
@@ -38,8 +38,11 @@ func NewStatusEffectComponent(effectType string, magnitude, duration, tickInterv
 	effect.EffectType = effectType
 	effect.Magnitude = magnitude
 	effect.Duration = duration
+	effect.MaxDuration = duration
+	effect.Stacks = 1
 	effect.TickInterval = tickInterval
 	effect.NextTick = tickInterval
+	effect.StackPolicy = DefaultStackPolicy(effectType)
 
 	return effect
 }
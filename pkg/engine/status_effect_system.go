@@ -1,9 +1,15 @@
 package engine
 
 import (
+	"math"
 	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/combat"
 )
 
+// MaxStatusEffectStacks caps the stack count shown for a repeatedly applied effect.
+const MaxStatusEffectStacks = 99
+
 // StatusEffectSystem manages status effects on entities.
 type StatusEffectSystem struct {
 	world *World
@@ -27,8 +33,15 @@ func (s *StatusEffectSystem) Update(entities []*Entity, deltaTime float64) {
 		for _, comp := range entity.Components {
 			if effect, ok := comp.(*StatusEffectComponent); ok {
 				// Update effect duration and check for ticks
+				before := effect.Magnitude
 				ticked := effect.Update(deltaTime)
 
+				// Independent applications expiring lower the magnitude
+				if after := effect.Magnitude; after != before && !effect.IsExpired() {
+					effect.Magnitude = before
+					s.setEffectMagnitude(entity, effect, after)
+				}
+
 				if effect.IsExpired() {
 					// Remove expired effects
 					effectsToRemove = append(effectsToRemove, effect)
@@ -73,11 +86,11 @@ func (s *StatusEffectSystem) applyPeriodicEffect(entity *Entity, effect *StatusE
 	switch effect.EffectType {
 	case "burning":
 		// Fire DoT (damage over time)
-		health.TakeDamage(effect.Magnitude)
+		health.TakeTypedDamage(effect.Magnitude, combat.DamageFire)
 
 	case "poisoned":
 		// Poison DoT (ignores armor)
-		health.TakeDamage(effect.Magnitude)
+		health.TakeTypedDamage(effect.Magnitude, combat.DamagePoison)
 
 	case "regeneration":
 		// Healing over time
@@ -112,16 +125,21 @@ func (s *StatusEffectSystem) removeEffectModifiers(entity *Entity, effect *Statu
 	}
 }
 
-// ApplyStatusEffect applies a new status effect to an entity.
+// ApplyStatusEffect applies a new status effect to an entity, stacking
+// with an active effect of the same type by the type's default policy.
 func (s *StatusEffectSystem) ApplyStatusEffect(entity *Entity, effectType string, magnitude, duration, tickInterval float64) {
+	s.ApplyStatusEffectWithPolicy(entity, effectType, magnitude, duration, tickInterval, DefaultStackPolicy(effectType))
+}
+
+// ApplyStatusEffectWithPolicy applies a status effect that stacks by the
+// given policy. The policy is fixed by the first application: reapplying
+// an active effect follows the policy it already has.
+func (s *StatusEffectSystem) ApplyStatusEffectWithPolicy(entity *Entity, effectType string, magnitude, duration, tickInterval float64, policy StackPolicy) {
 	// Check if effect already exists
 	for _, comp := range entity.Components {
 		if existing, ok := comp.(*StatusEffectComponent); ok {
 			if existing.EffectType == effectType {
-				// Refresh duration if same effect type
-				if duration > existing.Duration {
-					existing.Duration = duration
-				}
+				s.stackEffect(entity, existing, magnitude, duration)
 				return
 			}
 		}
@@ -129,6 +147,7 @@ func (s *StatusEffectSystem) ApplyStatusEffect(entity *Entity, effectType string
 
 	// Create new status effect from pool
 	effect := NewStatusEffectComponent(effectType, magnitude, duration, tickInterval)
+	effect.StackPolicy = policy
 
 	entity.AddComponent(effect)
 
@@ -136,6 +155,82 @@ func (s *StatusEffectSystem) ApplyStatusEffect(entity *Entity, effectType string
 	s.applyEffectModifiers(entity, effect)
 }
 
+// stackEffect combines a reapplication with an active effect according to
+// the effect's stack policy. Once the stack cap is reached, further
+// applications only refresh the duration.
+func (s *StatusEffectSystem) stackEffect(entity *Entity, existing *StatusEffectComponent, magnitude, duration float64) {
+	policy := existing.StackPolicy
+	if policy == StackIgnore {
+		return
+	}
+	if existing.Stacks >= MaxStatusEffectStacks {
+		policy = StackRefresh
+	}
+
+	switch policy {
+	case StackAdd:
+		s.setEffectMagnitude(entity, existing, existing.Magnitude+magnitude)
+		refreshEffectDuration(existing, duration)
+
+	case StackIndependent:
+		// The first application becomes a tracked share on first restack
+		if len(existing.Applications) == 0 {
+			existing.Applications = append(existing.Applications, StatusEffectApplication{
+				Magnitude: existing.Magnitude,
+				Duration:  existing.Duration,
+			})
+		}
+		existing.Applications = append(existing.Applications, StatusEffectApplication{
+			Magnitude: magnitude,
+			Duration:  duration,
+		})
+		s.setEffectMagnitude(entity, existing, existing.Magnitude+magnitude)
+		refreshEffectDuration(existing, duration)
+
+	default:
+		refreshEffectDuration(existing, duration)
+		clampToApplications(existing)
+	}
+
+	if existing.Stacks < MaxStatusEffectStacks {
+		existing.Stacks++
+	}
+}
+
+// refreshEffectDuration resets an effect's remaining duration to duration
+// if that is longer.
+func refreshEffectDuration(effect *StatusEffectComponent, duration float64) {
+	if duration > effect.Duration {
+		effect.Duration = duration
+		effect.MaxDuration = duration
+	}
+}
+
+// clampToApplications limits the remaining duration of an effect with
+// independent applications to the longest of them, so a refresh at the
+// stack cap cannot keep their summed magnitude alive after they expire.
+func clampToApplications(effect *StatusEffectComponent) {
+	if len(effect.Applications) == 0 {
+		return
+	}
+	longest := 0.0
+	for _, app := range effect.Applications {
+		longest = math.Max(longest, app.Duration)
+	}
+	if effect.Duration > longest {
+		effect.Duration = longest
+		effect.MaxDuration = math.Min(effect.MaxDuration, longest)
+	}
+}
+
+// setEffectMagnitude changes an active effect's magnitude, swapping its
+// stat modifiers for ones at the new magnitude.
+func (s *StatusEffectSystem) setEffectMagnitude(entity *Entity, effect *StatusEffectComponent, magnitude float64) {
+	s.removeEffectModifiers(entity, effect)
+	effect.Magnitude = magnitude
+	s.applyEffectModifiers(entity, effect)
+}
+
 // applyEffectModifiers applies stat modifications when effect is added.
 func (s *StatusEffectSystem) applyEffectModifiers(entity *Entity, effect *StatusEffectComponent) {
 	statsComp, hasStats := entity.GetComponent("stats")
@@ -198,7 +293,7 @@ func (s *StatusEffectSystem) ChainLightning(source, initialTarget *Entity, damag
 	// Apply damage to initial target
 	if healthComp, hasHealth := initialTarget.GetComponent("health"); hasHealth {
 		health := healthComp.(*HealthComponent)
-		health.TakeDamage(damage)
+		health.TakeTypedDamage(damage, combat.DamageLightning)
 
 		// Apply shocked effect
 		s.ApplyStatusEffect(initialTarget, "shocked", 0, 2.0, 0)
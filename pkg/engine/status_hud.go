@@ -0,0 +1,120 @@
+// Package engine provides the status effect HUD model.
+// This file collects an entity's active buffs and debuffs into display
+// entries with remaining-duration fractions and stack counts. The HUD
+// system draws these entries; keeping collection separate from rendering
+// lets the list be tested without a graphics context.
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// debuffEffects lists status effect types that harm the affected entity.
+// Any effect type not listed here is displayed as a buff.
+var debuffEffects = map[string]bool{
+	"burning":       true,
+	"burn":          true,
+	"poisoned":      true,
+	"poison":        true,
+	"shocked":       true,
+	"frozen":        true,
+	"freeze":        true,
+	"slow":          true,
+	"stun":          true,
+	"weakness":      true,
+	"vulnerability": true,
+}
+
+// IsDebuffEffect returns true if the status effect type is harmful.
+func IsDebuffEffect(effectType string) bool {
+	return debuffEffects[effectType]
+}
+
+// StatusHUDEntry describes one active status effect for HUD display.
+type StatusHUDEntry struct {
+	// EffectType is the status effect identifier (e.g. "poisoned")
+	EffectType string
+
+	// Remaining is the duration left in seconds
+	Remaining float64
+
+	// Total is the full duration of the current application in seconds
+	Total float64
+
+	// Stacks is how many times the effect has been applied while active
+	Stacks int
+
+	// Debuff is true for harmful effects
+	Debuff bool
+}
+
+// Progress returns the fraction of duration remaining (1.0 = just applied, 0.0 = expiring).
+func (e StatusHUDEntry) Progress() float64 {
+	if e.Total <= 0 {
+		return 0
+	}
+	p := e.Remaining / e.Total
+	if p > 1 {
+		return 1
+	}
+	if p < 0 {
+		return 0
+	}
+	return p
+}
+
+// Label returns the display text for the entry, e.g. "Poisoned x2 3.5s".
+func (e StatusHUDEntry) Label() string {
+	name := strings.ReplaceAll(e.EffectType, "_", " ")
+	if name != "" {
+		name = strings.ToUpper(name[:1]) + name[1:]
+	}
+	if e.Stacks > 1 {
+		name = fmt.Sprintf("%s x%d", name, e.Stacks)
+	}
+	return fmt.Sprintf("%s %.1fs", name, e.Remaining)
+}
+
+// CollectStatusHUDEntries returns the entity's active status effects for
+// HUD display. Buffs are listed before debuffs; within each group entries
+// are sorted by effect type so the list does not jitter between frames.
+// Expired effects are omitted.
+func CollectStatusHUDEntries(entity *Entity) []StatusHUDEntry {
+	if entity == nil {
+		return nil
+	}
+
+	var entries []StatusHUDEntry
+	for _, comp := range entity.Components {
+		effect, ok := comp.(*StatusEffectComponent)
+		if !ok || effect.IsExpired() {
+			continue
+		}
+
+		total := effect.MaxDuration
+		if total < effect.Duration {
+			total = effect.Duration
+		}
+		stacks := effect.Stacks
+		if stacks < 1 {
+			stacks = 1
+		}
+		entries = append(entries, StatusHUDEntry{
+			EffectType: effect.EffectType,
+			Remaining:  effect.Duration,
+			Total:      total,
+			Stacks:     stacks,
+			Debuff:     IsDebuffEffect(effect.EffectType),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Debuff != entries[j].Debuff {
+			return !entries[i].Debuff
+		}
+		return entries[i].EffectType < entries[j].EffectType
+	})
+	return entries
+}
@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestStatusHUD_TimedBuffLifecycle(t *testing.T) {
+	world := NewWorld()
+	system := NewStatusEffectSystem(world, rand.New(rand.NewSource(1)))
+	player := NewEntity(1)
+	player.AddComponent(&StatsComponent{Attack: 10, Defense: 5})
+
+	if entries := CollectStatusHUDEntries(player); len(entries) != 0 {
+		t.Fatalf("expected empty HUD list, got %d entries", len(entries))
+	}
+
+	system.ApplyStatusEffect(player, "strength", 0.3, 2.0, 0)
+
+	entries := CollectStatusHUDEntries(player)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 HUD entry after buff, got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.EffectType != "strength" || entry.Debuff {
+		t.Errorf("entry = %+v, want strength buff", entry)
+	}
+	if entry.Progress() != 1.0 || entry.Stacks != 1 {
+		t.Errorf("fresh buff progress = %v, stacks = %d, want 1.0 and 1", entry.Progress(), entry.Stacks)
+	}
+
+	// Duration decreases as the status effect system ticks
+	previous := entry.Remaining
+	for i := 0; i < 3; i++ {
+		system.Update([]*Entity{player}, 0.5)
+		entries = CollectStatusHUDEntries(player)
+		if len(entries) != 1 {
+			t.Fatalf("tick %d: expected buff still listed, got %d entries", i, len(entries))
+		}
+		if entries[0].Remaining >= previous {
+			t.Errorf("tick %d: remaining %v did not decrease from %v", i, entries[0].Remaining, previous)
+		}
+		if entries[0].Total != 2.0 {
+			t.Errorf("tick %d: total = %v, want 2.0", i, entries[0].Total)
+		}
+		previous = entries[0].Remaining
+	}
+
+	// Entry disappears once the buff expires
+	system.Update([]*Entity{player}, 0.6)
+	if entries := CollectStatusHUDEntries(player); len(entries) != 0 {
+		t.Errorf("expected buff removed after expiry, got %+v", entries)
+	}
+}
+
+func TestStatusHUD_StackCount(t *testing.T) {
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	target := NewEntity(2)
+
+	system.ApplyStatusEffect(target, "poisoned", 2, 3.0, 1.0)
+	system.Update([]*Entity{target}, 1.0)
+	system.ApplyStatusEffect(target, "poisoned", 2, 3.0, 1.0)
+
+	entries := CollectStatusHUDEntries(target)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].Stacks != 2 {
+		t.Errorf("stacks = %d, want 2", entries[0].Stacks)
+	}
+	if !entries[0].Debuff {
+		t.Error("poisoned should be listed as a debuff")
+	}
+	// Reapplication refreshes the bar to full
+	if entries[0].Progress() != 1.0 {
+		t.Errorf("progress after refresh = %v, want 1.0", entries[0].Progress())
+	}
+	if got := entries[0].Label(); got != "Poisoned x2 3.0s" {
+		t.Errorf("Label() = %q, want %q", got, "Poisoned x2 3.0s")
+	}
+}
+
+func TestStatusHUDEntry_Progress(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry StatusHUDEntry
+		want  float64
+	}{
+		{"half", StatusHUDEntry{Remaining: 2, Total: 4}, 0.5},
+		{"no total", StatusHUDEntry{Remaining: 2}, 0},
+		{"clamped", StatusHUDEntry{Remaining: 5, Total: 4}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.entry.Progress(); got != tt.want {
+				t.Errorf("Progress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectStatusHUDEntries_NilEntity(t *testing.T) {
+	if entries := CollectStatusHUDEntries(nil); entries != nil {
+		t.Errorf("expected nil for nil entity, got %v", entries)
+	}
+}
@@ -0,0 +1,82 @@
+// Package engine provides status effect stacking policies.
+// This file defines StackPolicy, which decides what happens when an effect
+// is applied to an entity that already has it: refresh the duration, sum
+// the magnitude, track each application separately, or ignore it.
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StackPolicy selects how a status effect combines with a reapplication of
+// the same effect.
+type StackPolicy int
+
+const (
+	// StackRefresh resets the remaining duration, never shortening it
+	StackRefresh StackPolicy = iota
+	// StackAdd sums the magnitudes and refreshes the duration
+	StackAdd
+	// StackIndependent tracks each application with its own duration; the
+	// magnitude is the sum of those still active
+	StackIndependent
+	// StackIgnore discards reapplications while the effect is active
+	StackIgnore
+)
+
+// String returns the string representation of a stack policy.
+func (p StackPolicy) String() string {
+	switch p {
+	case StackRefresh:
+		return "refresh"
+	case StackAdd:
+		return "add"
+	case StackIndependent:
+		return "independent"
+	case StackIgnore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseStackPolicy converts a string (refresh, add, independent, ignore) to
+// a StackPolicy.
+func ParseStackPolicy(s string) (StackPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "refresh":
+		return StackRefresh, nil
+	case "add":
+		return StackAdd, nil
+	case "independent":
+		return StackIndependent, nil
+	case "ignore":
+		return StackIgnore, nil
+	default:
+		return StackRefresh, fmt.Errorf("unknown stack policy: %q", s)
+	}
+}
+
+// DefaultStackPolicy returns the stack policy used for an effect type when
+// none is given: damage over time stacks per application, each expiring on
+// its own so recasting cannot grow it past what is active at once;
+// everything else refreshes.
+func DefaultStackPolicy(effectType string) StackPolicy {
+	switch effectType {
+	case "poison", "poisoned", "burn", "burning":
+		return StackIndependent
+	default:
+		return StackRefresh
+	}
+}
+
+// StatusEffectApplication is one application of an effect stacked with
+// StackIndependent.
+type StatusEffectApplication struct {
+	// Magnitude contributed by this application
+	Magnitude float64
+
+	// Duration remaining in seconds
+	Duration float64
+}
@@ -0,0 +1,230 @@
+package engine
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/saveload"
+)
+
+// activeEffect returns the entity's status effect, failing if it has none.
+func activeEffect(t *testing.T, entity *Entity) *StatusEffectComponent {
+	t.Helper()
+	comp, ok := entity.GetComponent("status_effect")
+	if !ok {
+		t.Fatal("expected an active status effect")
+	}
+	return comp.(*StatusEffectComponent)
+}
+
+func TestStackPolicy_StringAndParse(t *testing.T) {
+	for _, policy := range []StackPolicy{StackRefresh, StackAdd, StackIndependent, StackIgnore} {
+		parsed, err := ParseStackPolicy(policy.String())
+		if err != nil || parsed != policy {
+			t.Errorf("ParseStackPolicy(%q) = %v, %v; want %v", policy.String(), parsed, err, policy)
+		}
+	}
+	if StackPolicy(99).String() != "unknown" {
+		t.Error("out of range policy should be unknown")
+	}
+	if _, err := ParseStackPolicy("bogus"); err == nil {
+		t.Error("expected error for unknown policy")
+	}
+}
+
+func TestDefaultStackPolicy(t *testing.T) {
+	tests := []struct {
+		effectType string
+		want       StackPolicy
+	}{
+		{"poisoned", StackIndependent},
+		{"poison", StackIndependent},
+		{"burning", StackIndependent},
+		{"slow", StackRefresh},
+		{"strength", StackRefresh},
+	}
+	for _, tt := range tests {
+		if got := DefaultStackPolicy(tt.effectType); got != tt.want {
+			t.Errorf("DefaultStackPolicy(%q) = %v, want %v", tt.effectType, got, tt.want)
+		}
+	}
+}
+
+func TestStatusEffectSystem_StackPolicies(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        StackPolicy
+		wantMagnitude float64
+		wantDuration  float64
+		wantStacks    int
+	}{
+		// First application: magnitude 2 for 3s, then 1s passes, then a
+		// second application of magnitude 5 for 2.5s
+		{"refresh", StackRefresh, 2, 2.5, 2},
+		{"add", StackAdd, 7, 2.5, 2},
+		{"independent", StackIndependent, 7, 2.5, 2},
+		{"ignore", StackIgnore, 2, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+			target := NewEntity(1)
+
+			system.ApplyStatusEffectWithPolicy(target, "slow", 2, 3, 0, tt.policy)
+			system.Update([]*Entity{target}, 1)
+			system.ApplyStatusEffectWithPolicy(target, "slow", 5, 2.5, 0, tt.policy)
+
+			effect := activeEffect(t, target)
+			if effect.Magnitude != tt.wantMagnitude {
+				t.Errorf("magnitude = %.1f, want %.1f", effect.Magnitude, tt.wantMagnitude)
+			}
+			if math.Abs(effect.Duration-tt.wantDuration) > 1e-9 {
+				t.Errorf("duration = %.2f, want %.2f", effect.Duration, tt.wantDuration)
+			}
+			if effect.Stacks != tt.wantStacks {
+				t.Errorf("stacks = %d, want %d", effect.Stacks, tt.wantStacks)
+			}
+		})
+	}
+}
+
+func TestStatusEffectSystem_IndependentExpiry(t *testing.T) {
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	target := NewEntity(1)
+
+	system.ApplyStatusEffectWithPolicy(target, "slow", 2, 1, 0, StackIndependent)
+	system.ApplyStatusEffectWithPolicy(target, "slow", 3, 4, 0, StackIndependent)
+	if effect := activeEffect(t, target); effect.Magnitude != 5 || len(effect.Applications) != 2 {
+		t.Fatalf("magnitude = %.1f with %d applications, want 5 with 2", effect.Magnitude, len(effect.Applications))
+	}
+
+	// The short application runs out first, taking its share with it
+	system.Update([]*Entity{target}, 1.5)
+	effect := activeEffect(t, target)
+	if effect.Magnitude != 3 || effect.Stacks != 1 {
+		t.Errorf("after first expiry: magnitude = %.1f, stacks = %d; want 3, 1", effect.Magnitude, effect.Stacks)
+	}
+
+	system.Update([]*Entity{target}, 3)
+	if target.HasComponent("status_effect") {
+		t.Error("effect should expire with its last application")
+	}
+}
+
+func TestStatusEffectSystem_StackAddModifiers(t *testing.T) {
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	target := NewEntity(1)
+	stats := NewStatsComponent()
+	stats.Attack = 100
+	target.AddComponent(stats)
+
+	system.ApplyStatusEffectWithPolicy(target, "strength", 0.2, 5, 0, StackAdd)
+	system.ApplyStatusEffectWithPolicy(target, "strength", 0.3, 5, 0, StackAdd)
+	if math.Abs(stats.Attack-150) > 1e-9 {
+		t.Errorf("attack = %.2f, want 150 with +50%% stacked", stats.Attack)
+	}
+
+	system.Update([]*Entity{target}, 6)
+	if math.Abs(stats.Attack-100) > 1e-9 {
+		t.Errorf("attack after expiry = %.2f, want 100", stats.Attack)
+	}
+}
+
+func TestStatusEffectSystem_DefaultPoisonStacks(t *testing.T) {
+	// Two poison hits stack damage, while a slow only refreshes
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	target := NewEntity(1)
+
+	system.ApplyStatusEffect(target, "poisoned", 2, 3, 1)
+	system.ApplyStatusEffect(target, "poisoned", 2, 3, 1)
+	if effect := activeEffect(t, target); effect.Magnitude != 4 {
+		t.Errorf("poison magnitude = %.1f, want 4", effect.Magnitude)
+	}
+}
+
+func TestStatusEffectSystem_RecastBurnDoesNotAccumulate(t *testing.T) {
+	// A 3s burn recast every second only ever has three casts active
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	target := NewEntity(1)
+
+	for i := 0; i < 20; i++ {
+		system.ApplyStatusEffect(target, "burning", 5, 3, 1)
+		if effect := activeEffect(t, target); effect.Magnitude > 15 {
+			t.Fatalf("cast %d: burn magnitude = %.1f, want at most 15", i+1, effect.Magnitude)
+		}
+		system.Update([]*Entity{target}, 1)
+	}
+}
+
+func TestStatusEffectSystem_IndependentStackCapDuration(t *testing.T) {
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	target := NewEntity(1)
+
+	for i := 0; i < MaxStatusEffectStacks; i++ {
+		system.ApplyStatusEffectWithPolicy(target, "slow", 1, 2, 0, StackIndependent)
+	}
+	// At the cap a longer application refreshes, but not past the
+	// applications that carry the magnitude
+	system.ApplyStatusEffectWithPolicy(target, "slow", 1, 30, 0, StackIndependent)
+	effect := activeEffect(t, target)
+	if math.Abs(effect.Duration-2) > 1e-9 {
+		t.Errorf("duration = %.2f, want 2 (the longest application)", effect.Duration)
+	}
+
+	system.Update([]*Entity{target}, 2.5)
+	if target.HasComponent("status_effect") {
+		t.Error("effect should expire with its applications")
+	}
+}
+
+func TestStatusEffectSystem_StackCap(t *testing.T) {
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	target := NewEntity(1)
+
+	for i := 0; i < MaxStatusEffectStacks+10; i++ {
+		system.ApplyStatusEffectWithPolicy(target, "slow", 1, 5, 0, StackAdd)
+	}
+	effect := activeEffect(t, target)
+	if effect.Stacks != MaxStatusEffectStacks || effect.Magnitude != MaxStatusEffectStacks {
+		t.Errorf("stacks = %d, magnitude = %.0f; want both capped at %d",
+			effect.Stacks, effect.Magnitude, MaxStatusEffectStacks)
+	}
+}
+
+func TestCombatState_StackPolicyRoundTrip(t *testing.T) {
+	system := NewStatusEffectSystem(NewWorld(), rand.New(rand.NewSource(1)))
+	player := NewEntity(1)
+	system.ApplyStatusEffectWithPolicy(player, "slow", 2, 2, 0, StackIndependent)
+	system.ApplyStatusEffectWithPolicy(player, "slow", 3, 6, 0, StackIndependent)
+
+	var state saveload.PlayerState
+	SnapshotCombatState(player, &state)
+	if len(state.StatusEffects) != 1 || state.StatusEffects[0].StackPolicy != "independent" {
+		t.Fatalf("saved effects = %+v, want one independent effect", state.StatusEffects)
+	}
+
+	restored := NewEntity(2)
+	RestoreCombatState(restored, &state)
+	effect := activeEffect(t, restored)
+	if effect.StackPolicy != StackIndependent || len(effect.Applications) != 2 || effect.Magnitude != 5 {
+		t.Fatalf("restored = %v with %d applications at %.1f, want independent with 2 at 5",
+			effect.StackPolicy, len(effect.Applications), effect.Magnitude)
+	}
+
+	// The restored applications keep expiring separately
+	system.Update([]*Entity{restored}, 3)
+	if effect.Magnitude != 3 {
+		t.Errorf("magnitude after the short application expires = %.1f, want 3", effect.Magnitude)
+	}
+
+	// Saves without a policy fall back to the effect type's default
+	old := saveload.PlayerState{StatusEffects: []saveload.StatusEffectData{
+		{EffectType: "poisoned", Duration: 3, MaxDuration: 3, Magnitude: 2, TickInterval: 1},
+	}}
+	legacy := NewEntity(3)
+	RestoreCombatState(legacy, &old)
+	if got := activeEffect(t, legacy).StackPolicy; got != StackIndependent {
+		t.Errorf("legacy poison policy = %v, want independent", got)
+	}
+}
@@ -0,0 +1,300 @@
+// Package engine provides stealth and enemy detection.
+// This file implements StealthSystem, which rates how visible each player
+// is from light level, movement speed and nearby cover, and lets unaware
+// enemies with line of sight fill a detection meter before they aggro.
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// Stealth tuning constants
+const (
+	// StealthMinLightFactor is the visibility multiplier in total darkness
+	StealthMinLightFactor = 0.2
+	// StealthStillFactor is the visibility multiplier when standing still
+	StealthStillFactor = 0.5
+	// StealthReferenceSpeed is the movement speed (pixels/second) at which
+	// movement stops adding visibility
+	StealthReferenceSpeed = 100.0
+	// StealthMaxCoverReduction is the visibility removed when every tile
+	// around the player blocks sight
+	StealthMaxCoverReduction = 0.5
+	// DefaultDetectionFillRate is the meter gained per second by a fully
+	// visible player at point-blank range
+	DefaultDetectionFillRate = 1.0
+	// DefaultDetectionDecayRate is the meter lost per second with no player
+	// in sight
+	DefaultDetectionDecayRate = 0.5
+)
+
+// LightSampler reports the light level (0-1) at a world position.
+// LightingSystem implements it.
+type LightSampler interface {
+	CalculateLightIntensityAt(x, y float64, entities []*Entity) float64
+}
+
+// StealthComponent reports how visible a player currently is.
+type StealthComponent struct {
+	// Visibility from 0 (invisible) to 1 (fully exposed)
+	Visibility float64
+
+	// LightLevel, Speed and Cover are the inputs Visibility was rated from
+	LightLevel float64
+	Speed      float64
+	Cover      float64
+}
+
+// Type returns the component type identifier.
+func (s *StealthComponent) Type() string {
+	return "stealth"
+}
+
+// DetectionComponent is an enemy's awareness of the player. The enemy
+// aggroes when Meter reaches 1.
+type DetectionComponent struct {
+	// Meter from 0 (unaware) to 1 (detected)
+	Meter float64
+
+	// FillRate is the meter gained per second by a fully visible target at
+	// point-blank range
+	FillRate float64
+
+	// DecayRate is the meter lost per second with no target in sight
+	DecayRate float64
+}
+
+// Type returns the component type identifier.
+func (d *DetectionComponent) Type() string {
+	return "detection"
+}
+
+// NewDetectionComponent creates a detection meter with default rates.
+func NewDetectionComponent() *DetectionComponent {
+	return &DetectionComponent{
+		FillRate:  DefaultDetectionFillRate,
+		DecayRate: DefaultDetectionDecayRate,
+	}
+}
+
+// Detected reports whether the meter is full.
+func (d *DetectionComponent) Detected() bool {
+	return d.Meter >= 1.0
+}
+
+// CalculateVisibility rates how visible a player is from the light level at
+// their position (0-1), their movement speed and the fraction of
+// surrounding tiles giving cover (0-1). Returns 0-1.
+func CalculateVisibility(lightLevel, speed, cover float64) float64 {
+	light := StealthMinLightFactor + (1-StealthMinLightFactor)*clamp01(lightLevel)
+	movement := StealthStillFactor + (1-StealthStillFactor)*clamp01(speed/StealthReferenceSpeed)
+	concealment := 1 - StealthMaxCoverReduction*clamp01(cover)
+	return light * movement * concealment
+}
+
+// HasTileLineOfSight reports whether the straight line between two world
+// positions crosses only transparent tiles. The tiles the line starts and
+// ends on are not checked. A nil terrain never blocks sight.
+func HasTileLineOfSight(terr *terrain.Terrain, x0, y0, x1, y1, tileSize float64) bool {
+	if terr == nil || tileSize <= 0 {
+		return true
+	}
+	tx0, ty0 := int(math.Floor(x0/tileSize)), int(math.Floor(y0/tileSize))
+	tx1, ty1 := int(math.Floor(x1/tileSize)), int(math.Floor(y1/tileSize))
+
+	// Bresenham over tiles
+	dx, dy := tx1-tx0, ty1-ty0
+	stepX, stepY := 1, 1
+	if dx < 0 {
+		dx, stepX = -dx, -1
+	}
+	if dy < 0 {
+		dy, stepY = -dy, -1
+	}
+	err := dx - dy
+	x, y := tx0, ty0
+	for x != tx1 || y != ty1 {
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x += stepX
+		}
+		if e2 < dx {
+			err += dx
+			y += stepY
+		}
+		if (x != tx1 || y != ty1) && !terr.GetTile(x, y).IsTransparent() {
+			return false
+		}
+	}
+	return true
+}
+
+// StealthSystem rates player visibility and fills enemy detection meters.
+// AI entities with a DetectionComponent only acquire targets through this
+// system; the system gives one to every enemy AI it sees without one.
+type StealthSystem struct {
+	terrain  *terrain.Terrain
+	tileSize float64
+	lights   LightSampler
+}
+
+// NewStealthSystem creates a stealth system. Without terrain there is no
+// cover and nothing blocks sight; without a light sampler everything is
+// fully lit.
+func NewStealthSystem() *StealthSystem {
+	return &StealthSystem{tileSize: 32}
+}
+
+// SetTerrain sets the tiles used for line of sight and cover.
+func (s *StealthSystem) SetTerrain(terr *terrain.Terrain, tileSize float64) {
+	s.terrain = terr
+	if tileSize > 0 {
+		s.tileSize = tileSize
+	}
+}
+
+// SetLightSampler sets the source of light levels.
+func (s *StealthSystem) SetLightSampler(lights LightSampler) {
+	s.lights = lights
+}
+
+// stealthTarget is a player and how visible they are this frame.
+type stealthTarget struct {
+	entity     *Entity
+	pos        *PositionComponent
+	visibility float64
+}
+
+// Update rates every player's visibility, then advances the detection
+// meter of every unaware enemy AI.
+func (s *StealthSystem) Update(entities []*Entity, deltaTime float64) {
+	var targets []stealthTarget
+	for _, entity := range entities {
+		if !entity.HasComponent("input") || entity.HasComponent("dead") {
+			continue
+		}
+		pos := entity.GetPosition()
+		if pos == nil {
+			continue
+		}
+		visibility := s.rateVisibility(entity, pos, entities)
+		targets = append(targets, stealthTarget{entity: entity, pos: pos, visibility: visibility})
+	}
+
+	for _, entity := range entities {
+		aiComp, ok := entity.GetComponent("ai")
+		if !ok || entity.HasComponent("dead") {
+			continue
+		}
+		teamComp, ok := entity.GetComponent("team")
+		if !ok {
+			continue
+		}
+		pos := entity.GetPosition()
+		if pos == nil {
+			continue
+		}
+		ai := aiComp.(*AIComponent)
+
+		detectionComp, ok := entity.GetComponent("detection")
+		if !ok {
+			if teamComp.(*TeamComponent).TeamID != 2 {
+				continue
+			}
+			detectionComp = NewDetectionComponent()
+			entity.AddComponent(detectionComp)
+		}
+		detection := detectionComp.(*DetectionComponent)
+
+		// Already engaged: combat AI owns the target
+		if ai.IsAggressiveState() || ai.State == AIStateDetect {
+			continue
+		}
+		s.updateDetection(ai, detection, teamComp.(*TeamComponent), pos, targets, deltaTime)
+	}
+}
+
+// rateVisibility computes and records how visible a player is.
+func (s *StealthSystem) rateVisibility(player *Entity, pos *PositionComponent, entities []*Entity) float64 {
+	lightLevel := 1.0
+	if s.lights != nil {
+		lightLevel = s.lights.CalculateLightIntensityAt(pos.X, pos.Y, entities)
+	}
+	speed := 0.0
+	if vel := player.GetVelocity(); vel != nil {
+		speed = math.Sqrt(vel.VX*vel.VX + vel.VY*vel.VY)
+	}
+	cover := s.coverAt(pos.X, pos.Y)
+	visibility := CalculateVisibility(lightLevel, speed, cover)
+
+	if stealthComp, ok := player.GetComponent("stealth"); ok {
+		stealth := stealthComp.(*StealthComponent)
+		stealth.Visibility = visibility
+		stealth.LightLevel = lightLevel
+		stealth.Speed = speed
+		stealth.Cover = cover
+	}
+	return visibility
+}
+
+// coverAt returns the fraction of the 8 tiles around a position that block
+// sight.
+func (s *StealthSystem) coverAt(x, y float64) float64 {
+	if s.terrain == nil {
+		return 0
+	}
+	tx, ty := int(math.Floor(x/s.tileSize)), int(math.Floor(y/s.tileSize))
+	blocking := 0
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			if (dx != 0 || dy != 0) && !s.terrain.GetTile(tx+dx, ty+dy).IsTransparent() {
+				blocking++
+			}
+		}
+	}
+	return float64(blocking) / 8
+}
+
+// updateDetection fills the meter toward the most noticeable visible target,
+// or drains it if none is in sight, and aggroes the AI once it is full.
+func (s *StealthSystem) updateDetection(ai *AIComponent, detection *DetectionComponent, team *TeamComponent,
+	pos *PositionComponent, targets []stealthTarget, deltaTime float64,
+) {
+	var best *stealthTarget
+	bestRate := 0.0
+	for i := range targets {
+		target := &targets[i]
+		targetTeam, ok := target.entity.GetComponent("team")
+		if !ok || !team.IsEnemy(targetTeam.(*TeamComponent).TeamID) {
+			continue
+		}
+		dx, dy := target.pos.X-pos.X, target.pos.Y-pos.Y
+		dist := math.Sqrt(dx*dx + dy*dy)
+		if dist > ai.DetectionRange {
+			continue
+		}
+		if !HasTileLineOfSight(s.terrain, pos.X, pos.Y, target.pos.X, target.pos.Y, s.tileSize) {
+			continue
+		}
+
+		// Closer targets are noticed up to twice as fast
+		proximity := 1 - 0.5*dist/ai.DetectionRange
+		if rate := detection.FillRate * target.visibility * proximity; rate > bestRate {
+			best, bestRate = target, rate
+		}
+	}
+
+	if best == nil {
+		detection.Meter = math.Max(0, detection.Meter-detection.DecayRate*deltaTime)
+		return
+	}
+
+	detection.Meter = math.Min(1, detection.Meter+bestRate*deltaTime)
+	if detection.Detected() {
+		ai.Target = best.entity
+		ai.ChangeState(AIStateDetect)
+	}
+}
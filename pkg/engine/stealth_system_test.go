@@ -0,0 +1,170 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// fixedLight reports one light level everywhere.
+type fixedLight float64
+
+func (l fixedLight) CalculateLightIntensityAt(x, y float64, entities []*Entity) float64 {
+	return float64(l)
+}
+
+// stealthOpenTerrain returns a walled room of floor tiles.
+func stealthOpenTerrain(width, height int) *terrain.Terrain {
+	terr := terrain.NewTerrain(width, height, 1)
+	for y := 1; y < height-1; y++ {
+		for x := 1; x < width-1; x++ {
+			terr.SetTile(x, y, terrain.TileFloor)
+		}
+	}
+	return terr
+}
+
+func stealthTestPlayer(x, y, vx float64) *Entity {
+	player := NewEntity(1)
+	player.AddComponent(&EbitenInput{})
+	player.AddComponent(&TeamComponent{TeamID: 1})
+	player.AddComponent(&PositionComponent{X: x, Y: y})
+	player.AddComponent(&VelocityComponent{VX: vx})
+	player.AddComponent(&StealthComponent{})
+	return player
+}
+
+func stealthTestEnemy(x, y float64) *Entity {
+	enemy := NewEntity(2)
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+	enemy.AddComponent(&PositionComponent{X: x, Y: y})
+	enemy.AddComponent(NewAIComponent(x, y))
+	return enemy
+}
+
+func enemyMeter(t *testing.T, enemy *Entity) float64 {
+	t.Helper()
+	comp, ok := enemy.GetComponent("detection")
+	if !ok {
+		t.Fatal("enemy was not given a detection meter")
+	}
+	return comp.(*DetectionComponent).Meter
+}
+
+func TestStealthSystem_DarkStillFillsSlowerThanBrightMoving(t *testing.T) {
+	run := func(light, speed float64) float64 {
+		system := NewStealthSystem()
+		system.SetTerrain(stealthOpenTerrain(20, 5), 32)
+		system.SetLightSampler(fixedLight(light))
+		player := stealthTestPlayer(7*32+16, 2*32+16, speed)
+		enemy := stealthTestEnemy(2*32+16, 2*32+16)
+		system.Update([]*Entity{player, enemy}, 0.1)
+		return enemyMeter(t, enemy)
+	}
+
+	sneaking := run(0, 0)
+	exposed := run(1, StealthReferenceSpeed)
+	if sneaking <= 0 {
+		t.Errorf("player in line of sight should raise the meter, got %v", sneaking)
+	}
+	if sneaking >= exposed {
+		t.Errorf("still player in darkness filled meter to %v, moving player in light to %v", sneaking, exposed)
+	}
+}
+
+func TestStealthSystem_WallBlocksDetection(t *testing.T) {
+	terr := stealthOpenTerrain(20, 5)
+	for y := 1; y < 4; y++ {
+		terr.SetTile(6, y, terrain.TileWall)
+	}
+	system := NewStealthSystem()
+	system.SetTerrain(terr, 32)
+	player := stealthTestPlayer(9*32+16, 2*32+16, StealthReferenceSpeed)
+	enemy := stealthTestEnemy(4*32+16, 2*32+16)
+
+	system.Update([]*Entity{player, enemy}, 1)
+	if meter := enemyMeter(t, enemy); meter != 0 {
+		t.Errorf("meter = %v behind a wall, want 0", meter)
+	}
+}
+
+func TestStealthSystem_FullMeterAggroes(t *testing.T) {
+	system := NewStealthSystem()
+	player := stealthTestPlayer(50, 0, StealthReferenceSpeed)
+	enemy := stealthTestEnemy(0, 0)
+	entities := []*Entity{player, enemy}
+	aiComp, _ := enemy.GetComponent("ai")
+	ai := aiComp.(*AIComponent)
+
+	system.Update(entities, 0.1)
+	if ai.State != AIStateIdle || ai.Target != nil {
+		t.Fatalf("enemy aggroed before its meter filled: state %v", ai.State)
+	}
+	for i := 0; i < 20 && ai.State == AIStateIdle; i++ {
+		system.Update(entities, 0.1)
+	}
+	if ai.State != AIStateDetect || ai.Target != player {
+		t.Errorf("enemy with full meter: state %v, target %v", ai.State, ai.Target)
+	}
+
+	// Out of sight the meter drains once the enemy gives up
+	ai.ChangeState(AIStateIdle)
+	ai.ClearTarget()
+	player.GetPosition().X = 1000
+	system.Update(entities, 1)
+	if meter := enemyMeter(t, enemy); meter != 1-DefaultDetectionDecayRate {
+		t.Errorf("meter = %v after 1s out of sight, want %v", meter, 1-DefaultDetectionDecayRate)
+	}
+}
+
+func TestStealthSystem_RecordsPlayerVisibility(t *testing.T) {
+	terr := stealthOpenTerrain(5, 5)
+	system := NewStealthSystem()
+	system.SetTerrain(terr, 32)
+	system.SetLightSampler(fixedLight(0.5))
+	player := stealthTestPlayer(1*32+16, 1*32+16, 0)
+
+	system.Update([]*Entity{player}, 0.1)
+	stealthComp, _ := player.GetComponent("stealth")
+	stealth := stealthComp.(*StealthComponent)
+	if stealth.Cover != 5.0/8 {
+		t.Errorf("cover in room corner = %v, want 5/8", stealth.Cover)
+	}
+	if want := CalculateVisibility(0.5, 0, 5.0/8); stealth.Visibility != want {
+		t.Errorf("visibility = %v, want %v", stealth.Visibility, want)
+	}
+}
+
+func TestCalculateVisibility(t *testing.T) {
+	if got := CalculateVisibility(1, StealthReferenceSpeed*2, 0); got != 1 {
+		t.Errorf("fully exposed visibility = %v, want 1", got)
+	}
+	dark := CalculateVisibility(0, 0, 1)
+	want := StealthMinLightFactor * StealthStillFactor * (1 - StealthMaxCoverReduction)
+	if dark != want {
+		t.Errorf("hidden visibility = %v, want %v", dark, want)
+	}
+}
+
+func TestAISystem_DetectionMeterGatesTargeting(t *testing.T) {
+	world := NewWorld()
+	aiSystem := NewAISystem(world)
+
+	enemy := world.CreateEntity()
+	enemy.AddComponent(NewAIComponent(100, 100))
+	enemy.AddComponent(&PositionComponent{X: 100, Y: 100})
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+	enemy.AddComponent(NewDetectionComponent())
+
+	player := world.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 150, Y: 100})
+	player.AddComponent(&TeamComponent{TeamID: 1})
+	player.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	world.Update(0)
+
+	aiSystem.Update(world.GetEntities(), 0.6)
+	aiComp, _ := enemy.GetComponent("ai")
+	if ai := aiComp.(*AIComponent); ai.State != AIStateIdle || ai.HasTarget() {
+		t.Errorf("enemy with a detection meter acquired a target directly: state %v", ai.State)
+	}
+}
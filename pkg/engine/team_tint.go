@@ -0,0 +1,64 @@
+// Package engine provides team/faction sprite tinting.
+// This file implements TeamTintPalette which maps TeamComponent IDs to team
+// colors. The render system recolors sprites toward their team color with
+// sprites.TintByTeam, so entities sharing the same base sprite are visually
+// distinguishable by team. Tinting is opt-in through SetTeamTintPalette; the
+// client enables it for multiplayer games.
+package engine
+
+import (
+	"image/color"
+)
+
+// defaultTeamColors are assigned to teams 1..N; higher team IDs cycle through them.
+var defaultTeamColors = []color.RGBA{
+	{70, 130, 255, 255},  // Blue
+	{230, 60, 60, 255},   // Red
+	{60, 200, 90, 255},   // Green
+	{240, 200, 50, 255},  // Yellow
+	{170, 80, 230, 255},  // Purple
+	{250, 140, 40, 255},  // Orange
+	{50, 210, 210, 255},  // Cyan
+	{240, 110, 190, 255}, // Pink
+}
+
+// TeamTintPalette maps team IDs to render-time sprite tints.
+// Team 0 (neutral) is never tinted unless explicitly assigned a color.
+type TeamTintPalette struct {
+	tints map[int]color.RGBA
+}
+
+// NewTeamTintPalette creates a palette using the default team colors.
+func NewTeamTintPalette() *TeamTintPalette {
+	return &TeamTintPalette{
+		tints: make(map[int]color.RGBA),
+	}
+}
+
+// SetTeamColor overrides the tint color for a team (e.g. a faction theme color).
+func (p *TeamTintPalette) SetTeamColor(teamID int, col color.RGBA) {
+	p.tints[teamID] = col
+}
+
+// GetTeamColor returns the tint color for a team.
+// Returns false for neutral teams without an explicit color.
+func (p *TeamTintPalette) GetTeamColor(teamID int) (color.RGBA, bool) {
+	if col, ok := p.tints[teamID]; ok {
+		return col, true
+	}
+	if teamID <= 0 {
+		return color.RGBA{}, false
+	}
+	return defaultTeamColors[(teamID-1)%len(defaultTeamColors)], true
+}
+
+// EntityTeamColor returns the tint color for an entity based on its
+// TeamComponent. Returns false for entities without a team or on a neutral
+// team.
+func (p *TeamTintPalette) EntityTeamColor(entity *Entity) (color.RGBA, bool) {
+	teamComp, ok := entity.GetComponent("team")
+	if !ok {
+		return color.RGBA{}, false
+	}
+	return p.GetTeamColor(teamComp.(*TeamComponent).TeamID)
+}
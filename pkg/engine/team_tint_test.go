@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestTeamTintPalette_NeutralUntinted(t *testing.T) {
+	palette := NewTeamTintPalette()
+
+	if _, ok := palette.GetTeamColor(0); ok {
+		t.Error("neutral team should have no color")
+	}
+	if _, ok := palette.EntityTeamColor(NewEntity(1)); ok {
+		t.Error("entity without team should have no color")
+	}
+
+	merchant := NewEntity(2)
+	merchant.AddComponent(&TeamComponent{TeamID: 0})
+	if _, ok := palette.EntityTeamColor(merchant); ok {
+		t.Error("entity on the neutral team should have no color")
+	}
+}
+
+func TestTeamTintPalette_CustomColor(t *testing.T) {
+	palette := NewTeamTintPalette()
+	red := color.RGBA{255, 0, 0, 255}
+	palette.SetTeamColor(3, red)
+
+	if got, ok := palette.GetTeamColor(3); !ok || got != red {
+		t.Errorf("GetTeamColor(3) = %v, %v; want %v", got, ok, red)
+	}
+
+	// Explicit colors also apply to the neutral team
+	palette.SetTeamColor(0, red)
+	if _, ok := palette.GetTeamColor(0); !ok {
+		t.Error("explicit neutral color ignored")
+	}
+}
+
+// drawTeamSprites draws two entities sharing one red sprite, on teams 1
+// and 2, and returns the screen colors at their centers.
+func drawTeamSprites(renderSys *EbitenRenderSystem) (color.Color, color.Color) {
+	base := ebiten.NewImage(16, 16)
+	base.Fill(color.RGBA{200, 40, 40, 255})
+
+	var entities []*Entity
+	for i, x := range []float64{300, 500} {
+		entity := NewEntity(uint64(i + 1))
+		entity.AddComponent(&PositionComponent{X: x, Y: 300})
+		entity.AddComponent(&EbitenSprite{Image: base, Width: 16, Height: 16, Visible: true})
+		entity.AddComponent(&TeamComponent{TeamID: i + 1})
+		entities = append(entities, entity)
+	}
+
+	screen := ebiten.NewImage(800, 600)
+	renderSys.Update(entities, 0)
+	renderSys.Draw(screen, entities)
+	return screen.At(300, 300), screen.At(500, 300)
+}
+
+func teamTintCamera() *CameraSystem {
+	cameraSystem := NewCameraSystem(800, 600)
+	camera := NewEntity(100)
+	camera.AddComponent(&PositionComponent{X: 400, Y: 300})
+	camera.AddComponent(NewCameraComponent())
+	cameraSystem.SetActiveCamera(camera)
+	return cameraSystem
+}
+
+func TestRenderSystem_TeamTintOffByDefault(t *testing.T) {
+	first, second := drawTeamSprites(NewRenderSystem(teamTintCamera()))
+	if first != second {
+		t.Errorf("sprites tinted without a palette: %v and %v", first, second)
+	}
+}
+
+func TestRenderSystem_DrawsTeamsInTheirColors(t *testing.T) {
+	renderSys := NewRenderSystem(teamTintCamera())
+	renderSys.SetTeamTintPalette(NewTeamTintPalette())
+
+	first, second := drawTeamSprites(renderSys)
+	if first == second {
+		t.Fatalf("teams 1 and 2 drew the same color %v", first)
+	}
+	r1, _, b1, _ := first.RGBA()
+	r2, _, b2, _ := second.RGBA()
+	if b1 <= r1 {
+		t.Errorf("team 1 sprite = %v, want it shifted toward blue", first)
+	}
+	if r2 <= b2 {
+		t.Errorf("team 2 sprite = %v, want it to stay red", second)
+	}
+
+	// Disabling the palette draws the base sprite again
+	renderSys.SetTeamTintPalette(nil)
+	if first, second = drawTeamSprites(renderSys); first != second {
+		t.Errorf("sprites tinted after disabling the palette: %v and %v", first, second)
+	}
+}
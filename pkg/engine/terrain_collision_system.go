@@ -160,15 +160,88 @@ func (t *TerrainCollisionChecker) CheckEntityCollision(entity *Entity) bool {
 	pos := posComp.(*PositionComponent)
 	collider := colliderComp.(*ColliderComponent)
 
-	// Get entity's layer (default to ground layer if no layer component)
-	layer := 0
-	if entity.HasComponent("layer") {
-		layerComp, _ := entity.GetComponent("layer")
-		layerComponent := layerComp.(*LayerComponent)
-		layer = layerComponent.GetEffectiveLayer()
+	return t.CheckCollisionWithLayer(pos.X, pos.Y, collider.Width, collider.Height, entityTerrainLayer(entity))
+}
+
+// entityTerrainLayer returns the terrain layer an entity collides on
+// (the ground layer if it has no layer component).
+func entityTerrainLayer(entity *Entity) int {
+	if layerComp, ok := entity.GetComponent("layer"); ok {
+		return layerComp.(*LayerComponent).GetEffectiveLayer()
+	}
+	return 0
+}
+
+// ContactNormal returns the unit surface normal, pointing out of the walls,
+// of the terrain a bounding box overlaps on the given layer. Diagonal walls
+// contribute their slanted face; square walls the face of least
+// penetration. Returns false if nothing is overlapped.
+func (t *TerrainCollisionChecker) ContactNormal(minX, minY, maxX, maxY float64, layer int) (nx, ny float64, ok bool) {
+	if t.terrain == nil {
+		return 0, 0, false
 	}
 
-	return t.CheckCollisionWithLayer(pos.X, pos.Y, collider.Width, collider.Height, layer)
+	centerX, centerY := (minX+maxX)/2, (minY+maxY)/2
+	minTileX, minTileY := t.worldToTileCoords(minX, minY)
+	maxTileX, maxTileY := t.worldToTileCoords(maxX, maxY)
+	for y := minTileY; y <= maxTileY; y++ {
+		for x := minTileX; x <= maxTileX; x++ {
+			tile := t.terrain.GetTile(x, y)
+			if !t.tileMatchesLayer(tile, layer) {
+				continue
+			}
+
+			if tile.IsDiagonalWall() {
+				if !t.checkDiagonalWallCollision(x, y, tile, minX, minY, maxX, maxY) {
+					continue
+				}
+				dx, dy := diagonalWallNormal(tile)
+				nx += dx
+				ny += dy
+				continue
+			}
+
+			// Square tile: push out across the face of least penetration
+			tileMinX := float64(x * t.tileWidth)
+			tileMinY := float64(y * t.tileHeight)
+			tileMaxX := tileMinX + float64(t.tileWidth)
+			tileMaxY := tileMinY + float64(t.tileHeight)
+			overlapX := math.Min(maxX, tileMaxX) - math.Max(minX, tileMinX)
+			overlapY := math.Min(maxY, tileMaxY) - math.Max(minY, tileMinY)
+			if overlapX <= 0 || overlapY <= 0 {
+				continue
+			}
+			if overlapX < overlapY {
+				nx += math.Copysign(1, centerX-(tileMinX+tileMaxX)/2)
+			} else {
+				ny += math.Copysign(1, centerY-(tileMinY+tileMaxY)/2)
+			}
+		}
+	}
+
+	length := math.Hypot(nx, ny)
+	if length == 0 {
+		return 0, 0, false
+	}
+	return nx / length, ny / length, true
+}
+
+// diagonalWallNormal returns the unit normal of a diagonal wall's slanted
+// face, pointing into the open half of the tile.
+func diagonalWallNormal(tile terrain.TileType) (nx, ny float64) {
+	const d = math.Sqrt2 / 2
+	switch tile {
+	case terrain.TileWallNE: // Solid lower-right half
+		return -d, -d
+	case terrain.TileWallNW: // Solid lower-left half
+		return d, -d
+	case terrain.TileWallSE: // Solid upper-right half
+		return -d, d
+	case terrain.TileWallSW: // Solid upper-left half
+		return d, d
+	default:
+		return 0, 0
+	}
 }
 
 // CheckCollisionWithLayer checks if a world position collides with terrain
@@ -180,6 +180,8 @@ func (t *TerrainRenderSystem) drawFallbackTile(screen *ebiten.Image, camera *Cam
 			r, g, b = 200, 200, 120 // Brighter gold for treasure
 		case terrain.RoomTrap:
 			r, g, b = 180, 120, 180 // Brighter purple for traps
+		case terrain.RoomShrine:
+			r, g, b = 120, 190, 190 // Brighter teal for shrines
 		default:
 			r, g, b = 150, 150, 150 // Brighter gray for normal floors
 		}
@@ -0,0 +1,386 @@
+// Package engine provides the carry and throw system.
+// This file implements ThrowSystem, which lets carrier entities pick up
+// carryable objects (barrels, crates, puzzle blocks) and throw them in an
+// arc that breaks against walls or damages the first entity it hits.
+package engine
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/opd-ai/venture/pkg/combat"
+)
+
+const (
+	// ThrowGravity pulls thrown objects back to the ground (pixels/second²)
+	ThrowGravity = 400.0
+
+	// ThrowHitRadius is the distance at which a thrown object hits an entity
+	ThrowHitRadius = 16.0
+
+	// CarryHeight is how far above the ground carried objects are held
+	CarryHeight = 16.0
+
+	// thrownObjectSize is the bounding box used for wall collision
+	thrownObjectSize = 8.0
+)
+
+// ThrowSystem moves carried objects with their carriers and simulates
+// thrown objects until they land or hit something.
+type ThrowSystem struct {
+	world *World
+	// Terrain collision checker for wall impacts (optional)
+	terrainChecker *TerrainCollisionChecker
+	// Camera for screen shake on impact (optional)
+	camera *CameraSystem
+}
+
+// NewThrowSystem creates a new throw system.
+func NewThrowSystem(w *World) *ThrowSystem {
+	return &ThrowSystem{world: w}
+}
+
+// SetTerrainChecker assigns a terrain collision checker for wall impacts.
+func (s *ThrowSystem) SetTerrainChecker(checker *TerrainCollisionChecker) {
+	s.terrainChecker = checker
+}
+
+// SetCamera sets the camera reference for screen shake on impact.
+func (s *ThrowSystem) SetCamera(camera *CameraSystem) {
+	s.camera = camera
+}
+
+// PickUp makes the carrier hold the object. The object must be carryable,
+// not already carried or in flight, and within the carrier's reach.
+func (s *ThrowSystem) PickUp(carrier, object *Entity) error {
+	holder, ok := carrierOf(carrier)
+	if !ok {
+		return fmt.Errorf("entity %d cannot carry objects", carrier.ID)
+	}
+	if holder.Holding {
+		return fmt.Errorf("entity %d is already carrying entity %d", carrier.ID, holder.HeldID)
+	}
+
+	carryable, ok := carryableOf(object)
+	if !ok {
+		return fmt.Errorf("entity %d is not carryable", object.ID)
+	}
+	if carryable.Carried || object.HasComponent("thrown") {
+		return fmt.Errorf("entity %d is not available to pick up", object.ID)
+	}
+
+	carrierPos := carrier.GetPosition()
+	objectPos := object.GetPosition()
+	if carrierPos == nil || objectPos == nil {
+		return fmt.Errorf("carrier and object must have positions")
+	}
+	dx := objectPos.X - carrierPos.X
+	dy := objectPos.Y - carrierPos.Y
+	if dx*dx+dy*dy > holder.CarryRange*holder.CarryRange {
+		return fmt.Errorf("entity %d is out of reach", object.ID)
+	}
+
+	holder.Holding = true
+	holder.HeldID = object.ID
+	carryable.Carried = true
+	carryable.CarrierID = carrier.ID
+	carryable.wasSolid = setColliderSolid(object, false)
+
+	objectPos.X, objectPos.Y = carrierPos.X, carrierPos.Y
+	if vel := object.GetVelocity(); vel != nil {
+		vel.VX, vel.VY = 0, 0
+	}
+	return nil
+}
+
+// Throw launches the carried object in the given direction and returns it.
+// The direction does not need to be normalized.
+func (s *ThrowSystem) Throw(carrier *Entity, dirX, dirY float64) (*Entity, error) {
+	object, holder, carryable, err := s.heldObject(carrier)
+	if err != nil {
+		return nil, err
+	}
+
+	length := math.Sqrt(dirX*dirX + dirY*dirY)
+	if length == 0 {
+		return nil, fmt.Errorf("throw direction cannot be zero")
+	}
+
+	var throwerTeam int
+	if team, ok := carrier.GetComponent("team"); ok {
+		throwerTeam = team.(*TeamComponent).TeamID
+	}
+
+	holder.Holding = false
+	carryable.Carried = false
+	object.AddComponent(&ThrownComponent{
+		ThrowerID:   carrier.ID,
+		ThrowerTeam: throwerTeam,
+		VX:          dirX / length * holder.ThrowSpeed,
+		VY:          dirY / length * holder.ThrowSpeed,
+		Height:      CarryHeight,
+		VZ:          holder.ThrowArc,
+		Damage:      carryable.ImpactDamage,
+	})
+	return object, nil
+}
+
+// Drop sets the carried object down at the carrier's feet.
+func (s *ThrowSystem) Drop(carrier *Entity) error {
+	object, holder, carryable, err := s.heldObject(carrier)
+	if err != nil {
+		return err
+	}
+
+	holder.Holding = false
+	carryable.Carried = false
+	setColliderSolid(object, carryable.wasSolid)
+	return nil
+}
+
+// PickUpOrThrow is the carry action of a player: a carrier holding an
+// object throws it in its aim direction, and an empty-handed carrier picks
+// up the closest carryable object within reach. Returns the entity picked
+// up or thrown.
+func (s *ThrowSystem) PickUpOrThrow(carrier *Entity) (*Entity, error) {
+	holder, ok := carrierOf(carrier)
+	if !ok {
+		return nil, fmt.Errorf("entity %d cannot carry objects", carrier.ID)
+	}
+
+	if holder.Holding {
+		dirX, dirY := 1.0, 0.0
+		if aimComp, ok := carrier.GetComponent("aim"); ok {
+			dirX, dirY = aimComp.(*AimComponent).GetAimDirection()
+		}
+		return s.Throw(carrier, dirX, dirY)
+	}
+
+	pos := carrier.GetPosition()
+	if pos == nil {
+		return nil, fmt.Errorf("entity %d has no position", carrier.ID)
+	}
+	object := FindClosestCarryable(s.world, pos.X, pos.Y, holder.CarryRange)
+	if object == nil {
+		return nil, fmt.Errorf("nothing to pick up within reach of entity %d", carrier.ID)
+	}
+	if err := s.PickUp(carrier, object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// FindClosestCarryable returns the closest carryable object within radius
+// of (x, y) that is neither carried nor in flight, or nil.
+func FindClosestCarryable(world *World, x, y, radius float64) *Entity {
+	var closest *Entity
+	minDistSq := radius * radius
+	for _, entity := range world.GetEntities() {
+		carryable, ok := carryableOf(entity)
+		if !ok || carryable.Carried || entity.HasComponent("thrown") {
+			continue
+		}
+		pos := entity.GetPosition()
+		if pos == nil {
+			continue
+		}
+		dx, dy := pos.X-x, pos.Y-y
+		if distSq := dx*dx + dy*dy; distSq <= minDistSq {
+			minDistSq = distSq
+			closest = entity
+		}
+	}
+	return closest
+}
+
+// Elevation returns how far above the ground an entity is drawn: the
+// current height of a thrown object, CarryHeight for a carried one, and 0
+// for everything else.
+func Elevation(entity *Entity) float64 {
+	if comp, ok := entity.GetComponent("thrown"); ok {
+		return math.Max(comp.(*ThrownComponent).Height, 0)
+	}
+	if carryable, ok := carryableOf(entity); ok && carryable.Carried {
+		return CarryHeight
+	}
+	return 0
+}
+
+// heldObject returns the object a carrier is holding along with its components.
+func (s *ThrowSystem) heldObject(carrier *Entity) (*Entity, *CarrierComponent, *CarryableComponent, error) {
+	holder, ok := carrierOf(carrier)
+	if !ok || !holder.Holding {
+		return nil, nil, nil, fmt.Errorf("entity %d is not carrying anything", carrier.ID)
+	}
+	object, ok := s.world.GetEntity(holder.HeldID)
+	if !ok {
+		holder.Holding = false
+		return nil, nil, nil, fmt.Errorf("carried entity %d no longer exists", holder.HeldID)
+	}
+	carryable, ok := carryableOf(object)
+	if !ok {
+		holder.Holding = false
+		return nil, nil, nil, fmt.Errorf("carried entity %d is not carryable", object.ID)
+	}
+	return object, holder, carryable, nil
+}
+
+// Update moves carried objects with their carriers and advances thrown objects.
+func (s *ThrowSystem) Update(entities []*Entity, deltaTime float64) {
+	if s.world == nil {
+		return
+	}
+
+	for _, entity := range entities {
+		if comp, ok := entity.GetComponent("thrown"); ok {
+			s.updateThrown(entity, comp.(*ThrownComponent), entities, deltaTime)
+			continue
+		}
+		if carryable, ok := carryableOf(entity); ok && carryable.Carried {
+			s.followCarrier(entity, carryable)
+		}
+	}
+}
+
+// followCarrier keeps a carried object at its carrier's position, dropping
+// it if the carrier is gone.
+func (s *ThrowSystem) followCarrier(object *Entity, carryable *CarryableComponent) {
+	carrier, ok := s.world.GetEntity(carryable.CarrierID)
+	if !ok || carrier.GetPosition() == nil {
+		carryable.Carried = false
+		setColliderSolid(object, carryable.wasSolid)
+		return
+	}
+	if pos := object.GetPosition(); pos != nil {
+		pos.X, pos.Y = carrier.GetPosition().X, carrier.GetPosition().Y
+	}
+}
+
+// updateThrown advances a thrown object along its arc and resolves impacts.
+func (s *ThrowSystem) updateThrown(object *Entity, thrown *ThrownComponent, entities []*Entity, deltaTime float64) {
+	pos := object.GetPosition()
+	if pos == nil {
+		object.RemoveComponent("thrown")
+		return
+	}
+
+	oldX, oldY := pos.X, pos.Y
+	pos.X += thrown.VX * deltaTime
+	pos.Y += thrown.VY * deltaTime
+	thrown.VZ -= ThrowGravity * deltaTime
+	thrown.Height += thrown.VZ * deltaTime
+
+	// Walls stop the object where it was before entering them
+	if s.terrainChecker != nil && s.terrainChecker.CheckCollision(pos.X, pos.Y, thrownObjectSize, thrownObjectSize) {
+		pos.X, pos.Y = oldX, oldY
+		s.impact(object, nil, thrown)
+		return
+	}
+
+	if target := s.findTarget(object, thrown, pos, entities); target != nil {
+		s.impact(object, target, thrown)
+		return
+	}
+
+	if thrown.Height <= 0 {
+		s.land(object)
+	}
+}
+
+// findTarget returns the first damageable entity within hit range.
+// Entities on the thrower's team (or any team, for a thrower without one)
+// are passed over, matching FindEnemiesInRange.
+func (s *ThrowSystem) findTarget(object *Entity, thrown *ThrownComponent, pos *PositionComponent, entities []*Entity) *Entity {
+	for _, entity := range entities {
+		if entity.ID == object.ID || entity.ID == thrown.ThrowerID || entity.HasComponent("dead") {
+			continue
+		}
+
+		// Check team
+		if team, hasTeam := entity.GetComponent("team"); hasTeam {
+			if !team.(*TeamComponent).IsEnemy(thrown.ThrowerTeam) {
+				continue
+			}
+		}
+
+		if !entity.HasComponent("health") {
+			continue
+		}
+		targetPos := entity.GetPosition()
+		if targetPos == nil {
+			continue
+		}
+		dx := pos.X - targetPos.X
+		dy := pos.Y - targetPos.Y
+		if dx*dx+dy*dy <= ThrowHitRadius*ThrowHitRadius {
+			return entity
+		}
+	}
+	return nil
+}
+
+// impact applies damage to the target (if any), then breaks or drops the object.
+func (s *ThrowSystem) impact(object, target *Entity, thrown *ThrownComponent) {
+	if target != nil {
+		if comp, ok := target.GetComponent("health"); ok {
+			health := comp.(*HealthComponent)
+			health.TakeTypedDamage(thrown.Damage, combat.DamagePhysical)
+
+			if s.camera != nil {
+				intensity := CalculateShakeIntensity(thrown.Damage, health.Max,
+					ProjectileShakeScaleFactor, ProjectileShakeMinIntensity, ProjectileShakeMaxIntensity)
+				duration := CalculateShakeDuration(intensity,
+					ProjectileShakeBaseDuration, ProjectileShakeAdditionalDuration, ProjectileShakeMaxIntensity)
+				s.camera.ShakeAdvanced(intensity, duration)
+			}
+		}
+	}
+
+	if carryable, ok := carryableOf(object); ok && carryable.Breakable {
+		object.RemoveComponent("thrown")
+		s.world.RemoveEntity(object.ID)
+		return
+	}
+	s.land(object)
+}
+
+// land ends an object's flight and makes it solid and carryable again.
+func (s *ThrowSystem) land(object *Entity) {
+	object.RemoveComponent("thrown")
+	if carryable, ok := carryableOf(object); ok {
+		setColliderSolid(object, carryable.wasSolid)
+	}
+}
+
+// carrierOf returns the entity's carrier component.
+func carrierOf(entity *Entity) (*CarrierComponent, bool) {
+	comp, ok := entity.GetComponent("carrier")
+	if !ok {
+		return nil, false
+	}
+	carrier, ok := comp.(*CarrierComponent)
+	return carrier, ok
+}
+
+// carryableOf returns the entity's carryable component.
+func carryableOf(entity *Entity) (*CarryableComponent, bool) {
+	comp, ok := entity.GetComponent("carryable")
+	if !ok {
+		return nil, false
+	}
+	carryable, ok := comp.(*CarryableComponent)
+	return carryable, ok
+}
+
+// setColliderSolid sets the entity's collider solidity and returns the
+// previous value (false if the entity has no collider).
+func setColliderSolid(entity *Entity, solid bool) bool {
+	comp, ok := entity.GetComponent("collider")
+	if !ok {
+		return false
+	}
+	collider := comp.(*ColliderComponent)
+	previous := collider.Solid
+	collider.Solid = solid
+	return previous
+}
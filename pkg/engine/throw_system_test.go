@@ -0,0 +1,318 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+// throwTestWorld creates a world with a carrier at (48,48) holding a barrel.
+func throwTestWorld(t *testing.T, breakable bool) (*World, *ThrowSystem, *Entity, *Entity) {
+	t.Helper()
+	w := NewWorld()
+	sys := NewThrowSystem(w)
+
+	carrier := w.CreateEntity()
+	carrier.AddComponent(&PositionComponent{X: 48, Y: 40})
+	carrier.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	carrier.AddComponent(NewCarrierComponent())
+
+	barrel := w.CreateEntity()
+	barrel.AddComponent(&PositionComponent{X: 60, Y: 40})
+	barrel.AddComponent(&ColliderComponent{Width: 16, Height: 16, Solid: true})
+	barrel.AddComponent(NewCarryableComponent(25, breakable))
+	w.Update(0)
+
+	if err := sys.PickUp(carrier, barrel); err != nil {
+		t.Fatalf("PickUp failed: %v", err)
+	}
+	return w, sys, carrier, barrel
+}
+
+// stepThrow runs the system until the object stops flying or maxSteps pass.
+func stepThrow(w *World, sys *ThrowSystem, object *Entity, maxSteps int, onStep func()) {
+	for i := 0; i < maxSteps && object.HasComponent("thrown"); i++ {
+		sys.Update(w.GetEntities(), 1.0/60.0)
+		if onStep != nil {
+			onStep()
+		}
+	}
+	w.Update(0)
+}
+
+func TestThrowSystem_PickUp(t *testing.T) {
+	w, sys, carrier, barrel := throwTestWorld(t, false)
+
+	holder, _ := carrierOf(carrier)
+	if !holder.Holding || holder.HeldID != barrel.ID {
+		t.Fatal("carrier should be holding the barrel")
+	}
+	if collider, _ := barrel.GetComponent("collider"); collider.(*ColliderComponent).Solid {
+		t.Error("carried object should not be solid")
+	}
+
+	// Carried object follows the carrier
+	carrier.GetPosition().X = 100
+	sys.Update(w.GetEntities(), 0.016)
+	if barrel.GetPosition().X != 100 {
+		t.Errorf("carried object X = %f, want 100", barrel.GetPosition().X)
+	}
+
+	// Cannot pick up a second object or something out of reach
+	crate := w.CreateEntity()
+	crate.AddComponent(&PositionComponent{X: 500, Y: 500})
+	crate.AddComponent(NewCarryableComponent(10, false))
+	if err := sys.PickUp(carrier, crate); err == nil {
+		t.Error("expected error picking up while holding")
+	}
+	if err := sys.Drop(carrier); err != nil {
+		t.Fatalf("Drop failed: %v", err)
+	}
+	if collider, _ := barrel.GetComponent("collider"); !collider.(*ColliderComponent).Solid {
+		t.Error("dropped object should be solid again")
+	}
+	if err := sys.PickUp(carrier, crate); err == nil {
+		t.Error("expected error picking up out-of-reach object")
+	}
+}
+
+func TestThrowSystem_ThrowFollowsArc(t *testing.T) {
+	w, sys, carrier, barrel := throwTestWorld(t, false)
+
+	if _, err := sys.Throw(carrier, 0, 0); err == nil {
+		t.Error("expected error for zero direction")
+	}
+	if _, err := sys.Throw(carrier, 3, 0); err != nil {
+		t.Fatalf("Throw failed: %v", err)
+	}
+
+	thrown := barrel.Components["thrown"].(*ThrownComponent)
+	peak := thrown.Height
+	rising := true
+	steps := 0
+	stepThrow(w, sys, barrel, 600, func() {
+		steps++
+		if barrel.GetPosition().Y != 40 {
+			t.Fatalf("object drifted off its line: Y = %f", barrel.GetPosition().Y)
+		}
+		if !barrel.HasComponent("thrown") {
+			return
+		}
+		if thrown.Height > peak {
+			if !rising {
+				t.Fatal("object rose again after starting to fall")
+			}
+			peak = thrown.Height
+		} else {
+			rising = false
+		}
+	})
+
+	if peak <= CarryHeight {
+		t.Errorf("peak height %f should exceed release height %f", peak, CarryHeight)
+	}
+	if barrel.HasComponent("thrown") {
+		t.Fatal("object should have landed")
+	}
+	if distance := barrel.GetPosition().X - 48; distance < 100 {
+		t.Errorf("object only traveled %f pixels", distance)
+	}
+	if collider, _ := barrel.GetComponent("collider"); !collider.(*ColliderComponent).Solid {
+		t.Error("landed object should be solid again")
+	}
+	if _, ok := w.GetEntity(barrel.ID); !ok {
+		t.Error("object should survive landing on the floor")
+	}
+}
+
+func TestThrowSystem_HitsEnemy(t *testing.T) {
+	w, sys, carrier, barrel := throwTestWorld(t, true)
+
+	enemy := w.CreateEntity()
+	enemy.AddComponent(&PositionComponent{X: 130, Y: 40})
+	enemy.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	w.Update(0)
+
+	if _, err := sys.Throw(carrier, 1, 0); err != nil {
+		t.Fatalf("Throw failed: %v", err)
+	}
+	stepThrow(w, sys, barrel, 600, nil)
+
+	if hp := enemy.Components["health"].(*HealthComponent).Current; hp != 75 {
+		t.Errorf("enemy health = %f, want 75", hp)
+	}
+	if hp := carrier.Components["health"].(*HealthComponent).Current; hp != 100 {
+		t.Errorf("thrower should not be hit, health = %f", hp)
+	}
+	if _, ok := w.GetEntity(barrel.ID); ok {
+		t.Error("breakable object should break on impact")
+	}
+}
+
+func TestThrowSystem_PassesOverAllies(t *testing.T) {
+	w, sys, carrier, barrel := throwTestWorld(t, true)
+	carrier.AddComponent(&TeamComponent{TeamID: 1})
+
+	// An ally stands between the thrower and the enemy
+	ally := w.CreateEntity()
+	ally.AddComponent(&PositionComponent{X: 100, Y: 40})
+	ally.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	ally.AddComponent(&TeamComponent{TeamID: 1})
+
+	enemy := w.CreateEntity()
+	enemy.AddComponent(&PositionComponent{X: 150, Y: 40})
+	enemy.AddComponent(&HealthComponent{Current: 100, Max: 100})
+	enemy.AddComponent(&TeamComponent{TeamID: 2})
+	w.Update(0)
+
+	if _, err := sys.Throw(carrier, 1, 0); err != nil {
+		t.Fatalf("Throw failed: %v", err)
+	}
+	stepThrow(w, sys, barrel, 600, nil)
+
+	if hp := ally.Components["health"].(*HealthComponent).Current; hp != 100 {
+		t.Errorf("ally should not be hit, health = %f", hp)
+	}
+	if hp := enemy.Components["health"].(*HealthComponent).Current; hp != 75 {
+		t.Errorf("enemy health = %f, want 75", hp)
+	}
+}
+
+func TestThrowSystem_WallImpact(t *testing.T) {
+	tests := []struct {
+		name      string
+		breakable bool
+	}{
+		{"breaks", true},
+		{"drops", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, sys, carrier, barrel := throwTestWorld(t, tt.breakable)
+
+			// Floor corridor with a wall at tile column 4 (x=128..160)
+			terr := terrain.NewTerrain(10, 3, 1)
+			for x := 0; x < 10; x++ {
+				terr.SetTile(x, 1, terrain.TileFloor)
+			}
+			terr.SetTile(4, 1, terrain.TileWall)
+			checker := NewTerrainCollisionChecker(32, 32)
+			checker.SetTerrain(terr)
+			sys.SetTerrainChecker(checker)
+
+			if _, err := sys.Throw(carrier, 1, 0); err != nil {
+				t.Fatalf("Throw failed: %v", err)
+			}
+			stepThrow(w, sys, barrel, 600, nil)
+
+			_, exists := w.GetEntity(barrel.ID)
+			if tt.breakable && exists {
+				t.Error("breakable object should break against the wall")
+			}
+			if !tt.breakable {
+				if !exists || barrel.HasComponent("thrown") {
+					t.Fatal("object should drop at the wall")
+				}
+				if x := barrel.GetPosition().X; x+thrownObjectSize/2 > 128 {
+					t.Errorf("object ended inside the wall at X = %f", x)
+				}
+			}
+		})
+	}
+}
+
+func TestThrowSystem_PickUpOrThrow(t *testing.T) {
+	w := NewWorld()
+	sys := NewThrowSystem(w)
+
+	player := w.CreateEntity()
+	player.AddComponent(&PositionComponent{X: 100, Y: 100})
+	player.AddComponent(NewCarrierComponent())
+	player.AddComponent(NewAimComponent(math.Pi / 2)) // Aiming down
+
+	far := SpawnBarrel(w, 100, 120)
+	near := SpawnBarrel(w, 110, 100)
+	w.Update(0)
+
+	if Elevation(near) != 0 {
+		t.Errorf("barrel on the ground has elevation %f", Elevation(near))
+	}
+
+	picked, err := sys.PickUpOrThrow(player)
+	if err != nil {
+		t.Fatalf("pick up failed: %v", err)
+	}
+	if picked != near {
+		t.Fatalf("picked up entity %d, want the closest barrel %d", picked.ID, near.ID)
+	}
+	if Elevation(near) != CarryHeight {
+		t.Errorf("carried barrel elevation = %f, want %f", Elevation(near), CarryHeight)
+	}
+
+	thrown, err := sys.PickUpOrThrow(player)
+	if err != nil || thrown != near {
+		t.Fatalf("throw = %v, %v; want the held barrel", thrown, err)
+	}
+	comp, _ := near.GetComponent("thrown")
+	if vel := comp.(*ThrownComponent); math.Abs(vel.VX) > 1e-9 || vel.VY <= 0 {
+		t.Errorf("throw velocity = (%f, %f), want along the aim direction", vel.VX, vel.VY)
+	}
+	if Elevation(near) != CarryHeight {
+		t.Errorf("thrown barrel elevation = %f, want launch height %f", Elevation(near), CarryHeight)
+	}
+
+	// The far barrel is out of reach
+	player.GetPosition().Y = 40
+	if _, err := sys.PickUpOrThrow(player); err == nil {
+		t.Errorf("expected error with barrel %d out of reach", far.ID)
+	}
+}
+
+func TestSpawnBarrelsInTerrain(t *testing.T) {
+	terr := terrain.NewTerrain(40, 40, 1)
+	for i := 0; i < 8; i++ {
+		room := &terrain.Room{X: 1 + (i%4)*9, Y: 1 + (i/4)*9, Width: 6, Height: 6, Type: terrain.RoomNormal}
+		for y := room.Y; y < room.Y+room.Height; y++ {
+			for x := room.X; x < room.X+room.Width; x++ {
+				terr.SetTile(x, y, terrain.TileFloor)
+			}
+		}
+		terr.Rooms = append(terr.Rooms, room)
+	}
+	terr.Rooms[0].Type = terrain.RoomSpawn
+
+	spawn := func() []*Entity {
+		w := NewWorld()
+		SpawnBarrelsInTerrain(w, terr, 32, 7)
+		w.Update(0)
+		var barrels []*Entity
+		for _, e := range w.GetEntities() {
+			if e.HasComponent("carryable") {
+				barrels = append(barrels, e)
+			}
+		}
+		return barrels
+	}
+
+	barrels := spawn()
+	if len(barrels) == 0 {
+		t.Fatal("no barrels spawned")
+	}
+	for _, barrel := range barrels {
+		pos := barrel.GetPosition()
+		tx, ty := int(pos.X)/32, int(pos.Y)/32
+		if !terr.IsWalkable(tx, ty) {
+			t.Errorf("barrel on unwalkable tile (%d, %d)", tx, ty)
+		}
+		spawnRoom := terr.Rooms[0]
+		if tx >= spawnRoom.X && tx < spawnRoom.X+spawnRoom.Width && ty >= spawnRoom.Y && ty < spawnRoom.Y+spawnRoom.Height {
+			t.Error("barrel placed in the spawn room")
+		}
+	}
+
+	again := spawn()
+	if len(again) != len(barrels) {
+		t.Fatalf("same seed spawned %d barrels, then %d", len(barrels), len(again))
+	}
+}
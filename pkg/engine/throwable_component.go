@@ -0,0 +1,95 @@
+package engine
+
+// CarryableComponent marks an entity that can be picked up and thrown,
+// such as barrels, crates, and puzzle blocks.
+type CarryableComponent struct {
+	// ImpactDamage is dealt to an entity hit by the object in flight
+	ImpactDamage float64
+
+	// Breakable objects are destroyed when they hit a wall or entity;
+	// others drop to the floor at the point of impact
+	Breakable bool
+
+	// Carried is true while another entity holds this object
+	Carried bool
+
+	// CarrierID is the entity holding this object (valid when Carried)
+	CarrierID uint64
+
+	// wasSolid remembers the collider state while carried or thrown
+	wasSolid bool
+}
+
+// Type returns the component type identifier.
+func (c *CarryableComponent) Type() string {
+	return "carryable"
+}
+
+// NewCarryableComponent creates a carryable object with the given impact damage.
+func NewCarryableComponent(impactDamage float64, breakable bool) *CarryableComponent {
+	return &CarryableComponent{
+		ImpactDamage: impactDamage,
+		Breakable:    breakable,
+	}
+}
+
+// CarrierComponent lets an entity pick up and throw carryable objects.
+type CarrierComponent struct {
+	// CarryRange is the maximum pickup distance in pixels
+	CarryRange float64
+
+	// ThrowSpeed is the horizontal speed of thrown objects (pixels/second)
+	ThrowSpeed float64
+
+	// ThrowArc is the initial upward speed of thrown objects (pixels/second)
+	ThrowArc float64
+
+	// Holding is true while the carrier has an object
+	Holding bool
+
+	// HeldID is the entity being carried (valid when Holding)
+	HeldID uint64
+}
+
+// Type returns the component type identifier.
+func (c *CarrierComponent) Type() string {
+	return "carrier"
+}
+
+// NewCarrierComponent creates a carrier with standard reach and throw strength.
+func NewCarrierComponent() *CarrierComponent {
+	return &CarrierComponent{
+		CarryRange: 32.0,
+		ThrowSpeed: 250.0,
+		ThrowArc:   120.0,
+	}
+}
+
+// ThrownComponent tracks an object in flight. The arc is simulated as a
+// height above the ground, so the top-down position moves in a straight
+// line while Height rises and falls under gravity.
+type ThrownComponent struct {
+	// ThrowerID is the entity that threw the object (never hit by it)
+	ThrowerID uint64
+
+	// ThrowerTeam is the thrower's team ID (0 when it has no team); the
+	// object only hits entities on an enemy team or without a team
+	ThrowerTeam int
+
+	// VX and VY are the horizontal velocity in pixels per second
+	VX, VY float64
+
+	// Height above the ground in pixels
+	Height float64
+
+	// VZ is the vertical velocity in pixels per second (positive is up)
+	VZ float64
+
+	// Damage is dealt to the first entity hit
+	Damage float64
+}
+
+// Type returns the component type identifier.
+func (t *ThrownComponent) Type() string {
+	return "thrown"
+}
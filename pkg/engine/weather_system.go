@@ -146,9 +146,10 @@ func (ws *WeatherSystem) GetWeatherParticles() []WeatherParticleData {
 			continue
 		}
 
-		// Get particles from weather system
-		for i := range weather.System.Particles {
-			p := &weather.System.Particles[i]
+		// Get falling and settled particles from weather system
+		particles := weather.System.AllParticles()
+		for i := range particles {
+			p := &particles[i]
 
 			// Viewport culling for performance
 			if !ws.isInViewport(p.X, p.Y) {
@@ -0,0 +1,159 @@
+// Package engine provides spawning of generated world plans.
+// This file turns the spawn points of a world generation pipeline into
+// entities, so every process that runs the same pipeline with the same
+// seed builds the same world.
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/entity"
+	"github.com/opd-ai/venture/pkg/procgen/item"
+	"github.com/opd-ai/venture/pkg/procgen/worldgen"
+)
+
+// WorldPlanCounts is how many entities of each kind SpawnWorldPlan created.
+type WorldPlanCounts struct {
+	Enemies     int
+	Items       int
+	Keys        int
+	LockedDoors int
+}
+
+// SpawnWorldPlan spawns the gameplay content of a generated world at its
+// planned spawn points: enemies, items, keys and locked doors. Lights and
+// weather only affect presentation and are left to the client. Enemy
+// counts, stats and elites follow the difficulty settings; each room's
+// planned enemy points are reused when the difficulty adds enemies.
+func SpawnWorldPlan(world *World, plan *worldgen.World, tileSize int, difficulty DifficultySettings) (WorldPlanCounts, error) {
+	var counts WorldPlanCounts
+	if plan == nil || plan.Terrain == nil {
+		return counts, fmt.Errorf("world plan has no terrain")
+	}
+
+	// Spawn points are tiles; entities stand on tile centers
+	tileCenter := func(spawn worldgen.Spawn) (float64, float64) {
+		return (float64(spawn.X) + 0.5) * float64(tileSize), (float64(spawn.Y) + 0.5) * float64(tileSize)
+	}
+
+	enemies, err := spawnPlannedEnemies(world, plan, tileCenter, difficulty)
+	if err != nil {
+		return counts, err
+	}
+	counts.Enemies = enemies
+
+	items, err := spawnPlannedItems(world, plan, tileCenter, difficulty)
+	if err != nil {
+		return counts, err
+	}
+	counts.Items = items
+
+	for _, spawn := range plan.SpawnsOfKind(worldgen.SpawnKey) {
+		x, y := tileCenter(spawn)
+		SpawnKey(world, spawn.Tag, x, y)
+		counts.Keys++
+	}
+	for _, spawn := range plan.SpawnsOfKind(worldgen.SpawnLockedDoor) {
+		x, y := tileCenter(spawn)
+		SpawnLockedDoor(world, spawn.Tag, x, y, float64(tileSize))
+		counts.LockedDoors++
+	}
+
+	return counts, nil
+}
+
+// spawnPlannedEnemies spawns the plan's enemies room by room, scaling each
+// room's count by the difficulty's spawn budget.
+func spawnPlannedEnemies(world *World, plan *worldgen.World, tileCenter func(worldgen.Spawn) (float64, float64), difficulty DifficultySettings) (int, error) {
+	eliteConfig := difficulty.EliteConfig(DefaultEliteAffixConfig())
+	if err := eliteConfig.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid elite config: %w", err)
+	}
+
+	// Group enemy points by room, keeping plan order
+	var rooms []int
+	points := make(map[int][]worldgen.Spawn)
+	for _, spawn := range plan.SpawnsOfKind(worldgen.SpawnEnemy) {
+		if _, seen := points[spawn.RoomIndex]; !seen {
+			rooms = append(rooms, spawn.RoomIndex)
+		}
+		points[spawn.RoomIndex] = append(points[spawn.RoomIndex], spawn)
+	}
+
+	total := 0
+	roomCounts := make([]int, len(rooms))
+	for i, room := range rooms {
+		roomCounts[i] = difficulty.SpawnCount(len(points[room]))
+		total += roomCounts[i]
+	}
+	if total == 0 {
+		return 0, nil
+	}
+
+	params := difficulty.GenerationParams(plan.Params)
+	params.Custom = map[string]interface{}{"count": total}
+	result, err := entity.NewEntityGenerator().Generate(plan.Seed+1000, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate entities: %w", err)
+	}
+	generated := result.([]*entity.Entity)
+
+	// Extra enemies on a reused point are offset so they do not overlap;
+	// elites roll from their own stream
+	rng := rand.New(rand.NewSource(plan.Seed))
+	eliteRng := rand.New(rand.NewSource(plan.Seed + 2000))
+
+	spawned := 0
+	for i, room := range rooms {
+		for n := 0; n < roomCounts[i] && spawned < len(generated); n++ {
+			point := points[room][n%len(points[room])]
+			x, y := tileCenter(point)
+			if n >= len(points[room]) {
+				x += rng.Float64()*20 - 10
+				y += rng.Float64()*20 - 10
+			}
+
+			spawnGeneratedEnemy(world, generated[spawned], x, y, room, plan.Seed, plan.Params.GenreID, difficulty, RollEliteAffixes(eliteRng, eliteConfig))
+			spawned++
+		}
+	}
+	return spawned, nil
+}
+
+// spawnPlannedItems spawns a generated item at each of the plan's item
+// points.
+func spawnPlannedItems(world *World, plan *worldgen.World, tileCenter func(worldgen.Spawn) (float64, float64), difficulty DifficultySettings) (int, error) {
+	spawns := plan.SpawnsOfKind(worldgen.SpawnItem)
+	if len(spawns) == 0 {
+		return 0, nil
+	}
+
+	params := procgen.GenerationParams{
+		Difficulty: plan.Params.Difficulty,
+		Depth:      plan.Params.Depth,
+		GenreID:    plan.Params.GenreID,
+		Custom: map[string]interface{}{
+			"count":        len(spawns),
+			"rarity_bonus": difficulty.LootRarityBonus,
+		},
+	}
+	result, err := item.NewItemGenerator().Generate(plan.Seed+3000, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate items: %w", err)
+	}
+	items := result.([]*item.Item)
+
+	spawned := 0
+	for i, spawn := range spawns {
+		if i >= len(items) {
+			break
+		}
+		x, y := tileCenter(spawn)
+		if SpawnItemInWorld(world, items[i], x, y) != nil {
+			spawned++
+		}
+	}
+	return spawned, nil
+}
@@ -0,0 +1,85 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/worldgen"
+)
+
+func TestSpawnWorldPlan_SpawnsPlannedContent(t *testing.T) {
+	pipeline, err := worldgen.NewPipelineFromConfig(worldgen.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewPipelineFromConfig failed: %v", err)
+	}
+	plan, err := pipeline.Run(42, procgen.GenerationParams{Difficulty: 0.5, Depth: 1, GenreID: "fantasy"})
+	if err != nil {
+		t.Fatalf("pipeline failed: %v", err)
+	}
+
+	world := NewWorld()
+	counts, err := SpawnWorldPlan(world, plan, 32, DifficultyNormal.Settings())
+	if err != nil {
+		t.Fatalf("SpawnWorldPlan failed: %v", err)
+	}
+	world.Update(0)
+
+	want := WorldPlanCounts{
+		Enemies:     len(plan.SpawnsOfKind(worldgen.SpawnEnemy)),
+		Items:       len(plan.SpawnsOfKind(worldgen.SpawnItem)),
+		Keys:        len(plan.SpawnsOfKind(worldgen.SpawnKey)),
+		LockedDoors: len(plan.SpawnsOfKind(worldgen.SpawnLockedDoor)),
+	}
+	if counts != want {
+		t.Errorf("counts = %+v, want one entity per planned spawn %+v", counts, want)
+	}
+	if want.Enemies == 0 || want.Keys == 0 {
+		t.Fatalf("default pipeline planned no enemies or keys: %+v", want)
+	}
+
+	// Enemies stand on their planned tiles and belong to their room's zone
+	planned := make(map[[2]float64]int)
+	for _, spawn := range plan.SpawnsOfKind(worldgen.SpawnEnemy) {
+		planned[[2]float64{float64(spawn.X)*32 + 16, float64(spawn.Y)*32 + 16}] = spawn.RoomIndex
+	}
+	for _, e := range world.GetEntities() {
+		zoneComp, ok := e.GetComponent("spawn_zone")
+		if !ok {
+			continue
+		}
+		pos := e.GetPosition()
+		room, ok := planned[[2]float64{pos.X, pos.Y}]
+		if !ok {
+			t.Errorf("enemy at (%v, %v) is not on a planned spawn point", pos.X, pos.Y)
+		} else if zoneComp.(*SpawnZoneComponent).ZoneID != room {
+			t.Errorf("enemy zone = %d, want room %d", zoneComp.(*SpawnZoneComponent).ZoneID, room)
+		}
+	}
+}
+
+func TestSpawnWorldPlan_DifficultyScalesEnemies(t *testing.T) {
+	pipeline, err := worldgen.NewPipelineFromConfig(worldgen.DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewPipelineFromConfig failed: %v", err)
+	}
+	plan, err := pipeline.Run(7, procgen.GenerationParams{Difficulty: 0.5, Depth: 1, GenreID: "scifi"})
+	if err != nil {
+		t.Fatalf("pipeline failed: %v", err)
+	}
+
+	normal, err := SpawnWorldPlan(NewWorld(), plan, 32, DifficultyNormal.Settings())
+	if err != nil {
+		t.Fatalf("SpawnWorldPlan failed: %v", err)
+	}
+	nightmare, err := SpawnWorldPlan(NewWorld(), plan, 32, DifficultyNightmare.Settings())
+	if err != nil {
+		t.Fatalf("SpawnWorldPlan failed: %v", err)
+	}
+	if nightmare.Enemies <= normal.Enemies {
+		t.Errorf("Nightmare spawned %d enemies, Normal %d; want more on Nightmare", nightmare.Enemies, normal.Enemies)
+	}
+
+	if _, err := SpawnWorldPlan(NewWorld(), &worldgen.World{}, 32, DifficultyNormal.Settings()); err == nil {
+		t.Error("expected error for a plan without terrain")
+	}
+}
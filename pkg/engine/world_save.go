@@ -0,0 +1,380 @@
+// Package engine provides world entity save support.
+// This file implements WorldSaveAdapter, which exposes the non-player
+// entities of a World (enemies, dropped items, merchants, crafting stations)
+// to the saveload package for snapshotting and restoring.
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	procgenEntity "github.com/opd-ai/venture/pkg/procgen/entity"
+	"github.com/opd-ai/venture/pkg/procgen/item"
+	"github.com/opd-ai/venture/pkg/saveload"
+)
+
+// WorldSaveAdapter implements saveload.EntityWorld for an ECS World.
+type WorldSaveAdapter struct {
+	world *World
+
+	// spawned holds entities created during a restore, which the world
+	// only registers on its next Update
+	spawned map[uint64]*Entity
+}
+
+// NewWorldSaveAdapter creates an adapter for world.
+func NewWorldSaveAdapter(world *World) *WorldSaveAdapter {
+	return &WorldSaveAdapter{
+		world:   world,
+		spawned: make(map[uint64]*Entity),
+	}
+}
+
+// savedEntityKind classifies an entity for saving. Players, dead entities
+// and anything else regenerated or transient report false.
+func savedEntityKind(e *Entity) (saveload.EntityKind, bool) {
+	if e.HasComponent("input") || e.HasComponent("dead") {
+		return "", false
+	}
+	switch {
+	case e.HasComponent("item_entity"):
+		return saveload.EntityKindItem, true
+	case e.HasComponent("merchant"):
+		return saveload.EntityKindMerchant, true
+	case e.HasComponent("crafting_station"):
+		return saveload.EntityKindStation, true
+	}
+	if teamComp, ok := e.GetComponent("team"); ok && teamComp.(*TeamComponent).TeamID == 2 {
+		return saveload.EntityKindEnemy, true
+	}
+	return "", false
+}
+
+// SavedEntities returns the state of every savable entity, ordered by ID.
+func (a *WorldSaveAdapter) SavedEntities() []saveload.EntityData {
+	var entities []saveload.EntityData
+	for _, e := range a.world.GetEntities() {
+		if kind, ok := savedEntityKind(e); ok {
+			entities = append(entities, snapshotEntity(e, kind))
+		}
+	}
+	sort.Slice(entities, func(i, j int) bool { return entities[i].ID < entities[j].ID })
+	return entities
+}
+
+// snapshotEntity captures the saved state of e.
+func snapshotEntity(e *Entity, kind saveload.EntityKind) saveload.EntityData {
+	data := saveload.EntityData{ID: e.ID, Kind: kind}
+	if pos := e.GetPosition(); pos != nil {
+		data.X, data.Y = pos.X, pos.Y
+	}
+	if health := e.GetHealth(); health != nil {
+		data.CurrentHealth, data.MaxHealth = health.Current, health.Max
+	}
+
+	switch kind {
+	case saveload.EntityKindEnemy:
+		if attack := e.GetAttack(); attack != nil {
+			data.Attack = attack.Damage
+		}
+		if stats := e.GetStats(); stats != nil {
+			data.Defense = stats.Defense
+		}
+		data.EliteAffixes = savedEliteAffixes(e)
+		if shieldComp, ok := e.GetComponent("shield"); ok {
+			shield := shieldComp.(*ShieldComponent)
+			data.ShieldAmount, data.ShieldMaxAmount = shield.Amount, shield.MaxAmount
+		}
+		if identityComp, ok := e.GetComponent("enemy_identity"); ok {
+			identity := identityComp.(*EnemyIdentityComponent)
+			data.Name = identity.Name
+			data.EnemyType = identity.EntityType.String()
+			data.EnemySize = identity.Size.String()
+			data.GenreID = identity.GenreID
+		}
+		if zoneComp, ok := e.GetComponent("spawn_zone"); ok {
+			data.ZoneID = zoneComp.(*SpawnZoneComponent).ZoneID
+		}
+		if aiComp, ok := e.GetComponent("ai"); ok {
+			if target := aiComp.(*AIComponent).Target; target != nil {
+				data.References = map[string]uint64{saveload.ReferenceTarget: target.ID}
+			}
+		}
+	case saveload.EntityKindItem:
+		itemComp, _ := e.GetComponent("item_entity")
+		if itm := itemComp.(*ItemEntityComponent).Item; itm != nil {
+			itemData := saveload.ItemToData(itm)
+			data.Item = &itemData
+		}
+	case saveload.EntityKindMerchant:
+		merchantComp, _ := e.GetComponent("merchant")
+		merchant := merchantComp.(*MerchantComponent)
+		data.Name = merchant.MerchantName
+		data.MerchantType = int(merchant.MerchantType)
+		data.PriceMultiplier = merchant.PriceMultiplier
+		data.BuyBackPercentage = merchant.BuyBackPercentage
+		for _, itm := range merchant.Inventory {
+			data.Inventory = append(data.Inventory, saveload.ItemToData(itm))
+		}
+	case saveload.EntityKindStation:
+		stationComp, _ := e.GetComponent("crafting_station")
+		station := stationComp.(*CraftingStationComponent)
+		data.StationType = stationTypeForRecipe(station.StationType)
+		data.StationTier = station.Tier
+		data.StationMaxCharges = station.MaxCharges
+		data.StationCharges = station.Charges
+		data.StationFuelItem = station.FuelItem
+	}
+	return data
+}
+
+// savedEliteAffixes returns the elite affixes of e as saved, or nil if e is
+// not an elite.
+func savedEliteAffixes(e *Entity) []int {
+	eliteComp, ok := e.GetComponent("elite")
+	if !ok {
+		return nil
+	}
+	var affixes []int
+	for _, affix := range eliteComp.(*EliteComponent).Affixes {
+		affixes = append(affixes, int(affix))
+	}
+	return affixes
+}
+
+// stationTypeForRecipe inverts the StationType mapping of SpawnStationFromData.
+func stationTypeForRecipe(recipeType RecipeType) int {
+	switch recipeType {
+	case RecipeEnchanting:
+		return 1 // StationForge
+	case RecipeMagicItem:
+		return 2 // StationWorkbench
+	default:
+		return 0 // StationAlchemyTable
+	}
+}
+
+// lookup finds a live or just-respawned entity by ID.
+func (a *WorldSaveAdapter) lookup(id uint64) (*Entity, bool) {
+	if e, ok := a.spawned[id]; ok {
+		return e, true
+	}
+	return a.world.GetEntity(id)
+}
+
+// UpdateSavedEntity applies data to the live entity with the same ID and
+// kind.
+func (a *WorldSaveAdapter) UpdateSavedEntity(data saveload.EntityData) bool {
+	e, ok := a.lookup(data.ID)
+	if !ok {
+		return false
+	}
+	if kind, ok := savedEntityKind(e); !ok || kind != data.Kind {
+		return false
+	}
+	// An enemy that rolled different affixes is not the saved enemy;
+	// replace it so the saved affixes are applied exactly once
+	if data.Kind == saveload.EntityKindEnemy && !sameAffixes(savedEliteAffixes(e), data.EliteAffixes) {
+		a.RemoveSavedEntity(e.ID)
+		return false
+	}
+	applySavedEntity(e, data)
+	return true
+}
+
+// sameAffixes reports whether two saved affix lists are equal.
+func sameAffixes(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// applySavedEntity overwrites the saved state of e with data. AI targets
+// are cleared; LinkSavedEntity sets them once every entity exists.
+func applySavedEntity(e *Entity, data saveload.EntityData) {
+	if pos := e.GetPosition(); pos != nil {
+		pos.X, pos.Y = data.X, data.Y
+	}
+	if health := e.GetHealth(); health != nil {
+		health.Current, health.Max = data.CurrentHealth, data.MaxHealth
+	}
+
+	switch data.Kind {
+	case saveload.EntityKindEnemy:
+		if attack := e.GetAttack(); attack != nil {
+			attack.Damage = data.Attack
+		}
+		if stats := e.GetStats(); stats != nil {
+			stats.Defense = data.Defense
+		}
+		applySavedShield(e, data)
+		if aiComp, ok := e.GetComponent("ai"); ok {
+			aiComp.(*AIComponent).ClearTarget()
+		}
+	case saveload.EntityKindItem:
+		if data.Item != nil {
+			itemComp, _ := e.GetComponent("item_entity")
+			itemComp.(*ItemEntityComponent).Item = saveload.DataToItem(*data.Item)
+		}
+	case saveload.EntityKindMerchant:
+		merchantComp, _ := e.GetComponent("merchant")
+		merchantComp.(*MerchantComponent).Inventory = savedInventory(data.Inventory)
+	}
+}
+
+// applySavedShield restores an enemy's shield to its saved strength,
+// removing it if it was depleted. A saved shield lasts until depleted, like
+// an elite's.
+func applySavedShield(e *Entity, data saveload.EntityData) {
+	if data.ShieldMaxAmount <= 0 {
+		e.RemoveComponent("shield")
+		return
+	}
+	if shieldComp, ok := e.GetComponent("shield"); ok {
+		shield := shieldComp.(*ShieldComponent)
+		shield.Amount, shield.MaxAmount = data.ShieldAmount, data.ShieldMaxAmount
+		return
+	}
+	e.AddComponent(&ShieldComponent{
+		Amount:      data.ShieldAmount,
+		MaxAmount:   data.ShieldMaxAmount,
+		Duration:    math.Inf(1),
+		MaxDuration: math.Inf(1),
+	})
+}
+
+// savedInventory converts saved items back into items.
+func savedInventory(items []saveload.ItemData) []*item.Item {
+	inventory := make([]*item.Item, 0, len(items))
+	for _, itemData := range items {
+		inventory = append(inventory, saveload.DataToItem(itemData))
+	}
+	return inventory
+}
+
+// SpawnSavedEntity recreates an entity that no longer exists in the world
+// using the spawn helper for its kind.
+func (a *WorldSaveAdapter) SpawnSavedEntity(data saveload.EntityData) (uint64, error) {
+	var e *Entity
+	switch data.Kind {
+	case saveload.EntityKindEnemy:
+		affixes := make([]EliteAffix, len(data.EliteAffixes))
+		for i, affix := range data.EliteAffixes {
+			affixes[i] = EliteAffix(affix)
+		}
+		// Saved health already includes the elite bonus; undo it so the
+		// affixes only apply once
+		health := data.MaxHealth
+		if len(affixes) > 0 {
+			health /= EliteHealthMultiplier
+		}
+		template := &procgenEntity.Entity{
+			Name: data.Name,
+			Type: savedEnemyType(data.EnemyType),
+			Size: savedEnemySize(data.EnemySize),
+			Stats: procgenEntity.Stats{
+				Health:  int(math.Round(health)),
+				Damage:  int(data.Attack),
+				Defense: int(data.Defense),
+			},
+		}
+		if data.EnemyType != "" {
+			// Saved stats are final, so spawn unscaled
+			e = spawnGeneratedEnemy(a.world, template, data.X, data.Y, data.ZoneID, 0, data.GenreID, DifficultyNormal.Settings(), affixes)
+		} else {
+			e = SpawnEnemyFromTemplate(a.world, template, data.X, data.Y)
+			ApplyEliteAffixes(e, affixes)
+		}
+	case saveload.EntityKindItem:
+		if data.Item == nil {
+			return 0, fmt.Errorf("item entity %d has no item", data.ID)
+		}
+		e = SpawnItemInWorld(a.world, saveload.DataToItem(*data.Item), data.X, data.Y)
+	case saveload.EntityKindMerchant:
+		merchantType := procgenEntity.MerchantFixed
+		if MerchantType(data.MerchantType) == MerchantNomadic {
+			merchantType = procgenEntity.MerchantNomadic
+		}
+		e = SpawnMerchantFromData(a.world, &procgenEntity.MerchantData{
+			Entity: &procgenEntity.Entity{
+				Name:  data.Name,
+				Stats: procgenEntity.Stats{Health: int(data.MaxHealth)},
+			},
+			MerchantType:      merchantType,
+			Inventory:         savedInventory(data.Inventory),
+			PriceMultiplier:   data.PriceMultiplier,
+			BuyBackPercentage: data.BuyBackPercentage,
+		}, data.X, data.Y)
+	case saveload.EntityKindStation:
+		e = SpawnStationFromData(a.world, &StationData{
+			StationType: data.StationType,
+			Tier:        data.StationTier,
+			MaxCharges:  data.StationMaxCharges,
+			Charges:     data.StationCharges,
+			FuelItem:    data.StationFuelItem,
+			SpawnX:      data.X,
+			SpawnY:      data.Y,
+		}, data.X, data.Y)
+	default:
+		return 0, fmt.Errorf("unknown entity kind %q", data.Kind)
+	}
+	if e == nil {
+		return 0, fmt.Errorf("failed to spawn %s entity %d", data.Kind, data.ID)
+	}
+
+	applySavedEntity(e, data)
+	a.spawned[e.ID] = e
+	return e.ID, nil
+}
+
+// savedEnemyType parses a saved enemy type name, defaulting to a monster.
+func savedEnemyType(name string) procgenEntity.EntityType {
+	for t := procgenEntity.TypeMonster; t <= procgenEntity.TypeMinion; t++ {
+		if t.String() == name {
+			return t
+		}
+	}
+	return procgenEntity.TypeMonster
+}
+
+// savedEnemySize parses a saved enemy size name, defaulting to medium.
+func savedEnemySize(name string) procgenEntity.EntitySize {
+	for size := procgenEntity.SizeTiny; size <= procgenEntity.SizeHuge; size++ {
+		if size.String() == name {
+			return size
+		}
+	}
+	return procgenEntity.SizeMedium
+}
+
+// RemoveSavedEntity removes the entity with id from the world.
+func (a *WorldSaveAdapter) RemoveSavedEntity(id uint64) {
+	delete(a.spawned, id)
+	a.world.RemoveEntity(id)
+}
+
+// LinkSavedEntity restores the AI target of entity id.
+func (a *WorldSaveAdapter) LinkSavedEntity(id uint64, references map[string]uint64) {
+	e, ok := a.lookup(id)
+	if !ok {
+		return
+	}
+	targetID, ok := references[saveload.ReferenceTarget]
+	if !ok {
+		return
+	}
+	aiComp, ok := e.GetComponent("ai")
+	if !ok {
+		return
+	}
+	if target, ok := a.lookup(targetID); ok {
+		aiComp.(*AIComponent).Target = target
+	}
+}
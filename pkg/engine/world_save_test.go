@@ -0,0 +1,196 @@
+package engine
+
+import (
+	"testing"
+
+	procgenEntity "github.com/opd-ai/venture/pkg/procgen/entity"
+	"github.com/opd-ai/venture/pkg/procgen/item"
+	"github.com/opd-ai/venture/pkg/saveload"
+)
+
+func TestWorldSaveAdapter_SnapshotSkipsPlayerAndDead(t *testing.T) {
+	world := NewWorld()
+	player := world.CreateEntity()
+	player.AddComponent(&EbitenInput{})
+	player.AddComponent(&TeamComponent{TeamID: 1})
+	dead := eliteTestEnemy(world)
+	dead.AddComponent(&DeadComponent{})
+	enemy := eliteTestEnemy(world)
+	ApplyEliteAffixes(enemy, []EliteAffix{AffixFast})
+	aiComp, _ := enemy.GetComponent("ai")
+	aiComp.(*AIComponent).Target = player
+	SpawnItemInWorld(world, &item.Item{Name: "Rusty Sword"}, 40, 50)
+	world.Update(0)
+
+	entities := NewWorldSaveAdapter(world).SavedEntities()
+	if len(entities) != 2 {
+		t.Fatalf("snapshot has %d entities, want enemy and item", len(entities))
+	}
+	if entities[0].ID != enemy.ID || entities[0].Kind != saveload.EntityKindEnemy {
+		t.Errorf("first entity = %+v, want enemy %d", entities[0], enemy.ID)
+	}
+	if len(entities[0].EliteAffixes) != 1 || entities[0].References[saveload.ReferenceTarget] != player.ID {
+		t.Errorf("enemy affixes/references not captured: %+v", entities[0])
+	}
+	if entities[1].Kind != saveload.EntityKindItem || entities[1].Item.Name != "Rusty Sword" || entities[1].X != 40 {
+		t.Errorf("item = %+v", entities[1])
+	}
+}
+
+func TestWorldSaveAdapter_RestoreRespawnsAndRelinks(t *testing.T) {
+	manager, err := saveload.NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	world := NewWorld()
+	player := world.CreateEntity()
+	player.AddComponent(&EbitenInput{})
+	player.AddComponent(&TeamComponent{TeamID: 1})
+	survivor := eliteTestEnemy(world)
+	killed := eliteTestEnemy(world)
+	ApplyEliteAffixes(killed, []EliteAffix{AffixShielded})
+	killed.GetHealth().Current = 75
+	aiComp, _ := killed.GetComponent("ai")
+	aiComp.(*AIComponent).Target = player
+	world.Update(0)
+
+	state := manager.SnapshotEntities(NewWorldSaveAdapter(world))
+
+	// After the save the elite dies and the survivor is wounded
+	world.RemoveEntity(killed.ID)
+	survivor.GetHealth().Current = 10
+	world.Update(0)
+
+	idMap, err := manager.RestoreEntities(NewWorldSaveAdapter(world), state)
+	if err != nil {
+		t.Fatalf("RestoreEntities failed: %v", err)
+	}
+	world.Update(0)
+
+	if idMap[survivor.ID] != survivor.ID || survivor.GetHealth().Current != 100 {
+		t.Errorf("survivor not restored in place: id %d, health %v", idMap[survivor.ID], survivor.GetHealth().Current)
+	}
+
+	respawned, ok := world.GetEntity(idMap[killed.ID])
+	if !ok || respawned.ID == killed.ID {
+		t.Fatalf("killed elite not respawned with a new ID: %v", idMap)
+	}
+	if health := respawned.GetHealth(); health.Current != 75 || health.Max != 150 {
+		t.Errorf("respawned health = %v/%v, want 75/150", health.Current, health.Max)
+	}
+	if !respawned.HasComponent("elite") || !respawned.HasComponent("shield") {
+		t.Error("respawned elite lost its affixes")
+	}
+	respawnedAI, _ := respawned.GetComponent("ai")
+	if respawnedAI.(*AIComponent).Target != player {
+		t.Error("respawned elite should still target the player")
+	}
+}
+
+func TestWorldSaveAdapter_RespawnKeepsEnemyIdentityAndShield(t *testing.T) {
+	manager, err := saveload.NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	world := NewWorld()
+	template := &procgenEntity.Entity{
+		Name:  "Cave Troll",
+		Type:  procgenEntity.TypeBoss,
+		Size:  procgenEntity.SizeLarge,
+		Stats: procgenEntity.Stats{Health: 100, Damage: 12, Defense: 4},
+	}
+	elite := spawnGeneratedEnemy(world, template, 64, 64, 3, 1, "fantasy", DifficultyNormal.Settings(), []EliteAffix{AffixShielded})
+	shieldComp, _ := elite.GetComponent("shield")
+	shieldComp.(*ShieldComponent).Amount = 10
+	colliderComp, _ := elite.GetComponent("collider")
+	wantWidth := colliderComp.(*ColliderComponent).Width
+	world.Update(0)
+
+	// Save and reload several times; the enemy must come back the same
+	id := elite.ID
+	for load := 0; load < 3; load++ {
+		state := manager.SnapshotEntities(NewWorldSaveAdapter(world))
+		world.RemoveEntity(id)
+		world.Update(0)
+
+		idMap, err := manager.RestoreEntities(NewWorldSaveAdapter(world), state)
+		if err != nil {
+			t.Fatalf("RestoreEntities failed: %v", err)
+		}
+		world.Update(0)
+		id = idMap[id]
+	}
+
+	respawned, ok := world.GetEntity(id)
+	if !ok {
+		t.Fatal("elite not respawned")
+	}
+	if health := respawned.GetHealth(); health.Max != 150 {
+		t.Errorf("respawned max health = %v, want 150", health.Max)
+	}
+	shieldComp, ok = respawned.GetComponent("shield")
+	if !ok {
+		t.Fatal("respawned elite lost its shield")
+	}
+	if shield := shieldComp.(*ShieldComponent); shield.Amount != 10 || shield.MaxAmount != 75 {
+		t.Errorf("shield = %v/%v, want 10/75", shield.Amount, shield.MaxAmount)
+	}
+	identityComp, ok := respawned.GetComponent("enemy_identity")
+	if !ok {
+		t.Fatal("respawned enemy lost its identity")
+	}
+	if identity := identityComp.(*EnemyIdentityComponent); identity.Name != "Cave Troll" || identity.EntityType != procgenEntity.TypeBoss || identity.Size != procgenEntity.SizeLarge {
+		t.Errorf("identity = %+v", identity)
+	}
+	colliderComp, _ = respawned.GetComponent("collider")
+	if width := colliderComp.(*ColliderComponent).Width; width != wantWidth {
+		t.Errorf("collider width = %v, want %v", width, wantWidth)
+	}
+	zoneComp, _ := respawned.GetComponent("spawn_zone")
+	if zoneComp.(*SpawnZoneComponent).ZoneID != 3 {
+		t.Error("respawned enemy lost its spawn zone")
+	}
+}
+
+func TestWorldSaveAdapter_UpdateReplacesRerolledElite(t *testing.T) {
+	manager, err := saveload.NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	world := NewWorld()
+	enemy := eliteTestEnemy(world)
+	ApplyEliteAffixes(enemy, []EliteAffix{AffixShielded})
+	shieldComp, _ := enemy.GetComponent("shield")
+	shieldComp.(*ShieldComponent).Amount = 5
+	world.Update(0)
+	state := manager.SnapshotEntities(NewWorldSaveAdapter(world))
+
+	// The regenerated level rolled a different affix for the same ID
+	enemy.RemoveComponent("shield")
+	eliteComp, _ := enemy.GetComponent("elite")
+	eliteComp.(*EliteComponent).Affixes = []EliteAffix{AffixFast}
+
+	idMap, err := manager.RestoreEntities(NewWorldSaveAdapter(world), state)
+	if err != nil {
+		t.Fatalf("RestoreEntities failed: %v", err)
+	}
+	world.Update(0)
+
+	if _, ok := world.GetEntity(enemy.ID); ok {
+		t.Error("re-rolled elite should be replaced")
+	}
+	restored, ok := world.GetEntity(idMap[enemy.ID])
+	if !ok {
+		t.Fatal("saved elite not respawned")
+	}
+	shieldComp, ok = restored.GetComponent("shield")
+	if !ok || shieldComp.(*ShieldComponent).Amount != 5 {
+		t.Error("restored elite should keep its saved shield")
+	}
+	if health := restored.GetHealth(); health.Max != 150 {
+		t.Errorf("restored max health = %v, want 150", health.Max)
+	}
+}
@@ -0,0 +1,185 @@
+// Package hostplay provides LAN server discovery.
+// Hosts bound to the LAN broadcast a small UDP announcement on a well-known
+// port, and clients listen for announcements to list joinable games instead
+// of typing the host's IP address.
+package hostplay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultDiscoveryPort is the well-known UDP port LAN servers announce
+// themselves on and DiscoverServers listens on.
+const DefaultDiscoveryPort = 8090
+
+// DefaultAnnounceInterval is how often a LAN server announces itself.
+const DefaultAnnounceInterval = 1 * time.Second
+
+// discoveryMagic identifies Venture announcements among other broadcasts.
+const discoveryMagic = "venture-lan-v1"
+
+// ServerInfo describes a LAN server found by DiscoverServers.
+type ServerInfo struct {
+	// Name is the host's server name
+	Name string `json:"name"`
+
+	// GenreID is the genre of the hosted world
+	GenreID string `json:"genre"`
+
+	// Players and MaxPlayers are the current and maximum player counts
+	Players    int `json:"players"`
+	MaxPlayers int `json:"max_players"`
+
+	// Port is the game port clients connect to
+	Port int `json:"port"`
+
+	// Address is the host:port to connect to, filled in from the sender of
+	// the announcement
+	Address string `json:"-"`
+}
+
+// announcement is the UDP payload of a server announcement.
+type announcement struct {
+	Magic string `json:"magic"`
+	ServerInfo
+}
+
+// announcer periodically broadcasts a server's info on the LAN.
+type announcer struct {
+	conn     net.PacketConn
+	target   *net.UDPAddr
+	interval time.Duration
+	info     func() ServerInfo
+	logger   *logrus.Logger
+}
+
+// newAnnouncer creates an announcer sending to target (host:port) every
+// interval. info is called before each announcement for fresh player counts.
+func newAnnouncer(target string, interval time.Duration, info func() ServerInfo, logger *logrus.Logger) (*announcer, error) {
+	addr, err := net.ResolveUDPAddr("udp4", target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid discovery address %s: %w", target, err)
+	}
+	conn, err := net.ListenPacket("udp4", ":0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open discovery socket: %w", err)
+	}
+	if interval <= 0 {
+		interval = DefaultAnnounceInterval
+	}
+	return &announcer{
+		conn:     conn,
+		target:   addr,
+		interval: interval,
+		info:     info,
+		logger:   logger,
+	}, nil
+}
+
+// run announces immediately and then every interval until ctx is cancelled.
+func (a *announcer) run(ctx context.Context) {
+	defer a.conn.Close()
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.announce(); err != nil && a.logger != nil {
+			a.logger.WithError(err).Debug("LAN announcement failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// announce sends one announcement.
+func (a *announcer) announce() error {
+	data, err := json.Marshal(announcement{Magic: discoveryMagic, ServerInfo: a.info()})
+	if err != nil {
+		return fmt.Errorf("failed to encode announcement: %w", err)
+	}
+	if _, err := a.conn.WriteTo(data, a.target); err != nil {
+		return fmt.Errorf("failed to send announcement: %w", err)
+	}
+	return nil
+}
+
+// DiscoverServers listens on DefaultDiscoveryPort for timeout and returns
+// every LAN server that announced itself, sorted by name and address.
+func DiscoverServers(timeout time.Duration) ([]ServerInfo, error) {
+	return DiscoverServersOnPort(DefaultDiscoveryPort, timeout)
+}
+
+// DiscoverServersOnPort is DiscoverServers with a custom discovery port.
+func DiscoverServersOnPort(port int, timeout time.Duration) ([]ServerInfo, error) {
+	conn, err := net.ListenPacket("udp4", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for LAN servers on port %d: %w", port, err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, fmt.Errorf("failed to set discovery deadline: %w", err)
+	}
+
+	found := make(map[string]ServerInfo)
+	buf := make([]byte, 2048)
+	for {
+		n, from, err := conn.ReadFrom(buf)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				break
+			}
+			return nil, fmt.Errorf("failed to read LAN announcement: %w", err)
+		}
+
+		info, ok := parseAnnouncement(buf[:n], from)
+		if ok {
+			found[info.Address] = info
+		}
+	}
+
+	servers := make([]ServerInfo, 0, len(found))
+	for _, info := range found {
+		servers = append(servers, info)
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		if servers[i].Name != servers[j].Name {
+			return servers[i].Name < servers[j].Name
+		}
+		return servers[i].Address < servers[j].Address
+	})
+	return servers, nil
+}
+
+// parseAnnouncement decodes an announcement received from sender. Returns
+// false for packets that are not valid Venture announcements.
+func parseAnnouncement(data []byte, sender net.Addr) (ServerInfo, bool) {
+	var msg announcement
+	if err := json.Unmarshal(data, &msg); err != nil || msg.Magic != discoveryMagic {
+		return ServerInfo{}, false
+	}
+	if msg.Port <= 0 || msg.Port > 65535 {
+		return ServerInfo{}, false
+	}
+
+	udpAddr, ok := sender.(*net.UDPAddr)
+	if !ok {
+		return ServerInfo{}, false
+	}
+	info := msg.ServerInfo
+	info.Address = net.JoinHostPort(udpAddr.IP.String(), strconv.Itoa(info.Port))
+	return info, true
+}
@@ -0,0 +1,93 @@
+package hostplay
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// freeUDPPort returns a UDP port that was free a moment ago.
+func freeUDPPort(t *testing.T) int {
+	t.Helper()
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot open UDP socket: %v", err)
+	}
+	port := conn.LocalAddr().(*net.UDPAddr).Port
+	conn.Close()
+	return port
+}
+
+// TestDiscoverServers verifies announcements are found with their sender's address
+func TestDiscoverServers(t *testing.T) {
+	port := freeUDPPort(t)
+	info := ServerInfo{Name: "Alice's Game", GenreID: "scifi", Players: 1, MaxPlayers: 4, Port: 8081}
+
+	a, err := newAnnouncer(net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), 20*time.Millisecond,
+		func() ServerInfo { return info }, nil)
+	if err != nil {
+		t.Fatalf("newAnnouncer failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start announcing once the listener is likely up
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		a.run(ctx)
+	}()
+
+	servers, err := DiscoverServersOnPort(port, 300*time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscoverServersOnPort failed: %v", err)
+	}
+	if len(servers) != 1 {
+		t.Fatalf("found %d servers, want 1 (repeated announcements are deduplicated)", len(servers))
+	}
+
+	got := servers[0]
+	if got.Name != info.Name || got.GenreID != info.GenreID || got.Players != 1 || got.MaxPlayers != 4 {
+		t.Errorf("server info = %+v, want %+v", got, info)
+	}
+	if got.Address != "127.0.0.1:8081" {
+		t.Errorf("Address = %q, want 127.0.0.1:8081", got.Address)
+	}
+}
+
+// TestDiscoverServers_NoServers verifies an empty result after the timeout
+func TestDiscoverServers_NoServers(t *testing.T) {
+	start := time.Now()
+	servers, err := DiscoverServersOnPort(freeUDPPort(t), 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscoverServersOnPort failed: %v", err)
+	}
+	if len(servers) != 0 {
+		t.Errorf("found %d servers, want none", len(servers))
+	}
+	if time.Since(start) < 100*time.Millisecond {
+		t.Error("discovery should listen for the full timeout")
+	}
+}
+
+// TestParseAnnouncement rejects packets that are not Venture announcements
+func TestParseAnnouncement(t *testing.T) {
+	sender := &net.UDPAddr{IP: net.IPv4(192, 168, 1, 20), Port: 40000}
+
+	info, ok := parseAnnouncement([]byte(`{"magic":"venture-lan-v1","name":"LAN","genre":"fantasy","port":8080}`), sender)
+	if !ok || info.Address != "192.168.1.20:8080" || info.Name != "LAN" {
+		t.Errorf("valid announcement parsed as %+v, %v", info, ok)
+	}
+
+	for _, packet := range []string{
+		`not json`,
+		`{"magic":"other-game","port":8080}`,
+		`{"magic":"venture-lan-v1","port":0}`,
+		`{"magic":"venture-lan-v1","port":70000}`,
+	} {
+		if _, ok := parseAnnouncement([]byte(packet), sender); ok {
+			t.Errorf("packet %s should be rejected", packet)
+		}
+	}
+}
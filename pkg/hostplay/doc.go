@@ -10,6 +10,20 @@
 // connections, users must explicitly enable --host-lan flag, which binds to 0.0.0.0.
 // No UPnP or public internet exposure occurs without explicit configuration.
 //
+// # LAN Discovery
+//
+// When ServerConfig.Announce is set, a LAN-bound server broadcasts a small UDP
+// announcement (name, genre, player counts and game port) on DefaultDiscoveryPort
+// every AnnounceInterval. Clients call DiscoverServers to list joinable games.
+// Localhost-only servers never announce themselves.
+//
+// # Player Capacity
+//
+// Connections beyond MaxPlayers are rejected with a "server full" message, which
+// clients report as network.ErrServerFull, rather than being left hanging. With
+// QueueSlots set, that many extra connections wait instead and join in order as
+// players leave. PlayerCount, QueueLength and IsFull report the current load.
+//
 // # Port Management
 //
 // The ServerManager attempts to bind to the requested port (default 8080). If that
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/opd-ai/venture/pkg/network"
 	"github.com/opd-ai/venture/pkg/procgen"
 	"github.com/opd-ai/venture/pkg/procgen/terrain"
+	"github.com/opd-ai/venture/pkg/procgen/worldgen"
 	"github.com/sirupsen/logrus"
 )
 
@@ -21,8 +23,13 @@ type ServerConfig struct {
 	Port int
 
 	// MaxPlayers is the maximum number of concurrent players (default 4).
+	// Connections beyond it are rejected with a "server full" message.
 	MaxPlayers int
 
+	// QueueSlots is how many connections may wait for a player slot once
+	// the server is full; they join in order as players leave (default 0, no queue).
+	QueueSlots int
+
 	// BindLAN controls whether to bind to all interfaces (0.0.0.0) or just localhost (127.0.0.1).
 	// Default is false (localhost only) for security.
 	BindLAN bool
@@ -38,6 +45,19 @@ type ServerConfig struct {
 
 	// TickRate is the server update rate in Hz (default 20).
 	TickRate int
+
+	// Announce broadcasts the server on the LAN so DiscoverServers can find
+	// it. Only takes effect when BindLAN is set.
+	Announce bool
+
+	// ServerName is the name shown in LAN server lists (default "Venture on <hostname>").
+	ServerName string
+
+	// DiscoveryPort is the UDP port announcements are sent to (default DefaultDiscoveryPort).
+	DiscoveryPort int
+
+	// AnnounceInterval is the time between announcements (default DefaultAnnounceInterval).
+	AnnounceInterval time.Duration
 }
 
 // ServerManager manages the lifecycle of an in-process game server.
@@ -55,6 +75,10 @@ type ServerManager struct {
 	mu               sync.RWMutex
 	running          bool
 	generatedTerrain *terrain.Terrain
+
+	// discoveryTarget overrides the broadcast address announcements are
+	// sent to (tests announce to loopback)
+	discoveryTarget string
 }
 
 // NewServerManager creates a new ServerManager with the given configuration.
@@ -79,6 +103,18 @@ func NewServerManager(config *ServerConfig, logger *logrus.Logger) (*ServerManag
 	if config.TickRate == 0 {
 		config.TickRate = 20
 	}
+	if config.ServerName == "" {
+		config.ServerName = "Venture"
+		if hostname, err := os.Hostname(); err == nil && hostname != "" {
+			config.ServerName = "Venture on " + hostname
+		}
+	}
+	if config.DiscoveryPort == 0 {
+		config.DiscoveryPort = DefaultDiscoveryPort
+	}
+	if config.AnnounceInterval == 0 {
+		config.AnnounceInterval = DefaultAnnounceInterval
+	}
 
 	return &ServerManager{
 		config: config,
@@ -120,30 +156,44 @@ func (sm *ServerManager) Start() error {
 	sm.world.AddSystem(aiSystem)
 	sm.world.AddSystem(progressionSystem)
 	sm.world.AddSystem(inventorySystem)
+	sm.world.AddSystem(engine.NewLockSystem(sm.world))
 
-	// Generate world terrain
-	terrainGen := terrain.NewBSPGeneratorWithLogger(sm.logger)
+	// Health regen pauses after damage; the combat damage callback resets its delay
+	healthRegenSystem := engine.NewHealthRegenSystem()
+	combatSystem.SetDamageCallback(healthRegenSystem.OnDamage)
+	sm.world.AddSystem(healthRegenSystem)
+
+	// Generate the world with the pipeline shared by the client and server
+	worldPipeline, err := worldgen.NewPipelineFromConfigWithLogger(worldgen.DefaultConfig(), sm.logger)
+	if err != nil {
+		return fmt.Errorf("failed to create world generation pipeline: %w", err)
+	}
 	params := procgen.GenerationParams{
 		Difficulty: sm.config.Difficulty,
 		Depth:      1,
 		GenreID:    sm.config.GenreID,
-		Custom: map[string]interface{}{
-			"width":  100,
-			"height": 100,
-		},
 	}
 
-	terrainResult, err := terrainGen.Generate(sm.config.WorldSeed, params)
+	worldPlan, err := worldPipeline.Run(sm.config.WorldSeed, params)
 	if err != nil {
 		return fmt.Errorf("failed to generate terrain: %w", err)
 	}
-	sm.generatedTerrain = terrainResult.(*terrain.Terrain)
+	sm.generatedTerrain = worldPlan.Terrain
+
+	difficulty := engine.DifficultyNormal.Settings()
+	difficulty.GenerationDifficulty = sm.config.Difficulty
+	planCounts, err := engine.SpawnWorldPlan(sm.world, worldPlan, 32, difficulty)
+	if err != nil {
+		return fmt.Errorf("failed to spawn world content: %w", err)
+	}
 
 	sm.logger.WithFields(logrus.Fields{
 		"width":     sm.generatedTerrain.Width,
 		"height":    sm.generatedTerrain.Height,
 		"roomCount": len(sm.generatedTerrain.Rooms),
-	}).Info("world terrain generated")
+		"enemies":   planCounts.Enemies,
+		"items":     planCounts.Items,
+	}).Info("world generated")
 
 	// Try to bind to a port (with fallback)
 	var port int
@@ -156,6 +206,7 @@ func (sm *ServerManager) Start() error {
 		serverConfig = network.DefaultServerConfig()
 		serverConfig.Address = addr
 		serverConfig.MaxPlayers = sm.config.MaxPlayers
+		serverConfig.QueueSlots = sm.config.QueueSlots
 		serverConfig.UpdateRate = sm.config.TickRate
 
 		// Try to create and start server
@@ -190,6 +241,15 @@ func (sm *ServerManager) Start() error {
 	sm.wg.Add(1)
 	go sm.serverLoop(ctx)
 
+	// Announce on the LAN; never when bound to localhost only
+	if sm.config.Announce {
+		if sm.config.BindLAN {
+			sm.startAnnouncer(ctx)
+		} else {
+			sm.logger.Warn("LAN announcements require BindLAN, server will not be discoverable")
+		}
+	}
+
 	sm.running = true
 
 	// Wait a moment to ensure server is fully initialized
@@ -205,6 +265,43 @@ func (sm *ServerManager) Start() error {
 	return nil
 }
 
+// startAnnouncer starts broadcasting the server on the LAN until ctx is
+// cancelled. Failure to announce is logged but does not stop the server.
+func (sm *ServerManager) startAnnouncer(ctx context.Context) {
+	target := sm.discoveryTarget
+	if target == "" {
+		target = fmt.Sprintf("255.255.255.255:%d", sm.config.DiscoveryPort)
+	}
+
+	a, err := newAnnouncer(target, sm.config.AnnounceInterval, sm.ServerInfo, sm.logger)
+	if err != nil {
+		sm.logger.WithError(err).Warn("LAN discovery unavailable")
+		return
+	}
+
+	sm.wg.Add(1)
+	go func() {
+		defer sm.wg.Done()
+		a.run(ctx)
+	}()
+
+	sm.logger.WithFields(logrus.Fields{
+		"name":           sm.config.ServerName,
+		"discovery_port": sm.config.DiscoveryPort,
+	}).Info("announcing server on LAN")
+}
+
+// ServerInfo returns the server's current LAN announcement.
+func (sm *ServerManager) ServerInfo() ServerInfo {
+	return ServerInfo{
+		Name:       sm.config.ServerName,
+		GenreID:    sm.config.GenreID,
+		Players:    sm.PlayerCount(),
+		MaxPlayers: sm.config.MaxPlayers,
+		Port:       sm.Port(),
+	}
+}
+
 // serverLoop runs the server in a goroutine until context is cancelled.
 func (sm *ServerManager) serverLoop(ctx context.Context) {
 	defer sm.wg.Done()
@@ -275,6 +372,7 @@ func (sm *ServerManager) spawnPlayer(playerID uint64) {
 
 	// Add combat stats
 	playerEntity.AddComponent(&engine.HealthComponent{Current: 100, Max: 100})
+	playerEntity.AddComponent(engine.NewPlayerHealthRegen(sm.config.GenreID, engine.ClassWarrior))
 
 	// Add network component to track player ID
 	networkComp := &engine.NetworkComponent{PlayerID: playerID}
@@ -356,6 +454,35 @@ func (sm *ServerManager) Port() int {
 	return sm.port
 }
 
+// PlayerCount returns the number of connected players (0 if not running).
+func (sm *ServerManager) PlayerCount() int {
+	sm.mu.RLock()
+	server := sm.server
+	sm.mu.RUnlock()
+
+	if server == nil {
+		return 0
+	}
+	return server.GetPlayerCount()
+}
+
+// QueueLength returns the number of connections waiting for a player slot.
+func (sm *ServerManager) QueueLength() int {
+	sm.mu.RLock()
+	server := sm.server
+	sm.mu.RUnlock()
+
+	if server == nil {
+		return 0
+	}
+	return server.GetQueueLength()
+}
+
+// IsFull returns whether every player slot is taken.
+func (sm *ServerManager) IsFull() bool {
+	return sm.PlayerCount() >= sm.config.MaxPlayers
+}
+
 // IsRunning returns whether the server is currently running.
 func (sm *ServerManager) IsRunning() bool {
 	sm.mu.RLock()
@@ -1,9 +1,13 @@
 package hostplay
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/opd-ai/venture/pkg/network"
+
 	"github.com/sirupsen/logrus"
 )
 
@@ -103,6 +107,12 @@ func TestServerManagerDefaults(t *testing.T) {
 	if manager.config.TickRate != 20 {
 		t.Errorf("expected default tick rate 20, got %d", manager.config.TickRate)
 	}
+	if manager.config.ServerName == "" {
+		t.Error("expected a default server name")
+	}
+	if manager.config.DiscoveryPort != DefaultDiscoveryPort {
+		t.Errorf("expected default discovery port %d, got %d", DefaultDiscoveryPort, manager.config.DiscoveryPort)
+	}
 }
 
 // TestServerManagerPortFallback tests port fallback logic
@@ -367,3 +377,132 @@ func TestServerManagerGenreSupport(t *testing.T) {
 		})
 	}
 }
+
+// startAnnouncingManager starts a manager announcing to a loopback discovery
+// port. Returns the manager and the discovery port.
+func startAnnouncingManager(t *testing.T, bindLAN bool, gamePort int) (*ServerManager, int) {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	discoveryPort := freeUDPPort(t)
+	manager, err := NewServerManager(&ServerConfig{
+		Port:             gamePort,
+		MaxPlayers:       3,
+		BindLAN:          bindLAN,
+		WorldSeed:        12345,
+		GenreID:          "horror",
+		Announce:         true,
+		ServerName:       "Test Host",
+		DiscoveryPort:    discoveryPort,
+		AnnounceInterval: 20 * time.Millisecond,
+	}, logger)
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+	manager.discoveryTarget = fmt.Sprintf("127.0.0.1:%d", discoveryPort)
+
+	if err := manager.Start(); err != nil {
+		t.Skipf("cannot start server for testing: %v", err)
+	}
+	t.Cleanup(func() { manager.Stop() })
+	return manager, discoveryPort
+}
+
+// TestServerManagerLANAnnounce verifies a LAN server can be discovered
+func TestServerManagerLANAnnounce(t *testing.T) {
+	manager, discoveryPort := startAnnouncingManager(t, true, 50300)
+
+	servers, err := DiscoverServersOnPort(discoveryPort, 300*time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscoverServersOnPort failed: %v", err)
+	}
+	if len(servers) != 1 {
+		t.Fatalf("found %d servers, want 1", len(servers))
+	}
+
+	info := servers[0]
+	if info.Name != "Test Host" || info.GenreID != "horror" || info.MaxPlayers != 3 || info.Players != 0 {
+		t.Errorf("unexpected server info: %+v", info)
+	}
+	if info.Port != manager.Port() {
+		t.Errorf("announced port %d, want game port %d", info.Port, manager.Port())
+	}
+}
+
+// TestServerManagerAnnounceRequiresLAN verifies localhost-only servers stay hidden
+func TestServerManagerAnnounceRequiresLAN(t *testing.T) {
+	_, discoveryPort := startAnnouncingManager(t, false, 50310)
+
+	servers, err := DiscoverServersOnPort(discoveryPort, 200*time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscoverServersOnPort failed: %v", err)
+	}
+	if len(servers) != 0 {
+		t.Errorf("localhost-only server was discovered: %+v", servers)
+	}
+}
+
+// TestServerManagerCapacity verifies connections beyond MaxPlayers are
+// rejected with ErrServerFull instead of hanging
+func TestServerManagerCapacity(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	const maxPlayers = 2
+	manager, err := NewServerManager(&ServerConfig{
+		Port:       50320,
+		MaxPlayers: maxPlayers,
+		WorldSeed:  12345,
+		GenreID:    "fantasy",
+	}, logger)
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+	if manager.PlayerCount() != 0 || manager.IsFull() {
+		t.Error("stopped server should report no players and not full")
+	}
+	if err := manager.Start(); err != nil {
+		t.Skipf("cannot start server for testing: %v", err)
+	}
+	defer manager.Stop()
+
+	connect := func() *network.TCPClient {
+		config := network.DefaultClientConfig()
+		config.ServerAddress = manager.Address()
+		config.ConnectionTimeout = 2 * time.Second
+		client := network.NewClient(config)
+		if err := client.Connect(); err != nil {
+			t.Fatalf("client connect failed: %v", err)
+		}
+		t.Cleanup(func() { client.Disconnect() })
+		return client
+	}
+
+	for i := 0; i < maxPlayers; i++ {
+		connect()
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for manager.PlayerCount() < maxPlayers && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !manager.IsFull() {
+		t.Fatalf("expected server full with %d players, got %d", maxPlayers, manager.PlayerCount())
+	}
+
+	for i := 0; i < 2; i++ {
+		client := connect()
+		select {
+		case err := <-client.ReceiveError():
+			if !errors.Is(err, network.ErrServerFull) {
+				t.Errorf("overflow client %d: expected ErrServerFull, got %v", i+1, err)
+			}
+		case <-time.After(2 * time.Second):
+			t.Errorf("overflow client %d was not rejected", i+1)
+		}
+	}
+
+	if manager.PlayerCount() != maxPlayers {
+		t.Errorf("player count = %d, want %d", manager.PlayerCount(), maxPlayers)
+	}
+}
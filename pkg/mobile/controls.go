@@ -99,6 +99,11 @@ func (d *VirtualDPad) Update(touches map[ebiten.TouchID]*Touch) {
 	}
 }
 
+// BoundTouch returns the touch controlling the D-pad, or -1.
+func (d *VirtualDPad) BoundTouch() ebiten.TouchID {
+	return d.TouchID
+}
+
 // GetDirection returns the normalized direction (-1.0 to 1.0 for each axis).
 func (d *VirtualDPad) GetDirection() (float64, float64) {
 	return d.DirectionX, d.DirectionY
@@ -195,6 +200,11 @@ func (b *VirtualButton) Update(touches map[ebiten.TouchID]*Touch) {
 	}
 }
 
+// BoundTouch returns the touch holding the button down, or -1.
+func (b *VirtualButton) BoundTouch() ebiten.TouchID {
+	return b.TouchID
+}
+
 // IsPressed returns true for one frame when the button is pressed.
 func (b *VirtualButton) IsPressed() bool {
 	return b.Pressed
@@ -246,40 +256,92 @@ type VirtualControlsLayout struct {
 	SecondaryButton *VirtualButton
 	MenuButton      *VirtualButton
 
+	// Anchored placement of each control, applied by Resize
+	DPadLayout      *Layout
+	ActionLayout    *Layout
+	SecondaryLayout *Layout
+	MenuLayout      *Layout
+
 	Visible      bool
 	touchHandler *TouchInputHandler
 }
 
+// DefaultDPadLayout anchors the D-pad bottom-left, raised in portrait where
+// the thumb rests higher.
+func DefaultDPadLayout() *Layout {
+	landscape := &Layout{
+		Anchor:         AnchorBottomLeft,
+		OffsetXPercent: 0.05,
+		OffsetYPercent: -0.05,
+		WidthPercent:   0.30,
+		HeightPercent:  0.30,
+	}
+	portrait := *landscape
+	portrait.OffsetYPercent = -0.15
+	landscape.Portrait = &portrait
+	return landscape
+}
+
+// defaultButtonLayout anchors a round button of the given diameter
+// (fraction of the shorter screen side) at an offset from a corner. Bottom
+// buttons are raised in portrait like the D-pad.
+func defaultButtonLayout(anchor LayoutAnchor, offsetX, offsetY, size float64) *Layout {
+	layout := &Layout{
+		Anchor:         anchor,
+		OffsetXPercent: offsetX,
+		OffsetYPercent: offsetY,
+		WidthPercent:   size,
+		HeightPercent:  size,
+	}
+	if anchor == AnchorBottomRight || anchor == AnchorBottomLeft {
+		portrait := *layout
+		portrait.OffsetYPercent -= 0.10
+		layout.Portrait = &portrait
+	}
+	return layout
+}
+
 // NewVirtualControlsLayout creates a complete virtual control layout for a given screen size.
+// The D-pad sits bottom-left, the action buttons bottom-right and the menu
+// button top-right in either orientation; call Resize when the screen
+// size or orientation changes.
 func NewVirtualControlsLayout(screenWidth, screenHeight int) *VirtualControlsLayout {
-	// Calculate positions based on screen size
-	dpadSize := float64(screenHeight) * 0.15
-	buttonSize := float64(screenHeight) * 0.08
-	margin := float64(screenHeight) * 0.05
-
-	// D-pad on bottom left
-	dpadX := margin + dpadSize
-	dpadY := float64(screenHeight) - margin - dpadSize
-
-	// Action buttons on bottom right
-	actionX := float64(screenWidth) - margin - buttonSize*2.5
-	actionY := float64(screenHeight) - margin - buttonSize
-
-	secondaryX := float64(screenWidth) - margin - buttonSize
-	secondaryY := float64(screenHeight) - margin - buttonSize*2.5
-
-	// Menu button on top right
-	menuX := float64(screenWidth) - margin - buttonSize
-	menuY := margin + buttonSize
-
-	return &VirtualControlsLayout{
-		DPad:            NewVirtualDPad(dpadX, dpadY, dpadSize),
-		ActionButton:    NewVirtualButton(actionX, actionY, buttonSize, "A"),
-		SecondaryButton: NewVirtualButton(secondaryX, secondaryY, buttonSize, "B"),
-		MenuButton:      NewVirtualButton(menuX, menuY, buttonSize*0.7, "☰"),
+	touchHandler := NewTouchInputHandler()
+
+	l := &VirtualControlsLayout{
+		DPad:            NewVirtualDPad(0, 0, 0),
+		ActionButton:    NewVirtualButton(0, 0, 0, "A"),
+		SecondaryButton: NewVirtualButton(0, 0, 0, "B"),
+		MenuButton:      NewVirtualButton(0, 0, 0, "☰"),
+		DPadLayout:      DefaultDPadLayout(),
+		ActionLayout:    defaultButtonLayout(AnchorBottomRight, -0.17, -0.05, 0.16),
+		SecondaryLayout: defaultButtonLayout(AnchorBottomRight, -0.05, -0.17, 0.16),
+		MenuLayout:      defaultButtonLayout(AnchorTopRight, -0.074, 0.074, 0.112),
 		Visible:         true,
-		touchHandler:    NewTouchInputHandler(),
+		touchHandler:    touchHandler,
 	}
+	l.Resize(screenWidth, screenHeight)
+	return l
+}
+
+// Resize repositions every control from its layout for a new screen size.
+func (l *VirtualControlsLayout) Resize(screenWidth, screenHeight int) {
+	l.DPad.X, l.DPad.Y, l.DPad.Radius = l.DPadLayout.ResolveCircle(screenWidth, screenHeight)
+	l.DPad.InnerRadius = l.DPad.Radius * 0.3
+
+	buttons := []struct {
+		button *VirtualButton
+		layout *Layout
+	}{
+		{l.ActionButton, l.ActionLayout},
+		{l.SecondaryButton, l.SecondaryLayout},
+		{l.MenuButton, l.MenuLayout},
+	}
+	for _, b := range buttons {
+		b.button.X, b.button.Y, b.button.Radius = b.layout.ResolveCircle(screenWidth, screenHeight)
+	}
+
+	l.touchHandler.SetScreenSize(screenWidth, screenHeight)
 }
 
 // Update processes touch input for all virtual controls.
@@ -289,15 +351,15 @@ func (l *VirtualControlsLayout) Update() {
 	}
 
 	l.touchHandler.Update()
-	touches := make(map[ebiten.TouchID]*Touch)
-	for _, touch := range l.touchHandler.GetActiveTouches() {
-		touches[touch.ID] = touch
-	}
+	l.updateControls()
+}
 
-	l.DPad.Update(touches)
-	l.ActionButton.Update(touches)
-	l.SecondaryButton.Update(touches)
-	l.MenuButton.Update(touches)
+// updateControls updates each control with the touches bound to it.
+func (l *VirtualControlsLayout) updateControls() {
+	l.touchHandler.UpdateControl(l.DPad)
+	l.touchHandler.UpdateControl(l.ActionButton)
+	l.touchHandler.UpdateControl(l.SecondaryButton)
+	l.touchHandler.UpdateControl(l.MenuButton)
 }
 
 // Draw renders all virtual controls on screen.
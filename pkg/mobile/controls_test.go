@@ -467,3 +467,124 @@ func TestVirtualDPad_DeadZone(t *testing.T) {
 		t.Errorf("Direction in dead zone = (%.2f, %.2f), want (0.0, 0.0)", x, y)
 	}
 }
+
+// touchFrame feeds one frame of touches (ID to position) through the
+// layout's touch handler and controls.
+func touchFrame(l *VirtualControlsLayout, touches map[ebiten.TouchID][2]int) {
+	ids := make([]ebiten.TouchID, 0, len(touches))
+	for id := range touches {
+		ids = append(ids, id)
+	}
+	l.touchHandler.updateTouches(ids, func(id ebiten.TouchID) (int, int) {
+		return touches[id][0], touches[id][1]
+	})
+	l.updateControls()
+}
+
+// TestVirtualControlsLayout_ChordedTouches tests that simultaneous touches on
+// the D-pad and both action buttons are tracked independently.
+func TestVirtualControlsLayout_ChordedTouches(t *testing.T) {
+	l := NewVirtualControlsLayout(800, 600)
+	dpad := [2]int{int(l.DPad.X + l.DPad.Radius*0.8), int(l.DPad.Y)}
+	action := [2]int{int(l.ActionButton.X), int(l.ActionButton.Y)}
+	secondary := [2]int{int(l.SecondaryButton.X), int(l.SecondaryButton.Y)}
+
+	// Hold right on the D-pad and press both action buttons
+	touchFrame(l, map[ebiten.TouchID][2]int{0: dpad, 1: action})
+	touchFrame(l, map[ebiten.TouchID][2]int{0: dpad, 1: action, 2: secondary})
+	if !l.DPad.IsActive() || !l.ActionButton.IsActive() || !l.SecondaryButton.IsActive() {
+		t.Fatalf("active: dpad %v, action %v, secondary %v; want all",
+			l.DPad.IsActive(), l.ActionButton.IsActive(), l.SecondaryButton.IsActive())
+	}
+	if l.DPad.TouchID != 0 || l.ActionButton.TouchID != 1 || l.SecondaryButton.TouchID != 2 {
+		t.Errorf("touch bindings = %d/%d/%d, want 0/1/2",
+			l.DPad.TouchID, l.ActionButton.TouchID, l.SecondaryButton.TouchID)
+	}
+
+	// Releasing the action button fires it without disturbing the others
+	touchFrame(l, map[ebiten.TouchID][2]int{0: dpad, 2: secondary})
+	if !l.IsActionPressed() || l.IsSecondaryPressed() {
+		t.Errorf("pressed: action %v, secondary %v; want only action", l.IsActionPressed(), l.IsSecondaryPressed())
+	}
+	if x, _ := l.GetMovementInput(); x <= 0 || !l.SecondaryButton.IsActive() {
+		t.Errorf("after action release: movement x %v, secondary active %v", x, l.SecondaryButton.IsActive())
+	}
+
+	// The D-pad touch sliding over the action button stays with the D-pad,
+	// and a new touch on the button is captured independently
+	touchFrame(l, map[ebiten.TouchID][2]int{0: action, 2: secondary, 3: action})
+	if owner, _ := l.touchHandler.TouchOwner(0); owner != TouchControl(l.DPad) {
+		t.Error("D-pad touch was reassigned when it slid over the action button")
+	}
+	if l.ActionButton.TouchID != 3 {
+		t.Errorf("action button holds touch %d, want new touch 3", l.ActionButton.TouchID)
+	}
+
+	// Releasing the D-pad stops movement only
+	touchFrame(l, map[ebiten.TouchID][2]int{2: secondary, 3: action})
+	if l.DPad.IsActive() || !l.ActionButton.IsActive() || !l.SecondaryButton.IsActive() {
+		t.Errorf("after D-pad release: dpad %v, action %v, secondary %v",
+			l.DPad.IsActive(), l.ActionButton.IsActive(), l.SecondaryButton.IsActive())
+	}
+	if _, bound := l.touchHandler.TouchOwner(0); bound {
+		t.Error("released touch is still bound")
+	}
+
+	// Releasing the secondary button fires it alone
+	touchFrame(l, map[ebiten.TouchID][2]int{3: action})
+	if !l.IsSecondaryPressed() || l.IsActionPressed() {
+		t.Errorf("pressed: action %v, secondary %v; want only secondary", l.IsActionPressed(), l.IsSecondaryPressed())
+	}
+}
+
+// TestVirtualControlsLayout_Orientation tests that the D-pad stays
+// bottom-left and the action buttons bottom-right in both orientations.
+func TestVirtualControlsLayout_Orientation(t *testing.T) {
+	sizes := []struct {
+		name          string
+		width, height int
+	}{
+		{"landscape", 1280, 720},
+		{"portrait", 720, 1280},
+		{"tablet", 1024, 768},
+	}
+
+	for _, size := range sizes {
+		t.Run(size.name, func(t *testing.T) {
+			layout := NewVirtualControlsLayout(size.width, size.height)
+			w, h := float64(size.width), float64(size.height)
+
+			if layout.DPad.X >= w/2 || layout.DPad.Y <= h/2 {
+				t.Errorf("D-pad at (%.0f, %.0f), want bottom-left", layout.DPad.X, layout.DPad.Y)
+			}
+			for _, button := range []*VirtualButton{layout.ActionButton, layout.SecondaryButton} {
+				if button.X <= w/2 || button.Y <= h/2 {
+					t.Errorf("%s button at (%.0f, %.0f), want bottom-right", button.Label, button.X, button.Y)
+				}
+				if button.X+button.Radius > w || button.Y+button.Radius > h {
+					t.Errorf("%s button extends off screen", button.Label)
+				}
+			}
+			if menu := layout.MenuButton; menu.X <= w/2 || menu.Y >= h/2 {
+				t.Errorf("menu button at (%.0f, %.0f), want top-right", menu.X, menu.Y)
+			}
+		})
+	}
+}
+
+// TestVirtualControlsLayout_Resize tests repositioning on rotation.
+func TestVirtualControlsLayout_Resize(t *testing.T) {
+	layout := NewVirtualControlsLayout(1280, 720)
+	landscapeRadius := layout.ActionButton.Radius
+
+	layout.Resize(720, 1280)
+	if layout.ActionButton.X > 720 || layout.ActionButton.Y < 640 {
+		t.Errorf("action button at (%.0f, %.0f) after rotating to portrait", layout.ActionButton.X, layout.ActionButton.Y)
+	}
+	if layout.ActionButton.Radius != landscapeRadius {
+		t.Errorf("button radius changed from %.1f to %.1f on rotation", landscapeRadius, layout.ActionButton.Radius)
+	}
+	if layout.DPad.InnerRadius != layout.DPad.Radius*0.3 {
+		t.Error("D-pad dead zone should scale with its radius")
+	}
+}
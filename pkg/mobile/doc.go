@@ -4,7 +4,7 @@
 //   - Touch input detection and gesture recognition (tap, swipe, pinch)
 //   - Virtual controls (D-pad, action buttons)
 //   - Mobile-optimized UI layouts
-//   - Haptic feedback support
+//   - Haptic feedback patterns (Haptics, HapticPattern)
 //   - Orientation handling (portrait/landscape)
 //
 // The mobile package integrates with the engine package to provide feature parity
@@ -25,6 +25,11 @@
 //	    // Handle tap at (x, y)
 //	}
 //
+// Controls are updated through TouchInputHandler.UpdateControl, which binds
+// the touch a control captures to it until that touch ends. Other controls
+// never see a bound touch, so holding the D-pad while tapping action buttons
+// tracks each press independently.
+//
 // # Virtual Controls
 //
 // Virtual on-screen controls provide tactile feedback for games requiring
@@ -37,6 +42,11 @@
 //	dpad.Update()
 //	moveX, moveY := dpad.GetDirection()
 //
+// VirtualControlsLayout places its controls with Layout, which anchors an
+// element to a screen corner, edge or center with an offset and optional
+// percentage sizing, and can switch to a different placement in portrait.
+// Layout.Resolve returns the element's rectangle for a screen size.
+//
 // # Gestures
 //
 // The GestureDetector recognizes common mobile gestures:
@@ -44,8 +54,16 @@
 //   - Double tap (two quick taps)
 //   - Long press (touch held > threshold)
 //   - Swipe (fast directional movement)
+//   - Edge swipe (a swipe that starts at a screen border and moves inward,
+//     reported by GetEdgeSwipe instead of GetSwipe once SetScreenSize is set)
 //   - Pinch (two-finger zoom)
 //
+// Thresholds are tunable with a GestureConfig, e.g. for larger tablets:
+//
+//	config := mobile.DefaultGestureConfig()
+//	config.SwipeMinDistance = 90
+//	detector := mobile.NewGestureDetectorWithConfig(config)
+//
 // # Platform Detection
 //
 // The IsMobilePlatform() function detects iOS and Android at runtime,
@@ -71,18 +71,12 @@ func (l *DualJoystickLayout) Update() {
 
 	// Update touch handler to get all active touches
 	l.touchHandler.Update()
-	touches := make(map[ebiten.TouchID]*Touch)
-	for _, touch := range l.touchHandler.GetActiveTouches() {
-		touches[touch.ID] = touch
-	}
-
-	// Update joysticks (they handle their own touch capture)
-	l.LeftJoystick.Update(touches)
-	l.RightJoystick.Update(touches)
 
-	// Update action buttons
+	// Each control captures its own touch and keeps it until released
+	l.touchHandler.UpdateControl(l.LeftJoystick)
+	l.touchHandler.UpdateControl(l.RightJoystick)
 	for _, button := range l.ActionButtons {
-		button.Update(touches)
+		l.touchHandler.UpdateControl(button)
 	}
 }
 
@@ -306,6 +300,11 @@ func (j *VirtualJoystick) updateDirection(touchX, touchY float64) {
 	j.DirectionY = math.Max(-1.0, math.Min(1.0, j.DirectionY))
 }
 
+// BoundTouch returns the touch controlling the joystick, or -1.
+func (j *VirtualJoystick) BoundTouch() ebiten.TouchID {
+	return j.TouchID
+}
+
 // GetDirection returns the normalized direction vector.
 func (j *VirtualJoystick) GetDirection() (float64, float64) {
 	return j.DirectionX, j.DirectionY
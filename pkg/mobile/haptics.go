@@ -0,0 +1,151 @@
+package mobile
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// HapticStep is one segment of a haptic pattern: a pulse at Intensity for
+// Duration, or a pause when Intensity is 0.
+type HapticStep struct {
+	Duration  time.Duration
+	Intensity float64 // 0.0-1.0
+}
+
+// HapticPattern is a named sequence of haptic steps.
+type HapticPattern struct {
+	Name  string
+	Steps []HapticStep
+}
+
+// Predefined haptic patterns.
+var (
+	// HapticLight is a short, soft tap for minor UI feedback.
+	HapticLight = HapticPattern{Name: "light", Steps: []HapticStep{
+		{Duration: 10 * time.Millisecond, Intensity: 0.3},
+	}}
+	// HapticMedium is a firm tap, e.g. for landing a hit.
+	HapticMedium = HapticPattern{Name: "medium", Steps: []HapticStep{
+		{Duration: 20 * time.Millisecond, Intensity: 0.6},
+	}}
+	// HapticHeavy is a strong thump, e.g. for taking heavy damage.
+	HapticHeavy = HapticPattern{Name: "heavy", Steps: []HapticStep{
+		{Duration: 50 * time.Millisecond, Intensity: 1.0},
+	}}
+	// HapticSuccess is a soft-then-firm double tap for confirmations.
+	HapticSuccess = HapticPattern{Name: "success", Steps: []HapticStep{
+		{Duration: 15 * time.Millisecond, Intensity: 0.5},
+		{Duration: 60 * time.Millisecond},
+		{Duration: 30 * time.Millisecond, Intensity: 0.8},
+	}}
+	// HapticError is three sharp buzzes for failed actions.
+	HapticError = HapticPattern{Name: "error", Steps: []HapticStep{
+		{Duration: 40 * time.Millisecond, Intensity: 1.0},
+		{Duration: 50 * time.Millisecond},
+		{Duration: 40 * time.Millisecond, Intensity: 1.0},
+		{Duration: 50 * time.Millisecond},
+		{Duration: 40 * time.Millisecond, Intensity: 1.0},
+	}}
+)
+
+// HapticFeedback is the former name of HapticPattern.
+//
+// Deprecated: use HapticPattern.
+type HapticFeedback = HapticPattern
+
+// NewHapticPattern creates a custom pattern from steps.
+func NewHapticPattern(name string, steps ...HapticStep) (HapticPattern, error) {
+	pattern := HapticPattern{Name: name, Steps: steps}
+	if err := pattern.Validate(); err != nil {
+		return HapticPattern{}, err
+	}
+	return pattern, nil
+}
+
+// Validate checks that the pattern has at least one pulse and that every
+// step has a positive duration and an intensity between 0 and 1.
+func (p HapticPattern) Validate() error {
+	pulses := 0
+	for i, step := range p.Steps {
+		if step.Duration <= 0 {
+			return fmt.Errorf("haptic pattern %q step %d: duration must be positive, got %v", p.Name, i, step.Duration)
+		}
+		if step.Intensity < 0 || step.Intensity > 1 {
+			return fmt.Errorf("haptic pattern %q step %d: intensity must be between 0 and 1, got %f", p.Name, i, step.Intensity)
+		}
+		if step.Intensity > 0 {
+			pulses++
+		}
+	}
+	if pulses == 0 {
+		return fmt.Errorf("haptic pattern %q has no pulses", p.Name)
+	}
+	return nil
+}
+
+// TotalDuration returns how long the pattern takes to play.
+func (p HapticPattern) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, step := range p.Steps {
+		total += step.Duration
+	}
+	return total
+}
+
+// Haptics plays haptic patterns on the device.
+type Haptics interface {
+	// Play starts a pattern without blocking the caller.
+	Play(pattern HapticPattern)
+}
+
+// NoopHaptics ignores every pattern. It is used on desktop and WASM, and
+// when the player turns haptics off.
+type NoopHaptics struct{}
+
+// Play does nothing.
+func (NoopHaptics) Play(pattern HapticPattern) {}
+
+// NewHaptics returns the haptics implementation for the current platform.
+// Only iOS and Android builds made with ebitenmobile vibrate; everything
+// else gets NoopHaptics.
+func NewHaptics() Haptics {
+	return newPlatformHaptics()
+}
+
+var (
+	hapticsMu      sync.RWMutex
+	defaultHaptics = NewHaptics()
+)
+
+// SetHaptics replaces the implementation used by TriggerHaptic. Pass
+// NoopHaptics{} to disable haptics or nil to restore the platform default.
+func SetHaptics(h Haptics) {
+	if h == nil {
+		h = NewHaptics()
+	}
+	hapticsMu.Lock()
+	defaultHaptics = h
+	hapticsMu.Unlock()
+}
+
+// TriggerHaptic plays a pattern through the implementation set with
+// SetHaptics, the platform default unless replaced.
+func TriggerHaptic(pattern HapticPattern) {
+	hapticsMu.RLock()
+	h := defaultHaptics
+	hapticsMu.RUnlock()
+	h.Play(pattern)
+}
+
+// playHapticSteps runs a pattern in order, calling pulse for each step with
+// a non-zero intensity and sleep after every step. Platform
+// implementations run it on their own goroutine.
+func playHapticSteps(pattern HapticPattern, pulse func(step HapticStep), sleep func(time.Duration)) {
+	for _, step := range pattern.Steps {
+		if step.Intensity > 0 {
+			pulse(step)
+		}
+		sleep(step.Duration)
+	}
+}
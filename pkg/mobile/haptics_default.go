@@ -0,0 +1,10 @@
+//go:build !((ios || android) && cgo && ebitenmobilebind)
+// +build !ios,!android !cgo !ebitenmobilebind
+
+package mobile
+
+// newPlatformHaptics returns NoopHaptics on platforms without a vibration
+// backend (desktop, WASM, and mobile builds without ebitenmobile).
+func newPlatformHaptics() Haptics {
+	return NoopHaptics{}
+}
@@ -0,0 +1,93 @@
+package mobile
+
+import (
+	"testing"
+	"time"
+)
+
+// recordingHaptics records every pattern it is asked to play.
+type recordingHaptics struct {
+	played []string
+}
+
+func (r *recordingHaptics) Play(pattern HapticPattern) {
+	r.played = append(r.played, pattern.Name)
+}
+
+// TestPredefinedHapticPatterns tests that the built-in patterns are valid.
+func TestPredefinedHapticPatterns(t *testing.T) {
+	patterns := []HapticPattern{HapticLight, HapticMedium, HapticHeavy, HapticSuccess, HapticError}
+	for _, pattern := range patterns {
+		if err := pattern.Validate(); err != nil {
+			t.Errorf("%s: %v", pattern.Name, err)
+		}
+	}
+	if HapticHeavy.Steps[0].Intensity <= HapticLight.Steps[0].Intensity {
+		t.Error("heavy pattern should be stronger than light")
+	}
+}
+
+// TestNewHapticPattern tests building and validating custom patterns.
+func TestNewHapticPattern(t *testing.T) {
+	pattern, err := NewHapticPattern("heartbeat",
+		HapticStep{Duration: 30 * time.Millisecond, Intensity: 0.7},
+		HapticStep{Duration: 100 * time.Millisecond},
+		HapticStep{Duration: 30 * time.Millisecond, Intensity: 0.4},
+	)
+	if err != nil {
+		t.Fatalf("NewHapticPattern failed: %v", err)
+	}
+	if got := pattern.TotalDuration(); got != 160*time.Millisecond {
+		t.Errorf("TotalDuration = %v, want 160ms", got)
+	}
+
+	invalid := [][]HapticStep{
+		nil,
+		{{Duration: 50 * time.Millisecond}},
+		{{Duration: 0, Intensity: 0.5}},
+		{{Duration: 10 * time.Millisecond, Intensity: 1.5}},
+		{{Duration: 10 * time.Millisecond, Intensity: -0.1}},
+	}
+	for _, steps := range invalid {
+		if _, err := NewHapticPattern("bad", steps...); err == nil {
+			t.Errorf("steps %+v should be rejected", steps)
+		}
+	}
+}
+
+// TestPlayHapticSteps tests that pulses fire for non-zero steps and that
+// every step, including pauses, is waited out in order.
+func TestPlayHapticSteps(t *testing.T) {
+	var pulses []float64
+	var waited time.Duration
+	playHapticSteps(HapticSuccess, func(step HapticStep) {
+		pulses = append(pulses, step.Intensity)
+	}, func(d time.Duration) {
+		waited += d
+	})
+
+	if len(pulses) != 2 || pulses[0] != 0.5 || pulses[1] != 0.8 {
+		t.Errorf("pulses = %v, want [0.5 0.8]", pulses)
+	}
+	if waited != HapticSuccess.TotalDuration() {
+		t.Errorf("waited %v, want %v", waited, HapticSuccess.TotalDuration())
+	}
+}
+
+// TestSetHaptics tests routing TriggerHaptic through a custom implementation.
+func TestSetHaptics(t *testing.T) {
+	recorder := &recordingHaptics{}
+	SetHaptics(recorder)
+	defer SetHaptics(nil)
+
+	TriggerHaptic(HapticMedium)
+	TriggerHaptic(HapticError)
+	if len(recorder.played) != 2 || recorder.played[0] != "medium" || recorder.played[1] != "error" {
+		t.Errorf("played = %v, want [medium error]", recorder.played)
+	}
+
+	SetHaptics(nil)
+	if _, ok := NewHaptics().(NoopHaptics); !ok && !IsMobilePlatform() {
+		t.Error("desktop builds should use NoopHaptics")
+	}
+}
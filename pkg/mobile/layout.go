@@ -0,0 +1,133 @@
+package mobile
+
+import (
+	"image"
+	"math"
+)
+
+// LayoutAnchor is the point of the screen an element is positioned from.
+type LayoutAnchor int
+
+const (
+	// AnchorTopLeft pins the element's top-left corner to the screen's.
+	AnchorTopLeft LayoutAnchor = iota
+	// AnchorTop centers the element horizontally along the top edge.
+	AnchorTop
+	// AnchorTopRight pins the element's top-right corner to the screen's.
+	AnchorTopRight
+	// AnchorLeft centers the element vertically along the left edge.
+	AnchorLeft
+	// AnchorCenter centers the element on the screen.
+	AnchorCenter
+	// AnchorRight centers the element vertically along the right edge.
+	AnchorRight
+	// AnchorBottomLeft pins the element's bottom-left corner to the screen's.
+	AnchorBottomLeft
+	// AnchorBottom centers the element horizontally along the bottom edge.
+	AnchorBottom
+	// AnchorBottomRight pins the element's bottom-right corner to the screen's.
+	AnchorBottomRight
+)
+
+// String returns the string representation of the anchor.
+func (a LayoutAnchor) String() string {
+	switch a {
+	case AnchorTopLeft:
+		return "TopLeft"
+	case AnchorTop:
+		return "Top"
+	case AnchorTopRight:
+		return "TopRight"
+	case AnchorLeft:
+		return "Left"
+	case AnchorCenter:
+		return "Center"
+	case AnchorRight:
+		return "Right"
+	case AnchorBottomLeft:
+		return "BottomLeft"
+	case AnchorBottom:
+		return "Bottom"
+	case AnchorBottomRight:
+		return "BottomRight"
+	default:
+		return "Unknown"
+	}
+}
+
+// fractions returns where the anchor sits along each axis (0 = left/top,
+// 0.5 = center, 1 = right/bottom).
+func (a LayoutAnchor) fractions() (fx, fy float64) {
+	switch a {
+	case AnchorTop, AnchorCenter, AnchorBottom:
+		fx = 0.5
+	case AnchorTopRight, AnchorRight, AnchorBottomRight:
+		fx = 1
+	}
+	switch a {
+	case AnchorLeft, AnchorCenter, AnchorRight:
+		fy = 0.5
+	case AnchorBottomLeft, AnchorBottom, AnchorBottomRight:
+		fy = 1
+	}
+	return fx, fy
+}
+
+// Layout positions one UI element relative to a screen anchor, so it keeps
+// its place across aspect ratios and orientations.
+//
+// Percentages are fractions of the screen's shorter side, so an element
+// sized 0.3 is the same physical size in portrait and landscape and square
+// elements stay square.
+type Layout struct {
+	// Anchor is the screen point the element is attached to. The element's
+	// matching point (e.g. its bottom-left corner for AnchorBottomLeft) is
+	// placed there.
+	Anchor LayoutAnchor
+
+	// Offset from the anchor in pixels along screen axes (positive is
+	// right/down), plus a fraction of the shorter side
+	OffsetX, OffsetY               float64
+	OffsetXPercent, OffsetYPercent float64
+
+	// Size in pixels. A non-zero percentage replaces the pixel size.
+	Width, Height               float64
+	WidthPercent, HeightPercent float64
+
+	// Portrait, if set, is used instead when the screen is portrait
+	Portrait *Layout
+}
+
+// Resolve returns the element's rectangle on a screen of the given size.
+func (l *Layout) Resolve(screenW, screenH int) image.Rectangle {
+	if l.Portrait != nil && GetOrientation(screenW, screenH) == OrientationPortrait {
+		return l.Portrait.Resolve(screenW, screenH)
+	}
+
+	short := math.Min(float64(screenW), float64(screenH))
+	width := l.Width
+	if l.WidthPercent != 0 {
+		width = l.WidthPercent * short
+	}
+	height := l.Height
+	if l.HeightPercent != 0 {
+		height = l.HeightPercent * short
+	}
+
+	fx, fy := l.Anchor.fractions()
+	x := fx*float64(screenW) - fx*width + l.OffsetX + l.OffsetXPercent*short
+	y := fy*float64(screenH) - fy*height + l.OffsetY + l.OffsetYPercent*short
+
+	minX, minY := int(math.Round(x)), int(math.Round(y))
+	return image.Rect(minX, minY, minX+int(math.Round(width)), minY+int(math.Round(height)))
+}
+
+// ResolveCircle returns the center and radius of a round element (D-pad,
+// button, joystick) laid out as the square Resolve returns.
+func (l *Layout) ResolveCircle(screenW, screenH int) (x, y, radius float64) {
+	rect := l.Resolve(screenW, screenH)
+	x = float64(rect.Min.X+rect.Max.X) / 2
+	y = float64(rect.Min.Y+rect.Max.Y) / 2
+	radius = math.Min(float64(rect.Dx()), float64(rect.Dy())) / 2
+	return x, y, radius
+}
@@ -0,0 +1,75 @@
+package mobile
+
+import (
+	"image"
+	"testing"
+)
+
+// TestLayout_ResolveAnchors tests that each anchor places the element's
+// matching point on the screen's.
+func TestLayout_ResolveAnchors(t *testing.T) {
+	tests := []struct {
+		anchor LayoutAnchor
+		want   image.Rectangle
+	}{
+		{AnchorTopLeft, image.Rect(0, 0, 100, 50)},
+		{AnchorTop, image.Rect(350, 0, 450, 50)},
+		{AnchorTopRight, image.Rect(700, 0, 800, 50)},
+		{AnchorLeft, image.Rect(0, 275, 100, 325)},
+		{AnchorCenter, image.Rect(350, 275, 450, 325)},
+		{AnchorRight, image.Rect(700, 275, 800, 325)},
+		{AnchorBottomLeft, image.Rect(0, 550, 100, 600)},
+		{AnchorBottom, image.Rect(350, 550, 450, 600)},
+		{AnchorBottomRight, image.Rect(700, 550, 800, 600)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.anchor.String(), func(t *testing.T) {
+			layout := &Layout{Anchor: tt.anchor, Width: 100, Height: 50}
+			if got := layout.Resolve(800, 600); got != tt.want {
+				t.Errorf("Resolve = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLayout_OffsetsAndPercentages tests pixel and percentage offsets and
+// percentage sizing against the shorter screen side.
+func TestLayout_OffsetsAndPercentages(t *testing.T) {
+	layout := &Layout{
+		Anchor:         AnchorBottomRight,
+		OffsetX:        -10,
+		OffsetYPercent: -0.1,
+		WidthPercent:   0.25,
+		HeightPercent:  0.25,
+	}
+
+	// Landscape: shorter side is the height (400)
+	if got, want := layout.Resolve(1000, 400), image.Rect(890, 260, 990, 360); got != want {
+		t.Errorf("landscape Resolve = %v, want %v", got, want)
+	}
+	// Portrait: shorter side is the width (400), so the element keeps its size
+	if got, want := layout.Resolve(400, 1000), image.Rect(290, 860, 390, 960); got != want {
+		t.Errorf("portrait Resolve = %v, want %v", got, want)
+	}
+}
+
+// TestLayout_PortraitOverride tests that the portrait layout is used only in
+// portrait orientation.
+func TestLayout_PortraitOverride(t *testing.T) {
+	layout := &Layout{
+		Anchor: AnchorBottomLeft, Width: 50, Height: 50,
+		Portrait: &Layout{Anchor: AnchorLeft, Width: 50, Height: 50},
+	}
+	if got, want := layout.Resolve(800, 600), image.Rect(0, 550, 50, 600); got != want {
+		t.Errorf("landscape Resolve = %v, want %v", got, want)
+	}
+	if got, want := layout.Resolve(600, 800), image.Rect(0, 375, 50, 425); got != want {
+		t.Errorf("portrait Resolve = %v, want %v", got, want)
+	}
+
+	x, y, radius := layout.ResolveCircle(800, 600)
+	if x != 25 || y != 575 || radius != 25 {
+		t.Errorf("ResolveCircle = (%v, %v, %v), want (25, 575, 25)", x, y, radius)
+	}
+}
@@ -107,33 +107,3 @@ func GetOrientation(width, height int) Orientation {
 	}
 	return OrientationUnknown
 }
-
-// HapticFeedback represents haptic feedback intensity.
-type HapticFeedback int
-
-const (
-	// HapticLight represents light haptic feedback.
-	HapticLight HapticFeedback = iota
-	// HapticMedium represents medium haptic feedback.
-	HapticMedium
-	// HapticHeavy represents heavy haptic feedback.
-	HapticHeavy
-)
-
-// TriggerHaptic triggers haptic feedback on mobile devices.
-// Platform-specific implementations should be provided via build tags:
-// - platform_ios.go with //go:build ios tag for iOS Core Haptics
-// - platform_android.go with //go:build android tag for Android Vibrator
-// This default implementation is a no-op for desktop/WASM builds.
-func TriggerHaptic(feedback HapticFeedback) {
-	// Default no-op implementation for non-mobile platforms
-	// Mobile platforms should provide their own implementations via build tags
-	triggerHapticImpl(feedback)
-}
-
-// triggerHapticImpl is the platform-specific implementation.
-// Default implementation is a no-op.
-func triggerHapticImpl(feedback HapticFeedback) {
-	// No-op on desktop/WASM platforms
-	_ = feedback
-}
@@ -23,32 +23,39 @@ package mobile
 // When integrated with Android NDK, this function would:
 // 1. Receive JNIEnv* and activity context from gomobile/Ebiten
 // 2. Call Context.getSystemService(Context.VIBRATOR_SERVICE) via JNI
-// 3. Call Vibrator.vibrate(duration) via JNI
+// 3. Vibrate for durationMs at amplitude (1-255) via JNI
 // 4. Handle API level differences (VibrationEffect for API 26+)
-void triggerAndroidHaptic(int intensity) {
-	// Calculate duration based on intensity
-	// Light: 10ms, Medium: 20ms, Heavy: 50ms
-	int duration = 20;
-	switch(intensity) {
-		case 0: duration = 10; break;
-		case 1: duration = 20; break;
-		case 2: duration = 50; break;
-	}
-
+void triggerAndroidHaptic(int durationMs, int amplitude) {
 	// Full JNI implementation requires Android NDK environment
 	// The following is example code structure for reference:
 	//
 	// Get JNIEnv and activity context from gomobile
 	// Find Context class and getSystemService method
 	// Get Vibrator service
-	// Find Vibrator class and vibrate method
-	// Call vibrate with calculated duration
+	// Find VibrationEffect.createOneShot(durationMs, amplitude) (API 26+)
+	// Call Vibrator.vibrate with the effect, or vibrate(durationMs) below API 26
 	//
 	// This cannot be completed without Android NDK build environment
 	// and runtime access to JNIEnv from Ebiten's gomobile integration
 }
 */
-import "C" // triggerHapticImpl implements platform-specific haptic feedback for Android.
-func triggerHapticImpl(feedback HapticFeedback) {
-	C.triggerAndroidHaptic(C.int(feedback))
+import "C"
+
+import "time"
+
+// androidHaptics plays patterns through the Android Vibrator service.
+type androidHaptics struct{}
+
+// newPlatformHaptics returns the Android vibrator backend.
+func newPlatformHaptics() Haptics {
+	return androidHaptics{}
+}
+
+// Play vibrates each pulse of the pattern on a background goroutine.
+// Intensity maps to the VibrationEffect amplitude range 1-255.
+func (androidHaptics) Play(pattern HapticPattern) {
+	go playHapticSteps(pattern, func(step HapticStep) {
+		amplitude := int(step.Intensity*254) + 1
+		C.triggerAndroidHaptic(C.int(step.Duration/time.Millisecond), C.int(amplitude))
+	}, time.Sleep)
 }
@@ -10,33 +10,44 @@ package mobile
 #cgo LDFLAGS: -framework CoreHaptics -framework UIKit
 #import <UIKit/UIKit.h>
 
-// iOS haptic feedback using UIImpactFeedbackGenerator
-void triggerIOSHaptic(int intensity) {
+// iOS haptic feedback using UIImpactFeedbackGenerator. Impacts are
+// transient, so each pulse is one impact at the given intensity (0-1).
+void triggerIOSHaptic(double intensity) {
 	dispatch_async(dispatch_get_main_queue(), ^{
 		UIImpactFeedbackStyle style;
-		switch(intensity) {
-			case 0: // Light
-				style = UIImpactFeedbackStyleLight;
-				break;
-			case 1: // Medium
-				style = UIImpactFeedbackStyleMedium;
-				break;
-			case 2: // Heavy
-				style = UIImpactFeedbackStyleHeavy;
-				break;
-			default:
-				style = UIImpactFeedbackStyleMedium;
+		if (intensity < 0.4) {
+			style = UIImpactFeedbackStyleLight;
+		} else if (intensity < 0.8) {
+			style = UIImpactFeedbackStyleMedium;
+		} else {
+			style = UIImpactFeedbackStyleHeavy;
 		}
 
 		UIImpactFeedbackGenerator *generator = [[UIImpactFeedbackGenerator alloc] initWithStyle:style];
 		[generator prepare];
-		[generator impactOccurred];
+		if (@available(iOS 13.0, *)) {
+			[generator impactOccurredWithIntensity:intensity];
+		} else {
+			[generator impactOccurred];
+		}
 	});
 }
 */
 import "C"
 
-// triggerHapticImpl implements platform-specific haptic feedback for iOS.
-func triggerHapticImpl(feedback HapticFeedback) {
-	C.triggerIOSHaptic(C.int(feedback))
+import "time"
+
+// iosHaptics plays patterns through UIKit impact feedback.
+type iosHaptics struct{}
+
+// newPlatformHaptics returns the iOS impact feedback backend.
+func newPlatformHaptics() Haptics {
+	return iosHaptics{}
+}
+
+// Play fires one impact per pulse of the pattern on a background goroutine.
+func (iosHaptics) Play(pattern HapticPattern) {
+	go playHapticSteps(pattern, func(step HapticStep) {
+		C.triggerIOSHaptic(C.double(step.Intensity))
+	}, time.Sleep)
 }
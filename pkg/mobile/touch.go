@@ -17,12 +17,25 @@ type Touch struct {
 	Active    bool
 }
 
+// TouchControl is an on-screen control that captures a single touch.
+// VirtualDPad, VirtualButton and VirtualJoystick implement it.
+type TouchControl interface {
+	// Update processes the touches the control may use
+	Update(touches map[ebiten.TouchID]*Touch)
+
+	// BoundTouch returns the touch the control holds, or -1
+	BoundTouch() ebiten.TouchID
+}
+
 // TouchInputHandler manages touch input detection and gesture recognition.
 type TouchInputHandler struct {
 	touches         map[ebiten.TouchID]*Touch
 	lastTapTime     time.Time
 	tapCount        int
 	gestureDetector *GestureDetector
+
+	// owners maps each touch held by a control to that control
+	owners map[ebiten.TouchID]TouchControl
 }
 
 // NewTouchInputHandler creates a new touch input handler.
@@ -30,19 +43,23 @@ func NewTouchInputHandler() *TouchInputHandler {
 	return &TouchInputHandler{
 		touches:         make(map[ebiten.TouchID]*Touch),
 		gestureDetector: NewGestureDetector(),
+		owners:          make(map[ebiten.TouchID]TouchControl),
 	}
 }
 
 // Update processes touch input from Ebiten and updates gesture detection.
 // Must be called every frame.
 func (h *TouchInputHandler) Update() {
-	// Get all active touch IDs
-	activeTouchIDs := ebiten.TouchIDs()
+	h.updateTouches(ebiten.TouchIDs(), ebiten.TouchPosition)
+}
+
+// updateTouches processes the active touch IDs and their positions.
+func (h *TouchInputHandler) updateTouches(activeTouchIDs []ebiten.TouchID, position func(ebiten.TouchID) (int, int)) {
 	activeSet := make(map[ebiten.TouchID]bool)
 
 	// Update existing touches and add new ones
 	for _, id := range activeTouchIDs {
-		x, y := ebiten.TouchPosition(id)
+		x, y := position(id)
 		activeSet[id] = true
 
 		if touch, exists := h.touches[id]; exists {
@@ -72,11 +89,49 @@ func (h *TouchInputHandler) Update() {
 			delete(h.touches, id)
 		}
 	}
+	for id := range h.owners {
+		if !activeSet[id] {
+			delete(h.owners, id)
+		}
+	}
 
 	// Update gesture detector with current touches
 	h.gestureDetector.Update(h.touches)
 }
 
+// SetGestureConfig replaces the gesture detector's thresholds.
+func (h *TouchInputHandler) SetGestureConfig(config GestureConfig) {
+	h.gestureDetector.SetConfig(config)
+}
+
+// UpdateControl updates control with the touch bound to it plus any touch
+// no other control holds, then binds the touch the control captured to it
+// until that touch ends. Updating every control of a layout this way lets
+// simultaneous touches on different controls be tracked independently.
+func (h *TouchInputHandler) UpdateControl(control TouchControl) {
+	touches := make(map[ebiten.TouchID]*Touch, len(h.touches))
+	for id, touch := range h.touches {
+		if owner, bound := h.owners[id]; !bound || owner == control {
+			touches[id] = touch
+		}
+	}
+	previous := control.BoundTouch()
+	control.Update(touches)
+
+	if previous >= 0 && h.owners[previous] == control {
+		delete(h.owners, previous)
+	}
+	if id := control.BoundTouch(); id >= 0 {
+		h.owners[id] = control
+	}
+}
+
+// TouchOwner returns the control holding touch id, if any.
+func (h *TouchInputHandler) TouchOwner(id ebiten.TouchID) (TouchControl, bool) {
+	control, ok := h.owners[id]
+	return control, ok
+}
+
 // GetActiveTouches returns all currently active touches.
 func (h *TouchInputHandler) GetActiveTouches() []*Touch {
 	touches := make([]*Touch, 0, len(h.touches))
@@ -125,12 +180,126 @@ func (h *TouchInputHandler) GetSwipe() (direction, distance float64, detected bo
 	return h.gestureDetector.GetSwipe()
 }
 
+// GetEdgeSwipe returns the screen edge a swipe started from and its
+// distance if an edge swipe was detected this frame.
+func (h *TouchInputHandler) GetEdgeSwipe() (edge ScreenEdge, distance float64, detected bool) {
+	return h.gestureDetector.GetEdgeSwipe()
+}
+
+// SetScreenSize sets the screen size used to detect edge swipes.
+func (h *TouchInputHandler) SetScreenSize(width, height int) {
+	h.gestureDetector.SetScreenSize(width, height)
+}
+
 // GetPinch returns the pinch scale factor if a pinch gesture is active.
 // Returns 1.0 if no pinch detected.
 func (h *TouchInputHandler) GetPinch() float64 {
 	return h.gestureDetector.GetPinchScale()
 }
 
+// GestureConfig holds the thresholds the GestureDetector uses to recognize
+// gestures. Larger screens usually want longer distances.
+type GestureConfig struct {
+	// TapMaxDistance is the most a touch may move (pixels) and still count
+	// as a tap or long press
+	TapMaxDistance float64
+
+	// DoubleTapMaxInterval is the longest gap between two taps that makes a
+	// double tap
+	DoubleTapMaxInterval time.Duration
+
+	// LongPressThreshold is how long a touch must be held to long press
+	LongPressThreshold time.Duration
+
+	// SwipeMinDistance is the shortest movement (pixels) that makes a swipe
+	SwipeMinDistance float64
+
+	// SwipeMinVelocity is the slowest average speed (pixels per second) that
+	// makes a swipe; 0 accepts any speed
+	SwipeMinVelocity float64
+
+	// PinchMinDelta is how far (pixels) the distance between two fingers
+	// must change before the pinch scale moves off 1.0; 0 tracks any change
+	PinchMinDelta float64
+
+	// EdgeThreshold is how close (pixels) to a screen border a swipe must
+	// start to count as an edge swipe instead of a regular swipe
+	EdgeThreshold float64
+}
+
+// ScreenEdge identifies a border of the screen.
+type ScreenEdge int
+
+const (
+	// EdgeNone means no edge
+	EdgeNone ScreenEdge = iota
+	// EdgeTop is the top border
+	EdgeTop
+	// EdgeBottom is the bottom border
+	EdgeBottom
+	// EdgeLeft is the left border
+	EdgeLeft
+	// EdgeRight is the right border
+	EdgeRight
+)
+
+// String returns the string representation of a screen edge.
+func (e ScreenEdge) String() string {
+	switch e {
+	case EdgeTop:
+		return "top"
+	case EdgeBottom:
+		return "bottom"
+	case EdgeLeft:
+		return "left"
+	case EdgeRight:
+		return "right"
+	default:
+		return "none"
+	}
+}
+
+// DefaultGestureConfig returns the thresholds NewGestureDetector uses.
+func DefaultGestureConfig() GestureConfig {
+	return GestureConfig{
+		TapMaxDistance:       20.0,
+		DoubleTapMaxInterval: 300 * time.Millisecond,
+		LongPressThreshold:   500 * time.Millisecond,
+		SwipeMinDistance:     50.0,
+		SwipeMinVelocity:     0,
+		PinchMinDelta:        0,
+		EdgeThreshold:        24.0,
+	}
+}
+
+// withDefaults replaces unusable fields (non-positive thresholds, negative
+// minimums) with their defaults.
+func (c GestureConfig) withDefaults() GestureConfig {
+	defaults := DefaultGestureConfig()
+	if c.TapMaxDistance <= 0 {
+		c.TapMaxDistance = defaults.TapMaxDistance
+	}
+	if c.DoubleTapMaxInterval <= 0 {
+		c.DoubleTapMaxInterval = defaults.DoubleTapMaxInterval
+	}
+	if c.LongPressThreshold <= 0 {
+		c.LongPressThreshold = defaults.LongPressThreshold
+	}
+	if c.SwipeMinDistance <= 0 {
+		c.SwipeMinDistance = defaults.SwipeMinDistance
+	}
+	if c.SwipeMinVelocity < 0 {
+		c.SwipeMinVelocity = defaults.SwipeMinVelocity
+	}
+	if c.PinchMinDelta < 0 {
+		c.PinchMinDelta = defaults.PinchMinDelta
+	}
+	if c.EdgeThreshold <= 0 {
+		c.EdgeThreshold = defaults.EdgeThreshold
+	}
+	return c
+}
+
 // GestureDetector recognizes common mobile gestures.
 type GestureDetector struct {
 	// Tap detection
@@ -151,35 +320,62 @@ type GestureDetector struct {
 	swipeDirection float64 // Radians
 	swipeDistance  float64
 
+	// Edge swipe detection
+	edgeSwipeDetected bool
+	edgeSwipeEdge     ScreenEdge
+	edgeSwipeDistance float64
+	screenWidth       int
+	screenHeight      int
+
 	// Pinch detection
 	pinchActive     bool
 	pinchScale      float64
 	initialDistance float64
 
+	// lastTouch is the single touch seen last frame, used to detect its
+	// release once it disappears from the touch map
+	lastTouch *Touch
+
 	// Configuration
-	tapMaxDistance     float64       // Max movement for tap
-	doubleTapWindow    time.Duration // Time window for double tap
-	longPressThreshold time.Duration // Time for long press
-	swipeMinDistance   float64       // Min distance for swipe
+	config GestureConfig
+
+	// now returns the current time (replaceable in tests)
+	now func() time.Time
 }
 
 // NewGestureDetector creates a new gesture detector with default thresholds.
 func NewGestureDetector() *GestureDetector {
+	return NewGestureDetectorWithConfig(DefaultGestureConfig())
+}
+
+// NewGestureDetectorWithConfig creates a gesture detector with custom
+// thresholds. Unusable fields fall back to DefaultGestureConfig values.
+func NewGestureDetectorWithConfig(config GestureConfig) *GestureDetector {
 	return &GestureDetector{
-		tapMaxDistance:     20.0,
-		doubleTapWindow:    300 * time.Millisecond,
-		longPressThreshold: 500 * time.Millisecond,
-		swipeMinDistance:   50.0,
-		pinchScale:         1.0,
+		config:     config.withDefaults(),
+		pinchScale: 1.0,
+		now:        time.Now,
 	}
 }
 
+// Config returns the detector's thresholds.
+func (g *GestureDetector) Config() GestureConfig {
+	return g.config
+}
+
+// SetConfig replaces the detector's thresholds. Unusable fields fall back
+// to DefaultGestureConfig values.
+func (g *GestureDetector) SetConfig(config GestureConfig) {
+	g.config = config.withDefaults()
+}
+
 // Update processes touches and detects gestures.
 func (g *GestureDetector) Update(touches map[ebiten.TouchID]*Touch) {
 	// Reset frame-specific states
 	g.currentTap = false
 	g.currentDoubleTap = false
 	g.swipeDetected = false
+	g.edgeSwipeDetected = false
 
 	activeTouches := make([]*Touch, 0, len(touches))
 	for _, touch := range touches {
@@ -191,6 +387,12 @@ func (g *GestureDetector) Update(touches map[ebiten.TouchID]*Touch) {
 	touchCount := len(activeTouches)
 
 	if touchCount == 0 {
+		// A single touch that vanished was released this frame
+		if g.lastTouch != nil {
+			g.lastTouch.Active = false
+			g.detectSingleTouchGestures(g.lastTouch)
+			g.lastTouch = nil
+		}
 		g.longPressActive = false
 		g.pinchActive = false
 		g.pinchScale = 1.0
@@ -201,9 +403,14 @@ func (g *GestureDetector) Update(touches map[ebiten.TouchID]*Touch) {
 		// Single touch gestures
 		touch := activeTouches[0]
 		g.detectSingleTouchGestures(touch)
+		released := *touch
+		g.lastTouch = &released
 	} else if touchCount == 2 {
 		// Two-finger gestures (pinch/zoom)
+		g.lastTouch = nil
 		g.detectPinchGesture(activeTouches[0], activeTouches[1])
+	} else {
+		g.lastTouch = nil
 	}
 }
 
@@ -212,37 +419,86 @@ func (g *GestureDetector) detectSingleTouchGestures(touch *Touch) {
 	dx := float64(touch.X - touch.StartX)
 	dy := float64(touch.Y - touch.StartY)
 	distance := math.Sqrt(dx*dx + dy*dy)
-	duration := time.Since(touch.StartTime)
+	now := g.now()
+	duration := now.Sub(touch.StartTime)
 
-	// Tap detection (touch just ended with minimal movement)
-	if !touch.Active && distance <= g.tapMaxDistance {
+	// Tap detection (touch just ended with minimal movement, not held into
+	// a long press)
+	if !touch.Active && distance <= g.config.TapMaxDistance && !g.longPressActive {
 		g.currentTap = true
 		g.lastTapX = touch.X
 		g.lastTapY = touch.Y
 
 		// Double tap detection
-		if time.Since(g.lastTapTime) <= g.doubleTapWindow {
+		if g.tapCount > 0 && now.Sub(g.lastTapTime) <= g.config.DoubleTapMaxInterval {
 			g.currentDoubleTap = true
 			g.tapCount = 0
 		} else {
 			g.tapCount = 1
 		}
-		g.lastTapTime = time.Now()
+		g.lastTapTime = now
 	}
 
 	// Long press detection
-	if touch.Active && duration >= g.longPressThreshold && distance <= g.tapMaxDistance {
+	if touch.Active && duration >= g.config.LongPressThreshold && distance <= g.config.TapMaxDistance {
 		g.longPressActive = true
 		g.longPressX = touch.X
 		g.longPressY = touch.Y
 	}
 
-	// Swipe detection (fast movement then release)
-	if !touch.Active && distance >= g.swipeMinDistance {
-		g.swipeDetected = true
-		g.swipeDistance = distance
-		g.swipeDirection = math.Atan2(dy, dx)
+	// Swipe detection (fast movement then release). Swipes that start at a
+	// screen border and move inward are edge swipes instead.
+	if !touch.Active && distance >= g.config.SwipeMinDistance && g.swipeFastEnough(distance, duration) {
+		if edge := g.swipeEdge(touch.StartX, touch.StartY, dx, dy); edge != EdgeNone {
+			g.edgeSwipeDetected = true
+			g.edgeSwipeEdge = edge
+			g.edgeSwipeDistance = distance
+		} else {
+			g.swipeDetected = true
+			g.swipeDistance = distance
+			g.swipeDirection = math.Atan2(dy, dx)
+		}
+	}
+}
+
+// swipeEdge returns the edge a swipe starting at (x, y) and moving by
+// (dx, dy) came in from: one within EdgeThreshold of the start whose inward
+// direction the swipe follows most. Returns EdgeNone without a screen size.
+func (g *GestureDetector) swipeEdge(x, y int, dx, dy float64) ScreenEdge {
+	if g.screenWidth <= 0 || g.screenHeight <= 0 {
+		return EdgeNone
+	}
+	threshold := g.config.EdgeThreshold
+	candidates := []struct {
+		edge   ScreenEdge
+		near   bool
+		inward float64
+	}{
+		{EdgeTop, float64(y) <= threshold, dy},
+		{EdgeBottom, float64(g.screenHeight-y) <= threshold, -dy},
+		{EdgeLeft, float64(x) <= threshold, dx},
+		{EdgeRight, float64(g.screenWidth-x) <= threshold, -dx},
+	}
+
+	best, bestInward := EdgeNone, 0.0
+	for _, c := range candidates {
+		if c.near && c.inward > bestInward {
+			best, bestInward = c.edge, c.inward
+		}
 	}
+	return best
+}
+
+// swipeFastEnough reports whether a movement of distance over duration
+// meets the minimum swipe velocity.
+func (g *GestureDetector) swipeFastEnough(distance float64, duration time.Duration) bool {
+	if g.config.SwipeMinVelocity <= 0 {
+		return true
+	}
+	if duration <= 0 {
+		return true // Instantaneous movement is as fast as it gets
+	}
+	return distance/duration.Seconds() >= g.config.SwipeMinVelocity
 }
 
 // detectPinchGesture detects pinch/zoom with two fingers.
@@ -258,9 +514,11 @@ func (g *GestureDetector) detectPinchGesture(touch1, touch2 *Touch) {
 		g.initialDistance = currentDistance
 		g.pinchScale = 1.0
 	} else {
-		// Update pinch scale
-		if g.initialDistance > 0 {
+		// Update pinch scale once the fingers have moved far enough
+		if g.initialDistance > 0 && math.Abs(currentDistance-g.initialDistance) >= g.config.PinchMinDelta {
 			g.pinchScale = currentDistance / g.initialDistance
+		} else {
+			g.pinchScale = 1.0
 		}
 	}
 }
@@ -295,6 +553,20 @@ func (g *GestureDetector) GetSwipe() (direction, distance float64, detected bool
 	return g.swipeDirection, g.swipeDistance, g.swipeDetected
 }
 
+// GetEdgeSwipe returns the screen edge a swipe started from and its
+// distance if an edge swipe was detected this frame. Edge swipes are not
+// reported by GetSwipe.
+func (g *GestureDetector) GetEdgeSwipe() (edge ScreenEdge, distance float64, detected bool) {
+	return g.edgeSwipeEdge, g.edgeSwipeDistance, g.edgeSwipeDetected
+}
+
+// SetScreenSize sets the screen size used to detect edge swipes. Until it
+// is set every swipe is a regular swipe.
+func (g *GestureDetector) SetScreenSize(width, height int) {
+	g.screenWidth = width
+	g.screenHeight = height
+}
+
 // GetPinchScale returns the current pinch zoom scale factor.
 // 1.0 = no zoom, >1.0 = zoom in, <1.0 = zoom out.
 func (g *GestureDetector) GetPinchScale() float64 {
@@ -412,3 +412,218 @@ func TestGestureDetector_UpdateWithInactiveTouches(t *testing.T) {
 
 	// Should only process active touches
 }
+
+// gestureClock is a manual clock for feeding timed touch sequences.
+type gestureClock struct{ now time.Time }
+
+func (c *gestureClock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// newTestGestureDetector returns a detector driven by a manual clock.
+func newTestGestureDetector(config GestureConfig) (*GestureDetector, *gestureClock) {
+	clock := &gestureClock{now: time.Unix(1000, 0)}
+	detector := NewGestureDetectorWithConfig(config)
+	detector.now = func() time.Time { return clock.now }
+	return detector, clock
+}
+
+// pressTouch feeds a single touch that starts at (x, y), moves to
+// (endX, endY) after held, and is released on the following frame.
+func pressTouch(detector *GestureDetector, clock *gestureClock, x, y, endX, endY int, held time.Duration) {
+	touch := &Touch{ID: 0, X: x, Y: y, StartX: x, StartY: y, StartTime: clock.now, Active: true}
+	detector.Update(map[ebiten.TouchID]*Touch{0: touch})
+	clock.advance(held)
+	touch.X, touch.Y = endX, endY
+	detector.Update(map[ebiten.TouchID]*Touch{0: touch})
+	detector.Update(map[ebiten.TouchID]*Touch{})
+}
+
+// TestDefaultGestureConfig tests that the default thresholds match NewGestureDetector.
+func TestDefaultGestureConfig(t *testing.T) {
+	config := NewGestureDetector().Config()
+	if config != DefaultGestureConfig() {
+		t.Errorf("NewGestureDetector config = %+v, want defaults", config)
+	}
+	if config.LongPressThreshold != 500*time.Millisecond || config.SwipeMinDistance != 50 {
+		t.Errorf("defaults changed: %+v", config)
+	}
+
+	fixed := NewGestureDetectorWithConfig(GestureConfig{SwipeMinDistance: -5, PinchMinDelta: -1}).Config()
+	if fixed != DefaultGestureConfig() {
+		t.Errorf("invalid config not replaced by defaults: %+v", fixed)
+	}
+}
+
+// TestGestureDetector_ConfiguredLongPress tests the long-press threshold.
+func TestGestureDetector_ConfiguredLongPress(t *testing.T) {
+	config := DefaultGestureConfig()
+	config.LongPressThreshold = time.Second
+	detector, clock := newTestGestureDetector(config)
+
+	touch := &Touch{ID: 0, X: 100, Y: 100, StartX: 100, StartY: 100, StartTime: clock.now, Active: true}
+	touches := map[ebiten.TouchID]*Touch{0: touch}
+
+	clock.advance(900 * time.Millisecond)
+	detector.Update(touches)
+	if detector.IsLongPress() {
+		t.Error("long press fired before the configured threshold")
+	}
+
+	clock.advance(100 * time.Millisecond)
+	detector.Update(touches)
+	if !detector.IsLongPress() {
+		t.Error("long press did not fire at the configured threshold")
+	}
+
+	detector.Update(map[ebiten.TouchID]*Touch{})
+	if detector.IsTap() {
+		t.Error("releasing a long press should not tap")
+	}
+}
+
+// TestGestureDetector_ConfiguredSwipe tests swipe distance and velocity thresholds.
+func TestGestureDetector_ConfiguredSwipe(t *testing.T) {
+	config := DefaultGestureConfig()
+	config.SwipeMinDistance = 120
+	config.SwipeMinVelocity = 1000 // pixels per second
+	detector, clock := newTestGestureDetector(config)
+
+	pressTouch(detector, clock, 0, 0, 100, 0, 50*time.Millisecond)
+	if _, _, detected := detector.GetSwipe(); detected {
+		t.Error("swipe shorter than the configured distance fired")
+	}
+
+	pressTouch(detector, clock, 0, 0, 150, 0, 300*time.Millisecond)
+	if _, _, detected := detector.GetSwipe(); detected {
+		t.Error("swipe slower than the configured velocity fired")
+	}
+
+	pressTouch(detector, clock, 0, 0, 0, 150, 100*time.Millisecond)
+	direction, distance, detected := detector.GetSwipe()
+	if !detected || distance != 150 {
+		t.Fatalf("fast long swipe: detected=%v distance=%v", detected, distance)
+	}
+	if direction < 1.57 || direction > 1.58 {
+		t.Errorf("swipe direction = %v, want down (pi/2)", direction)
+	}
+}
+
+// TestGestureDetector_ConfiguredDoubleTap tests the double-tap interval.
+func TestGestureDetector_ConfiguredDoubleTap(t *testing.T) {
+	config := DefaultGestureConfig()
+	config.DoubleTapMaxInterval = 500 * time.Millisecond
+	detector, clock := newTestGestureDetector(config)
+
+	pressTouch(detector, clock, 50, 50, 50, 50, 50*time.Millisecond)
+	if !detector.IsTap() || detector.IsDoubleTap() {
+		t.Fatal("first tap should be a single tap")
+	}
+
+	// 400ms apart: a double tap under the configured 500ms, not the default 300ms
+	clock.advance(400 * time.Millisecond)
+	pressTouch(detector, clock, 50, 50, 50, 50, 0)
+	if !detector.IsDoubleTap() {
+		t.Error("taps within the configured interval should double tap")
+	}
+
+	clock.advance(600 * time.Millisecond)
+	pressTouch(detector, clock, 50, 50, 50, 50, 0)
+	clock.advance(600 * time.Millisecond)
+	pressTouch(detector, clock, 50, 50, 50, 50, 0)
+	if detector.IsDoubleTap() {
+		t.Error("taps beyond the configured interval should not double tap")
+	}
+}
+
+// TestGestureDetector_ConfiguredPinch tests the pinch minimum delta.
+func TestGestureDetector_ConfiguredPinch(t *testing.T) {
+	config := DefaultGestureConfig()
+	config.PinchMinDelta = 30
+	detector, _ := newTestGestureDetector(config)
+
+	first := &Touch{ID: 0, X: 100, Y: 100, Active: true}
+	second := &Touch{ID: 1, X: 200, Y: 100, Active: true}
+	touches := map[ebiten.TouchID]*Touch{0: first, 1: second}
+	detector.Update(touches)
+
+	second.X = 220 // 20px spread, below the threshold
+	detector.Update(touches)
+	if detector.GetPinchScale() != 1.0 {
+		t.Errorf("pinch scale = %v below the configured delta, want 1.0", detector.GetPinchScale())
+	}
+
+	second.X = 250 // 50px spread
+	detector.Update(touches)
+	if detector.GetPinchScale() != 1.5 {
+		t.Errorf("pinch scale = %v, want 1.5", detector.GetPinchScale())
+	}
+}
+
+// swipeFrom performs a quick swipe from (x, y) to (endX, endY).
+func swipeFrom(detector *GestureDetector, clock *gestureClock, x, y, endX, endY int) {
+	pressTouch(detector, clock, x, y, endX, endY, 100*time.Millisecond)
+}
+
+// TestGestureDetector_EdgeSwipe tests that swipes starting at a screen border
+// are reported as edge swipes, separately from mid-screen swipes.
+func TestGestureDetector_EdgeSwipe(t *testing.T) {
+	tests := []struct {
+		name             string
+		x, y, endX, endY int
+		wantEdge         ScreenEdge
+		wantRegularSwipe bool
+	}{
+		{"up from bottom", 400, 595, 400, 450, EdgeBottom, false},
+		{"down from top", 400, 5, 400, 150, EdgeTop, false},
+		{"right from left", 10, 300, 200, 300, EdgeLeft, false},
+		{"left from right", 790, 300, 600, 300, EdgeRight, false},
+		{"mid-screen", 400, 300, 400, 150, EdgeNone, true},
+		{"outward from bottom", 400, 590, 200, 599, EdgeNone, true},
+		{"bottom-left corner going up", 5, 595, 40, 450, EdgeBottom, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector, clock := newTestGestureDetector(DefaultGestureConfig())
+			detector.SetScreenSize(800, 600)
+			swipeFrom(detector, clock, tt.x, tt.y, tt.endX, tt.endY)
+
+			edge, distance, detected := detector.GetEdgeSwipe()
+			if tt.wantEdge == EdgeNone {
+				if detected {
+					t.Errorf("unexpected %s edge swipe", edge)
+				}
+			} else if !detected || edge != tt.wantEdge || distance < 50 {
+				t.Errorf("edge swipe = %s, %.0f, %v; want %s", edge, distance, detected, tt.wantEdge)
+			}
+			if _, _, swiped := detector.GetSwipe(); swiped != tt.wantRegularSwipe {
+				t.Errorf("regular swipe detected = %v, want %v", swiped, tt.wantRegularSwipe)
+			}
+		})
+	}
+}
+
+// TestGestureDetector_EdgeThreshold tests the configurable edge threshold and
+// that edge swipes need a screen size.
+func TestGestureDetector_EdgeThreshold(t *testing.T) {
+	config := DefaultGestureConfig()
+	config.EdgeThreshold = 60
+	detector, clock := newTestGestureDetector(config)
+	detector.SetScreenSize(800, 600)
+	swipeFrom(detector, clock, 400, 550, 400, 400)
+	if edge, _, detected := detector.GetEdgeSwipe(); !detected || edge != EdgeBottom {
+		t.Errorf("swipe 50px from bottom with 60px threshold: edge %s, detected %v", edge, detected)
+	}
+
+	detector, clock = newTestGestureDetector(DefaultGestureConfig())
+	detector.SetScreenSize(800, 600)
+	swipeFrom(detector, clock, 400, 550, 400, 400)
+	if _, _, detected := detector.GetEdgeSwipe(); detected {
+		t.Error("swipe 50px from bottom should not be an edge swipe with the default threshold")
+	}
+
+	detector, clock = newTestGestureDetector(DefaultGestureConfig())
+	swipeFrom(detector, clock, 400, 595, 400, 450)
+	if _, _, detected := detector.GetEdgeSwipe(); detected {
+		t.Error("edge swipe detected without a screen size")
+	}
+}
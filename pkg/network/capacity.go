@@ -0,0 +1,244 @@
+// Package network provides server capacity handling.
+// This file implements the server status messages that tell a connecting
+// client it was rejected because the server is full, that it is waiting in
+// the join queue, or that it was admitted from the queue. Without them an
+// overflow connection is simply closed and the client cannot tell why.
+package network
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// ServerStatusComponentType is the StateUpdate component type that carries
+// server status messages.
+const ServerStatusComponentType = "server_status"
+
+// ErrServerFull is reported on the client's error channel when the server
+// rejects the connection because every player and queue slot is taken.
+var ErrServerFull = errors.New("server full")
+
+// ErrStateUpdateDropped is returned by SendStateUpdate when the client's
+// send queue is full and the update was discarded.
+var ErrStateUpdateDropped = errors.New("state update dropped: send queue full")
+
+// ServerStatus is the kind of a server status message.
+type ServerStatus uint8
+
+const (
+	// StatusFull means the connection was rejected and will be closed.
+	StatusFull ServerStatus = iota + 1
+
+	// StatusQueued means the connection is waiting for a player slot.
+	StatusQueued
+
+	// StatusAdmitted means a queued connection became a player.
+	StatusAdmitted
+)
+
+// String returns the status name.
+func (s ServerStatus) String() string {
+	switch s {
+	case StatusFull:
+		return "full"
+	case StatusQueued:
+		return "queued"
+	case StatusAdmitted:
+		return "admitted"
+	default:
+		return fmt.Sprintf("status(%d)", uint8(s))
+	}
+}
+
+// serverStatusUpdate builds a status message. position is the 1-based queue
+// position for StatusQueued and 0 otherwise.
+func serverStatusUpdate(status ServerStatus, position int) *StateUpdate {
+	data := make([]byte, 5)
+	data[0] = byte(status)
+	binary.LittleEndian.PutUint32(data[1:], uint32(position))
+	return &StateUpdate{
+		Timestamp:  uint64(time.Now().UnixNano()),
+		Components: []ComponentData{{Type: ServerStatusComponentType, Data: data}},
+		Priority:   PriorityCritical,
+	}
+}
+
+// serverStatusFromUpdate returns the status and queue position carried by
+// update, if it is a status message.
+func serverStatusFromUpdate(update *StateUpdate) (ServerStatus, int, bool) {
+	if update.EntityID != 0 || len(update.Components) != 1 || update.Components[0].Type != ServerStatusComponentType {
+		return 0, 0, false
+	}
+	data := update.Components[0].Data
+	if len(data) != 5 {
+		return 0, 0, false
+	}
+	return ServerStatus(data[0]), int(binary.LittleEndian.Uint32(data[1:])), true
+}
+
+// writeServerStatus writes a status message directly to a connection that
+// has no send loop (rejected or queued connections).
+func (s *TCPServer) writeServerStatus(conn net.Conn, status ServerStatus, position int) error {
+	data, err := s.protocol.EncodeStateUpdate(serverStatusUpdate(status, position))
+	if err != nil {
+		return fmt.Errorf("failed to encode %s status: %w", status, err)
+	}
+
+	frame := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+
+	conn.SetWriteDeadline(time.Now().Add(s.config.WriteTimeout))
+	if _, err := conn.Write(frame); err != nil {
+		return fmt.Errorf("failed to send %s status: %w", status, err)
+	}
+	return nil
+}
+
+// rejectFull tells a connection the server is full and closes it.
+func (s *TCPServer) rejectFull(conn net.Conn) {
+	s.writeServerStatus(conn, StatusFull, 0)
+	conn.Close()
+	s.reportError(fmt.Errorf("server full, rejected connection from %s", conn.RemoteAddr()))
+}
+
+// queuedConn is a connection waiting in the join queue. Its status writes
+// are serialized per connection, so a slow client never blocks the others
+// and a queue position is never written after the admission.
+type queuedConn struct {
+	conn net.Conn
+
+	writeMu  sync.Mutex
+	admitted bool // Set under writeMu once the client has a player slot
+}
+
+// writeQueued sends qc its queue position unless it has been admitted.
+func (s *TCPServer) writeQueued(qc *queuedConn, position int) error {
+	qc.writeMu.Lock()
+	defer qc.writeMu.Unlock()
+	if qc.admitted {
+		return nil
+	}
+	return s.writeServerStatus(qc.conn, StatusQueued, position)
+}
+
+// enqueue adds a connection to the join queue if a queue slot is free.
+// Caller must hold s.clientsMu. Returns the queued connection and its
+// 1-based position, or nil if the queue is full.
+func (s *TCPServer) enqueue(conn net.Conn) (*queuedConn, int) {
+	if len(s.queue) >= s.config.QueueSlots {
+		return nil, 0
+	}
+	qc := &queuedConn{conn: conn}
+	s.queue = append(s.queue, qc)
+	return qc, len(s.queue)
+}
+
+// admitQueued promotes queued connections to players while there is room.
+// An admitted connection that has gone away fails its first read and is
+// disconnected like any other player, admitting the next one.
+func (s *TCPServer) admitQueued() {
+	for {
+		s.clientsMu.Lock()
+		if !s.running || len(s.queue) == 0 || len(s.clients) >= s.config.MaxPlayers {
+			s.clientsMu.Unlock()
+			return
+		}
+		qc := s.queue[0]
+		client := s.registerClient(qc.conn)
+		s.queue = s.queue[1:]
+		s.clientsMu.Unlock()
+
+		// The send loop is not running yet, so the status can be written directly
+		qc.writeMu.Lock()
+		qc.admitted = true
+		s.writeServerStatus(client.conn, StatusAdmitted, 0)
+		qc.writeMu.Unlock()
+
+		s.startClient(client)
+		s.notifyQueuePositions()
+	}
+}
+
+// notifyQueuePositions sends every queued connection its current position.
+// Connections that cannot be reached are closed and removed from the queue.
+func (s *TCPServer) notifyQueuePositions() {
+	s.clientsMu.RLock()
+	queue := append([]*queuedConn(nil), s.queue...)
+	s.clientsMu.RUnlock()
+
+	var dead []*queuedConn
+	for i, qc := range queue {
+		if err := s.writeQueued(qc, i+1); err != nil {
+			dead = append(dead, qc)
+		}
+	}
+	if len(dead) == 0 {
+		return
+	}
+
+	s.clientsMu.Lock()
+	for _, qc := range dead {
+		for i, queued := range s.queue {
+			if queued == qc {
+				qc.conn.Close()
+				s.queue = append(s.queue[:i], s.queue[i+1:]...)
+				break
+			}
+		}
+	}
+	s.clientsMu.Unlock()
+}
+
+// queueLoop periodically refreshes queue positions so waiting clients do
+// not time out and dead queued connections are released.
+func (s *TCPServer) queueLoop() {
+	defer s.wg.Done()
+
+	interval := s.config.ReadTimeout / 2
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.notifyQueuePositions()
+		}
+	}
+}
+
+// closeQueue closes every queued connection.
+func (s *TCPServer) closeQueue() {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+
+	for _, qc := range s.queue {
+		qc.conn.Close()
+	}
+	s.queue = nil
+}
+
+// GetQueueLength returns the number of connections waiting for a player slot.
+func (s *TCPServer) GetQueueLength() int {
+	s.clientsMu.RLock()
+	defer s.clientsMu.RUnlock()
+	return len(s.queue)
+}
+
+// reportError sends err to the error channel, dropping it if the channel is
+// full so the caller never blocks.
+func (s *TCPServer) reportError(err error) {
+	select {
+	case s.errors <- err:
+	default:
+	}
+}
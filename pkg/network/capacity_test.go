@@ -0,0 +1,153 @@
+package network
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// startCapacityServer starts a server on a free loopback port.
+func startCapacityServer(t *testing.T, maxPlayers, queueSlots int) (*TCPServer, string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	config := DefaultServerConfig()
+	config.Address = address
+	config.MaxPlayers = maxPlayers
+	config.QueueSlots = queueSlots
+	server := NewServer(config)
+	if err := server.Start(); err != nil {
+		t.Fatalf("server start: %v", err)
+	}
+	t.Cleanup(func() { server.Stop() })
+	return server, address
+}
+
+// connectCapacityClient connects a client to address.
+func connectCapacityClient(t *testing.T, address string) *TCPClient {
+	t.Helper()
+	config := DefaultClientConfig()
+	config.ServerAddress = address
+	client := NewClient(config)
+	if err := client.Connect(); err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect() })
+	return client
+}
+
+// waitFor polls cond until it holds or the timeout passes.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// expectServerFull waits for client to report ErrServerFull.
+func expectServerFull(t *testing.T, client *TCPClient) {
+	t.Helper()
+	select {
+	case err := <-client.ReceiveError():
+		if !errors.Is(err, ErrServerFull) {
+			t.Errorf("expected ErrServerFull, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("overflow client was never rejected")
+	}
+}
+
+func TestServerStatus_Encoding(t *testing.T) {
+	update := serverStatusUpdate(StatusQueued, 3)
+	status, position, ok := serverStatusFromUpdate(update)
+	if !ok || status != StatusQueued || position != 3 {
+		t.Errorf("decoded (%v, %d, %v), want (queued, 3, true)", status, position, ok)
+	}
+
+	if _, _, ok := serverStatusFromUpdate(reliableStateUpdate([]byte{0, 0, 0, 0, 0, 0, 0, 0})); ok {
+		t.Error("reliable packets are not status messages")
+	}
+	if StatusFull.String() != "full" || ServerStatus(99).String() != "status(99)" {
+		t.Error("unexpected status names")
+	}
+}
+
+func TestTCPServer_RejectsOverflowConnections(t *testing.T) {
+	const maxPlayers = 2
+	server, address := startCapacityServer(t, maxPlayers, 0)
+
+	for i := 0; i < maxPlayers; i++ {
+		connectCapacityClient(t, address)
+		select {
+		case <-server.ReceivePlayerJoin():
+		case <-time.After(2 * time.Second):
+			t.Fatalf("player %d never joined", i+1)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		expectServerFull(t, connectCapacityClient(t, address))
+	}
+
+	if count := server.GetPlayerCount(); count != maxPlayers {
+		t.Errorf("player count = %d, want %d", count, maxPlayers)
+	}
+	select {
+	case id := <-server.ReceivePlayerJoin():
+		t.Errorf("overflow connection joined as player %d", id)
+	default:
+	}
+}
+
+func TestTCPServer_QueueAdmitsWhenSlotFrees(t *testing.T) {
+	server, address := startCapacityServer(t, 1, 1)
+
+	first := connectCapacityClient(t, address)
+	<-server.ReceivePlayerJoin()
+
+	queued := connectCapacityClient(t, address)
+	waitFor(t, "queue position", func() bool { return queued.QueuePosition() == 1 })
+	if server.GetQueueLength() != 1 {
+		t.Errorf("queue length = %d, want 1", server.GetQueueLength())
+	}
+
+	expectServerFull(t, connectCapacityClient(t, address))
+
+	first.Disconnect()
+	select {
+	case <-server.ReceivePlayerJoin():
+	case <-time.After(2 * time.Second):
+		t.Fatal("queued client was never admitted")
+	}
+	waitFor(t, "admission", func() bool { return queued.QueuePosition() == 0 })
+	if server.GetQueueLength() != 0 {
+		t.Errorf("queue length = %d after admission, want 0", server.GetQueueLength())
+	}
+}
+
+func TestTCPServer_StalledQueuedClientDoesNotBlockQueue(t *testing.T) {
+	server, address := startCapacityServer(t, 1, 2)
+	connectCapacityClient(t, address)
+	<-server.ReceivePlayerJoin()
+
+	// A queued connection that never reads blocks writes to it
+	stalled, peer := net.Pipe()
+	t.Cleanup(func() { peer.Close() })
+	server.clientsMu.Lock()
+	server.enqueue(stalled)
+	server.clientsMu.Unlock()
+	go server.notifyQueuePositions()
+
+	queued := connectCapacityClient(t, address)
+	waitFor(t, "queue position", func() bool { return queued.QueuePosition() == 2 })
+}
@@ -19,6 +19,11 @@ type ClientConfig struct {
 	PingInterval      time.Duration // Interval between ping messages
 	MaxLatency        time.Duration // Maximum acceptable latency before warnings
 	BufferSize        int           // Size of send/receive buffers
+
+	// ReliableTimeout is how long a reliable message waits for an ack
+	// before it is resent (DefaultReliableTimeout if zero, at least
+	// MinReliableTimeout)
+	ReliableTimeout time.Duration
 }
 
 // DefaultClientConfig returns a client configuration with sensible defaults.
@@ -29,9 +34,14 @@ func DefaultClientConfig() ClientConfig {
 		PingInterval:      1 * time.Second,
 		MaxLatency:        500 * time.Millisecond,
 		BufferSize:        256,
+
+		ReliableTimeout: DefaultReliableTimeout,
 	}
 }
 
+// clientWorldSnapshots is how many rebuilt world snapshots the client keeps.
+const clientWorldSnapshots = 8
+
 // TCPClient handles client-side networking over TCP.
 // Implements ClientConnection interface.
 type TCPClient struct {
@@ -43,14 +53,27 @@ type TCPClient struct {
 	connected bool
 	playerID  uint64
 
+	// Position in the server's join queue (0 = not queued)
+	queuePosition int
+
 	// Sequence tracking
 	inputSeq uint32
 	stateSeq uint32
 
 	// Channels for async communication
-	stateUpdates chan *StateUpdate
-	inputQueue   chan *InputCommand
-	errors       chan error
+	stateUpdates     chan *StateUpdate
+	reliableMessages chan *ReliableMessage
+	inputQueue       chan *InputCommand
+	errors           chan error
+
+	// Reliable ordered messages, carried on the input stream
+	reliable *ReliableChannel
+
+	// Server world state rebuilt from world updates as they arrive
+	world *SnapshotManager
+
+	// Predictor whose reconciliation metrics are reported (optional)
+	predictor *ClientPredictor
 
 	// Latency tracking
 	latency  time.Duration
@@ -83,15 +106,19 @@ func NewClientWithLogger(config ClientConfig, logger *logrus.Logger) *TCPClient
 		})
 	}
 
-	return &TCPClient{
-		config:       config,
-		protocol:     NewBinaryProtocol(),
-		stateUpdates: make(chan *StateUpdate, config.BufferSize),
-		inputQueue:   make(chan *InputCommand, config.BufferSize),
-		errors:       make(chan error, 16),
-		done:         make(chan struct{}),
-		logger:       logEntry,
+	c := &TCPClient{
+		config:           config,
+		protocol:         NewBinaryProtocol(),
+		stateUpdates:     make(chan *StateUpdate, config.BufferSize),
+		reliableMessages: make(chan *ReliableMessage, config.BufferSize),
+		inputQueue:       make(chan *InputCommand, config.BufferSize),
+		errors:           make(chan error, 16),
+		world:            NewSnapshotManager(clientWorldSnapshots),
+		done:             make(chan struct{}),
+		logger:           logEntry,
 	}
+	c.reliable = NewReliableChannel(config.ReliableTimeout, c.transmitReliable)
+	return c
 }
 
 // Connect establishes connection to the server.
@@ -192,6 +219,36 @@ func (c *TCPClient) GetLatency() time.Duration {
 	return c.latency
 }
 
+// QueuePosition returns the client's 1-based position in the server's join
+// queue, or 0 if the client is not waiting for a player slot.
+func (c *TCPClient) QueuePosition() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.queuePosition
+}
+
+// SetPredictor sets the client-side predictor whose reconciliation metrics
+// GetReconciliationStats reports.
+func (c *TCPClient) SetPredictor(predictor *ClientPredictor) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.predictor = predictor
+}
+
+// GetReconciliationStats returns how often and how far server state has
+// corrected the client's predictions. Returns zero stats if no predictor
+// is set.
+func (c *TCPClient) GetReconciliationStats() ReconciliationStats {
+	c.mu.RLock()
+	predictor := c.predictor
+	c.mu.RUnlock()
+
+	if predictor == nil {
+		return ReconciliationStats{}
+	}
+	return predictor.GetReconciliationStats()
+}
+
 // SendInput queues an input command to send to the server.
 func (c *TCPClient) SendInput(inputType string, data []byte) error {
 	c.mu.Lock()
@@ -220,11 +277,35 @@ func (c *TCPClient) SendInput(inputType string, data []byte) error {
 	}
 }
 
+// SendReliable sends a message to the server over the reliable channel. The
+// message is resent until the server acknowledges it and is delivered
+// exactly once, in order with other reliable messages.
+func (c *TCPClient) SendReliable(msgType string, data []byte) error {
+	if !c.IsConnected() {
+		return fmt.Errorf("not connected")
+	}
+
+	_, err := c.reliable.Send(msgType, data)
+	return err
+}
+
 // ReceiveStateUpdate returns a channel for receiving state updates from the server.
 func (c *TCPClient) ReceiveStateUpdate() <-chan *StateUpdate {
 	return c.stateUpdates
 }
 
+// WorldSnapshot returns the server's world state rebuilt from the world
+// updates received so far, or nil before the first keyframe arrives.
+func (c *TCPClient) WorldSnapshot() *WorldSnapshot {
+	return c.world.GetLatestSnapshot()
+}
+
+// ReceiveReliable returns a channel for receiving reliable messages from the
+// server, in the order they were sent.
+func (c *TCPClient) ReceiveReliable() <-chan *ReliableMessage {
+	return c.reliableMessages
+}
+
 // ReceiveError returns a channel for receiving errors.
 func (c *TCPClient) ReceiveError() <-chan error {
 	return c.errors
@@ -275,11 +356,59 @@ func (c *TCPClient) receiveLoop() {
 			continue
 		}
 
+		// Server status: rejection or join queue progress
+		if status, position, ok := serverStatusFromUpdate(update); ok {
+			if status == StatusFull {
+				if c.logger != nil {
+					c.logger.Warn("server is full, connection rejected")
+				}
+				select {
+				case c.errors <- fmt.Errorf("connection to %s rejected: %w", c.config.ServerAddress, ErrServerFull):
+				case <-c.done:
+				}
+				return
+			}
+			c.mu.Lock()
+			c.queuePosition = position
+			c.mu.Unlock()
+			if c.logger != nil {
+				c.logger.WithField("position", position).Info(status.String())
+			}
+			continue
+		}
+
+		// Reliable packets go through the reliable channel; the messages it
+		// releases are delivered in order and never dropped
+		if packet, ok := reliablePacketFromUpdate(update); ok {
+			messages, err := c.reliable.Receive(packet)
+			if err != nil {
+				c.errors <- fmt.Errorf("reliable packet error: %w", err)
+				continue
+			}
+			for _, msg := range messages {
+				select {
+				case c.reliableMessages <- msg:
+				case <-c.done:
+					return
+				}
+			}
+			continue
+		}
+
 		// Update sequence number
 		c.mu.Lock()
 		c.stateSeq = update.SequenceNumber
 		c.mu.Unlock()
 
+		// World updates are applied here rather than by the channel reader
+		// so none is lost when the channel is full; a delta whose baseline
+		// is missing is dropped and the world resyncs on the next keyframe
+		if update.EntityID == WorldUpdateEntityID {
+			if _, err := c.world.ApplyStateUpdate(update); err != nil && c.logger != nil {
+				c.logger.WithError(err).Debug("dropped world update until the next keyframe")
+			}
+		}
+
 		// Send to channel (non-blocking)
 		select {
 		case c.stateUpdates <- update:
@@ -291,18 +420,41 @@ func (c *TCPClient) receiveLoop() {
 	}
 }
 
-// sendLoop continuously sends queued inputs to the server.
+// transmitReliable queues a reliable channel packet as an input command.
+// Packets dropped because the queue is full are resent by the channel.
+func (c *TCPClient) transmitReliable(packet []byte) {
+	cmd := &InputCommand{
+		PlayerID:  c.GetPlayerID(),
+		Timestamp: uint64(time.Now().UnixNano()),
+		InputType: ReliableInputType,
+		Data:      packet,
+	}
+
+	select {
+	case c.inputQueue <- cmd:
+	default:
+	}
+}
+
+// sendLoop continuously sends queued inputs to the server and retransmits
+// unacknowledged reliable messages.
 func (c *TCPClient) sendLoop() {
 	defer c.wg.Done()
 
 	pingTicker := time.NewTicker(c.config.PingInterval)
 	defer pingTicker.Stop()
 
+	retransmitTicker := time.NewTicker(c.reliable.GetTimeout() / 2)
+	defer retransmitTicker.Stop()
+
 	for {
 		select {
 		case <-c.done:
 			return
 
+		case now := <-retransmitTicker.C:
+			c.reliable.Retransmit(now)
+
 		case <-pingTicker.C:
 			// Send ping (empty input with type "ping")
 			c.mu.Lock()
@@ -72,6 +72,24 @@ func TestClient_GetLatency(t *testing.T) {
 	}
 }
 
+// TestClient_GetReconciliationStats verifies stats come from the predictor.
+func TestClient_GetReconciliationStats(t *testing.T) {
+	client := NewClient(DefaultClientConfig())
+
+	if stats := client.GetReconciliationStats(); stats != (ReconciliationStats{}) {
+		t.Errorf("Expected zero stats without a predictor, got %+v", stats)
+	}
+
+	predictor := NewClientPredictor()
+	predictor.PredictInput(100, 0, 0.05)
+	predictor.ReconcileServerState(1, Position{X: 10}, Velocity{})
+	client.SetPredictor(predictor)
+
+	if stats := client.GetReconciliationStats(); stats.Corrections != 1 {
+		t.Errorf("Expected 1 correction from the predictor, got %+v", stats)
+	}
+}
+
 // TestClient_IsConnected verifies connection state.
 func TestClient_IsConnected(t *testing.T) {
 	config := DefaultClientConfig()
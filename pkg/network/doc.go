@@ -12,4 +12,5 @@
 // - Entity interpolation for smooth movement
 // - Lag compensation for fair hit detection
 // - Delta compression for bandwidth efficiency
+// - Per-recipient update prioritization under byte budgets
 package network
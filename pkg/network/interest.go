@@ -0,0 +1,85 @@
+// Package network provides area-of-interest filtering.
+// This file implements InterestManager, which trims a world snapshot down
+// to the entities near one player before it is delta-encoded and sent.
+// Filtering per client saves bandwidth in large worlds and keeps clients
+// from learning about entities they should not be able to see.
+package network
+
+import (
+	"sync"
+
+	"github.com/opd-ai/venture/pkg/engine"
+)
+
+// DefaultInterestRadius is the default area-of-interest radius in pixels,
+// a little over one screen from the player.
+const DefaultInterestRadius = 1000.0
+
+// SpatialIndex finds entities near a point. engine.Quadtree and
+// engine.SpatialPartitionSystem both implement it.
+type SpatialIndex interface {
+	QueryRadius(x, y, radius float64) []*engine.Entity
+}
+
+// InterestManager filters world snapshots to each player's area of
+// interest using an engine spatial index.
+type InterestManager struct {
+	mu     sync.RWMutex
+	index  SpatialIndex
+	radius float64
+}
+
+// NewInterestManager creates an interest manager that queries index. The
+// caller keeps index up to date (e.g. rebuilding a Quadtree each tick). A
+// radius of 0 or less disables filtering.
+func NewInterestManager(index SpatialIndex, radius float64) *InterestManager {
+	return &InterestManager{
+		index:  index,
+		radius: radius,
+	}
+}
+
+// SetRadius sets the area-of-interest radius in pixels.
+func (im *InterestManager) SetRadius(radius float64) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	im.radius = radius
+}
+
+// GetRadius returns the area-of-interest radius in pixels.
+func (im *InterestManager) GetRadius() float64 {
+	im.mu.RLock()
+	defer im.mu.RUnlock()
+	return im.radius
+}
+
+// FilterSnapshot returns the part of snapshot the viewer entity is
+// interested in: the viewer itself and every entity within the radius of
+// it. The result keeps the snapshot's sequence and timestamp so it can be
+// passed to SnapshotManager.Delta.
+//
+// When the viewer has no position in the snapshot (e.g. a player whose
+// entity has not spawned yet), there is no index, or filtering is
+// disabled, the full snapshot is returned.
+func (im *InterestManager) FilterSnapshot(snapshot WorldSnapshot, viewerID uint64) WorldSnapshot {
+	im.mu.RLock()
+	index, radius := im.index, im.radius
+	im.mu.RUnlock()
+
+	viewer, ok := snapshot.Entities[viewerID]
+	if !ok || index == nil || radius <= 0 {
+		return snapshot
+	}
+
+	filtered := WorldSnapshot{
+		Timestamp: snapshot.Timestamp,
+		Sequence:  snapshot.Sequence,
+		Entities:  map[uint64]EntitySnapshot{viewerID: viewer},
+	}
+	for _, entity := range index.QueryRadius(viewer.Position.X, viewer.Position.Y, radius) {
+		if entitySnapshot, ok := snapshot.Entities[entity.ID]; ok {
+			filtered.Entities[entity.ID] = entitySnapshot
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,128 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opd-ai/venture/pkg/engine"
+)
+
+// newInterestWorld creates positioned entities in a quadtree and a matching
+// snapshot. Returns the quadtree and snapshot.
+func newInterestWorld(positions map[uint64]Position) (*engine.Quadtree, WorldSnapshot) {
+	quadtree := engine.NewQuadtree(engine.Bounds{Width: 10000, Height: 10000}, 4)
+	snapshot := WorldSnapshot{
+		Timestamp: time.Unix(1000, 0),
+		Sequence:  7,
+		Entities:  make(map[uint64]EntitySnapshot),
+	}
+	for id, pos := range positions {
+		entity := engine.NewEntity(id)
+		entity.AddComponent(&engine.PositionComponent{X: pos.X, Y: pos.Y})
+		quadtree.Insert(entity)
+		snapshot.Entities[id] = EntitySnapshot{EntityID: id, Position: pos}
+	}
+	return quadtree, snapshot
+}
+
+func TestInterestManager_FilterSnapshot(t *testing.T) {
+	quadtree, snapshot := newInterestWorld(map[uint64]Position{
+		1: {X: 1000, Y: 1000}, // viewer
+		2: {X: 1200, Y: 1000}, // near
+		3: {X: 1000, Y: 1450}, // near
+		4: {X: 3000, Y: 1000}, // far
+		5: {X: 5000, Y: 5000}, // far
+	})
+	im := NewInterestManager(quadtree, 500)
+
+	filtered := im.FilterSnapshot(snapshot, 1)
+
+	if filtered.Sequence != snapshot.Sequence || !filtered.Timestamp.Equal(snapshot.Timestamp) {
+		t.Error("filtered snapshot should keep sequence and timestamp")
+	}
+	for _, id := range []uint64{1, 2, 3} {
+		if _, ok := filtered.Entities[id]; !ok {
+			t.Errorf("entity %d within radius was filtered out", id)
+		}
+	}
+	for _, id := range []uint64{4, 5} {
+		if _, ok := filtered.Entities[id]; ok {
+			t.Errorf("entity %d outside radius was included", id)
+		}
+	}
+	if len(snapshot.Entities) != 5 {
+		t.Error("FilterSnapshot should not modify the input snapshot")
+	}
+}
+
+func TestInterestManager_DegradesWithoutPosition(t *testing.T) {
+	quadtree, snapshot := newInterestWorld(map[uint64]Position{
+		1: {X: 100, Y: 100},
+		2: {X: 4000, Y: 4000},
+	})
+
+	// Viewer not in the snapshot (no position yet)
+	if got := NewInterestManager(quadtree, 500).FilterSnapshot(snapshot, 99); len(got.Entities) != 2 {
+		t.Errorf("viewer without position got %d entities, want all 2", len(got.Entities))
+	}
+
+	// Filtering disabled
+	if got := NewInterestManager(quadtree, 0).FilterSnapshot(snapshot, 1); len(got.Entities) != 2 {
+		t.Errorf("disabled filter returned %d entities, want all 2", len(got.Entities))
+	}
+
+	// No spatial index
+	if got := NewInterestManager(nil, 500).FilterSnapshot(snapshot, 1); len(got.Entities) != 2 {
+		t.Errorf("filter without index returned %d entities, want all 2", len(got.Entities))
+	}
+}
+
+func TestInterestManager_SetRadius(t *testing.T) {
+	quadtree, snapshot := newInterestWorld(map[uint64]Position{
+		1: {X: 1000, Y: 1000},
+		2: {X: 1800, Y: 1000},
+	})
+	im := NewInterestManager(quadtree, 500)
+
+	if _, ok := im.FilterSnapshot(snapshot, 1).Entities[2]; ok {
+		t.Error("entity at 800px should be outside a 500px radius")
+	}
+
+	im.SetRadius(1000)
+	if im.GetRadius() != 1000 {
+		t.Errorf("GetRadius = %f, want 1000", im.GetRadius())
+	}
+	if _, ok := im.FilterSnapshot(snapshot, 1).Entities[2]; !ok {
+		t.Error("entity at 800px should be inside a 1000px radius")
+	}
+}
+
+func TestInterestManager_DeltaRemovesEntitiesLeavingArea(t *testing.T) {
+	quadtree, snapshot := newInterestWorld(map[uint64]Position{
+		1: {X: 1000, Y: 1000},
+		2: {X: 1100, Y: 1000},
+	})
+	im := NewInterestManager(quadtree, 500)
+	sm := NewSnapshotManager(10)
+
+	baseline, err := ApplyStateUpdate(nil, Keyframe(im.FilterSnapshot(snapshot, 1)))
+	if err != nil {
+		t.Fatalf("apply keyframe: %v", err)
+	}
+	if len(baseline.Entities) != 2 {
+		t.Fatalf("baseline has %d entities, want 2", len(baseline.Entities))
+	}
+
+	// Entity 2 moves away
+	moved, next := newInterestWorld(map[uint64]Position{
+		1: {X: 1000, Y: 1000},
+		2: {X: 4000, Y: 1000},
+	})
+	im = NewInterestManager(moved, 500)
+	next.Sequence = snapshot.Sequence + 1
+
+	update := sm.Delta(*baseline, im.FilterSnapshot(next, 1))
+	if got := countRecords(update, DeltaComponentRemoved); got != 1 {
+		t.Errorf("removed records = %d, want 1 for the entity leaving the area", got)
+	}
+}
@@ -42,12 +42,25 @@ type ClientConnection interface {
 	// GetLatency returns the current network latency
 	GetLatency() time.Duration
 
+	// GetReconciliationStats returns client-side prediction correction metrics
+	GetReconciliationStats() ReconciliationStats
+
 	// SendInput sends an input command to the server
 	SendInput(inputType string, data []byte) error
 
+	// SendReliable sends a message to the server with guaranteed, ordered delivery
+	SendReliable(msgType string, data []byte) error
+
 	// ReceiveStateUpdate returns a channel for receiving state updates
 	ReceiveStateUpdate() <-chan *StateUpdate
 
+	// WorldSnapshot returns the server's world state rebuilt from the world
+	// updates received so far, or nil before the first keyframe
+	WorldSnapshot() *WorldSnapshot
+
+	// ReceiveReliable returns a channel for receiving reliable messages
+	ReceiveReliable() <-chan *ReliableMessage
+
 	// ReceiveError returns a channel for receiving errors
 	ReceiveError() <-chan error
 }
@@ -76,9 +89,15 @@ type ServerConnection interface {
 	// SendStateUpdate sends a state update to a specific player
 	SendStateUpdate(playerID uint64, update *StateUpdate) error
 
+	// SendReliable sends a message to a specific player with guaranteed, ordered delivery
+	SendReliable(playerID uint64, msgType string, data []byte) error
+
 	// ReceiveInputCommand returns a channel for receiving input commands
 	ReceiveInputCommand() <-chan *InputCommand
 
+	// ReceiveReliable returns a channel for receiving reliable messages
+	ReceiveReliable() <-chan *ReliableMessage
+
 	// ReceivePlayerJoin returns a channel for player connection events
 	ReceivePlayerJoin() <-chan uint64
 
@@ -18,6 +18,7 @@ type MockClient struct {
 	Connected bool
 	PlayerID  uint64
 	Latency   time.Duration
+	Stats     ReconciliationStats // Returned by GetReconciliationStats()
 
 	// Recording
 	ConnectCalls    int
@@ -27,10 +28,15 @@ type MockClient struct {
 		Type string
 		Data []byte
 	}
+	SentReliable []*ReliableMessage
 
 	// Channels
-	stateUpdates chan *StateUpdate
-	errors       chan error
+	stateUpdates     chan *StateUpdate
+	reliableMessages chan *ReliableMessage
+	errors           chan error
+
+	// World state rebuilt from simulated world updates
+	world *SnapshotManager
 
 	// Thread safety
 	mu sync.RWMutex
@@ -39,9 +45,11 @@ type MockClient struct {
 // NewMockClient creates a new mock client for testing.
 func NewMockClient() *MockClient {
 	return &MockClient{
-		stateUpdates: make(chan *StateUpdate, 16),
-		errors:       make(chan error, 16),
-		Latency:      50 * time.Millisecond, // Default simulated latency
+		stateUpdates:     make(chan *StateUpdate, 16),
+		reliableMessages: make(chan *ReliableMessage, 16),
+		errors:           make(chan error, 16),
+		world:            NewSnapshotManager(clientWorldSnapshots),
+		Latency:          50 * time.Millisecond, // Default simulated latency
 	}
 }
 
@@ -111,6 +119,13 @@ func (m *MockClient) GetLatency() time.Duration {
 	return m.Latency
 }
 
+// GetReconciliationStats implements ClientConnection.
+func (m *MockClient) GetReconciliationStats() ReconciliationStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.Stats
+}
+
 // SendInput implements ClientConnection.
 func (m *MockClient) SendInput(inputType string, data []byte) error {
 	m.mu.Lock()
@@ -138,19 +153,58 @@ func (m *MockClient) SendInput(inputType string, data []byte) error {
 	return nil
 }
 
+// SendReliable implements ClientConnection.
+func (m *MockClient) SendReliable(msgType string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.SendInputError != nil {
+		return m.SendInputError
+	}
+
+	if !m.Connected {
+		return fmt.Errorf("not connected")
+	}
+
+	// Record the message
+	m.SentReliable = append(m.SentReliable, &ReliableMessage{
+		PlayerID: m.PlayerID,
+		Sequence: uint32(len(m.SentReliable) + 1),
+		Type:     msgType,
+		Data:     append([]byte(nil), data...), // Copy data
+	})
+
+	return nil
+}
+
 // ReceiveStateUpdate implements ClientConnection.
 func (m *MockClient) ReceiveStateUpdate() <-chan *StateUpdate {
 	return m.stateUpdates
 }
 
+// WorldSnapshot implements ClientConnection.
+func (m *MockClient) WorldSnapshot() *WorldSnapshot {
+	return m.world.GetLatestSnapshot()
+}
+
+// ReceiveReliable implements ClientConnection.
+func (m *MockClient) ReceiveReliable() <-chan *ReliableMessage {
+	return m.reliableMessages
+}
+
 // ReceiveError implements ClientConnection.
 func (m *MockClient) ReceiveError() <-chan error {
 	return m.errors
 }
 
 // SimulateStateUpdate injects a state update for testing.
-// Use this to simulate server messages in tests.
+// Use this to simulate server messages in tests. World updates are also
+// applied to the state returned by WorldSnapshot, like TCPClient does.
 func (m *MockClient) SimulateStateUpdate(update *StateUpdate) {
+	if update.EntityID == WorldUpdateEntityID {
+		m.world.ApplyStateUpdate(update)
+	}
+
 	select {
 	case m.stateUpdates <- update:
 	default:
@@ -158,6 +212,16 @@ func (m *MockClient) SimulateStateUpdate(update *StateUpdate) {
 	}
 }
 
+// SimulateReliableMessage injects a reliable message for testing.
+// Use this to simulate reliable server messages in tests.
+func (m *MockClient) SimulateReliableMessage(msg *ReliableMessage) {
+	select {
+	case m.reliableMessages <- msg:
+	default:
+		// Channel full - drop message
+	}
+}
+
 // SimulateError injects an error for testing.
 // Use this to simulate network errors in tests.
 func (m *MockClient) SimulateError(err error) {
@@ -204,10 +268,12 @@ func (m *MockClient) Reset() {
 
 	m.Connected = false
 	m.PlayerID = 0
+	m.Stats = ReconciliationStats{}
 	m.ConnectCalls = 0
 	m.DisconnectCalls = 0
 	m.SendInputCalls = 0
 	m.SentInputs = nil
+	m.SentReliable = nil
 	m.ConnectError = nil
 	m.DisconnectError = nil
 	m.SendInputError = nil
@@ -216,6 +282,9 @@ func (m *MockClient) Reset() {
 	for len(m.stateUpdates) > 0 {
 		<-m.stateUpdates
 	}
+	for len(m.reliableMessages) > 0 {
+		<-m.reliableMessages
+	}
 	for len(m.errors) > 0 {
 		<-m.errors
 	}
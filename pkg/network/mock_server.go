@@ -26,12 +26,14 @@ type MockServer struct {
 		PlayerID uint64 // 0 for broadcasts
 		Update   *StateUpdate
 	}
+	SentReliable []*ReliableMessage // PlayerID is the recipient
 
 	// Channels
-	inputCommands chan *InputCommand
-	playerJoins   chan uint64
-	playerLeaves  chan uint64
-	errors        chan error
+	inputCommands    chan *InputCommand
+	reliableMessages chan *ReliableMessage
+	playerJoins      chan uint64
+	playerLeaves     chan uint64
+	errors           chan error
 
 	// Thread safety
 	mu sync.RWMutex
@@ -40,11 +42,12 @@ type MockServer struct {
 // NewMockServer creates a new mock server for testing.
 func NewMockServer() *MockServer {
 	return &MockServer{
-		Players:       make(map[uint64]bool),
-		inputCommands: make(chan *InputCommand, 64),
-		playerJoins:   make(chan uint64, 16),
-		playerLeaves:  make(chan uint64, 16),
-		errors:        make(chan error, 16),
+		Players:          make(map[uint64]bool),
+		inputCommands:    make(chan *InputCommand, 64),
+		reliableMessages: make(chan *ReliableMessage, 64),
+		playerJoins:      make(chan uint64, 16),
+		playerLeaves:     make(chan uint64, 16),
+		errors:           make(chan error, 16),
 	}
 }
 
@@ -162,11 +165,44 @@ func (m *MockServer) SendStateUpdate(playerID uint64, update *StateUpdate) error
 	return nil
 }
 
+// SendReliable implements ServerConnection.
+func (m *MockServer) SendReliable(playerID uint64, msgType string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.SendError != nil {
+		return m.SendError
+	}
+
+	if !m.Running {
+		return fmt.Errorf("server not running")
+	}
+
+	if !m.Players[playerID] {
+		return fmt.Errorf("player %d not connected", playerID)
+	}
+
+	// Record the message
+	m.SentReliable = append(m.SentReliable, &ReliableMessage{
+		PlayerID: playerID,
+		Sequence: uint32(len(m.SentReliable) + 1),
+		Type:     msgType,
+		Data:     append([]byte(nil), data...), // Copy data
+	})
+
+	return nil
+}
+
 // ReceiveInputCommand implements ServerConnection.
 func (m *MockServer) ReceiveInputCommand() <-chan *InputCommand {
 	return m.inputCommands
 }
 
+// ReceiveReliable implements ServerConnection.
+func (m *MockServer) ReceiveReliable() <-chan *ReliableMessage {
+	return m.reliableMessages
+}
+
 // ReceivePlayerJoin implements ServerConnection.
 func (m *MockServer) ReceivePlayerJoin() <-chan uint64 {
 	return m.playerJoins
@@ -220,6 +256,16 @@ func (m *MockServer) SimulateInputCommand(cmd *InputCommand) {
 	}
 }
 
+// SimulateReliableMessage injects a reliable message for testing.
+// Use this to simulate reliable client messages in tests.
+func (m *MockServer) SimulateReliableMessage(msg *ReliableMessage) {
+	select {
+	case m.reliableMessages <- msg:
+	default:
+		// Channel full - drop message
+	}
+}
+
 // SimulateError injects an error for testing.
 // Use this to simulate network errors in tests.
 func (m *MockServer) SimulateError(err error) {
@@ -264,6 +310,7 @@ func (m *MockServer) Reset() {
 	m.BroadcastCalls = 0
 	m.SendCalls = 0
 	m.SentUpdates = nil
+	m.SentReliable = nil
 	m.StartError = nil
 	m.StopError = nil
 	m.SendError = nil
@@ -272,6 +319,9 @@ func (m *MockServer) Reset() {
 	for len(m.inputCommands) > 0 {
 		<-m.inputCommands
 	}
+	for len(m.reliableMessages) > 0 {
+		<-m.reliableMessages
+	}
 	for len(m.playerJoins) > 0 {
 		<-m.playerJoins
 	}
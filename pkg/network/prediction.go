@@ -26,6 +26,43 @@ type Velocity struct {
 	VX, VY float64
 }
 
+// ReconciliationStats summarizes how often and how far authoritative server
+// state has corrected the client's predictions. Use it to tune prediction
+// for high-latency connections.
+type ReconciliationStats struct {
+	// Reconciliations is the number of server states reconciled
+	Reconciliations uint64
+
+	// Corrections is the number of reconciliations whose prediction was off
+	// by more than the error threshold (including resyncs)
+	Corrections uint64
+
+	// Resyncs is the number of corrections where the acknowledged input had
+	// left the history, so the client snapped to the server state
+	Resyncs uint64
+
+	// AverageError is the mean positional error of corrections in pixels
+	AverageError float64
+
+	// MaxError is the largest positional error of a correction in pixels
+	MaxError float64
+
+	// LastError is the positional error of the most recent reconciliation
+	LastError float64
+
+	// RollbackFrames is the total number of inputs replayed after corrections
+	RollbackFrames uint64
+}
+
+// CorrectionRate returns the fraction of reconciliations that corrected the
+// prediction (0.0-1.0).
+func (s ReconciliationStats) CorrectionRate() float64 {
+	if s.Reconciliations == 0 {
+		return 0
+	}
+	return float64(s.Corrections) / float64(s.Reconciliations)
+}
+
 // ClientPredictor handles client-side prediction and reconciliation
 type ClientPredictor struct {
 	mu sync.RWMutex
@@ -44,6 +81,10 @@ type ClientPredictor struct {
 
 	// Current sequence number
 	currentSeq uint32
+
+	// Reconciliation error metrics
+	stats      ReconciliationStats
+	totalError float64
 }
 
 // NewClientPredictor creates a new client-side predictor
@@ -106,8 +147,12 @@ func (cp *ClientPredictor) ReconcileServerState(serverSeq uint32, serverPos Posi
 		}
 	}
 
+	cp.stats.Reconciliations++
+
 	// If we don't have the state anymore, trust the server completely
 	if stateIndex == -1 {
+		cp.recordCorrection(serverPos, cp.currentState.Position, 0)
+		cp.stats.Resyncs++
 		cp.currentState = PredictedState{
 			Sequence:  serverSeq,
 			Timestamp: time.Now(),
@@ -127,6 +172,7 @@ func (cp *ClientPredictor) ReconcileServerState(serverSeq uint32, serverPos Posi
 	if abs(errorX) < errorThreshold && abs(errorY) < errorThreshold {
 		// Prediction was accurate, no correction needed
 		// Just remove old states
+		cp.stats.LastError = sqrt(errorX*errorX + errorY*errorY)
 		cp.stateHistory = cp.stateHistory[stateIndex+1:]
 		return cp.currentState
 	}
@@ -142,6 +188,7 @@ func (cp *ClientPredictor) ReconcileServerState(serverSeq uint32, serverPos Posi
 
 	// Replay all inputs that came after the acknowledged one
 	inputsToReplay := cp.stateHistory[stateIndex+1:]
+	cp.recordCorrection(serverPos, predicted.Position, len(inputsToReplay))
 	for i, oldState := range inputsToReplay {
 		// Calculate deltaTime between states
 		var deltaTime float64
@@ -176,6 +223,40 @@ func (cp *ClientPredictor) ReconcileServerState(serverSeq uint32, serverPos Posi
 	return correctedState
 }
 
+// recordCorrection updates the reconciliation stats for a correction from
+// predicted to serverPos that replayed rollbackFrames inputs.
+// Caller must hold cp.mu.
+func (cp *ClientPredictor) recordCorrection(serverPos, predicted Position, rollbackFrames int) {
+	dx := serverPos.X - predicted.X
+	dy := serverPos.Y - predicted.Y
+	distance := sqrt(dx*dx + dy*dy)
+
+	cp.stats.Corrections++
+	cp.stats.RollbackFrames += uint64(rollbackFrames)
+	cp.stats.LastError = distance
+	if distance > cp.stats.MaxError {
+		cp.stats.MaxError = distance
+	}
+	cp.totalError += distance
+	cp.stats.AverageError = cp.totalError / float64(cp.stats.Corrections)
+}
+
+// GetReconciliationStats returns a copy of the reconciliation error metrics.
+func (cp *ClientPredictor) GetReconciliationStats() ReconciliationStats {
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+	return cp.stats
+}
+
+// ResetReconciliationStats clears the reconciliation error metrics, e.g.
+// to measure a fresh logging interval.
+func (cp *ClientPredictor) ResetReconciliationStats() {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.stats = ReconciliationStats{}
+	cp.totalError = 0
+}
+
 // GetCurrentState returns the current predicted state
 func (cp *ClientPredictor) GetCurrentState() PredictedState {
 	cp.mu.RLock()
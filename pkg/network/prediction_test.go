@@ -397,3 +397,48 @@ func BenchmarkClientPredictor_GetCurrentState(b *testing.B) {
 		predictor.GetCurrentState()
 	}
 }
+
+func TestClientPredictor_ReconciliationStats(t *testing.T) {
+	predictor := NewClientPredictor()
+	predictor.SetInitialState(Position{X: 0, Y: 0}, Velocity{VX: 0, VY: 0})
+
+	// Predicted X positions: 0.25, 0.75, 1.5, 2.5, 3.75
+	for i := 0; i < 5; i++ {
+		predictor.PredictInput(100, 0, 0.05)
+	}
+
+	// Accurate prediction: no correction
+	predictor.ReconcileServerState(1, Position{X: 0.25}, Velocity{VX: 5})
+	// Off by 3: correction replaying inputs 3, 4, 5
+	predictor.ReconcileServerState(2, Position{X: 3.75}, Velocity{VX: 10})
+	// Off by 5: correction replaying inputs 4, 5
+	predictor.ReconcileServerState(3, Position{X: 6.5}, Velocity{VX: 15})
+
+	stats := predictor.GetReconciliationStats()
+	if stats.Reconciliations != 3 || stats.Corrections != 2 || stats.Resyncs != 0 {
+		t.Errorf("counts = %+v, want 3 reconciliations and 2 corrections", stats)
+	}
+	if math.Abs(stats.AverageError-4) > 1e-6 || math.Abs(stats.MaxError-5) > 1e-6 || math.Abs(stats.LastError-5) > 1e-6 {
+		t.Errorf("errors = avg %f max %f last %f, want 4, 5, 5", stats.AverageError, stats.MaxError, stats.LastError)
+	}
+	if stats.RollbackFrames != 5 {
+		t.Errorf("RollbackFrames = %d, want 5", stats.RollbackFrames)
+	}
+	if math.Abs(stats.CorrectionRate()-2.0/3.0) > 1e-9 {
+		t.Errorf("CorrectionRate = %f, want 2/3", stats.CorrectionRate())
+	}
+
+	// Acknowledged input no longer in history: resync
+	predictor.ReconcileServerState(1, Position{X: 100}, Velocity{})
+	if stats := predictor.GetReconciliationStats(); stats.Resyncs != 1 || stats.Corrections != 3 {
+		t.Errorf("resync not counted: %+v", stats)
+	}
+
+	predictor.ResetReconciliationStats()
+	if stats := predictor.GetReconciliationStats(); stats != (ReconciliationStats{}) {
+		t.Errorf("stats after reset = %+v", stats)
+	}
+	if (ReconciliationStats{}).CorrectionRate() != 0 {
+		t.Error("CorrectionRate with no reconciliations should be 0")
+	}
+}
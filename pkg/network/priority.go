@@ -0,0 +1,273 @@
+// Package network provides state update prioritization.
+// This file assigns each entity's StateUpdate a priority for one recipient
+// (its own entity, attackers, objective targets, and nearby entities rank
+// highest) and selects which updates fit in a per-tick byte budget. Updates
+// that are dropped age upward so distant entities still refresh eventually.
+package network
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Standard state update priorities (higher = more important).
+const (
+	PriorityLow      uint8 = 32
+	PriorityNormal   uint8 = 128
+	PriorityHigh     uint8 = 200
+	PriorityCritical uint8 = 255
+)
+
+// DefaultPriorityDistance is the distance in world pixels beyond which
+// entities drop to PriorityLow.
+const DefaultPriorityDistance = 800.0
+
+// DefaultSendBudget is the number of bytes of state sent to each player
+// per server tick.
+const DefaultSendBudget = 16 * 1024
+
+// DefaultAgingBoost is the priority added per consecutive tick an update
+// was dropped for lack of budget.
+const DefaultAgingBoost uint8 = 16
+
+// stateUpdateHeaderSize is the encoded size of a StateUpdate without
+// components: header (21 bytes) plus component count (2 bytes).
+const stateUpdateHeaderSize = 23
+
+// PriorityContext describes one recipient's view of the world.
+type PriorityContext struct {
+	// ViewerEntityID is the recipient's own entity (always critical)
+	ViewerEntityID uint64
+
+	// ViewerPosition is the recipient's position in world pixels
+	ViewerPosition Position
+
+	// AttackerIDs are entities currently attacking the recipient
+	AttackerIDs map[uint64]bool
+
+	// ObjectiveIDs are quest objective targets for the recipient
+	ObjectiveIDs map[uint64]bool
+
+	// MaxDistance is where distance-based priority bottoms out
+	// (DefaultPriorityDistance if zero)
+	MaxDistance float64
+}
+
+// EntityPriority returns the priority of an entity for this recipient.
+// The viewer's own entity is critical, attackers and objective targets are
+// high, and everything else scales from PriorityNormal at the viewer's
+// position down to PriorityLow at MaxDistance and beyond.
+func (c *PriorityContext) EntityPriority(entity EntitySnapshot) uint8 {
+	switch {
+	case entity.EntityID == c.ViewerEntityID:
+		return PriorityCritical
+	case c.AttackerIDs[entity.EntityID]:
+		return PriorityHigh
+	case c.ObjectiveIDs[entity.EntityID]:
+		return PriorityHigh
+	}
+
+	maxDistance := c.MaxDistance
+	if maxDistance <= 0 {
+		maxDistance = DefaultPriorityDistance
+	}
+	dx := entity.Position.X - c.ViewerPosition.X
+	dy := entity.Position.Y - c.ViewerPosition.Y
+	t := math.Min(math.Sqrt(dx*dx+dy*dy)/maxDistance, 1)
+
+	return PriorityNormal - uint8(t*float64(PriorityNormal-PriorityLow))
+}
+
+// StateUpdateSize returns the number of bytes BinaryProtocol encodes the
+// update into, without encoding it.
+func StateUpdateSize(update *StateUpdate) int {
+	size := stateUpdateHeaderSize
+	for _, comp := range update.Components {
+		size += 2 + len(comp.Type) + 4 + len(comp.Data)
+	}
+	return size
+}
+
+// UpdatePrioritizer selects which state updates to send each tick.
+// Aging is tracked per recipient, so an entity dropped for one player does
+// not jump the queue for another. It is safe for concurrent use.
+type UpdatePrioritizer struct {
+	mu sync.Mutex
+
+	// starved counts consecutive ticks each entity's update was dropped
+	// for each recipient
+	starved map[starvedKey]int
+
+	// agingBoost is added to priority per starved tick
+	agingBoost uint8
+}
+
+// starvedKey identifies an entity's updates to one recipient.
+type starvedKey struct {
+	recipientID uint64
+	entityID    uint64
+}
+
+// NewUpdatePrioritizer creates a prioritizer with DefaultAgingBoost.
+func NewUpdatePrioritizer() *UpdatePrioritizer {
+	return &UpdatePrioritizer{
+		starved:    make(map[starvedKey]int),
+		agingBoost: DefaultAgingBoost,
+	}
+}
+
+// SetAgingBoost sets the priority added per consecutive dropped tick.
+// Zero disables aging.
+func (p *UpdatePrioritizer) SetAgingBoost(boost uint8) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.agingBoost = boost
+}
+
+// effectivePriority returns the update's priority plus its aging boost for
+// the recipient, capped at PriorityCritical - 1 so aged updates never
+// outrank critical ones.
+func (p *UpdatePrioritizer) effectivePriority(recipientID uint64, update *StateUpdate) int {
+	priority := int(update.Priority)
+	if priority == int(PriorityCritical) {
+		return priority
+	}
+	priority += p.starved[starvedKey{recipientID, update.EntityID}] * int(p.agingBoost)
+	if priority >= int(PriorityCritical) {
+		priority = int(PriorityCritical) - 1
+	}
+	return priority
+}
+
+// Select returns the updates to send to a recipient this tick, highest
+// priority first, keeping the total encoded size within byteBudget.
+// Lower-priority updates that do not fit are skipped and gain priority for
+// that recipient on later ticks. Ties are broken by entity ID so selection
+// is deterministic. A byteBudget <= 0 means unlimited.
+func (p *UpdatePrioritizer) Select(recipientID uint64, updates []*StateUpdate, byteBudget int) []*StateUpdate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ordered := make([]*StateUpdate, len(updates))
+	copy(ordered, updates)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, pj := p.effectivePriority(recipientID, ordered[i]), p.effectivePriority(recipientID, ordered[j])
+		if pi != pj {
+			return pi > pj
+		}
+		return ordered[i].EntityID < ordered[j].EntityID
+	})
+
+	selected := make([]*StateUpdate, 0, len(ordered))
+	used := 0
+	for _, update := range ordered {
+		key := starvedKey{recipientID, update.EntityID}
+		size := StateUpdateSize(update)
+		if byteBudget > 0 && used+size > byteBudget {
+			p.starved[key]++
+			continue
+		}
+		used += size
+		selected = append(selected, update)
+		delete(p.starved, key)
+	}
+	return selected
+}
+
+// LimitView trims a recipient's view of the world so the delta against
+// baseline fits in byteBudget. Entities whose state changed are ranked by
+// ctx and chosen with Select; an entity left out keeps its baseline state
+// in the returned view, so the delta skips it until it ages in, and a new
+// entity left out is not sent yet. A byteBudget <= 0 returns view unchanged.
+func (p *UpdatePrioritizer) LimitView(recipientID uint64, ctx *PriorityContext, baseline *WorldSnapshot, view WorldSnapshot, config DeltaConfig, byteBudget int) WorldSnapshot {
+	if byteBudget <= 0 {
+		return view
+	}
+
+	var changed []*StateUpdate
+	for _, id := range sortedEntityIDs(view.Entities) {
+		entity := view.Entities[id]
+		fields := deltaFieldPosition | deltaFieldVelocity | deltaFieldComponents
+		components := entity.Components
+		var removed []string
+		if baseline != nil {
+			if old, existed := baseline.Entities[id]; existed {
+				if fields, components, removed = changedFields(old, entity, config); fields == 0 {
+					continue
+				}
+			}
+		}
+		changed = append(changed, &StateUpdate{
+			EntityID: id,
+			Priority: ctx.EntityPriority(entity),
+			Components: []ComponentData{{
+				Type: DeltaComponentEntity,
+				Data: encodeEntityRecord(entity, fields, components, removed),
+			}},
+		})
+	}
+
+	// Removal records are small and always sent; removed entities stop aging
+	budget := byteBudget - stateUpdateHeaderSize
+	if baseline != nil {
+		p.mu.Lock()
+		for id := range baseline.Entities {
+			if _, exists := view.Entities[id]; !exists {
+				budget -= 2 + len(DeltaComponentRemoved) + 4 + 8
+				delete(p.starved, starvedKey{recipientID, id})
+			}
+		}
+		p.mu.Unlock()
+	}
+	if budget < 1 {
+		budget = 1 // Nothing fits, but Select treats <= 0 as unlimited
+	}
+
+	dropped := make(map[uint64]bool, len(changed))
+	for _, update := range changed {
+		dropped[update.EntityID] = true
+	}
+	for _, update := range p.Select(recipientID, changed, budget) {
+		delete(dropped, update.EntityID)
+	}
+
+	limited := view
+	limited.Entities = make(map[uint64]EntitySnapshot, len(view.Entities))
+	for id, entity := range view.Entities {
+		if !dropped[id] {
+			limited.Entities[id] = entity
+			continue
+		}
+		if baseline != nil {
+			if old, existed := baseline.Entities[id]; existed {
+				limited.Entities[id] = old
+			}
+		}
+	}
+	return limited
+}
+
+// Forget clears aging state for an entity for every recipient (e.g. after
+// it despawns).
+func (p *UpdatePrioritizer) Forget(entityID uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key := range p.starved {
+		if key.entityID == entityID {
+			delete(p.starved, key)
+		}
+	}
+}
+
+// ForgetRecipient clears aging state for a recipient (e.g. after it
+// disconnects).
+func (p *UpdatePrioritizer) ForgetRecipient(recipientID uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key := range p.starved {
+		if key.recipientID == recipientID {
+			delete(p.starved, key)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package network
+
+import (
+	"testing"
+)
+
+func priorityTestUpdate(entityID uint64, priority uint8, dataSize int) *StateUpdate {
+	return &StateUpdate{
+		EntityID: entityID,
+		Priority: priority,
+		Components: []ComponentData{
+			{Type: "position", Data: make([]byte, dataSize)},
+		},
+	}
+}
+
+func TestPriorityContext_EntityPriority(t *testing.T) {
+	ctx := &PriorityContext{
+		ViewerEntityID: 1,
+		ViewerPosition: Position{X: 100, Y: 100},
+		AttackerIDs:    map[uint64]bool{2: true},
+		ObjectiveIDs:   map[uint64]bool{3: true},
+	}
+
+	far := Position{X: 5000, Y: 5000}
+	tests := []struct {
+		name   string
+		entity EntitySnapshot
+		want   uint8
+	}{
+		{"viewer", EntitySnapshot{EntityID: 1, Position: far}, PriorityCritical},
+		{"attacker", EntitySnapshot{EntityID: 2, Position: far}, PriorityHigh},
+		{"objective", EntitySnapshot{EntityID: 3, Position: far}, PriorityHigh},
+		{"adjacent", EntitySnapshot{EntityID: 4, Position: Position{X: 100, Y: 100}}, PriorityNormal},
+		{"distant", EntitySnapshot{EntityID: 5, Position: far}, PriorityLow},
+		{"halfway", EntitySnapshot{EntityID: 6, Position: Position{X: 500, Y: 100}}, 80},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ctx.EntityPriority(tt.entity); got != tt.want {
+				t.Errorf("EntityPriority = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStateUpdateSize_MatchesEncoding(t *testing.T) {
+	update := &StateUpdate{
+		EntityID: 9,
+		Components: []ComponentData{
+			{Type: "position", Data: make([]byte, 16)},
+			{Type: "health", Data: make([]byte, 8)},
+		},
+	}
+	encoded, err := NewBinaryProtocol().EncodeStateUpdate(update)
+	if err != nil {
+		t.Fatalf("EncodeStateUpdate failed: %v", err)
+	}
+	if got := StateUpdateSize(update); got != len(encoded) {
+		t.Errorf("StateUpdateSize = %d, encoded length %d", got, len(encoded))
+	}
+}
+
+func TestUpdatePrioritizer_SelectUnderBudget(t *testing.T) {
+	ctx := &PriorityContext{
+		ViewerEntityID: 1,
+		ViewerPosition: Position{X: 0, Y: 0},
+		AttackerIDs:    map[uint64]bool{2: true},
+	}
+	entities := []EntitySnapshot{
+		{EntityID: 10, Position: Position{X: 3000, Y: 0}}, // Distant
+		{EntityID: 11, Position: Position{X: 2500, Y: 0}}, // Distant
+		{EntityID: 2, Position: Position{X: 400, Y: 0}},   // Attacker
+		{EntityID: 1, Position: Position{X: 0, Y: 0}},     // Viewer
+		{EntityID: 12, Position: Position{X: 50, Y: 0}},   // Nearby
+	}
+
+	updates := make([]*StateUpdate, len(entities))
+	for i, entity := range entities {
+		updates[i] = priorityTestUpdate(entity.EntityID, ctx.EntityPriority(entity), 20)
+	}
+
+	size := StateUpdateSize(updates[0])
+	selected := NewUpdatePrioritizer().Select(1, updates, 3*size)
+
+	if len(selected) != 3 {
+		t.Fatalf("selected %d updates, want 3", len(selected))
+	}
+	wantOrder := []uint64{1, 2, 12}
+	for i, id := range wantOrder {
+		if selected[i].EntityID != id {
+			t.Errorf("selected[%d] = entity %d, want %d", i, selected[i].EntityID, id)
+		}
+	}
+	for _, update := range selected {
+		if update.EntityID == 10 || update.EntityID == 11 {
+			t.Errorf("distant entity %d should have been dropped", update.EntityID)
+		}
+	}
+}
+
+func TestUpdatePrioritizer_UnlimitedBudget(t *testing.T) {
+	updates := []*StateUpdate{
+		priorityTestUpdate(1, PriorityLow, 100),
+		priorityTestUpdate(2, PriorityHigh, 100),
+	}
+	selected := NewUpdatePrioritizer().Select(1, updates, 0)
+	if len(selected) != 2 || selected[0].EntityID != 2 {
+		t.Errorf("unlimited budget should keep all updates, highest first; got %d", len(selected))
+	}
+}
+
+func TestUpdatePrioritizer_AgingEventuallySendsLowPriority(t *testing.T) {
+	p := NewUpdatePrioritizer()
+	budget := StateUpdateSize(priorityTestUpdate(0, 0, 20))
+
+	sent := false
+	for tick := 0; tick < 10 && !sent; tick++ {
+		updates := []*StateUpdate{
+			priorityTestUpdate(1, PriorityNormal, 20),
+			priorityTestUpdate(2, PriorityLow, 20),
+		}
+		for _, update := range p.Select(1, updates, budget) {
+			if update.EntityID == 2 {
+				sent = true
+			}
+		}
+	}
+	if !sent {
+		t.Error("low-priority update was never sent despite aging")
+	}
+
+	// Without aging the low-priority update starves forever
+	p = NewUpdatePrioritizer()
+	p.SetAgingBoost(0)
+	for tick := 0; tick < 10; tick++ {
+		updates := []*StateUpdate{
+			priorityTestUpdate(1, PriorityNormal, 20),
+			priorityTestUpdate(2, PriorityLow, 20),
+		}
+		if selected := p.Select(1, updates, budget); selected[0].EntityID != 1 {
+			t.Fatal("without aging the higher priority update should always win")
+		}
+	}
+}
+
+func TestUpdatePrioritizer_AgingNeverOutranksCritical(t *testing.T) {
+	p := NewUpdatePrioritizer()
+	budget := StateUpdateSize(priorityTestUpdate(0, 0, 20))
+
+	for tick := 0; tick < 30; tick++ {
+		updates := []*StateUpdate{
+			priorityTestUpdate(1, PriorityCritical, 20),
+			priorityTestUpdate(2, PriorityLow, 20),
+		}
+		selected := p.Select(1, updates, budget)
+		if len(selected) != 1 || selected[0].EntityID != 1 {
+			t.Fatalf("tick %d: critical update was not sent first", tick)
+		}
+	}
+}
+
+func TestUpdatePrioritizer_AgingIsPerRecipient(t *testing.T) {
+	p := NewUpdatePrioritizer()
+	budget := StateUpdateSize(priorityTestUpdate(0, 0, 20))
+	updates := func() []*StateUpdate {
+		return []*StateUpdate{
+			priorityTestUpdate(1, PriorityNormal, 20),
+			priorityTestUpdate(2, PriorityNormal-DefaultAgingBoost+1, 20), // Outranks 1 after one dropped tick
+		}
+	}
+
+	if selected := p.Select(1, updates(), budget); selected[0].EntityID != 1 {
+		t.Fatal("entity 1 should win the first tick")
+	}
+	if selected := p.Select(1, updates(), budget); selected[0].EntityID != 2 {
+		t.Error("entity 2 should age past entity 1 for recipient 1")
+	}
+
+	// Entity 2 was never dropped for recipient 2
+	if selected := p.Select(2, updates(), budget); selected[0].EntityID != 1 {
+		t.Error("aging for recipient 1 leaked into recipient 2")
+	}
+
+	p.ForgetRecipient(1)
+	for key := range p.starved {
+		if key.recipientID == 1 {
+			t.Errorf("ForgetRecipient left aging for entity %d", key.entityID)
+		}
+	}
+	if len(p.starved) == 0 {
+		t.Error("ForgetRecipient cleared recipient 2's aging")
+	}
+}
+
+func TestUpdatePrioritizer_LimitView(t *testing.T) {
+	entity := func(id uint64, x float64) EntitySnapshot {
+		return EntitySnapshot{EntityID: id, Position: Position{X: x}}
+	}
+	baseline := &WorldSnapshot{Sequence: 1, Entities: map[uint64]EntitySnapshot{
+		1: entity(1, 0),    // Viewer
+		2: entity(2, 3000), // Distant
+	}}
+	view := WorldSnapshot{Sequence: 2, Entities: map[uint64]EntitySnapshot{
+		1: entity(1, 50),
+		2: entity(2, 3050),
+		3: entity(3, 3100), // New and distant
+	}}
+	ctx := &PriorityContext{ViewerEntityID: 1}
+	config := DefaultDeltaConfig()
+	config.KeyframeInterval = 0
+
+	sm := NewSnapshotManager(4)
+	sm.SetDeltaConfig(config)
+	full := StateUpdateSize(sm.Delta(*baseline, view))
+	budget := full - 1
+
+	p := NewUpdatePrioritizer()
+	limited := p.LimitView(1, ctx, baseline, view, config, budget)
+	delta := sm.Delta(*baseline, limited)
+	if size := StateUpdateSize(delta); size > budget {
+		t.Errorf("limited delta is %d bytes, budget %d", size, budget)
+	}
+	if limited.Entities[1].Position.X != 50 {
+		t.Error("viewer update should be sent")
+	}
+	_, hasNew := limited.Entities[3]
+	if limited.Entities[2].Position.X == 3050 && hasNew {
+		t.Error("a distant update should have been held back")
+	}
+	if _, kept := limited.Entities[2]; !kept {
+		t.Error("held-back entity must stay in the view at its baseline state")
+	}
+
+	// Held-back updates age in on later ticks
+	applied, err := ApplyStateUpdate(baseline, delta)
+	if err != nil {
+		t.Fatalf("ApplyStateUpdate: %v", err)
+	}
+	for tick := 0; tick < 10; tick++ {
+		view.Sequence = applied.Sequence + 1
+		delta = sm.Delta(*applied, p.LimitView(1, ctx, applied, view, config, budget))
+		if applied, err = ApplyStateUpdate(applied, delta); err != nil {
+			t.Fatalf("ApplyStateUpdate: %v", err)
+		}
+	}
+	if applied.Entities[2].Position.X != 3050 {
+		t.Error("distant entity update never sent")
+	}
+	if _, ok := applied.Entities[3]; !ok {
+		t.Error("new distant entity never sent")
+	}
+
+	if unlimited := p.LimitView(1, ctx, baseline, view, config, 0); len(unlimited.Entities) != len(view.Entities) {
+		t.Error("zero budget should leave the view unchanged")
+	}
+}
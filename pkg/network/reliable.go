@@ -0,0 +1,288 @@
+// Package network provides a reliable ordered message channel.
+// This file implements ReliableChannel, which adds sequence numbers,
+// cumulative acks and timed retransmission on top of the lossy state
+// stream. Messages that must arrive exactly once and in order (quest
+// completion, trades, chat) are sent through it instead of as ordinary
+// state updates or inputs, which may be dropped when a queue is full.
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// ReliableInputType is the InputCommand type that carries reliable
+	// packets from client to server.
+	ReliableInputType = "reliable"
+
+	// ReliableComponentType is the StateUpdate component type that carries
+	// reliable packets from server to client.
+	ReliableComponentType = "reliable"
+
+	// DefaultReliableTimeout is how long a reliable packet waits for an ack
+	// before it is retransmitted.
+	DefaultReliableTimeout = 200 * time.Millisecond
+
+	// MinReliableTimeout is the shortest retransmission timeout a channel
+	// uses. Owners poll Retransmit at half the timeout, so it must stay
+	// well above zero.
+	MinReliableTimeout = 10 * time.Millisecond
+
+	// DefaultReliableWindow is the maximum number of unacknowledged packets
+	// a channel holds, and how far ahead of the next expected sequence a
+	// received packet may be buffered.
+	DefaultReliableWindow = 256
+)
+
+// ReliableMessage is a message delivered by a reliable channel.
+type ReliableMessage struct {
+	// PlayerID of the sender (0 for messages from the server)
+	PlayerID uint64
+
+	// Sequence number of the message within its channel (starts at 1)
+	Sequence uint32
+
+	// Type identifies the message kind (e.g. "chat", "quest_complete")
+	Type string
+
+	// Data contains the message payload
+	Data []byte
+}
+
+// pendingReliable is a sent message that has not been acknowledged yet.
+type pendingReliable struct {
+	message *ReliableMessage
+	sentAt  time.Time
+}
+
+// ReliableChannel delivers messages exactly once and in order over an
+// unreliable transport. Each endpoint owns one channel; packets produced by
+// a channel are handed to its transmit function and must be passed to the
+// peer channel's Receive.
+//
+// Every data packet carries a sequence number and a cumulative ack of the
+// peer's messages. Packets arriving out of order are buffered until the gap
+// is filled. Unacknowledged packets are resent by Retransmit once the
+// timeout has passed, so the owner should call it periodically.
+type ReliableChannel struct {
+	mu       sync.Mutex
+	timeout  time.Duration
+	window   int
+	transmit func(packet []byte)
+
+	// Sending side
+	nextSeq     uint32
+	pending     []*pendingReliable // ordered by sequence
+	retransmits int
+
+	// Receiving side
+	received uint32 // highest sequence delivered in order
+	buffered map[uint32]*ReliableMessage
+}
+
+// NewReliableChannel creates a reliable channel that sends packets with
+// transmit. transmit may drop packets; a timeout of 0 or less uses
+// DefaultReliableTimeout and shorter ones are raised to MinReliableTimeout.
+func NewReliableChannel(timeout time.Duration, transmit func(packet []byte)) *ReliableChannel {
+	if timeout <= 0 {
+		timeout = DefaultReliableTimeout
+	} else if timeout < MinReliableTimeout {
+		timeout = MinReliableTimeout
+	}
+	return &ReliableChannel{
+		timeout:  timeout,
+		window:   DefaultReliableWindow,
+		transmit: transmit,
+		nextSeq:  1,
+		buffered: make(map[uint32]*ReliableMessage),
+	}
+}
+
+// Send queues a message for reliable delivery and transmits it. Returns the
+// message's sequence number, or an error if too many messages are awaiting
+// an ack.
+func (rc *ReliableChannel) Send(msgType string, data []byte) (uint32, error) {
+	rc.mu.Lock()
+	if len(rc.pending) >= rc.window {
+		rc.mu.Unlock()
+		return 0, fmt.Errorf("reliable window full: %d messages awaiting ack", len(rc.pending))
+	}
+
+	msg := &ReliableMessage{
+		Sequence: rc.nextSeq,
+		Type:     msgType,
+		Data:     append([]byte(nil), data...),
+	}
+	rc.nextSeq++
+	rc.pending = append(rc.pending, &pendingReliable{message: msg, sentAt: time.Now()})
+	packet := encodeReliablePacket(msg, rc.received)
+	rc.mu.Unlock()
+
+	rc.transmit(packet)
+	return msg.Sequence, nil
+}
+
+// Receive processes a packet from the peer channel. It releases messages the
+// packet acknowledges, acks any data it carries and returns the messages
+// that are now deliverable in order. Duplicates are acked again but not
+// returned.
+func (rc *ReliableChannel) Receive(packet []byte) ([]*ReliableMessage, error) {
+	msg, ack, err := decodeReliablePacket(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	rc.mu.Lock()
+	rc.acknowledge(ack)
+
+	if msg == nil {
+		rc.mu.Unlock()
+		return nil, nil
+	}
+
+	var delivered []*ReliableMessage
+	if msg.Sequence > rc.received && msg.Sequence-rc.received <= uint32(rc.window) {
+		rc.buffered[msg.Sequence] = msg
+		for {
+			next, ok := rc.buffered[rc.received+1]
+			if !ok {
+				break
+			}
+			delete(rc.buffered, next.Sequence)
+			rc.received = next.Sequence
+			delivered = append(delivered, next)
+		}
+	}
+	ackPacket := encodeReliablePacket(nil, rc.received)
+	rc.mu.Unlock()
+
+	rc.transmit(ackPacket)
+	return delivered, nil
+}
+
+// Retransmit resends every message that has waited longer than the timeout
+// for an ack. Returns the number of packets resent.
+func (rc *ReliableChannel) Retransmit(now time.Time) int {
+	rc.mu.Lock()
+	var packets [][]byte
+	for _, p := range rc.pending {
+		if now.Sub(p.sentAt) >= rc.timeout {
+			p.sentAt = now
+			packets = append(packets, encodeReliablePacket(p.message, rc.received))
+		}
+	}
+	rc.retransmits += len(packets)
+	rc.mu.Unlock()
+
+	for _, packet := range packets {
+		rc.transmit(packet)
+	}
+	return len(packets)
+}
+
+// Pending returns the number of sent messages awaiting an ack.
+func (rc *ReliableChannel) Pending() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return len(rc.pending)
+}
+
+// Retransmits returns the total number of packets resent.
+func (rc *ReliableChannel) Retransmits() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.retransmits
+}
+
+// GetTimeout returns the retransmission timeout.
+func (rc *ReliableChannel) GetTimeout() time.Duration {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.timeout
+}
+
+// acknowledge drops pending messages up to and including ack.
+// Caller must hold rc.mu.
+func (rc *ReliableChannel) acknowledge(ack uint32) {
+	i := 0
+	for i < len(rc.pending) && rc.pending[i].message.Sequence <= ack {
+		i++
+	}
+	rc.pending = rc.pending[i:]
+}
+
+// reliableStateUpdate wraps a reliable packet in a state update so it can
+// travel on the server-to-client stream.
+func reliableStateUpdate(packet []byte) *StateUpdate {
+	return &StateUpdate{
+		Timestamp:  uint64(time.Now().UnixNano()),
+		Components: []ComponentData{{Type: ReliableComponentType, Data: packet}},
+		Priority:   PriorityCritical,
+	}
+}
+
+// reliablePacketFromUpdate returns the reliable packet carried by update, if
+// it is one.
+func reliablePacketFromUpdate(update *StateUpdate) ([]byte, bool) {
+	if update.EntityID != 0 || len(update.Components) != 1 || update.Components[0].Type != ReliableComponentType {
+		return nil, false
+	}
+	return update.Components[0].Data, true
+}
+
+// encodeReliablePacket encodes a data packet, or an ack-only packet when msg
+// is nil. Layout: [sequence uint32][ack uint32], then for data packets
+// [type length uint16][type][data length uint32][data]. Sequence 0 marks an
+// ack-only packet.
+func encodeReliablePacket(msg *ReliableMessage, ack uint32) []byte {
+	if msg == nil {
+		packet := make([]byte, 8)
+		binary.LittleEndian.PutUint32(packet[4:], ack)
+		return packet
+	}
+
+	packet := make([]byte, 14+len(msg.Type)+len(msg.Data))
+	binary.LittleEndian.PutUint32(packet[0:], msg.Sequence)
+	binary.LittleEndian.PutUint32(packet[4:], ack)
+	binary.LittleEndian.PutUint16(packet[8:], uint16(len(msg.Type)))
+	offset := 10 + copy(packet[10:], msg.Type)
+	binary.LittleEndian.PutUint32(packet[offset:], uint32(len(msg.Data)))
+	copy(packet[offset+4:], msg.Data)
+	return packet
+}
+
+// decodeReliablePacket decodes a packet written by encodeReliablePacket.
+// msg is nil for ack-only packets.
+func decodeReliablePacket(packet []byte) (msg *ReliableMessage, ack uint32, err error) {
+	if len(packet) < 8 {
+		return nil, 0, fmt.Errorf("reliable packet too short: %d bytes", len(packet))
+	}
+	seq := binary.LittleEndian.Uint32(packet[0:])
+	ack = binary.LittleEndian.Uint32(packet[4:])
+	if seq == 0 {
+		return nil, ack, nil
+	}
+
+	if len(packet) < 10 {
+		return nil, 0, fmt.Errorf("reliable packet %d missing type length", seq)
+	}
+	typeLen := int(binary.LittleEndian.Uint16(packet[8:]))
+	offset := 10 + typeLen
+	if len(packet) < offset+4 {
+		return nil, 0, fmt.Errorf("reliable packet %d truncated in type", seq)
+	}
+	dataLen := int(binary.LittleEndian.Uint32(packet[offset:]))
+	if len(packet) < offset+4+dataLen {
+		return nil, 0, fmt.Errorf("reliable packet %d truncated in data", seq)
+	}
+
+	msg = &ReliableMessage{
+		Sequence: seq,
+		Type:     string(packet[10:offset]),
+		Data:     append([]byte(nil), packet[offset+4:offset+4+dataLen]...),
+	}
+	return msg, ack, nil
+}
@@ -0,0 +1,283 @@
+package network
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+)
+
+// lossyLink carries packets one way, dropping and reordering them.
+type lossyLink struct {
+	rng      *rand.Rand
+	dropRate float64
+	queue    [][]byte
+	dropped  int
+}
+
+func (l *lossyLink) transmit(packet []byte) {
+	if l.rng.Float64() < l.dropRate {
+		l.dropped++
+		return
+	}
+	l.queue = append(l.queue, packet)
+}
+
+// deliver passes every queued packet to rc in random order and returns the
+// messages rc released.
+func (l *lossyLink) deliver(t *testing.T, rc *ReliableChannel) []*ReliableMessage {
+	packets := l.queue
+	l.queue = nil
+	l.rng.Shuffle(len(packets), func(i, j int) { packets[i], packets[j] = packets[j], packets[i] })
+
+	var delivered []*ReliableMessage
+	for _, packet := range packets {
+		messages, err := rc.Receive(packet)
+		if err != nil {
+			t.Fatalf("Receive failed: %v", err)
+		}
+		delivered = append(delivered, messages...)
+	}
+	return delivered
+}
+
+func TestReliableChannel_OrderedDeliveryOverLossyLink(t *testing.T) {
+	const messages = 100
+	rng := rand.New(rand.NewSource(42))
+	toServer := &lossyLink{rng: rng, dropRate: 0.3}
+	toClient := &lossyLink{rng: rng, dropRate: 0.3}
+
+	client := NewReliableChannel(50*time.Millisecond, toServer.transmit)
+	server := NewReliableChannel(50*time.Millisecond, toClient.transmit)
+
+	for i := 0; i < messages; i++ {
+		if _, err := client.Send("chat", []byte(fmt.Sprintf("message %d", i))); err != nil {
+			t.Fatalf("Send %d failed: %v", i, err)
+		}
+	}
+
+	var received []*ReliableMessage
+	now := time.Now()
+	for round := 0; round < 200 && (len(received) < messages || client.Pending() > 0); round++ {
+		received = append(received, toServer.deliver(t, server)...)
+		toClient.deliver(t, client)
+
+		now = now.Add(50 * time.Millisecond)
+		client.Retransmit(now)
+	}
+
+	if toServer.dropped == 0 {
+		t.Fatal("test link dropped no packets")
+	}
+	if client.Retransmits() == 0 {
+		t.Error("lost packets should have been retransmitted")
+	}
+	if len(received) != messages {
+		t.Fatalf("received %d messages, want %d", len(received), messages)
+	}
+	for i, msg := range received {
+		if msg.Sequence != uint32(i+1) || string(msg.Data) != fmt.Sprintf("message %d", i) {
+			t.Fatalf("message %d = %d %q, out of order", i, msg.Sequence, msg.Data)
+		}
+	}
+	if client.Pending() != 0 {
+		t.Errorf("%d messages still awaiting ack", client.Pending())
+	}
+}
+
+func TestReliableChannel_DuplicatesDeliveredOnce(t *testing.T) {
+	var sent, acks [][]byte
+	sender := NewReliableChannel(0, func(packet []byte) { sent = append(sent, packet) })
+	receiver := NewReliableChannel(0, func(packet []byte) { acks = append(acks, packet) })
+
+	sender.Send("quest_complete", []byte{7})
+
+	first, err := receiver.Receive(sent[0])
+	if err != nil || len(first) != 1 {
+		t.Fatalf("first receive = %v, %v; want one message", first, err)
+	}
+	second, err := receiver.Receive(sent[0])
+	if err != nil || len(second) != 0 {
+		t.Errorf("duplicate delivered again: %v, %v", second, err)
+	}
+	if len(acks) != 2 {
+		t.Errorf("duplicate should be acked again, got %d acks", len(acks))
+	}
+
+	if sender.Pending() != 1 {
+		t.Fatalf("Pending = %d before ack, want 1", sender.Pending())
+	}
+	sender.Receive(acks[0])
+	if sender.Pending() != 0 {
+		t.Errorf("Pending = %d after ack, want 0", sender.Pending())
+	}
+}
+
+func TestReliableChannel_BuffersOutOfOrder(t *testing.T) {
+	var sent [][]byte
+	sender := NewReliableChannel(0, func(packet []byte) { sent = append(sent, packet) })
+	receiver := NewReliableChannel(0, func([]byte) {})
+
+	for i := 0; i < 3; i++ {
+		sender.Send("trade", []byte{byte(i)})
+	}
+
+	if got, _ := receiver.Receive(sent[2]); len(got) != 0 {
+		t.Error("message 3 should wait for 1 and 2")
+	}
+	if got, _ := receiver.Receive(sent[1]); len(got) != 0 {
+		t.Error("message 2 should wait for 1")
+	}
+	got, _ := receiver.Receive(sent[0])
+	if len(got) != 3 {
+		t.Fatalf("filling the gap released %d messages, want 3", len(got))
+	}
+	for i, msg := range got {
+		if msg.Data[0] != byte(i) {
+			t.Errorf("message %d has data %d", i, msg.Data[0])
+		}
+	}
+}
+
+func TestReliableChannel_RetransmitTimeout(t *testing.T) {
+	sends := 0
+	rc := NewReliableChannel(100*time.Millisecond, func([]byte) { sends++ })
+
+	if rc.GetTimeout() != 100*time.Millisecond {
+		t.Errorf("GetTimeout = %v, want 100ms", rc.GetTimeout())
+	}
+
+	start := time.Now()
+	rc.Send("chat", []byte("hi"))
+
+	if n := rc.Retransmit(start.Add(50 * time.Millisecond)); n != 0 {
+		t.Errorf("retransmitted %d packets before the timeout", n)
+	}
+	if n := rc.Retransmit(start.Add(150 * time.Millisecond)); n != 1 {
+		t.Errorf("retransmitted %d packets after the timeout, want 1", n)
+	}
+	if n := rc.Retransmit(start.Add(200 * time.Millisecond)); n != 0 {
+		t.Error("retransmit should restart the timeout")
+	}
+	if sends != 2 {
+		t.Errorf("transmitted %d packets, want 2", sends)
+	}
+
+	if NewReliableChannel(0, nil).GetTimeout() != DefaultReliableTimeout {
+		t.Error("zero timeout should use the default")
+	}
+	if NewReliableChannel(time.Nanosecond, nil).GetTimeout() != MinReliableTimeout {
+		t.Error("tiny timeout should be raised to the minimum")
+	}
+}
+
+func TestReliableChannel_WindowFull(t *testing.T) {
+	rc := NewReliableChannel(0, func([]byte) {})
+	for i := 0; i < DefaultReliableWindow; i++ {
+		if _, err := rc.Send("chat", nil); err != nil {
+			t.Fatalf("Send %d failed: %v", i, err)
+		}
+	}
+	if _, err := rc.Send("chat", nil); err == nil {
+		t.Error("expected error when the window is full")
+	}
+}
+
+func TestReliablePacket_Encoding(t *testing.T) {
+	msg := &ReliableMessage{Sequence: 9, Type: "chat", Data: []byte("hello")}
+	decoded, ack, err := decodeReliablePacket(encodeReliablePacket(msg, 4))
+	if err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	if ack != 4 || decoded.Sequence != 9 || decoded.Type != "chat" || !bytes.Equal(decoded.Data, msg.Data) {
+		t.Errorf("round trip = %+v ack %d", decoded, ack)
+	}
+
+	ackOnly, ack, err := decodeReliablePacket(encodeReliablePacket(nil, 12))
+	if err != nil || ackOnly != nil || ack != 12 {
+		t.Errorf("ack packet = %v, %d, %v", ackOnly, ack, err)
+	}
+
+	full := encodeReliablePacket(msg, 4)
+	for _, n := range []int{0, 7, 9, 12, len(full) - 1} {
+		if _, _, err := decodeReliablePacket(full[:n]); err == nil {
+			t.Errorf("expected error decoding %d of %d bytes", n, len(full))
+		}
+	}
+
+	update := reliableStateUpdate(full)
+	if packet, ok := reliablePacketFromUpdate(update); !ok || !bytes.Equal(packet, full) {
+		t.Error("reliable state update should carry the packet")
+	}
+	if _, ok := reliablePacketFromUpdate(&StateUpdate{EntityID: 3, Components: update.Components}); ok {
+		t.Error("entity updates are not reliable packets")
+	}
+}
+
+func TestTCPReliable_EndToEnd(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	serverConfig := DefaultServerConfig()
+	serverConfig.Address = address
+	serverConfig.ReliableTimeout = 20 * time.Millisecond
+	server := NewServer(serverConfig)
+	if err := server.Start(); err != nil {
+		t.Fatalf("server start: %v", err)
+	}
+	defer server.Stop()
+
+	clientConfig := DefaultClientConfig()
+	clientConfig.ServerAddress = address
+	clientConfig.ReliableTimeout = 20 * time.Millisecond
+	client := NewClient(clientConfig)
+	if err := client.Connect(); err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	var playerID uint64
+	select {
+	case playerID = <-server.ReceivePlayerJoin():
+	case <-time.After(2 * time.Second):
+		t.Fatal("player never joined")
+	}
+
+	for i := 0; i < 5; i++ {
+		if err := client.SendReliable("chat", []byte{byte(i)}); err != nil {
+			t.Fatalf("client SendReliable: %v", err)
+		}
+		if err := server.SendReliable(playerID, "quest_complete", []byte{byte(i)}); err != nil {
+			t.Fatalf("server SendReliable: %v", err)
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		select {
+		case msg := <-server.ReceiveReliable():
+			if msg.PlayerID != playerID || msg.Type != "chat" || msg.Data[0] != byte(i) {
+				t.Errorf("server message %d = %+v", i, msg)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("server missing message %d", i)
+		}
+		select {
+		case msg := <-client.ReceiveReliable():
+			if msg.Type != "quest_complete" || msg.Data[0] != byte(i) {
+				t.Errorf("client message %d = %+v", i, msg)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("client missing message %d", i)
+		}
+	}
+
+	if err := server.SendReliable(playerID+100, "chat", nil); err == nil {
+		t.Error("expected error sending to an unknown player")
+	}
+}
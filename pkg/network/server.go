@@ -20,6 +20,15 @@ type ServerConfig struct {
 	WriteTimeout time.Duration // Timeout for writing to clients
 	UpdateRate   int           // State updates per second
 	BufferSize   int           // Size of send/receive buffers per client
+
+	// ReliableTimeout is how long a reliable message waits for an ack
+	// before it is resent (DefaultReliableTimeout if zero, at least
+	// MinReliableTimeout)
+	ReliableTimeout time.Duration
+
+	// QueueSlots is how many connections may wait for a player slot when
+	// the server is full; connections beyond that are rejected (0 = no queue)
+	QueueSlots int
 }
 
 // DefaultServerConfig returns a server configuration with sensible defaults.
@@ -31,6 +40,8 @@ func DefaultServerConfig() ServerConfig {
 		WriteTimeout: 5 * time.Second,
 		UpdateRate:   20, // 20 updates/second
 		BufferSize:   256,
+
+		ReliableTimeout: DefaultReliableTimeout,
 	}
 }
 
@@ -48,12 +59,14 @@ type TCPServer struct {
 	clients      map[uint64]*clientConnection
 	clientsMu    sync.RWMutex
 	nextPlayerID uint64
+	queue        []*queuedConn // Connections waiting for a player slot
 
 	// Channels for game logic
-	inputCommands chan *InputCommand
-	playerJoins   chan uint64 // Player connection events
-	playerLeaves  chan uint64 // Player disconnection events
-	errors        chan error
+	inputCommands    chan *InputCommand
+	reliableMessages chan *ReliableMessage
+	playerJoins      chan uint64 // Player connection events
+	playerLeaves     chan uint64 // Player disconnection events
+	errors           chan error
 
 	// Shutdown
 	done chan struct{}
@@ -78,6 +91,9 @@ type clientConnection struct {
 	// Channels
 	stateUpdates chan *StateUpdate
 
+	// Reliable ordered messages, carried on the state update stream
+	reliable *ReliableChannel
+
 	// Thread safety
 	mu sync.RWMutex
 }
@@ -98,16 +114,17 @@ func NewServerWithLogger(config ServerConfig, logger *logrus.Logger) *TCPServer
 	}
 
 	return &TCPServer{
-		config:        config,
-		protocol:      NewBinaryProtocol(),
-		clients:       make(map[uint64]*clientConnection),
-		nextPlayerID:  1,
-		inputCommands: make(chan *InputCommand, config.BufferSize*config.MaxPlayers),
-		playerJoins:   make(chan uint64, config.MaxPlayers),
-		playerLeaves:  make(chan uint64, config.MaxPlayers),
-		errors:        make(chan error, 64),
-		done:          make(chan struct{}),
-		logger:        logEntry,
+		config:           config,
+		protocol:         NewBinaryProtocol(),
+		clients:          make(map[uint64]*clientConnection),
+		nextPlayerID:     1,
+		inputCommands:    make(chan *InputCommand, config.BufferSize*config.MaxPlayers),
+		reliableMessages: make(chan *ReliableMessage, config.BufferSize*config.MaxPlayers),
+		playerJoins:      make(chan uint64, config.MaxPlayers),
+		playerLeaves:     make(chan uint64, config.MaxPlayers),
+		errors:           make(chan error, 64),
+		done:             make(chan struct{}),
+		logger:           logEntry,
 	}
 }
 
@@ -133,6 +150,11 @@ func (s *TCPServer) Start() error {
 	s.wg.Add(1)
 	go s.acceptLoop()
 
+	if s.config.QueueSlots > 0 {
+		s.wg.Add(1)
+		go s.queueLoop()
+	}
+
 	return nil
 }
 
@@ -157,6 +179,7 @@ func (s *TCPServer) Stop() error {
 		client.disconnect()
 	}
 	s.clientsMu.Unlock()
+	s.closeQueue()
 
 	// Wait for goroutines
 	s.wg.Wait()
@@ -223,7 +246,24 @@ func (s *TCPServer) SendStateUpdate(playerID uint64, update *StateUpdate) error
 	s.stateSeq++
 	s.stateMu.Unlock()
 
-	client.sendStateUpdate(update)
+	return client.sendStateUpdate(update)
+}
+
+// SendReliable sends a message to a specific client over its reliable
+// channel. The message is resent until the client acknowledges it and is
+// delivered exactly once, in order with other reliable messages.
+func (s *TCPServer) SendReliable(playerID uint64, msgType string, data []byte) error {
+	s.clientsMu.RLock()
+	client, exists := s.clients[playerID]
+	s.clientsMu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("player %d not connected", playerID)
+	}
+
+	if _, err := client.reliable.Send(msgType, data); err != nil {
+		return fmt.Errorf("player %d: %w", playerID, err)
+	}
 	return nil
 }
 
@@ -232,6 +272,12 @@ func (s *TCPServer) ReceiveInputCommand() <-chan *InputCommand {
 	return s.inputCommands
 }
 
+// ReceiveReliable returns a channel for receiving reliable messages from
+// clients, in the order each client sent them.
+func (s *TCPServer) ReceiveReliable() <-chan *ReliableMessage {
+	return s.reliableMessages
+}
+
 // ReceivePlayerJoin returns a channel for receiving player join events.
 func (s *TCPServer) ReceivePlayerJoin() <-chan uint64 {
 	return s.playerJoins
@@ -263,48 +309,63 @@ func (s *TCPServer) acceptLoop() {
 			}
 		}
 
-		// Check player limit
-		s.clientsMu.RLock()
-		playerCount := len(s.clients)
-		s.clientsMu.RUnlock()
-
-		if playerCount >= s.config.MaxPlayers {
-			conn.Close()
-			s.errors <- fmt.Errorf("server full, rejected connection from %s", conn.RemoteAddr())
+		// Admit, queue or reject depending on capacity
+		s.clientsMu.Lock()
+		if len(s.clients) < s.config.MaxPlayers && len(s.queue) == 0 {
+			client := s.registerClient(conn)
+			s.clientsMu.Unlock()
+			s.startClient(client)
 			continue
 		}
+		s.clientsMu.Unlock()
 
-		// Create client connection
 		s.clientsMu.Lock()
-		playerID := s.nextPlayerID
-		s.nextPlayerID++
-
-		client := &clientConnection{
-			playerID:     playerID,
-			conn:         conn,
-			address:      conn.RemoteAddr().String(),
-			connected:    true,
-			lastActive:   time.Now(),
-			stateUpdates: make(chan *StateUpdate, s.config.BufferSize),
+		qc, position := s.enqueue(conn)
+		s.clientsMu.Unlock()
+		if qc == nil {
+			s.rejectFull(conn)
+			continue
+		}
+		if err := s.writeQueued(qc, position); err != nil {
+			s.reportError(fmt.Errorf("queued connection from %s: %w", conn.RemoteAddr(), err))
 		}
+	}
+}
 
-		s.clients[playerID] = client
-		s.clientsMu.Unlock()
+// registerClient creates a player for conn. Caller must hold s.clientsMu.
+func (s *TCPServer) registerClient(conn net.Conn) *clientConnection {
+	playerID := s.nextPlayerID
+	s.nextPlayerID++
+
+	client := &clientConnection{
+		playerID:     playerID,
+		conn:         conn,
+		address:      conn.RemoteAddr().String(),
+		connected:    true,
+		lastActive:   time.Now(),
+		stateUpdates: make(chan *StateUpdate, s.config.BufferSize),
+	}
+	client.reliable = NewReliableChannel(s.config.ReliableTimeout, func(packet []byte) {
+		client.sendStateUpdate(reliableStateUpdate(packet))
+	})
 
-		// Notify game logic of new player
-		select {
-		case s.playerJoins <- playerID:
-		case <-s.done:
-			return
-		default:
-			s.errors <- fmt.Errorf("player join channel full, dropped event for player %d", playerID)
-		}
+	s.clients[playerID] = client
+	return client
+}
 
-		// Start client handlers
-		s.wg.Add(2)
-		go s.handleClientReceive(client)
-		go s.handleClientSend(client)
+// startClient notifies game logic of a new player and starts its handlers.
+func (s *TCPServer) startClient(client *clientConnection) {
+	select {
+	case s.playerJoins <- client.playerID:
+	case <-s.done:
+		return
+	default:
+		s.reportError(fmt.Errorf("player join channel full, dropped event for player %d", client.playerID))
 	}
+
+	s.wg.Add(2)
+	go s.handleClientReceive(client)
+	go s.handleClientSend(client)
 }
 
 // handleClientReceive receives data from a client.
@@ -358,6 +419,25 @@ func (s *TCPServer) handleClientReceive(client *clientConnection) {
 			continue
 		}
 
+		// Reliable packets go through the client's reliable channel; the
+		// messages it releases are delivered in order and never dropped
+		if cmd.InputType == ReliableInputType {
+			messages, err := client.reliable.Receive(cmd.Data)
+			if err != nil {
+				s.errors <- fmt.Errorf("player %d reliable packet error: %w", client.playerID, err)
+				continue
+			}
+			for _, msg := range messages {
+				msg.PlayerID = client.playerID
+				select {
+				case s.reliableMessages <- msg:
+				case <-s.done:
+					return
+				}
+			}
+			continue
+		}
+
 		// Send to game logic (non-blocking)
 		select {
 		case s.inputCommands <- cmd:
@@ -369,16 +449,28 @@ func (s *TCPServer) handleClientReceive(client *clientConnection) {
 	}
 }
 
-// handleClientSend sends state updates to a client.
+// handleClientSend sends state updates to a client and retransmits
+// unacknowledged reliable messages.
 func (s *TCPServer) handleClientSend(client *clientConnection) {
 	defer s.wg.Done()
 
+	retransmitTicker := time.NewTicker(client.reliable.GetTimeout() / 2)
+	defer retransmitTicker.Stop()
+
 	for {
 		select {
 		case <-s.done:
 			return
 
-		case update := <-client.stateUpdates:
+		case now := <-retransmitTicker.C:
+			client.reliable.Retransmit(now)
+
+		case update, ok := <-client.stateUpdates:
+			if !ok {
+				// Client disconnected
+				return
+			}
+
 			// Encode state update
 			data, err := s.protocol.EncodeStateUpdate(update)
 			if err != nil {
@@ -433,6 +525,9 @@ func (s *TCPServer) disconnectClient(playerID uint64) {
 		default:
 			s.errors <- fmt.Errorf("player leave channel full, dropped event for player %d", playerID)
 		}
+
+		// A slot opened up for the next queued connection
+		s.admitQueued()
 	}
 }
 
@@ -457,18 +552,20 @@ func (c *clientConnection) disconnect() {
 	}
 }
 
-func (c *clientConnection) sendStateUpdate(update *StateUpdate) {
+func (c *clientConnection) sendStateUpdate(update *StateUpdate) error {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
 	if !c.connected {
-		return
+		return fmt.Errorf("player %d disconnected", c.playerID)
 	}
 
 	select {
 	case c.stateUpdates <- update:
+		return nil
 	default:
 		// Drop if full (prioritize fresh updates)
+		return ErrStateUpdateDropped
 	}
 }
 
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -354,7 +355,9 @@ func TestClientConnection_SendStateUpdate_Disconnected(t *testing.T) {
 	}
 
 	// Should not panic or block
-	client.sendStateUpdate(update)
+	if err := client.sendStateUpdate(update); err == nil {
+		t.Error("Expected an error sending to a disconnected client")
+	}
 
 	// Verify no update was queued
 	select {
@@ -364,3 +367,19 @@ func TestClientConnection_SendStateUpdate_Disconnected(t *testing.T) {
 		// Expected
 	}
 }
+
+// TestClientConnection_SendStateUpdate_QueueFull verifies a dropped update is reported.
+func TestClientConnection_SendStateUpdate_QueueFull(t *testing.T) {
+	client := &clientConnection{
+		playerID:     1,
+		connected:    true,
+		stateUpdates: make(chan *StateUpdate, 1),
+	}
+
+	if err := client.sendStateUpdate(&StateUpdate{EntityID: 1}); err != nil {
+		t.Fatalf("first update should be queued: %v", err)
+	}
+	if err := client.sendStateUpdate(&StateUpdate{EntityID: 2}); !errors.Is(err, ErrStateUpdateDropped) {
+		t.Errorf("sendStateUpdate on a full queue = %v, want ErrStateUpdateDropped", err)
+	}
+}
@@ -40,6 +40,9 @@ type SnapshotManager struct {
 
 	// Current sequence number
 	currentSeq uint32
+
+	// Delta compression settings used by Delta
+	deltaConfig DeltaConfig
 }
 
 // NewSnapshotManager creates a new snapshot manager
@@ -53,6 +56,7 @@ func NewSnapshotManager(maxSnapshots int) *SnapshotManager {
 		currentIndex: -1,
 		maxSnapshots: maxSnapshots,
 		currentSeq:   0,
+		deltaConfig:  DefaultDeltaConfig(),
 	}
 }
 
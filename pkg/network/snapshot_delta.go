@@ -0,0 +1,462 @@
+// Package network provides delta-compressed world state updates.
+// This file implements per-entity diffing of world snapshots into a single
+// StateUpdate that carries only the entities and fields that changed since
+// a baseline snapshot, periodic full keyframes so late joiners can sync,
+// and the client-side apply that rebuilds the world snapshot.
+package network
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+)
+
+// WorldUpdateEntityID is the EntityID of StateUpdates that describe the
+// whole world rather than one entity.
+const WorldUpdateEntityID uint64 = 0
+
+// Component types used inside world delta updates
+const (
+	// DeltaComponentBaseline holds the baseline snapshot sequence (uint32)
+	// the delta applies to, 0 for a full keyframe, followed by the snapshot
+	// sequence (uint32) it produces. The snapshot sequence is carried here
+	// because the server renumbers StateUpdate.SequenceNumber on send.
+	DeltaComponentBaseline = "delta_baseline"
+	// DeltaComponentEntity holds one added or changed entity
+	DeltaComponentEntity = "delta_entity"
+	// DeltaComponentRemoved holds the ID (uint64) of a removed entity
+	DeltaComponentRemoved = "delta_removed"
+)
+
+// Default delta settings
+const (
+	DefaultPositionThreshold = 0.01 // pixels
+	DefaultVelocityThreshold = 0.01 // pixels per second
+	DefaultKeyframeInterval  = 60   // ticks (3 seconds at 20 Hz)
+)
+
+// Field flags of an entity record
+const (
+	deltaFieldPosition uint8 = 1 << iota
+	deltaFieldVelocity
+	deltaFieldComponents
+	deltaFieldRemovedComponents
+)
+
+// DeltaConfig controls delta compression.
+type DeltaConfig struct {
+	// PositionThreshold is the smallest position change (either axis) sent
+	PositionThreshold float64
+
+	// VelocityThreshold is the smallest velocity change (either axis) sent
+	VelocityThreshold float64
+
+	// KeyframeInterval sends a full snapshot every N sequence numbers
+	// (0 = only when there is no baseline)
+	KeyframeInterval uint32
+}
+
+// DefaultDeltaConfig returns the default delta compression settings.
+func DefaultDeltaConfig() DeltaConfig {
+	return DeltaConfig{
+		PositionThreshold: DefaultPositionThreshold,
+		VelocityThreshold: DefaultVelocityThreshold,
+		KeyframeInterval:  DefaultKeyframeInterval,
+	}
+}
+
+// SetDeltaConfig sets the delta compression settings used by Delta.
+func (sm *SnapshotManager) SetDeltaConfig(config DeltaConfig) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.deltaConfig = config
+}
+
+// GetDeltaConfig returns the delta compression settings.
+func (sm *SnapshotManager) GetDeltaConfig() DeltaConfig {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.deltaConfig
+}
+
+// Delta encodes the changes from prev to curr as one world StateUpdate.
+// Entities are sent only if new or if a field changed by more than its
+// threshold, and only the changed fields are included. A full keyframe is
+// sent instead when prev has no sequence number or curr's sequence falls on
+// the keyframe interval.
+//
+// Because changes under the threshold are not sent, callers should diff
+// against the state the receiver holds (the previous update applied to its
+// baseline with ApplyStateUpdate), not the previous server snapshot, so
+// small movements accumulate until they are sent.
+func (sm *SnapshotManager) Delta(prev, curr WorldSnapshot) *StateUpdate {
+	config := sm.GetDeltaConfig()
+	if prev.Sequence == 0 || (config.KeyframeInterval > 0 && curr.Sequence%config.KeyframeInterval == 0) {
+		return Keyframe(curr)
+	}
+
+	update := newWorldUpdate(curr, prev.Sequence, PriorityNormal)
+	for _, id := range sortedEntityIDs(prev.Entities) {
+		if _, exists := curr.Entities[id]; !exists {
+			update.Components = append(update.Components, ComponentData{
+				Type: DeltaComponentRemoved,
+				Data: encodeEntityID(id),
+			})
+		}
+	}
+	for _, id := range sortedEntityIDs(curr.Entities) {
+		entity := curr.Entities[id]
+		fields := deltaFieldPosition | deltaFieldVelocity | deltaFieldComponents
+		var components map[string][]byte
+		var removed []string
+		if old, existed := prev.Entities[id]; existed {
+			fields, components, removed = changedFields(old, entity, config)
+			if fields == 0 {
+				continue
+			}
+		} else {
+			components = entity.Components
+		}
+		update.Components = append(update.Components, ComponentData{
+			Type: DeltaComponentEntity,
+			Data: encodeEntityRecord(entity, fields, components, removed),
+		})
+	}
+	return update
+}
+
+// Keyframe encodes every entity of a snapshot as a full world StateUpdate.
+func Keyframe(curr WorldSnapshot) *StateUpdate {
+	update := newWorldUpdate(curr, 0, PriorityHigh)
+	for _, id := range sortedEntityIDs(curr.Entities) {
+		entity := curr.Entities[id]
+		update.Components = append(update.Components, ComponentData{
+			Type: DeltaComponentEntity,
+			Data: encodeEntityRecord(entity, deltaFieldPosition|deltaFieldVelocity|deltaFieldComponents, entity.Components, nil),
+		})
+	}
+	return update
+}
+
+// IsKeyframe reports whether a world update is a full keyframe.
+func IsKeyframe(update *StateUpdate) bool {
+	baseline, _, err := updateBaseline(update)
+	return err == nil && baseline == 0
+}
+
+// ApplyStateUpdate applies a world update to base and returns the new
+// snapshot, leaving base unchanged. Keyframes replace base entirely (base
+// may be nil); deltas require base to be the update's baseline.
+func ApplyStateUpdate(base *WorldSnapshot, update *StateUpdate) (*WorldSnapshot, error) {
+	baseline, seq, err := updateBaseline(update)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WorldSnapshot{
+		Timestamp: time.Unix(0, int64(update.Timestamp)*int64(time.Millisecond)),
+		Sequence:  seq,
+		Entities:  make(map[uint64]EntitySnapshot),
+	}
+	if baseline != 0 {
+		if base == nil || base.Sequence != baseline {
+			have := uint32(0)
+			if base != nil {
+				have = base.Sequence
+			}
+			return nil, fmt.Errorf("delta %d needs baseline %d, have %d", seq, baseline, have)
+		}
+		for id, entity := range base.Entities {
+			result.Entities[id] = entity
+		}
+	}
+
+	for i, comp := range update.Components[1:] {
+		switch comp.Type {
+		case DeltaComponentRemoved:
+			id, err := decodeEntityID(comp.Data)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: %w", i+1, err)
+			}
+			delete(result.Entities, id)
+		case DeltaComponentEntity:
+			if err := decodeEntityRecord(comp.Data, result.Entities, seq, result.Timestamp); err != nil {
+				return nil, fmt.Errorf("record %d: %w", i+1, err)
+			}
+		default:
+			return nil, fmt.Errorf("record %d: unknown delta record type %q", i+1, comp.Type)
+		}
+	}
+	return result, nil
+}
+
+// ApplyStateUpdate applies a world update received from the server to the
+// latest snapshot and stores the result under the server's sequence
+// number. Deltas whose baseline is not the latest snapshot (e.g. after
+// joining late) return an error and should be dropped until the next
+// keyframe.
+func (sm *SnapshotManager) ApplyStateUpdate(update *StateUpdate) (*WorldSnapshot, error) {
+	snapshot, err := ApplyStateUpdate(sm.GetLatestSnapshot(), update)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.currentSeq = snapshot.Sequence
+	sm.currentIndex = (sm.currentIndex + 1) % sm.maxSnapshots
+	sm.snapshots[sm.currentIndex] = *snapshot
+	return snapshot, nil
+}
+
+// newWorldUpdate creates a world update header for curr.
+func newWorldUpdate(curr WorldSnapshot, baseline uint32, priority uint8) *StateUpdate {
+	var baselineData [8]byte
+	binary.LittleEndian.PutUint32(baselineData[:4], baseline)
+	binary.LittleEndian.PutUint32(baselineData[4:], curr.Sequence)
+	return &StateUpdate{
+		Timestamp:      uint64(curr.Timestamp.UnixNano() / int64(time.Millisecond)),
+		EntityID:       WorldUpdateEntityID,
+		Priority:       priority,
+		SequenceNumber: curr.Sequence,
+		Components:     []ComponentData{{Type: DeltaComponentBaseline, Data: baselineData[:]}},
+	}
+}
+
+// updateBaseline returns the baseline and snapshot sequences of a world
+// update.
+func updateBaseline(update *StateUpdate) (baseline, seq uint32, err error) {
+	if update == nil {
+		return 0, 0, fmt.Errorf("cannot apply nil state update")
+	}
+	if update.EntityID != WorldUpdateEntityID || len(update.Components) == 0 ||
+		update.Components[0].Type != DeltaComponentBaseline || len(update.Components[0].Data) != 8 {
+		return 0, 0, fmt.Errorf("state update %d is not a world delta", update.SequenceNumber)
+	}
+	data := update.Components[0].Data
+	return binary.LittleEndian.Uint32(data[:4]), binary.LittleEndian.Uint32(data[4:]), nil
+}
+
+// changedFields returns which fields of an entity changed beyond the
+// thresholds, the components whose data changed and the names of the
+// components the entity no longer has.
+func changedFields(old, curr EntitySnapshot, config DeltaConfig) (uint8, map[string][]byte, []string) {
+	var fields uint8
+	if math.Abs(curr.Position.X-old.Position.X) > config.PositionThreshold ||
+		math.Abs(curr.Position.Y-old.Position.Y) > config.PositionThreshold {
+		fields |= deltaFieldPosition
+	}
+	if math.Abs(curr.Velocity.VX-old.Velocity.VX) > config.VelocityThreshold ||
+		math.Abs(curr.Velocity.VY-old.Velocity.VY) > config.VelocityThreshold {
+		fields |= deltaFieldVelocity
+	}
+
+	var components map[string][]byte
+	for name, data := range curr.Components {
+		if oldData, ok := old.Components[name]; !ok || !bytes.Equal(oldData, data) {
+			if components == nil {
+				components = make(map[string][]byte)
+			}
+			components[name] = data
+		}
+	}
+	if components != nil {
+		fields |= deltaFieldComponents
+	}
+
+	var removed []string
+	for _, name := range sortedComponentNames(old.Components) {
+		if _, ok := curr.Components[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	if removed != nil {
+		fields |= deltaFieldRemovedComponents
+	}
+	return fields, components, removed
+}
+
+// encodeEntityRecord serializes the selected fields of an entity.
+// Binary format:
+//   - EntityID: 8 bytes (uint64)
+//   - Fields: 1 byte (flags)
+//   - Position X, Y: 16 bytes (float64), if flagged
+//   - Velocity VX, VY: 16 bytes (float64), if flagged
+//   - ComponentCount: 2 bytes (uint16), if flagged
+//   - For each component: TypeLength (uint16), Type, DataLength (uint32), Data
+//   - RemovedCount: 2 bytes (uint16), if flagged
+//   - For each removed component: TypeLength (uint16), Type
+func encodeEntityRecord(entity EntitySnapshot, fields uint8, components map[string][]byte, removed []string) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.LittleEndian, entity.EntityID)
+	binary.Write(buf, binary.LittleEndian, fields)
+	if fields&deltaFieldPosition != 0 {
+		binary.Write(buf, binary.LittleEndian, entity.Position.X)
+		binary.Write(buf, binary.LittleEndian, entity.Position.Y)
+	}
+	if fields&deltaFieldVelocity != 0 {
+		binary.Write(buf, binary.LittleEndian, entity.Velocity.VX)
+		binary.Write(buf, binary.LittleEndian, entity.Velocity.VY)
+	}
+	if fields&deltaFieldComponents != 0 {
+		names := sortedComponentNames(components)
+		binary.Write(buf, binary.LittleEndian, uint16(len(names)))
+		for _, name := range names {
+			binary.Write(buf, binary.LittleEndian, uint16(len(name)))
+			buf.WriteString(name)
+			binary.Write(buf, binary.LittleEndian, uint32(len(components[name])))
+			buf.Write(components[name])
+		}
+	}
+	if fields&deltaFieldRemovedComponents != 0 {
+		binary.Write(buf, binary.LittleEndian, uint16(len(removed)))
+		for _, name := range removed {
+			binary.Write(buf, binary.LittleEndian, uint16(len(name)))
+			buf.WriteString(name)
+		}
+	}
+	return buf.Bytes()
+}
+
+// decodeEntityRecord applies one entity record to entities.
+func decodeEntityRecord(data []byte, entities map[uint64]EntitySnapshot, seq uint32, timestamp time.Time) error {
+	r := bytes.NewReader(data)
+	var id uint64
+	var fields uint8
+	if err := binary.Read(r, binary.LittleEndian, &id); err != nil {
+		return fmt.Errorf("failed to read entity ID: %w", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &fields); err != nil {
+		return fmt.Errorf("failed to read fields of entity %d: %w", id, err)
+	}
+
+	entity, existed := entities[id]
+	if !existed {
+		entity = EntitySnapshot{EntityID: id}
+	}
+	entity.Sequence = seq
+	entity.Timestamp = timestamp
+
+	if fields&deltaFieldPosition != 0 {
+		if err := binary.Read(r, binary.LittleEndian, &entity.Position); err != nil {
+			return fmt.Errorf("failed to read position of entity %d: %w", id, err)
+		}
+	}
+	if fields&deltaFieldVelocity != 0 {
+		if err := binary.Read(r, binary.LittleEndian, &entity.Velocity); err != nil {
+			return fmt.Errorf("failed to read velocity of entity %d: %w", id, err)
+		}
+	}
+	if fields&deltaFieldComponents != 0 {
+		var count uint16
+		if err := binary.Read(r, binary.LittleEndian, &count); err != nil {
+			return fmt.Errorf("failed to read component count of entity %d: %w", id, err)
+		}
+		merged := make(map[string][]byte, len(entity.Components)+int(count))
+		for name, comp := range entity.Components {
+			merged[name] = comp
+		}
+		for i := uint16(0); i < count; i++ {
+			name, comp, err := readDeltaComponent(r)
+			if err != nil {
+				return fmt.Errorf("component %d of entity %d: %w", i, id, err)
+			}
+			merged[name] = comp
+		}
+		entity.Components = merged
+	}
+	if fields&deltaFieldRemovedComponents != 0 {
+		var count uint16
+		if err := binary.Read(r, binary.LittleEndian, &count); err != nil {
+			return fmt.Errorf("failed to read removed component count of entity %d: %w", id, err)
+		}
+		remaining := make(map[string][]byte, len(entity.Components))
+		for name, comp := range entity.Components {
+			remaining[name] = comp
+		}
+		for i := uint16(0); i < count; i++ {
+			name, err := readDeltaComponentName(r)
+			if err != nil {
+				return fmt.Errorf("removed component %d of entity %d: %w", i, id, err)
+			}
+			delete(remaining, name)
+		}
+		entity.Components = remaining
+	}
+
+	entities[id] = entity
+	return nil
+}
+
+// readDeltaComponent reads one component entry of an entity record.
+func readDeltaComponent(r *bytes.Reader) (string, []byte, error) {
+	name, err := readDeltaComponentName(r)
+	if err != nil {
+		return "", nil, err
+	}
+	var dataLength uint32
+	if err := binary.Read(r, binary.LittleEndian, &dataLength); err != nil {
+		return "", nil, fmt.Errorf("failed to read data length: %w", err)
+	}
+	if int64(dataLength) > int64(r.Len()) {
+		return "", nil, fmt.Errorf("data length %d exceeds remaining %d bytes", dataLength, r.Len())
+	}
+	data := make([]byte, dataLength)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return "", nil, fmt.Errorf("failed to read data: %w", err)
+	}
+	return name, data, nil
+}
+
+// readDeltaComponentName reads a length-prefixed component type.
+func readDeltaComponentName(r *bytes.Reader) (string, error) {
+	var nameLength uint16
+	if err := binary.Read(r, binary.LittleEndian, &nameLength); err != nil {
+		return "", fmt.Errorf("failed to read type length: %w", err)
+	}
+	name := make([]byte, nameLength)
+	if _, err := io.ReadFull(r, name); err != nil {
+		return "", fmt.Errorf("failed to read type: %w", err)
+	}
+	return string(name), nil
+}
+
+// encodeEntityID serializes an entity ID.
+func encodeEntityID(id uint64) []byte {
+	var data [8]byte
+	binary.LittleEndian.PutUint64(data[:], id)
+	return data[:]
+}
+
+// decodeEntityID deserializes an entity ID.
+func decodeEntityID(data []byte) (uint64, error) {
+	if len(data) != 8 {
+		return 0, fmt.Errorf("invalid entity ID length: %d", len(data))
+	}
+	return binary.LittleEndian.Uint64(data), nil
+}
+
+// sortedEntityIDs returns the keys of entities in ascending order so
+// updates are deterministic.
+func sortedEntityIDs(entities map[uint64]EntitySnapshot) []uint64 {
+	ids := make([]uint64, 0, len(entities))
+	for id := range entities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// sortedComponentNames returns the keys of components in ascending order.
+func sortedComponentNames(components map[string][]byte) []string {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
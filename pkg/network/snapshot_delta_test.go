@@ -0,0 +1,330 @@
+package network
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+// deltaWorld builds a world snapshot with the given sequence and entities.
+func deltaWorld(seq uint32, entities ...EntitySnapshot) WorldSnapshot {
+	world := WorldSnapshot{
+		Timestamp: time.Unix(1000, 0),
+		Sequence:  seq,
+		Entities:  make(map[uint64]EntitySnapshot),
+	}
+	for _, e := range entities {
+		world.Entities[e.EntityID] = e
+	}
+	return world
+}
+
+// countRecords counts the records of each type in a world update.
+func countRecords(update *StateUpdate, recordType string) int {
+	count := 0
+	for _, comp := range update.Components {
+		if comp.Type == recordType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestSnapshotManager_Delta_OnlyChangedEntities(t *testing.T) {
+	sm := NewSnapshotManager(10)
+	prev := deltaWorld(1,
+		EntitySnapshot{EntityID: 1, Position: Position{X: 10, Y: 10}},
+		EntitySnapshot{EntityID: 2, Position: Position{X: 20, Y: 20}},
+		EntitySnapshot{EntityID: 3, Position: Position{X: 30, Y: 30}},
+	)
+	curr := deltaWorld(2,
+		EntitySnapshot{EntityID: 1, Position: Position{X: 15, Y: 10}},
+		EntitySnapshot{EntityID: 2, Position: Position{X: 20.001, Y: 20}}, // under threshold
+		EntitySnapshot{EntityID: 4, Position: Position{X: 40, Y: 40}},
+	)
+
+	update := sm.Delta(prev, curr)
+
+	if update.EntityID != WorldUpdateEntityID || update.SequenceNumber != 2 {
+		t.Fatalf("unexpected header: entity %d, sequence %d", update.EntityID, update.SequenceNumber)
+	}
+	if IsKeyframe(update) {
+		t.Fatal("delta should not be a keyframe")
+	}
+	if got := countRecords(update, DeltaComponentEntity); got != 2 {
+		t.Errorf("entity records = %d, want 2 (moved and added)", got)
+	}
+	if got := countRecords(update, DeltaComponentRemoved); got != 1 {
+		t.Errorf("removed records = %d, want 1", got)
+	}
+}
+
+func TestSnapshotManager_Delta_OnlyChangedFields(t *testing.T) {
+	sm := NewSnapshotManager(10)
+	prev := deltaWorld(1, EntitySnapshot{
+		EntityID:   1,
+		Position:   Position{X: 10, Y: 10},
+		Velocity:   Velocity{VX: 5},
+		Components: map[string][]byte{"health": {100}, "sprite": {1, 2, 3}},
+	})
+	curr := deltaWorld(2, EntitySnapshot{
+		EntityID:   1,
+		Position:   Position{X: 10, Y: 10},
+		Velocity:   Velocity{VX: 5},
+		Components: map[string][]byte{"health": {90}, "sprite": {1, 2, 3}},
+	})
+
+	full := Keyframe(curr)
+	update := sm.Delta(prev, curr)
+
+	if len(update.Components) != 2 {
+		t.Fatalf("expected baseline and one entity record, got %d records", len(update.Components))
+	}
+	if StateUpdateSize(update) >= StateUpdateSize(full) {
+		t.Errorf("delta size %d should be smaller than keyframe size %d", StateUpdateSize(update), StateUpdateSize(full))
+	}
+
+	result, err := ApplyStateUpdate(&prev, update)
+	if err != nil {
+		t.Fatalf("ApplyStateUpdate failed: %v", err)
+	}
+	entity := result.Entities[1]
+	if entity.Components["health"][0] != 90 {
+		t.Errorf("health = %v, want 90", entity.Components["health"])
+	}
+	if len(entity.Components["sprite"]) != 3 {
+		t.Error("unchanged component should be kept from the baseline")
+	}
+	if entity.Position.X != 10 || entity.Velocity.VX != 5 {
+		t.Errorf("unchanged fields lost: %+v", entity)
+	}
+}
+
+func TestSnapshotManager_Delta_NoChanges(t *testing.T) {
+	sm := NewSnapshotManager(10)
+	prev := deltaWorld(1, EntitySnapshot{EntityID: 1, Position: Position{X: 10, Y: 10}})
+	curr := deltaWorld(2, EntitySnapshot{EntityID: 1, Position: Position{X: 10, Y: 10}})
+
+	update := sm.Delta(prev, curr)
+	if len(update.Components) != 1 {
+		t.Errorf("expected only the baseline record, got %d records", len(update.Components))
+	}
+}
+
+func TestSnapshotManager_Delta_Thresholds(t *testing.T) {
+	sm := NewSnapshotManager(10)
+	config := DefaultDeltaConfig()
+	config.PositionThreshold = 1.0
+	config.VelocityThreshold = 2.0
+	sm.SetDeltaConfig(config)
+
+	prev := deltaWorld(1, EntitySnapshot{EntityID: 1})
+	small := deltaWorld(2, EntitySnapshot{EntityID: 1, Position: Position{X: 0.5}, Velocity: Velocity{VY: 1.5}})
+	large := deltaWorld(2, EntitySnapshot{EntityID: 1, Position: Position{X: 1.5}})
+
+	if got := countRecords(sm.Delta(prev, small), DeltaComponentEntity); got != 0 {
+		t.Errorf("changes under threshold sent %d records", got)
+	}
+	if got := countRecords(sm.Delta(prev, large), DeltaComponentEntity); got != 1 {
+		t.Errorf("change over threshold sent %d records, want 1", got)
+	}
+}
+
+func TestSnapshotManager_Delta_Keyframes(t *testing.T) {
+	sm := NewSnapshotManager(10)
+	config := DefaultDeltaConfig()
+	config.KeyframeInterval = 5
+	sm.SetDeltaConfig(config)
+
+	entity := EntitySnapshot{EntityID: 1, Position: Position{X: 10, Y: 10}}
+
+	if update := sm.Delta(WorldSnapshot{}, deltaWorld(3, entity)); !IsKeyframe(update) {
+		t.Error("delta without a baseline should be a keyframe")
+	}
+
+	for seq := uint32(2); seq <= 10; seq++ {
+		update := sm.Delta(deltaWorld(seq-1, entity), deltaWorld(seq, entity))
+		want := seq%5 == 0
+		if IsKeyframe(update) != want {
+			t.Errorf("sequence %d: keyframe = %v, want %v", seq, IsKeyframe(update), want)
+		}
+		if want {
+			if got := countRecords(update, DeltaComponentEntity); got != 1 {
+				t.Errorf("keyframe should contain every entity, got %d records", got)
+			}
+			if update.Priority != PriorityHigh {
+				t.Errorf("keyframe priority = %d, want %d", update.Priority, PriorityHigh)
+			}
+		}
+	}
+}
+
+func TestApplyStateUpdate_BaselineMismatch(t *testing.T) {
+	sm := NewSnapshotManager(10)
+	prev := deltaWorld(4, EntitySnapshot{EntityID: 1})
+	curr := deltaWorld(6, EntitySnapshot{EntityID: 1, Position: Position{X: 5}})
+	update := sm.Delta(prev, curr)
+
+	stale := deltaWorld(3, EntitySnapshot{EntityID: 1})
+	if _, err := ApplyStateUpdate(&stale, update); err == nil {
+		t.Error("expected error applying delta to the wrong baseline")
+	}
+	if _, err := ApplyStateUpdate(nil, update); err == nil {
+		t.Error("expected error applying delta without a baseline")
+	}
+	if _, err := ApplyStateUpdate(nil, Keyframe(curr)); err != nil {
+		t.Errorf("keyframe should apply without a baseline: %v", err)
+	}
+	if _, err := ApplyStateUpdate(nil, &StateUpdate{EntityID: 7}); err == nil {
+		t.Error("expected error applying an entity update as a world delta")
+	}
+}
+
+func TestSnapshotManager_ApplyStateUpdate_RoundTrip(t *testing.T) {
+	server := NewSnapshotManager(10)
+	client := NewSnapshotManager(10)
+	protocol := NewBinaryProtocol()
+
+	ticks := []WorldSnapshot{
+		deltaWorld(1,
+			EntitySnapshot{EntityID: 1, Position: Position{X: 0, Y: 0}, Components: map[string][]byte{"health": {100}}},
+			EntitySnapshot{EntityID: 2, Position: Position{X: 50, Y: 50}},
+		),
+		deltaWorld(2,
+			EntitySnapshot{EntityID: 1, Position: Position{X: 4, Y: 0}, Velocity: Velocity{VX: 80}, Components: map[string][]byte{"health": {100}}},
+			EntitySnapshot{EntityID: 2, Position: Position{X: 50, Y: 50}},
+		),
+		deltaWorld(3,
+			EntitySnapshot{EntityID: 1, Position: Position{X: 8, Y: 0}, Velocity: Velocity{VX: 80}, Components: map[string][]byte{"health": {75}}},
+			EntitySnapshot{EntityID: 3, Position: Position{X: 70, Y: 10}},
+		),
+	}
+
+	var baseline WorldSnapshot
+	for _, tick := range ticks {
+		update := server.Delta(baseline, tick)
+
+		data, err := protocol.EncodeStateUpdate(update)
+		if err != nil {
+			t.Fatalf("encode sequence %d: %v", tick.Sequence, err)
+		}
+		decoded, err := protocol.DecodeStateUpdate(data)
+		if err != nil {
+			t.Fatalf("decode sequence %d: %v", tick.Sequence, err)
+		}
+		decoded.SequenceNumber += 100 // the server renumbers updates on send
+
+		got, err := client.ApplyStateUpdate(decoded)
+		if err != nil {
+			t.Fatalf("apply sequence %d: %v", tick.Sequence, err)
+		}
+		baseline = *got
+
+		if got.Sequence != tick.Sequence || client.GetCurrentSequence() != tick.Sequence {
+			t.Errorf("client sequence = %d/%d, want %d", got.Sequence, client.GetCurrentSequence(), tick.Sequence)
+		}
+		if len(got.Entities) != len(tick.Entities) {
+			t.Fatalf("sequence %d: %d entities, want %d", tick.Sequence, len(got.Entities), len(tick.Entities))
+		}
+		for id, want := range tick.Entities {
+			if !entityEquals(got.Entities[id], want) {
+				t.Errorf("sequence %d entity %d = %+v, want %+v", tick.Sequence, id, got.Entities[id], want)
+			}
+		}
+	}
+
+	if client.GetLatestSnapshot().Entities[1].Components["health"][0] != 75 {
+		t.Error("client should hold the latest component data")
+	}
+}
+
+func TestApplyStateUpdate_RemovedComponents(t *testing.T) {
+	sm := NewSnapshotManager(10)
+	prev := deltaWorld(1, EntitySnapshot{
+		EntityID:   1,
+		Components: map[string][]byte{"burning": {1}, "health": {100}, "stunned": {1}},
+	})
+	curr := deltaWorld(2, EntitySnapshot{
+		EntityID:   1,
+		Components: map[string][]byte{"health": {90}},
+	})
+
+	update := sm.Delta(prev, curr)
+	if got := countRecords(update, DeltaComponentEntity); got != 1 {
+		t.Fatalf("entity records = %d, want 1", got)
+	}
+
+	result, err := ApplyStateUpdate(&prev, update)
+	if err != nil {
+		t.Fatalf("ApplyStateUpdate failed: %v", err)
+	}
+	components := result.Entities[1].Components
+	if len(components) != 1 || components["health"][0] != 90 {
+		t.Errorf("components = %v, want only the updated health", components)
+	}
+	if len(prev.Entities[1].Components) != 3 {
+		t.Error("applying the delta modified the baseline")
+	}
+
+	// Removing a component is a change on its own
+	unchanged := deltaWorld(3, EntitySnapshot{EntityID: 1})
+	if got := countRecords(sm.Delta(curr, unchanged), DeltaComponentEntity); got != 1 {
+		t.Errorf("entity records after removing the last component = %d, want 1", got)
+	}
+}
+
+func TestTCPClient_AppliesWorldUpdates(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	serverConfig := DefaultServerConfig()
+	serverConfig.Address = address
+	server := NewServer(serverConfig)
+	if err := server.Start(); err != nil {
+		t.Fatalf("server start: %v", err)
+	}
+	defer server.Stop()
+
+	clientConfig := DefaultClientConfig()
+	clientConfig.ServerAddress = address
+	client := NewClient(clientConfig)
+	if err := client.Connect(); err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	var playerID uint64
+	select {
+	case playerID = <-server.ReceivePlayerJoin():
+	case <-time.After(2 * time.Second):
+		t.Fatal("player never joined")
+	}
+
+	sm := NewSnapshotManager(10)
+	first := deltaWorld(1, EntitySnapshot{EntityID: 1, Position: Position{X: 10, Y: 10}})
+	second := deltaWorld(2, EntitySnapshot{EntityID: 1, Position: Position{X: 20, Y: 10}})
+	for _, update := range []*StateUpdate{sm.Delta(WorldSnapshot{}, first), sm.Delta(first, second)} {
+		if err := server.SendStateUpdate(playerID, update); err != nil {
+			t.Fatalf("SendStateUpdate: %v", err)
+		}
+	}
+
+	// The client rebuilds the world without anyone reading its channel
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if world := client.WorldSnapshot(); world != nil && world.Sequence == 2 {
+			if x := world.Entities[1].Position.X; x != 20 {
+				t.Errorf("entity 1 X = %f, want 20", x)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("client world = %+v, want sequence 2", client.WorldSnapshot())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
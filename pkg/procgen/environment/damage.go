@@ -0,0 +1,201 @@
+// Package environment provides destructible object damage visuals.
+// This file generates progressively damaged sprites for obstacles and tracks
+// their health, so renderers can show cracks as objects are attacked.
+package environment
+
+import (
+	"image"
+	"image/color"
+	"math/rand"
+)
+
+// Damage stage indices of destructible objects.
+const (
+	DamageIntact    = 0
+	DamageCracked   = 1
+	DamageShattered = 2
+
+	// DamageStageCount is the number of damage stages of a destructible object.
+	DamageStageCount = 3
+)
+
+// damageSeedOffset decorrelates the crack pattern from the sprite rng.
+const damageSeedOffset = 7919
+
+// destructibleHealth returns the health of a destructible subtype, or 0 if
+// the subtype cannot be destroyed. All obstacles are destructible.
+func destructibleHealth(subType SubType) int {
+	switch subType {
+	case SubTypeDebris:
+		return 10
+	case SubTypeRubble:
+		return 15
+	case SubTypeBarrel:
+		return 20
+	case SubTypeCrate:
+		return 25
+	case SubTypeWreckage:
+		return 30
+	case SubTypePillar, SubTypeColumn:
+		return 60
+	case SubTypeBoulder:
+		return 80
+	default:
+		return 0
+	}
+}
+
+// IsDestructible reports whether the object can be damaged and destroyed.
+func (o *EnvironmentalObject) IsDestructible() bool {
+	return o.MaxHealth > 0
+}
+
+// IsDestroyed reports whether a destructible object has no health left.
+func (o *EnvironmentalObject) IsDestroyed() bool {
+	return o.IsDestructible() && o.Health <= 0
+}
+
+// ApplyDamage reduces a destructible object's health and returns true if
+// the hit destroyed it. Objects with a broken state switch to it when
+// destroyed. Indestructible objects ignore damage.
+func (o *EnvironmentalObject) ApplyDamage(amount int) bool {
+	if !o.IsDestructible() || o.Health <= 0 || amount <= 0 {
+		return false
+	}
+
+	o.Health -= amount
+	if o.Health > 0 {
+		return false
+	}
+
+	o.Health = 0
+	if o.HasStates() && o.StateNames[StateBroken] == "broken" {
+		o.SetState(StateBroken)
+	}
+	return true
+}
+
+// DamageStage returns the damage stage index for the object's remaining
+// health: intact above two thirds, cracked above one third, then shattered.
+func (o *EnvironmentalObject) DamageStage() int {
+	if !o.IsDestructible() || len(o.DamageStages) == 0 {
+		return DamageIntact
+	}
+	lost := float64(o.MaxHealth-o.Health) / float64(o.MaxHealth)
+	stage := int(lost * float64(len(o.DamageStages)))
+	if stage >= len(o.DamageStages) {
+		stage = len(o.DamageStages) - 1
+	}
+	if stage < 0 {
+		stage = 0
+	}
+	return stage
+}
+
+// DamageSprite returns the sprite to render for the object's current health.
+// Destroyed objects and objects without damage stages use Sprite.
+func (o *EnvironmentalObject) DamageSprite() *image.RGBA {
+	if len(o.DamageStages) == 0 || o.IsDestroyed() {
+		return o.Sprite
+	}
+	return o.DamageStages[o.DamageStage()]
+}
+
+// generateDamageStages creates the intact, cracked and shattered sprites of a
+// destructible object. Returns nil for indestructible subtypes.
+func (g *Generator) generateDamageStages(config Config, sprite *image.RGBA) ([]*image.RGBA, error) {
+	if destructibleHealth(config.SubType) == 0 {
+		return nil, nil
+	}
+
+	pal, err := g.paletteGen.Generate(config.GenreID, config.Seed)
+	if err != nil {
+		return nil, err
+	}
+	base, _ := g.selectColors(config.SubType.GetObjectType(), pal)
+	crackColor := darken(base, 0.35)
+	rng := rand.New(rand.NewSource(config.Seed + damageSeedOffset))
+	w := config.Width
+
+	cracked := image.NewRGBA(sprite.Bounds())
+	copy(cracked.Pix, sprite.Pix)
+	shattered := image.NewRGBA(sprite.Bounds())
+
+	// Cracks and chips start on the object itself, so sparse sprites such
+	// as debris still show damage
+	solid := opaquePixels(sprite)
+	if len(solid) == 0 {
+		copy(shattered.Pix, sprite.Pix)
+		return []*image.RGBA{sprite, cracked, shattered}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		g.drawCrack(cracked, solid[rng.Intn(len(solid))], w, crackColor, rng)
+	}
+	copy(shattered.Pix, cracked.Pix)
+	for i := 0; i < 3; i++ {
+		g.drawCrack(shattered, solid[rng.Intn(len(solid))], w, crackColor, rng)
+	}
+	for i := 0; i < 3; i++ {
+		g.chipOff(shattered, solid[rng.Intn(len(solid))], max(w/10, 1))
+	}
+
+	return []*image.RGBA{sprite, cracked, shattered}, nil
+}
+
+// opaquePixels returns the positions of a sprite's non-transparent pixels.
+func opaquePixels(img *image.RGBA) []image.Point {
+	var points []image.Point
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if img.RGBAAt(x, y).A > 0 {
+				points = append(points, image.Pt(x, y))
+			}
+		}
+	}
+	return points
+}
+
+// drawCrack draws a jagged crack of three segments from start. Only opaque
+// pixels are cracked.
+func (g *Generator) drawCrack(img *image.RGBA, start image.Point, width int, c color.Color, rng *rand.Rand) {
+	x, y := start.X, start.Y
+	step := max(width/5, 2)
+
+	for segment := 0; segment < 3; segment++ {
+		nx := x + rng.Intn(2*step+1) - step
+		ny := y + rng.Intn(2*step+1) - step
+		g.drawOpaqueLine(img, x, y, nx, ny, c)
+		x, y = nx, ny
+	}
+}
+
+// drawOpaqueLine draws a line that only touches non-transparent pixels.
+func (g *Generator) drawOpaqueLine(img *image.RGBA, x1, y1, x2, y2 int, c color.Color) {
+	steps := max(abs(x2-x1), abs(y2-y1))
+	bounds := img.Bounds()
+	for i := 0; i <= steps; i++ {
+		x, y := x1, y1
+		if steps > 0 {
+			x = x1 + (x2-x1)*i/steps
+			y = y1 + (y2-y1)*i/steps
+		}
+		if image.Pt(x, y).In(bounds) && img.RGBAAt(x, y).A > 0 {
+			img.Set(x, y, c)
+		}
+	}
+}
+
+// chipOff knocks a round chunk out of the sprite.
+func (g *Generator) chipOff(img *image.RGBA, center image.Point, radius int) {
+	centerX, centerY := center.X, center.Y
+	bounds := img.Bounds()
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if dx*dx+dy*dy <= radius*radius && image.Pt(centerX+dx, centerY+dy).In(bounds) {
+				img.Set(centerX+dx, centerY+dy, color.Transparent)
+			}
+		}
+	}
+}
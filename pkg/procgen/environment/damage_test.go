@@ -0,0 +1,88 @@
+package environment
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestGenerator_GenerateDamageStages tests that obstacles get three distinct,
+// deterministic damage stages and other objects get none.
+func TestGenerator_GenerateDamageStages(t *testing.T) {
+	gen := NewGenerator()
+
+	obstacles := []SubType{
+		SubTypeBarrel, SubTypeCrate, SubTypeRubble, SubTypePillar,
+		SubTypeBoulder, SubTypeDebris, SubTypeWreckage, SubTypeColumn,
+	}
+	for _, subType := range obstacles {
+		t.Run(subType.String(), func(t *testing.T) {
+			config := Config{SubType: subType, Width: 32, Height: 32, GenreID: "postapoc", Seed: 21}
+			obj, err := gen.Generate(config)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if len(obj.DamageStages) != DamageStageCount || obj.DamageStages[DamageIntact] != obj.Sprite {
+				t.Fatalf("got %d damage stages, want %d starting with the sprite", len(obj.DamageStages), DamageStageCount)
+			}
+			if obj.MaxHealth <= 0 || obj.Health != obj.MaxHealth {
+				t.Errorf("health = %d/%d", obj.Health, obj.MaxHealth)
+			}
+			for i := 1; i < DamageStageCount; i++ {
+				if bytes.Equal(obj.DamageStages[i].Pix, obj.DamageStages[i-1].Pix) {
+					t.Errorf("damage stage %d looks the same as stage %d", i, i-1)
+				}
+			}
+
+			again, _ := gen.Generate(config)
+			for i := range obj.DamageStages {
+				if !bytes.Equal(obj.DamageStages[i].Pix, again.DamageStages[i].Pix) {
+					t.Errorf("damage stage %d is not deterministic", i)
+				}
+			}
+		})
+	}
+
+	for _, subType := range []SubType{SubTypeChest, SubTypeStatue, SubTypeSpikes} {
+		obj, _ := gen.Generate(Config{SubType: subType, Width: 32, Height: 32, GenreID: "fantasy", Seed: 21})
+		if obj.IsDestructible() || len(obj.DamageStages) != 0 {
+			t.Errorf("%v should not be destructible", subType)
+		}
+	}
+}
+
+// TestEnvironmentalObject_ApplyDamage tests health-driven damage stages.
+func TestEnvironmentalObject_ApplyDamage(t *testing.T) {
+	obj, err := NewGenerator().Generate(Config{SubType: SubTypeBarrel, Width: 32, Height: 32, GenreID: "fantasy", Seed: 4})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	steps := []struct {
+		damage    int
+		stage     int
+		destroyed bool
+	}{
+		{5, DamageIntact, false},    // 15/20
+		{5, DamageCracked, false},   // 10/20
+		{5, DamageShattered, false}, // 5/20
+		{10, DamageShattered, true}, // 0/20
+	}
+	for _, step := range steps {
+		if destroyed := obj.ApplyDamage(step.damage); destroyed != step.destroyed {
+			t.Errorf("ApplyDamage(%d) at %d health destroyed = %v", step.damage, obj.Health, destroyed)
+		}
+		if obj.DamageStage() != step.stage {
+			t.Errorf("at %d health stage = %d, want %d", obj.Health, obj.DamageStage(), step.stage)
+		}
+	}
+
+	if obj.Health != 0 || !obj.IsDestroyed() {
+		t.Errorf("health = %d after destruction", obj.Health)
+	}
+	if obj.StateName() != "broken" || obj.DamageSprite() != obj.States[StateBroken] {
+		t.Error("destroyed barrel should show its broken state")
+	}
+	if obj.ApplyDamage(5) {
+		t.Error("a destroyed object cannot be destroyed again")
+	}
+}
@@ -4,11 +4,29 @@
 // collision detection, interaction properties, and genre-specific styling.
 //
 // Object types include:
-//   - Furniture (tables, chairs, beds, shelves, chests)
+//   - Furniture (tables, chairs, beds, shelves, chests, levers)
 //   - Decorations (plants, statues, paintings, banners)
 //   - Obstacles (barrels, crates, rubble, pillars)
 //   - Hazards (spikes, fire pits, acid pools, bear traps)
 //
+// Interactive objects get every visual state from one Generate call, so the
+// renderer can swap sprites on interaction without regenerating:
+//   - Chests: closed, open
+//   - Levers: off, on
+//   - Barrels and crates: intact, broken
+//
+// All other subtypes have a single "default" state. Use SetState or
+// ToggleState to switch, which also updates Sprite.
+//
+// Obstacles are destructible: they carry MaxHealth and three DamageStages
+// (intact, cracked, shattered). ApplyDamage lowers Health and DamageSprite
+// picks the stage for the remaining health; destroyed barrels and crates
+// switch to their broken state.
+//
+// Hazards carry HazardData: damage per tick, damage type, tick interval and
+// the status effect they apply (e.g. fire pits burn, post-apocalyptic toxic
+// pools poison). Damage scales with Config.Difficulty and Config.Depth.
+//
 // All generation is deterministic based on seed values, ensuring reproducible
 // content across different game sessions and clients.
 package environment
@@ -60,8 +60,28 @@ func (g *Generator) Generate(config Config) (*EnvironmentalObject, error) {
 		return nil, fmt.Errorf("failed to generate sprite: %w", err)
 	}
 
+	states, err := g.generateStates(config, sprite)
+	if err != nil {
+		g.logError("state generation failed", err, logrus.Fields{"subType": config.SubType})
+		return nil, fmt.Errorf("failed to generate states: %w", err)
+	}
+
+	damageStages, err := g.generateDamageStages(config, sprite)
+	if err != nil {
+		g.logError("damage stage generation failed", err, logrus.Fields{"subType": config.SubType})
+		return nil, fmt.Errorf("failed to generate damage stages: %w", err)
+	}
+
 	name := g.generateName(config.SubType, config.GenreID, rng)
 	obj := g.createObject(config, sprite, name, collidable, interactable, harmful, damage)
+	obj.States = states
+	obj.StateNames = StateNames(config.SubType)
+	obj.DamageStages = damageStages
+	obj.MaxHealth = destructibleHealth(config.SubType)
+	obj.Health = obj.MaxHealth
+	if obj.Hazard = generateHazardData(config); obj.Hazard != nil {
+		obj.Damage = obj.Hazard.DamagePerTick
+	}
 
 	g.logInfo("environmental object generated", logrus.Fields{
 		"name":    name,
@@ -135,6 +155,8 @@ func (g *Generator) drawObjectSprite(img *image.RGBA, config Config, baseColor,
 		g.drawShelf(img, w, h, baseColor, accentColor)
 	case SubTypeChest:
 		g.drawChest(img, w, h, baseColor, accentColor)
+	case SubTypeLever:
+		g.drawLever(img, w, h, baseColor, accentColor, false)
 	case SubTypePlant:
 		g.drawPlant(img, w, h, baseColor, accentColor, rng)
 	case SubTypeStatue:
@@ -39,6 +39,7 @@ func TestSubType_String(t *testing.T) {
 		{"table", SubTypeTable, "Table"},
 		{"chair", SubTypeChair, "Chair"},
 		{"chest", SubTypeChest, "Chest"},
+		{"lever", SubTypeLever, "Lever"},
 
 		// Decorations
 		{"plant", SubTypePlant, "Plant"},
@@ -337,7 +338,7 @@ func TestGenerator_GenerateAllSubTypes(t *testing.T) {
 	subtypes := []SubType{
 		// Furniture
 		SubTypeTable, SubTypeChair, SubTypeBed, SubTypeShelf, SubTypeChest,
-		SubTypeDesk, SubTypeBench, SubTypeCabinet,
+		SubTypeDesk, SubTypeBench, SubTypeCabinet, SubTypeLever,
 		// Decorations
 		SubTypePlant, SubTypeStatue, SubTypePainting, SubTypeBanner,
 		SubTypeTorch, SubTypeCandlestick, SubTypeVase, SubTypeTapestry,
@@ -0,0 +1,100 @@
+// Package environment provides hazard gameplay data.
+// This file defines the damage and status effects hazards apply to entities
+// that touch them, with genre-specific variants and difficulty scaling, so
+// collision and trigger systems can act on generated hazards.
+package environment
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/combat"
+)
+
+// HazardData describes what a hazard does to entities inside it.
+type HazardData struct {
+	// Damage dealt every TickInterval seconds while in contact
+	DamagePerTick int
+	DamageType    combat.DamageType
+	TickInterval  float64
+
+	// Status effect applied on contact ("" for none), using the status
+	// effect system's names (e.g. "burning", "poisoned")
+	StatusEffect   string
+	EffectDuration float64 // seconds
+
+	// EffectMagnitude is damage per tick for damage-over-time effects and
+	// the effect's multiplier for the rest (e.g. 0.5 speed for "slow")
+	EffectMagnitude float64
+}
+
+// hazardSeedOffset decorrelates hazard variation from the sprite rng.
+const hazardSeedOffset = 104729
+
+// baseHazards are the hazard values at difficulty 0 and depth 1.
+var baseHazards = map[SubType]HazardData{
+	SubTypeSpikes:        {DamagePerTick: 8, DamageType: combat.DamagePhysical, TickInterval: 1.0},
+	SubTypeFirePit:       {DamagePerTick: 6, DamageType: combat.DamageFire, TickInterval: 0.5, StatusEffect: "burning", EffectDuration: 3, EffectMagnitude: 2},
+	SubTypeAcidPool:      {DamagePerTick: 5, DamageType: combat.DamagePoison, TickInterval: 1.0, StatusEffect: "poisoned", EffectDuration: 4, EffectMagnitude: 2},
+	SubTypeBearTrap:      {DamagePerTick: 12, DamageType: combat.DamagePhysical, TickInterval: 2.0, StatusEffect: "slow", EffectDuration: 3, EffectMagnitude: 0.5},
+	SubTypePoisonGas:     {DamagePerTick: 3, DamageType: combat.DamagePoison, TickInterval: 0.5, StatusEffect: "poisoned", EffectDuration: 5, EffectMagnitude: 1},
+	SubTypeLavaPit:       {DamagePerTick: 12, DamageType: combat.DamageFire, TickInterval: 0.5, StatusEffect: "burning", EffectDuration: 4, EffectMagnitude: 4},
+	SubTypeElectricField: {DamagePerTick: 4, DamageType: combat.DamageLightning, TickInterval: 0.5, StatusEffect: "shocked", EffectDuration: 1, EffectMagnitude: 1},
+	SubTypeIceField:      {DamagePerTick: 2, DamageType: combat.DamageIce, TickInterval: 1.0, StatusEffect: "frozen", EffectDuration: 2, EffectMagnitude: 0.5},
+}
+
+// genreHazards replace the base values of hazards that behave differently
+// in a genre.
+var genreHazards = map[string]map[SubType]HazardData{
+	"scifi": {
+		// Plasma vents burn faster
+		SubTypeFirePit: {DamagePerTick: 4, DamageType: combat.DamageFire, TickInterval: 0.25, StatusEffect: "burning", EffectDuration: 2, EffectMagnitude: 2},
+		// Coolant spills freeze instead of corroding
+		SubTypeAcidPool: {DamagePerTick: 3, DamageType: combat.DamageIce, TickInterval: 1.0, StatusEffect: "frozen", EffectDuration: 2, EffectMagnitude: 0.5},
+	},
+	"horror": {
+		// Cursed spikes leave victims weakened
+		SubTypeSpikes:    {DamagePerTick: 8, DamageType: combat.DamagePhysical, TickInterval: 1.0, StatusEffect: "weakness", EffectDuration: 5, EffectMagnitude: 0.8},
+		SubTypePoisonGas: {DamagePerTick: 3, DamageType: combat.DamageMagical, TickInterval: 0.5, StatusEffect: "poisoned", EffectDuration: 8, EffectMagnitude: 1},
+	},
+	"cyberpunk": {
+		// Live wiring stuns
+		SubTypeElectricField: {DamagePerTick: 5, DamageType: combat.DamageLightning, TickInterval: 0.5, StatusEffect: "stun", EffectDuration: 1, EffectMagnitude: 1},
+	},
+	"postapoc": {
+		// Toxic waste pools poison for longer
+		SubTypeAcidPool: {DamagePerTick: 4, DamageType: combat.DamagePoison, TickInterval: 1.0, StatusEffect: "poisoned", EffectDuration: 10, EffectMagnitude: 3},
+		// Radioactive clouds
+		SubTypePoisonGas: {DamagePerTick: 4, DamageType: combat.DamagePoison, TickInterval: 1.0, StatusEffect: "poisoned", EffectDuration: 8, EffectMagnitude: 2},
+	},
+}
+
+// generateHazardData returns the hazard data of a hazard subtype, scaled by
+// difficulty and depth with a small seeded variation. Returns nil for
+// subtypes that are not hazards.
+func generateHazardData(config Config) *HazardData {
+	hazard, ok := genreHazards[config.GenreID][config.SubType]
+	if !ok {
+		hazard, ok = baseHazards[config.SubType]
+	}
+	if !ok {
+		return nil
+	}
+
+	rng := rand.New(rand.NewSource(config.Seed + hazardSeedOffset))
+	variation := 0.9 + rng.Float64()*0.2
+
+	scale := hazardScale(config.Difficulty, config.Depth) * variation
+	hazard.DamagePerTick = max(int(math.Round(float64(hazard.DamagePerTick)*scale)), 1)
+	if hazard.StatusEffect == "burning" || hazard.StatusEffect == "poisoned" {
+		hazard.EffectMagnitude *= scale
+	}
+	return &hazard
+}
+
+// hazardScale returns the damage multiplier for a difficulty (0-1) and
+// depth: up to double damage at full difficulty, and 10% more per level
+// below depth 1.
+func hazardScale(difficulty float64, depth int) float64 {
+	return (1 + difficulty) * (1 + 0.1*float64(max(depth-1, 0)))
+}
@@ -0,0 +1,86 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/combat"
+)
+
+// TestGenerator_GenerateHazardData tests that hazards, and only hazards,
+// carry gameplay data.
+func TestGenerator_GenerateHazardData(t *testing.T) {
+	gen := NewGenerator()
+
+	for subType := SubTypeSpikes; subType <= SubTypeIceField; subType++ {
+		obj, err := gen.Generate(Config{SubType: subType, Width: 32, Height: 32, GenreID: "fantasy", Seed: 5, Difficulty: 0.5, Depth: 1})
+		if err != nil {
+			t.Fatalf("Generate(%v) error = %v", subType, err)
+		}
+		if obj.Hazard == nil {
+			t.Fatalf("%v has no hazard data", subType)
+		}
+		if obj.Hazard.DamagePerTick <= 0 || obj.Hazard.TickInterval <= 0 {
+			t.Errorf("%v hazard = %+v", subType, obj.Hazard)
+		}
+		if obj.Damage != obj.Hazard.DamagePerTick {
+			t.Errorf("%v Damage = %d, want %d", subType, obj.Damage, obj.Hazard.DamagePerTick)
+		}
+	}
+
+	for _, subType := range []SubType{SubTypeTable, SubTypeTorch, SubTypeBarrel} {
+		obj, _ := gen.Generate(Config{SubType: subType, Width: 32, Height: 32, GenreID: "fantasy", Seed: 5})
+		if obj.Hazard != nil {
+			t.Errorf("%v should have no hazard data", subType)
+		}
+	}
+}
+
+// TestGenerator_HazardGenres tests genre-specific hazard effects.
+func TestGenerator_HazardGenres(t *testing.T) {
+	gen := NewGenerator()
+	hazard := func(subType SubType, genreID string) *HazardData {
+		obj, err := gen.Generate(Config{SubType: subType, Width: 32, Height: 32, GenreID: genreID, Seed: 5})
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		return obj.Hazard
+	}
+
+	if h := hazard(SubTypeFirePit, "fantasy"); h.StatusEffect != "burning" || h.DamageType != combat.DamageFire {
+		t.Errorf("fantasy fire pit = %+v, want burning fire damage", h)
+	}
+	toxic := hazard(SubTypeAcidPool, "postapoc")
+	if toxic.StatusEffect != "poisoned" || toxic.DamageType != combat.DamagePoison {
+		t.Errorf("post-apoc toxic pool = %+v, want poison", toxic)
+	}
+	if toxic.EffectDuration <= hazard(SubTypeAcidPool, "fantasy").EffectDuration {
+		t.Error("post-apoc toxic pools should poison for longer")
+	}
+	if h := hazard(SubTypeElectricField, "cyberpunk"); h.StatusEffect != "stun" {
+		t.Errorf("cyberpunk electric field effect = %q, want stun", h.StatusEffect)
+	}
+}
+
+// TestGenerator_HazardScaling tests deterministic difficulty and depth scaling.
+func TestGenerator_HazardScaling(t *testing.T) {
+	gen := NewGenerator()
+	damage := func(difficulty float64, depth int) int {
+		obj, err := gen.Generate(Config{SubType: SubTypeLavaPit, Width: 32, Height: 32, GenreID: "fantasy", Seed: 77, Difficulty: difficulty, Depth: depth})
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		return obj.Hazard.DamagePerTick
+	}
+
+	easy, hard, deep := damage(0, 1), damage(1, 1), damage(1, 10)
+	if !(easy < hard && hard < deep) {
+		t.Errorf("damage easy=%d hard=%d deep=%d, want increasing", easy, hard, deep)
+	}
+	if damage(1, 10) != deep {
+		t.Error("hazard damage is not deterministic")
+	}
+
+	if err := (Config{SubType: SubTypeSpikes, Width: 32, Height: 32, GenreID: "fantasy", Difficulty: 2}).Validate(); err == nil {
+		t.Error("expected error for difficulty above 1")
+	}
+}
@@ -0,0 +1,187 @@
+// Package environment provides environmental object visual states.
+// This file generates the alternate sprites of interactive objects (open
+// chests, pulled levers, broken barrels) alongside their default sprite, so
+// renderers can swap visuals on interaction without regenerating the object.
+package environment
+
+import (
+	"image"
+	"image/color"
+)
+
+// State indices of multi-state objects. The first state (index 0) is always
+// the object's initial look.
+const (
+	// StateClosed and StateOpen are the states of chests.
+	StateClosed = 0
+	StateOpen   = 1
+
+	// StateOff and StateOn are the states of levers.
+	StateOff = 0
+	StateOn  = 1
+
+	// StateIntact and StateBroken are the states of barrels and crates.
+	StateIntact = 0
+	StateBroken = 1
+)
+
+// StateNames returns the names of the visual states generated for a subtype.
+// Chests (closed/open), levers (off/on), and barrels and crates
+// (intact/broken) have two states; every other subtype has a single
+// "default" state.
+func StateNames(subType SubType) []string {
+	switch subType {
+	case SubTypeChest:
+		return []string{"closed", "open"}
+	case SubTypeLever:
+		return []string{"off", "on"}
+	case SubTypeBarrel, SubTypeCrate:
+		return []string{"intact", "broken"}
+	default:
+		return []string{"default"}
+	}
+}
+
+// HasStates reports whether the object has more than one visual state.
+func (o *EnvironmentalObject) HasStates() bool {
+	return len(o.States) > 1
+}
+
+// StateName returns the name of the object's current state.
+func (o *EnvironmentalObject) StateName() string {
+	if o.CurrentState < 0 || o.CurrentState >= len(o.StateNames) {
+		return ""
+	}
+	return o.StateNames[o.CurrentState]
+}
+
+// SetState switches the object to a visual state and updates Sprite.
+// Returns false, leaving the object unchanged, if the state does not exist.
+func (o *EnvironmentalObject) SetState(state int) bool {
+	if state < 0 || state >= len(o.States) {
+		return false
+	}
+	o.CurrentState = state
+	o.Sprite = o.States[state]
+	return true
+}
+
+// ToggleState advances the object to its next visual state, wrapping
+// around, and returns the new state.
+func (o *EnvironmentalObject) ToggleState() int {
+	if len(o.States) > 0 {
+		o.SetState((o.CurrentState + 1) % len(o.States))
+	}
+	return o.CurrentState
+}
+
+// generateStates creates the sprite of every visual state of an object.
+// The first state is the already generated default sprite.
+func (g *Generator) generateStates(config Config, sprite *image.RGBA) ([]*image.RGBA, error) {
+	names := StateNames(config.SubType)
+	states := []*image.RGBA{sprite}
+	if len(names) == 1 {
+		return states, nil
+	}
+
+	pal, err := g.paletteGen.Generate(config.GenreID, config.Seed)
+	if err != nil {
+		return nil, err
+	}
+	base, accent := g.selectColors(config.SubType.GetObjectType(), pal)
+	w, h := config.Width, config.Height
+
+	variant := image.NewRGBA(sprite.Bounds())
+	switch config.SubType {
+	case SubTypeChest:
+		g.drawOpenChest(variant, w, h, base, accent)
+	case SubTypeLever:
+		g.drawLever(variant, w, h, base, accent, true)
+	case SubTypeBarrel, SubTypeCrate:
+		copy(variant.Pix, sprite.Pix)
+		g.breakObject(variant, w, h, accent)
+	}
+	return append(states, variant), nil
+}
+
+func (g *Generator) drawOpenChest(img *image.RGBA, width, height int, base, accent color.Color) {
+	g.drawChest(img, width, height, base, accent)
+
+	// Remove the closed lid
+	for y := height / 6; y < height/3; y++ {
+		for x := width / 6; x < width*5/6; x++ {
+			img.Set(x, y, color.Transparent)
+		}
+	}
+	// Draw the dark interior
+	interior := darken(base, 0.4)
+	for y := height / 3; y < height/3+height/8; y++ {
+		for x := width/6 + 1; x < width*5/6-1; x++ {
+			img.Set(x, y, interior)
+		}
+	}
+	// Draw the raised lid as a thin edge above the body
+	for y := height/6 - 1; y < height/6+1; y++ {
+		for x := width / 6; x < width*5/6; x++ {
+			if y >= 0 {
+				img.Set(x, y, accent)
+			}
+		}
+	}
+}
+
+func (g *Generator) drawLever(img *image.RGBA, width, height int, base, accent color.Color, on bool) {
+	// Draw base plate
+	for y := height * 2 / 3; y < height*5/6; y++ {
+		for x := width / 3; x < width*2/3; x++ {
+			img.Set(x, y, base)
+		}
+	}
+	// Draw handle, tilted left when off and right when on
+	pivotX, pivotY := width/2, height*2/3
+	tipX := width / 4
+	if on {
+		tipX = width * 3 / 4
+	}
+	tipY := height / 5
+	g.drawLine(img, pivotX, pivotY, tipX, tipY, base)
+	g.drawLine(img, pivotX+1, pivotY, tipX+1, tipY, base)
+	// Draw knob
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			x, y := tipX+dx, tipY+dy
+			if dx*dx+dy*dy <= 4 && x >= 0 && x < width && y >= 0 && y < height {
+				img.Set(x, y, accent)
+			}
+		}
+	}
+}
+
+// breakObject smashes the top of a barrel or crate sprite into a jagged edge
+// and scatters splinters at its base.
+func (g *Generator) breakObject(img *image.RGBA, width, height int, accent color.Color) {
+	for x := 0; x < width; x++ {
+		cut := height/2 + (x*7)%5 - 2
+		for y := 0; y < cut; y++ {
+			img.Set(x, y, color.Transparent)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		x := width/8 + i*width/4
+		y := height*5/6 + i%2
+		if y < height {
+			g.drawLine(img, x, y, min(x+width/8, width-1), y, accent)
+		}
+	}
+}
+
+// darken scales a color's RGB channels by factor.
+func darken(c color.Color, factor float64) color.Color {
+	r, gr, b, a := c.RGBA()
+	return color.RGBA{
+		R: uint8(float64(r>>8) * factor),
+		G: uint8(float64(gr>>8) * factor),
+		B: uint8(float64(b>>8) * factor),
+		A: uint8(a >> 8),
+	}
+}
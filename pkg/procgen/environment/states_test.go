@@ -0,0 +1,82 @@
+package environment
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestGenerator_GenerateStates tests that interactive subtypes get distinct
+// state sprites and everything else gets a single state.
+func TestGenerator_GenerateStates(t *testing.T) {
+	gen := NewGenerator()
+
+	tests := []struct {
+		subType SubType
+		states  []string
+	}{
+		{SubTypeChest, []string{"closed", "open"}},
+		{SubTypeLever, []string{"off", "on"}},
+		{SubTypeBarrel, []string{"intact", "broken"}},
+		{SubTypeCrate, []string{"intact", "broken"}},
+		{SubTypeTable, []string{"default"}},
+		{SubTypeSpikes, []string{"default"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.subType.String(), func(t *testing.T) {
+			for _, genreID := range []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc"} {
+				obj, err := gen.Generate(Config{SubType: tt.subType, Width: 32, Height: 32, GenreID: genreID, Seed: 99})
+				if err != nil {
+					t.Fatalf("Generate() error = %v", err)
+				}
+				if len(obj.States) != len(tt.states) || len(obj.StateNames) != len(tt.states) {
+					t.Fatalf("%s: %d states %v, want %v", genreID, len(obj.States), obj.StateNames, tt.states)
+				}
+				if obj.Sprite != obj.States[0] || obj.CurrentState != 0 || obj.StateName() != tt.states[0] {
+					t.Errorf("%s: object should start in state %q", genreID, tt.states[0])
+				}
+				for i := 1; i < len(obj.States); i++ {
+					if bytes.Equal(obj.States[i].Pix, obj.States[0].Pix) {
+						t.Errorf("%s: state %q looks the same as %q", genreID, tt.states[i], tt.states[0])
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestGenerator_GenerateStatesDeterminism tests that state sprites are
+// reproducible from the seed.
+func TestGenerator_GenerateStatesDeterminism(t *testing.T) {
+	gen := NewGenerator()
+	config := Config{SubType: SubTypeChest, Width: 32, Height: 32, GenreID: "horror", Seed: 7}
+
+	a, _ := gen.Generate(config)
+	b, _ := gen.Generate(config)
+	for i := range a.States {
+		if !bytes.Equal(a.States[i].Pix, b.States[i].Pix) {
+			t.Errorf("state %d differs between generations", i)
+		}
+	}
+}
+
+// TestEnvironmentalObject_SetState tests switching visual states.
+func TestEnvironmentalObject_SetState(t *testing.T) {
+	obj, err := NewGenerator().Generate(Config{SubType: SubTypeLever, Width: 32, Height: 32, GenreID: "scifi", Seed: 3})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !obj.HasStates() {
+		t.Fatal("lever should have states")
+	}
+
+	if !obj.SetState(StateOn) || obj.Sprite != obj.States[StateOn] || obj.StateName() != "on" {
+		t.Errorf("SetState(StateOn) left state %q", obj.StateName())
+	}
+	if obj.SetState(5) || obj.CurrentState != StateOn {
+		t.Error("SetState() with an invalid state should not change the object")
+	}
+	if obj.ToggleState() != StateOff || obj.Sprite != obj.States[StateOff] {
+		t.Error("ToggleState() should wrap back to off")
+	}
+}
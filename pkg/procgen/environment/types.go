@@ -56,6 +56,8 @@ const (
 	SubTypeBench
 	// SubTypeCabinet represents a cabinet furniture item.
 	SubTypeCabinet
+	// SubTypeLever represents a wall or floor lever.
+	SubTypeLever
 )
 
 const (
@@ -139,6 +141,8 @@ func (s SubType) String() string {
 		return "Bench"
 	case SubTypeCabinet:
 		return "Cabinet"
+	case SubTypeLever:
+		return "Lever"
 
 	// Decorations
 	case SubTypePlant:
@@ -225,16 +229,30 @@ type EnvironmentalObject struct {
 	Type    ObjectType
 	SubType SubType
 
-	// Visual representation
+	// Visual representation. Sprite is the image of the current state.
 	Sprite *image.RGBA
 	Width  int
 	Height int
 
+	// Visual states (e.g. closed/open for chests). States holds one sprite
+	// per entry of StateNames; objects with a single look have one state.
+	States       []*image.RGBA
+	StateNames   []string
+	CurrentState int
+
+	// Damage visuals of destructible objects (intact, cracked, shattered),
+	// selected by remaining Health. Indestructible objects have no stages
+	// and zero MaxHealth.
+	DamageStages []*image.RGBA
+	MaxHealth    int
+	Health       int
+
 	// Gameplay properties
 	Collidable   bool
 	Interactable bool
 	Harmful      bool
-	Damage       int // Damage per tick if harmful
+	Damage       int         // Damage per tick if harmful
+	Hazard       *HazardData // Damage and effects of hazards, nil otherwise
 
 	// Genre and seed for reproduction
 	GenreID string
@@ -259,6 +277,10 @@ type Config struct {
 	// Seed for deterministic generation
 	Seed int64
 
+	// Difficulty (0-1) and dungeon Depth scale hazard damage
+	Difficulty float64
+	Depth      int
+
 	// Custom parameters
 	Custom map[string]interface{}
 }
@@ -266,12 +288,14 @@ type Config struct {
 // DefaultConfig returns default object configuration.
 func DefaultConfig() Config {
 	return Config{
-		SubType: SubTypeTable,
-		Width:   32,
-		Height:  32,
-		GenreID: "fantasy",
-		Seed:    0,
-		Custom:  make(map[string]interface{}),
+		SubType:    SubTypeTable,
+		Width:      32,
+		Height:     32,
+		GenreID:    "fantasy",
+		Seed:       0,
+		Difficulty: 0.5,
+		Depth:      1,
+		Custom:     make(map[string]interface{}),
 	}
 }
 
@@ -286,6 +310,12 @@ func (c Config) Validate() error {
 	if c.GenreID == "" {
 		return fmt.Errorf("genreID cannot be empty")
 	}
+	if c.Difficulty < 0 || c.Difficulty > 1 {
+		return fmt.Errorf("difficulty must be between 0 and 1, got %f", c.Difficulty)
+	}
+	if c.Depth < 0 {
+		return fmt.Errorf("depth cannot be negative, got %d", c.Depth)
+	}
 	return nil
 }
 
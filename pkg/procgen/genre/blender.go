@@ -15,7 +15,66 @@ type BlendedGenre struct {
 	*Genre
 	PrimaryBase   *Genre
 	SecondaryBase *Genre
-	BlendWeight   float64 // 0.0 (all primary) to 1.0 (all secondary)
+	BlendWeight   float64 // 0.0 (all primary) to 1.0 (all secondary), mean of Weights
+
+	// Weights holds the blend ratio of each aspect so downstream generators
+	// can honor them (all equal to BlendWeight for Blend)
+	Weights BlendWeights
+}
+
+// BlendWeights sets the blend ratio of each aspect of a blended genre
+// separately. Each weight runs from 0.0 (all primary) to 1.0 (all secondary).
+// For example, sci-fi terrain with a horror palette blends scifi with horror
+// using Terrain 0.0 and Palette 1.0.
+type BlendWeights struct {
+	// Palette blends the primary, secondary and accent colors
+	Palette float64
+
+	// Naming is the chance of each name prefix coming from the secondary genre
+	Naming float64
+
+	// Terrain is the chance of a level using the secondary genre's terrain
+	// generators (see BlendedGenre.TerrainGenreID)
+	Terrain float64
+
+	// Themes is the share of theme tags taken from the secondary genre
+	Themes float64
+}
+
+// UniformBlendWeights returns weights that blend every aspect by weight.
+func UniformBlendWeights(weight float64) BlendWeights {
+	return BlendWeights{Palette: weight, Naming: weight, Terrain: weight, Themes: weight}
+}
+
+// Validate checks that every weight is between 0.0 and 1.0.
+func (w BlendWeights) Validate() error {
+	for _, aspect := range []struct {
+		name   string
+		weight float64
+	}{
+		{"palette", w.Palette},
+		{"naming", w.Naming},
+		{"terrain", w.Terrain},
+		{"themes", w.Themes},
+	} {
+		if aspect.weight < 0.0 || aspect.weight > 1.0 {
+			return fmt.Errorf("%s blend weight must be between 0.0 and 1.0, got %f", aspect.name, aspect.weight)
+		}
+	}
+	return nil
+}
+
+// IsUniform returns true if every aspect has the same weight.
+func (w BlendWeights) IsUniform() bool {
+	return w.Palette == w.Naming && w.Naming == w.Terrain && w.Terrain == w.Themes
+}
+
+// Mean returns the average weight across all aspects.
+func (w BlendWeights) Mean() float64 {
+	if w.IsUniform() {
+		return w.Palette
+	}
+	return (w.Palette + w.Naming + w.Terrain + w.Themes) / 4
 }
 
 // GenreBlender creates blended genres from two base genres.
@@ -42,6 +101,17 @@ func (gb *GenreBlender) Blend(primaryID, secondaryID string, weight float64, see
 		return nil, fmt.Errorf("blend weight must be between 0.0 and 1.0, got %f", weight)
 	}
 
+	return gb.BlendWithWeights(primaryID, secondaryID, UniformBlendWeights(weight), seed)
+}
+
+// BlendWithWeights creates a new genre by blending two existing genres with
+// a separate ratio for each aspect, e.g. one genre's terrain with the other's
+// palette. The result is deterministic for the same genres, weights and seed.
+func (gb *GenreBlender) BlendWithWeights(primaryID, secondaryID string, weights BlendWeights, seed int64) (*BlendedGenre, error) {
+	if err := weights.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Get base genres
 	primary, err := gb.registry.Get(primaryID)
 	if err != nil {
@@ -59,19 +129,20 @@ func (gb *GenreBlender) Blend(primaryID, secondaryID string, weight float64, see
 	}
 
 	rng := rand.New(rand.NewSource(seed))
+	weight := weights.Mean()
 
 	// Create blended genre
 	blended := &Genre{
-		ID:             generateBlendedID(primary, secondary, weight),
+		ID:             generateWeightedBlendedID(primary, secondary, weights),
 		Name:           generateBlendedName(primary, secondary, weight),
 		Description:    generateBlendedDescription(primary, secondary, weight),
-		Themes:         blendThemes(primary.Themes, secondary.Themes, weight, rng),
-		PrimaryColor:   blendColor(primary.PrimaryColor, secondary.PrimaryColor, weight),
-		SecondaryColor: blendColor(primary.SecondaryColor, secondary.SecondaryColor, weight),
-		AccentColor:    blendColor(primary.AccentColor, secondary.AccentColor, weight),
-		EntityPrefix:   selectPrefix(primary.EntityPrefix, secondary.EntityPrefix, weight, rng),
-		ItemPrefix:     selectPrefix(primary.ItemPrefix, secondary.ItemPrefix, weight, rng),
-		LocationPrefix: selectPrefix(primary.LocationPrefix, secondary.LocationPrefix, weight, rng),
+		Themes:         blendThemes(primary.Themes, secondary.Themes, weights.Themes, rng),
+		PrimaryColor:   blendColor(primary.PrimaryColor, secondary.PrimaryColor, weights.Palette),
+		SecondaryColor: blendColor(primary.SecondaryColor, secondary.SecondaryColor, weights.Palette),
+		AccentColor:    blendColor(primary.AccentColor, secondary.AccentColor, weights.Palette),
+		EntityPrefix:   selectPrefix(primary.EntityPrefix, secondary.EntityPrefix, weights.Naming, rng),
+		ItemPrefix:     selectPrefix(primary.ItemPrefix, secondary.ItemPrefix, weights.Naming, rng),
+		LocationPrefix: selectPrefix(primary.LocationPrefix, secondary.LocationPrefix, weights.Naming, rng),
 	}
 
 	return &BlendedGenre{
@@ -79,6 +150,7 @@ func (gb *GenreBlender) Blend(primaryID, secondaryID string, weight float64, see
 		PrimaryBase:   primary,
 		SecondaryBase: secondary,
 		BlendWeight:   weight,
+		Weights:       weights,
 	}, nil
 }
 
@@ -95,6 +167,29 @@ func generateBlendedID(primary, secondary *Genre, weight float64) string {
 	return fmt.Sprintf("%s-%s-%d", primary.ID, secondary.ID, weightPercent)
 }
 
+// generateWeightedBlendedID creates a unique ID for a blend with per-aspect
+// weights. Uniform weights produce the same ID as generateBlendedID.
+func generateWeightedBlendedID(primary, secondary *Genre, weights BlendWeights) string {
+	if weights.IsUniform() {
+		return generateBlendedID(primary, secondary, weights.Palette)
+	}
+
+	// Order genres alphabetically for consistency
+	if primary.ID > secondary.ID {
+		primary, secondary = secondary, primary
+		weights = BlendWeights{
+			Palette: 1.0 - weights.Palette,
+			Naming:  1.0 - weights.Naming,
+			Terrain: 1.0 - weights.Terrain,
+			Themes:  1.0 - weights.Themes,
+		}
+	}
+
+	return fmt.Sprintf("%s-%s-p%d-n%d-t%d-h%d", primary.ID, secondary.ID,
+		int(weights.Palette*100), int(weights.Naming*100),
+		int(weights.Terrain*100), int(weights.Themes*100))
+}
+
 // generateBlendedName creates a human-readable name for the blended genre.
 func generateBlendedName(primary, secondary *Genre, weight float64) string {
 	// Determine which genre to put first based on weight
@@ -218,6 +313,18 @@ func (bg *BlendedGenre) GetBaseGenres() (*Genre, *Genre) {
 	return bg.PrimaryBase, bg.SecondaryBase
 }
 
+// TerrainGenreID returns the ID of the base genre whose terrain generators a
+// level should use, chosen by the Terrain weight. The choice is deterministic
+// for a given seed (e.g. the level seed), so a weight of 0.3 gives about 30%
+// of levels the secondary genre's terrain.
+func (bg *BlendedGenre) TerrainGenreID(seed int64) string {
+	rng := rand.New(rand.NewSource(seed))
+	if rng.Float64() < bg.Weights.Terrain {
+		return bg.SecondaryBase.ID
+	}
+	return bg.PrimaryBase.ID
+}
+
 // PresetBlends returns common preset blended genres.
 func PresetBlends() map[string]struct {
 	Primary   string
@@ -647,3 +647,111 @@ func BenchmarkCreatePresetBlend(b *testing.B) {
 		_, _ = blender.CreatePresetBlend("sci-fi-horror", int64(i))
 	}
 }
+
+func TestGenreBlender_BlendWithWeights(t *testing.T) {
+	blender := NewGenreBlender(DefaultRegistry())
+	scifi, _ := DefaultRegistry().Get("scifi")
+	horror, _ := DefaultRegistry().Get("horror")
+
+	// Sci-fi terrain and naming with a horror palette
+	weights := BlendWeights{Palette: 1.0, Naming: 0.0, Terrain: 0.0, Themes: 0.5}
+	blended, err := blender.BlendWithWeights("scifi", "horror", weights, 12345)
+	if err != nil {
+		t.Fatalf("BlendWithWeights() error = %v", err)
+	}
+
+	if !strings.EqualFold(blended.PrimaryColor, horror.PrimaryColor) {
+		t.Errorf("PrimaryColor = %s, want horror %s", blended.PrimaryColor, horror.PrimaryColor)
+	}
+	if blended.EntityPrefix != scifi.EntityPrefix || blended.ItemPrefix != scifi.ItemPrefix {
+		t.Errorf("prefixes = %s/%s, want sci-fi %s/%s",
+			blended.EntityPrefix, blended.ItemPrefix, scifi.EntityPrefix, scifi.ItemPrefix)
+	}
+	if blended.Weights != weights {
+		t.Errorf("Weights = %+v, want %+v", blended.Weights, weights)
+	}
+	if blended.BlendWeight != 0.375 {
+		t.Errorf("BlendWeight = %f, want mean 0.375", blended.BlendWeight)
+	}
+	if blended.ID != "horror-scifi-p0-n100-t100-h50" {
+		t.Errorf("ID = %s, want horror-scifi-p0-n100-t100-h50", blended.ID)
+	}
+	for seed := int64(0); seed < 20; seed++ {
+		if id := blended.TerrainGenreID(seed); id != "scifi" {
+			t.Fatalf("TerrainGenreID(%d) = %s, want scifi", seed, id)
+		}
+	}
+
+	again, _ := blender.BlendWithWeights("scifi", "horror", weights, 12345)
+	if strings.Join(again.Themes, ",") != strings.Join(blended.Themes, ",") {
+		t.Error("BlendWithWeights() is not deterministic")
+	}
+}
+
+func TestGenreBlender_BlendWithWeightsValidation(t *testing.T) {
+	blender := NewGenreBlender(DefaultRegistry())
+
+	tests := []struct {
+		name    string
+		weights BlendWeights
+		aspect  string
+	}{
+		{"negative palette", BlendWeights{Palette: -0.1}, "palette"},
+		{"naming above one", BlendWeights{Naming: 1.5}, "naming"},
+		{"terrain above one", BlendWeights{Terrain: 2}, "terrain"},
+		{"negative themes", BlendWeights{Themes: -1}, "themes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := blender.BlendWithWeights("fantasy", "scifi", tt.weights, 1)
+			if err == nil || !strings.Contains(err.Error(), tt.aspect) {
+				t.Errorf("BlendWithWeights() error = %v, want %s weight error", err, tt.aspect)
+			}
+		})
+	}
+}
+
+func TestGenreBlender_BlendMatchesUniformWeights(t *testing.T) {
+	blender := NewGenreBlender(DefaultRegistry())
+
+	blended, err := blender.Blend("fantasy", "horror", 0.3, 42)
+	if err != nil {
+		t.Fatalf("Blend() error = %v", err)
+	}
+	weighted, err := blender.BlendWithWeights("fantasy", "horror", UniformBlendWeights(0.3), 42)
+	if err != nil {
+		t.Fatalf("BlendWithWeights() error = %v", err)
+	}
+
+	if blended.ID != weighted.ID || blended.PrimaryColor != weighted.PrimaryColor ||
+		blended.EntityPrefix != weighted.EntityPrefix ||
+		strings.Join(blended.Themes, ",") != strings.Join(weighted.Themes, ",") {
+		t.Errorf("Blend() = %+v, BlendWithWeights() = %+v", blended.Genre, weighted.Genre)
+	}
+	if blended.BlendWeight != 0.3 || blended.Weights != UniformBlendWeights(0.3) {
+		t.Errorf("weights = %f %+v, want uniform 0.3", blended.BlendWeight, blended.Weights)
+	}
+}
+
+func TestBlendedGenre_TerrainGenreID(t *testing.T) {
+	blender := NewGenreBlender(DefaultRegistry())
+	blended, err := blender.BlendWithWeights("fantasy", "cyberpunk", BlendWeights{Terrain: 0.3}, 7)
+	if err != nil {
+		t.Fatalf("BlendWithWeights() error = %v", err)
+	}
+
+	secondary := 0
+	for seed := int64(0); seed < 1000; seed++ {
+		id := blended.TerrainGenreID(seed)
+		if id != blended.TerrainGenreID(seed) {
+			t.Fatalf("TerrainGenreID(%d) is not deterministic", seed)
+		}
+		if id == "cyberpunk" {
+			secondary++
+		}
+	}
+	if secondary < 230 || secondary > 370 {
+		t.Errorf("secondary terrain chosen %d/1000 times, want about 300", secondary)
+	}
+}
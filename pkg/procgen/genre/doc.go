@@ -37,6 +37,16 @@
 //	    fmt.Printf("%s: %s\n", g.ID, g.Name)
 //	}
 //
+// # Name Generation
+//
+// NameGenerator produces deterministic genre-appropriate names by category:
+//
+//	names := genre.NewNameGeneratorForID("scifi")
+//	weapon := names.GenerateName(genre.NameCategoryWeapon, seed)
+//
+// The item, spell and station generators use GenerateSuffix to give their
+// legendary and top-tier results a genre epithet such as "of Embers".
+//
 // # Genre Blending
 //
 // Create hybrid genres by blending two base genres:
@@ -48,6 +58,11 @@
 //	}
 //	fmt.Printf("Blended genre: %s\n", scifiHorror.Name)
 //
+// Blend each aspect separately, e.g. sci-fi terrain with a horror palette:
+//
+//	weights := genre.BlendWeights{Palette: 1.0, Naming: 0.0, Terrain: 0.0, Themes: 0.5}
+//	blended, err := blender.BlendWithWeights("scifi", "horror", weights, 12345)
+//
 // Use preset blends for common combinations:
 //
 //	darkFantasy, err := blender.CreatePresetBlend("dark-fantasy", 12345)
@@ -0,0 +1,340 @@
+// Package genre provides genre-appropriate name generation.
+// This file implements NameGenerator, which builds names for weapons,
+// places, creatures and other categories from per-genre word pools so every
+// generator draws on the same vocabulary instead of keeping its own lists.
+package genre
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// Name categories with built-in pools for every predefined genre.
+const (
+	NameCategoryWeapon   = "weapon"
+	NameCategoryArmor    = "armor"
+	NameCategoryPlace    = "place"
+	NameCategoryCreature = "creature"
+	NameCategorySpell    = "spell"
+	NameCategoryStation  = "station"
+)
+
+// Chances of a generated name using a prefix and a suffix.
+const (
+	namePrefixChance = 0.7
+	nameSuffixChance = 0.4
+)
+
+// NamePool holds the words names in one category are built from. A name is
+// an optional prefix, a root and an optional suffix, e.g. "Cursed" +
+// "Blade" + "of Embers".
+type NamePool struct {
+	// Prefixes are words placed before the root
+	Prefixes []string `json:"prefixes,omitempty"`
+
+	// Roots are the core nouns; a pool needs at least one to generate names
+	Roots []string `json:"roots,omitempty"`
+
+	// Suffixes are words or phrases placed after the root
+	Suffixes []string `json:"suffixes,omitempty"`
+
+	// Replace discards the built-in pool instead of extending it when the
+	// pool is set on a Genre
+	Replace bool `json:"replace,omitempty"`
+}
+
+// extend returns the pool with other's words appended.
+func (p NamePool) extend(other NamePool) NamePool {
+	return NamePool{
+		Prefixes: append(append([]string{}, p.Prefixes...), other.Prefixes...),
+		Roots:    append(append([]string{}, p.Roots...), other.Roots...),
+		Suffixes: append(append([]string{}, p.Suffixes...), other.Suffixes...),
+	}
+}
+
+// NameGenerator generates deterministic genre-appropriate names.
+type NameGenerator struct {
+	genreID string
+	pools   map[string]NamePool
+}
+
+// NewNameGenerator creates a name generator for a genre. It starts from the
+// built-in pools of the genre's ID (fantasy's for custom genres) and applies
+// the genre's NamePools on top, extending or replacing each category.
+func NewNameGenerator(g *Genre) *NameGenerator {
+	ng := NewNameGeneratorForID(g.ID)
+	for category, pool := range g.NamePools {
+		if pool.Replace {
+			ng.SetPool(category, pool)
+		} else {
+			ng.ExtendPool(category, pool)
+		}
+	}
+	return ng
+}
+
+// NewNameGeneratorForID creates a name generator with the built-in pools of
+// a predefined genre. Unknown genre IDs use the fantasy pools.
+func NewNameGeneratorForID(genreID string) *NameGenerator {
+	builtin, ok := builtinNamePools[genreID]
+	if !ok {
+		builtin = builtinNamePools["fantasy"]
+	}
+
+	pools := make(map[string]NamePool, len(builtin))
+	for category, pool := range builtin {
+		pools[category] = NamePool{}.extend(pool)
+	}
+	return &NameGenerator{genreID: genreID, pools: pools}
+}
+
+// GenreID returns the genre the generator names things for.
+func (ng *NameGenerator) GenreID() string {
+	return ng.genreID
+}
+
+// GenerateName returns a name for the category. The same category and seed
+// always produce the same name. Returns an empty string for a category
+// without roots.
+func (ng *NameGenerator) GenerateName(category string, seed int64) string {
+	pool, ok := ng.pools[category]
+	if !ok || len(pool.Roots) == 0 {
+		return ""
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	parts := make([]string, 0, 3)
+	if len(pool.Prefixes) > 0 && rng.Float64() < namePrefixChance {
+		parts = append(parts, pool.Prefixes[rng.Intn(len(pool.Prefixes))])
+	}
+	parts = append(parts, pool.Roots[rng.Intn(len(pool.Roots))])
+	if len(pool.Suffixes) > 0 && rng.Float64() < nameSuffixChance {
+		parts = append(parts, pool.Suffixes[rng.Intn(len(pool.Suffixes))])
+	}
+	return strings.Join(parts, " ")
+}
+
+// GenerateSuffix returns one of the category's suffixes, e.g. "of Embers",
+// for generators that build the rest of a name from their own templates. The
+// same category and seed always produce the same suffix. Returns an empty
+// string for a category without suffixes.
+func (ng *NameGenerator) GenerateSuffix(category string, seed int64) string {
+	pool := ng.pools[category]
+	if len(pool.Suffixes) == 0 {
+		return ""
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	return pool.Suffixes[rng.Intn(len(pool.Suffixes))]
+}
+
+// SetPool replaces the pool for a category, adding the category if needed.
+func (ng *NameGenerator) SetPool(category string, pool NamePool) {
+	pool.Replace = false
+	ng.pools[category] = NamePool{}.extend(pool)
+}
+
+// ExtendPool appends words to the pool for a category, adding the category
+// if needed.
+func (ng *NameGenerator) ExtendPool(category string, pool NamePool) {
+	ng.pools[category] = ng.pools[category].extend(pool)
+}
+
+// Pool returns a copy of the pool for a category.
+func (ng *NameGenerator) Pool(category string) (NamePool, bool) {
+	pool, ok := ng.pools[category]
+	if !ok {
+		return NamePool{}, false
+	}
+	return NamePool{}.extend(pool), true
+}
+
+// Categories returns the categories the generator has pools for, sorted.
+func (ng *NameGenerator) Categories() []string {
+	categories := make([]string, 0, len(ng.pools))
+	for category := range ng.pools {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// validateNamePools checks the name pools set on a genre.
+func validateNamePools(pools map[string]NamePool) error {
+	for category, pool := range pools {
+		if category == "" {
+			return fmt.Errorf("name pool category cannot be empty")
+		}
+		if pool.Replace && len(pool.Roots) == 0 {
+			return fmt.Errorf("replacement name pool %q must have at least one root", category)
+		}
+	}
+	return nil
+}
+
+// builtinNamePools holds the name pools of the predefined genres, by genre
+// ID and category.
+var builtinNamePools = map[string]map[string]NamePool{
+	"fantasy": {
+		NameCategoryWeapon: {
+			Prefixes: []string{"Ancient", "Enchanted", "Runed", "Elven", "Dwarven", "Blessed"},
+			Roots:    []string{"Blade", "Sword", "Axe", "Bow", "Staff", "Mace", "Spear"},
+			Suffixes: []string{"of Embers", "of the Dawn", "of Kings", "of Frost", "of the Wyrm"},
+		},
+		NameCategoryArmor: {
+			Prefixes: []string{"Ancient", "Gilded", "Mithril", "Blessed", "Knight's"},
+			Roots:    []string{"Plate", "Mail", "Helm", "Shield", "Gauntlets", "Robe"},
+			Suffixes: []string{"of Warding", "of the Paladin", "of Valor", "of the Grove"},
+		},
+		NameCategoryPlace: {
+			Prefixes: []string{"The", "Old", "Forgotten", "High"},
+			Roots:    []string{"Keep", "Hollow", "Citadel", "Crypt", "Vale", "Spire", "Barrow"},
+			Suffixes: []string{"of Shadows", "of the Ancients", "of Whispers", "of the Moon"},
+		},
+		NameCategoryCreature: {
+			Prefixes: []string{"Ancient", "Elder", "Feral", "Cave", "Frost"},
+			Roots:    []string{"Dragon", "Troll", "Goblin", "Wyvern", "Ogre", "Wraith", "Griffin"},
+			Suffixes: []string{"Lord", "Shaman", "Brute", "Matriarch"},
+		},
+		NameCategorySpell: {
+			Prefixes: []string{"Arcane", "Holy", "Greater", "Lesser", "Mystic"},
+			Roots:    []string{"Fireball", "Bolt", "Ward", "Blessing", "Nova", "Hex"},
+			Suffixes: []string{"of Binding", "of Light", "of the Magi"},
+		},
+		NameCategoryStation: {
+			Prefixes: []string{"Ancient", "Arcane", "Dwarven", "Master"},
+			Roots:    []string{"Forge", "Anvil", "Altar", "Workbench", "Cauldron"},
+			Suffixes: []string{"of Making", "of the Guild"},
+		},
+	},
+	"scifi": {
+		NameCategoryWeapon: {
+			Prefixes: []string{"Plasma", "Pulse", "Ion", "Quantum", "Prototype", "Photon"},
+			Roots:    []string{"Rifle", "Blaster", "Cannon", "Carbine", "Lance", "Repeater"},
+			Suffixes: []string{"Mk II", "X-9", "Mk IV", "Alpha", "Omega"},
+		},
+		NameCategoryArmor: {
+			Prefixes: []string{"Powered", "Composite", "Nano", "Shielded", "Titanium"},
+			Roots:    []string{"Exosuit", "Vest", "Helmet", "Plating", "Hardsuit"},
+			Suffixes: []string{"Mk II", "Type-7", "Series 3"},
+		},
+		NameCategoryPlace: {
+			Prefixes: []string{"Station", "Outpost", "Colony", "Orbital"},
+			Roots:    []string{"Kepler", "Tycho", "Vega", "Orion", "Helix", "Nova"},
+			Suffixes: []string{"Prime", "IV", "Research Deck", "Habitat"},
+		},
+		NameCategoryCreature: {
+			Prefixes: []string{"Rogue", "Alien", "Mutant", "Security", "Hive"},
+			Roots:    []string{"Drone", "Xenomorph", "Android", "Crawler", "Sentinel"},
+			Suffixes: []string{"Unit", "Alpha", "Queen", "Swarm"},
+		},
+		NameCategorySpell: {
+			Prefixes: []string{"Psionic", "Gravity", "Kinetic", "Neural"},
+			Roots:    []string{"Pulse", "Field", "Surge", "Barrier", "Burst"},
+			Suffixes: []string{"Protocol", "Array", "Matrix"},
+		},
+		NameCategoryStation: {
+			Prefixes: []string{"Molecular", "Fusion", "Nano", "Automated"},
+			Roots:    []string{"Fabricator", "Assembler", "Synthesizer", "Terminal"},
+			Suffixes: []string{"Mk II", "Series 9", "Omega"},
+		},
+	},
+	"horror": {
+		NameCategoryWeapon: {
+			Prefixes: []string{"Cursed", "Bloodstained", "Rusted", "Twisted", "Bone"},
+			Roots:    []string{"Cleaver", "Sickle", "Hook", "Knife", "Scythe", "Hatchet"},
+			Suffixes: []string{"of Screams", "of the Damned", "of Sorrow", "of the Grave"},
+		},
+		NameCategoryArmor: {
+			Prefixes: []string{"Stitched", "Rotting", "Cursed", "Bone"},
+			Roots:    []string{"Shroud", "Coat", "Mask", "Wrappings", "Cowl"},
+			Suffixes: []string{"of the Dead", "of Dread", "of the Crypt"},
+		},
+		NameCategoryPlace: {
+			Prefixes: []string{"The Haunted", "Abandoned", "Silent", "Forsaken"},
+			Roots:    []string{"Asylum", "Manor", "Chapel", "Morgue", "Cellar", "Orphanage"},
+			Suffixes: []string{"of Whispers", "of the Lost", "of Bones"},
+		},
+		NameCategoryCreature: {
+			Prefixes: []string{"Pale", "Hollow", "Shrieking", "Rotting", "Faceless"},
+			Roots:    []string{"Ghoul", "Wraith", "Revenant", "Stalker", "Husk", "Banshee"},
+			Suffixes: []string{"Mother", "Horde", "of the Pit"},
+		},
+		NameCategorySpell: {
+			Prefixes: []string{"Unholy", "Blood", "Dread", "Grave"},
+			Roots:    []string{"Curse", "Hex", "Rite", "Wail", "Plague"},
+			Suffixes: []string{"of Madness", "of Decay", "of the Void"},
+		},
+		NameCategoryStation: {
+			Prefixes: []string{"Corrupted", "Cursed", "Bloodstained", "Ritual"},
+			Roots:    []string{"Altar", "Slab", "Cauldron", "Furnace"},
+			Suffixes: []string{"of Sacrifice", "of the Coven"},
+		},
+	},
+	"cyberpunk": {
+		NameCategoryWeapon: {
+			Prefixes: []string{"Smart", "Neon", "Mono", "Chrome", "Black-Market"},
+			Roots:    []string{"Katana", "Pistol", "SMG", "Shotgun", "Whip", "Blade"},
+			Suffixes: []string{"v2.0", "Pro", "X", "Custom", "Elite"},
+		},
+		NameCategoryArmor: {
+			Prefixes: []string{"Subdermal", "Kevlar", "Chrome", "Street"},
+			Roots:    []string{"Jacket", "Implant", "Visor", "Weave", "Duster"},
+			Suffixes: []string{"v3", "Plus", "Corp Edition"},
+		},
+		NameCategoryPlace: {
+			Prefixes: []string{"Neo", "Lower", "Upper", "Sector"},
+			Roots:    []string{"Arcology", "Sprawl", "Nightmarket", "Datacenter", "Undercity"},
+			Suffixes: []string{"7", "Zero", "Heights", "Block"},
+		},
+		NameCategoryCreature: {
+			Prefixes: []string{"Augmented", "Rogue", "Corporate", "Feral"},
+			Roots:    []string{"Netrunner", "Enforcer", "Cyborg", "Drone", "Ganger", "Mech"},
+			Suffixes: []string{"Unit", "Boss", "Squad"},
+		},
+		NameCategorySpell: {
+			Prefixes: []string{"Neural", "Overclocked", "Viral", "Ghost"},
+			Roots:    []string{"Hack", "Spike", "Worm", "Overload", "Glitch"},
+			Suffixes: []string{"Daemon", "Exploit", "Script"},
+		},
+		NameCategoryStation: {
+			Prefixes: []string{"Black-Market", "Chrome", "Neon", "Street"},
+			Roots:    []string{"Ripperdoc Chair", "Workbench", "Printer", "Rig"},
+			Suffixes: []string{"v2", "Pro"},
+		},
+	},
+	"postapoc": {
+		NameCategoryWeapon: {
+			Prefixes: []string{"Salvaged", "Jury-Rigged", "Rusty", "Scrap", "Makeshift"},
+			Roots:    []string{"Pipe", "Rifle", "Machete", "Crossbow", "Club", "Shotgun"},
+			Suffixes: []string{"of the Wastes", "of Ash", "of the Road"},
+		},
+		NameCategoryArmor: {
+			Prefixes: []string{"Scrap", "Patched", "Tire", "Lead-Lined"},
+			Roots:    []string{"Vest", "Duster", "Gas Mask", "Plating", "Poncho"},
+			Suffixes: []string{"of the Raider", "of the Drifter"},
+		},
+		NameCategoryPlace: {
+			Prefixes: []string{"Ruins of", "Old", "Scorched", "Dead"},
+			Roots:    []string{"Junction", "Refinery", "Bunker", "Overpass", "Mall", "Silo"},
+			Suffixes: []string{"Town", "Camp", "Crossing"},
+		},
+		NameCategoryCreature: {
+			Prefixes: []string{"Mutated", "Irradiated", "Feral", "Two-Headed"},
+			Roots:    []string{"Raider", "Scavenger", "Mutant", "Ghoul", "Radroach", "Hound"},
+			Suffixes: []string{"Warlord", "Pack", "Chief"},
+		},
+		NameCategorySpell: {
+			Prefixes: []string{"Radiant", "Toxic", "Scorching", "Dust"},
+			Roots:    []string{"Burst", "Cloud", "Storm", "Flare", "Purge"},
+			Suffixes: []string{"of Fallout", "of the Blast"},
+		},
+		NameCategoryStation: {
+			Prefixes: []string{"Salvaged", "Makeshift", "Scrap", "Jury-Rigged"},
+			Roots:    []string{"Workbench", "Forge", "Still", "Reloading Bench"},
+			Suffixes: []string{"of the Settlement"},
+		},
+	},
+}
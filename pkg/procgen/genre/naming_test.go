@@ -0,0 +1,188 @@
+package genre
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNameGenerator_BuiltinCategories(t *testing.T) {
+	categories := []string{
+		NameCategoryWeapon, NameCategoryArmor, NameCategoryPlace,
+		NameCategoryCreature, NameCategorySpell, NameCategoryStation,
+	}
+
+	for _, g := range PredefinedGenres() {
+		ng := NewNameGenerator(g)
+		for _, category := range categories {
+			for seed := int64(0); seed < 20; seed++ {
+				name := ng.GenerateName(category, seed)
+				if name == "" {
+					t.Fatalf("%s %s name for seed %d is empty", g.ID, category, seed)
+				}
+				if name != ng.GenerateName(category, seed) {
+					t.Fatalf("%s %s name for seed %d is not deterministic", g.ID, category, seed)
+				}
+			}
+		}
+	}
+}
+
+func TestNameGenerator_UsesPoolWords(t *testing.T) {
+	ng := NewNameGeneratorForID("horror")
+	pool, _ := ng.Pool(NameCategoryWeapon)
+
+	names := make(map[string]bool)
+	for seed := int64(0); seed < 50; seed++ {
+		name := ng.GenerateName(NameCategoryWeapon, seed)
+		names[name] = true
+
+		hasRoot := false
+		for _, root := range pool.Roots {
+			if strings.Contains(name, root) {
+				hasRoot = true
+			}
+		}
+		if !hasRoot {
+			t.Errorf("name %q has no horror weapon root", name)
+		}
+	}
+	if len(names) < 10 {
+		t.Errorf("only %d distinct names from 50 seeds", len(names))
+	}
+}
+
+func TestNameGenerator_GenresDiffer(t *testing.T) {
+	fantasy := NewNameGeneratorForID("fantasy")
+	scifi := NewNameGeneratorForID("scifi")
+
+	same := 0
+	for seed := int64(0); seed < 20; seed++ {
+		if fantasy.GenerateName(NameCategoryPlace, seed) == scifi.GenerateName(NameCategoryPlace, seed) {
+			same++
+		}
+	}
+	if same > 0 {
+		t.Errorf("%d/20 place names identical across fantasy and sci-fi", same)
+	}
+}
+
+func TestNameGenerator_GenerateSuffix(t *testing.T) {
+	ng := NewNameGeneratorForID("scifi")
+	pool, _ := ng.Pool(NameCategoryWeapon)
+
+	for seed := int64(0); seed < 20; seed++ {
+		suffix := ng.GenerateSuffix(NameCategoryWeapon, seed)
+		found := false
+		for _, s := range pool.Suffixes {
+			if s == suffix {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("suffix %q for seed %d is not from the weapon pool", suffix, seed)
+		}
+		if suffix != ng.GenerateSuffix(NameCategoryWeapon, seed) {
+			t.Fatalf("suffix for seed %d is not deterministic", seed)
+		}
+	}
+
+	ng.SetPool("vehicle", NamePool{Roots: []string{"Shuttle"}})
+	if suffix := ng.GenerateSuffix("vehicle", 1); suffix != "" {
+		t.Errorf("category without suffixes gave %q", suffix)
+	}
+}
+
+func TestNameGenerator_UnknownCategoryAndGenre(t *testing.T) {
+	ng := NewNameGeneratorForID("steampunk")
+	if ng.GenreID() != "steampunk" {
+		t.Errorf("GenreID() = %s, want steampunk", ng.GenreID())
+	}
+	if name := ng.GenerateName(NameCategoryWeapon, 1); name != NewNameGeneratorForID("fantasy").GenerateName(NameCategoryWeapon, 1) {
+		t.Errorf("unknown genre should use fantasy pools, got %q", name)
+	}
+	if name := ng.GenerateName("vehicle", 1); name != "" {
+		t.Errorf("unknown category name = %q, want empty", name)
+	}
+}
+
+func TestNameGenerator_GenrePoolsExtendAndReplace(t *testing.T) {
+	g := FantasyGenre()
+	g.NamePools = map[string]NamePool{
+		NameCategoryWeapon: {Roots: []string{"Glaive"}},
+		NameCategoryPlace:  {Roots: []string{"Mire"}, Replace: true},
+		"vehicle":          {Roots: []string{"Carriage"}},
+	}
+	ng := NewNameGenerator(g)
+
+	weapons, _ := ng.Pool(NameCategoryWeapon)
+	if len(weapons.Roots) != len(builtinNamePools["fantasy"][NameCategoryWeapon].Roots)+1 {
+		t.Errorf("extended weapon roots = %v", weapons.Roots)
+	}
+
+	for seed := int64(0); seed < 10; seed++ {
+		if name := ng.GenerateName(NameCategoryPlace, seed); name != "Mire" {
+			t.Fatalf("replaced place pool produced %q, want Mire", name)
+		}
+	}
+	if name := ng.GenerateName("vehicle", 3); name != "Carriage" {
+		t.Errorf("custom category name = %q, want Carriage", name)
+	}
+
+	// The built-in pools are not modified
+	if places, _ := NewNameGeneratorForID("fantasy").Pool(NameCategoryPlace); len(places.Roots) < 2 {
+		t.Error("genre pools leaked into the built-in pools")
+	}
+}
+
+func TestNameGenerator_SetAndExtendPool(t *testing.T) {
+	ng := NewNameGeneratorForID("scifi")
+	ng.SetPool(NameCategoryCreature, NamePool{Prefixes: []string{"Void"}, Roots: []string{"Leviathan"}})
+	ng.ExtendPool(NameCategoryCreature, NamePool{Suffixes: []string{"Prime"}})
+
+	pool, ok := ng.Pool(NameCategoryCreature)
+	if !ok || len(pool.Roots) != 1 || len(pool.Suffixes) != 1 {
+		t.Fatalf("pool = %+v", pool)
+	}
+	valid := map[string]bool{"Leviathan": true, "Void Leviathan": true, "Leviathan Prime": true, "Void Leviathan Prime": true}
+	for seed := int64(0); seed < 20; seed++ {
+		if name := ng.GenerateName(NameCategoryCreature, seed); !valid[name] {
+			t.Errorf("unexpected name %q", name)
+		}
+	}
+
+	pool.Roots[0] = "Changed"
+	if again, _ := ng.Pool(NameCategoryCreature); again.Roots[0] != "Leviathan" {
+		t.Error("Pool() should return a copy")
+	}
+	if categories := ng.Categories(); len(categories) != 6 || categories[0] != NameCategoryArmor {
+		t.Errorf("Categories() = %v", categories)
+	}
+}
+
+func TestGenre_ValidateNamePools(t *testing.T) {
+	g := FantasyGenre()
+	g.NamePools = map[string]NamePool{NameCategoryWeapon: {Prefixes: []string{"Old"}, Replace: true}}
+	if err := g.Validate(); err == nil {
+		t.Error("expected error for replacement pool without roots")
+	}
+
+	g.NamePools = map[string]NamePool{NameCategoryWeapon: {Prefixes: []string{"Old"}}}
+	if err := g.Validate(); err != nil {
+		t.Errorf("extension pool without roots should be valid: %v", err)
+	}
+}
+
+func TestRegistry_ImportNamePools(t *testing.T) {
+	data := `{"genres": [{"id": "steampunk", "name": "Steampunk", "description": "d", "themes": ["brass"],
+		"name_pools": {"weapon": {"roots": ["Steam Lance"], "replace": true}}}]}`
+
+	registry, err := DefaultRegistryWithImport([]byte(data), false)
+	if err != nil {
+		t.Fatalf("DefaultRegistryWithImport() error = %v", err)
+	}
+	steampunk, _ := registry.Get("steampunk")
+	if name := NewNameGenerator(steampunk).GenerateName(NameCategoryWeapon, 9); name != "Steam Lance" {
+		t.Errorf("imported genre weapon name = %q, want Steam Lance", name)
+	}
+}
@@ -0,0 +1,107 @@
+// Package genre provides genre serialization.
+// This file implements JSON export and import of registries so genres
+// defined at runtime or by modders in a data file survive restarts.
+package genre
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+)
+
+// GenreFileVersion is the version of the genre data file format.
+const GenreFileVersion = 1
+
+// genreFile is the JSON layout of an exported registry.
+type genreFile struct {
+	Version int      `json:"version"`
+	Genres  []*Genre `json:"genres"`
+}
+
+// Export encodes every registered genre as JSON, sorted by ID.
+func (r *Registry) Export() ([]byte, error) {
+	file := genreFile{Version: GenreFileVersion, Genres: r.All()}
+	sort.Slice(file.Genres, func(i, j int) bool {
+		return file.Genres[i].ID < file.Genres[j].ID
+	})
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode genres: %w", err)
+	}
+	return data, nil
+}
+
+// Import registers the genres in JSON data produced by Export or written by
+// hand. A genre whose ID is already registered is an error unless its
+// definition is identical; use ImportForce to replace existing genres.
+// Nothing is registered if any genre is invalid or conflicts.
+func (r *Registry) Import(data []byte) error {
+	return r.importGenres(data, false)
+}
+
+// ImportForce is like Import but replaces registered genres, including the
+// predefined ones, that share an ID with an imported genre.
+func (r *Registry) ImportForce(data []byte) error {
+	return r.importGenres(data, true)
+}
+
+// ImportFile imports the genres in a JSON data file (see Import and
+// ImportForce for the meaning of force).
+func (r *Registry) ImportFile(path string, force bool) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read genre file: %w", err)
+	}
+	if err := r.importGenres(data, force); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// importGenres validates every genre in data before registering any.
+func (r *Registry) importGenres(data []byte, force bool) error {
+	var file genreFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to decode genres: %w", err)
+	}
+	if file.Version > GenreFileVersion {
+		return fmt.Errorf("unsupported genre file version %d (max %d)", file.Version, GenreFileVersion)
+	}
+
+	seen := make(map[string]bool, len(file.Genres))
+	for i, g := range file.Genres {
+		if g == nil {
+			return fmt.Errorf("genre %d is empty", i)
+		}
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("invalid genre %d (%q): %w", i, g.ID, err)
+		}
+		if seen[g.ID] {
+			return fmt.Errorf("genre '%s' defined more than once", g.ID)
+		}
+		seen[g.ID] = true
+
+		if existing, exists := r.genres[g.ID]; exists && !force && !reflect.DeepEqual(existing, g) {
+			return fmt.Errorf("genre with ID '%s' already registered (import with force to replace it)", g.ID)
+		}
+	}
+
+	for _, g := range file.Genres {
+		r.genres[g.ID] = g
+	}
+	return nil
+}
+
+// DefaultRegistryWithImport returns the default registry with the genres in
+// data merged over the predefined ones. Unless force is set, imported genres
+// may not replace predefined genres.
+func DefaultRegistryWithImport(data []byte, force bool) (*Registry, error) {
+	registry := DefaultRegistry()
+	if err := registry.importGenres(data, force); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
@@ -0,0 +1,170 @@
+package genre
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const steampunkJSON = `{
+  "version": 1,
+  "genres": [
+    {
+      "id": "steampunk",
+      "name": "Steampunk",
+      "description": "Victorian industry powered by steam and clockwork",
+      "themes": ["clockwork", "steam", "brass", "airships"],
+      "primary_color": "#B87333",
+      "secondary_color": "#3B2F2F",
+      "accent_color": "#FFD700",
+      "entity_prefix": "Clockwork",
+      "item_prefix": "Brass",
+      "location_prefix": "Foundry of"
+    }
+  ]
+}`
+
+func TestRegistry_ExportImportRoundTrip(t *testing.T) {
+	source := NewRegistry()
+	for _, g := range PredefinedGenres() {
+		if err := source.Register(g); err != nil {
+			t.Fatalf("Register() error = %v", err)
+		}
+	}
+	if err := source.Import([]byte(steampunkJSON)); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	data, err := source.Export()
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	restored := NewRegistry()
+	if err := restored.Import(data); err != nil {
+		t.Fatalf("Import() of exported data error = %v", err)
+	}
+	if restored.Count() != source.Count() {
+		t.Fatalf("restored %d genres, want %d", restored.Count(), source.Count())
+	}
+	for _, id := range source.IDs() {
+		want, _ := source.Get(id)
+		got, err := restored.Get(id)
+		if err != nil {
+			t.Fatalf("restored registry missing %s", id)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("genre %s = %+v, want %+v", id, got, want)
+		}
+	}
+
+	again, _ := source.Export()
+	if string(again) != string(data) {
+		t.Error("Export() is not deterministic")
+	}
+}
+
+func TestRegistry_ImportRejectsConflicts(t *testing.T) {
+	registry := DefaultRegistry()
+	clobber := strings.Replace(steampunkJSON, `"id": "steampunk"`, `"id": "fantasy"`, 1)
+
+	err := registry.Import([]byte(clobber))
+	if err == nil || !strings.Contains(err.Error(), "already registered") {
+		t.Fatalf("Import() error = %v, want already registered", err)
+	}
+	fantasy, _ := registry.Get("fantasy")
+	if fantasy.Name != "Fantasy" {
+		t.Error("rejected import replaced the built-in genre")
+	}
+
+	// Re-importing an identical definition is not a conflict
+	data, _ := registry.Export()
+	if err := registry.Import(data); err != nil {
+		t.Errorf("Import() of identical genres error = %v", err)
+	}
+
+	if err := registry.ImportForce([]byte(clobber)); err != nil {
+		t.Fatalf("ImportForce() error = %v", err)
+	}
+	fantasy, _ = registry.Get("fantasy")
+	if fantasy.Name != "Steampunk" {
+		t.Errorf("ImportForce() did not replace fantasy, got %s", fantasy.Name)
+	}
+}
+
+func TestRegistry_ImportInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"malformed JSON", `{"genres": [`, "decode"},
+		{"future version", `{"version": 99, "genres": []}`, "version"},
+		{"missing themes", `{"genres": [{"id": "x", "name": "X", "description": "d"}]}`, "theme"},
+		{"null genre", `{"genres": [null]}`, "empty"},
+		{"duplicate IDs", `{"genres": [` + genreJSON("a") + `,` + genreJSON("a") + `]}`, "more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			err := registry.Import([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Import() error = %v, want %q", err, tt.want)
+			}
+			if registry.Count() != 0 {
+				t.Errorf("failed import registered %d genres", registry.Count())
+			}
+		})
+	}
+}
+
+func TestDefaultRegistryWithImport(t *testing.T) {
+	registry, err := DefaultRegistryWithImport([]byte(steampunkJSON), false)
+	if err != nil {
+		t.Fatalf("DefaultRegistryWithImport() error = %v", err)
+	}
+	if registry.Count() != len(PredefinedGenres())+1 || !registry.Has("steampunk") || !registry.Has("horror") {
+		t.Errorf("merged registry has %v", registry.IDs())
+	}
+
+	steampunk, _ := registry.Get("steampunk")
+	if steampunk.ItemPrefix != "Brass" || len(steampunk.Themes) != 4 || steampunk.AccentColor != "#FFD700" {
+		t.Errorf("imported genre = %+v", steampunk)
+	}
+
+	// Imported genres can be blended like built-ins
+	blended, err := NewGenreBlender(registry).Blend("steampunk", "horror", 0.5, 1)
+	if err != nil {
+		t.Fatalf("Blend() with imported genre error = %v", err)
+	}
+	if !blended.IsBlended() {
+		t.Error("expected blended genre")
+	}
+}
+
+func TestRegistry_ImportFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "genres.json")
+	if err := os.WriteFile(path, []byte(steampunkJSON), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := DefaultRegistry()
+	if err := registry.ImportFile(path, false); err != nil {
+		t.Fatalf("ImportFile() error = %v", err)
+	}
+	if !registry.Has("steampunk") {
+		t.Error("ImportFile() did not register steampunk")
+	}
+
+	if err := registry.ImportFile(filepath.Join(t.TempDir(), "missing.json"), false); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+// genreJSON returns a minimal valid genre definition.
+func genreJSON(id string) string {
+	return `{"id": "` + id + `", "name": "N", "description": "D", "themes": ["t"]}`
+}
@@ -8,34 +8,38 @@ import "fmt"
 // Genre represents a game genre with associated metadata and theming.
 type Genre struct {
 	// ID is the unique identifier for this genre (lowercase, no spaces)
-	ID string
+	ID string `json:"id"`
 
 	// Name is the human-readable name of the genre
-	Name string
+	Name string `json:"name"`
 
 	// Description provides a brief description of the genre
-	Description string
+	Description string `json:"description"`
 
 	// Themes are keywords that describe the genre's aesthetic and content
-	Themes []string
+	Themes []string `json:"themes"`
 
 	// PrimaryColor is the main color associated with this genre (RGB hex)
-	PrimaryColor string
+	PrimaryColor string `json:"primary_color"`
 
 	// SecondaryColor is an accent color for this genre (RGB hex)
-	SecondaryColor string
+	SecondaryColor string `json:"secondary_color"`
 
 	// AccentColor is another accent color for variety (RGB hex)
-	AccentColor string
+	AccentColor string `json:"accent_color"`
 
 	// EntityPrefix is the prefix used for entity names in this genre
-	EntityPrefix string
+	EntityPrefix string `json:"entity_prefix"`
 
 	// ItemPrefix is the prefix used for item names in this genre
-	ItemPrefix string
+	ItemPrefix string `json:"item_prefix"`
 
 	// LocationPrefix is the prefix used for location names in this genre
-	LocationPrefix string
+	LocationPrefix string `json:"location_prefix"`
+
+	// NamePools extends or replaces the built-in name pools by category
+	// (see NameGenerator)
+	NamePools map[string]NamePool `json:"name_pools,omitempty"`
 }
 
 // ColorPalette returns the genre's color palette as a slice of hex colors.
@@ -67,6 +71,9 @@ func (g *Genre) Validate() error {
 	if len(g.Themes) == 0 {
 		return fmt.Errorf("genre must have at least one theme")
 	}
+	if err := validateNamePools(g.NamePools); err != nil {
+		return err
+	}
 	// Color validation is optional - some genres might not define colors
 	return nil
 }
@@ -1,6 +1,6 @@
 // Package item provides procedural item generation.
 // This file implements item generators for weapons, armor, consumables,
-// and accessories with procedural stats and effects.
+// accessories, materials and currency with procedural stats and effects.
 package item
 
 import (
@@ -8,6 +8,7 @@ import (
 	"math/rand"
 
 	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/genre"
 	"github.com/sirupsen/logrus"
 )
 
@@ -16,6 +17,8 @@ type ItemGenerator struct {
 	weaponTemplates     map[string][]ItemTemplate
 	armorTemplates      map[string][]ItemTemplate
 	consumableTemplates map[string][]ItemTemplate
+	materialTemplates   map[string][]ItemTemplate
+	currencyTemplates   map[string][]ItemTemplate
 	logger              *logrus.Entry
 }
 
@@ -35,6 +38,8 @@ func NewItemGeneratorWithLogger(logger *logrus.Logger) *ItemGenerator {
 		weaponTemplates:     make(map[string][]ItemTemplate),
 		armorTemplates:      make(map[string][]ItemTemplate),
 		consumableTemplates: make(map[string][]ItemTemplate),
+		materialTemplates:   make(map[string][]ItemTemplate),
+		currencyTemplates:   make(map[string][]ItemTemplate),
 		logger:              logEntry,
 	}
 
@@ -42,15 +47,27 @@ func NewItemGeneratorWithLogger(logger *logrus.Logger) *ItemGenerator {
 	gen.weaponTemplates["fantasy"] = GetFantasyWeaponTemplates()
 	gen.armorTemplates["fantasy"] = GetFantasyArmorTemplates()
 	gen.consumableTemplates["fantasy"] = GetFantasyConsumableTemplates()
+	gen.materialTemplates["fantasy"] = GetFantasyMaterialTemplates()
+	gen.currencyTemplates["fantasy"] = GetFantasyCurrencyTemplates()
 
 	// Register sci-fi genre templates
 	gen.weaponTemplates["scifi"] = GetSciFiWeaponTemplates()
 	gen.armorTemplates["scifi"] = GetSciFiArmorTemplates()
+	gen.materialTemplates["scifi"] = GetSciFiMaterialTemplates()
+	gen.currencyTemplates["scifi"] = GetSciFiCurrencyTemplates()
+
+	// Materials follow each genre's recipes
+	gen.materialTemplates["horror"] = GetHorrorMaterialTemplates()
+	gen.materialTemplates["cyberpunk"] = GetCyberpunkMaterialTemplates()
+	gen.materialTemplates["postapoc"] = GetPostApocMaterialTemplates()
+	gen.currencyTemplates["cyberpunk"] = GetSciFiCurrencyTemplates()
 
 	// Default templates
 	gen.weaponTemplates[""] = GetFantasyWeaponTemplates()
 	gen.armorTemplates[""] = GetFantasyArmorTemplates()
 	gen.consumableTemplates[""] = GetFantasyConsumableTemplates()
+	gen.materialTemplates[""] = GetFantasyMaterialTemplates()
+	gen.currencyTemplates[""] = GetFantasyCurrencyTemplates()
 
 	if logEntry != nil {
 		logEntry.Debug("item generator initialized")
@@ -76,11 +93,12 @@ func (g *ItemGenerator) Generate(seed int64, params procgen.GenerationParams) (i
 
 	itemTypeFilter := g.getItemTypeFilter(params)
 	rng := rand.New(rand.NewSource(seed))
+	names := genre.NewNameGeneratorForID(params.GenreID)
 
 	items := make([]*Item, count)
 	for i := 0; i < count; i++ {
 		itemSeed := seed + int64(i)*1000
-		items[i] = g.generateSingleItem(itemSeed, params, itemTypeFilter, i, rng)
+		items[i] = g.generateSingleItem(itemSeed, params, itemTypeFilter, i, rng, names)
 	}
 
 	typeFilter := "all"
@@ -117,6 +135,10 @@ func (g *ItemGenerator) getItemTypeFilter(params procgen.GenerationParams) *Item
 		itemType = TypeArmor
 	case "consumable":
 		itemType = TypeConsumable
+	case "material":
+		itemType = TypeMaterial
+	case "currency":
+		itemType = TypeCurrency
 	default:
 		return nil
 	}
@@ -124,7 +146,7 @@ func (g *ItemGenerator) getItemTypeFilter(params procgen.GenerationParams) *Item
 }
 
 // generateSingleItem creates one item.
-func (g *ItemGenerator) generateSingleItem(seed int64, params procgen.GenerationParams, itemTypeFilter *ItemType, index int, rng *rand.Rand) *Item {
+func (g *ItemGenerator) generateSingleItem(seed int64, params procgen.GenerationParams, itemTypeFilter *ItemType, index int, rng *rand.Rand, names *genre.NameGenerator) *Item {
 	// Determine item type
 	itemType := g.determineItemType(itemTypeFilter, rng)
 
@@ -140,6 +162,10 @@ func (g *ItemGenerator) generateSingleItem(seed int64, params procgen.Generation
 	case TypeAccessory:
 		// For now, accessories use armor templates
 		templates = g.getArmorTemplates(params.GenreID)
+	case TypeMaterial:
+		templates = g.getMaterialTemplates(params.GenreID)
+	case TypeCurrency:
+		templates = g.getCurrencyTemplates(params.GenreID)
 	}
 
 	if len(templates) == 0 {
@@ -164,10 +190,19 @@ func (g *ItemGenerator) generateSingleItem(seed int64, params procgen.Generation
 	copy(item.Tags, template.Tags)
 
 	// Determine rarity based on depth
-	item.Rarity = g.determineRarity(params.Depth, rng)
+	rarityBonus := 0.0
+	if params.Custom != nil {
+		if bonus, ok := params.Custom["rarity_bonus"].(float64); ok {
+			rarityBonus = bonus
+		}
+	}
+	item.Rarity = g.determineRarity(params.Depth, rarityBonus, rng)
 
 	// Generate name
 	item.Name = g.generateName(template, item.Rarity, rng)
+	if item.Rarity == RarityLegendary {
+		item.Name = withEpithet(item.Name, item.Type, names, seed)
+	}
 
 	// Generate stats
 	item.Stats = g.generateStats(template, params.Depth, item.Rarity, params.Difficulty, rng)
@@ -225,8 +260,28 @@ func (g *ItemGenerator) getConsumableTemplates(genreID string) []ItemTemplate {
 	return templates
 }
 
+// getMaterialTemplates retrieves material templates for a genre.
+func (g *ItemGenerator) getMaterialTemplates(genreID string) []ItemTemplate {
+	templates := g.materialTemplates[genreID]
+	if templates == nil {
+		templates = g.materialTemplates[""] // fallback to default
+	}
+	return templates
+}
+
+// getCurrencyTemplates retrieves currency templates for a genre.
+func (g *ItemGenerator) getCurrencyTemplates(genreID string) []ItemTemplate {
+	templates := g.currencyTemplates[genreID]
+	if templates == nil {
+		templates = g.currencyTemplates[""] // fallback to default
+	}
+	return templates
+}
+
 // determineRarity calculates item rarity based on depth and random chance.
-func (g *ItemGenerator) determineRarity(depth int, rng *rand.Rand) Rarity {
+// rarityBonus shifts the rarity thresholds like extra depth: positive values
+// make rare items more common, negative values less.
+func (g *ItemGenerator) determineRarity(depth int, rarityBonus float64, rng *rand.Rand) Rarity {
 	// Base probabilities
 	roll := rng.Float64()
 
@@ -236,6 +291,15 @@ func (g *ItemGenerator) determineRarity(depth int, rng *rand.Rand) Rarity {
 		depthBonus = 0.3
 	}
 
+	// Apply the caller's bonus, keeping every tier reachable
+	depthBonus += rarityBonus
+	if depthBonus < -0.3 {
+		depthBonus = -0.3
+	}
+	if depthBonus > 0.45 {
+		depthBonus = 0.45
+	}
+
 	// Adjust thresholds based on depth
 	commonThreshold := 0.50 - depthBonus
 	uncommonThreshold := 0.80 - depthBonus
@@ -258,6 +322,12 @@ func (g *ItemGenerator) determineRarity(depth int, rng *rand.Rand) Rarity {
 
 // generateName creates a name for the item.
 func (g *ItemGenerator) generateName(template ItemTemplate, rarity Rarity, rng *rand.Rand) string {
+	// Materials and currency keep their plain name so recipes and station
+	// fuel can match them
+	if len(template.NamePrefixes) == 0 {
+		return template.NameSuffixes[rng.Intn(len(template.NameSuffixes))]
+	}
+
 	prefix := template.NamePrefixes[rng.Intn(len(template.NamePrefixes))]
 	suffix := template.NameSuffixes[rng.Intn(len(template.NameSuffixes))]
 
@@ -275,6 +345,26 @@ func (g *ItemGenerator) generateName(template ItemTemplate, rarity Rarity, rng *
 	return rarityPrefix + prefix + " " + suffix
 }
 
+// withEpithet appends a genre epithet from the shared name pools to a
+// weapon, armor or accessory name, e.g. "Legendary Iron Sword of Embers".
+// Other item types keep the name as is.
+func withEpithet(name string, itemType ItemType, names *genre.NameGenerator, seed int64) string {
+	var category string
+	switch itemType {
+	case TypeWeapon:
+		category = genre.NameCategoryWeapon
+	case TypeArmor, TypeAccessory:
+		category = genre.NameCategoryArmor
+	default:
+		return name
+	}
+
+	if suffix := names.GenerateSuffix(category, seed); suffix != "" {
+		return name + " " + suffix
+	}
+	return name
+}
+
 // generateStats creates stats for the item.
 func (g *ItemGenerator) generateStats(template ItemTemplate, depth int, rarity Rarity, difficulty float64, rng *rand.Rand) Stats {
 	stats := Stats{}
@@ -431,6 +521,15 @@ func (g *ItemGenerator) generateDescription(item *Item, template ItemTemplate, r
 			"A valuable resource for any adventurer.",
 			"Use wisely, supplies are limited.",
 		)
+	case TypeMaterial:
+		descriptions = append(descriptions,
+			"A useful crafting ingredient.",
+			"Crafters would put this to good use.",
+		)
+	case TypeCurrency:
+		descriptions = append(descriptions,
+			"Money is always welcome.",
+		)
 	}
 
 	// Add rarity-specific text
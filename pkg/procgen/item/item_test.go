@@ -1,9 +1,11 @@
 package item
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/genre"
 )
 
 func TestNewItemGenerator(t *testing.T) {
@@ -241,6 +243,8 @@ func TestItemTypes(t *testing.T) {
 		{TypeArmor, "armor"},
 		{TypeConsumable, "consumable"},
 		{TypeAccessory, "accessory"},
+		{TypeMaterial, "material"},
+		{TypeCurrency, "currency"},
 	}
 
 	for _, tt := range tests {
@@ -614,3 +618,128 @@ func TestRarityDistribution(t *testing.T) {
 		t.Errorf("Expected more uncommon+ items at depth 20, got %d", uncommonPlus)
 	}
 }
+
+func TestRarityBonus(t *testing.T) {
+	gen := NewItemGenerator()
+
+	countRare := func(bonus float64) int {
+		params := procgen.GenerationParams{
+			Depth:      5,
+			Difficulty: 0.5,
+			GenreID:    "fantasy",
+			Custom: map[string]interface{}{
+				"count":        200,
+				"rarity_bonus": bonus,
+			},
+		}
+		result, err := gen.Generate(777, params)
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		rare := 0
+		for _, item := range result.([]*Item) {
+			if item.Rarity >= RarityRare {
+				rare++
+			}
+		}
+		return rare
+	}
+
+	stingy, normal, generous := countRare(-0.2), countRare(0), countRare(0.2)
+	if !(stingy < normal && normal < generous) {
+		t.Errorf("rare+ counts should rise with rarity_bonus: -0.2=%d, 0=%d, 0.2=%d", stingy, normal, generous)
+	}
+}
+
+func TestItemGeneration_MaterialsAndCurrency(t *testing.T) {
+	gen := NewItemGenerator()
+	for _, tt := range []struct {
+		typeName string
+		itemType ItemType
+		tag      string
+	}{
+		{"material", TypeMaterial, "material"},
+		{"currency", TypeCurrency, "currency"},
+	} {
+		for _, genreID := range []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc"} {
+			names := make(map[string]bool)
+			templates := gen.getMaterialTemplates(genreID)
+			if tt.itemType == TypeCurrency {
+				templates = gen.getCurrencyTemplates(genreID)
+			}
+			for _, template := range templates {
+				for _, name := range template.NameSuffixes {
+					names[name] = true
+				}
+			}
+
+			params := procgen.GenerationParams{
+				Depth:   5,
+				GenreID: genreID,
+				Custom:  map[string]interface{}{"count": 10, "type": tt.typeName},
+			}
+			result, err := gen.Generate(99, params)
+			if err != nil {
+				t.Fatalf("Generate(%s, %s) failed: %v", tt.typeName, genreID, err)
+			}
+			for _, itm := range result.([]*Item) {
+				if itm.Type != tt.itemType {
+					t.Errorf("%s %s: type = %v, want %v", genreID, itm.Name, itm.Type, tt.itemType)
+				}
+				if len(itm.Tags) != 1 || itm.Tags[0] != tt.tag {
+					t.Errorf("%s %s: tags = %v, want [%s]", genreID, itm.Name, itm.Tags, tt.tag)
+				}
+				if !names[itm.Name] {
+					t.Errorf("%s: name %q is not a plain template name", genreID, itm.Name)
+				}
+				if tt.itemType == TypeCurrency && itm.Stats.Value <= 0 {
+					t.Errorf("%s %s: currency worth %d", genreID, itm.Name, itm.Stats.Value)
+				}
+			}
+		}
+	}
+}
+
+func TestItemGeneration_LegendaryEpithets(t *testing.T) {
+	gen := NewItemGenerator()
+	params := procgen.GenerationParams{
+		Depth:      10,
+		Difficulty: 0.5,
+		GenreID:    "horror",
+		Custom: map[string]interface{}{
+			"count":        30,
+			"type":         "weapon",
+			"rarity_bonus": 1.0,
+		},
+	}
+
+	result, err := gen.Generate(4242, params)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	pool, _ := genre.NewNameGeneratorForID("horror").Pool(genre.NameCategoryWeapon)
+	legendary := 0
+	for _, itm := range result.([]*Item) {
+		if itm.Rarity != RarityLegendary {
+			continue
+		}
+		legendary++
+		if !hasAnySuffix(itm.Name, pool.Suffixes) {
+			t.Errorf("legendary weapon %q has no horror epithet", itm.Name)
+		}
+	}
+	if legendary == 0 {
+		t.Fatal("expected legendary weapons with a rarity bonus")
+	}
+}
+
+// hasAnySuffix reports whether name ends with one of suffixes.
+func hasAnySuffix(name string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(name, " "+suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+// Package item provides material and currency templates.
+// This file defines the crafting materials and money the item generator
+// produces when asked for the "material" or "currency" type. Material names
+// match the ingredients of the recipe generator's genre templates so that
+// dropped materials can be used for crafting, and the fuel materials match
+// the fuel items of the station generator's charged stations.
+package item
+
+// materialTemplate builds a template for a named material. Materials keep
+// their exact name so recipes can find them by name.
+func materialTemplate(names []string, valueRange [2]int, weightRange [2]float64) ItemTemplate {
+	return ItemTemplate{
+		BaseType:     TypeMaterial,
+		NameSuffixes: names,
+		Tags:         []string{"material"},
+		ValueRange:   valueRange,
+		WeightRange:  weightRange,
+	}
+}
+
+// currencyTemplate builds a template for a named currency. The generated
+// item's value is the amount of gold it is worth.
+func currencyTemplate(names []string, valueRange [2]int) ItemTemplate {
+	return ItemTemplate{
+		BaseType:     TypeCurrency,
+		NameSuffixes: names,
+		Tags:         []string{"currency"},
+		ValueRange:   valueRange,
+	}
+}
+
+// GetFantasyMaterialTemplates returns material templates for fantasy genre.
+func GetFantasyMaterialTemplates() []ItemTemplate {
+	return []ItemTemplate{
+		materialTemplate([]string{"Healing Herb", "Water Flask", "Honey", "Purified Water"}, [2]int{2, 10}, [2]float64{0.1, 0.5}),
+		materialTemplate([]string{"Mana Crystal", "Arcane Dust", "Magic Crystal", "Magic Ink"}, [2]int{5, 20}, [2]float64{0.1, 0.3}),
+		materialTemplate([]string{"Enchantment Scroll", "Silver Dust", "Silver Wire", "Oak Branch"}, [2]int{5, 15}, [2]float64{0.1, 1.0}),
+	}
+}
+
+// GetSciFiMaterialTemplates returns material templates for sci-fi genre.
+func GetSciFiMaterialTemplates() []ItemTemplate {
+	return []ItemTemplate{
+		materialTemplate([]string{"Nano-Gel", "Synth Fluid", "Med-Pack"}, [2]int{5, 15}, [2]float64{0.1, 0.5}),
+		materialTemplate([]string{"Circuit Board", "Nano-Wire", "Power Cell"}, [2]int{5, 20}, [2]float64{0.1, 0.5}),
+		materialTemplate([]string{"Plasma Core", "Weapon Frame", "Energy Coil"}, [2]int{10, 25}, [2]float64{0.5, 2.0}),
+		// Station fuel
+		materialTemplate([]string{"Energy Cell"}, [2]int{5, 15}, [2]float64{0.2, 0.5}),
+	}
+}
+
+// GetHorrorMaterialTemplates returns material templates for horror genre.
+func GetHorrorMaterialTemplates() []ItemTemplate {
+	return []ItemTemplate{
+		materialTemplate([]string{"Dried Blood", "Bone Dust", "Dark Herb"}, [2]int{2, 10}, [2]float64{0.1, 0.3}),
+		materialTemplate([]string{"Ritual Scroll", "Soul Fragment", "Black Ink"}, [2]int{5, 20}, [2]float64{0.1, 0.3}),
+		materialTemplate([]string{"Human Bone", "Dark Crystal", "Sinew"}, [2]int{5, 15}, [2]float64{0.2, 1.0}),
+	}
+}
+
+// GetCyberpunkMaterialTemplates returns material templates for cyberpunk genre.
+func GetCyberpunkMaterialTemplates() []ItemTemplate {
+	return []ItemTemplate{
+		materialTemplate([]string{"Synth-Chem", "Neuro-Booster", "Filter Capsule"}, [2]int{5, 15}, [2]float64{0.1, 0.3}),
+		materialTemplate([]string{"Neural Link", "Bio-Circuit", "Interface Chip"}, [2]int{10, 25}, [2]float64{0.1, 0.3}),
+		materialTemplate([]string{"Titanium Alloy", "Mono-Wire", "Power Core"}, [2]int{10, 25}, [2]float64{0.5, 2.0}),
+		// Station fuel
+		materialTemplate([]string{"Power Cell"}, [2]int{5, 15}, [2]float64{0.2, 0.5}),
+	}
+}
+
+// GetPostApocMaterialTemplates returns material templates for post-apocalyptic genre.
+func GetPostApocMaterialTemplates() []ItemTemplate {
+	return []ItemTemplate{
+		materialTemplate([]string{"Purified Water", "Scrap Medicine", "Mutant Plant"}, [2]int{2, 10}, [2]float64{0.1, 0.5}),
+		materialTemplate([]string{"Scrap Metal", "Duct Tape", "Rusty Nails", "Pipe", "Wire"}, [2]int{1, 8}, [2]float64{0.2, 1.5}),
+		// Station fuel
+		materialTemplate([]string{"Clean Water", "Welding Fuel", "Battery Pack"}, [2]int{3, 12}, [2]float64{0.3, 1.0}),
+	}
+}
+
+// GetFantasyCurrencyTemplates returns currency templates for fantasy genre.
+func GetFantasyCurrencyTemplates() []ItemTemplate {
+	return []ItemTemplate{
+		currencyTemplate([]string{"Gold Coins", "Coin Purse"}, [2]int{5, 25}),
+	}
+}
+
+// GetSciFiCurrencyTemplates returns currency templates for sci-fi genre.
+func GetSciFiCurrencyTemplates() []ItemTemplate {
+	return []ItemTemplate{
+		currencyTemplate([]string{"Credit Chip", "Credits"}, [2]int{5, 25}),
+	}
+}
@@ -15,6 +15,10 @@ const (
 	TypeConsumable
 	// TypeAccessory represents stat-boosting equipment
 	TypeAccessory
+	// TypeMaterial represents crafting materials and station fuel
+	TypeMaterial
+	// TypeCurrency represents money that is added to gold when picked up
+	TypeCurrency
 )
 
 // String returns the string representation of an item type.
@@ -28,6 +32,10 @@ func (t ItemType) String() string {
 		return "consumable"
 	case TypeAccessory:
 		return "accessory"
+	case TypeMaterial:
+		return "material"
+	case TypeCurrency:
+		return "currency"
 	default:
 		return "unknown"
 	}
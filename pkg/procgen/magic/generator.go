@@ -8,6 +8,7 @@ import (
 	"math/rand"
 
 	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/genre"
 	"github.com/sirupsen/logrus"
 )
 
@@ -111,11 +112,19 @@ func (g *SpellGenerator) getTemplatesForGenre(genreID string) ([]SpellTemplate,
 
 // generateSpells generates the specified count of spells from templates.
 func (g *SpellGenerator) generateSpells(rng *rand.Rand, templates []SpellTemplate, params procgen.GenerationParams, seed int64, count int) []*Spell {
+	names := genre.NewNameGeneratorForID(params.GenreID)
 	spells := make([]*Spell, 0, count)
 	for i := 0; i < count; i++ {
 		template := templates[rng.Intn(len(templates))]
 		spell := g.generateFromTemplate(rng, template, params)
 		spell.Seed = seed + int64(i)
+
+		// Legendary spells carry a genre epithet, e.g. "of the Magi"
+		if spell.Rarity == RarityLegendary {
+			if suffix := names.GenerateSuffix(genre.NameCategorySpell, spell.Seed); suffix != "" {
+				spell.Name += " " + suffix
+			}
+		}
 		spells = append(spells, spell)
 	}
 	return spells
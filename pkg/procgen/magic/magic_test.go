@@ -1,9 +1,11 @@
 package magic
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/genre"
 )
 
 func TestSpellGenerator_Generate(t *testing.T) {
@@ -327,6 +329,45 @@ func TestSpellGenerator_GenreDifferences(t *testing.T) {
 	}
 }
 
+func TestSpellGenerator_LegendaryEpithets(t *testing.T) {
+	gen := NewSpellGenerator()
+	params := procgen.GenerationParams{
+		Difficulty: 1.0,
+		Depth:      20,
+		GenreID:    "horror",
+		Custom: map[string]interface{}{
+			"count": 30,
+		},
+	}
+
+	result, err := gen.Generate(777, params)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	pool, _ := genre.NewNameGeneratorForID("horror").Pool(genre.NameCategorySpell)
+	legendary := 0
+	for _, spell := range result.([]*Spell) {
+		if spell.Rarity != RarityLegendary {
+			continue
+		}
+		legendary++
+		found := false
+		for _, suffix := range pool.Suffixes {
+			if strings.HasSuffix(spell.Name, " "+suffix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("legendary spell %q has no horror epithet", spell.Name)
+		}
+	}
+	if legendary == 0 {
+		t.Fatal("expected legendary spells at depth 20")
+	}
+}
+
 func TestSpell_IsOffensive(t *testing.T) {
 	tests := []struct {
 		name      string
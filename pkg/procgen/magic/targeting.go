@@ -0,0 +1,85 @@
+// Package magic provides spell targeting previews.
+// This file computes the tiles affected by a spell's target pattern so the
+// client can draw a targeting preview before an Area, Cone, or Line spell
+// is cast. Range and AreaSize are world pixels, as when the spell is
+// resolved, and are converted to tiles with the caller's tile size.
+package magic
+
+import (
+	"image"
+	"math"
+)
+
+// ConeHalfAngle is the half-angle of cone-shaped spells in radians (45°),
+// matching the cone used when resolving spell targets.
+const ConeHalfAngle = math.Pi / 4
+
+// LineHalfWidth is the half-width of line-shaped spells in tiles.
+const LineHalfWidth = 0.5
+
+// AffectedTiles returns the tiles impacted by this spell when cast from the
+// origin tile toward the aim angle (radians, 0 = +X, π/2 = +Y). tileSize is
+// the size of a tile in world pixels and converts Range and AreaSize to
+// tiles; a tileSize of zero or less returns nil.
+//
+// Area spells cover a disc of radius AreaSize centered on origin; cone
+// spells fan out from origin within ConeHalfAngle of the aim direction up to
+// Range; line spells extend from origin along the aim up to Range. Self
+// spells affect only the origin tile. Single-target and global spells have no
+// spatial pattern and return nil. Tiles are returned in row-major order.
+func (s *Spell) AffectedTiles(origin image.Point, aim, tileSize float64) []image.Point {
+	if tileSize <= 0 {
+		return nil
+	}
+	spellRange := s.Stats.Range / tileSize
+	areaSize := s.Stats.AreaSize / tileSize
+
+	switch s.Target {
+	case TargetSelf:
+		return []image.Point{origin}
+	case TargetArea:
+		return tilesWithin(origin, areaSize, func(dx, dy float64) bool {
+			return dx*dx+dy*dy <= areaSize*areaSize
+		})
+	case TargetCone:
+		dirX, dirY := math.Cos(aim), math.Sin(aim)
+		minDot := math.Cos(ConeHalfAngle)
+		return tilesWithin(origin, spellRange, func(dx, dy float64) bool {
+			dist := math.Hypot(dx, dy)
+			if dist == 0 || dist > spellRange {
+				return false
+			}
+			return (dx*dirX+dy*dirY)/dist >= minDot-1e-9
+		})
+	case TargetLine:
+		dirX, dirY := math.Cos(aim), math.Sin(aim)
+		return tilesWithin(origin, spellRange, func(dx, dy float64) bool {
+			along := dx*dirX + dy*dirY
+			if along <= 0 || along > spellRange {
+				return false
+			}
+			across := math.Abs(dx*dirY - dy*dirX)
+			return across <= LineHalfWidth
+		})
+	default:
+		return nil
+	}
+}
+
+// tilesWithin returns tiles within radius of origin that satisfy include,
+// which receives the tile offset from origin.
+func tilesWithin(origin image.Point, radius float64, include func(dx, dy float64) bool) []image.Point {
+	if radius < 0 {
+		return nil
+	}
+	r := int(math.Ceil(radius))
+	var tiles []image.Point
+	for dy := -r; dy <= r; dy++ {
+		for dx := -r; dx <= r; dx++ {
+			if include(float64(dx), float64(dy)) {
+				tiles = append(tiles, image.Point{X: origin.X + dx, Y: origin.Y + dy})
+			}
+		}
+	}
+	return tiles
+}
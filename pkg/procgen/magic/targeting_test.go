@@ -0,0 +1,134 @@
+package magic
+
+import (
+	"image"
+	"math"
+	"testing"
+)
+
+func TestSpell_AffectedTiles_Cone(t *testing.T) {
+	spell := &Spell{Target: TargetCone, Stats: Stats{Range: 6}}
+	origin := image.Point{X: 10, Y: 10}
+	aim := 0.0 // facing +X
+
+	tiles := spell.AffectedTiles(origin, aim, 1)
+	if len(tiles) == 0 {
+		t.Fatal("cone spell returned no tiles")
+	}
+
+	maxSpread := 0
+	for _, tile := range tiles {
+		dx := float64(tile.X - origin.X)
+		dy := float64(tile.Y - origin.Y)
+		dist := math.Hypot(dx, dy)
+
+		if tile == origin {
+			t.Error("cone should not include the origin tile")
+		}
+		if dist > spell.Stats.Range {
+			t.Errorf("tile %v beyond range (%.2f > %.2f)", tile, dist, spell.Stats.Range)
+		}
+		if angle := math.Abs(math.Atan2(dy, dx)); angle > ConeHalfAngle+1e-9 {
+			t.Errorf("tile %v outside cone angle (%.2f rad)", tile, angle)
+		}
+		if dx <= 0 {
+			t.Errorf("tile %v is not in the aim direction", tile)
+		}
+		if spread := int(math.Abs(dy)); spread > maxSpread {
+			maxSpread = spread
+		}
+	}
+
+	// The cone must fan out: far tiles spread wider than the first step
+	if maxSpread < 2 {
+		t.Errorf("cone max spread = %d, expected cone to widen with distance", maxSpread)
+	}
+
+	// The tile directly along the aim at full range is included
+	tip := image.Point{X: origin.X + 6, Y: origin.Y}
+	if !containsTile(tiles, tip) {
+		t.Errorf("cone missing tip tile %v", tip)
+	}
+}
+
+func TestSpell_AffectedTiles_ConeFollowsAim(t *testing.T) {
+	spell := &Spell{Target: TargetCone, Stats: Stats{Range: 4}}
+	origin := image.Point{}
+
+	tiles := spell.AffectedTiles(origin, math.Pi/2, 1) // facing +Y
+	for _, tile := range tiles {
+		if tile.Y <= 0 {
+			t.Errorf("tile %v not in +Y direction", tile)
+		}
+	}
+}
+
+func TestSpell_AffectedTiles_Area(t *testing.T) {
+	spell := &Spell{Target: TargetArea, Stats: Stats{AreaSize: 2}}
+	origin := image.Point{X: 5, Y: 5}
+
+	tiles := spell.AffectedTiles(origin, 0, 1)
+	if !containsTile(tiles, origin) {
+		t.Error("area should include origin")
+	}
+	// Disc of radius 2 on a grid contains 13 tiles
+	if len(tiles) != 13 {
+		t.Errorf("area tiles = %d, want 13", len(tiles))
+	}
+}
+
+func TestSpell_AffectedTiles_Line(t *testing.T) {
+	spell := &Spell{Target: TargetLine, Stats: Stats{Range: 5}}
+	origin := image.Point{X: 0, Y: 0}
+
+	tiles := spell.AffectedTiles(origin, math.Pi, 1) // facing -X
+	if len(tiles) != 5 {
+		t.Fatalf("line tiles = %d, want 5: %v", len(tiles), tiles)
+	}
+	for _, tile := range tiles {
+		if tile.Y != 0 || tile.X >= 0 {
+			t.Errorf("tile %v not on the -X line", tile)
+		}
+	}
+}
+
+func TestSpell_AffectedTiles_NonSpatial(t *testing.T) {
+	self := &Spell{Target: TargetSelf}
+	if tiles := self.AffectedTiles(image.Point{X: 3, Y: 4}, 0, 1); len(tiles) != 1 || tiles[0] != (image.Point{X: 3, Y: 4}) {
+		t.Errorf("self spell tiles = %v, want origin only", tiles)
+	}
+
+	for _, target := range []TargetType{TargetSingle, TargetAllAllies, TargetAllEnemies} {
+		spell := &Spell{Target: target, Stats: Stats{Range: 10}}
+		if tiles := spell.AffectedTiles(image.Point{}, 0, 1); tiles != nil {
+			t.Errorf("%v spell returned tiles %v, want nil", target, tiles)
+		}
+	}
+}
+
+func TestSpell_AffectedTiles_PixelRanges(t *testing.T) {
+	// Spell sizes are world pixels; with 32px tiles a 96px line spans 3
+	// tiles and a 64px area has a radius of 2 tiles
+	line := &Spell{Target: TargetLine, Stats: Stats{Range: 96}}
+	if tiles := line.AffectedTiles(image.Point{X: 4, Y: 4}, 0, 32); len(tiles) != 3 || !containsTile(tiles, image.Point{X: 7, Y: 4}) {
+		t.Errorf("line tiles = %v, want (5,4) through (7,4)", tiles)
+	}
+
+	area := &Spell{Target: TargetArea, Stats: Stats{AreaSize: 64}}
+	if tiles := area.AffectedTiles(image.Point{}, 0, 32); len(tiles) != 13 {
+		t.Errorf("area tiles = %d, want 13", len(tiles))
+	}
+
+	if tiles := area.AffectedTiles(image.Point{}, 0, 0); tiles != nil {
+		t.Errorf("zero tile size returned %v, want nil", tiles)
+	}
+}
+
+func containsTile(tiles []image.Point, p image.Point) bool {
+	for _, tile := range tiles {
+		if tile == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+// Package puzzle provides puzzle difficulty estimation.
+// This file measures how hard a generated puzzle is by replaying its solution
+// through the CSP backtracking search, and drives the generator toward a
+// requested difficulty.
+package puzzle
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+const (
+	// CustomTargetDifficulty is the GenerationParams.Custom key (float64,
+	// 0.0-1.0) requesting a puzzle whose EstimateDifficulty is near it.
+	CustomTargetDifficulty = "target_difficulty"
+
+	// CustomDifficultyTolerance is the GenerationParams.Custom key (float64)
+	// for how far the estimate may be from the target. Defaults to
+	// DefaultDifficultyTolerance.
+	CustomDifficultyTolerance = "difficulty_tolerance"
+
+	// DefaultDifficultyTolerance is the accepted distance from a target
+	// difficulty when none is given.
+	DefaultDifficultyTolerance = 0.1
+
+	// maxDifficultyAttempts bounds the puzzles generated to hit a target.
+	maxDifficultyAttempts = 24
+
+	// difficultySeedStride separates the seeds of successive attempts.
+	difficultySeedStride = 7919
+)
+
+// DifficultyMetrics are the raw measurements behind EstimateDifficulty.
+type DifficultyMetrics struct {
+	SolutionLength  int     // Minimum number of actions that solve the puzzle
+	NodesExplored   int     // Values a backtracking search tried to find the solution
+	BranchingFactor float64 // Average values tried per solution step
+	Ordered         bool    // Whether the solution must be performed in order
+}
+
+// DifficultyMetrics models the puzzle as a CSP with one variable per
+// solution step over the interactable elements, and measures the
+// backtracking search that finds the solution.
+func (p *Puzzle) DifficultyMetrics() DifficultyMetrics {
+	metrics := DifficultyMetrics{
+		SolutionLength: len(p.Solution),
+		Ordered:        p.isOrdered(),
+	}
+	if len(p.Solution) == 0 {
+		return metrics
+	}
+
+	var candidates []interface{}
+	index := make(map[string]int)
+	for _, elem := range p.Elements {
+		if elem.Interactable {
+			index[elem.ID] = len(candidates)
+			candidates = append(candidates, elem.ID)
+		}
+	}
+
+	csp := NewCSP(0)
+	members := make(map[string]bool, len(p.Solution))
+	for _, id := range p.Solution {
+		members[id] = true
+	}
+
+	for i, want := range p.Solution {
+		name := fmt.Sprintf("step_%03d", i)
+		_ = csp.AddVariable(name, candidates)
+
+		if metrics.Ordered {
+			// Trial and error: only the right element advances the sequence
+			want := want
+			_ = csp.AddConstraint([]string{name}, func(a map[string]interface{}) bool {
+				return a[name] == want
+			})
+			continue
+		}
+
+		// Any order works, so the search takes solution elements in
+		// element order to avoid counting permutations
+		_ = csp.AddConstraint([]string{name}, func(a map[string]interface{}) bool {
+			return members[a[name].(string)]
+		})
+		if i > 0 {
+			prev := fmt.Sprintf("step_%03d", i-1)
+			_ = csp.AddConstraint([]string{prev, name}, func(a map[string]interface{}) bool {
+				return index[a[prev].(string)] < index[a[name].(string)]
+			})
+		}
+	}
+
+	if _, err := csp.Solve(); err != nil {
+		return metrics
+	}
+	metrics.NodesExplored = csp.NodesExplored()
+	metrics.BranchingFactor = float64(metrics.NodesExplored) / float64(metrics.SolutionLength)
+	return metrics
+}
+
+// EstimateDifficulty rates how hard the puzzle is from 0.0 (trivial) to 1.0.
+// Longer solutions, more candidate elements per step, fixed solution order,
+// tight time limits, few attempts and long block pushes all raise it.
+func (p *Puzzle) EstimateDifficulty() float64 {
+	m := p.DifficultyMetrics()
+	if m.SolutionLength == 0 {
+		return 0
+	}
+
+	score := 0.45*math.Min(float64(m.SolutionLength)/6, 1) +
+		0.35*math.Min(math.Max(m.BranchingFactor-1, 0)/4, 1)
+	if m.Ordered {
+		score += 0.1
+	}
+	if p.TimeLimit > 0 {
+		score += 0.1 * (1 - math.Min(p.TimeLimit/60, 1))
+	}
+	if p.MaxAttempts > 0 {
+		score += 0.05 * (1 - math.Min(float64(p.MaxAttempts)/15, 1))
+	}
+	if p.Type == PuzzleTypeBlockPushing {
+		score += 0.1 * math.Min(p.averagePushDistance()/12, 1)
+	}
+	return math.Min(score, 1)
+}
+
+// isOrdered reports whether the solution sequence order matters.
+func (p *Puzzle) isOrdered() bool {
+	switch p.Type {
+	case PuzzleTypeLeverSequence, PuzzleTypeMemoryPattern:
+		return true
+	case PuzzleTypeTimedChallenge:
+		return len(p.Elements) > 0 && p.Elements[0].ElementType == "lever"
+	default:
+		return false
+	}
+}
+
+// averagePushDistance returns the mean Manhattan distance from each block to
+// its target.
+func (p *Puzzle) averagePushDistance() float64 {
+	positions := make(map[string][2]int, len(p.Elements))
+	for _, elem := range p.Elements {
+		positions[elem.ID] = elem.Position
+	}
+
+	total, blocks := 0, 0
+	for i := 0; ; i++ {
+		block, hasBlock := positions[fmt.Sprintf("block_%d", i)]
+		target, hasTarget := positions[fmt.Sprintf("target_%d", i)]
+		if !hasBlock || !hasTarget {
+			break
+		}
+		total += abs(block[0]-target[0]) + abs(block[1]-target[1])
+		blocks++
+	}
+	if blocks == 0 {
+		return 0
+	}
+	return float64(total) / float64(blocks)
+}
+
+// targetDifficulty reads the requested target difficulty and tolerance from
+// params. ok is false when no target is requested.
+func targetDifficulty(params procgen.GenerationParams) (target, tolerance float64, ok bool, err error) {
+	target, ok = params.Custom[CustomTargetDifficulty].(float64)
+	if !ok {
+		return 0, 0, false, nil
+	}
+	if target < 0 || target > 1 {
+		return 0, 0, false, fmt.Errorf("%s must be between 0 and 1, got %f", CustomTargetDifficulty, target)
+	}
+
+	tolerance = DefaultDifficultyTolerance
+	if t, set := params.Custom[CustomDifficultyTolerance].(float64); set {
+		if t < 0 {
+			return 0, 0, false, fmt.Errorf("%s cannot be negative, got %f", CustomDifficultyTolerance, t)
+		}
+		tolerance = t
+	}
+	return target, tolerance, true, nil
+}
+
+// generateForTarget generates puzzles until one's estimated difficulty is
+// within tolerance of target, nudging the puzzle's internal difficulty (and
+// so its element count and solution length) toward the target and moving to
+// a derived seed on each attempt. If no attempt lands in the band, the
+// closest puzzle is returned.
+func (g *Generator) generateForTarget(seed int64, params procgen.GenerationParams, target, tolerance float64) (*Puzzle, error) {
+	difficulty := 1 + int(math.Round(target*9))
+
+	var best *Puzzle
+	bestGap := math.Inf(1)
+	for attempt := 0; attempt < maxDifficultyAttempts; attempt++ {
+		puzzle, err := g.generateWithDifficulty(seed+int64(attempt)*difficultySeedStride, params, difficulty)
+		if err != nil {
+			return nil, err
+		}
+
+		estimate := puzzle.EstimateDifficulty()
+		gap := math.Abs(estimate - target)
+		if gap < bestGap {
+			best, bestGap = puzzle, gap
+		}
+		if gap <= tolerance {
+			break
+		}
+
+		if estimate < target && difficulty < 10 {
+			difficulty++
+		} else if estimate > target && difficulty > 1 {
+			difficulty--
+		}
+	}
+	return best, nil
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,128 @@
+package puzzle
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+func TestCSPNodesExplored(t *testing.T) {
+	csp := NewCSP(0)
+	csp.AddVariable("a", []interface{}{1, 2, 3})
+	csp.AddConstraint([]string{"a"}, func(a map[string]interface{}) bool {
+		return a["a"] == 3
+	})
+
+	if _, err := csp.Solve(); err != nil {
+		t.Fatalf("Solve() error = %v", err)
+	}
+	if got := csp.NodesExplored(); got != 3 {
+		t.Errorf("NodesExplored() = %d, want 3", got)
+	}
+}
+
+func TestDifficultyMetrics(t *testing.T) {
+	levers := &Puzzle{
+		Type:     PuzzleTypeLeverSequence,
+		Solution: []string{"lever_2", "lever_0"},
+		Elements: []PuzzleElement{
+			{ID: "lever_0", ElementType: "lever", Interactable: true},
+			{ID: "lever_1", ElementType: "lever", Interactable: true},
+			{ID: "lever_2", ElementType: "lever", Interactable: true},
+		},
+	}
+	m := levers.DifficultyMetrics()
+	// Finding lever_2 takes three tries, lever_0 one
+	if !m.Ordered || m.SolutionLength != 2 || m.NodesExplored != 4 || m.BranchingFactor != 2 {
+		t.Errorf("lever metrics = %+v", m)
+	}
+
+	plates := &Puzzle{
+		Type:     PuzzleTypePressurePlate,
+		Solution: []string{"plate_2", "plate_0"},
+		Elements: []PuzzleElement{
+			{ID: "plate_0", ElementType: "plate", Interactable: true},
+			{ID: "plate_1", ElementType: "plate", Interactable: true},
+			{ID: "plate_2", ElementType: "plate", Interactable: true},
+		},
+	}
+	m = plates.DifficultyMetrics()
+	if m.Ordered || m.SolutionLength != 2 {
+		t.Errorf("plate metrics = %+v", m)
+	}
+
+	if (&Puzzle{}).EstimateDifficulty() != 0 {
+		t.Error("puzzle without a solution should have zero difficulty")
+	}
+}
+
+func TestEstimateDifficultyOrdering(t *testing.T) {
+	gen := NewGenerator()
+	rng := rand.New(rand.NewSource(1))
+	params := procgen.GenerationParams{GenreID: "fantasy"}
+
+	easy, err := gen.generateLeverSequencePuzzle(rng, gen.templates[PuzzleTypeLeverSequence], 1, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hard, err := gen.generateLeverSequencePuzzle(rng, gen.templates[PuzzleTypeLeverSequence], 10, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, h := easy.EstimateDifficulty(), hard.EstimateDifficulty()
+	if e < 0 || h > 1 || e >= h {
+		t.Errorf("EstimateDifficulty() easy = %f, hard = %f", e, h)
+	}
+}
+
+func TestGenerateTargetDifficulty(t *testing.T) {
+	gen := NewGenerator()
+
+	for _, target := range []float64{0.2, 0.5, 0.8} {
+		for seed := int64(0); seed < 20; seed++ {
+			params := procgen.GenerationParams{
+				Difficulty: 0.5,
+				Depth:      5,
+				GenreID:    "fantasy",
+				Custom:     map[string]interface{}{CustomTargetDifficulty: target},
+			}
+
+			result, err := gen.Generate(seed, params)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if err := gen.Validate(result); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			puzzle := result.(*Puzzle)
+			if estimate := puzzle.EstimateDifficulty(); math.Abs(estimate-target) > DefaultDifficultyTolerance {
+				t.Errorf("target %.1f seed %d: estimate %f outside band", target, seed, estimate)
+			}
+
+			again, _ := gen.Generate(seed, params)
+			if again.(*Puzzle).ID != puzzle.ID || again.(*Puzzle).Difficulty != puzzle.Difficulty {
+				t.Errorf("target %.1f seed %d: generation not deterministic", target, seed)
+			}
+		}
+	}
+}
+
+func TestGenerateTargetDifficultyInvalid(t *testing.T) {
+	gen := NewGenerator()
+
+	tests := map[string]map[string]interface{}{
+		"target above 1":     {CustomTargetDifficulty: 1.5},
+		"negative target":    {CustomTargetDifficulty: -0.1},
+		"negative tolerance": {CustomTargetDifficulty: 0.5, CustomDifficultyTolerance: -1.0},
+	}
+	for name, custom := range tests {
+		params := procgen.GenerationParams{Difficulty: 0.5, Depth: 5, Custom: custom}
+		if _, err := gen.Generate(1, params); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -14,6 +14,29 @@ package puzzle
 // - Timed Challenge: Complete within time limit
 // - Memory Pattern: Repeat shown pattern
 // - Color Matching: Match colors/symbols
+// - Pipe Connection: Rotate pipe tiles to connect a source to a sink
 //
 // All puzzles are generated deterministically from a seed and guarantee solvability
 // through constraint solving and validation.
+//
+// Difficulty estimation:
+// Puzzle.EstimateDifficulty rates a puzzle from 0.0 to 1.0 using its minimum
+// solution length and the branching factor of a backtracking search for the
+// solution (see Puzzle.DifficultyMetrics), plus time and attempt limits.
+// Setting params.Custom["target_difficulty"] makes Generate retry derived
+// seeds and internal difficulties until the estimate is within
+// params.Custom["difficulty_tolerance"] (default 0.1) of the target. The
+// result is deterministic for a given seed and target.
+//
+// Hints:
+// Puzzle.GenerateHints derives progressively revealing hints from the known
+// solution: the area of the first step, the first step, then the full
+// solution. Each hint lists the IDs of the elements it refers to so the UI
+// can highlight them.
+//
+// Pipe connection puzzles:
+// Puzzle.Pipes holds the tile grid. The generator lays a random path from the
+// source on the west edge to the sink on the east edge, solves for the tile
+// rotations that join it with the constraint solver, then scrambles every
+// tile. PipeGrid.Rotate turns a tile, ConnectedTiles reports where water
+// reaches and IsSolved checks the source-to-sink connection.
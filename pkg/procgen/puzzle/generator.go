@@ -27,6 +27,8 @@ const (
 	PuzzleTypeMemoryPattern PuzzleType = "memory_pattern"
 	// PuzzleTypeColorMatching requires matching colors/symbols
 	PuzzleTypeColorMatching PuzzleType = "color_matching"
+	// PuzzleTypePipeConnection requires rotating pipe tiles to connect a source to a sink
+	PuzzleTypePipeConnection PuzzleType = "pipe_connection"
 )
 
 // PuzzleTemplate defines puzzle generation parameters.
@@ -53,6 +55,7 @@ type Puzzle struct {
 	HintText     string          // Player-facing hint
 	Description  string          // Puzzle description
 	RewardType   string          // Type of reward (door, chest, etc.)
+	Pipes        *PipeGrid       // Tile grid (pipe connection puzzles only)
 }
 
 // PuzzleElement represents an interactive puzzle element.
@@ -139,10 +142,43 @@ func (g *Generator) initializeTemplates() {
 		TimeLimitRange:   [2]float64{0, 45},
 		MaxAttemptsRange: [2]int{0, 8},
 	}
+
+	g.templates[PuzzleTypePipeConnection] = PuzzleTemplate{
+		Type:             PuzzleTypePipeConnection,
+		MinElements:      9,  // 3x3 grid
+		MaxElements:      30, // 6x5 grid
+		MinComplexity:    3,
+		MaxComplexity:    9,
+		TimeLimitRange:   [2]float64{0, 90},
+		MaxAttemptsRange: [2]int{0, 0},
+	}
 }
 
 // Generate creates a new puzzle using the provided seed and parameters.
+// If params.Custom["target_difficulty"] is set, generation retries derived
+// seeds and difficulties until the puzzle's EstimateDifficulty is within
+// params.Custom["difficulty_tolerance"] of it.
 func (g *Generator) Generate(seed int64, params procgen.GenerationParams) (interface{}, error) {
+	target, tolerance, hasTarget, err := targetDifficulty(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var puzzle *Puzzle
+	if hasTarget {
+		puzzle, err = g.generateForTarget(seed, params, target, tolerance)
+	} else {
+		// Calculate difficulty (1-10 scale)
+		puzzle, err = g.generateWithDifficulty(seed, params, g.calculateDifficulty(params))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return puzzle, nil
+}
+
+// generateWithDifficulty creates a puzzle of the given difficulty (1-10).
+func (g *Generator) generateWithDifficulty(seed int64, params procgen.GenerationParams, difficulty int) (*Puzzle, error) {
 	// Create RNG from seed
 	rng := rand.New(rand.NewSource(seed))
 
@@ -155,9 +191,6 @@ func (g *Generator) Generate(seed int64, params procgen.GenerationParams) (inter
 		return nil, fmt.Errorf("no template for puzzle type: %s", puzzleType)
 	}
 
-	// Calculate difficulty (1-10 scale)
-	difficulty := g.calculateDifficulty(params)
-
 	// Generate puzzle based on type
 	var puzzle *Puzzle
 	var err error
@@ -175,6 +208,8 @@ func (g *Generator) Generate(seed int64, params procgen.GenerationParams) (inter
 		puzzle, err = g.generateMemoryPatternPuzzle(rng, template, difficulty, params)
 	case PuzzleTypeColorMatching:
 		puzzle, err = g.generateColorMatchingPuzzle(rng, template, difficulty, params)
+	case PuzzleTypePipeConnection:
+		puzzle, err = g.generatePipeConnectionPuzzle(rng, template, difficulty, params)
 	default:
 		return nil, fmt.Errorf("unsupported puzzle type: %s", puzzleType)
 	}
@@ -235,6 +270,13 @@ func (g *Generator) Validate(result interface{}) error {
 		if puzzle.TimeLimit <= 0 {
 			return fmt.Errorf("timed challenge must have positive time limit")
 		}
+	case PuzzleTypePipeConnection:
+		if puzzle.Pipes == nil {
+			return fmt.Errorf("pipe connection puzzle missing tile grid")
+		}
+		if !puzzle.Pipes.solved().IsSolved() {
+			return fmt.Errorf("pipe connection puzzle is not solvable")
+		}
 	}
 
 	return nil
@@ -258,6 +300,7 @@ func (g *Generator) selectPuzzleType(rng *rand.Rand, params procgen.GenerationPa
 			PuzzleTypeLeverSequence,
 			PuzzleTypeMemoryPattern,
 			PuzzleTypeColorMatching,
+			PuzzleTypePipeConnection,
 		}
 		return types[rng.Intn(len(types))]
 	}
@@ -270,6 +313,7 @@ func (g *Generator) selectPuzzleType(rng *rand.Rand, params procgen.GenerationPa
 		PuzzleTypeTimedChallenge,
 		PuzzleTypeMemoryPattern,
 		PuzzleTypeColorMatching,
+		PuzzleTypePipeConnection,
 	}
 	return types[rng.Intn(len(types))]
 }
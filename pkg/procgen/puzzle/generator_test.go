@@ -21,6 +21,7 @@ func TestNewGenerator(t *testing.T) {
 		PuzzleTypeTimedChallenge,
 		PuzzleTypeMemoryPattern,
 		PuzzleTypeColorMatching,
+		PuzzleTypePipeConnection,
 	}
 
 	for _, puzzleType := range expectedTypes {
@@ -0,0 +1,179 @@
+// Package puzzle provides progressive puzzle hints.
+// This file derives hints from a puzzle's known solution, from a vague
+// pointer toward the first step up to the full solution, so players can ask
+// for as much help as they need.
+package puzzle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Hint is one step of progressively revealing help for a puzzle.
+type Hint struct {
+	Level      int      // 1 for the vaguest hint, increasing as more is revealed
+	Text       string   // Player-facing hint
+	ElementIDs []string // Elements the UI should highlight with this hint
+}
+
+// GenerateHints returns the puzzle's hints in reveal order: the area of the
+// first step, then the first step itself, then the full solution. Hints are
+// derived from the solution, so they are deterministic for a puzzle.
+func (p *Puzzle) GenerateHints() []Hint {
+	if len(p.Solution) == 0 {
+		return nil
+	}
+
+	elements := make(map[string]PuzzleElement, len(p.Elements))
+	for _, elem := range p.Elements {
+		elements[elem.ID] = elem
+	}
+	first := elements[p.Solution[0]]
+	verb, noun := p.hintWords()
+	ordered := p.isOrdered()
+
+	// Level 1: the area of the first step, highlighting every element there
+	region := p.regionOf(first)
+	var nearby []string
+	for _, elem := range p.Elements {
+		if elem.Interactable && p.regionOf(elem) == region {
+			nearby = append(nearby, elem.ID)
+		}
+	}
+	text := fmt.Sprintf("One of the %ss you need is %s", noun, region)
+	if ordered {
+		text = fmt.Sprintf("The first %s is %s", noun, region)
+	}
+	hints := []Hint{{Level: 1, Text: text, ElementIDs: nearby}}
+
+	// Level 2: the first step
+	text = fmt.Sprintf("%s %s", verb, p.stepLabel(first.ID))
+	if ordered {
+		text += " first"
+	}
+	hints = append(hints, Hint{Level: 2, Text: text, ElementIDs: p.stepElements(first.ID)})
+	if len(p.Solution) == 1 {
+		return hints
+	}
+
+	// Level 3: the full solution
+	steps := make([]string, len(p.Solution))
+	var ids []string
+	for i, id := range p.Solution {
+		steps[i] = p.stepLabel(id)
+		ids = append(ids, p.stepElements(id)...)
+	}
+	if ordered {
+		text = fmt.Sprintf("%s the %ss in this order: %s", verb, noun, strings.Join(steps, ", "))
+	} else {
+		text = fmt.Sprintf("%s %s and %s, in any order", verb,
+			strings.Join(steps[:len(steps)-1], ", "), steps[len(steps)-1])
+	}
+	return append(hints, Hint{Level: 3, Text: text, ElementIDs: ids})
+}
+
+// hintWords returns the action verb and element noun used in hints.
+func (p *Puzzle) hintWords() (verb, noun string) {
+	elementType := ""
+	if len(p.Elements) > 0 {
+		elementType = p.Elements[0].ElementType
+	}
+	switch elementType {
+	case "pressure_plate":
+		return "Step on", "plate"
+	case "lever":
+		return "Pull", "lever"
+	case "pushable_block":
+		return "Push", "block"
+	case "memory_symbol":
+		return "Touch", "symbol"
+	case "colored_tile":
+		return "Activate", "tile"
+	case "pipe_tile":
+		return "Rotate", "pipe"
+	default:
+		return "Use", "element"
+	}
+}
+
+// stepLabel describes the solution step for an element, such as "lever 3",
+// "symbol 2 (circle)" or "block 0 onto target 0".
+func (p *Puzzle) stepLabel(id string) string {
+	label := strings.ReplaceAll(id, "_", " ")
+	for _, elem := range p.Elements {
+		if elem.ID != id {
+			continue
+		}
+		if state, ok := elem.State.(string); ok && (elem.ElementType == "memory_symbol" || elem.ElementType == "colored_tile") {
+			label = fmt.Sprintf("%s (%s)", label, state)
+		}
+		break
+	}
+	if target := blockTarget(id); p.Type == PuzzleTypeBlockPushing && target != "" {
+		label += " onto " + strings.ReplaceAll(target, "_", " ")
+	}
+	return label
+}
+
+// stepElements returns the elements involved in a solution step: the element
+// itself, and its target for block pushing.
+func (p *Puzzle) stepElements(id string) []string {
+	if p.Type == PuzzleTypeBlockPushing {
+		if target := blockTarget(id); target != "" {
+			return []string{id, target}
+		}
+	}
+	return []string{id}
+}
+
+// blockTarget returns the target ID of a pushable block ID, or "".
+func blockTarget(id string) string {
+	if !strings.HasPrefix(id, "block_") {
+		return ""
+	}
+	return "target_" + strings.TrimPrefix(id, "block_")
+}
+
+// regionOf describes where an element lies among the puzzle's interactable
+// elements, such as "on the left" or "in the top right".
+func (p *Puzzle) regionOf(elem PuzzleElement) string {
+	minX, minY := elem.Position[0], elem.Position[1]
+	maxX, maxY := minX, minY
+	for _, e := range p.Elements {
+		if !e.Interactable {
+			continue
+		}
+		minX, maxX = min(minX, e.Position[0]), max(maxX, e.Position[0])
+		minY, maxY = min(minY, e.Position[1]), max(maxY, e.Position[1])
+	}
+
+	horizontal := third(elem.Position[0], minX, maxX, "left", "right")
+	vertical := third(elem.Position[1], minY, maxY, "top", "bottom")
+	switch {
+	case horizontal == "" && vertical == "":
+		return "in the middle"
+	case vertical == "":
+		return "on the " + horizontal
+	case horizontal == "":
+		return "at the " + vertical
+	default:
+		return "in the " + vertical + " " + horizontal
+	}
+}
+
+// third returns low or high if v lies in the lower or upper third of
+// [lo, hi], and "" for the middle third.
+func third(v, lo, hi int, low, high string) string {
+	if hi == lo {
+		return ""
+	}
+	rel := float64(v-lo) / float64(hi-lo)
+	switch {
+	case rel < 1.0/3:
+		return low
+	case rel > 2.0/3:
+		return high
+	default:
+		return ""
+	}
+}
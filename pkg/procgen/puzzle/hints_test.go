@@ -0,0 +1,111 @@
+package puzzle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+func TestGenerateHintsLeverSequence(t *testing.T) {
+	puzzle := &Puzzle{
+		Type:     PuzzleTypeLeverSequence,
+		Solution: []string{"lever_0", "lever_2"},
+		Elements: []PuzzleElement{
+			{ID: "lever_0", ElementType: "lever", Position: [2]int{0, 5}, Interactable: true},
+			{ID: "lever_1", ElementType: "lever", Position: [2]int{5, 5}, Interactable: true},
+			{ID: "lever_2", ElementType: "lever", Position: [2]int{9, 5}, Interactable: true},
+		},
+	}
+
+	hints := puzzle.GenerateHints()
+	want := []string{
+		"The first lever is on the left",
+		"Pull lever 0 first",
+		"Pull the levers in this order: lever 0, lever 2",
+	}
+	if len(hints) != len(want) {
+		t.Fatalf("got %d hints, want %d", len(hints), len(want))
+	}
+	for i, hint := range hints {
+		if hint.Level != i+1 || hint.Text != want[i] {
+			t.Errorf("hint %d = %d %q, want %d %q", i, hint.Level, hint.Text, i+1, want[i])
+		}
+	}
+	if ids := hints[1].ElementIDs; len(ids) != 1 || ids[0] != "lever_0" {
+		t.Errorf("first step highlights %v, want [lever_0]", ids)
+	}
+	if ids := hints[2].ElementIDs; len(ids) != 2 || ids[1] != "lever_2" {
+		t.Errorf("full solution highlights %v", ids)
+	}
+}
+
+func TestGenerateHintsBlockPushing(t *testing.T) {
+	puzzle := &Puzzle{
+		Type:     PuzzleTypeBlockPushing,
+		Solution: []string{"block_0"},
+		Elements: []PuzzleElement{
+			{ID: "block_0", ElementType: "pushable_block", Interactable: true},
+			{ID: "target_0", ElementType: "block_target"},
+		},
+	}
+
+	hints := puzzle.GenerateHints()
+	if len(hints) != 2 {
+		t.Fatalf("single step puzzle should have 2 hints, got %d", len(hints))
+	}
+	if hints[1].Text != "Push block 0 onto target 0" {
+		t.Errorf("hint = %q", hints[1].Text)
+	}
+	if ids := hints[1].ElementIDs; len(ids) != 2 || ids[1] != "target_0" {
+		t.Errorf("block hint highlights %v, want block and target", ids)
+	}
+}
+
+func TestGenerateHintsAllPuzzleTypes(t *testing.T) {
+	gen := NewGenerator()
+	params := procgen.GenerationParams{Difficulty: 0.9, Depth: 10, GenreID: "fantasy"}
+
+	for seed := int64(0); seed < 50; seed++ {
+		result, err := gen.Generate(seed, params)
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		puzzle := result.(*Puzzle)
+
+		hints := puzzle.GenerateHints()
+		if len(hints) < 2 {
+			t.Fatalf("%s: got %d hints", puzzle.Type, len(hints))
+		}
+
+		ids := make(map[string]bool)
+		for _, elem := range puzzle.Elements {
+			ids[elem.ID] = true
+		}
+		for i, hint := range hints {
+			if hint.Text == "" || len(hint.ElementIDs) == 0 {
+				t.Errorf("%s hint %d is empty: %+v", puzzle.Type, i, hint)
+			}
+			for _, id := range hint.ElementIDs {
+				if !ids[id] {
+					t.Errorf("%s hint %d references unknown element %q", puzzle.Type, i, id)
+				}
+			}
+		}
+
+		// The last hint reveals every solution step
+		last := hints[len(hints)-1]
+		for _, id := range puzzle.Solution {
+			if !strings.Contains(last.Text, strings.ReplaceAll(id, "_", " ")) {
+				t.Errorf("%s final hint %q missing %s", puzzle.Type, last.Text, id)
+			}
+		}
+
+		again := puzzle.GenerateHints()
+		for i := range hints {
+			if again[i].Text != hints[i].Text {
+				t.Errorf("%s hints not deterministic", puzzle.Type)
+			}
+		}
+	}
+}
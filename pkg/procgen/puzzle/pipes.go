@@ -0,0 +1,373 @@
+// Package puzzle provides pipe connection puzzles.
+// This file implements the tile grid of pipe connection puzzles, where the
+// player rotates pipe tiles to connect a source to a sink, and its generator,
+// which lays a path through the grid, orients it with the constraint solver
+// and then scrambles every tile.
+package puzzle
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+// PipeDirection is a bitmask of the sides of a tile a pipe opens onto.
+type PipeDirection uint8
+
+const (
+	PipeNorth PipeDirection = 1 << iota
+	PipeEast
+	PipeSouth
+	PipeWest
+)
+
+// PipeShape is the unrotated shape of a pipe tile.
+type PipeShape int
+
+const (
+	// PipeStraight connects north and south
+	PipeStraight PipeShape = iota
+	// PipeElbow connects north and east
+	PipeElbow
+	// PipeTee connects north, east and south
+	PipeTee
+)
+
+// String returns the shape name.
+func (s PipeShape) String() string {
+	switch s {
+	case PipeStraight:
+		return "straight"
+	case PipeElbow:
+		return "elbow"
+	case PipeTee:
+		return "tee"
+	default:
+		return "unknown"
+	}
+}
+
+// openings returns the sides the unrotated shape opens onto.
+func (s PipeShape) openings() PipeDirection {
+	switch s {
+	case PipeStraight:
+		return PipeNorth | PipeSouth
+	case PipeElbow:
+		return PipeNorth | PipeEast
+	case PipeTee:
+		return PipeNorth | PipeEast | PipeSouth
+	default:
+		return 0
+	}
+}
+
+// rotateOpenings turns openings clockwise by quarter turns.
+func rotateOpenings(d PipeDirection, quarterTurns int) PipeDirection {
+	r := quarterTurns & 3
+	return (d<<r | d>>(4-r)) & 0xF
+}
+
+// PipeTile is one rotatable tile of a pipe grid.
+type PipeTile struct {
+	Shape          PipeShape
+	Rotation       int // Current clockwise quarter turns (0-3)
+	SolvedRotation int // A rotation that completes the intended path
+}
+
+// Openings returns the sides the tile opens onto at its current rotation.
+func (t *PipeTile) Openings() PipeDirection {
+	return rotateOpenings(t.Shape.openings(), t.Rotation)
+}
+
+// PipeGrid is the tile grid of a pipe connection puzzle. Water enters the
+// source tile from the west edge and must leave the sink tile through the
+// east edge.
+type PipeGrid struct {
+	Width, Height int
+	Tiles         []PipeTile // Row-major, Width*Height tiles
+	Source        [2]int     // Tile on the west edge fed by the source
+	Sink          [2]int     // Tile on the east edge draining to the sink
+	Path          [][2]int   // Intended source-to-sink tiles, in flow order
+}
+
+// Tile returns the tile at (x, y), or nil if out of bounds.
+func (g *PipeGrid) Tile(x, y int) *PipeTile {
+	if x < 0 || x >= g.Width || y < 0 || y >= g.Height {
+		return nil
+	}
+	return &g.Tiles[y*g.Width+x]
+}
+
+// Rotate turns the tile at (x, y) a quarter turn clockwise. Returns false if
+// (x, y) is out of bounds.
+func (g *PipeGrid) Rotate(x, y int) bool {
+	tile := g.Tile(x, y)
+	if tile == nil {
+		return false
+	}
+	tile.Rotation = (tile.Rotation + 1) % 4
+	return true
+}
+
+// ConnectedTiles returns the tiles water reaches from the source at the
+// current rotations, in breadth-first order.
+func (g *PipeGrid) ConnectedTiles() [][2]int {
+	source := g.Tile(g.Source[0], g.Source[1])
+	if source == nil || source.Openings()&PipeWest == 0 {
+		return nil
+	}
+
+	steps := []struct {
+		dir      PipeDirection
+		opposite PipeDirection
+		dx, dy   int
+	}{
+		{PipeNorth, PipeSouth, 0, -1},
+		{PipeEast, PipeWest, 1, 0},
+		{PipeSouth, PipeNorth, 0, 1},
+		{PipeWest, PipeEast, -1, 0},
+	}
+
+	visited := map[[2]int]bool{g.Source: true}
+	connected := [][2]int{g.Source}
+	for i := 0; i < len(connected); i++ {
+		cell := connected[i]
+		openings := g.Tile(cell[0], cell[1]).Openings()
+		for _, step := range steps {
+			next := [2]int{cell[0] + step.dx, cell[1] + step.dy}
+			neighbor := g.Tile(next[0], next[1])
+			if openings&step.dir == 0 || neighbor == nil || visited[next] ||
+				neighbor.Openings()&step.opposite == 0 {
+				continue
+			}
+			visited[next] = true
+			connected = append(connected, next)
+		}
+	}
+	return connected
+}
+
+// IsSolved reports whether water flows from the source to the sink.
+func (g *PipeGrid) IsSolved() bool {
+	sink := g.Tile(g.Sink[0], g.Sink[1])
+	if sink == nil || sink.Openings()&PipeEast == 0 {
+		return false
+	}
+	for _, cell := range g.ConnectedTiles() {
+		if cell == g.Sink {
+			return true
+		}
+	}
+	return false
+}
+
+// solved returns a copy of the grid with every tile at its solved rotation.
+func (g *PipeGrid) solved() *PipeGrid {
+	c := *g
+	c.Tiles = make([]PipeTile, len(g.Tiles))
+	for i, tile := range g.Tiles {
+		tile.Rotation = tile.SolvedRotation
+		c.Tiles[i] = tile
+	}
+	return &c
+}
+
+// pipeTileID returns the element ID of the tile at (x, y).
+func pipeTileID(x, y int) string {
+	return fmt.Sprintf("pipe_%d_%d", x, y)
+}
+
+// generatePipeConnectionPuzzle creates a pipe rotation puzzle.
+func (g *Generator) generatePipeConnectionPuzzle(rng *rand.Rand, template PuzzleTemplate, difficulty int, params procgen.GenerationParams) (*Puzzle, error) {
+	// Grid size based on difficulty, 3x3 up to 6x5
+	width := 3 + difficulty/3
+	height := 3 + difficulty/4
+
+	puzzleID := fmt.Sprintf("pipe_%d", rng.Int63())
+
+	grid := &PipeGrid{
+		Width:  width,
+		Height: height,
+		Tiles:  make([]PipeTile, width*height),
+		Source: [2]int{0, rng.Intn(height)},
+		Sink:   [2]int{width - 1, rng.Intn(height)},
+	}
+	grid.Path = layPipePath(rng, grid)
+
+	// Decoy tiles off the path
+	onPath := make(map[[2]int]bool, len(grid.Path))
+	for _, cell := range grid.Path {
+		onPath[cell] = true
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if !onPath[[2]int{x, y}] {
+				rotation := rng.Intn(4)
+				*grid.Tile(x, y) = PipeTile{Shape: PipeShape(rng.Intn(3)), Rotation: rotation, SolvedRotation: rotation}
+			}
+		}
+	}
+
+	if err := orientPipePath(grid); err != nil {
+		return nil, err
+	}
+	if !grid.solved().IsSolved() {
+		return nil, fmt.Errorf("pipe path does not connect source to sink")
+	}
+
+	// Scramble the path, making sure the puzzle doesn't start solved
+	for _, cell := range grid.Path {
+		grid.Tile(cell[0], cell[1]).Rotation = rng.Intn(4)
+	}
+	for grid.IsSolved() {
+		grid.Rotate(grid.Path[0][0], grid.Path[0][1])
+	}
+
+	elements := make([]PuzzleElement, 0, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			elements = append(elements, PuzzleElement{
+				ID:           pipeTileID(x, y),
+				ElementType:  "pipe_tile",
+				Position:     [2]int{x, y},
+				State:        grid.Tile(x, y),
+				Interactable: true,
+			})
+		}
+	}
+
+	// Solution: the path tiles, from source to sink
+	solution := make([]string, len(grid.Path))
+	for i, cell := range grid.Path {
+		solution[i] = pipeTileID(cell[0], cell[1])
+	}
+
+	hint := fmt.Sprintf("Rotate the pipes to connect the source to the drain (%d tiles)", len(solution))
+
+	puzzle := &Puzzle{
+		ID:           puzzleID,
+		Type:         PuzzleTypePipeConnection,
+		Difficulty:   difficulty,
+		Solution:     solution,
+		ElementCount: len(elements),
+		Elements:     elements,
+		TimeLimit:    0,
+		MaxAttempts:  0,
+		HintText:     hint,
+		Description:  "Broken pipework must be turned to restore the flow",
+		RewardType:   "door",
+		Pipes:        grid,
+	}
+
+	return puzzle, nil
+}
+
+// layPipePath finds a random path from the source to the sink with a
+// depth-first search over shuffled neighbors.
+func layPipePath(rng *rand.Rand, grid *PipeGrid) [][2]int {
+	visited := map[[2]int]bool{grid.Source: true}
+	path := [][2]int{grid.Source}
+	offsets := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+	for len(path) > 0 {
+		cell := path[len(path)-1]
+		if cell == grid.Sink {
+			return path
+		}
+
+		order := rng.Perm(len(offsets))
+		advanced := false
+		for _, i := range order {
+			next := [2]int{cell[0] + offsets[i][0], cell[1] + offsets[i][1]}
+			if grid.Tile(next[0], next[1]) == nil || visited[next] {
+				continue
+			}
+			visited[next] = true
+			path = append(path, next)
+			advanced = true
+			break
+		}
+		if !advanced {
+			path = path[:len(path)-1]
+		}
+	}
+	return path
+}
+
+// orientPipePath gives every path tile a shape and solves for the rotations
+// that join each tile to its neighbors on the path, storing them as the
+// tiles' solved rotations.
+func orientPipePath(grid *PipeGrid) error {
+	csp := NewCSP(0)
+	rotations := []interface{}{0, 1, 2, 3}
+
+	for i, cell := range grid.Path {
+		// Sides the tile must open onto: where the flow comes from and goes
+		in := PipeWest
+		if i > 0 {
+			in = directionTo(cell, grid.Path[i-1])
+		}
+		out := PipeEast
+		if i < len(grid.Path)-1 {
+			out = directionTo(cell, grid.Path[i+1])
+		}
+
+		shape := PipeElbow
+		if in|out == PipeNorth|PipeSouth || in|out == PipeEast|PipeWest {
+			shape = PipeStraight
+		}
+		grid.Tile(cell[0], cell[1]).Shape = shape
+
+		name := pipeTileID(cell[0], cell[1])
+		required := in | out
+		if err := csp.AddVariable(name, rotations); err != nil {
+			return err
+		}
+		if err := csp.AddConstraint([]string{name}, func(a map[string]interface{}) bool {
+			return rotateOpenings(shape.openings(), a[name].(int))&required == required
+		}); err != nil {
+			return err
+		}
+
+		// Adjacent path tiles must open onto each other
+		if i > 0 {
+			prev := grid.Path[i-1]
+			prevName := pipeTileID(prev[0], prev[1])
+			prevShape := grid.Tile(prev[0], prev[1]).Shape
+			toPrev := in
+			toCell := directionTo(prev, cell)
+			if err := csp.AddConstraint([]string{prevName, name}, func(a map[string]interface{}) bool {
+				return rotateOpenings(prevShape.openings(), a[prevName].(int))&toCell != 0 &&
+					rotateOpenings(shape.openings(), a[name].(int))&toPrev != 0
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	solution, err := csp.Solve()
+	if err != nil {
+		return fmt.Errorf("failed to orient pipe path: %w", err)
+	}
+	for _, cell := range grid.Path {
+		tile := grid.Tile(cell[0], cell[1])
+		tile.SolvedRotation = solution[pipeTileID(cell[0], cell[1])].(int)
+		tile.Rotation = tile.SolvedRotation
+	}
+	return nil
+}
+
+// directionTo returns the side of from that faces the adjacent cell to.
+func directionTo(from, to [2]int) PipeDirection {
+	switch {
+	case to[1] < from[1]:
+		return PipeNorth
+	case to[0] > from[0]:
+		return PipeEast
+	case to[1] > from[1]:
+		return PipeSouth
+	default:
+		return PipeWest
+	}
+}
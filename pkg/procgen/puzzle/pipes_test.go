@@ -0,0 +1,96 @@
+package puzzle
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+func TestPipeConnectionPuzzle(t *testing.T) {
+	gen := NewGenerator()
+	template := gen.templates[PuzzleTypePipeConnection]
+	params := procgen.GenerationParams{GenreID: "fantasy"}
+
+	for difficulty := 1; difficulty <= 10; difficulty++ {
+		for seed := int64(0); seed < 20; seed++ {
+			puzzle, err := gen.generatePipeConnectionPuzzle(rand.New(rand.NewSource(seed)), template, difficulty, params)
+			if err != nil {
+				t.Fatalf("difficulty %d seed %d: %v", difficulty, seed, err)
+			}
+			if err := gen.Validate(puzzle); err != nil {
+				t.Fatalf("difficulty %d seed %d: Validate() error = %v", difficulty, seed, err)
+			}
+
+			grid := puzzle.Pipes
+			if len(grid.Tiles) != puzzle.ElementCount ||
+				puzzle.ElementCount < template.MinElements || puzzle.ElementCount > template.MaxElements {
+				t.Errorf("grid %dx%d has %d elements", grid.Width, grid.Height, puzzle.ElementCount)
+			}
+			if grid.Source[0] != 0 || grid.Sink[0] != grid.Width-1 {
+				t.Errorf("source %v and sink %v must be on the west and east edges", grid.Source, grid.Sink)
+			}
+			if grid.Path[0] != grid.Source || grid.Path[len(grid.Path)-1] != grid.Sink {
+				t.Errorf("path %v does not run from source to sink", grid.Path)
+			}
+			if grid.IsSolved() {
+				t.Error("puzzle should start scrambled")
+			}
+
+			// Turning each path tile to its solved rotation solves the puzzle
+			for _, cell := range grid.Path {
+				tile := grid.Tile(cell[0], cell[1])
+				for tile.Rotation != tile.SolvedRotation {
+					grid.Rotate(cell[0], cell[1])
+				}
+			}
+			if !grid.IsSolved() {
+				t.Errorf("difficulty %d seed %d: solved rotations do not connect", difficulty, seed)
+			}
+		}
+	}
+}
+
+func TestPipeConnectionDeterminism(t *testing.T) {
+	gen := NewGenerator()
+	template := gen.templates[PuzzleTypePipeConnection]
+	params := procgen.GenerationParams{GenreID: "fantasy"}
+
+	a, _ := gen.generatePipeConnectionPuzzle(rand.New(rand.NewSource(99)), template, 7, params)
+	b, _ := gen.generatePipeConnectionPuzzle(rand.New(rand.NewSource(99)), template, 7, params)
+	for i := range a.Pipes.Tiles {
+		if a.Pipes.Tiles[i] != b.Pipes.Tiles[i] {
+			t.Fatalf("tile %d differs: %+v vs %+v", i, a.Pipes.Tiles[i], b.Pipes.Tiles[i])
+		}
+	}
+}
+
+func TestPipeGrid(t *testing.T) {
+	// Two straight pipes turned east-west connect source to sink
+	grid := &PipeGrid{
+		Width:  2,
+		Height: 1,
+		Tiles:  []PipeTile{{Shape: PipeStraight, Rotation: 1}, {Shape: PipeStraight}},
+		Source: [2]int{0, 0},
+		Sink:   [2]int{1, 0},
+	}
+	if grid.IsSolved() {
+		t.Fatal("north-south sink tile should block the flow")
+	}
+	if got := len(grid.ConnectedTiles()); got != 1 {
+		t.Errorf("ConnectedTiles() has %d tiles, want 1", got)
+	}
+
+	grid.Rotate(1, 0)
+	if !grid.IsSolved() {
+		t.Error("grid should be solved after rotating the sink tile")
+	}
+	if grid.Rotate(5, 5) || grid.Tile(-1, 0) != nil {
+		t.Error("out of bounds tiles should be rejected")
+	}
+
+	elbow := PipeTile{Shape: PipeElbow, Rotation: 2}
+	if got := elbow.Openings(); got != PipeSouth|PipeWest {
+		t.Errorf("elbow rotated twice opens %04b, want south and west", got)
+	}
+}
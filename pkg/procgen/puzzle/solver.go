@@ -41,6 +41,9 @@ type CSP struct {
 
 	// Random number generator for variable ordering
 	rng *rand.Rand
+
+	// nodes counts the values tried by the last Solve
+	nodes int
 }
 
 // NewCSP creates a new constraint satisfaction problem.
@@ -86,6 +89,7 @@ func (csp *CSP) AddConstraint(variables []string, isSatisfied func(map[string]in
 
 // Solve attempts to find a solution using backtracking search.
 func (csp *CSP) Solve() (map[string]interface{}, error) {
+	csp.nodes = 0
 	assignments := make(map[string]interface{})
 	solution := csp.backtrack(assignments)
 
@@ -113,6 +117,7 @@ func (csp *CSP) backtrack(assignments map[string]interface{}) map[string]interfa
 	for _, value := range variable.Domain {
 		// Assign value
 		assignments[variable.Name] = value
+		csp.nodes++
 
 		// Check if consistent
 		if csp.isConsistent(variable.Name, assignments) {
@@ -132,6 +137,7 @@ func (csp *CSP) backtrack(assignments map[string]interface{}) map[string]interfa
 
 // selectUnassignedVariable selects the next variable to assign.
 // Uses Minimum Remaining Values (MRV) heuristic: choose variable with smallest domain.
+// Ties go to the variable with the lowest name so the search order is deterministic.
 func (csp *CSP) selectUnassignedVariable(assignments map[string]interface{}) *Variable {
 	var best *Variable
 	minRemaining := -1
@@ -144,7 +150,7 @@ func (csp *CSP) selectUnassignedVariable(assignments map[string]interface{}) *Va
 
 		remaining := len(variable.Domain)
 
-		if best == nil || remaining < minRemaining {
+		if best == nil || remaining < minRemaining || (remaining == minRemaining && name < best.Name) {
 			best = variable
 			minRemaining = remaining
 		}
@@ -213,6 +219,12 @@ func (csp *CSP) GetDomainSize(varName string) int {
 	return 0
 }
 
+// NodesExplored returns how many value assignments the last Solve tried,
+// including those that were backtracked.
+func (csp *CSP) NodesExplored() int {
+	return csp.nodes
+}
+
 // GetConstraintCount returns the number of constraints.
 func (csp *CSP) GetConstraintCount() int {
 	return len(csp.Constraints)
@@ -0,0 +1,68 @@
+// Package station provides station charge mechanics.
+// This file implements the optional fuel/energy charges some station types
+// consume per craft, and the genre tables that decide which stations use them.
+package station
+
+import "math/rand"
+
+// chargeSpec describes a station type that runs on limited charges.
+type chargeSpec struct {
+	MinCharges int
+	MaxCharges int
+	FuelItem   string // Item consumed to refill the station
+}
+
+// chargedStations lists the station types that consume charges, per genre.
+// Station types not listed here have unlimited charges.
+var chargedStations = map[string]map[StationType]chargeSpec{
+	"scifi": {
+		StationForge: {MinCharges: 6, MaxCharges: 10, FuelItem: "Energy Cell"},
+	},
+	"cyberpunk": {
+		StationForge:     {MinCharges: 5, MaxCharges: 8, FuelItem: "Power Cell"},
+		StationWorkbench: {MinCharges: 6, MaxCharges: 10, FuelItem: "Power Cell"},
+	},
+	"postapoc": {
+		StationAlchemyTable: {MinCharges: 4, MaxCharges: 8, FuelItem: "Clean Water"},
+		StationForge:        {MinCharges: 3, MaxCharges: 6, FuelItem: "Welding Fuel"},
+		StationWorkbench:    {MinCharges: 5, MaxCharges: 8, FuelItem: "Battery Pack"},
+	},
+}
+
+// rollCharges assigns charges to a station if its genre and type use them.
+func rollCharges(rng *rand.Rand, genreID string, stationType StationType) (maxCharges int, fuelItem string) {
+	spec, ok := chargedStations[genreID][stationType]
+	if !ok {
+		return 0, ""
+	}
+	return spec.MinCharges + rng.Intn(spec.MaxCharges-spec.MinCharges+1), spec.FuelItem
+}
+
+// UsesCharges reports whether the station has limited charges.
+func (s *StationData) UsesCharges() bool {
+	return s.MaxCharges > 0
+}
+
+// HasCharges reports whether the station can be used for another craft.
+// Stations without limited charges always can.
+func (s *StationData) HasCharges() bool {
+	return !s.UsesCharges() || s.Charges > 0
+}
+
+// ConsumeCharge uses one charge for a craft. It returns false, leaving the
+// station unchanged, if the station is depleted.
+func (s *StationData) ConsumeCharge() bool {
+	if !s.UsesCharges() {
+		return true
+	}
+	if s.Charges <= 0 {
+		return false
+	}
+	s.Charges--
+	return true
+}
+
+// Refill restores the station to its maximum charges.
+func (s *StationData) Refill() {
+	s.Charges = s.MaxCharges
+}
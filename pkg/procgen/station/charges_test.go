@@ -0,0 +1,105 @@
+package station
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/item"
+)
+
+func TestGenerateCharges(t *testing.T) {
+	gen := NewStationGenerator()
+
+	for _, genre := range []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc"} {
+		params := procgen.GenerationParams{Difficulty: 0.5, Depth: 5, GenreID: genre}
+		result, err := gen.Generate(42, params)
+		if err != nil {
+			t.Fatalf("Generate(%s) error = %v", genre, err)
+		}
+		again, _ := gen.Generate(42, params)
+
+		for i, s := range result.([]*StationData) {
+			spec, charged := chargedStations[genre][s.StationType]
+			if s.UsesCharges() != charged {
+				t.Errorf("%s %v UsesCharges() = %v, want %v", genre, s.StationType, s.UsesCharges(), charged)
+			}
+			if charged && (s.MaxCharges < spec.MinCharges || s.MaxCharges > spec.MaxCharges ||
+				s.Charges != s.MaxCharges || s.FuelItem != spec.FuelItem) {
+				t.Errorf("%s %v charges = %d/%d fuel %q", genre, s.StationType, s.Charges, s.MaxCharges, s.FuelItem)
+			}
+			if other := again.([]*StationData)[i]; other.MaxCharges != s.MaxCharges {
+				t.Errorf("%s %v charges not deterministic", genre, s.StationType)
+			}
+		}
+	}
+}
+
+func TestConsumeCharge(t *testing.T) {
+	unlimited := &StationData{}
+	for i := 0; i < 10; i++ {
+		if !unlimited.ConsumeCharge() {
+			t.Fatal("stations without charges should never deplete")
+		}
+	}
+
+	welder := &StationData{MaxCharges: 2, Charges: 2, FuelItem: "Welding Fuel"}
+	if !welder.ConsumeCharge() || !welder.ConsumeCharge() {
+		t.Fatal("expected two charges")
+	}
+	if welder.ConsumeCharge() || welder.HasCharges() || welder.Charges != 0 {
+		t.Errorf("depleted station: charges = %d, HasCharges() = %v", welder.Charges, welder.HasCharges())
+	}
+
+	welder.Refill()
+	if welder.Charges != 2 || !welder.HasCharges() {
+		t.Errorf("Charges = %d after Refill(), want 2", welder.Charges)
+	}
+}
+
+func TestValidateCharges(t *testing.T) {
+	gen := NewStationGenerator()
+	params := procgen.GenerationParams{Difficulty: 0.5, Depth: 1, GenreID: "postapoc"}
+
+	tests := map[string]func(s *StationData){
+		"charges above max": func(s *StationData) { s.Charges = s.MaxCharges + 1 },
+		"negative charges":  func(s *StationData) { s.Charges = -1 },
+		"no fuel item":      func(s *StationData) { s.FuelItem = "" },
+	}
+	for name, corrupt := range tests {
+		result, _ := gen.Generate(7, params)
+		stations := result.([]*StationData)
+		corrupt(stations[StationForge])
+		if err := gen.Validate(stations); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestFuelItemsAreGeneratedMaterials(t *testing.T) {
+	materials := map[string]func() []item.ItemTemplate{
+		"fantasy":   item.GetFantasyMaterialTemplates,
+		"scifi":     item.GetSciFiMaterialTemplates,
+		"horror":    item.GetHorrorMaterialTemplates,
+		"cyberpunk": item.GetCyberpunkMaterialTemplates,
+		"postapoc":  item.GetPostApocMaterialTemplates,
+	}
+
+	for genreID, stations := range chargedStations {
+		templates, ok := materials[genreID]
+		if !ok {
+			t.Errorf("%s: no material templates", genreID)
+			continue
+		}
+		names := make(map[string]bool)
+		for _, template := range templates() {
+			for _, name := range template.NameSuffixes {
+				names[name] = true
+			}
+		}
+		for stationType, spec := range stations {
+			if !names[spec.FuelItem] {
+				t.Errorf("%s %v: fuel %q is never generated", genreID, stationType, spec.FuelItem)
+			}
+		}
+	}
+}
@@ -13,6 +13,28 @@
 //   - Forge: For enchanting equipment (+5% success, 25% faster)
 //   - Workbench: For crafting magic items (+5% success, 25% faster)
 //
+// # Tiers
+//
+// Each station has a Tier from 1 to 3. The crafting system scales the station
+// bonuses by tier: tier 2 gives 1.5x and tier 3 gives 2x (+10% success, 50%
+// faster). Depth 1 stations are always tier 1; deeper levels roll tier 2 and
+// tier 3 stations more often. Tier 2 and 3 stations carry a genre-specific
+// title in their name, e.g. "Masterwork Runic Forge" or "Military-Grade Cyber
+// Modification Rig" (see TierTitle).
+//
+// # Charges
+//
+// Some genre station types run on fuel: sci-fi forges, cyberpunk forges and
+// workbenches, and every post-apocalyptic station. These get MaxCharges (0
+// means unlimited) and a FuelItem. Each craft started at the station uses a
+// charge; once depleted the crafting system refuses crafts there until a
+// player refills it with the fuel item, e.g. "Welding Fuel" for a scavenged
+// welding forge:
+//
+//	if !s.ConsumeCharge() {
+//	    fmt.Printf("%s needs %s\n", s.Name, s.FuelItem)
+//	}
+//
 // # Generation
 //
 // Station generation is deterministic and genre-aware:
@@ -56,12 +78,13 @@
 //	    tileSize,
 //	    seed,
 //	    genreID,
+//	    depth,
 //	)
 //
 // # Determinism
 //
 // All generation is deterministic based on seed:
-//   - Same seed + parameters always produces same station names
+//   - Same seed + parameters always produces same station names and tiers
 //   - Station types are always generated in same order (alchemy, forge, workbench)
 //   - Name variations come from seed-based RNG, not system randomness
 //
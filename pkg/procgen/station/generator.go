@@ -11,9 +11,11 @@ package station
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 
 	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/genre"
 	"github.com/sirupsen/logrus"
 )
 
@@ -43,14 +45,27 @@ func (s StationType) String() string {
 	}
 }
 
+// Station tiers. Higher tiers give larger crafting bonuses.
+const (
+	MinTier = 1
+	MaxTier = 3
+)
+
 // StationData represents a generated crafting station.
 type StationData struct {
 	StationType StationType
 	Name        string
 	GenreID     string
 	Seed        int64
+	Tier        int     // MinTier to MaxTier, scales the station's crafting bonuses
 	SpawnX      float64 // Set by spawn system, not generator
 	SpawnY      float64 // Set by spawn system, not generator
+
+	// MaxCharges limits how many crafts the station allows before it must be
+	// refilled with FuelItem (0 = unlimited). Charges is the number left.
+	MaxCharges int
+	Charges    int
+	FuelItem   string
 }
 
 // StationNameTemplate defines naming patterns for stations.
@@ -123,21 +138,36 @@ func (g *StationGenerator) Generate(seed int64, params procgen.GenerationParams)
 		templates = g.nameTemplates["fantasy"]
 	}
 
+	names := genre.NewNameGeneratorForID(genreID)
+
 	// Generate exactly 3 stations (one per type)
 	stations := make([]*StationData, 3)
 	stationTypes := []StationType{StationAlchemyTable, StationForge, StationWorkbench}
 
 	for i, stationType := range stationTypes {
 		stationSeed := seed + int64(i*100) // Derive unique seed per station
-		name := g.generateStationName(rng, templates[stationType])
+		stationRNG := rand.New(rand.NewSource(stationSeed))
+		tier := rollTier(stationRNG, params.Depth)
+		maxCharges, fuelItem := rollCharges(stationRNG, genreID, stationType)
+		name := g.generateTieredStationName(rng, templates[stationType], genreID, tier)
+		if tier == MaxTier {
+			// Top-tier stations carry a genre epithet, e.g. "of Making"
+			if suffix := names.GenerateSuffix(genre.NameCategoryStation, stationSeed); suffix != "" {
+				name += " " + suffix
+			}
+		}
 
 		stations[i] = &StationData{
 			StationType: stationType,
 			Name:        name,
 			GenreID:     genreID,
 			Seed:        stationSeed,
+			Tier:        tier,
 			SpawnX:      0, // Set by spawn system
 			SpawnY:      0, // Set by spawn system
+			MaxCharges:  maxCharges,
+			Charges:     maxCharges,
+			FuelItem:    fuelItem,
 		}
 
 		if g.logger != nil && g.logger.Logger.GetLevel() >= logrus.DebugLevel {
@@ -145,6 +175,8 @@ func (g *StationGenerator) Generate(seed int64, params procgen.GenerationParams)
 				"stationType": stationType.String(),
 				"name":        name,
 				"genreID":     genreID,
+				"tier":        tier,
+				"charges":     maxCharges,
 			}).Debug("generated station")
 		}
 	}
@@ -178,6 +210,18 @@ func (g *StationGenerator) Validate(result interface{}) error {
 			return fmt.Errorf("invalid station type: %d", station.StationType)
 		}
 
+		if station.Tier != 0 && (station.Tier < MinTier || station.Tier > MaxTier) {
+			return fmt.Errorf("invalid station tier: %d", station.Tier)
+		}
+
+		if station.MaxCharges < 0 || station.Charges < 0 || station.Charges > station.MaxCharges {
+			return fmt.Errorf("invalid station charges: %d/%d", station.Charges, station.MaxCharges)
+		}
+
+		if station.UsesCharges() && station.FuelItem == "" {
+			return fmt.Errorf("station %q uses charges but has no fuel item", station.Name)
+		}
+
 		typeCount[station.StationType]++
 	}
 
@@ -216,6 +260,63 @@ func (g *StationGenerator) generateStationName(rng *rand.Rand, template StationN
 	return name
 }
 
+// rollTier picks a station tier for a dungeon depth. Depth 1 stations are
+// always tier 1; each deeper level makes tier 2 (up to 80%) and, from depth
+// 4, tier 3 (up to 50%) more likely.
+func rollTier(rng *rand.Rand, depth int) int {
+	tier2Chance := math.Min(0.1*float64(depth-1), 0.8)
+	tier3Chance := math.Min(0.05*float64(depth-3), 0.5)
+
+	roll := rng.Float64()
+	switch {
+	case roll < tier3Chance:
+		return 3
+	case roll < tier3Chance+tier2Chance:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// tierTitles are the genre-specific titles of tier 2 and 3 stations.
+var tierTitles = map[string][MaxTier + 1]string{
+	"fantasy":   {2: "Fine", 3: "Masterwork"},
+	"scifi":     {2: "Advanced", 3: "Prototype"},
+	"horror":    {2: "Blood-Soaked", 3: "Unholy"},
+	"cyberpunk": {2: "Upgraded", 3: "Military-Grade"},
+	"postapoc":  {2: "Reinforced", 3: "Pre-War"},
+}
+
+// TierTitle returns the genre-specific title of a station tier, e.g.
+// "Masterwork" for a tier 3 fantasy station. Tier 1 stations have no title.
+// Unknown genres use the fantasy titles.
+func TierTitle(genreID string, tier int) string {
+	if tier <= MinTier || tier > MaxTier {
+		return ""
+	}
+	titles, ok := tierTitles[genreID]
+	if !ok {
+		titles = tierTitles["fantasy"]
+	}
+	return titles[tier]
+}
+
+// generateTieredStationName creates a station name for a tier. Tier 2 and 3
+// stations lead with their tier title instead of a prefix, e.g.
+// "Masterwork Runic Forge".
+func (g *StationGenerator) generateTieredStationName(rng *rand.Rand, template StationNameTemplate, genreID string, tier int) string {
+	title := TierTitle(genreID, tier)
+	if title == "" {
+		return g.generateStationName(rng, template)
+	}
+
+	name := title + " "
+	if len(template.Adjective) > 0 {
+		name += template.Adjective[rng.Intn(len(template.Adjective))] + " "
+	}
+	return name + template.Noun[rng.Intn(len(template.Noun))]
+}
+
 // registerFantasyTemplates registers fantasy-themed station name templates.
 func (g *StationGenerator) registerFantasyTemplates() {
 	g.nameTemplates["fantasy"] = map[StationType]StationNameTemplate{
@@ -2,9 +2,11 @@ package station
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/genre"
 )
 
 // TestNewStationGenerator tests station generator creation.
@@ -425,6 +427,109 @@ func TestAllGenresHaveTemplates(t *testing.T) {
 	}
 }
 
+// TestStationTiers tests that tiers are valid, deterministic and rise with depth.
+func TestStationTiers(t *testing.T) {
+	gen := NewStationGenerator()
+
+	tierCounts := func(depth int) [MaxTier + 1]int {
+		var counts [MaxTier + 1]int
+		for seed := int64(0); seed < 200; seed++ {
+			params := procgen.GenerationParams{Difficulty: 0.5, Depth: depth, GenreID: "fantasy"}
+			result, err := gen.Generate(seed, params)
+			if err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+			again, _ := gen.Generate(seed, params)
+			for i, s := range result.([]*StationData) {
+				if s.Tier < MinTier || s.Tier > MaxTier {
+					t.Fatalf("tier %d out of range", s.Tier)
+				}
+				if other := again.([]*StationData)[i]; other.Tier != s.Tier || other.Name != s.Name {
+					t.Fatalf("seed %d not deterministic: %s (%d) vs %s (%d)", seed, s.Name, s.Tier, other.Name, other.Tier)
+				}
+				counts[s.Tier]++
+			}
+		}
+		return counts
+	}
+
+	shallow := tierCounts(1)
+	if shallow[2] != 0 || shallow[3] != 0 {
+		t.Errorf("depth 1 produced higher tiers: %v", shallow)
+	}
+
+	deep := tierCounts(10)
+	if deep[3] == 0 || deep[3]+deep[2] <= deep[1] {
+		t.Errorf("depth 10 tiers not skewed upward: %v", deep)
+	}
+}
+
+// TestTierNames tests genre-specific tier titles in station names.
+func TestTierNames(t *testing.T) {
+	gen := NewStationGenerator()
+	template := gen.nameTemplates["fantasy"][StationForge]
+
+	for seed := int64(0); seed < 20; seed++ {
+		name := gen.generateTieredStationName(newRNG(seed), template, "fantasy", 3)
+		if !strings.HasPrefix(name, "Masterwork ") || !containsAny(name, template.Noun) {
+			t.Errorf("tier 3 fantasy forge name = %q", name)
+		}
+	}
+
+	if TierTitle("fantasy", 1) != "" {
+		t.Error("tier 1 stations should have no title")
+	}
+	if TierTitle("scifi", 2) == TierTitle("fantasy", 2) {
+		t.Error("tier titles should differ by genre")
+	}
+	if TierTitle("unknown", 3) != "Masterwork" {
+		t.Error("unknown genre should use fantasy tier titles")
+	}
+}
+
+// TestTopTierEpithets tests that tier 3 stations end in a genre epithet from
+// the shared name pools and lower tiers do not.
+func TestTopTierEpithets(t *testing.T) {
+	gen := NewStationGenerator()
+	pool, _ := genre.NewNameGeneratorForID("scifi").Pool(genre.NameCategoryStation)
+
+	topTier := 0
+	for seed := int64(0); seed < 50; seed++ {
+		result, err := gen.Generate(seed, procgen.GenerationParams{Difficulty: 0.5, Depth: 12, GenreID: "scifi"})
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		for _, s := range result.([]*StationData) {
+			hasEpithet := false
+			for _, suffix := range pool.Suffixes {
+				if strings.HasSuffix(s.Name, " "+suffix) {
+					hasEpithet = true
+					break
+				}
+			}
+			if s.Tier == MaxTier {
+				topTier++
+			}
+			if hasEpithet != (s.Tier == MaxTier) {
+				t.Errorf("tier %d station %q: epithet = %v", s.Tier, s.Name, hasEpithet)
+			}
+		}
+	}
+	if topTier == 0 {
+		t.Fatal("expected tier 3 stations at depth 12")
+	}
+}
+
+// containsAny reports whether s contains any of words.
+func containsAny(s string, words []string) bool {
+	for _, w := range words {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper function to create a seeded RNG.
 func newRNG(seed int64) *rand.Rand {
 	return rand.New(rand.NewSource(seed))
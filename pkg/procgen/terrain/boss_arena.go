@@ -0,0 +1,215 @@
+// Package terrain provides boss arena generation.
+// This file lays out boss rooms as arenas with features boss mechanics can
+// use: pillars the player can take cover behind, hazard zones a boss phase
+// can flood with lava, and spawn points for adds. Arena layouts are
+// generated deterministically from the terrain seed.
+package terrain
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Minimum boss room side length for 2x2 pillars
+const bossArenaLargePillarSize = 12
+
+// ArenaHazardZone is a set of arena tiles a boss phase can turn hazardous.
+// Zones start inactive (plain floor).
+type ArenaHazardZone struct {
+	// Name identifies the zone to boss mechanics, e.g. "outer_ring"
+	Name string
+
+	// Tiles covered by the zone
+	Tiles []Point
+
+	// Hazard is the tile the zone becomes while active
+	Hazard TileType
+
+	// Active is set while the zone's tiles are hazardous
+	Active bool
+
+	// restore holds the tiles replaced on activation
+	restore []TileType
+}
+
+// BossArena describes the mechanics features of one boss room.
+type BossArena struct {
+	// Room is the boss room the arena occupies
+	Room *Room
+
+	// BossSpawn is where the boss starts, the center of the arena
+	BossSpawn Point
+
+	// Pillars are the top-left tiles of the cover pillars, each PillarSize
+	// tiles square
+	Pillars    []Point
+	PillarSize int
+
+	// HazardZones are areas boss phases can activate
+	HazardZones []*ArenaHazardZone
+
+	// AddSpawnPoints are walkable tiles near the arena edges for adds
+	AddSpawnPoints []Point
+}
+
+// GenerateBossArena lays out room as a boss arena: multi-layer features
+// inside it are flattened, cover pillars are placed at the quarter points,
+// and hazard zones and add spawn points are recorded. The arena is also
+// added to terrain.BossArenas.
+func GenerateBossArena(terrain *Terrain, room *Room, seed int64) (*BossArena, error) {
+	if terrain == nil || room == nil {
+		return nil, fmt.Errorf("boss arena requires terrain and room")
+	}
+	if room.Width < 6 || room.Height < 6 {
+		return nil, fmt.Errorf("room %dx%d too small for boss arena (minimum 6x6)", room.Width, room.Height)
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	// Clear platforms, pits and lava so the arena floor is one open layer
+	for y := room.Y; y < room.Y+room.Height; y++ {
+		for x := room.X; x < room.X+room.Width; x++ {
+			switch terrain.GetTile(x, y) {
+			case TilePlatform, TileRamp, TileRampUp, TileRampDown, TilePit, TileLavaFlow, TileBridge:
+				terrain.SetTile(x, y, TileFloor)
+			}
+		}
+	}
+
+	cx, cy := room.Center()
+	arena := &BossArena{
+		Room:       room,
+		BossSpawn:  Point{X: cx, Y: cy},
+		PillarSize: 1,
+	}
+	if min(room.Width, room.Height) >= bossArenaLargePillarSize {
+		arena.PillarSize = 2
+	}
+
+	// Pillars at the quarter points, clear of the walls and the center
+	left := room.X + room.Width/4
+	right := room.X + room.Width - room.Width/4 - arena.PillarSize
+	top := room.Y + room.Height/4
+	bottom := room.Y + room.Height - room.Height/4 - arena.PillarSize
+	pillarTiles := make(map[Point]bool)
+	for _, corner := range []Point{{left, top}, {right, top}, {left, bottom}, {right, bottom}} {
+		arena.Pillars = append(arena.Pillars, corner)
+		for y := corner.Y; y < corner.Y+arena.PillarSize; y++ {
+			for x := corner.X; x < corner.X+arena.PillarSize; x++ {
+				terrain.SetTile(x, y, TileWall)
+				pillarTiles[Point{X: x, Y: y}] = true
+			}
+		}
+	}
+
+	// Outer ring: the floor along the arena walls
+	ring := &ArenaHazardZone{Name: "outer_ring", Hazard: TileLavaFlow}
+	for y := room.Y; y < room.Y+room.Height; y++ {
+		for x := room.X; x < room.X+room.Width; x++ {
+			onEdge := x == room.X || x == room.X+room.Width-1 || y == room.Y || y == room.Y+room.Height-1
+			if onEdge && terrain.IsWalkable(x, y) {
+				ring.Tiles = append(ring.Tiles, Point{X: x, Y: y})
+			}
+		}
+	}
+	arena.HazardZones = append(arena.HazardZones, ring)
+
+	// Two opposite inner quadrants, leaving the center row and column as
+	// safe lanes to the boss
+	quadrants := []string{"north_west", "south_east"}
+	if rng.Intn(2) == 1 {
+		quadrants = []string{"north_east", "south_west"}
+	}
+	for _, name := range quadrants {
+		zone := &ArenaHazardZone{Name: name, Hazard: TileLavaFlow}
+		for y := room.Y + 1; y < room.Y+room.Height-1; y++ {
+			for x := room.X + 1; x < room.X+room.Width-1; x++ {
+				if x == cx || y == cy || pillarTiles[Point{X: x, Y: y}] || !terrain.IsWalkable(x, y) {
+					continue
+				}
+				north, west := y < cy, x < cx
+				switch name {
+				case "north_west":
+					if !north || !west {
+						continue
+					}
+				case "north_east":
+					if !north || west {
+						continue
+					}
+				case "south_west":
+					if north || !west {
+						continue
+					}
+				case "south_east":
+					if north || west {
+						continue
+					}
+				}
+				zone.Tiles = append(zone.Tiles, Point{X: x, Y: y})
+			}
+		}
+		if len(zone.Tiles) > 0 {
+			arena.HazardZones = append(arena.HazardZones, zone)
+		}
+	}
+
+	// Adds enter from the middle of each wall
+	for _, p := range []Point{
+		{cx, room.Y}, {room.X + room.Width - 1, cy},
+		{cx, room.Y + room.Height - 1}, {room.X, cy},
+	} {
+		if terrain.IsWalkable(p.X, p.Y) {
+			arena.AddSpawnPoints = append(arena.AddSpawnPoints, p)
+		}
+	}
+
+	terrain.BossArenas = append(terrain.BossArenas, arena)
+	return arena, nil
+}
+
+// HazardZone returns the zone with the given name, or nil.
+func (a *BossArena) HazardZone(name string) *ArenaHazardZone {
+	for _, zone := range a.HazardZones {
+		if zone.Name == name {
+			return zone
+		}
+	}
+	return nil
+}
+
+// SetHazardActive turns the named hazard zone on (its tiles become the
+// zone's hazard) or off (the original tiles are restored).
+func (a *BossArena) SetHazardActive(terrain *Terrain, name string, active bool) error {
+	zone := a.HazardZone(name)
+	if zone == nil {
+		return fmt.Errorf("boss arena has no hazard zone %q", name)
+	}
+	if zone.Active == active {
+		return nil
+	}
+
+	if active {
+		zone.restore = make([]TileType, len(zone.Tiles))
+		for i, p := range zone.Tiles {
+			zone.restore[i] = terrain.GetTile(p.X, p.Y)
+			terrain.SetTile(p.X, p.Y, zone.Hazard)
+		}
+	} else {
+		for i, p := range zone.Tiles {
+			terrain.SetTile(p.X, p.Y, zone.restore[i])
+		}
+		zone.restore = nil
+	}
+	zone.Active = active
+	return nil
+}
+
+// BossArenaFor returns the arena generated for room, or nil.
+func (t *Terrain) BossArenaFor(room *Room) *BossArena {
+	for _, arena := range t.BossArenas {
+		if arena.Room == room {
+			return arena
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+// newArenaTerrain returns a terrain with one open boss room.
+func newArenaTerrain(width, height int) (*Terrain, *Room) {
+	terrain := NewTerrain(width+4, height+4, 42)
+	room := &Room{X: 2, Y: 2, Width: width, Height: height, Type: RoomBoss}
+	for y := room.Y; y < room.Y+room.Height; y++ {
+		for x := room.X; x < room.X+room.Width; x++ {
+			terrain.SetTile(x, y, TileFloor)
+		}
+	}
+	terrain.Rooms = append(terrain.Rooms, room)
+	return terrain, room
+}
+
+func TestGenerateBossArena_Features(t *testing.T) {
+	for _, size := range []int{6, 9, 12, 15} {
+		terrain, room := newArenaTerrain(size, size)
+		arena, err := GenerateBossArena(terrain, room, 7)
+		if err != nil {
+			t.Fatalf("size %d: GenerateBossArena failed: %v", size, err)
+		}
+
+		if len(arena.Pillars) != 4 {
+			t.Errorf("size %d: %d pillars, want 4", size, len(arena.Pillars))
+		}
+		for _, p := range arena.Pillars {
+			if terrain.GetTile(p.X, p.Y) != TileWall {
+				t.Errorf("size %d: pillar at %v is %v, want wall", size, p, terrain.GetTile(p.X, p.Y))
+			}
+			if p.X <= room.X || p.Y <= room.Y {
+				t.Errorf("size %d: pillar at %v touches the arena wall", size, p)
+			}
+		}
+
+		if len(arena.HazardZones) < 1 {
+			t.Fatalf("size %d: no hazard zones", size)
+		}
+		for _, zone := range arena.HazardZones {
+			if len(zone.Tiles) == 0 || zone.Active {
+				t.Errorf("size %d: zone %s has %d tiles, active %v", size, zone.Name, len(zone.Tiles), zone.Active)
+			}
+		}
+
+		if len(arena.AddSpawnPoints) == 0 {
+			t.Errorf("size %d: no add spawn points", size)
+		}
+		for _, p := range arena.AddSpawnPoints {
+			if !terrain.IsWalkable(p.X, p.Y) {
+				t.Errorf("size %d: add spawn %v is not walkable", size, p)
+			}
+		}
+		if !terrain.IsWalkable(arena.BossSpawn.X, arena.BossSpawn.Y) {
+			t.Errorf("size %d: boss spawn %v is not walkable", size, arena.BossSpawn)
+		}
+		if terrain.BossArenaFor(room) != arena {
+			t.Errorf("size %d: arena not registered on terrain", size)
+		}
+	}
+}
+
+func TestGenerateBossArena_LargePillars(t *testing.T) {
+	terrain, room := newArenaTerrain(14, 12)
+	arena, err := GenerateBossArena(terrain, room, 1)
+	if err != nil {
+		t.Fatalf("GenerateBossArena failed: %v", err)
+	}
+	if arena.PillarSize != 2 {
+		t.Errorf("PillarSize = %d, want 2", arena.PillarSize)
+	}
+}
+
+func TestGenerateBossArena_TooSmall(t *testing.T) {
+	terrain, room := newArenaTerrain(5, 8)
+	if _, err := GenerateBossArena(terrain, room, 1); err == nil {
+		t.Error("expected error for room smaller than 6x6")
+	}
+	if len(terrain.BossArenas) != 0 {
+		t.Error("failed arena should not be registered")
+	}
+}
+
+func TestBossArena_SetHazardActive(t *testing.T) {
+	terrain, room := newArenaTerrain(10, 10)
+	arena, err := GenerateBossArena(terrain, room, 3)
+	if err != nil {
+		t.Fatalf("GenerateBossArena failed: %v", err)
+	}
+
+	if err := arena.SetHazardActive(terrain, "outer_ring", true); err != nil {
+		t.Fatalf("SetHazardActive failed: %v", err)
+	}
+	ring := arena.HazardZone("outer_ring")
+	for _, p := range ring.Tiles {
+		if terrain.GetTile(p.X, p.Y) != TileLavaFlow {
+			t.Fatalf("active ring tile %v is %v", p, terrain.GetTile(p.X, p.Y))
+		}
+	}
+	if terrain.GetTile(arena.BossSpawn.X, arena.BossSpawn.Y) != TileFloor {
+		t.Error("boss spawn should stay safe while the ring is active")
+	}
+
+	if err := arena.SetHazardActive(terrain, "outer_ring", false); err != nil {
+		t.Fatalf("SetHazardActive failed: %v", err)
+	}
+	for _, p := range ring.Tiles {
+		if terrain.GetTile(p.X, p.Y) != TileFloor {
+			t.Fatalf("deactivated ring tile %v is %v", p, terrain.GetTile(p.X, p.Y))
+		}
+	}
+
+	if err := arena.SetHazardActive(terrain, "missing", true); err == nil {
+		t.Error("expected error for unknown hazard zone")
+	}
+}
+
+func TestGenerateBossArena_Deterministic(t *testing.T) {
+	t1, r1 := newArenaTerrain(11, 9)
+	t2, r2 := newArenaTerrain(11, 9)
+	a1, _ := GenerateBossArena(t1, r1, 99)
+	a2, _ := GenerateBossArena(t2, r2, 99)
+
+	if len(a1.HazardZones) != len(a2.HazardZones) {
+		t.Fatal("same seed produced different hazard zones")
+	}
+	for i := range a1.HazardZones {
+		if a1.HazardZones[i].Name != a2.HazardZones[i].Name {
+			t.Errorf("zone %d: %s != %s", i, a1.HazardZones[i].Name, a2.HazardZones[i].Name)
+		}
+	}
+}
+
+func TestBSPGenerator_BossArena(t *testing.T) {
+	gen := NewBSPGenerator()
+	params := procgen.GenerationParams{
+		GenreID: "fantasy",
+		Custom:  map[string]interface{}{"width": 80, "height": 50},
+	}
+
+	for seed := int64(1); seed <= 10; seed++ {
+		result, err := gen.Generate(seed, params)
+		if err != nil {
+			t.Fatalf("seed %d: Generate failed: %v", seed, err)
+		}
+		terrain := result.(*Terrain)
+
+		for _, room := range terrain.Rooms {
+			if room.Type != RoomBoss {
+				continue
+			}
+			arena := terrain.BossArenaFor(room)
+			if arena == nil {
+				t.Fatalf("seed %d: boss room has no arena", seed)
+			}
+			if len(arena.Pillars) == 0 || len(arena.HazardZones) == 0 || len(arena.AddSpawnPoints) == 0 {
+				t.Errorf("seed %d: arena missing features: %d pillars, %d hazards, %d add spawns",
+					seed, len(arena.Pillars), len(arena.HazardZones), len(arena.AddSpawnPoints))
+			}
+		}
+	}
+}
@@ -111,6 +111,9 @@ func (g *BSPGenerator) Generate(seed int64, params procgen.GenerationParams) (in
 	// Phase 11.1: Add multi-layer features (platforms, pits, lava flows)
 	g.addMultiLayerFeatures(terrain, rng)
 
+	// Lay out boss rooms as arenas for boss mechanics
+	g.addBossArenas(terrain)
+
 	if g.logger != nil {
 		g.logger.WithFields(logrus.Fields{
 			"width":     terrain.Width,
@@ -401,9 +404,32 @@ func (g *BSPGenerator) assignRoomTypes(terrain *Terrain, rng *rand.Rand) {
 		}
 	}
 
+	g.assignShrineRooms(terrain)
+
 	// All remaining rooms stay as RoomNormal (already default)
 }
 
+// assignShrineRooms turns some remaining normal rooms into shrine rooms.
+// Uses its own RNG so shrines don't shift the rest of the generated level.
+func (g *BSPGenerator) assignShrineRooms(terrain *Terrain) {
+	rng := rand.New(rand.NewSource(terrain.Seed + 5000))
+	numRooms := len(terrain.Rooms)
+
+	// Assign shrine rooms (about one per 8 rooms)
+	numShrines := max(1, numRooms/8)
+	shrinesAssigned := 0
+	for i := 1; i < numRooms-1 && shrinesAssigned < numShrines; i++ {
+		if terrain.Rooms[i].Type != RoomNormal {
+			continue
+		}
+		// 30% chance to be a shrine room
+		if rng.Float64() < 0.3 {
+			terrain.Rooms[i].Type = RoomShrine
+			shrinesAssigned++
+		}
+	}
+}
+
 // addWaterFeatures adds water features like moats to special rooms.
 // Boss rooms get moats for dramatic effect and tactical challenge.
 func (g *BSPGenerator) addWaterFeatures(terrain *Terrain, rng *rand.Rand) {
@@ -425,6 +451,19 @@ func (g *BSPGenerator) addWaterFeatures(terrain *Terrain, rng *rand.Rand) {
 	}
 }
 
+// addBossArenas generates an arena for every boss room. Each arena uses
+// its own seed so arenas don't shift the rest of the generated level.
+func (g *BSPGenerator) addBossArenas(terrain *Terrain) {
+	for i, room := range terrain.Rooms {
+		if room.Type != RoomBoss {
+			continue
+		}
+		if _, err := GenerateBossArena(terrain, room, terrain.Seed+6000+int64(i)); err != nil && g.logger != nil {
+			g.logger.WithError(err).Warn("boss room left without arena")
+		}
+	}
+}
+
 // chamferRoomCorners adds diagonal walls to room corners (Phase 11.1).
 // This creates 45° angle cuts on corners, making rooms more visually interesting.
 func (g *BSPGenerator) chamferRoomCorners(terrain *Terrain, room *Room, rng *rand.Rand) {
@@ -17,6 +17,9 @@ type CityGenerator struct {
 	streetWidth     int     // Width of streets (2-3 tiles)
 	buildingDensity float64 // Percentage of blocks with buildings (0.7 = 70%)
 	plazaDensity    float64 // Percentage of blocks that are plazas (0.2 = 20%)
+	plazaCount      int     // Exact number of plazas to carve (0 = use plazaDensity)
+	plazaSize       int     // Plaza footprint in blocks per side (1-4)
+	plazaMonuments  bool    // Place a central structure in each plaza
 	logger          *logrus.Entry
 }
 
@@ -36,6 +39,7 @@ func NewCityGeneratorWithLogger(logger *logrus.Logger) *CityGenerator {
 		streetWidth:     2,  // 2-tile wide streets
 		buildingDensity: 0.7,
 		plazaDensity:    0.2,
+		plazaSize:       1,
 		logger:          logEntry,
 	}
 }
@@ -56,6 +60,7 @@ const (
 type CityBlock struct {
 	Rect      Rect      // Block boundaries
 	BlockType BlockType // Type of block
+	col, row  int       // Position in the block grid
 }
 
 // Rect represents a rectangular area.
@@ -106,6 +111,15 @@ func (g *CityGenerator) Generate(seed int64, params procgen.GenerationParams) (i
 		if pd, ok := params.Custom["plazaDensity"].(float64); ok {
 			g.plazaDensity = pd
 		}
+		if pc, ok := params.Custom["plazaCount"].(int); ok {
+			g.plazaCount = pc
+		}
+		if ps, ok := params.Custom["plazaSize"].(int); ok {
+			g.plazaSize = ps
+		}
+		if pm, ok := params.Custom["plazaMonuments"].(bool); ok {
+			g.plazaMonuments = pm
+		}
 	}
 
 	// Validate dimensions
@@ -126,6 +140,15 @@ func (g *CityGenerator) Generate(seed int64, params procgen.GenerationParams) (i
 		return nil, fmt.Errorf("invalid street width: %d (must be 1-5)", g.streetWidth)
 	}
 
+	// Validate plaza parameters
+	if g.plazaCount < 0 || g.plazaCount > 50 {
+		return nil, fmt.Errorf("invalid plaza count: %d (must be 0-50)", g.plazaCount)
+	}
+
+	if g.plazaSize < 1 || g.plazaSize > 4 {
+		return nil, fmt.Errorf("invalid plaza size: %d (must be 1-4)", g.plazaSize)
+	}
+
 	// Create RNG with seed
 	rng := rand.New(rand.NewSource(seed))
 
@@ -146,6 +169,9 @@ func (g *CityGenerator) Generate(seed int64, params procgen.GenerationParams) (i
 	// Determine block types
 	g.assignBlockTypes(blocks, rng)
 
+	// Carve the requested number of plazas, merging blocks for larger squares
+	blocks = g.designatePlazas(blocks, rng)
+
 	// Place buildings, plazas, and parks
 	g.placeBuildings(blocks, terrain, rng)
 
@@ -215,6 +241,8 @@ func (g *CityGenerator) subdivideGrid(terrain *Terrain, rng *rand.Rand) []*CityB
 						Height: height,
 					},
 					BlockType: BlockBuilding, // Default, will be assigned later
+					col:       bx,
+					row:       by,
 				})
 			}
 		}
@@ -428,6 +456,10 @@ func (g *CityGenerator) createPlaza(block *CityBlock, terrain *Terrain) {
 		}
 	}
 
+	if g.plazaMonuments {
+		g.addPlazaMonument(rect, terrain)
+	}
+
 	// Track this as a "room" for stairs placement
 	room := &Room{
 		X:      rect.X,
@@ -439,6 +471,158 @@ func (g *CityGenerator) createPlaza(block *CityBlock, terrain *Terrain) {
 	terrain.Rooms = append(terrain.Rooms, room)
 }
 
+// addPlazaMonument places a solid centerpiece (statue, fountain, obelisk) in
+// the middle of a plaza, scaled to the plaza size and always leaving a
+// walkable ring around it.
+func (g *CityGenerator) addPlazaMonument(rect Rect, terrain *Terrain) {
+	side := rect.Width
+	if rect.Height < side {
+		side = rect.Height
+	}
+	side /= 5
+	if side > 4 {
+		side = 4
+	}
+	if side < 1 || rect.Width < 5 || rect.Height < 5 {
+		return
+	}
+
+	cx, cy := rect.Center()
+	x0 := cx - side/2
+	y0 := cy - side/2
+	for y := y0; y < y0+side; y++ {
+		for x := x0; x < x0+side; x++ {
+			terrain.SetTile(x, y, TileStructure)
+		}
+	}
+}
+
+// designatePlazas carves exactly plazaCount plazas when a count is configured.
+// Each plaza covers plazaSize×plazaSize grid blocks merged together with the
+// streets between them, forming one large paved square bordered by streets.
+// Plazas are never placed in neighboring grid cells so each remains a
+// distinct open area. If the grid cannot fit every plaza, as many as possible
+// are placed. With plazaCount == 0 the density-based assignment is kept.
+func (g *CityGenerator) designatePlazas(blocks []*CityBlock, rng *rand.Rand) []*CityBlock {
+	if g.plazaCount <= 0 {
+		return blocks
+	}
+
+	// Density-assigned plazas become buildings so the count is exact
+	grid := make(map[[2]int]*CityBlock, len(blocks))
+	for _, block := range blocks {
+		if block.BlockType == BlockPlaza {
+			block.BlockType = BlockBuilding
+		}
+		grid[[2]int{block.col, block.row}] = block
+	}
+
+	// Candidate top-left cells whose full footprint exists in the grid
+	candidates := make([]*CityBlock, 0, len(blocks))
+	for _, block := range blocks {
+		if g.plazaFootprintExists(grid, block.col, block.row) {
+			candidates = append(candidates, block)
+		}
+	}
+
+	claimed := make(map[[2]int]bool)
+	plazas := make([]*CityBlock, 0, g.plazaCount)
+	for _, i := range rng.Perm(len(candidates)) {
+		if len(plazas) == g.plazaCount {
+			break
+		}
+		origin := candidates[i]
+		if g.plazaFootprintNearClaimed(claimed, origin.col, origin.row) {
+			continue
+		}
+
+		last := grid[[2]int{origin.col + g.plazaSize - 1, origin.row + g.plazaSize - 1}]
+		for dy := 0; dy < g.plazaSize; dy++ {
+			for dx := 0; dx < g.plazaSize; dx++ {
+				claimed[[2]int{origin.col + dx, origin.row + dy}] = true
+			}
+		}
+		plazas = append(plazas, &CityBlock{
+			Rect: Rect{
+				X:      origin.Rect.X,
+				Y:      origin.Rect.Y,
+				Width:  last.Rect.X + last.Rect.Width - origin.Rect.X,
+				Height: last.Rect.Y + last.Rect.Height - origin.Rect.Y,
+			},
+			BlockType: BlockPlaza,
+			col:       origin.col,
+			row:       origin.row,
+		})
+	}
+
+	if len(plazas) < g.plazaCount && g.logger != nil {
+		g.logger.WithFields(logrus.Fields{
+			"requested": g.plazaCount,
+			"placed":    len(plazas),
+			"plazaSize": g.plazaSize,
+		}).Warn("city grid too small for requested plazas")
+	}
+
+	// Replace merged blocks with their plazas
+	result := make([]*CityBlock, 0, len(blocks))
+	for _, block := range blocks {
+		if !claimed[[2]int{block.col, block.row}] {
+			result = append(result, block)
+		}
+	}
+	return append(result, plazas...)
+}
+
+// plazaFootprintExists reports whether every block of a plaza anchored at
+// (col, row) exists in the grid.
+func (g *CityGenerator) plazaFootprintExists(grid map[[2]int]*CityBlock, col, row int) bool {
+	for dy := 0; dy < g.plazaSize; dy++ {
+		for dx := 0; dx < g.plazaSize; dx++ {
+			if grid[[2]int{col + dx, row + dy}] == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// plazaFootprintNearClaimed reports whether a plaza anchored at (col, row)
+// would overlap or border an already claimed plaza cell.
+func (g *CityGenerator) plazaFootprintNearClaimed(claimed map[[2]int]bool, col, row int) bool {
+	for dy := -1; dy <= g.plazaSize; dy++ {
+		for dx := -1; dx <= g.plazaSize; dx++ {
+			if claimed[[2]int{col + dx, row + dy}] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// walkableNearCenter returns the walkable tile closest to the center of rect,
+// searching outward ring by ring. Falls back to the center itself.
+func walkableNearCenter(rect Rect, terrain *Terrain) (int, int) {
+	cx, cy := rect.Center()
+	maxRadius := rect.Width
+	if rect.Height > maxRadius {
+		maxRadius = rect.Height
+	}
+	for r := 0; r <= maxRadius; r++ {
+		for dy := -r; dy <= r; dy++ {
+			for dx := -r; dx <= r; dx++ {
+				if dx != -r && dx != r && dy != -r && dy != r {
+					continue // Only the ring at distance r
+				}
+				x, y := cx+dx, cy+dy
+				if rect.Contains(x, y) && terrain.IsWalkable(x, y) {
+					return x, y
+				}
+			}
+		}
+	}
+	return cx, cy
+}
+
 // createPark creates a park with trees and/or water features.
 func (g *CityGenerator) createPark(block *CityBlock, terrain *Terrain, rng *rand.Rand) {
 	rect := block.Rect
@@ -547,12 +731,12 @@ func (g *CityGenerator) placeStairs(blocks []*CityBlock, terrain *Terrain, rng *
 		}
 
 		// Place stairs up in largest plaza
-		cx, cy := plazas[0].Rect.Center()
+		cx, cy := walkableNearCenter(plazas[0].Rect, terrain)
 		terrain.AddStairs(cx, cy, true)
 
 		// Place stairs down in second plaza or opposite corner of same plaza
 		if len(plazas) > 1 {
-			cx, cy = plazas[1].Rect.Center()
+			cx, cy = walkableNearCenter(plazas[1].Rect, terrain)
 			terrain.AddStairs(cx, cy, false)
 		} else {
 			// Use opposite corner of same plaza
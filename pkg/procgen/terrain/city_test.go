@@ -617,6 +617,188 @@ func TestCityGenerator_Stairs(t *testing.T) {
 	t.Logf("Stairs: %d up, %d down", len(terrain.StairsUp), len(terrain.StairsDown))
 }
 
+func TestCityGenerator_PlazaCount(t *testing.T) {
+	gen := NewCityGenerator()
+
+	params := procgen.GenerationParams{
+		Difficulty: 0.5,
+		Depth:      1,
+		GenreID:    "fantasy",
+		Custom: map[string]interface{}{
+			"width":          120,
+			"height":         100,
+			"plazaCount":     3,
+			"plazaSize":      2,
+			"plazaMonuments": true,
+		},
+	}
+
+	result, err := gen.Generate(2024, params)
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	terrain := result.(*Terrain)
+
+	// Plazas are tracked as rooms; exactly the requested number should exist
+	if len(terrain.Rooms) != 3 {
+		t.Fatalf("Expected 3 plazas, got %d", len(terrain.Rooms))
+	}
+
+	reachable := floodFillFromStreet(t, terrain)
+	minSide := 2*gen.blockSize + gen.streetWidth
+
+	for i, plaza := range terrain.Rooms {
+		if plaza.Width < minSide || plaza.Height < minSide {
+			t.Errorf("Plaza %d is %dx%d, expected at least %dx%d", i, plaza.Width, plaza.Height, minSide, minSide)
+		}
+
+		// Open: nearly all tiles walkable (monument and stairs aside)
+		walkable := 0
+		for y := plaza.Y; y < plaza.Y+plaza.Height; y++ {
+			for x := plaza.X; x < plaza.X+plaza.Width; x++ {
+				if terrain.IsWalkable(x, y) {
+					walkable++
+				}
+			}
+		}
+		area := plaza.Width * plaza.Height
+		if float64(walkable)/float64(area) < 0.9 {
+			t.Errorf("Plaza %d only %d/%d walkable", i, walkable, area)
+		}
+
+		// Central structure present
+		cx, cy := plaza.Center()
+		if terrain.GetTile(cx, cy) != TileStructure {
+			t.Errorf("Plaza %d missing central structure at (%d,%d)", i, cx, cy)
+		}
+
+		// Connected to the street network
+		if !reachable[plaza.Y][plaza.X] {
+			t.Errorf("Plaza %d at (%d,%d) not reachable from streets", i, plaza.X, plaza.Y)
+		}
+	}
+
+	if err := gen.Validate(terrain); err != nil {
+		t.Errorf("Validate() failed: %v", err)
+	}
+}
+
+func TestCityGenerator_PlazaCount_Deterministic(t *testing.T) {
+	params := procgen.GenerationParams{
+		Difficulty: 0.5,
+		Depth:      1,
+		GenreID:    "scifi",
+		Custom: map[string]interface{}{
+			"width":      100,
+			"height":     80,
+			"plazaCount": 2,
+		},
+	}
+
+	result1, err := NewCityGenerator().Generate(99, params)
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	result2, _ := NewCityGenerator().Generate(99, params)
+	t1, t2 := result1.(*Terrain), result2.(*Terrain)
+
+	for y := 0; y < t1.Height; y++ {
+		for x := 0; x < t1.Width; x++ {
+			if t1.GetTile(x, y) != t2.GetTile(x, y) {
+				t.Fatalf("Tile mismatch at (%d,%d)", x, y)
+			}
+		}
+	}
+}
+
+func TestCityGenerator_PlazaCount_TooManyRequested(t *testing.T) {
+	gen := NewCityGenerator()
+
+	params := procgen.GenerationParams{
+		Difficulty: 0.5,
+		Depth:      1,
+		GenreID:    "scifi",
+		Custom: map[string]interface{}{
+			"width":      60,
+			"height":     40,
+			"plazaCount": 20,
+			"plazaSize":  2,
+		},
+	}
+
+	result, err := gen.Generate(7, params)
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	terrain := result.(*Terrain)
+
+	// Grid only fits a limited number of non-adjacent 2x2 plazas
+	if len(terrain.Rooms) == 0 || len(terrain.Rooms) >= 20 {
+		t.Errorf("Expected a partial number of plazas, got %d", len(terrain.Rooms))
+	}
+}
+
+func TestCityGenerator_PlazaParams_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		custom map[string]interface{}
+	}{
+		{"negative count", map[string]interface{}{"plazaCount": -1}},
+		{"count too large", map[string]interface{}{"plazaCount": 51}},
+		{"size zero", map[string]interface{}{"plazaSize": 0}},
+		{"size too large", map[string]interface{}{"plazaSize": 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := NewCityGenerator()
+			params := procgen.GenerationParams{GenreID: "scifi", Custom: tt.custom}
+			if _, err := gen.Generate(1, params); err == nil {
+				t.Error("Expected error for invalid plaza parameters")
+			}
+		})
+	}
+}
+
+// floodFillFromStreet returns the walkable tiles reachable from the first street tile.
+func floodFillFromStreet(t *testing.T, terrain *Terrain) [][]bool {
+	t.Helper()
+
+	visited := make([][]bool, terrain.Height)
+	for i := range visited {
+		visited[i] = make([]bool, terrain.Width)
+	}
+
+	var queue []Point
+	for y := 0; y < terrain.Height && len(queue) == 0; y++ {
+		for x := 0; x < terrain.Width; x++ {
+			if terrain.GetTile(x, y) == TileCorridor {
+				queue = append(queue, Point{X: x, Y: y})
+				visited[y][x] = true
+				break
+			}
+		}
+	}
+	if len(queue) == 0 {
+		t.Fatal("No street tiles found")
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range current.Neighbors() {
+			if !terrain.IsInBounds(neighbor.X, neighbor.Y) || visited[neighbor.Y][neighbor.X] {
+				continue
+			}
+			if terrain.IsWalkable(neighbor.X, neighbor.Y) {
+				visited[neighbor.Y][neighbor.X] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return visited
+}
+
 func TestCityGenerator_Validate(t *testing.T) {
 	gen := NewCityGenerator()
 
@@ -128,6 +128,7 @@ func TestRoomType_String(t *testing.T) {
 		{RoomTrap, "trap"},
 		{RoomSpawn, "spawn"},
 		{RoomExit, "exit"},
+		{RoomShrine, "shrine"},
 		{RoomType(999), "unknown"},
 	}
 
@@ -187,3 +188,37 @@ func TestBSPGenerator_SmallDungeonRoomTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestBSPGenerator_ShrineRooms(t *testing.T) {
+	gen := NewBSPGenerator()
+	params := procgen.GenerationParams{
+		Difficulty: 0.5,
+		Depth:      1,
+		GenreID:    "fantasy",
+		Custom: map[string]interface{}{
+			"width":  80,
+			"height": 50,
+		},
+	}
+
+	shrines := 0
+	for seed := int64(1); seed <= 20; seed++ {
+		result, err := gen.Generate(seed, params)
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		terrain := result.(*Terrain)
+		for i, room := range terrain.Rooms {
+			if room.Type != RoomShrine {
+				continue
+			}
+			shrines++
+			if i == 0 || i == len(terrain.Rooms)-1 {
+				t.Errorf("seed %d: spawn or exit room %d became a shrine", seed, i)
+			}
+		}
+	}
+	if shrines == 0 {
+		t.Error("no shrine rooms generated across 20 seeds")
+	}
+}
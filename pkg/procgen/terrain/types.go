@@ -230,6 +230,8 @@ const (
 	RoomSpawn
 	// RoomExit represents the dungeon exit/stairs
 	RoomExit
+	// RoomShrine represents a room holding a shrine that grants a buff or curse
+	RoomShrine
 )
 
 // String returns the string representation of a room type.
@@ -247,6 +249,8 @@ func (rt RoomType) String() string {
 		return "spawn"
 	case RoomExit:
 		return "exit"
+	case RoomShrine:
+		return "shrine"
 	default:
 		return "unknown"
 	}
@@ -279,9 +283,10 @@ type Terrain struct {
 	Tiles      [][]TileType
 	Rooms      []*Room
 	Seed       int64
-	Level      int     // Dungeon level (0 = first level)
-	StairsUp   []Point // Positions of upward stairs
-	StairsDown []Point // Positions of downward stairs
+	Level      int          // Dungeon level (0 = first level)
+	StairsUp   []Point      // Positions of upward stairs
+	StairsDown []Point      // Positions of downward stairs
+	BossArenas []*BossArena // Mechanics layouts of boss rooms
 }
 
 // NewTerrain creates a new terrain map filled with walls.
@@ -0,0 +1,110 @@
+// Package worldgen provides declarative pipeline configuration.
+// This file defines Config, which describes a pipeline as plain data so
+// the client and server can share one definition.
+package worldgen
+
+import (
+	"fmt"
+
+	"github.com/opd-ai/venture/pkg/rendering/particles"
+	"github.com/sirupsen/logrus"
+)
+
+// Config declares which stages a pipeline runs and how they are tuned.
+type Config struct {
+	// Generator is the base terrain algorithm: bsp, cellular, maze, forest, or city
+	Generator string
+
+	// Width and Height of the map in tiles
+	Width, Height int
+
+	// RepairConnectivity joins isolated walkable regions
+	RepairConnectivity bool
+
+	// LockAndKeyPairs is the number of locked rooms with keys (0 disables)
+	LockAndKeyPairs int
+
+	// EnemiesPerRoom is the maximum enemy spawns per room (0 disables)
+	EnemiesPerRoom int
+
+	// ItemChance is the probability (0.0-1.0) that a room gets an item
+	ItemChance float64
+
+	// Lights places one light per room
+	Lights bool
+
+	// Weather selects a genre-appropriate weather effect
+	Weather bool
+
+	// WeatherIntensity of the chosen weather
+	WeatherIntensity particles.WeatherIntensity
+}
+
+// DefaultConfig returns the pipeline used by the client, the dedicated
+// server and hosted games. Its Width and Height are the map size all of
+// them share.
+func DefaultConfig() Config {
+	return Config{
+		Generator:          "bsp",
+		Width:              80,
+		Height:             50,
+		RepairConnectivity: true,
+		LockAndKeyPairs:    1,
+		EnemiesPerRoom:     3,
+		ItemChance:         0.3,
+		Lights:             true,
+		Weather:            true,
+		WeatherIntensity:   particles.IntensityMedium,
+	}
+}
+
+// Validate checks that the configuration is usable.
+func (c Config) Validate() error {
+	if _, err := newTerrainGenerator(c.Generator); err != nil {
+		return err
+	}
+	if c.Width <= 0 || c.Height <= 0 {
+		return fmt.Errorf("invalid map size %dx%d", c.Width, c.Height)
+	}
+	if c.LockAndKeyPairs < 0 {
+		return fmt.Errorf("lock and key pairs must be non-negative, got %d", c.LockAndKeyPairs)
+	}
+	if c.EnemiesPerRoom < 0 {
+		return fmt.Errorf("enemies per room must be non-negative, got %d", c.EnemiesPerRoom)
+	}
+	if c.ItemChance < 0 || c.ItemChance > 1 {
+		return fmt.Errorf("item chance must be between 0 and 1, got %f", c.ItemChance)
+	}
+	return nil
+}
+
+// NewPipelineFromConfig builds a pipeline from a configuration.
+func NewPipelineFromConfig(config Config) (*Pipeline, error) {
+	return NewPipelineFromConfigWithLogger(config, nil)
+}
+
+// NewPipelineFromConfigWithLogger builds a pipeline from a configuration with a logger.
+func NewPipelineFromConfigWithLogger(config Config, logger *logrus.Logger) (*Pipeline, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid world generation config: %w", err)
+	}
+
+	stages := []Stage{BaseTerrainStage{Generator: config.Generator, Width: config.Width, Height: config.Height}}
+	if config.RepairConnectivity {
+		stages = append(stages, ConnectivityStage{})
+	}
+	if config.LockAndKeyPairs > 0 {
+		stages = append(stages, LockAndKeyStage{Pairs: config.LockAndKeyPairs})
+	}
+	if config.EnemiesPerRoom > 0 || config.ItemChance > 0 {
+		stages = append(stages, PopulateRoomsStage{EnemiesPerRoom: config.EnemiesPerRoom, ItemChance: config.ItemChance})
+	}
+	if config.Lights {
+		stages = append(stages, LightsStage{})
+	}
+	if config.Weather {
+		stages = append(stages, WeatherStage{Intensity: config.WeatherIntensity})
+	}
+
+	return NewPipelineWithLogger(logger, stages...), nil
+}
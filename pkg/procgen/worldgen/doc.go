@@ -0,0 +1,34 @@
+// Package worldgen provides a declarative world generation pipeline.
+//
+// A Pipeline runs an ordered list of stages over a shared World: a base
+// terrain generator followed by passes that repair connectivity, place
+// lock-and-key pairs, populate rooms, position lights, and choose weather.
+// The result is pure data (terrain plus spawn placements) with no engine
+// entities, so the client and server can build identical worlds from the
+// same Config and seed and then instantiate entities however they need.
+//
+// # Determinism
+//
+// Each stage receives its own RNG derived from the world seed and the
+// stage's name and position, so adding or tuning one stage never changes
+// the random stream seen by another. The base terrain stage uses the world
+// seed directly, producing the same terrain as calling the generator alone.
+//
+// # Basic Usage
+//
+//	config := worldgen.DefaultConfig()
+//	pipeline, err := worldgen.NewPipelineFromConfig(config)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	world, err := pipeline.Run(seed, procgen.GenerationParams{
+//	    Difficulty: 0.5,
+//	    Depth:      1,
+//	    GenreID:    "fantasy",
+//	})
+//	for _, spawn := range world.SpawnsOfKind(worldgen.SpawnEnemy) {
+//	    // instantiate enemy at spawn.X, spawn.Y
+//	}
+//
+// Custom pipelines can be assembled from individual stages with NewPipeline.
+package worldgen
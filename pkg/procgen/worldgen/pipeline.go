@@ -0,0 +1,232 @@
+// Package worldgen provides the world generation pipeline.
+// This file defines the pipeline, its stage interface, and the World
+// result shared by all stages.
+package worldgen
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+	"github.com/opd-ai/venture/pkg/rendering/particles"
+	"github.com/sirupsen/logrus"
+)
+
+// SpawnKind identifies what a spawn placement represents.
+type SpawnKind int
+
+const (
+	// SpawnEnemy marks an enemy spawn point
+	SpawnEnemy SpawnKind = iota
+	// SpawnItem marks a floor item spawn point
+	SpawnItem
+	// SpawnKey marks a key that opens locked doors with the same tag
+	SpawnKey
+	// SpawnLockedDoor marks a door that requires the key with the same tag
+	SpawnLockedDoor
+	// SpawnLight marks an environmental light source
+	SpawnLight
+)
+
+// String returns the string representation of a spawn kind.
+func (k SpawnKind) String() string {
+	switch k {
+	case SpawnEnemy:
+		return "enemy"
+	case SpawnItem:
+		return "item"
+	case SpawnKey:
+		return "key"
+	case SpawnLockedDoor:
+		return "locked_door"
+	case SpawnLight:
+		return "light"
+	default:
+		return "unknown"
+	}
+}
+
+// Spawn is a placement produced by a pipeline stage, in tile coordinates.
+type Spawn struct {
+	// Kind of content to spawn
+	Kind SpawnKind
+
+	// X and Y are the tile coordinates
+	X, Y int
+
+	// RoomIndex is the index into Terrain.Rooms, or -1 outside rooms
+	RoomIndex int
+
+	// Tag links related spawns (e.g. a key and its locked doors)
+	Tag string
+}
+
+// WeatherPlan describes the weather chosen for the world.
+type WeatherPlan struct {
+	// Type of weather effect
+	Type particles.WeatherType
+
+	// Intensity of the weather effect
+	Intensity particles.WeatherIntensity
+
+	// Seed for the weather particle system
+	Seed int64
+}
+
+// World is the output of a pipeline run.
+type World struct {
+	// Seed the world was generated from
+	Seed int64
+
+	// Params used for generation
+	Params procgen.GenerationParams
+
+	// Terrain produced by the base stage and modified by later passes
+	Terrain *terrain.Terrain
+
+	// Spawns lists placements in the order stages produced them
+	Spawns []Spawn
+
+	// Weather is the chosen weather, or nil if no weather stage ran
+	Weather *WeatherPlan
+}
+
+// AddSpawn appends a placement to the world.
+func (w *World) AddSpawn(spawn Spawn) {
+	w.Spawns = append(w.Spawns, spawn)
+}
+
+// SpawnsOfKind returns all placements of the given kind, in order.
+func (w *World) SpawnsOfKind(kind SpawnKind) []Spawn {
+	var result []Spawn
+	for _, spawn := range w.Spawns {
+		if spawn.Kind == kind {
+			result = append(result, spawn)
+		}
+	}
+	return result
+}
+
+// isOccupied reports whether a spawn already uses the tile.
+func (w *World) isOccupied(x, y int) bool {
+	for _, spawn := range w.Spawns {
+		if spawn.X == x && spawn.Y == y {
+			return true
+		}
+	}
+	return false
+}
+
+// Stage is one step of a world generation pipeline.
+type Stage interface {
+	// Name identifies the stage; it also seeds the stage's RNG
+	Name() string
+
+	// Apply modifies the world in place using the stage's RNG
+	Apply(world *World, rng *rand.Rand) error
+}
+
+// Pipeline runs a sequence of stages to build a World.
+// Pipelines are stateless between runs and safe to reuse.
+type Pipeline struct {
+	stages []Stage
+	logger *logrus.Entry
+}
+
+// NewPipeline creates a pipeline from the given stages.
+// The first stage must produce terrain (typically a BaseTerrainStage).
+func NewPipeline(stages ...Stage) *Pipeline {
+	return NewPipelineWithLogger(nil, stages...)
+}
+
+// NewPipelineWithLogger creates a pipeline with a logger.
+func NewPipelineWithLogger(logger *logrus.Logger, stages ...Stage) *Pipeline {
+	var logEntry *logrus.Entry
+	if logger != nil {
+		logEntry = logger.WithFields(logrus.Fields{
+			"generator": "worldgen",
+		})
+	}
+	return &Pipeline{
+		stages: stages,
+		logger: logEntry,
+	}
+}
+
+// StageNames returns the names of the pipeline's stages in order.
+func (p *Pipeline) StageNames() []string {
+	names := make([]string, len(p.stages))
+	for i, stage := range p.stages {
+		names[i] = stage.Name()
+	}
+	return names
+}
+
+// Run executes every stage in order and returns the generated world.
+func (p *Pipeline) Run(seed int64, params procgen.GenerationParams) (*World, error) {
+	if len(p.stages) == 0 {
+		return nil, fmt.Errorf("pipeline has no stages")
+	}
+
+	world := &World{Seed: seed, Params: params}
+	seeds := procgen.NewSeedGenerator(seed)
+
+	for i, stage := range p.stages {
+		rng := rand.New(rand.NewSource(seeds.GetSeed(stage.Name(), i)))
+		if err := stage.Apply(world, rng); err != nil {
+			if p.logger != nil {
+				p.logger.WithError(err).WithField("stage", stage.Name()).Error("world generation stage failed")
+			}
+			return nil, fmt.Errorf("stage %q: %w", stage.Name(), err)
+		}
+		if world.Terrain == nil {
+			return nil, fmt.Errorf("stage %q: no terrain after stage (first stage must generate terrain)", stage.Name())
+		}
+
+		if p.logger != nil && p.logger.Logger.GetLevel() >= logrus.DebugLevel {
+			p.logger.WithFields(logrus.Fields{
+				"stage":  stage.Name(),
+				"spawns": len(world.Spawns),
+			}).Debug("world generation stage complete")
+		}
+	}
+
+	if p.logger != nil {
+		p.logger.WithFields(logrus.Fields{
+			"seed":   seed,
+			"stages": len(p.stages),
+			"rooms":  len(world.Terrain.Rooms),
+			"spawns": len(world.Spawns),
+		}).Info("world generation complete")
+	}
+
+	return world, nil
+}
+
+// Generate implements procgen.Generator by running the pipeline.
+func (p *Pipeline) Generate(seed int64, params procgen.GenerationParams) (interface{}, error) {
+	return p.Run(seed, params)
+}
+
+// Validate implements procgen.Generator. It checks that the world has
+// terrain and that every spawn is on an in-bounds walkable tile.
+func (p *Pipeline) Validate(result interface{}) error {
+	world, ok := result.(*World)
+	if !ok {
+		return fmt.Errorf("result is not a *World")
+	}
+	if world.Terrain == nil {
+		return fmt.Errorf("world has no terrain")
+	}
+
+	for i, spawn := range world.Spawns {
+		if !world.Terrain.IsInBounds(spawn.X, spawn.Y) {
+			return fmt.Errorf("spawn %d (%s) out of bounds at (%d,%d)", i, spawn.Kind, spawn.X, spawn.Y)
+		}
+		if !world.Terrain.IsWalkable(spawn.X, spawn.Y) {
+			return fmt.Errorf("spawn %d (%s) on unwalkable tile at (%d,%d)", i, spawn.Kind, spawn.X, spawn.Y)
+		}
+	}
+	return nil
+}
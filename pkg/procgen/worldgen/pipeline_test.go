@@ -0,0 +1,206 @@
+package worldgen
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+)
+
+func testParams() procgen.GenerationParams {
+	return procgen.GenerationParams{
+		Difficulty: 0.5,
+		Depth:      1,
+		GenreID:    "fantasy",
+	}
+}
+
+func runDefault(t *testing.T, seed int64) *World {
+	t.Helper()
+	pipeline, err := NewPipelineFromConfig(DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewPipelineFromConfig failed: %v", err)
+	}
+	world, err := pipeline.Run(seed, testParams())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	return world
+}
+
+func TestPipeline_ClientServerIdentical(t *testing.T) {
+	for _, seed := range []int64{1, 42, 12345} {
+		client := runDefault(t, seed)
+		server := runDefault(t, seed)
+
+		if !reflect.DeepEqual(client.Terrain.Tiles, server.Terrain.Tiles) {
+			t.Errorf("seed %d: client and server terrain differ", seed)
+		}
+		if !reflect.DeepEqual(client.Spawns, server.Spawns) {
+			t.Errorf("seed %d: client and server spawns differ", seed)
+		}
+		if !reflect.DeepEqual(client.Weather, server.Weather) {
+			t.Errorf("seed %d: client and server weather differ", seed)
+		}
+	}
+}
+
+func TestPipeline_BaseTerrainMatchesGenerator(t *testing.T) {
+	pipeline := NewPipeline(BaseTerrainStage{Generator: "bsp", Width: 80, Height: 50})
+	world, err := pipeline.Run(7, testParams())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	params := testParams()
+	params.Custom = map[string]interface{}{"width": 80, "height": 50}
+	result, err := terrain.NewBSPGenerator().Generate(7, params)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if !reflect.DeepEqual(world.Terrain.Tiles, result.(*terrain.Terrain).Tiles) {
+		t.Error("pipeline terrain differs from standalone generator")
+	}
+}
+
+func TestPipeline_ValidSpawns(t *testing.T) {
+	pipeline, _ := NewPipelineFromConfig(DefaultConfig())
+	for seed := int64(0); seed < 10; seed++ {
+		world, err := pipeline.Run(seed, testParams())
+		if err != nil {
+			t.Fatalf("seed %d: Run failed: %v", seed, err)
+		}
+		if err := pipeline.Validate(world); err != nil {
+			t.Errorf("seed %d: %v", seed, err)
+		}
+		if len(world.SpawnsOfKind(SpawnEnemy)) == 0 {
+			t.Errorf("seed %d: no enemies placed", seed)
+		}
+		if world.Weather == nil {
+			t.Errorf("seed %d: no weather chosen", seed)
+		}
+	}
+}
+
+func TestConnectivityStage_SingleRegion(t *testing.T) {
+	config := DefaultConfig()
+	config.Generator = "cellular"
+	config.LockAndKeyPairs = 0
+
+	pipeline, err := NewPipelineFromConfig(config)
+	if err != nil {
+		t.Fatalf("NewPipelineFromConfig failed: %v", err)
+	}
+	for seed := int64(0); seed < 5; seed++ {
+		world, err := pipeline.Run(seed, testParams())
+		if err != nil {
+			t.Fatalf("seed %d: Run failed: %v", seed, err)
+		}
+		if regions := walkableRegions(world.Terrain, nil); len(regions) != 1 {
+			t.Errorf("seed %d: %d walkable regions after repair, want 1", seed, len(regions))
+		}
+	}
+}
+
+func TestConnectivityStage_JoinsIslands(t *testing.T) {
+	terr := terrain.NewTerrain(20, 10, 1)
+	for _, p := range []terrain.Point{{X: 2, Y: 2}, {X: 3, Y: 2}, {X: 15, Y: 7}} {
+		terr.SetTile(p.X, p.Y, terrain.TileFloor)
+	}
+	world := &World{Terrain: terr}
+
+	if err := (ConnectivityStage{}).Apply(world, rand.New(rand.NewSource(1))); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if regions := walkableRegions(terr, nil); len(regions) != 1 {
+		t.Errorf("got %d regions, want 1", len(regions))
+	}
+}
+
+func TestLockAndKeyStage_KeyReachable(t *testing.T) {
+	pipeline, _ := NewPipelineFromConfig(DefaultConfig())
+	placed := 0
+	for seed := int64(0); seed < 10; seed++ {
+		world, err := pipeline.Run(seed, testParams())
+		if err != nil {
+			t.Fatalf("seed %d: Run failed: %v", seed, err)
+		}
+
+		keys := world.SpawnsOfKind(SpawnKey)
+		doors := world.SpawnsOfKind(SpawnLockedDoor)
+		if len(keys) == 0 {
+			continue
+		}
+		placed++
+
+		blocked := make(map[terrain.Point]bool)
+		for _, door := range doors {
+			blocked[terrain.Point{X: door.X, Y: door.Y}] = true
+		}
+		start, _ := roomWalkableTile(world.Terrain, world.Terrain.Rooms[0])
+		reachable := bfsDistances(world.Terrain, start, blocked)
+
+		for _, key := range keys {
+			if _, ok := reachable[terrain.Point{X: key.X, Y: key.Y}]; !ok {
+				t.Errorf("seed %d: key %s at (%d,%d) is behind a locked door", seed, key.Tag, key.X, key.Y)
+			}
+		}
+
+		// The locked room itself must be sealed off
+		lockedRoom := world.Terrain.Rooms[doors[0].RoomIndex]
+		inside, _ := roomWalkableTile(world.Terrain, lockedRoom)
+		if _, ok := reachable[inside]; ok {
+			t.Errorf("seed %d: locked room %d reachable without key", seed, doors[0].RoomIndex)
+		}
+	}
+	if placed == 0 {
+		t.Error("no lock-and-key pairs placed across seeds")
+	}
+}
+
+func TestNewPipelineFromConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+	}{
+		{"unknown generator", func(c *Config) { c.Generator = "voronoi" }},
+		{"zero width", func(c *Config) { c.Width = 0 }},
+		{"negative pairs", func(c *Config) { c.LockAndKeyPairs = -1 }},
+		{"negative enemies", func(c *Config) { c.EnemiesPerRoom = -1 }},
+		{"item chance", func(c *Config) { c.ItemChance = 1.5 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			tt.modify(&config)
+			if _, err := NewPipelineFromConfig(config); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+// noopStage is a stage that leaves the world unchanged.
+type noopStage struct{}
+
+func (noopStage) Name() string                             { return "noop" }
+func (noopStage) Apply(world *World, rng *rand.Rand) error { return nil }
+
+func TestPipeline_Run_NoTerrain(t *testing.T) {
+	if _, err := NewPipeline().Run(1, testParams()); err == nil {
+		t.Error("expected error for empty pipeline")
+	}
+	if _, err := NewPipeline(noopStage{}).Run(1, testParams()); err == nil {
+		t.Error("expected error when first stage produces no terrain")
+	}
+}
+
+func TestPipeline_StageNames(t *testing.T) {
+	pipeline, _ := NewPipelineFromConfig(DefaultConfig())
+	want := []string{"terrain", "connectivity", "lock_and_key", "populate_rooms", "lights", "weather"}
+	if got := pipeline.StageNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("StageNames() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,456 @@
+// Package worldgen provides the built-in pipeline stages.
+// This file implements the base terrain stage and the passes that run
+// on its output: connectivity repair, lock-and-key placement, room
+// population, light placement, and weather selection.
+package worldgen
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/opd-ai/venture/pkg/procgen"
+	"github.com/opd-ai/venture/pkg/procgen/terrain"
+	"github.com/opd-ai/venture/pkg/rendering/particles"
+)
+
+// newTerrainGenerator returns the terrain generator registered under name.
+func newTerrainGenerator(name string) (procgen.Generator, error) {
+	switch name {
+	case "bsp":
+		return terrain.NewBSPGenerator(), nil
+	case "cellular":
+		return terrain.NewCellularGenerator(), nil
+	case "maze":
+		return terrain.NewMazeGenerator(), nil
+	case "forest":
+		return terrain.NewForestGenerator(), nil
+	case "city":
+		return terrain.NewCityGenerator(), nil
+	default:
+		return nil, fmt.Errorf("unknown terrain generator %q", name)
+	}
+}
+
+// BaseTerrainStage generates the initial terrain.
+type BaseTerrainStage struct {
+	// Generator is the terrain algorithm: bsp, cellular, maze, forest, or city
+	Generator string
+
+	// Width and Height of the map in tiles
+	Width, Height int
+}
+
+// Name returns the stage name.
+func (s BaseTerrainStage) Name() string { return "terrain" }
+
+// Apply generates terrain using the world seed directly, so the result
+// matches running the terrain generator on its own.
+func (s BaseTerrainStage) Apply(world *World, rng *rand.Rand) error {
+	gen, err := newTerrainGenerator(s.Generator)
+	if err != nil {
+		return err
+	}
+
+	// Copy params so the caller's Custom map is not modified
+	params := world.Params
+	params.Custom = make(map[string]interface{}, len(world.Params.Custom)+2)
+	for k, v := range world.Params.Custom {
+		params.Custom[k] = v
+	}
+	params.Custom["width"] = s.Width
+	params.Custom["height"] = s.Height
+
+	result, err := gen.Generate(world.Seed, params)
+	if err != nil {
+		return err
+	}
+	terr, ok := result.(*terrain.Terrain)
+	if !ok {
+		return fmt.Errorf("generator %q returned %T, want *terrain.Terrain", s.Generator, result)
+	}
+	world.Terrain = terr
+	return nil
+}
+
+// ConnectivityStage carves corridors so every walkable tile is reachable
+// from every other. Isolated regions are joined to the largest region
+// with an L-shaped corridor to its nearest tile.
+type ConnectivityStage struct{}
+
+// Name returns the stage name.
+func (s ConnectivityStage) Name() string { return "connectivity" }
+
+// Apply joins all walkable regions into one.
+func (s ConnectivityStage) Apply(world *World, rng *rand.Rand) error {
+	terr := world.Terrain
+	for {
+		regions := walkableRegions(terr, nil)
+		if len(regions) <= 1 {
+			return nil
+		}
+
+		// Join the smallest region to the main (largest) region
+		main := regions[0]
+		island := regions[len(regions)-1]
+		from := island[0]
+		to := nearestPoint(main, from)
+		carveCorridor(terr, from, to, rng.Intn(2) == 0)
+	}
+}
+
+// LockAndKeyStage places locked doors on the entrances of rooms far from
+// the start room, each with a key placed where it can be reached without
+// passing through any locked door. Room 0 is treated as the start room.
+type LockAndKeyStage struct {
+	// Pairs is the number of locked rooms to create
+	Pairs int
+}
+
+// maxLockedEntrances skips rooms that are too open to lock sensibly.
+const maxLockedEntrances = 4
+
+// Name returns the stage name.
+func (s LockAndKeyStage) Name() string { return "lock_and_key" }
+
+// Apply places locked doors and keys. Terrains without enough rooms get
+// fewer pairs rather than an error.
+func (s LockAndKeyStage) Apply(world *World, rng *rand.Rand) error {
+	terr := world.Terrain
+	if s.Pairs <= 0 || len(terr.Rooms) < 2 {
+		return nil
+	}
+
+	start, ok := roomWalkableTile(terr, terr.Rooms[0])
+	if !ok {
+		return nil
+	}
+	distances := bfsDistances(terr, start, nil)
+
+	// Lock the rooms farthest from the start first
+	type candidate struct {
+		index     int
+		distance  int
+		entrances []terrain.Point
+	}
+	var candidates []candidate
+	for i := 1; i < len(terr.Rooms); i++ {
+		tile, ok := roomWalkableTile(terr, terr.Rooms[i])
+		if !ok {
+			continue
+		}
+		dist, reachable := distances[tile]
+		entrances := roomEntrances(terr, terr.Rooms[i])
+		if !reachable || len(entrances) == 0 || len(entrances) > maxLockedEntrances {
+			continue
+		}
+		candidates = append(candidates, candidate{index: i, distance: dist, entrances: entrances})
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].distance > candidates[b].distance
+	})
+
+	blocked := make(map[terrain.Point]bool)
+	locked := make(map[int]bool)
+	for pair := 0; pair < s.Pairs && pair < len(candidates); pair++ {
+		c := candidates[pair]
+		tag := fmt.Sprintf("key_%d", pair+1)
+
+		for _, p := range c.entrances {
+			blocked[p] = true
+			world.AddSpawn(Spawn{Kind: SpawnLockedDoor, X: p.X, Y: p.Y, RoomIndex: c.index, Tag: tag})
+		}
+		locked[c.index] = true
+
+		// The key must be reachable with every lock placed so far closed
+		reachable := bfsDistances(terr, start, blocked)
+		x, y, room, ok := s.pickKeyTile(world, reachable, locked, rng)
+		if !ok {
+			return fmt.Errorf("no reachable tile for %s", tag)
+		}
+		world.AddSpawn(Spawn{Kind: SpawnKey, X: x, Y: y, RoomIndex: room, Tag: tag})
+	}
+	return nil
+}
+
+// pickKeyTile chooses a free, reachable tile in an unlocked room,
+// preferring rooms other than the start room.
+func (s LockAndKeyStage) pickKeyTile(world *World, reachable map[terrain.Point]int, locked map[int]bool, rng *rand.Rand) (int, int, int, bool) {
+	terr := world.Terrain
+	for _, includeStart := range []bool{false, true} {
+		var rooms []int
+		tiles := make(map[int][]terrain.Point)
+		for i, room := range terr.Rooms {
+			if locked[i] || (i == 0 && !includeStart) {
+				continue
+			}
+			for y := room.Y; y < room.Y+room.Height; y++ {
+				for x := room.X; x < room.X+room.Width; x++ {
+					p := terrain.Point{X: x, Y: y}
+					if _, ok := reachable[p]; ok && !world.isOccupied(x, y) {
+						tiles[i] = append(tiles[i], p)
+					}
+				}
+			}
+			if len(tiles[i]) > 0 {
+				rooms = append(rooms, i)
+			}
+		}
+		if len(rooms) > 0 {
+			room := rooms[rng.Intn(len(rooms))]
+			p := tiles[room][rng.Intn(len(tiles[room]))]
+			return p.X, p.Y, room, true
+		}
+	}
+	return 0, 0, -1, false
+}
+
+// PopulateRoomsStage places enemy and item spawn points in every room
+// except the start room (room 0).
+type PopulateRoomsStage struct {
+	// EnemiesPerRoom is the maximum enemies per room (each room gets 1..N)
+	EnemiesPerRoom int
+
+	// ItemChance is the probability (0.0-1.0) that a room contains an item
+	ItemChance float64
+}
+
+// Name returns the stage name.
+func (s PopulateRoomsStage) Name() string { return "populate_rooms" }
+
+// Apply places enemies and items on free walkable tiles.
+func (s PopulateRoomsStage) Apply(world *World, rng *rand.Rand) error {
+	for i := 1; i < len(world.Terrain.Rooms); i++ {
+		room := world.Terrain.Rooms[i]
+
+		if s.EnemiesPerRoom > 0 {
+			count := 1 + rng.Intn(s.EnemiesPerRoom)
+			for n := 0; n < count; n++ {
+				if x, y, ok := randomFreeTile(world, room, rng); ok {
+					world.AddSpawn(Spawn{Kind: SpawnEnemy, X: x, Y: y, RoomIndex: i})
+				}
+			}
+		}
+
+		if rng.Float64() < s.ItemChance {
+			if x, y, ok := randomFreeTile(world, room, rng); ok {
+				world.AddSpawn(Spawn{Kind: SpawnItem, X: x, Y: y, RoomIndex: i})
+			}
+		}
+	}
+	return nil
+}
+
+// LightsStage places one light in each room, as close to its center as a
+// free walkable tile allows.
+type LightsStage struct{}
+
+// Name returns the stage name.
+func (s LightsStage) Name() string { return "lights" }
+
+// Apply places room lights.
+func (s LightsStage) Apply(world *World, rng *rand.Rand) error {
+	for i, room := range world.Terrain.Rooms {
+		if x, y, ok := freeTileNearCenter(world, room); ok {
+			world.AddSpawn(Spawn{Kind: SpawnLight, X: x, Y: y, RoomIndex: i})
+		}
+	}
+	return nil
+}
+
+// WeatherStage selects a genre-appropriate weather type.
+type WeatherStage struct {
+	// Intensity of the chosen weather
+	Intensity particles.WeatherIntensity
+}
+
+// Name returns the stage name.
+func (s WeatherStage) Name() string { return "weather" }
+
+// Apply chooses the weather type and particle seed.
+func (s WeatherStage) Apply(world *World, rng *rand.Rand) error {
+	options := particles.GetGenreWeather(world.Params.GenreID)
+	if len(options) == 0 {
+		return nil
+	}
+	world.Weather = &WeatherPlan{
+		Type:      options[rng.Intn(len(options))],
+		Intensity: s.Intensity,
+		Seed:      rng.Int63(),
+	}
+	return nil
+}
+
+// walkableRegions returns the 4-connected walkable regions of the terrain,
+// largest first (ties broken by first tile in row-major order). Tiles in
+// blocked are treated as unwalkable. Each region's tiles are in BFS order
+// starting from its first row-major tile.
+func walkableRegions(terr *terrain.Terrain, blocked map[terrain.Point]bool) [][]terrain.Point {
+	visited := make([][]bool, terr.Height)
+	for y := range visited {
+		visited[y] = make([]bool, terr.Width)
+	}
+
+	var regions [][]terrain.Point
+	for y := 0; y < terr.Height; y++ {
+		for x := 0; x < terr.Width; x++ {
+			start := terrain.Point{X: x, Y: y}
+			if visited[y][x] || !terr.IsWalkable(x, y) || blocked[start] {
+				continue
+			}
+
+			region := []terrain.Point{start}
+			visited[y][x] = true
+			for i := 0; i < len(region); i++ {
+				for _, n := range region[i].Neighbors() {
+					if terr.IsInBounds(n.X, n.Y) && !visited[n.Y][n.X] && terr.IsWalkable(n.X, n.Y) && !blocked[n] {
+						visited[n.Y][n.X] = true
+						region = append(region, n)
+					}
+				}
+			}
+			regions = append(regions, region)
+		}
+	}
+
+	sort.SliceStable(regions, func(a, b int) bool {
+		return len(regions[a]) > len(regions[b])
+	})
+	return regions
+}
+
+// bfsDistances returns the walking distance from start to every reachable
+// tile, treating tiles in blocked as impassable.
+func bfsDistances(terr *terrain.Terrain, start terrain.Point, blocked map[terrain.Point]bool) map[terrain.Point]int {
+	distances := map[terrain.Point]int{start: 0}
+	queue := []terrain.Point{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, n := range current.Neighbors() {
+			if _, seen := distances[n]; seen || blocked[n] || !terr.IsWalkable(n.X, n.Y) {
+				continue
+			}
+			distances[n] = distances[current] + 1
+			queue = append(queue, n)
+		}
+	}
+	return distances
+}
+
+// nearestPoint returns the point in points closest to target (Manhattan).
+func nearestPoint(points []terrain.Point, target terrain.Point) terrain.Point {
+	best := points[0]
+	bestDist := best.ManhattanDistance(target)
+	for _, p := range points[1:] {
+		if d := p.ManhattanDistance(target); d < bestDist {
+			best, bestDist = p, d
+		}
+	}
+	return best
+}
+
+// carveCorridor turns unwalkable tiles along an L-shaped path into corridor.
+func carveCorridor(terr *terrain.Terrain, from, to terrain.Point, horizontalFirst bool) {
+	carve := func(x, y int) {
+		if !terr.IsWalkable(x, y) {
+			terr.SetTile(x, y, terrain.TileCorridor)
+		}
+	}
+	step := func(a, b int) int {
+		if b > a {
+			return 1
+		}
+		return -1
+	}
+
+	x, y := from.X, from.Y
+	if horizontalFirst {
+		for ; x != to.X; x += step(x, to.X) {
+			carve(x, y)
+		}
+		for ; y != to.Y; y += step(y, to.Y) {
+			carve(x, y)
+		}
+	} else {
+		for ; y != to.Y; y += step(y, to.Y) {
+			carve(x, y)
+		}
+		for ; x != to.X; x += step(x, to.X) {
+			carve(x, y)
+		}
+	}
+	carve(x, y)
+}
+
+// roomEntrances returns walkable tiles directly outside a room's edges
+// (excluding diagonal corners) through which the room can be entered.
+func roomEntrances(terr *terrain.Terrain, room *terrain.Room) []terrain.Point {
+	var entrances []terrain.Point
+	add := func(x, y int) {
+		if terr.IsWalkable(x, y) {
+			entrances = append(entrances, terrain.Point{X: x, Y: y})
+		}
+	}
+	for x := room.X; x < room.X+room.Width; x++ {
+		add(x, room.Y-1)
+		add(x, room.Y+room.Height)
+	}
+	for y := room.Y; y < room.Y+room.Height; y++ {
+		add(room.X-1, y)
+		add(room.X+room.Width, y)
+	}
+	return entrances
+}
+
+// roomWalkableTile returns the walkable room tile nearest the room center.
+func roomWalkableTile(terr *terrain.Terrain, room *terrain.Room) (terrain.Point, bool) {
+	cx, cy := room.Center()
+	best := terrain.Point{}
+	bestDist := -1
+	for y := room.Y; y < room.Y+room.Height; y++ {
+		for x := room.X; x < room.X+room.Width; x++ {
+			if !terr.IsWalkable(x, y) {
+				continue
+			}
+			p := terrain.Point{X: x, Y: y}
+			if d := p.ManhattanDistance(terrain.Point{X: cx, Y: cy}); bestDist < 0 || d < bestDist {
+				best, bestDist = p, d
+			}
+		}
+	}
+	return best, bestDist >= 0
+}
+
+// randomFreeTile picks a random unoccupied walkable tile in the room.
+func randomFreeTile(world *World, room *terrain.Room, rng *rand.Rand) (int, int, bool) {
+	const attempts = 10
+	for i := 0; i < attempts; i++ {
+		x := room.X + rng.Intn(room.Width)
+		y := room.Y + rng.Intn(room.Height)
+		if world.Terrain.IsWalkable(x, y) && !world.isOccupied(x, y) {
+			return x, y, true
+		}
+	}
+	return 0, 0, false
+}
+
+// freeTileNearCenter returns the unoccupied walkable room tile nearest the center.
+func freeTileNearCenter(world *World, room *terrain.Room) (int, int, bool) {
+	cx, cy := room.Center()
+	center := terrain.Point{X: cx, Y: cy}
+	best := terrain.Point{}
+	bestDist := -1
+	for y := room.Y; y < room.Y+room.Height; y++ {
+		for x := room.X; x < room.X+room.Width; x++ {
+			if !world.Terrain.IsWalkable(x, y) || world.isOccupied(x, y) {
+				continue
+			}
+			p := terrain.Point{X: x, Y: y}
+			if d := p.ManhattanDistance(center); bestDist < 0 || d < bestDist {
+				best, bestDist = p, d
+			}
+		}
+	}
+	return best.X, best.Y, bestDist >= 0
+}
@@ -10,6 +10,9 @@
 //   - Thread-safe operations with fine-grained locking
 //   - Cache hit/miss statistics for monitoring
 //   - Configurable eviction callbacks for cleanup
+//   - Key namespaces (NamespacedKey) with bulk InvalidateNamespace/InvalidatePrefix
+//   - Optional per-entry TTL (PutWithTTL) with lazy expiry on access and Sweep
+//   - Warm pre-generates sprites (e.g. during a loading screen) so first draws hit
 //
 // Usage:
 //
@@ -25,6 +28,12 @@
 //	img := generateSprite(seed, "idle", 0)
 //	cache.Put(key, img)
 //
+//	// Pre-generate sprites for a new area
+//	warm := cache.Warm(keys, func(key cache.CacheKey) *ebiten.Image {
+//	    return generateSpriteForKey(key)
+//	})
+//	fmt.Printf("Warmed %d sprites in %v\n", warm.Generated, warm.Duration)
+//
 //	// Check statistics
 //	stats := cache.Stats()
 //	fmt.Printf("Hit rate: %.2f%%\n", stats.HitRate()*100)
@@ -4,7 +4,9 @@ import (
 	"container/list"
 	"fmt"
 	"hash/fnv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -12,6 +14,9 @@ import (
 // CacheKey represents a unique identifier for a cached sprite.
 type CacheKey string
 
+// NamespaceSeparator separates a key's namespace from the rest of the key.
+const NamespaceSeparator = ":"
+
 // GenerateKey creates a cache key from seed, state, and frame information.
 func GenerateKey(seed int64, state string, frame int) CacheKey {
 	return CacheKey(fmt.Sprintf("%d:%s:%d", seed, state, frame))
@@ -27,20 +32,40 @@ func GenerateCompositeKey(seed int64, layers []string) CacheKey {
 	return CacheKey(fmt.Sprintf("composite:%x", h.Sum64()))
 }
 
+// NamespacedKey prefixes key with a namespace (such as a genre ID), so all
+// of the namespace's sprites can be dropped at once with InvalidateNamespace.
+func NamespacedKey(namespace string, key CacheKey) CacheKey {
+	return CacheKey(namespace + NamespaceSeparator + string(key))
+}
+
+// expired reports whether the entry's TTL has run out at now.
+func (e *entry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
 // entry represents a single cache entry with its metadata.
 type entry struct {
 	key   CacheKey
 	image *ebiten.Image
 	size  int64 // Estimated size in bytes
+
+	// expires is when the entry's TTL runs out (zero for no expiry)
+	expires time.Time
+
+	// warming counts in-progress Warm calls that inserted this entry;
+	// warming entries are never evicted
+	warming int
 }
 
 // Statistics holds cache performance metrics.
 type Statistics struct {
-	Hits       uint64
-	Misses     uint64
-	Evictions  uint64
-	TotalSize  int64
-	EntryCount int
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64 // Entries removed because their TTL ran out
+	Warmed      uint64 // Entries inserted by Warm
+	TotalSize   int64
+	EntryCount  int
 }
 
 // HitRate returns the cache hit rate as a value between 0.0 and 1.0.
@@ -65,6 +90,9 @@ type SpriteCache struct {
 
 	// Statistics
 	stats Statistics
+
+	// now returns the current time, replaceable for testing
+	now func() time.Time
 }
 
 // NewSpriteCache creates a new sprite cache with the specified maximum size in bytes.
@@ -73,16 +101,24 @@ func NewSpriteCache(maxSize int64) *SpriteCache {
 		cache:   make(map[CacheKey]*list.Element),
 		lru:     list.New(),
 		maxSize: maxSize,
+		now:     time.Now,
 	}
 }
 
 // Get retrieves a sprite from the cache.
-// Returns (image, true) if found, (nil, false) if not found.
+// Returns (image, true) if found, (nil, false) if not found or expired.
+// Expired entries are removed.
 func (c *SpriteCache) Get(key CacheKey) (*ebiten.Image, bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	if elem, ok := c.cache[key]; ok {
+		if elem.Value.(*entry).expired(c.now()) {
+			c.expireElement(elem)
+			c.stats.Misses++
+			return nil, false
+		}
+
 		// Move to front (most recently used)
 		c.lru.MoveToFront(elem)
 		c.stats.Hits++
@@ -93,28 +129,45 @@ func (c *SpriteCache) Get(key CacheKey) (*ebiten.Image, bool) {
 	return nil, false
 }
 
-// Put adds a sprite to the cache.
+// Put adds a sprite to the cache with no expiry.
 // If the cache is full, it evicts the least recently used entries.
 func (c *SpriteCache) Put(key CacheKey, img *ebiten.Image) {
+	c.PutWithTTL(key, img, 0)
+}
+
+// PutWithTTL adds a sprite to the cache that expires after ttl, for
+// transient sprites that shouldn't linger. A ttl of zero or less means no
+// expiry. Expired entries are removed when accessed or by Sweep.
+func (c *SpriteCache) PutWithTTL(key CacheKey, img *ebiten.Image, ttl time.Duration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.now().Add(ttl)
+	}
+
 	// Check if already in cache
 	if elem, ok := c.cache[key]; ok {
 		// Update existing entry and move to front
 		c.lru.MoveToFront(elem)
-		elem.Value.(*entry).image = img
+		e := elem.Value.(*entry)
+		e.image = img
+		e.expires = expires
 		return
 	}
 
-	// Estimate size (width * height * 4 bytes per pixel for RGBA)
-	bounds := img.Bounds()
-	size := int64(bounds.Dx() * bounds.Dy() * 4)
+	size := imageSize(img)
 
 	// Evict entries if necessary
 	c.evictIfNeeded(size)
 
-	// Add new entry
+	elem := c.insert(key, img, size)
+	elem.Value.(*entry).expires = expires
+}
+
+// insert adds a new entry at the front of the LRU list.
+func (c *SpriteCache) insert(key CacheKey, img *ebiten.Image, size int64) *list.Element {
 	e := &entry{
 		key:   key,
 		image: img,
@@ -124,27 +177,70 @@ func (c *SpriteCache) Put(key CacheKey, img *ebiten.Image) {
 	c.cache[key] = elem
 	c.stats.TotalSize += size
 	c.stats.EntryCount++
+	return elem
 }
 
-// evictIfNeeded removes least recently used entries until there's enough space.
-func (c *SpriteCache) evictIfNeeded(requiredSize int64) {
-	for c.stats.TotalSize+requiredSize > c.maxSize && c.lru.Len() > 0 {
-		// Remove least recently used (back of list)
-		elem := c.lru.Back()
-		if elem != nil {
+// imageSize estimates an image's size (width * height * 4 bytes per pixel
+// for RGBA).
+func imageSize(img *ebiten.Image) int64 {
+	bounds := img.Bounds()
+	return int64(bounds.Dx() * bounds.Dy() * 4)
+}
+
+// evictIfNeeded removes least recently used entries until there's enough
+// space, skipping entries that are being warmed. Reports whether the
+// required size fits afterwards.
+func (c *SpriteCache) evictIfNeeded(requiredSize int64) bool {
+	elem := c.lru.Back()
+	for c.stats.TotalSize+requiredSize > c.maxSize && elem != nil {
+		prev := elem.Prev()
+		if elem.Value.(*entry).warming == 0 {
 			c.removeElement(elem)
 		}
+		elem = prev
 	}
+	return c.stats.TotalSize+requiredSize <= c.maxSize
 }
 
-// removeElement removes a specific element from the cache.
+// removeElement removes a specific element from the cache, counting it as an
+// eviction.
 func (c *SpriteCache) removeElement(elem *list.Element) {
+	c.unlink(elem)
+	c.stats.Evictions++
+}
+
+// expireElement removes an element whose TTL ran out, counting it as an
+// expiration.
+func (c *SpriteCache) expireElement(elem *list.Element) {
+	c.unlink(elem)
+	c.stats.Expirations++
+}
+
+// unlink removes an element from the cache storage and size accounting.
+func (c *SpriteCache) unlink(elem *list.Element) {
 	c.lru.Remove(elem)
 	e := elem.Value.(*entry)
 	delete(c.cache, e.key)
 	c.stats.TotalSize -= e.size
 	c.stats.EntryCount--
-	c.stats.Evictions++
+}
+
+// Sweep removes every expired entry and returns how many were removed.
+func (c *SpriteCache) Sweep() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	removed := 0
+	for elem := c.lru.Back(); elem != nil; {
+		prev := elem.Prev()
+		if elem.Value.(*entry).expired(now) {
+			c.expireElement(elem)
+			removed++
+		}
+		elem = prev
+	}
+	return removed
 }
 
 // Clear removes all entries from the cache.
@@ -205,10 +301,33 @@ func (c *SpriteCache) Remove(key CacheKey) bool {
 	return false
 }
 
-// Contains checks if a key exists in the cache without affecting LRU order.
+// InvalidatePrefix removes every entry whose key starts with prefix and
+// returns how many were removed.
+func (c *SpriteCache) InvalidatePrefix(prefix string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, elem := range c.cache {
+		if strings.HasPrefix(string(key), prefix) {
+			c.removeElement(elem)
+			removed++
+		}
+	}
+	return removed
+}
+
+// InvalidateNamespace removes every entry keyed with NamespacedKey under
+// namespace and returns how many were removed.
+func (c *SpriteCache) InvalidateNamespace(namespace string) int {
+	return c.InvalidatePrefix(namespace + NamespaceSeparator)
+}
+
+// Contains checks if an unexpired key exists in the cache without affecting
+// LRU order.
 func (c *SpriteCache) Contains(key CacheKey) bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	_, ok := c.cache[key]
-	return ok
+	elem, ok := c.cache[key]
+	return ok && !elem.Value.(*entry).expired(c.now())
 }
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -237,6 +238,121 @@ func TestSpriteCache_Contains(t *testing.T) {
 	}
 }
 
+func TestNamespacedKey(t *testing.T) {
+	got := NamespacedKey("fantasy", GenerateKey(12345, "idle", 0))
+	if want := CacheKey("fantasy:12345:idle:0"); got != want {
+		t.Errorf("NamespacedKey() = %v, want %v", got, want)
+	}
+}
+
+func TestSpriteCache_InvalidatePrefix(t *testing.T) {
+	cache := NewSpriteCache(10 * 1024 * 1024)
+	img := ebiten.NewImage(8, 8)
+
+	fantasy := []CacheKey{
+		NamespacedKey("fantasy", GenerateKey(1, "idle", 0)),
+		NamespacedKey("fantasy", GenerateKey(2, "walk", 1)),
+	}
+	shared := GenerateKey(1, "idle", 0)
+	scifi := NamespacedKey("scifi", GenerateKey(1, "idle", 0))
+	lookalike := NamespacedKey("fantasy2", GenerateKey(1, "idle", 0))
+	for _, key := range append(fantasy, shared, scifi, lookalike) {
+		cache.Put(key, img)
+	}
+
+	if removed := cache.InvalidateNamespace("fantasy"); removed != 2 {
+		t.Errorf("InvalidateNamespace() = %d, want 2", removed)
+	}
+	for _, key := range fantasy {
+		if cache.Contains(key) {
+			t.Errorf("%s should have been invalidated", key)
+		}
+	}
+	for _, key := range []CacheKey{shared, scifi, lookalike} {
+		if !cache.Contains(key) {
+			t.Errorf("%s should have been kept", key)
+		}
+	}
+	if cache.Count() != 3 || cache.Size() != 3*8*8*4 {
+		t.Errorf("Count = %d, Size = %d after invalidation", cache.Count(), cache.Size())
+	}
+
+	if removed := cache.InvalidatePrefix("scifi:"); removed != 1 {
+		t.Errorf("InvalidatePrefix() = %d, want 1", removed)
+	}
+	if removed := cache.InvalidatePrefix("missing:"); removed != 0 {
+		t.Errorf("InvalidatePrefix() with no matches = %d, want 0", removed)
+	}
+}
+
+func TestSpriteCache_PutWithTTL(t *testing.T) {
+	cache := NewSpriteCache(10 * 1024 * 1024)
+	now := time.Unix(1000, 0)
+	cache.now = func() time.Time { return now }
+
+	transient := GenerateKey(1, "spark", 0)
+	permanent := GenerateKey(2, "idle", 0)
+	cache.PutWithTTL(transient, ebiten.NewImage(16, 16), time.Second)
+	cache.Put(permanent, ebiten.NewImage(16, 16))
+
+	now = now.Add(999 * time.Millisecond)
+	if _, ok := cache.Get(transient); !ok {
+		t.Error("entry should not expire before its TTL")
+	}
+
+	now = now.Add(time.Millisecond)
+	if cache.Contains(transient) {
+		t.Error("Contains should report expired entries as missing")
+	}
+	if _, ok := cache.Get(transient); ok {
+		t.Error("Get should miss once the TTL runs out")
+	}
+	if _, ok := cache.Get(permanent); !ok {
+		t.Error("entries without a TTL should never expire")
+	}
+
+	stats := cache.Stats()
+	if stats.Expirations != 1 || stats.Evictions != 0 {
+		t.Errorf("Expirations = %d, Evictions = %d, want 1 and 0", stats.Expirations, stats.Evictions)
+	}
+	if stats.EntryCount != 1 || stats.TotalSize != 16*16*4 {
+		t.Errorf("EntryCount = %d, TotalSize = %d after expiry", stats.EntryCount, stats.TotalSize)
+	}
+
+	// Putting again without a TTL clears the expiry
+	cache.PutWithTTL(transient, ebiten.NewImage(16, 16), time.Second)
+	cache.Put(transient, ebiten.NewImage(16, 16))
+	now = now.Add(time.Hour)
+	if !cache.Contains(transient) {
+		t.Error("Put should clear a previous TTL")
+	}
+}
+
+func TestSpriteCache_Sweep(t *testing.T) {
+	cache := NewSpriteCache(10 * 1024 * 1024)
+	now := time.Unix(1000, 0)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 5; i++ {
+		cache.PutWithTTL(GenerateKey(int64(i), "spark", 0), ebiten.NewImage(8, 8), time.Duration(i+1)*time.Second)
+	}
+	cache.Put(GenerateKey(99, "idle", 0), ebiten.NewImage(8, 8))
+
+	now = now.Add(3 * time.Second)
+	if removed := cache.Sweep(); removed != 3 {
+		t.Errorf("Sweep() = %d, want 3", removed)
+	}
+	if cache.Count() != 3 {
+		t.Errorf("Count = %d, want 3", cache.Count())
+	}
+	if removed := cache.Sweep(); removed != 0 {
+		t.Errorf("second Sweep() = %d, want 0", removed)
+	}
+	if got := cache.Stats().Expirations; got != 3 {
+		t.Errorf("Expirations = %d, want 3", got)
+	}
+}
+
 func TestStatistics_HitRate(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// WarmupStats reports the outcome of a Warm call.
+type WarmupStats struct {
+	Requested     int           // Keys passed to Warm
+	Generated     int           // Entries generated and inserted
+	AlreadyCached int           // Keys that were cached before generation
+	Skipped       int           // Keys whose image was nil or didn't fit
+	Duration      time.Duration // Total time spent warming
+}
+
+// Warm pre-generates sprites for keys that aren't cached yet and inserts
+// them, so later Get calls hit. It's meant for loading screens, before the
+// sprites are first drawn.
+//
+// gen runs without the cache lock held, so Get and Put from other goroutines
+// aren't blocked while sprites generate. Entries inserted by this call are
+// not evicted to make room for each other: once the cache is full of them,
+// the remaining keys are skipped rather than evicting earlier ones. Warmed
+// entries become ordinary LRU entries when Warm returns.
+func (c *SpriteCache) Warm(keys []CacheKey, gen func(key CacheKey) *ebiten.Image) WarmupStats {
+	start := time.Now()
+	stats := WarmupStats{Requested: len(keys)}
+
+	var warmed []*list.Element
+	defer func() {
+		c.mu.Lock()
+		for _, elem := range warmed {
+			elem.Value.(*entry).warming--
+		}
+		c.mu.Unlock()
+	}()
+
+	for _, key := range keys {
+		if c.Contains(key) {
+			stats.AlreadyCached++
+			continue
+		}
+
+		img := gen(key)
+		if img == nil {
+			stats.Skipped++
+			continue
+		}
+
+		if elem := c.insertWarmed(key, img); elem != nil {
+			warmed = append(warmed, elem)
+			stats.Generated++
+		} else if c.Contains(key) {
+			// Another goroutine cached it while we were generating
+			stats.AlreadyCached++
+		} else {
+			stats.Skipped++
+		}
+	}
+
+	stats.Duration = time.Since(start)
+	return stats
+}
+
+// insertWarmed inserts a warmed entry and marks it as warming, replacing an
+// expired entry for the key. Returns nil if the key is already cached or the
+// image doesn't fit.
+func (c *SpriteCache) insertWarmed(key CacheKey, img *ebiten.Image) *list.Element {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.cache[key]; ok {
+		if !elem.Value.(*entry).expired(c.now()) {
+			return nil
+		}
+		c.expireElement(elem)
+	}
+
+	size := imageSize(img)
+	if !c.evictIfNeeded(size) {
+		return nil
+	}
+
+	elem := c.insert(key, img, size)
+	elem.Value.(*entry).warming++
+	c.stats.Warmed++
+	return elem
+}
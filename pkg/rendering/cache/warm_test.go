@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestSpriteCache_Warm(t *testing.T) {
+	cache := NewSpriteCache(10 * 1024 * 1024)
+	existing := ebiten.NewImage(16, 16)
+	cache.Put(GenerateKey(1, "idle", 0), existing)
+
+	keys := []CacheKey{
+		GenerateKey(1, "idle", 0),
+		GenerateKey(2, "idle", 0),
+		GenerateKey(3, "idle", 0),
+	}
+	var generated []CacheKey
+	stats := cache.Warm(keys, func(key CacheKey) *ebiten.Image {
+		generated = append(generated, key)
+		return ebiten.NewImage(16, 16)
+	})
+
+	if stats.Requested != 3 || stats.Generated != 2 || stats.AlreadyCached != 1 || stats.Skipped != 0 {
+		t.Errorf("Warm() stats = %+v, want 3 requested, 2 generated, 1 already cached", stats)
+	}
+	if len(generated) != 2 {
+		t.Errorf("gen called %d times, want 2", len(generated))
+	}
+
+	// Warmed keys should hit
+	for _, key := range keys {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("Get(%s) missed after Warm", key)
+		}
+	}
+	if img, _ := cache.Get(keys[0]); img != existing {
+		t.Error("Warm replaced an already cached image")
+	}
+	if got := cache.Stats().Warmed; got != 2 {
+		t.Errorf("Stats().Warmed = %d, want 2", got)
+	}
+}
+
+func TestSpriteCache_Warm_NilImage(t *testing.T) {
+	cache := NewSpriteCache(10 * 1024 * 1024)
+	stats := cache.Warm([]CacheKey{"missing"}, func(CacheKey) *ebiten.Image { return nil })
+
+	if stats.Skipped != 1 || stats.Generated != 0 {
+		t.Errorf("Warm() stats = %+v, want 1 skipped", stats)
+	}
+	if cache.Contains("missing") {
+		t.Error("nil image should not be cached")
+	}
+}
+
+func TestSpriteCache_Warm_RespectsSizeLimit(t *testing.T) {
+	// Room for two 32x32 images
+	imageSize := int64(32 * 32 * 4)
+	cache := NewSpriteCache(imageSize * 2)
+	old := GenerateKey(0, "idle", 0)
+	cache.Put(old, ebiten.NewImage(32, 32))
+
+	keys := []CacheKey{
+		GenerateKey(1, "idle", 0),
+		GenerateKey(2, "idle", 0),
+		GenerateKey(3, "idle", 0),
+	}
+	stats := cache.Warm(keys, func(CacheKey) *ebiten.Image {
+		return ebiten.NewImage(32, 32)
+	})
+
+	// The old entry makes room, but warmed entries don't evict each other
+	if stats.Generated != 2 || stats.Skipped != 1 {
+		t.Errorf("Warm() stats = %+v, want 2 generated, 1 skipped", stats)
+	}
+	if cache.Contains(old) {
+		t.Error("old entry should have been evicted")
+	}
+	if !cache.Contains(keys[0]) || !cache.Contains(keys[1]) {
+		t.Error("earlier warmed entries should not be evicted by later ones")
+	}
+	if cache.Size() > cache.MaxSize() {
+		t.Errorf("Size = %d exceeds MaxSize %d", cache.Size(), cache.MaxSize())
+	}
+
+	// After warming, entries are evictable again
+	cache.Put(keys[2], ebiten.NewImage(32, 32))
+	if cache.Contains(keys[0]) {
+		t.Error("warmed entries should be evictable after Warm returns")
+	}
+}
+
+func TestSpriteCache_Warm_Concurrent(t *testing.T) {
+	cache := NewSpriteCache(10 * 1024 * 1024)
+	keys := make([]CacheKey, 20)
+	for i := range keys {
+		keys[i] = GenerateKey(int64(i), "idle", 0)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache.Warm(keys, func(CacheKey) *ebiten.Image {
+				return ebiten.NewImage(8, 8)
+			})
+		}()
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			_, _ = cache.Get(keys[id])
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.Count() != len(keys) {
+		t.Errorf("Count = %d, want %d", cache.Count(), len(keys))
+	}
+}
@@ -0,0 +1,154 @@
+// Package palette provides color palette generation.
+// This file implements palette variants derived in HSL space, such as
+// darker night palettes or desaturated status-effect tints.
+package palette
+
+import (
+	"image/color"
+	"math"
+)
+
+// Darken returns a copy of the palette with every color's lightness reduced
+// by amount (0-1).
+func (p *Palette) Darken(amount float64) *Palette {
+	return p.adjust(func(h, s, l float64) (float64, float64, float64) {
+		return h, s, clamp(l-amount, 0, 1)
+	})
+}
+
+// Lighten returns a copy of the palette with every color's lightness
+// increased by amount (0-1).
+func (p *Palette) Lighten(amount float64) *Palette {
+	return p.adjust(func(h, s, l float64) (float64, float64, float64) {
+		return h, s, clamp(l+amount, 0, 1)
+	})
+}
+
+// Desaturate returns a copy of the palette with every color's saturation
+// reduced by amount (0-1). An amount of 1 gives greys.
+func (p *Palette) Desaturate(amount float64) *Palette {
+	return p.adjust(func(h, s, l float64) (float64, float64, float64) {
+		return h, clamp(s-amount, 0, 1), l
+	})
+}
+
+// ShiftHue returns a copy of the palette with every color's hue rotated by
+// degrees. Negative values rotate the other way.
+func (p *Palette) ShiftHue(degrees float64) *Palette {
+	return p.adjust(func(h, s, l float64) (float64, float64, float64) {
+		return math.Mod(math.Mod(h+degrees, 360)+360, 360), s, l
+	})
+}
+
+// Complementary returns a copy of the palette with every color replaced by
+// its complement (hue rotated 180°).
+func (p *Palette) Complementary() *Palette {
+	return p.ShiftHue(180)
+}
+
+// adjust returns a copy of the palette with fn applied to the HSL values of
+// every color. Alpha is preserved and nil colors stay nil.
+func (p *Palette) adjust(fn func(h, s, l float64) (float64, float64, float64)) *Palette {
+	return p.mapColors(func(c color.Color) color.Color {
+		h, s, l, a := colorToHSL(c)
+		h, s, l = fn(h, s, l)
+		return hslaToColor(h, s, l, a)
+	})
+}
+
+// mapColors returns a copy of the palette with fn applied to every color.
+// Nil colors stay nil.
+func (p *Palette) mapColors(fn func(c color.Color) color.Color) *Palette {
+	apply := func(c color.Color) color.Color {
+		if c == nil {
+			return nil
+		}
+		return fn(c)
+	}
+
+	result := &Palette{
+		Primary:    apply(p.Primary),
+		Secondary:  apply(p.Secondary),
+		Background: apply(p.Background),
+		Text:       apply(p.Text),
+		Accent1:    apply(p.Accent1),
+		Accent2:    apply(p.Accent2),
+		Accent3:    apply(p.Accent3),
+		Highlight1: apply(p.Highlight1),
+		Highlight2: apply(p.Highlight2),
+		Shadow1:    apply(p.Shadow1),
+		Shadow2:    apply(p.Shadow2),
+		Neutral:    apply(p.Neutral),
+		Danger:     apply(p.Danger),
+		Success:    apply(p.Success),
+		Warning:    apply(p.Warning),
+		Info:       apply(p.Info),
+	}
+	if p.Colors != nil {
+		result.Colors = make([]color.Color, len(p.Colors))
+		for i, c := range p.Colors {
+			result.Colors[i] = apply(c)
+		}
+	}
+	return result
+}
+
+// colorToHSL converts a color to HSL (h: 0-360, s: 0-1, l: 0-1) and its
+// alpha (0-255).
+func colorToHSL(c color.Color) (h, s, l float64, a uint8) {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	r, g, b := float64(n.R)/255, float64(n.G)/255, float64(n.B)/255
+
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+	l = (maxC + minC) / 2
+
+	delta := maxC - minC
+	if delta == 0 {
+		return 0, 0, l, n.A
+	}
+
+	if l < 0.5 {
+		s = delta / (maxC + minC)
+	} else {
+		s = delta / (2 - maxC - minC)
+	}
+
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/delta+6, 6)
+	case g:
+		h = (b-r)/delta + 2
+	default:
+		h = (r-g)/delta + 4
+	}
+	return h * 60, s, l, n.A
+}
+
+// hslaToColor converts HSL back to a color with the given alpha, rounding
+// so that an unchanged color converts back exactly.
+func hslaToColor(h, s, l float64, a uint8) color.Color {
+	h = math.Mod(h, 360) / 360
+
+	r, g, b := l, l, l
+	if s != 0 {
+		var q float64
+		if l < 0.5 {
+			q = l * (1 + s)
+		} else {
+			q = l + s - l*s
+		}
+		p := 2*l - q
+
+		r = hueToRGB(p, q, h+1.0/3.0)
+		g = hueToRGB(p, q, h)
+		b = hueToRGB(p, q, h-1.0/3.0)
+	}
+
+	return color.NRGBA{
+		R: uint8(clamp(math.Round(r*255), 0, 255)),
+		G: uint8(clamp(math.Round(g*255), 0, 255)),
+		B: uint8(clamp(math.Round(b*255), 0, 255)),
+		A: a,
+	}
+}
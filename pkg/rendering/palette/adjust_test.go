@@ -0,0 +1,136 @@
+package palette
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+// hslOf returns the HSL values of c, for comparing adjusted colors.
+func hslOf(c color.Color) (h, s, l float64) {
+	h, s, l, _ = colorToHSL(c)
+	return h, s, l
+}
+
+func TestColorToHSL_RoundTrip(t *testing.T) {
+	colors := []color.NRGBA{
+		{R: 255, G: 0, B: 0, A: 255},
+		{R: 12, G: 200, B: 90, A: 255},
+		{R: 40, G: 60, B: 230, A: 128},
+		{R: 128, G: 128, B: 128, A: 255},
+		{R: 255, G: 255, B: 255, A: 255},
+		{R: 0, G: 0, B: 0, A: 0},
+	}
+	for _, c := range colors {
+		h, s, l, a := colorToHSL(c)
+		if got := hslaToColor(h, s, l, a); got != c {
+			t.Errorf("round trip of %v = %v", c, got)
+		}
+	}
+
+	if h, s, l := hslOf(color.RGBA{R: 0, G: 255, B: 0, A: 255}); h != 120 || s != 1 || l != 0.5 {
+		t.Errorf("green = (%.1f, %.2f, %.2f), want (120, 1, 0.5)", h, s, l)
+	}
+}
+
+func TestPalette_Adjustments(t *testing.T) {
+	p := &Palette{
+		Primary: color.RGBA{R: 200, G: 60, B: 40, A: 255},
+		Colors:  []color.Color{color.RGBA{R: 40, G: 90, B: 160, A: 255}},
+	}
+	h0, s0, l0 := hslOf(p.Primary)
+	const tolerance = 0.01
+
+	tests := []struct {
+		name         string
+		adjusted     *Palette
+		wantH, wantS float64
+		wantL        float64
+	}{
+		{"darken", p.Darken(0.2), h0, s0, l0 - 0.2},
+		{"lighten", p.Lighten(0.2), h0, s0, l0 + 0.2},
+		{"desaturate", p.Desaturate(0.3), h0, s0 - 0.3, l0},
+		{"shift hue", p.ShiftHue(90), math.Mod(h0+90, 360), s0, l0},
+		{"complementary", p.Complementary(), math.Mod(h0+180, 360), s0, l0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, s, l := hslOf(tt.adjusted.Primary)
+			if math.Abs(h-tt.wantH) > 1 {
+				t.Errorf("hue = %.1f, want %.1f", h, tt.wantH)
+			}
+			if math.Abs(s-tt.wantS) > tolerance {
+				t.Errorf("saturation = %.3f, want %.3f", s, tt.wantS)
+			}
+			if math.Abs(l-tt.wantL) > tolerance {
+				t.Errorf("lightness = %.3f, want %.3f", l, tt.wantL)
+			}
+			if len(tt.adjusted.Colors) != 1 || tt.adjusted.Colors[0] == p.Colors[0] {
+				t.Error("theme colors should be adjusted too")
+			}
+		})
+	}
+}
+
+func TestPalette_AdjustmentsArePure(t *testing.T) {
+	original := color.RGBA{R: 200, G: 60, B: 40, A: 255}
+	p := &Palette{Primary: original, Colors: []color.Color{original}}
+
+	dark := p.Darken(0.3)
+	if p.Primary != original || p.Colors[0] != original {
+		t.Error("Darken modified the source palette")
+	}
+	if dark.Primary != p.Darken(0.3).Primary {
+		t.Error("Darken is not deterministic")
+	}
+	if dark.Secondary != nil {
+		t.Error("unset colors should stay nil")
+	}
+
+	// Opposite adjustments cancel out when nothing is clamped
+	back := p.ShiftHue(-75).ShiftHue(75)
+	if back.Primary != color.NRGBAModel.Convert(original) {
+		t.Errorf("shifting hue back = %v, want %v", back.Primary, original)
+	}
+}
+
+func TestPalette_AdjustmentLimits(t *testing.T) {
+	p := &Palette{
+		Primary:   color.RGBA{R: 200, G: 60, B: 40, A: 255},
+		Secondary: color.NRGBA{R: 40, G: 90, B: 160, A: 100},
+	}
+
+	if got := color.NRGBAModel.Convert(p.Darken(1).Primary); got != (color.NRGBA{A: 255}) {
+		t.Errorf("fully darkened = %v, want black", got)
+	}
+	if got := color.NRGBAModel.Convert(p.Lighten(1).Primary); got != (color.NRGBA{R: 255, G: 255, B: 255, A: 255}) {
+		t.Errorf("fully lightened = %v, want white", got)
+	}
+	grey := color.NRGBAModel.Convert(p.Desaturate(1).Primary).(color.NRGBA)
+	if grey.R != grey.G || grey.G != grey.B {
+		t.Errorf("fully desaturated = %v, want grey", grey)
+	}
+	if got := color.NRGBAModel.Convert(p.Darken(0.1).Secondary).(color.NRGBA); got.A != 100 {
+		t.Errorf("alpha = %d, want 100", got.A)
+	}
+}
+
+func TestPalette_AdjustGenerated(t *testing.T) {
+	gen := NewGenerator()
+	p, err := gen.Generate("fantasy", 12345)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	night := p.Darken(0.25)
+	if len(night.Colors) != len(p.Colors) {
+		t.Fatalf("night palette has %d colors, want %d", len(night.Colors), len(p.Colors))
+	}
+	for i := range p.Colors {
+		_, _, before := hslOf(p.Colors[i])
+		_, _, after := hslOf(night.Colors[i])
+		if after > before {
+			t.Errorf("color %d got lighter: %.2f -> %.2f", i, before, after)
+		}
+	}
+}
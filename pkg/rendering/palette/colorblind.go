@@ -0,0 +1,160 @@
+// Package palette provides color palette generation.
+// This file implements color vision deficiency simulation and colorblind-safe
+// palette adaptation.
+package palette
+
+import (
+	"image/color"
+	"math"
+)
+
+// colorblindMatrices simulate full dichromacy in linear RGB (Machado,
+// Oliveira and Fernandes, 2009, severity 1.0).
+var colorblindMatrices = map[ColorblindMode][3][3]float64{
+	ColorblindProtanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	ColorblindDeuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	ColorblindTritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// colorblindMinDistance is the CIE76 distance at which two simulated colors
+// count as clearly distinguishable; hues are only moved until this is met.
+const colorblindMinDistance = 25.0
+
+// colorblindHueStep is the granularity in degrees of the hue search.
+const colorblindHueStep = 15.0
+
+// SimulateColorblind returns a copy of the palette as it appears with the
+// given color vision deficiency. ColorblindNone returns an unchanged copy.
+func (p *Palette) SimulateColorblind(mode ColorblindMode) *Palette {
+	return p.mapColors(func(c color.Color) color.Color {
+		return simulateColorblind(c, mode)
+	})
+}
+
+// simulateColorblind returns c as seen with the given deficiency.
+func simulateColorblind(c color.Color, mode ColorblindMode) color.Color {
+	m, ok := colorblindMatrices[mode]
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if !ok {
+		return n
+	}
+
+	r, g, b := srgbToLinear(n.R), srgbToLinear(n.G), srgbToLinear(n.B)
+	return color.NRGBA{
+		R: linearToSRGB(m[0][0]*r + m[0][1]*g + m[0][2]*b),
+		G: linearToSRGB(m[1][0]*r + m[1][1]*g + m[1][2]*b),
+		B: linearToSRGB(m[2][0]*r + m[2][1]*g + m[2][2]*b),
+		A: n.A,
+	}
+}
+
+// adaptForColorblind returns a copy of the palette with the hues of its
+// distinguishing colors moved apart as seen with the given deficiency.
+// Colors are placed in order of importance, UI feedback first; each keeps
+// its saturation and lightness and takes the smallest hue shift that is
+// clearly distinguishable from those already placed, or the most
+// distinguishable shift if none is. Background, text, shadow, highlight and
+// neutral colors differ by lightness and are left alone, as are the theme
+// colors, which are meant to blend.
+func adaptForColorblind(p *Palette, mode ColorblindMode) *Palette {
+	result := p.mapColors(func(c color.Color) color.Color { return c })
+
+	roles := []*color.Color{
+		&result.Danger, &result.Success, &result.Warning, &result.Info,
+		&result.Primary, &result.Secondary,
+		&result.Accent1, &result.Accent2, &result.Accent3,
+	}
+
+	var placed [][3]float64
+	for _, role := range roles {
+		if *role == nil {
+			continue
+		}
+		h, s, l, a := colorToHSL(*role)
+
+		best := *role
+		bestLab := colorToLab(simulateColorblind(best, mode))
+		bestScore := minLabDistance(bestLab, placed)
+
+		// Try shifts in order of size, alternating direction, so ties
+		// keep the color closest to the original
+		for step := 1; step*colorblindHueStep <= 180 && bestScore < colorblindMinDistance; step++ {
+			for _, sign := range []float64{1, -1} {
+				candidate := hslaToColor(math.Mod(h+sign*float64(step)*colorblindHueStep+360, 360), s, l, a)
+				lab := colorToLab(simulateColorblind(candidate, mode))
+				if score := minLabDistance(lab, placed); score > bestScore {
+					best, bestLab, bestScore = candidate, lab, score
+				}
+			}
+		}
+
+		*role = best
+		placed = append(placed, bestLab)
+	}
+
+	return result
+}
+
+// minLabDistance returns the smallest CIE76 distance from lab to any of
+// others, or +Inf if there are none.
+func minLabDistance(lab [3]float64, others [][3]float64) float64 {
+	best := math.Inf(1)
+	for _, o := range others {
+		best = math.Min(best, math.Sqrt(
+			(lab[0]-o[0])*(lab[0]-o[0])+(lab[1]-o[1])*(lab[1]-o[1])+(lab[2]-o[2])*(lab[2]-o[2])))
+	}
+	return best
+}
+
+// colorToLab converts a color to CIELAB (D65 white point), where distances
+// approximate perceived differences.
+func colorToLab(c color.Color) [3]float64 {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	r, g, b := srgbToLinear(n.R), srgbToLinear(n.G), srgbToLinear(n.B)
+
+	x := (0.4124564*r + 0.3575761*g + 0.1804375*b) / 0.95047
+	y := 0.2126729*r + 0.7151522*g + 0.0721750*b
+	z := (0.0193339*r + 0.1191920*g + 0.9503041*b) / 1.08883
+
+	f := func(t float64) float64 {
+		if t > 0.008856 {
+			return math.Cbrt(t)
+		}
+		return 7.787*t + 16.0/116.0
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+	return [3]float64{116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)}
+}
+
+// srgbToLinear converts an sRGB channel to linear light (0-1).
+func srgbToLinear(v uint8) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB converts linear light to an sRGB channel, clamping out of
+// gamut values.
+func linearToSRGB(v float64) uint8 {
+	v = clamp(v, 0, 1)
+	if v <= 0.0031308 {
+		v *= 12.92
+	} else {
+		v = 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(v * 255))
+}
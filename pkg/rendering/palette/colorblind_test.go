@@ -0,0 +1,135 @@
+package palette
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+// simulatedDistance returns the perceptual distance between a and b as seen
+// with the given deficiency.
+func simulatedDistance(a, b color.Color, mode ColorblindMode) float64 {
+	return minLabDistance(
+		colorToLab(simulateColorblind(a, mode)),
+		[][3]float64{colorToLab(simulateColorblind(b, mode))},
+	)
+}
+
+// feedbackColors returns the colors that must stay distinguishable.
+func feedbackColors(p *Palette) []color.Color {
+	return []color.Color{p.Danger, p.Success, p.Warning, p.Info}
+}
+
+// minSimulatedDistance returns the closest pair distance among colors.
+func minSimulatedDistance(colors []color.Color, mode ColorblindMode) float64 {
+	best := math.Inf(1)
+	for i := range colors {
+		for j := i + 1; j < len(colors); j++ {
+			best = math.Min(best, simulatedDistance(colors[i], colors[j], mode))
+		}
+	}
+	return best
+}
+
+func TestColorblindMode_String(t *testing.T) {
+	tests := []struct {
+		mode ColorblindMode
+		want string
+	}{
+		{ColorblindNone, "None"},
+		{ColorblindProtanopia, "Protanopia"},
+		{ColorblindDeuteranopia, "Deuteranopia"},
+		{ColorblindTritanopia, "Tritanopia"},
+		{ColorblindMode(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.String(); got != tt.want {
+			t.Errorf("ColorblindMode(%d).String() = %q, want %q", tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestSimulateColorblind(t *testing.T) {
+	red := color.RGBA{R: 220, G: 30, B: 30, A: 255}
+	green := color.RGBA{R: 30, G: 200, B: 30, A: 255}
+	p := &Palette{Danger: red, Success: green, Neutral: color.RGBA{R: 128, G: 128, B: 128, A: 255}}
+
+	if got := p.SimulateColorblind(ColorblindNone); got.Danger != color.NRGBAModel.Convert(red) {
+		t.Errorf("no deficiency changed red to %v", got.Danger)
+	}
+
+	// Red and green are far apart normally but collapse for red-green
+	// deficiencies, and stay apart for tritanopia
+	normal := simulatedDistance(red, green, ColorblindNone)
+	for _, mode := range []ColorblindMode{ColorblindProtanopia, ColorblindDeuteranopia} {
+		if d := simulatedDistance(red, green, mode); d > normal/2 {
+			t.Errorf("%v: red-green distance = %.1f, want well below %.1f", mode, d, normal)
+		}
+	}
+	if d := simulatedDistance(red, green, ColorblindTritanopia); d < normal/2 {
+		t.Errorf("tritanopia: red-green distance = %.1f, want close to %.1f", d, normal)
+	}
+
+	// Greys look the same under every simulation
+	for _, mode := range []ColorblindMode{ColorblindProtanopia, ColorblindDeuteranopia, ColorblindTritanopia} {
+		grey := color.NRGBAModel.Convert(p.SimulateColorblind(mode).Neutral).(color.NRGBA)
+		if math.Abs(float64(grey.R)-128) > 1 || math.Abs(float64(grey.G)-128) > 1 || math.Abs(float64(grey.B)-128) > 1 {
+			t.Errorf("%v: grey simulated as %v", mode, grey)
+		}
+	}
+}
+
+func TestGenerateWithOptions_Colorblind(t *testing.T) {
+	gen := NewGenerator()
+	modes := []ColorblindMode{ColorblindProtanopia, ColorblindDeuteranopia, ColorblindTritanopia}
+
+	for _, mode := range modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			base, err := gen.Generate("fantasy", 42)
+			if err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+			opts := DefaultOptions()
+			opts.Colorblind = mode
+			safe, err := gen.GenerateWithOptions("fantasy", 42, opts)
+			if err != nil {
+				t.Fatalf("GenerateWithOptions failed: %v", err)
+			}
+
+			before := minSimulatedDistance(feedbackColors(base), mode)
+			after := minSimulatedDistance(feedbackColors(safe), mode)
+			if after < before || after < colorblindMinDistance {
+				t.Errorf("closest feedback colors = %.1f, was %.1f; want at least %.1f",
+					after, before, colorblindMinDistance)
+			}
+
+			// Lightness-based roles are untouched
+			if safe.Background != base.Background || safe.Text != base.Text {
+				t.Error("background and text should not change")
+			}
+
+			again, _ := gen.GenerateWithOptions("fantasy", 42, opts)
+			if again.Success != safe.Success || again.Primary != safe.Primary {
+				t.Error("colorblind generation is not deterministic")
+			}
+		})
+	}
+}
+
+func TestGenerateWithOptions_ColorblindRedGreen(t *testing.T) {
+	// Default danger red and success green are the classic problem pair
+	gen := NewGenerator()
+	base, _ := gen.Generate("fantasy", 7)
+	opts := DefaultOptions()
+	opts.Colorblind = ColorblindDeuteranopia
+	safe, _ := gen.GenerateWithOptions("fantasy", 7, opts)
+
+	before := simulatedDistance(base.Danger, base.Success, ColorblindDeuteranopia)
+	after := simulatedDistance(safe.Danger, safe.Success, ColorblindDeuteranopia)
+	if before >= colorblindMinDistance {
+		t.Fatalf("expected default red and green to be confusable, distance %.1f", before)
+	}
+	if after < colorblindMinDistance {
+		t.Errorf("danger-success distance = %.1f, want at least %.1f", after, colorblindMinDistance)
+	}
+}
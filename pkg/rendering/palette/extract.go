@@ -0,0 +1,248 @@
+// Package palette provides color palette generation.
+// This file implements palette extraction from reference images using
+// median-cut quantization.
+package palette
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+)
+
+// extractMaxSamples caps how many pixels of a large image are sampled
+// along each axis.
+const extractMaxSamples = 256
+
+// colorBin is a distinct image color and how many sampled pixels had it.
+type colorBin struct {
+	rgb   [3]uint8
+	count int
+}
+
+// colorCluster is a group of similar image colors from median cut.
+type colorCluster struct {
+	color      color.RGBA
+	count      int
+	saturation float64
+	lightness  float64
+}
+
+// ExtractFromImage builds a palette from the most representative colors of
+// img, for theming from a reference image or mood board. The image is
+// quantized to at most colorCount colors with median cut, which is
+// deterministic, so the same image always gives the same palette. Fully
+// transparent pixels are ignored.
+//
+// Roles are assigned from the clusters: the most common color becomes the
+// background, the most common vivid colors become primary, secondary and
+// accents, and the lightest and darkest become highlights and shadows.
+// Colors holds every cluster, most common first. UI feedback colors are the
+// same as for generated palettes.
+func ExtractFromImage(img image.Image, colorCount int) (*Palette, error) {
+	if img == nil {
+		return nil, fmt.Errorf("image is nil")
+	}
+	if colorCount < 1 {
+		return nil, fmt.Errorf("color count must be positive, got %d", colorCount)
+	}
+
+	bins := sampleColors(img)
+	if len(bins) == 0 {
+		return nil, fmt.Errorf("image has no opaque pixels")
+	}
+
+	clusters := medianCut(bins, colorCount)
+	return paletteFromClusters(clusters), nil
+}
+
+// sampleColors returns the distinct opaque colors of img with their counts,
+// sampling a grid of at most extractMaxSamples pixels per axis. Bins are
+// sorted by color so later steps are independent of map ordering.
+func sampleColors(img image.Image) []colorBin {
+	bounds := img.Bounds()
+	stepX := max(1, (bounds.Dx()+extractMaxSamples-1)/extractMaxSamples)
+	stepY := max(1, (bounds.Dy()+extractMaxSamples-1)/extractMaxSamples)
+
+	counts := make(map[[3]uint8]int)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += stepY {
+		for x := bounds.Min.X; x < bounds.Max.X; x += stepX {
+			n := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if n.A == 0 {
+				continue
+			}
+			counts[[3]uint8{n.R, n.G, n.B}]++
+		}
+	}
+
+	bins := make([]colorBin, 0, len(counts))
+	for rgb, count := range counts {
+		bins = append(bins, colorBin{rgb: rgb, count: count})
+	}
+	sort.Slice(bins, func(i, j int) bool { return lessRGB(bins[i].rgb, bins[j].rgb) })
+	return bins
+}
+
+// medianCut splits bins into at most n clusters by repeatedly cutting the
+// box with the widest channel range in two along that channel. Clusters are
+// returned most common first.
+func medianCut(bins []colorBin, n int) []colorCluster {
+	boxes := [][]colorBin{bins}
+	for len(boxes) < n {
+		// Split the box with the widest range; ties go to the earliest
+		widest, channel, widestRange := -1, 0, 0
+		for i, box := range boxes {
+			if len(box) < 2 {
+				continue
+			}
+			if c, r := widestChannel(box); r > widestRange {
+				widest, channel, widestRange = i, c, r
+			}
+		}
+		if widest < 0 {
+			break // Every box is a single color
+		}
+
+		box := boxes[widest]
+		sort.SliceStable(box, func(i, j int) bool { return box[i].rgb[channel] < box[j].rgb[channel] })
+
+		// Cutting at the weighted mean rather than the median keeps a
+		// dominant color in one piece instead of halving it
+		sum, total := 0, 0
+		for _, b := range box {
+			sum += int(b.rgb[channel]) * b.count
+			total += b.count
+		}
+		mean := float64(sum) / float64(total)
+		split := 1
+		for split < len(box)-1 && float64(box[split].rgb[channel]) <= mean {
+			split++
+		}
+
+		boxes[widest] = box[:split]
+		boxes = append(boxes, box[split:])
+	}
+
+	clusters := make([]colorCluster, len(boxes))
+	for i, box := range boxes {
+		clusters[i] = averageCluster(box)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		if clusters[i].count != clusters[j].count {
+			return clusters[i].count > clusters[j].count
+		}
+		return lessRGB(
+			[3]uint8{clusters[i].color.R, clusters[i].color.G, clusters[i].color.B},
+			[3]uint8{clusters[j].color.R, clusters[j].color.G, clusters[j].color.B},
+		)
+	})
+	return clusters
+}
+
+// widestChannel returns the RGB channel with the largest range in box and
+// that range.
+func widestChannel(box []colorBin) (channel, spread int) {
+	for c := 0; c < 3; c++ {
+		lo, hi := 255, 0
+		for _, b := range box {
+			lo = min(lo, int(b.rgb[c]))
+			hi = max(hi, int(b.rgb[c]))
+		}
+		if hi-lo > spread {
+			channel, spread = c, hi-lo
+		}
+	}
+	return channel, spread
+}
+
+// averageCluster returns the count-weighted mean color of box.
+func averageCluster(box []colorBin) colorCluster {
+	var sum [3]int
+	total := 0
+	for _, b := range box {
+		for c := 0; c < 3; c++ {
+			sum[c] += int(b.rgb[c]) * b.count
+		}
+		total += b.count
+	}
+
+	rgba := color.RGBA{A: 255}
+	rgba.R = uint8(math.Round(float64(sum[0]) / float64(total)))
+	rgba.G = uint8(math.Round(float64(sum[1]) / float64(total)))
+	rgba.B = uint8(math.Round(float64(sum[2]) / float64(total)))
+
+	_, s, l, _ := colorToHSL(rgba)
+	return colorCluster{color: rgba, count: total, saturation: s, lightness: l}
+}
+
+// paletteFromClusters assigns palette roles from clusters sorted most
+// common first.
+func paletteFromClusters(clusters []colorCluster) *Palette {
+	palette := &Palette{
+		Background: clusters[0].color,
+		Colors:     make([]color.Color, len(clusters)),
+	}
+	for i, c := range clusters {
+		palette.Colors[i] = c.color
+	}
+
+	// Prefer vivid colors for the main roles, weighted by how common they
+	// are; the background is only reused when nothing else exists
+	rest := clusters
+	if len(clusters) > 1 {
+		rest = clusters[1:]
+	}
+	vivid := append([]colorCluster(nil), rest...)
+	sort.SliceStable(vivid, func(i, j int) bool {
+		return float64(vivid[i].count)*(0.25+vivid[i].saturation) >
+			float64(vivid[j].count)*(0.25+vivid[j].saturation)
+	})
+	pick := func(i int) color.Color { return vivid[i%len(vivid)].color }
+	palette.Primary = pick(0)
+	palette.Secondary = pick(1)
+	palette.Accent1 = pick(2)
+	palette.Accent2 = pick(3)
+	palette.Accent3 = pick(4)
+
+	byLightness := append([]colorCluster(nil), clusters...)
+	sort.SliceStable(byLightness, func(i, j int) bool { return byLightness[i].lightness > byLightness[j].lightness })
+	last := len(byLightness) - 1
+	palette.Highlight1 = byLightness[0].color
+	palette.Highlight2 = byLightness[min(1, last)].color
+	palette.Shadow1 = byLightness[last].color
+	palette.Shadow2 = byLightness[max(last-1, 0)].color
+
+	neutral := clusters[0]
+	for _, c := range clusters[1:] {
+		if c.saturation < neutral.saturation {
+			neutral = c
+		}
+	}
+	palette.Neutral = neutral.color
+
+	// Text contrasts with the background, as for generated palettes
+	if clusters[0].lightness < 0.5 {
+		palette.Text = color.RGBA{R: 240, G: 240, B: 240, A: 255}
+	} else {
+		palette.Text = color.RGBA{R: 20, G: 20, B: 20, A: 255}
+	}
+
+	palette.Danger = hslToColor(0, 0.8, 0.5)
+	palette.Success = hslToColor(120, 0.7, 0.5)
+	palette.Warning = hslToColor(45, 0.9, 0.55)
+	palette.Info = hslToColor(200, 0.75, 0.5)
+
+	return palette
+}
+
+// lessRGB orders colors by red, then green, then blue.
+func lessRGB(a, b [3]uint8) bool {
+	if a[0] != b[0] {
+		return a[0] < b[0]
+	}
+	if a[1] != b[1] {
+		return a[1] < b[1]
+	}
+	return a[2] < b[2]
+}
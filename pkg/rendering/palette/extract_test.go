@@ -0,0 +1,137 @@
+package palette
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// moodBoard returns a 100x100 image that is 60% navy, 25% red and 15% gold,
+// with a little noise so each region holds several nearby colors.
+func moodBoard() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 100; x++ {
+			n := uint8((x*7 + y*3) % 5)
+			switch {
+			case y < 60:
+				img.Set(x, y, color.RGBA{R: 20 + n, G: 30 + n, B: 80 + n, A: 255})
+			case y < 85:
+				img.Set(x, y, color.RGBA{R: 200 + n, G: 30, B: 30 + n, A: 255})
+			default:
+				img.Set(x, y, color.RGBA{R: 240, G: 200 + n, B: 60, A: 255})
+			}
+		}
+	}
+	return img
+}
+
+// near reports whether c is within tolerance of want on every channel.
+func near(c color.Color, want color.RGBA, tolerance int) bool {
+	n := color.RGBAModel.Convert(c).(color.RGBA)
+	diff := func(a, b uint8) int {
+		if a > b {
+			return int(a - b)
+		}
+		return int(b - a)
+	}
+	return diff(n.R, want.R) <= tolerance && diff(n.G, want.G) <= tolerance && diff(n.B, want.B) <= tolerance
+}
+
+func TestExtractFromImage_Roles(t *testing.T) {
+	p, err := ExtractFromImage(moodBoard(), 3)
+	if err != nil {
+		t.Fatalf("ExtractFromImage failed: %v", err)
+	}
+
+	navy := color.RGBA{R: 22, G: 32, B: 82, A: 255}
+	red := color.RGBA{R: 202, G: 30, B: 32, A: 255}
+	gold := color.RGBA{R: 240, G: 202, B: 60, A: 255}
+
+	if len(p.Colors) != 3 {
+		t.Fatalf("got %d colors, want 3", len(p.Colors))
+	}
+	// Colors are ordered by how much of the image they cover
+	for i, want := range []color.RGBA{navy, red, gold} {
+		if !near(p.Colors[i], want, 4) {
+			t.Errorf("Colors[%d] = %v, want about %v", i, p.Colors[i], want)
+		}
+	}
+
+	if !near(p.Background, navy, 4) {
+		t.Errorf("Background = %v, want the dominant navy", p.Background)
+	}
+	if !near(p.Primary, red, 4) {
+		t.Errorf("Primary = %v, want the most common vivid color, red", p.Primary)
+	}
+	if !near(p.Secondary, gold, 4) {
+		t.Errorf("Secondary = %v, want gold", p.Secondary)
+	}
+	if !near(p.Highlight1, gold, 4) || !near(p.Shadow1, navy, 4) {
+		t.Errorf("Highlight1 = %v, Shadow1 = %v; want gold and navy", p.Highlight1, p.Shadow1)
+	}
+	if p.Text != (color.RGBA{R: 240, G: 240, B: 240, A: 255}) {
+		t.Errorf("Text = %v, want light text on a dark background", p.Text)
+	}
+	if p.Danger == nil || p.Success == nil || p.Warning == nil || p.Info == nil || p.Accent3 == nil {
+		t.Error("every role should be filled")
+	}
+}
+
+func TestExtractFromImage_ColorCount(t *testing.T) {
+	// A smooth gradient can be split into as many colors as requested
+	gradient := image.NewRGBA(image.Rect(0, 0, 64, 16))
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 64; x++ {
+			gradient.Set(x, y, color.RGBA{R: uint8(x * 4), G: uint8(y * 16), B: 128, A: 255})
+		}
+	}
+	for _, n := range []int{1, 4, 12} {
+		p, err := ExtractFromImage(gradient, n)
+		if err != nil {
+			t.Fatalf("ExtractFromImage(%d) failed: %v", n, err)
+		}
+		if len(p.Colors) != n {
+			t.Errorf("ExtractFromImage(%d) gave %d colors", n, len(p.Colors))
+		}
+	}
+
+	// An image with fewer distinct colors gives only those
+	two := image.NewRGBA(image.Rect(0, 0, 4, 1))
+	two.Set(0, 0, color.RGBA{R: 255, A: 255})
+	two.Set(1, 0, color.RGBA{B: 255, A: 255})
+	p, err := ExtractFromImage(two, 8)
+	if err != nil {
+		t.Fatalf("ExtractFromImage failed: %v", err)
+	}
+	if len(p.Colors) != 2 {
+		t.Errorf("got %d colors, want the 2 opaque colors", len(p.Colors))
+	}
+}
+
+func TestExtractFromImage_Deterministic(t *testing.T) {
+	img := moodBoard()
+	a, _ := ExtractFromImage(img, 6)
+	b, _ := ExtractFromImage(img, 6)
+
+	for i := range a.Colors {
+		if a.Colors[i] != b.Colors[i] {
+			t.Fatalf("Colors[%d] differs: %v vs %v", i, a.Colors[i], b.Colors[i])
+		}
+	}
+	if a.Primary != b.Primary || a.Background != b.Background || a.Neutral != b.Neutral {
+		t.Error("roles differ between runs")
+	}
+}
+
+func TestExtractFromImage_Errors(t *testing.T) {
+	if _, err := ExtractFromImage(nil, 4); err == nil {
+		t.Error("expected error for nil image")
+	}
+	if _, err := ExtractFromImage(moodBoard(), 0); err == nil {
+		t.Error("expected error for zero color count")
+	}
+	if _, err := ExtractFromImage(image.NewRGBA(image.Rect(0, 0, 8, 8)), 4); err == nil {
+		t.Error("expected error for a fully transparent image")
+	}
+}
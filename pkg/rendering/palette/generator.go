@@ -68,6 +68,9 @@ func (g *Generator) GenerateWithOptions(genreID string, seed int64, opts Generat
 
 	scheme := g.getSchemeForGenre(genre)
 	palette := g.generateFromScheme(scheme, rng, opts)
+	if opts.Colorblind != ColorblindNone {
+		palette = adaptForColorblind(palette, opts.Colorblind)
+	}
 
 	if g.logger != nil {
 		g.logger.WithFields(logrus.Fields{
@@ -174,6 +174,37 @@ func (r Rarity) String() string {
 	}
 }
 
+// ColorblindMode selects a color vision deficiency to keep palettes
+// distinguishable for.
+type ColorblindMode int
+
+const (
+	// ColorblindNone leaves palettes unchanged
+	ColorblindNone ColorblindMode = iota
+	// ColorblindProtanopia adapts for missing red cones (red-green)
+	ColorblindProtanopia
+	// ColorblindDeuteranopia adapts for missing green cones (red-green)
+	ColorblindDeuteranopia
+	// ColorblindTritanopia adapts for missing blue cones (blue-yellow)
+	ColorblindTritanopia
+)
+
+// String returns the string representation of ColorblindMode.
+func (m ColorblindMode) String() string {
+	switch m {
+	case ColorblindNone:
+		return "None"
+	case ColorblindProtanopia:
+		return "Protanopia"
+	case ColorblindDeuteranopia:
+		return "Deuteranopia"
+	case ColorblindTritanopia:
+		return "Tritanopia"
+	default:
+		return "Unknown"
+	}
+}
+
 // GenerationOptions configures palette generation.
 type GenerationOptions struct {
 	// Harmony type for color relationships
@@ -184,14 +215,18 @@ type GenerationOptions struct {
 	Rarity Rarity
 	// MinColors minimum number of colors to generate (default: 12)
 	MinColors int
+	// Colorblind adjusts hues to stay distinguishable under a color vision
+	// deficiency (default: none)
+	Colorblind ColorblindMode
 }
 
 // DefaultOptions returns default generation options.
 func DefaultOptions() GenerationOptions {
 	return GenerationOptions{
-		Harmony:   HarmonyComplementary,
-		Mood:      MoodNormal,
-		Rarity:    RarityCommon,
-		MinColors: 12,
+		Harmony:    HarmonyComplementary,
+		Mood:       MoodNormal,
+		Rarity:     RarityCommon,
+		MinColors:  12,
+		Colorblind: ColorblindNone,
 	}
 }
@@ -0,0 +1,169 @@
+package particles
+
+import (
+	"math"
+	"testing"
+)
+
+func attractorTestConfig() Config {
+	return Config{
+		Type:     ParticleMagic,
+		Count:    200,
+		GenreID:  "fantasy",
+		Seed:     777,
+		Duration: 5.0,
+		SpreadX:  20.0,
+		SpreadY:  20.0,
+		MinSize:  1.0,
+		MaxSize:  2.0,
+	}
+}
+
+func meanDistance(particles []Particle, cx, cy float64) float64 {
+	total := 0.0
+	for _, p := range particles {
+		total += math.Hypot(p.X-cx, p.Y-cy)
+	}
+	return total / float64(len(particles))
+}
+
+func TestParticleSystem_Attractor_PullsParticles(t *testing.T) {
+	gen := NewGenerator()
+
+	free := attractorTestConfig()
+	pulled := attractorTestConfig()
+	pulled.Attractors = []ParticleForce{{X: 0, Y: 0, Strength: 200, Falloff: 0}}
+
+	a, err := gen.Generate(free)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	b, err := gen.Generate(pulled)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for i := 0; i < 30; i++ {
+		a.Update(1.0 / 60.0)
+		b.Update(1.0 / 60.0)
+	}
+
+	if meanDistance(b.Particles, 0, 0) >= meanDistance(a.Particles, 0, 0) {
+		t.Errorf("attractor did not pull particles inward: %.2f >= %.2f",
+			meanDistance(b.Particles, 0, 0), meanDistance(a.Particles, 0, 0))
+	}
+}
+
+func TestParticleSystem_Repulsor_PushesParticles(t *testing.T) {
+	gen := NewGenerator()
+
+	free := attractorTestConfig()
+	pushed := attractorTestConfig()
+	pushed.Attractors = []ParticleForce{{X: 0, Y: 0, Strength: -200, Falloff: 0}}
+
+	a, _ := gen.Generate(free)
+	b, err := gen.Generate(pushed)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for i := 0; i < 30; i++ {
+		a.Update(1.0 / 60.0)
+		b.Update(1.0 / 60.0)
+	}
+
+	if meanDistance(b.Particles, 0, 0) <= meanDistance(a.Particles, 0, 0) {
+		t.Error("repulsor did not push particles outward")
+	}
+}
+
+func TestParticleForce_Swirl_IsTangential(t *testing.T) {
+	force := ParticleForce{Swirl: 10}
+
+	ax, ay := force.accelerationAt(5, 0)
+	if math.Abs(ax) > 1e-9 || ay == 0 {
+		t.Errorf("pure swirl at (5,0) = (%v, %v), want perpendicular to radius", ax, ay)
+	}
+}
+
+func TestParticleForce_Falloff(t *testing.T) {
+	force := ParticleForce{Strength: 100, Falloff: 2}
+
+	nearX, _ := force.accelerationAt(-2, 0)
+	farX, _ := force.accelerationAt(-4, 0)
+	if ratio := nearX / farX; math.Abs(ratio-4) > 1e-9 {
+		t.Errorf("inverse-square ratio = %v, want 4", ratio)
+	}
+
+	// The center itself exerts no force
+	if ax, ay := force.accelerationAt(0, 0); ax != 0 || ay != 0 {
+		t.Errorf("force at center = (%v, %v), want 0", ax, ay)
+	}
+
+	// Near the center the pull is softened instead of diverging
+	if ax, _ := force.accelerationAt(-0.01, 0); ax > force.Strength+1e-9 {
+		t.Errorf("force near center = %v, want <= %v", ax, force.Strength)
+	}
+}
+
+func TestParticleSystem_Attractors_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	config := attractorTestConfig()
+	config.Attractors = []ParticleForce{
+		{X: 10, Y: 0, Strength: 150, Falloff: 1, Swirl: 80},
+		{X: -10, Y: 5, Strength: -50, Falloff: 2},
+	}
+
+	a, _ := gen.Generate(config)
+	b, _ := gen.Generate(config)
+	for i := 0; i < 60; i++ {
+		a.Update(1.0 / 60.0)
+		b.Update(1.0 / 60.0)
+	}
+
+	for i := range a.Particles {
+		if a.Particles[i].X != b.Particles[i].X || a.Particles[i].Y != b.Particles[i].Y {
+			t.Fatalf("particle %d differs between runs", i)
+		}
+	}
+}
+
+func TestConfig_Validate_Attractors(t *testing.T) {
+	config := attractorTestConfig()
+	config.Attractors = make([]ParticleForce, MaxAttractors+1)
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for too many attractors")
+	}
+
+	config = attractorTestConfig()
+	config.Attractors = []ParticleForce{{Strength: 10, Falloff: -1}}
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for negative falloff")
+	}
+
+	config = attractorTestConfig()
+	config.Attractors = []ParticleForce{{Strength: math.NaN()}}
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for NaN strength")
+	}
+}
+
+func BenchmarkParticleSystem_UpdateAttractors(b *testing.B) {
+	gen := NewGenerator()
+	config := attractorTestConfig()
+	config.Count = 1000
+	config.Attractors = make([]ParticleForce, MaxAttractors)
+	for i := range config.Attractors {
+		config.Attractors[i] = ParticleForce{X: float64(i * 5), Strength: 100, Falloff: 1, Swirl: 40}
+	}
+
+	system, err := gen.Generate(config)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		system.Update(0.016)
+	}
+}
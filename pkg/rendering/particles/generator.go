@@ -71,7 +71,12 @@ func (g *Generator) Generate(config Config) (*ParticleSystem, error) {
 	// Create particle system from pool with pre-allocated particles
 	// Note: NewParticleSystem expects particles to be passed in, but we
 	// need to generate them. Create temporary slice, then pass to pooled system.
-	particles := make([]Particle, config.Count)
+	// Looping systems start empty and spawn particles over time.
+	initialCount := config.Count
+	if config.Looping {
+		initialCount = 0
+	}
+	particles := make([]Particle, initialCount)
 
 	// Temporarily create system for generation (will be replaced with pooled version)
 	system := &ParticleSystem{
@@ -82,21 +87,7 @@ func (g *Generator) Generate(config Config) (*ParticleSystem, error) {
 	}
 
 	// Generate particles based on type
-	switch config.Type {
-	case ParticleSpark:
-		g.generateSparks(system, pal, rng, config)
-	case ParticleSmoke:
-		g.generateSmoke(system, pal, rng, config)
-	case ParticleMagic:
-		g.generateMagic(system, pal, rng, config)
-	case ParticleFlame:
-		g.generateFlame(system, pal, rng, config)
-	case ParticleBlood:
-		g.generateBlood(system, pal, rng, config)
-	case ParticleDust:
-		g.generateDust(system, pal, rng, config)
-	default:
-		err := fmt.Errorf("unknown particle type: %d", config.Type)
+	if err := g.populate(system, pal, rng, config); err != nil {
 		if g.logger != nil {
 			g.logger.WithError(err).WithField("type", config.Type).Error("unknown particle type")
 		}
@@ -114,9 +105,57 @@ func (g *Generator) Generate(config Config) (*ParticleSystem, error) {
 	// This transfers particles to a pooled system, reducing GC pressure
 	pooledSystem := NewParticleSystem(system.Particles, config.Type, config)
 
+	if config.Looping {
+		// Reserve the full pool up front so steady-state emission never allocates
+		if cap(pooledSystem.Particles) < config.Count {
+			pooledSystem.Particles = make([]Particle, 0, config.Count)
+		}
+		pooledSystem.spawn = g.newSpawner(pal, rng, config)
+	}
+
 	return pooledSystem, nil
 }
 
+// populate fills every particle in the system using the type-specific
+// generator, then applies directional emission if configured.
+func (g *Generator) populate(system *ParticleSystem, pal *palette.Palette, rng *rand.Rand, config Config) error {
+	switch config.Type {
+	case ParticleSpark:
+		g.generateSparks(system, pal, rng, config)
+	case ParticleSmoke:
+		g.generateSmoke(system, pal, rng, config)
+	case ParticleMagic:
+		g.generateMagic(system, pal, rng, config)
+	case ParticleFlame:
+		g.generateFlame(system, pal, rng, config)
+	case ParticleBlood:
+		g.generateBlood(system, pal, rng, config)
+	case ParticleDust:
+		g.generateDust(system, pal, rng, config)
+	default:
+		return fmt.Errorf("unknown particle type: %d", config.Type)
+	}
+
+	// Redirect velocities into a cone for directional effects
+	if config.IsDirectional() {
+		applyDirectionalEmission(system.Particles, rng, config)
+	}
+	return nil
+}
+
+// newSpawner returns a function that regenerates particles in place for a
+// looping system. It continues the generation RNG stream, so spawns are
+// deterministic for a given seed and update sequence.
+func (g *Generator) newSpawner(pal *palette.Palette, rng *rand.Rand, config Config) func(dst []Particle) {
+	scratch := &ParticleSystem{Type: config.Type, Config: config}
+	return func(dst []Particle) {
+		scratch.Particles = dst
+		// Type was validated when the system was generated
+		_ = g.populate(scratch, pal, rng, config)
+		scratch.Particles = nil
+	}
+}
+
 // generateSparks creates bright, quick-moving spark particles.
 func (g *Generator) generateSparks(system *ParticleSystem, pal *palette.Palette, rng *rand.Rand, config Config) {
 	colors := []color.Color{
@@ -282,6 +321,24 @@ func (g *Generator) generateDust(system *ParticleSystem, pal *palette.Palette, r
 	}
 }
 
+// applyDirectionalEmission re-aims particle velocities within the emission cone.
+// Speeds come from [MinSpeed, MaxSpeed] when MaxSpeed is set, otherwise the
+// speed assigned by the type generator is preserved.
+func applyDirectionalEmission(particles []Particle, rng *rand.Rand, config Config) {
+	for i := range particles {
+		p := &particles[i]
+
+		angle := config.EmitAngle + (rng.Float64()-0.5)*config.EmitSpread
+		speed := math.Hypot(p.VX, p.VY)
+		if config.MaxSpeed > 0 {
+			speed = config.MinSpeed + rng.Float64()*(config.MaxSpeed-config.MinSpeed)
+		}
+
+		p.VX = math.Cos(angle) * speed
+		p.VY = math.Sin(angle) * speed
+	}
+}
+
 // Validate implements the procgen.Generator interface.
 func (g *Generator) Validate(result interface{}) error {
 	system, ok := result.(*ParticleSystem)
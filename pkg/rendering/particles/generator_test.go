@@ -1,6 +1,7 @@
 package particles
 
 import (
+	"math"
 	"testing"
 )
 
@@ -629,6 +630,131 @@ func TestGenerator_AllGenres(t *testing.T) {
 	}
 }
 
+func directionalTestConfig() Config {
+	return Config{
+		Type:       ParticleFlame,
+		Count:      200,
+		GenreID:    "scifi",
+		Seed:       4242,
+		Duration:   0.5,
+		SpreadX:    10.0,
+		SpreadY:    10.0,
+		MinSize:    1.0,
+		MaxSize:    2.0,
+		EmitAngle:  math.Pi, // Thruster exhaust pointing -X
+		EmitSpread: math.Pi / 6,
+		MinSpeed:   80,
+		MaxSpeed:   120,
+	}
+}
+
+func TestGenerator_DirectionalEmission(t *testing.T) {
+	gen := NewGenerator()
+	config := directionalTestConfig()
+
+	system, err := gen.Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	halfSpread := config.EmitSpread / 2
+	for i, p := range system.Particles {
+		speed := math.Hypot(p.VX, p.VY)
+		if speed < config.MinSpeed-1e-9 || speed > config.MaxSpeed+1e-9 {
+			t.Errorf("particle %d speed %.2f outside [%v, %v]", i, speed, config.MinSpeed, config.MaxSpeed)
+		}
+
+		angle := math.Atan2(p.VY, p.VX)
+		diff := math.Abs(math.Remainder(angle-config.EmitAngle, 2*math.Pi))
+		if diff > halfSpread+1e-9 {
+			t.Errorf("particle %d angle off by %.3f rad, max %.3f", i, diff, halfSpread)
+		}
+	}
+}
+
+func TestGenerator_DirectionalEmission_KeepsTypeSpeed(t *testing.T) {
+	gen := NewGenerator()
+	config := directionalTestConfig()
+	config.MinSpeed = 0
+	config.MaxSpeed = 0
+
+	radial := config
+	radial.EmitSpread = 0
+
+	directional, err := gen.Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	baseline, err := gen.Generate(radial)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for i := range directional.Particles {
+		got := math.Hypot(directional.Particles[i].VX, directional.Particles[i].VY)
+		want := math.Hypot(baseline.Particles[i].VX, baseline.Particles[i].VY)
+		if math.Abs(got-want) > 1e-9 {
+			t.Fatalf("particle %d speed = %v, want type default %v", i, got, want)
+		}
+	}
+}
+
+func TestGenerator_DirectionalEmission_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	config := directionalTestConfig()
+
+	a, err := gen.Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	b, err := gen.Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for i := range a.Particles {
+		if a.Particles[i].VX != b.Particles[i].VX || a.Particles[i].VY != b.Particles[i].VY {
+			t.Fatalf("particle %d differs between runs", i)
+		}
+	}
+}
+
+func TestConfig_Validate_Directional(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+	}{
+		{"negative spread", func(c *Config) { c.EmitSpread = -0.1 }},
+		{"spread above full circle", func(c *Config) { c.EmitSpread = 7 }},
+		{"negative min speed", func(c *Config) { c.MinSpeed = -1 }},
+		{"max below min", func(c *Config) { c.MinSpeed = 50; c.MaxSpeed = 10 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := directionalTestConfig()
+			tt.modify(&config)
+			if err := config.Validate(); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
+
+func BenchmarkGenerator_GenerateDirectional(b *testing.B) {
+	gen := NewGenerator()
+	config := directionalTestConfig()
+	config.Count = 100
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := gen.Generate(config)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkGenerator_Generate(b *testing.B) {
 	gen := NewGenerator()
 	config := Config{
@@ -0,0 +1,87 @@
+// Package particles provides looping particle emitters.
+// This file implements continuous emission for persistent effects such as
+// torches and steam vents. A looping system spawns particles at a fixed
+// rate and recycles dead particles in place, so its live count stays
+// roughly constant without per-particle allocation.
+package particles
+
+import "math"
+
+// SetOrigin sets the position where a looping system spawns new particles.
+// Attractor positions are also measured relative to this origin.
+// Existing particles are not moved.
+func (ps *ParticleSystem) SetOrigin(x, y float64) {
+	ps.originX = x
+	ps.originY = y
+}
+
+// Origin returns the position where new particles are spawned.
+func (ps *ParticleSystem) Origin() (x, y float64) {
+	return ps.originX, ps.originY
+}
+
+// IsEmitting returns true if the system is a looping emitter that is still
+// spawning particles.
+func (ps *ParticleSystem) IsEmitting() bool {
+	return ps.spawn != nil
+}
+
+// StopEmitting stops a looping system from spawning new particles.
+// Live particles finish their lifetimes, after which IsAlive returns false
+// and the system can be released. Safe to call on non-looping systems.
+func (ps *ParticleSystem) StopEmitting() {
+	ps.spawn = nil
+	ps.emitAccumulator = 0
+}
+
+// emit spawns particles owed for this frame. Spawn timing depends only on
+// the sequence of delta times, keeping emission deterministic.
+func (ps *ParticleSystem) emit(deltaTime float64) {
+	if ps.spawn == nil {
+		return
+	}
+
+	ps.emitAccumulator += ps.Config.EmissionRate * deltaTime
+	for ps.emitAccumulator >= 1 {
+		slot := ps.freeSlot()
+		if slot < 0 {
+			// Pool exhausted; drop the backlog so emission does not burst later
+			ps.emitAccumulator = math.Mod(ps.emitAccumulator, 1)
+			return
+		}
+		ps.emitAccumulator--
+
+		ps.spawn(ps.Particles[slot : slot+1])
+		ps.Particles[slot].X += ps.originX
+		ps.Particles[slot].Y += ps.originY
+	}
+}
+
+// freeSlot returns the index of a particle slot available for spawning,
+// growing the slice up to Config.Count before recycling dead particles.
+// Returns -1 if every slot holds a live particle.
+func (ps *ParticleSystem) freeSlot() int {
+	if len(ps.Particles) < ps.Config.Count {
+		ps.Particles = append(ps.Particles, Particle{})
+		return len(ps.Particles) - 1
+	}
+
+	n := len(ps.Particles)
+	for i := 0; i < n; i++ {
+		slot := (ps.nextSlot + i) % n
+		if ps.Particles[slot].Life <= 0 {
+			ps.nextSlot = (slot + 1) % n
+			return slot
+		}
+	}
+	return -1
+}
+
+// resetEmitter clears looping emitter state when a system is pooled.
+func (ps *ParticleSystem) resetEmitter() {
+	ps.spawn = nil
+	ps.emitAccumulator = 0
+	ps.nextSlot = 0
+	ps.originX = 0
+	ps.originY = 0
+}
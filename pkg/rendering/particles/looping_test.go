@@ -0,0 +1,201 @@
+package particles
+
+import (
+	"testing"
+)
+
+func loopingTestConfig() Config {
+	return Config{
+		Type:         ParticleFlame,
+		Count:        100,
+		GenreID:      "fantasy",
+		Seed:         31337,
+		Duration:     1.0,
+		SpreadX:      5.0,
+		SpreadY:      20.0,
+		MinSize:      1.0,
+		MaxSize:      2.0,
+		Looping:      true,
+		EmissionRate: 60,
+	}
+}
+
+func liveCount(ps *ParticleSystem) int {
+	count := 0
+	for i := range ps.Particles {
+		if ps.Particles[i].Life > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func TestParticleSystem_Looping_StartsEmpty(t *testing.T) {
+	system, err := NewGenerator().Generate(loopingTestConfig())
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if len(system.Particles) != 0 {
+		t.Errorf("looping system starts with %d particles, want 0", len(system.Particles))
+	}
+	if !system.IsEmitting() || !system.IsAlive() {
+		t.Error("looping system should be emitting and alive")
+	}
+}
+
+func TestParticleSystem_Looping_SteadyLiveCount(t *testing.T) {
+	config := loopingTestConfig()
+	system, err := NewGenerator().Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	// Warm up past the longest particle lifetime
+	for i := 0; i < 120; i++ {
+		system.Update(1.0 / 60.0)
+	}
+
+	// Flame lifetimes average 0.5s, so ~30 particles live at 60/sec
+	min, max := config.Count, 0
+	for i := 0; i < 300; i++ {
+		system.Update(1.0 / 60.0)
+		live := liveCount(system)
+		if live < min {
+			min = live
+		}
+		if live > max {
+			max = live
+		}
+	}
+
+	if min < 15 || max > 45 {
+		t.Errorf("live count ranged %d-%d, expected roughly constant around 30", min, max)
+	}
+	if len(system.Particles) > config.Count {
+		t.Errorf("pool grew to %d, exceeds Count %d", len(system.Particles), config.Count)
+	}
+}
+
+func TestParticleSystem_Looping_PoolCap(t *testing.T) {
+	config := loopingTestConfig()
+	config.Count = 20
+	config.EmissionRate = 1000
+
+	system, err := NewGenerator().Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for i := 0; i < 60; i++ {
+		system.Update(1.0 / 60.0)
+		if len(system.Particles) > config.Count {
+			t.Fatalf("pool grew to %d, exceeds Count %d", len(system.Particles), config.Count)
+		}
+	}
+	if liveCount(system) != config.Count {
+		t.Errorf("live = %d, want saturated pool of %d", liveCount(system), config.Count)
+	}
+}
+
+func TestParticleSystem_Looping_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	a, _ := gen.Generate(loopingTestConfig())
+	b, _ := gen.Generate(loopingTestConfig())
+
+	for i := 0; i < 90; i++ {
+		a.Update(1.0 / 60.0)
+		b.Update(1.0 / 60.0)
+	}
+
+	if len(a.Particles) != len(b.Particles) {
+		t.Fatalf("particle counts differ: %d vs %d", len(a.Particles), len(b.Particles))
+	}
+	for i := range a.Particles {
+		if a.Particles[i].X != b.Particles[i].X || a.Particles[i].Life != b.Particles[i].Life {
+			t.Fatalf("particle %d differs between runs", i)
+		}
+	}
+}
+
+func TestParticleSystem_Looping_SpawnsAtOrigin(t *testing.T) {
+	config := loopingTestConfig()
+	config.EmissionRate = 1
+
+	system, _ := NewGenerator().Generate(config)
+	system.SetOrigin(100, 50)
+	system.Update(1.0)
+
+	if len(system.Particles) != 1 {
+		t.Fatalf("expected 1 spawned particle, got %d", len(system.Particles))
+	}
+	p := system.Particles[0]
+	// Flames spawn within ±3 px horizontally of the origin
+	if p.X < 97 || p.X > 103 || p.Y != 50 {
+		t.Errorf("particle spawned at (%v, %v), want near origin (100, 50)", p.X, p.Y)
+	}
+}
+
+func TestParticleSystem_Looping_StopEmitting(t *testing.T) {
+	system, _ := NewGenerator().Generate(loopingTestConfig())
+	for i := 0; i < 60; i++ {
+		system.Update(1.0 / 60.0)
+	}
+
+	system.StopEmitting()
+	if system.IsEmitting() {
+		t.Error("IsEmitting() = true after StopEmitting")
+	}
+
+	count := len(system.Particles)
+	for i := 0; i < 120; i++ {
+		system.Update(1.0 / 60.0)
+	}
+	if len(system.Particles) != count {
+		t.Errorf("particles spawned after StopEmitting: %d -> %d", count, len(system.Particles))
+	}
+	if system.IsAlive() {
+		t.Error("stopped system should die once particles expire")
+	}
+}
+
+func TestParticleSystem_Looping_NoSteadyStateAllocs(t *testing.T) {
+	system, _ := NewGenerator().Generate(loopingTestConfig())
+	for i := 0; i < 120; i++ {
+		system.Update(1.0 / 60.0)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		system.Update(1.0 / 60.0)
+	})
+	if allocs > 0 {
+		t.Errorf("Update allocated %.1f times per frame, want 0", allocs)
+	}
+}
+
+func TestReleaseParticleSystem_ClearsLooping(t *testing.T) {
+	system, _ := NewGenerator().Generate(loopingTestConfig())
+	system.SetOrigin(5, 5)
+	ReleaseParticleSystem(system)
+
+	if system.IsEmitting() {
+		t.Error("released system still emitting")
+	}
+	if x, y := system.Origin(); x != 0 || y != 0 {
+		t.Errorf("released system origin = (%v, %v), want (0, 0)", x, y)
+	}
+}
+
+func TestConfig_Validate_Looping(t *testing.T) {
+	config := loopingTestConfig()
+	config.EmissionRate = 0
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for looping without emission rate")
+	}
+
+	config = loopingTestConfig()
+	config.Looping = false
+	config.EmissionRate = -5
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for negative emission rate")
+	}
+}
@@ -43,6 +43,7 @@ func NewParticleSystem(particles []Particle, pType ParticleType, config Config)
 	// Clear previous state
 	ps.Particles = ps.Particles[:0]
 	ps.ElapsedTime = 0
+	ps.resetEmitter()
 
 	// Set new state
 	ps.Type = pType
@@ -73,6 +74,7 @@ func ReleaseParticleSystem(ps *ParticleSystem) {
 	// Clear other fields to prevent state leaks
 	ps.ElapsedTime = 0
 	ps.Type = 0
+	ps.resetEmitter()
 	// Note: Config is value type, will be overwritten on next use
 
 	particleSystemPool.Put(ps)
@@ -6,6 +6,7 @@ package particles
 import (
 	"fmt"
 	"image/color"
+	"math"
 )
 
 // ParticleType represents different types of particle effects.
@@ -74,6 +75,32 @@ type Config struct {
 	MinSize float64
 	MaxSize float64
 
+	// EmitAngle is the emission direction in radians (0 = +X, π/2 = +Y)
+	// when directional emission is enabled
+	EmitAngle float64
+
+	// EmitSpread is the full cone width in radians around EmitAngle.
+	// Zero keeps the particle type's default emission pattern; a positive
+	// value launches all particles within the cone (muzzle flash, thruster).
+	EmitSpread float64
+
+	// MinSpeed and MaxSpeed set the launch speed range for directional
+	// emission. When MaxSpeed is zero the type's default speed is kept.
+	MinSpeed float64
+	MaxSpeed float64
+
+	// Looping keeps the system alive and continuously spawns particles at
+	// EmissionRate, recycling dead particles so at most Count are live.
+	// Used for persistent effects like torches and steam vents.
+	Looping bool
+
+	// EmissionRate is the number of particles spawned per second when Looping
+	EmissionRate float64
+
+	// Attractors are point force fields applied every update (up to
+	// MaxAttractors). Used for vortex and implosion effects.
+	Attractors []ParticleForce
+
 	// Custom parameters for specific particle types
 	Custom map[string]interface{}
 }
@@ -115,9 +142,37 @@ func (c Config) Validate() error {
 	if c.MaxSize < c.MinSize {
 		return fmt.Errorf("maxSize (%f) must be >= minSize (%f)", c.MaxSize, c.MinSize)
 	}
+	if c.EmitSpread < 0 || c.EmitSpread > 2*math.Pi {
+		return fmt.Errorf("emitSpread must be in [0, 2π], got %f", c.EmitSpread)
+	}
+	if c.MinSpeed < 0 {
+		return fmt.Errorf("minSpeed must be non-negative, got %f", c.MinSpeed)
+	}
+	if c.MaxSpeed != 0 && c.MaxSpeed < c.MinSpeed {
+		return fmt.Errorf("maxSpeed (%f) must be >= minSpeed (%f)", c.MaxSpeed, c.MinSpeed)
+	}
+	if c.EmissionRate < 0 {
+		return fmt.Errorf("emissionRate must be non-negative, got %f", c.EmissionRate)
+	}
+	if c.Looping && c.EmissionRate == 0 {
+		return fmt.Errorf("looping emitter requires a positive emissionRate")
+	}
+	if len(c.Attractors) > MaxAttractors {
+		return fmt.Errorf("too many attractors (max %d), got %d", MaxAttractors, len(c.Attractors))
+	}
+	for i, force := range c.Attractors {
+		if err := force.Validate(); err != nil {
+			return fmt.Errorf("attractor %d: %w", i, err)
+		}
+	}
 	return nil
 }
 
+// IsDirectional returns true if particles are emitted in a cone around EmitAngle.
+func (c Config) IsDirectional() bool {
+	return c.EmitSpread > 0
+}
+
 // Particle represents a single particle in a particle system.
 type Particle struct {
 	// Position
@@ -158,12 +213,22 @@ type ParticleSystem struct {
 
 	// Time elapsed since creation
 	ElapsedTime float64
+
+	// Looping emitter state (see looping.go)
+	spawn           func(dst []Particle)
+	emitAccumulator float64
+	nextSlot        int
+	originX         float64
+	originY         float64
 }
 
 // Update updates all particles in the system based on delta time.
 func (ps *ParticleSystem) Update(deltaTime float64) {
 	ps.ElapsedTime += deltaTime
 
+	// Apply force fields before integrating positions
+	applyForces(ps.Particles, ps.Config.Attractors, ps.originX, ps.originY, deltaTime)
+
 	for i := range ps.Particles {
 		p := &ps.Particles[i]
 
@@ -180,10 +245,16 @@ func (ps *ParticleSystem) Update(deltaTime float64) {
 		// Update rotation
 		p.Rotation += p.RotationVel * deltaTime
 	}
+
+	// Spawn new particles for looping emitters
+	ps.emit(deltaTime)
 }
 
 // IsAlive returns true if any particles are still alive.
 func (ps *ParticleSystem) IsAlive() bool {
+	if ps.IsEmitting() {
+		return true
+	}
 	for i := range ps.Particles {
 		if ps.Particles[i].Life > 0 {
 			return true
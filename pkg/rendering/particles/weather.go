@@ -106,6 +106,20 @@ type WeatherConfig struct {
 	WindX float64
 	WindY float64
 
+	// Gust enables time-varying wind (nil = constant WindX/WindY)
+	Gust *GustConfig
+
+	// GroundY is the height at which particles hit the ground (0 = no ground,
+	// particles fall through and wrap at Height)
+	GroundY float64
+
+	// Accumulate converts grounded particles into short-lived settled
+	// particles that build a thin layer instead of despawning
+	Accumulate bool
+
+	// MaxSettled caps the number of settled particles (0 = DefaultMaxSettled)
+	MaxSettled int
+
 	// Custom parameters
 	Custom map[string]interface{}
 }
@@ -136,6 +150,17 @@ func (c WeatherConfig) Validate() error {
 	if c.GenreID == "" {
 		return fmt.Errorf("genreID cannot be empty")
 	}
+	if c.GroundY < 0 {
+		return fmt.Errorf("groundY must be non-negative, got %f", c.GroundY)
+	}
+	if c.MaxSettled < 0 {
+		return fmt.Errorf("maxSettled must be non-negative, got %d", c.MaxSettled)
+	}
+	if c.Gust != nil {
+		if err := c.Gust.Validate(); err != nil {
+			return fmt.Errorf("invalid gust: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -168,11 +193,17 @@ type WeatherSystem struct {
 	// Active particles
 	Particles []Particle
 
+	// Settled particles resting on the ground (only when Config.Accumulate is set)
+	Settled []Particle
+
 	// Elapsed time
 	ElapsedTime float64
 
 	// Random number generator
 	rng *rand.Rand
+
+	// Active cross-fade to a new configuration (nil when idle)
+	transition *weatherTransition
 }
 
 // GenerateWeather creates a new weather particle system.
@@ -182,6 +213,17 @@ func GenerateWeather(config WeatherConfig) (*WeatherSystem, error) {
 	}
 
 	rng := rand.New(rand.NewSource(config.Seed))
+	particles := generateWeatherParticles(config, rng)
+
+	return &WeatherSystem{
+		Config:    config,
+		Particles: particles,
+		rng:       rng,
+	}, nil
+}
+
+// generateWeatherParticles creates the particle set for a weather configuration.
+func generateWeatherParticles(config WeatherConfig, rng *rand.Rand) []Particle {
 	particleCount := config.GetParticleCount()
 
 	// Cap at 10000 particles for performance
@@ -213,36 +255,55 @@ func GenerateWeather(config WeatherConfig) (*WeatherSystem, error) {
 		generateRainParticles(particles, config, rng)
 	}
 
-	return &WeatherSystem{
-		Config:    config,
-		Particles: particles,
-		rng:       rng,
-	}, nil
+	return particles
 }
 
 // Update updates the weather system.
 func (ws *WeatherSystem) Update(deltaTime float64) {
 	ws.ElapsedTime += deltaTime
+	ws.updateSettled(deltaTime)
+
+	if ws.transition != nil {
+		ws.updateTransition(deltaTime)
+		return
+	}
 
-	for i := range ws.Particles {
-		p := &ws.Particles[i]
+	ws.updateParticles(ws.Particles, ws.Config, deltaTime)
+}
+
+// updateParticles advances particle motion and wrapping for the given config.
+func (ws *WeatherSystem) updateParticles(particles []Particle, config WeatherConfig, deltaTime float64) {
+	// Wind is sampled once per frame so gusts move all particles coherently
+	windX, windY := config.windAt(ws.ElapsedTime)
+
+	for i := range particles {
+		p := &particles[i]
 
 		// Update position
-		p.X += (p.VX + ws.Config.WindX) * deltaTime
-		p.Y += (p.VY + ws.Config.WindY) * deltaTime
+		p.X += (p.VX + windX) * deltaTime
+		p.Y += (p.VY + windY) * deltaTime
 
 		// Update rotation
 		p.Rotation += p.RotationVel * deltaTime
 
+		// Particles reaching the ground settle (if accumulating) and respawn at the top
+		if config.GroundY > 0 && p.Y >= config.GroundY {
+			if config.Accumulate {
+				ws.settle(*p, config)
+			}
+			p.Y = 0
+			p.X = float64(ws.rng.Intn(config.Width))
+		}
+
 		// Wrap particles around screen edges
-		if p.Y > float64(ws.Config.Height) {
+		if p.Y > float64(config.Height) {
 			p.Y = 0
-			p.X = float64(ws.rng.Intn(ws.Config.Width))
+			p.X = float64(ws.rng.Intn(config.Width))
 		}
 		if p.X < 0 {
-			p.X = float64(ws.Config.Width)
+			p.X = float64(config.Width)
 		}
-		if p.X > float64(ws.Config.Width) {
+		if p.X > float64(config.Width) {
 			p.X = 0
 		}
 
@@ -253,7 +314,7 @@ func (ws *WeatherSystem) Update(deltaTime float64) {
 				// Respawn particle
 				p.Life = 1.0
 				p.Y = 0
-				p.X = float64(ws.rng.Intn(ws.Config.Width))
+				p.X = float64(ws.rng.Intn(config.Width))
 			}
 		}
 	}
@@ -0,0 +1,63 @@
+// Package particles provides ground accumulation for weather effects.
+// This file implements settled particles: when WeatherConfig.GroundY is set,
+// falling particles stop at the ground and, if Accumulate is enabled, leave a
+// short-lived settled particle behind that forms a thin layer (snow, ash).
+package particles
+
+// DefaultMaxSettled is the default cap on settled particles.
+const DefaultMaxSettled = 500
+
+// settledLifetime is how long a settled particle remains before melting away (seconds).
+const settledLifetime = 4.0
+
+// maxSettled returns the effective settled particle cap for a config.
+func (c WeatherConfig) maxSettled() int {
+	if c.MaxSettled > 0 {
+		return c.MaxSettled
+	}
+	return DefaultMaxSettled
+}
+
+// settle records a grounded particle as a stationary settled particle.
+// When the cap is reached, the oldest settled particle is discarded.
+func (ws *WeatherSystem) settle(p Particle, config WeatherConfig) {
+	limit := config.maxSettled()
+	if len(ws.Settled) >= limit {
+		drop := len(ws.Settled) - limit + 1
+		copy(ws.Settled, ws.Settled[drop:])
+		ws.Settled = ws.Settled[:len(ws.Settled)-drop]
+	}
+
+	p.Y = config.GroundY
+	p.VX = 0
+	p.VY = 0
+	p.RotationVel = 0
+	p.Life = 1.0
+	p.InitialLife = settledLifetime
+	ws.Settled = append(ws.Settled, p)
+}
+
+// updateSettled ages settled particles and removes those that have melted.
+// Order is preserved so the oldest particles remain first.
+func (ws *WeatherSystem) updateSettled(deltaTime float64) {
+	alive := ws.Settled[:0]
+	for i := range ws.Settled {
+		p := ws.Settled[i]
+		p.Life -= deltaTime / p.InitialLife
+		if p.Life > 0 {
+			alive = append(alive, p)
+		}
+	}
+	ws.Settled = alive
+}
+
+// AllParticles returns falling and settled particles together for rendering.
+func (ws *WeatherSystem) AllParticles() []Particle {
+	if len(ws.Settled) == 0 {
+		return ws.Particles
+	}
+	all := make([]Particle, 0, len(ws.Particles)+len(ws.Settled))
+	all = append(all, ws.Particles...)
+	all = append(all, ws.Settled...)
+	return all
+}
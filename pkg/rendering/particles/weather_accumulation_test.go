@@ -0,0 +1,121 @@
+package particles
+
+import (
+	"testing"
+)
+
+func groundedSnowConfig(accumulate bool) WeatherConfig {
+	config := DefaultWeatherConfig()
+	config.Type = WeatherSnow
+	config.Width = 200
+	config.Height = 200
+	config.Intensity = IntensityHeavy
+	config.Seed = 11
+	config.GroundY = 150
+	config.Accumulate = accumulate
+	return config
+}
+
+// TestWeatherSystem_Ground_StopsParticles tests no particle falls below the ground.
+func TestWeatherSystem_Ground_StopsParticles(t *testing.T) {
+	ws, err := GenerateWeather(groundedSnowConfig(false))
+	if err != nil {
+		t.Fatalf("GenerateWeather failed: %v", err)
+	}
+
+	for i := 0; i < 300; i++ {
+		ws.Update(1.0 / 30.0)
+		for j := range ws.Particles {
+			if ws.Particles[j].Y >= ws.Config.GroundY {
+				t.Fatalf("particle %d below ground: Y=%v", j, ws.Particles[j].Y)
+			}
+		}
+	}
+
+	if len(ws.Settled) != 0 {
+		t.Errorf("Settled = %d, want 0 without accumulation", len(ws.Settled))
+	}
+}
+
+// TestWeatherSystem_Accumulate_BuildsCappedLayer tests settled particles form and are capped.
+func TestWeatherSystem_Accumulate_BuildsCappedLayer(t *testing.T) {
+	config := groundedSnowConfig(true)
+	config.MaxSettled = 50
+
+	ws, err := GenerateWeather(config)
+	if err != nil {
+		t.Fatalf("GenerateWeather failed: %v", err)
+	}
+
+	for i := 0; i < 120; i++ {
+		ws.Update(1.0 / 30.0)
+		if len(ws.Settled) > config.MaxSettled {
+			t.Fatalf("Settled = %d exceeds cap %d", len(ws.Settled), config.MaxSettled)
+		}
+	}
+
+	if len(ws.Settled) == 0 {
+		t.Fatal("expected settled particles to accumulate")
+	}
+	for _, p := range ws.Settled {
+		if p.Y != config.GroundY || p.VX != 0 || p.VY != 0 {
+			t.Errorf("settled particle not resting on ground: %+v", p)
+		}
+	}
+	if got := len(ws.AllParticles()); got != len(ws.Particles)+len(ws.Settled) {
+		t.Errorf("AllParticles() = %d, want %d", got, len(ws.Particles)+len(ws.Settled))
+	}
+}
+
+// TestWeatherSystem_Accumulate_Melts tests settled particles expire when weather stops landing.
+func TestWeatherSystem_Accumulate_Melts(t *testing.T) {
+	ws, err := GenerateWeather(groundedSnowConfig(true))
+	if err != nil {
+		t.Fatalf("GenerateWeather failed: %v", err)
+	}
+	for i := 0; i < 60; i++ {
+		ws.Update(1.0 / 30.0)
+	}
+
+	ws.Particles = nil
+	ws.Update(settledLifetime + 0.1)
+
+	if len(ws.Settled) != 0 {
+		t.Errorf("Settled = %d after lifetime elapsed, want 0", len(ws.Settled))
+	}
+}
+
+// TestWeatherSystem_Accumulate_Deterministic tests accumulation is reproducible.
+func TestWeatherSystem_Accumulate_Deterministic(t *testing.T) {
+	a, _ := GenerateWeather(groundedSnowConfig(true))
+	b, _ := GenerateWeather(groundedSnowConfig(true))
+
+	for i := 0; i < 90; i++ {
+		a.Update(1.0 / 30.0)
+		b.Update(1.0 / 30.0)
+	}
+
+	if len(a.Settled) != len(b.Settled) {
+		t.Fatalf("settled counts differ: %d vs %d", len(a.Settled), len(b.Settled))
+	}
+	for i := range a.Settled {
+		if a.Settled[i].X != b.Settled[i].X || a.Settled[i].Life != b.Settled[i].Life {
+			t.Fatalf("settled particle %d differs between runs", i)
+		}
+	}
+}
+
+// TestWeatherConfig_Validate_Ground tests invalid ground parameters are rejected.
+func TestWeatherConfig_Validate_Ground(t *testing.T) {
+	config := groundedSnowConfig(true)
+	config.GroundY = -1
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for negative GroundY")
+	}
+
+	config = groundedSnowConfig(true)
+	config.MaxSettled = -5
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for negative MaxSettled")
+	}
+}
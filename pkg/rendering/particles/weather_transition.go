@@ -0,0 +1,150 @@
+// Package particles provides weather transition effects.
+// This file implements smooth cross-fading between weather configurations
+// so that weather changes (e.g. clear → storm) ramp in over time instead of
+// popping instantly.
+package particles
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+// weatherTransition tracks an in-progress cross-fade between two weather configs.
+type weatherTransition struct {
+	// Configuration being faded in
+	target WeatherConfig
+
+	// Particles fading out (previous weather) and fading in (target weather),
+	// stored with their undimmed colors
+	outgoing []Particle
+	incoming []Particle
+
+	// Duration and elapsed time of the transition in seconds
+	duration float64
+	elapsed  float64
+}
+
+// TransitionTo starts a smooth transition to a new weather configuration.
+// Over the given duration (in seconds) the previous particles fade out and
+// thin out while particles for the new configuration fade and spawn in.
+// The visible particle set is published through Particles each Update.
+// A non-positive duration switches immediately. The new particles are
+// generated from config.Seed, so transitions are deterministic for the
+// same seed and update timing.
+func (ws *WeatherSystem) TransitionTo(config WeatherConfig, duration float64) error {
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	incoming := generateWeatherParticles(config, rand.New(rand.NewSource(config.Seed)))
+
+	if duration <= 0 {
+		ws.transition = nil
+		ws.Config = config
+		ws.Particles = incoming
+		return nil
+	}
+
+	// If a transition is already running, fade out whatever is currently
+	// the dominant set so the change remains continuous.
+	outgoing := ws.Particles
+	if ws.transition != nil {
+		if ws.TransitionProgress() < 0.5 {
+			outgoing = ws.transition.outgoing
+		} else {
+			outgoing = ws.transition.incoming
+			ws.Config = ws.transition.target
+		}
+	}
+
+	ws.transition = &weatherTransition{
+		target:   config,
+		outgoing: outgoing,
+		incoming: incoming,
+		duration: duration,
+	}
+	ws.composeTransition()
+	return nil
+}
+
+// IsTransitioning returns true while a weather transition is in progress.
+func (ws *WeatherSystem) IsTransitioning() bool {
+	return ws.transition != nil
+}
+
+// TransitionProgress returns the current transition progress in [0, 1].
+// Returns 1.0 when no transition is active.
+func (ws *WeatherSystem) TransitionProgress() float64 {
+	if ws.transition == nil {
+		return 1.0
+	}
+	return math.Min(ws.transition.elapsed/ws.transition.duration, 1.0)
+}
+
+// TargetConfig returns the configuration the system is transitioning to,
+// or the current configuration if no transition is active.
+func (ws *WeatherSystem) TargetConfig() WeatherConfig {
+	if ws.transition == nil {
+		return ws.Config
+	}
+	return ws.transition.target
+}
+
+// updateTransition advances both particle sets and the cross-fade.
+func (ws *WeatherSystem) updateTransition(deltaTime float64) {
+	t := ws.transition
+	t.elapsed += deltaTime
+
+	ws.updateParticles(t.outgoing, ws.Config, deltaTime)
+	ws.updateParticles(t.incoming, t.target, deltaTime)
+
+	if t.elapsed >= t.duration {
+		ws.Config = t.target
+		ws.Particles = t.incoming
+		ws.transition = nil
+		return
+	}
+
+	ws.composeTransition()
+}
+
+// composeTransition rebuilds Particles from the outgoing and incoming sets,
+// scaling both their count and opacity by the transition progress.
+func (ws *WeatherSystem) composeTransition() {
+	t := ws.transition
+	progress := ws.TransitionProgress()
+
+	outCount := int(math.Round(float64(len(t.outgoing)) * (1.0 - progress)))
+	inCount := int(math.Round(float64(len(t.incoming)) * progress))
+
+	combined := make([]Particle, 0, outCount+inCount)
+	for i := 0; i < outCount; i++ {
+		p := t.outgoing[i]
+		p.Color = fadeColor(p.Color, 1.0-progress)
+		combined = append(combined, p)
+	}
+	for i := 0; i < inCount; i++ {
+		p := t.incoming[i]
+		p.Color = fadeColor(p.Color, progress)
+		combined = append(combined, p)
+	}
+
+	ws.Particles = combined
+}
+
+// fadeColor scales a color's opacity by factor (0.0 = transparent, 1.0 = unchanged).
+func fadeColor(c color.Color, factor float64) color.Color {
+	if c == nil {
+		return c
+	}
+	rgba := color.RGBAModel.Convert(c).(color.RGBA)
+	// RGBA is alpha-premultiplied, so all channels scale together
+	return color.RGBA{
+		R: uint8(float64(rgba.R) * factor),
+		G: uint8(float64(rgba.G) * factor),
+		B: uint8(float64(rgba.B) * factor),
+		A: uint8(float64(rgba.A) * factor),
+	}
+}
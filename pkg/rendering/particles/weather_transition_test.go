@@ -0,0 +1,139 @@
+package particles
+
+import (
+	"image/color"
+	"testing"
+)
+
+func newTransitionTestSystem(t *testing.T) *WeatherSystem {
+	t.Helper()
+	config := DefaultWeatherConfig()
+	config.Width = 200
+	config.Height = 200
+	config.Intensity = IntensityLight
+	config.Seed = 42
+
+	ws, err := GenerateWeather(config)
+	if err != nil {
+		t.Fatalf("GenerateWeather failed: %v", err)
+	}
+	return ws
+}
+
+func stormConfig() WeatherConfig {
+	config := DefaultWeatherConfig()
+	config.Width = 200
+	config.Height = 200
+	config.Type = WeatherSnow
+	config.Intensity = IntensityHeavy
+	config.Seed = 7
+	return config
+}
+
+// TestWeatherSystem_TransitionTo_CrossFade tests particle count ramps during transition.
+func TestWeatherSystem_TransitionTo_CrossFade(t *testing.T) {
+	ws := newTransitionTestSystem(t)
+	startCount := len(ws.Particles)
+	target := stormConfig()
+	targetCount := target.GetParticleCount()
+
+	if err := ws.TransitionTo(target, 2.0); err != nil {
+		t.Fatalf("TransitionTo failed: %v", err)
+	}
+	if !ws.IsTransitioning() {
+		t.Fatal("expected transition to be active")
+	}
+	if len(ws.Particles) != startCount {
+		t.Errorf("particle count at start = %d, want %d", len(ws.Particles), startCount)
+	}
+
+	ws.Update(1.0)
+	if p := ws.TransitionProgress(); p < 0.49 || p > 0.51 {
+		t.Errorf("TransitionProgress() = %v, want 0.5", p)
+	}
+	mid := len(ws.Particles)
+	if mid <= startCount || mid >= targetCount {
+		t.Errorf("mid-transition count = %d, want between %d and %d", mid, startCount, targetCount)
+	}
+
+	ws.Update(1.0)
+	if ws.IsTransitioning() {
+		t.Error("transition should be complete")
+	}
+	if len(ws.Particles) != targetCount {
+		t.Errorf("final particle count = %d, want %d", len(ws.Particles), targetCount)
+	}
+	if ws.Config.Type != WeatherSnow {
+		t.Errorf("Config.Type = %v, want %v", ws.Config.Type, WeatherSnow)
+	}
+}
+
+// TestWeatherSystem_TransitionTo_FadesColor tests outgoing particles dim during transition.
+func TestWeatherSystem_TransitionTo_FadesColor(t *testing.T) {
+	ws := newTransitionTestSystem(t)
+	original := color.RGBAModel.Convert(ws.Particles[0].Color).(color.RGBA)
+
+	if err := ws.TransitionTo(stormConfig(), 4.0); err != nil {
+		t.Fatalf("TransitionTo failed: %v", err)
+	}
+	ws.Update(1.0)
+
+	faded := color.RGBAModel.Convert(ws.Particles[0].Color).(color.RGBA)
+	if faded.A >= original.A {
+		t.Errorf("outgoing alpha = %d, want less than %d", faded.A, original.A)
+	}
+}
+
+// TestWeatherSystem_TransitionTo_Immediate tests non-positive duration switches instantly.
+func TestWeatherSystem_TransitionTo_Immediate(t *testing.T) {
+	ws := newTransitionTestSystem(t)
+	target := stormConfig()
+
+	if err := ws.TransitionTo(target, 0); err != nil {
+		t.Fatalf("TransitionTo failed: %v", err)
+	}
+	if ws.IsTransitioning() {
+		t.Error("zero-duration transition should complete immediately")
+	}
+	if len(ws.Particles) != target.GetParticleCount() {
+		t.Errorf("particle count = %d, want %d", len(ws.Particles), target.GetParticleCount())
+	}
+}
+
+// TestWeatherSystem_TransitionTo_Invalid tests invalid target configs are rejected.
+func TestWeatherSystem_TransitionTo_Invalid(t *testing.T) {
+	ws := newTransitionTestSystem(t)
+	target := stormConfig()
+	target.Width = 0
+
+	if err := ws.TransitionTo(target, 1.0); err == nil {
+		t.Error("expected error for invalid config")
+	}
+	if ws.IsTransitioning() {
+		t.Error("invalid config should not start a transition")
+	}
+}
+
+// TestWeatherSystem_TransitionTo_Deterministic tests identical inputs produce identical output.
+func TestWeatherSystem_TransitionTo_Deterministic(t *testing.T) {
+	run := func() []Particle {
+		ws := newTransitionTestSystem(t)
+		if err := ws.TransitionTo(stormConfig(), 1.5); err != nil {
+			t.Fatalf("TransitionTo failed: %v", err)
+		}
+		for i := 0; i < 30; i++ {
+			ws.Update(1.0 / 60.0)
+		}
+		return ws.Particles
+	}
+
+	a, b := run(), run()
+	if len(a) != len(b) {
+		t.Fatalf("particle counts differ: %d vs %d", len(a), len(b))
+	}
+	for i := range a {
+		if a[i].X != b[i].X || a[i].Y != b[i].Y || a[i].Color != b[i].Color {
+			t.Fatalf("particle %d differs between runs", i)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Package particles provides gusty wind simulation for weather effects.
+// This file implements GustConfig, which varies wind over time using seeded
+// 1D value noise so rain and snow sway coherently. Wind is a pure function of
+// the weather seed and elapsed time, keeping it deterministic for network sync.
+package particles
+
+import (
+	"fmt"
+	"math"
+)
+
+// GustConfig describes time-varying wind for a weather system.
+type GustConfig struct {
+	// BaseWindX and BaseWindY are the steady wind velocity
+	BaseWindX float64
+	BaseWindY float64
+
+	// Strength is the maximum additional wind speed during a gust
+	Strength float64
+
+	// Frequency is the approximate number of gusts per second
+	Frequency float64
+}
+
+// DefaultGustConfig returns a moderate gust configuration.
+func DefaultGustConfig() *GustConfig {
+	return &GustConfig{
+		BaseWindX: 20.0,
+		BaseWindY: 0.0,
+		Strength:  60.0,
+		Frequency: 0.3,
+	}
+}
+
+// Validate checks if the gust configuration is valid.
+func (g *GustConfig) Validate() error {
+	if g.Strength < 0 {
+		return fmt.Errorf("strength must be non-negative, got %f", g.Strength)
+	}
+	if g.Frequency < 0 {
+		return fmt.Errorf("frequency must be non-negative, got %f", g.Frequency)
+	}
+	return nil
+}
+
+// windAt returns the effective wind velocity for this config at the given time.
+func (c WeatherConfig) windAt(t float64) (float64, float64) {
+	if c.Gust == nil {
+		return c.WindX, c.WindY
+	}
+	g := c.Gust
+
+	// Gusts push along the base wind direction, or horizontally when calm
+	dirX, dirY := 1.0, 0.0
+	if mag := math.Hypot(g.BaseWindX, g.BaseWindY); mag > 0 {
+		dirX, dirY = g.BaseWindX/mag, g.BaseWindY/mag
+	}
+
+	// Primary gust envelope in [0, 1] plus a small perpendicular sway in [-1, 1]
+	gust := (valueNoise1D(c.Seed, t*g.Frequency) + 1.0) * 0.5
+	sway := valueNoise1D(c.Seed^0x5bd1e995, t*g.Frequency*2.0) * 0.25
+
+	windX := g.BaseWindX + g.Strength*(dirX*gust-dirY*sway)
+	windY := g.BaseWindY + g.Strength*(dirY*gust+dirX*sway)
+	return windX, windY
+}
+
+// EffectiveWind returns the wind velocity currently applied to particles.
+// During a transition the wind blends from the old to the new configuration.
+// Other systems (e.g. flag or sail animation) can read this to stay in sync.
+func (ws *WeatherSystem) EffectiveWind() (float64, float64) {
+	windX, windY := ws.Config.windAt(ws.ElapsedTime)
+	if ws.transition == nil {
+		return windX, windY
+	}
+
+	progress := ws.TransitionProgress()
+	targetX, targetY := ws.transition.target.windAt(ws.ElapsedTime)
+	return windX + (targetX-windX)*progress, windY + (targetY-windY)*progress
+}
+
+// valueNoise1D returns smooth seeded noise in [-1, 1] for position x.
+func valueNoise1D(seed int64, x float64) float64 {
+	x0 := math.Floor(x)
+	frac := x - x0
+	i := int64(x0)
+
+	a := latticeValue(seed, i)
+	b := latticeValue(seed, i+1)
+
+	// Smoothstep interpolation between lattice points
+	t := frac * frac * (3 - 2*frac)
+	return a + (b-a)*t
+}
+
+// latticeValue hashes a seed and integer lattice coordinate to [-1, 1].
+func latticeValue(seed, i int64) float64 {
+	h := uint64(seed)*0x9E3779B97F4A7C15 ^ uint64(i)*0xC2B2AE3D27D4EB4F
+	h ^= h >> 31
+	h *= 0xBF58476D1CE4E5B9
+	h ^= h >> 27
+	h *= 0x94D049BB133111EB
+	h ^= h >> 31
+	return float64(h>>11)/float64(1<<53)*2.0 - 1.0
+}
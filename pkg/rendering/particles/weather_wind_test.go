@@ -0,0 +1,100 @@
+package particles
+
+import (
+	"testing"
+)
+
+func gustyConfig() WeatherConfig {
+	config := DefaultWeatherConfig()
+	config.Width = 200
+	config.Height = 200
+	config.Intensity = IntensityLight
+	config.Seed = 99
+	config.Gust = DefaultGustConfig()
+	return config
+}
+
+// TestWeatherSystem_EffectiveWind_Constant tests wind without gusts is constant.
+func TestWeatherSystem_EffectiveWind_Constant(t *testing.T) {
+	config := DefaultWeatherConfig()
+	config.WindX = 12
+	config.WindY = -3
+
+	ws, err := GenerateWeather(config)
+	if err != nil {
+		t.Fatalf("GenerateWeather failed: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		ws.Update(0.5)
+		x, y := ws.EffectiveWind()
+		if x != 12 || y != -3 {
+			t.Fatalf("EffectiveWind() = (%v, %v), want (12, -3)", x, y)
+		}
+	}
+}
+
+// TestWeatherSystem_EffectiveWind_Gusts tests gusts vary wind within bounds.
+func TestWeatherSystem_EffectiveWind_Gusts(t *testing.T) {
+	ws, err := GenerateWeather(gustyConfig())
+	if err != nil {
+		t.Fatalf("GenerateWeather failed: %v", err)
+	}
+	gust := ws.Config.Gust
+
+	minX, maxX := 1e9, -1e9
+	for i := 0; i < 600; i++ {
+		ws.Update(1.0 / 30.0)
+		x, _ := ws.EffectiveWind()
+		if x < minX {
+			minX = x
+		}
+		if x > maxX {
+			maxX = x
+		}
+	}
+
+	if maxX-minX < gust.Strength*0.1 {
+		t.Errorf("wind range %v..%v too small for gust strength %v", minX, maxX, gust.Strength)
+	}
+	if minX < gust.BaseWindX-gust.Strength*0.3 || maxX > gust.BaseWindX+gust.Strength*1.3 {
+		t.Errorf("wind range %v..%v outside expected bounds", minX, maxX)
+	}
+}
+
+// TestWeatherSystem_Gusts_Deterministic tests identical seeds produce identical wind and motion.
+func TestWeatherSystem_Gusts_Deterministic(t *testing.T) {
+	a, _ := GenerateWeather(gustyConfig())
+	b, _ := GenerateWeather(gustyConfig())
+
+	for i := 0; i < 120; i++ {
+		a.Update(1.0 / 60.0)
+		b.Update(1.0 / 60.0)
+	}
+
+	ax, ay := a.EffectiveWind()
+	bx, by := b.EffectiveWind()
+	if ax != bx || ay != by {
+		t.Errorf("wind differs: (%v, %v) vs (%v, %v)", ax, ay, bx, by)
+	}
+	for i := range a.Particles {
+		if a.Particles[i].X != b.Particles[i].X || a.Particles[i].Y != b.Particles[i].Y {
+			t.Fatalf("particle %d differs between runs", i)
+		}
+	}
+}
+
+// TestGustConfig_Validate tests invalid gust parameters are rejected.
+func TestGustConfig_Validate(t *testing.T) {
+	config := gustyConfig()
+	config.Gust.Strength = -1
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for negative gust strength")
+	}
+
+	config = gustyConfig()
+	config.Gust.Frequency = -1
+	if err := config.Validate(); err == nil {
+		t.Error("expected error for negative gust frequency")
+	}
+}
@@ -0,0 +1,103 @@
+package patterns
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+)
+
+func TestBlendModes(t *testing.T) {
+	tests := []struct {
+		mode     string
+		dst, src float64
+		want     float64
+	}{
+		{BlendAlpha, 100, 200, 200},
+		{"", 100, 200, 200},
+		{BlendMultiply, 255, 128, 128},
+		{BlendMultiply, 0, 200, 0},
+		{BlendScreen, 0, 128, 128},
+		{BlendScreen, 255, 10, 255},
+		{BlendOverlay, 64, 255, 128},
+		{BlendOverlay, 255, 0, 255},
+		{BlendAdd, 100, 100, 200},
+		{BlendAdd, 200, 100, 255},
+	}
+
+	for _, tt := range tests {
+		blend, err := lookupBlend(tt.mode)
+		if err != nil {
+			t.Fatalf("lookupBlend(%q) error = %v", tt.mode, err)
+		}
+		if got := blend(tt.dst, tt.src); got != tt.want {
+			t.Errorf("%q blend(%v, %v) = %v, want %v", tt.mode, tt.dst, tt.src, got, tt.want)
+		}
+	}
+
+	if _, err := lookupBlend("dodge"); err == nil {
+		t.Error("expected error for unknown blend mode")
+	}
+}
+
+func TestBlendPixel_Opacity(t *testing.T) {
+	dst := color.RGBA{R: 100, G: 100, B: 100, A: 200}
+	src := color.RGBA{R: 200, G: 0, B: 100, A: 255}
+	blend, _ := lookupBlend(BlendAlpha)
+
+	if got := blendPixel(dst, src, blend, 0); got != dst {
+		t.Errorf("zero opacity = %v, want %v", got, dst)
+	}
+	if got := blendPixel(dst, src, blend, 1); got != (color.RGBA{R: 200, G: 0, B: 100, A: 200}) {
+		t.Errorf("full opacity = %v, want the source color with the image alpha", got)
+	}
+	if got := blendPixel(dst, src, blend, 0.5); got != (color.RGBA{R: 150, G: 50, B: 100, A: 200}) {
+		t.Errorf("half opacity = %v", got)
+	}
+}
+
+func TestApplyPatternBlended(t *testing.T) {
+	noise := DefaultConfig()
+	noise.Type = PatternFractalNoise
+	noise.Frequency = 16
+	noise.Opacity = 1
+
+	cracks := voronoiConfig()
+	cracks.Color1 = color.RGBA{R: 40, G: 40, B: 40, A: 255}
+
+	build := func(mode string) []byte {
+		img := filledImage(32, 32, color.RGBA{R: 150, G: 150, B: 150, A: 255})
+		if err := ApplyPatternBlended(img, noise, BlendAlpha); err != nil {
+			t.Fatalf("noise base: %v", err)
+		}
+		if err := ApplyPatternBlended(img, cracks, mode); err != nil {
+			t.Fatalf("cracks with %q: %v", mode, err)
+		}
+		return img.Pix
+	}
+
+	multiplied := build(BlendMultiply)
+	if !bytes.Equal(multiplied, build(BlendMultiply)) {
+		t.Error("stacked patterns are not deterministic")
+	}
+	if bytes.Equal(multiplied, build(BlendScreen)) {
+		t.Error("different blend modes produced identical textures")
+	}
+
+	// The mode argument overrides config.BlendMode
+	cracks.BlendMode = BlendScreen
+	if !bytes.Equal(multiplied, build(BlendMultiply)) {
+		t.Error("ApplyPatternBlended should ignore config.BlendMode")
+	}
+}
+
+func TestApplyPattern_Unsupported(t *testing.T) {
+	img := filledImage(8, 8, color.RGBA{A: 255})
+	config := DefaultConfig()
+	config.Type = PatternType(99)
+	if err := ApplyPattern(img, config); err == nil {
+		t.Error("expected error for an unknown pattern type")
+	}
+	if err := ApplyPatternBlended(img, voronoiConfig(), "dodge"); err == nil {
+		t.Error("expected error for unknown blend mode")
+	}
+}
@@ -2,8 +2,12 @@ package patterns
 
 // Package patterns provides procedural pattern generation for textures and overlays.
 //
-// This package implements various pattern types (stripes, dots, gradients, noise, checkerboard, circles)
-// that can be applied to existing images to add visual variety.
+// This package implements various pattern types (stripes, dots, gradients, noise, checkerboard, circles, voronoi,
+// fractal noise) that can be applied to existing images to add visual variety.
+// FractalNoise2D exposes the fractal noise function for reuse elsewhere.
+//
+// ApplyPatternBlended applies a pattern with a blend mode (alpha, multiply,
+// screen, overlay, add), so patterns can be stacked into richer textures.
 //
 // All patterns are generated deterministically using seed-based RNG,
 // ensuring reproducible results across game sessions.
@@ -0,0 +1,132 @@
+// Package patterns provides multi-octave gradient noise.
+// This file implements seeded 2D Perlin-style gradient noise summed over
+// octaves (fractal Brownian motion) for cloud, marble and fog textures, and
+// exposes the noise function for reuse outside the package.
+package patterns
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// NoiseOptions configures FractalNoise2D. Zero fields other than Seed take
+// their DefaultNoiseOptions values.
+type NoiseOptions struct {
+	Seed        int64
+	Octaves     int     // Number of noise layers summed
+	Persistence float64 // Amplitude multiplier from one octave to the next
+	Lacunarity  float64 // Frequency multiplier from one octave to the next
+}
+
+// DefaultNoiseOptions returns options for soft, cloud-like noise.
+func DefaultNoiseOptions() NoiseOptions {
+	return NoiseOptions{
+		Octaves:     4,
+		Persistence: 0.5,
+		Lacunarity:  2.0,
+	}
+}
+
+// withDefaults fills zero fields from DefaultNoiseOptions.
+func (o NoiseOptions) withDefaults() NoiseOptions {
+	defaults := DefaultNoiseOptions()
+	if o.Octaves <= 0 {
+		o.Octaves = defaults.Octaves
+	}
+	if o.Persistence == 0 {
+		o.Persistence = defaults.Persistence
+	}
+	if o.Lacunarity == 0 {
+		o.Lacunarity = defaults.Lacunarity
+	}
+	return o
+}
+
+// FractalNoise2D returns smooth noise in [-1, 1] at (x, y). The first octave
+// has features about one unit apart; each further octave adds finer detail.
+// The result is deterministic for the coordinates and options.
+func FractalNoise2D(x, y float64, opts NoiseOptions) float64 {
+	opts = opts.withDefaults()
+
+	var sum, total float64
+	amplitude, frequency := 1.0, 1.0
+	for octave := 0; octave < opts.Octaves; octave++ {
+		// Each octave gets its own gradients so layers don't line up
+		seed := opts.Seed + int64(octave)*0x9E3779B9
+		sum += gradientNoise2D(x*frequency, y*frequency, seed) * amplitude
+		total += amplitude
+		amplitude *= opts.Persistence
+		frequency *= opts.Lacunarity
+	}
+	return math.Max(-1, math.Min(1, sum/total))
+}
+
+// gradientNoise2D returns Perlin gradient noise, roughly in [-1, 1], with
+// gradients chosen by hashing lattice points with seed.
+func gradientNoise2D(x, y float64, seed int64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	ix, iy := int64(x0), int64(y0)
+	fx, fy := x-x0, y-y0
+
+	n00 := latticeGradient(ix, iy, seed, fx, fy)
+	n10 := latticeGradient(ix+1, iy, seed, fx-1, fy)
+	n01 := latticeGradient(ix, iy+1, seed, fx, fy-1)
+	n11 := latticeGradient(ix+1, iy+1, seed, fx-1, fy-1)
+
+	u, v := fade(fx), fade(fy)
+	top := n00 + (n10-n00)*u
+	bottom := n01 + (n11-n01)*u
+	// 2D Perlin noise peaks at ±sqrt(2)/2, so scale it to ±1
+	return (top + (bottom-top)*v) * math.Sqrt2
+}
+
+// latticeGradient returns the dot product of the lattice point's gradient
+// with the offset (dx, dy) from that point.
+func latticeGradient(ix, iy, seed int64, dx, dy float64) float64 {
+	angle := latticeHash(ix, iy, seed) * 2 * math.Pi
+	return math.Cos(angle)*dx + math.Sin(angle)*dy
+}
+
+// latticeHash returns a uniform value in [0, 1) for a lattice point and
+// seed.
+func latticeHash(ix, iy, seed int64) float64 {
+	h := uint64(ix)*0x9E3779B97F4A7C15 ^ uint64(iy)*0xC2B2AE3D27D4EB4F ^ uint64(seed)*0x165667B19E3779F9
+	h ^= h >> 31
+	h *= 0xBF58476D1CE4E5B9
+	h ^= h >> 29
+	return float64(h>>11) / float64(1<<53)
+}
+
+// fade is Perlin's quintic ease curve, giving continuous second derivatives
+// across lattice cells.
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// ApplyFractalNoise overlays fractal noise onto img in place.
+//
+// config.Frequency sets the size of the largest features in pixels, and
+// config.Octaves, config.Persistence and config.Lacunarity shape the detail
+// (see NoiseOptions). Each pixel mixes config.Color2 (low noise) toward
+// config.Color1 (high noise), with config.Amplitude as the contrast (0.5
+// spans the full range), and is blended with config.BlendMode at
+// config.Opacity. The result is deterministic for a seed.
+func ApplyFractalNoise(img *image.RGBA, config Config) error {
+	if img == nil {
+		return fmt.Errorf("image is nil")
+	}
+	if config.Frequency <= 0 {
+		return fmt.Errorf("frequency must be positive, got %f", config.Frequency)
+	}
+	opts := NoiseOptions{
+		Seed:        config.Seed,
+		Octaves:     config.Octaves,
+		Persistence: config.Persistence,
+		Lacunarity:  config.Lacunarity,
+	}
+	return applyShade(img, config, func(x, y int) float64 {
+		n := FractalNoise2D(float64(x)/config.Frequency, float64(y)/config.Frequency, opts)
+		return 0.5 + n*config.Amplitude
+	})
+}
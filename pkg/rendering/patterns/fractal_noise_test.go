@@ -0,0 +1,125 @@
+package patterns
+
+import (
+	"bytes"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestFractalNoise2D_Range(t *testing.T) {
+	opts := DefaultNoiseOptions()
+	opts.Seed = 42
+
+	var minV, maxV float64
+	for y := 0.0; y < 20; y += 0.37 {
+		for x := 0.0; x < 20; x += 0.37 {
+			v := FractalNoise2D(x, y, opts)
+			if v < -1 || v > 1 || math.IsNaN(v) {
+				t.Fatalf("FractalNoise2D(%v, %v) = %v, want in [-1, 1]", x, y, v)
+			}
+			minV, maxV = math.Min(minV, v), math.Max(maxV, v)
+		}
+	}
+	if maxV-minV < 0.5 {
+		t.Errorf("noise spans only [%v, %v], want more variation", minV, maxV)
+	}
+}
+
+func TestFractalNoise2D_Smooth(t *testing.T) {
+	opts := DefaultNoiseOptions()
+	for x := 0.0; x < 10; x += 0.1 {
+		a := FractalNoise2D(x, 3.3, opts)
+		b := FractalNoise2D(x+0.001, 3.3, opts)
+		if math.Abs(a-b) > 0.05 {
+			t.Fatalf("noise jumps from %v to %v between x=%v and x=%v", a, b, x, x+0.001)
+		}
+	}
+}
+
+func TestFractalNoise2D_Deterministic(t *testing.T) {
+	opts := NoiseOptions{Seed: 7, Octaves: 5, Persistence: 0.6, Lacunarity: 2.1}
+	if a, b := FractalNoise2D(1.5, -2.25, opts), FractalNoise2D(1.5, -2.25, opts); a != b {
+		t.Errorf("same inputs gave %v and %v", a, b)
+	}
+
+	other := opts
+	other.Seed = 8
+	same := 0
+	for i := 0; i < 10; i++ {
+		x := float64(i) * 0.7
+		if FractalNoise2D(x, x, opts) == FractalNoise2D(x, x, other) {
+			same++
+		}
+	}
+	if same == 10 {
+		t.Error("different seeds gave identical noise")
+	}
+}
+
+func TestFractalNoise2D_Defaults(t *testing.T) {
+	// Zero options behave like the defaults
+	if a, b := FractalNoise2D(0.3, 0.6, NoiseOptions{}), FractalNoise2D(0.3, 0.6, DefaultNoiseOptions()); a != b {
+		t.Errorf("zero options = %v, defaults = %v", a, b)
+	}
+}
+
+func TestFractalNoise2D_Octaves(t *testing.T) {
+	// More octaves add fine detail: the noise curves more sharply
+	roughness := func(octaves int) float64 {
+		opts := NoiseOptions{Seed: 3, Octaves: octaves}
+		const h = 0.02
+		var sum float64
+		for x := h; x < 10; x += h {
+			sum += math.Abs(FractalNoise2D(x+h, 1.7, opts) - 2*FractalNoise2D(x, 1.7, opts) + FractalNoise2D(x-h, 1.7, opts))
+		}
+		return sum
+	}
+	if smooth, rough := roughness(1), roughness(6); rough <= smooth {
+		t.Errorf("6 octaves roughness %v, 1 octave %v; want more detail with more octaves", rough, smooth)
+	}
+}
+
+func TestApplyFractalNoise(t *testing.T) {
+	config := DefaultConfig()
+	config.Type = PatternFractalNoise
+	config.Frequency = 16
+	config.Seed = 5
+	config.Opacity = 1
+	config.BlendMode = ""
+
+	a := filledImage(32, 32, color.RGBA{R: 128, G: 128, B: 128, A: 255})
+	if err := ApplyFractalNoise(a, config); err != nil {
+		t.Fatalf("ApplyFractalNoise() error = %v", err)
+	}
+
+	// Pixels vary between Color2 and Color1
+	var minR, maxR uint8 = 255, 0
+	for i := 0; i < len(a.Pix); i += 4 {
+		minR, maxR = min(minR, a.Pix[i]), max(maxR, a.Pix[i])
+	}
+	if maxR-minR < 64 {
+		t.Errorf("red channel spans only %d-%d, want a visible texture", minR, maxR)
+	}
+
+	b := filledImage(32, 32, color.RGBA{R: 128, G: 128, B: 128, A: 255})
+	_ = ApplyFractalNoise(b, config)
+	if !bytes.Equal(a.Pix, b.Pix) {
+		t.Error("same seed produced different textures")
+	}
+
+	config.Frequency = 0
+	if err := ApplyFractalNoise(a, config); err == nil {
+		t.Error("expected error for zero frequency")
+	}
+	if err := ApplyFractalNoise(nil, DefaultConfig()); err == nil {
+		t.Error("expected error for nil image")
+	}
+}
+
+func BenchmarkFractalNoise2D(b *testing.B) {
+	opts := DefaultNoiseOptions()
+	for i := 0; i < b.N; i++ {
+		_ = FractalNoise2D(float64(i)*0.01, 0.5, opts)
+	}
+}
@@ -0,0 +1,168 @@
+// Package patterns provides the basic pattern primitives.
+// This file implements stripes, dots, gradients, noise, checkerboards and
+// circles. Each computes a shade per pixel that mixes config.Color2 (0)
+// toward config.Color1 (1), blended onto the image like the other patterns.
+package patterns
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+)
+
+// shadeFunc returns the shade (0-1) at a pixel offset from the image's
+// top-left corner.
+type shadeFunc func(x, y int) float64
+
+// applyShade mixes config.Color2 toward config.Color1 by shade at every
+// pixel of img and blends the result with config.BlendMode at
+// config.Opacity. Nil colors default to white and black.
+func applyShade(img *image.RGBA, config Config, shade shadeFunc) error {
+	blend, err := lookupBlend(config.BlendMode)
+	if err != nil {
+		return err
+	}
+
+	high, low := color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBA{A: 255}
+	if config.Color1 != nil {
+		high = color.RGBAModel.Convert(config.Color1).(color.RGBA)
+	}
+	if config.Color2 != nil {
+		low = color.RGBAModel.Convert(config.Color2).(color.RGBA)
+	}
+
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			t := math.Max(0, math.Min(1, shade(x-bounds.Min.X, y-bounds.Min.Y)))
+			src := color.RGBA{
+				R: uint8(float64(low.R) + (float64(high.R)-float64(low.R))*t + 0.5),
+				G: uint8(float64(low.G) + (float64(high.G)-float64(low.G))*t + 0.5),
+				B: uint8(float64(low.B) + (float64(high.B)-float64(low.B))*t + 0.5),
+			}
+			img.SetRGBA(x, y, blendPixel(img.RGBAAt(x, y), src, blend, config.Opacity))
+		}
+	}
+	return nil
+}
+
+// rotated returns the pixel center of (x, y) rotated by angle degrees.
+func rotated(x, y int, angle float64) (float64, float64) {
+	sin, cos := math.Sincos(angle * math.Pi / 180)
+	px, py := float64(x)+0.5, float64(y)+0.5
+	return px*cos + py*sin, py*cos - px*sin
+}
+
+// checkPattern validates the image and, for patterns built on a grid or
+// period, the frequency.
+func checkPattern(img *image.RGBA, config Config, needsFrequency bool) error {
+	if img == nil {
+		return fmt.Errorf("image is nil")
+	}
+	if needsFrequency && config.Frequency <= 0 {
+		return fmt.Errorf("frequency must be positive, got %f", config.Frequency)
+	}
+	return nil
+}
+
+// ApplyStripes overlays parallel stripes onto img in place. Stripes repeat
+// every config.Frequency pixels, half Color1 and half Color2, and run at
+// config.Angle degrees (0 = vertical).
+func ApplyStripes(img *image.RGBA, config Config) error {
+	if err := checkPattern(img, config, true); err != nil {
+		return err
+	}
+	return applyShade(img, config, func(x, y int) float64 {
+		u, _ := rotated(x, y, config.Angle)
+		if math.Mod(math.Mod(u, config.Frequency)+config.Frequency, config.Frequency) < config.Frequency/2 {
+			return 1
+		}
+		return 0
+	})
+}
+
+// ApplyCheckerboard overlays a checkerboard of config.Frequency pixel
+// squares onto img in place, rotated by config.Angle degrees.
+func ApplyCheckerboard(img *image.RGBA, config Config) error {
+	if err := checkPattern(img, config, true); err != nil {
+		return err
+	}
+	return applyShade(img, config, func(x, y int) float64 {
+		u, v := rotated(x, y, config.Angle)
+		if (int64(math.Floor(u/config.Frequency))+int64(math.Floor(v/config.Frequency)))%2 == 0 {
+			return 1
+		}
+		return 0
+	})
+}
+
+// ApplyDots overlays a grid of Color1 dots on Color2 onto img in place.
+// Dots are config.Frequency pixels apart and config.Amplitude sets their
+// diameter as a fraction of the spacing (0.5 = half).
+func ApplyDots(img *image.RGBA, config Config) error {
+	if err := checkPattern(img, config, true); err != nil {
+		return err
+	}
+	radius := config.Amplitude * config.Frequency / 2
+	return applyShade(img, config, func(x, y int) float64 {
+		u, v := rotated(x, y, config.Angle)
+		cu := (math.Floor(u/config.Frequency) + 0.5) * config.Frequency
+		cv := (math.Floor(v/config.Frequency) + 0.5) * config.Frequency
+		// Antialias over one pixel at the dot's edge
+		return radius + 0.5 - math.Hypot(u-cu, v-cv)
+	})
+}
+
+// ApplyCircles overlays concentric rings around the image center onto img
+// in place. Rings repeat every config.Frequency pixels, half Color1 and
+// half Color2.
+func ApplyCircles(img *image.RGBA, config Config) error {
+	if err := checkPattern(img, config, true); err != nil {
+		return err
+	}
+	cx, cy := float64(img.Bounds().Dx())/2, float64(img.Bounds().Dy())/2
+	return applyShade(img, config, func(x, y int) float64 {
+		d := math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy)
+		if math.Mod(d, config.Frequency) < config.Frequency/2 {
+			return 1
+		}
+		return 0
+	})
+}
+
+// ApplyGradient overlays a linear gradient from Color2 to Color1 across
+// img in place, along config.Angle degrees (0 = left to right).
+func ApplyGradient(img *image.RGBA, config Config) error {
+	if err := checkPattern(img, config, false); err != nil {
+		return err
+	}
+
+	// Span the projections of the image corners so the gradient covers the
+	// image at any angle
+	sin, cos := math.Sincos(config.Angle * math.Pi / 180)
+	w, h := float64(img.Bounds().Dx()), float64(img.Bounds().Dy())
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, corner := range [][2]float64{{0, 0}, {w, 0}, {0, h}, {w, h}} {
+		u := corner[0]*cos + corner[1]*sin
+		lo, hi = math.Min(lo, u), math.Max(hi, u)
+	}
+	span := math.Max(hi-lo, 1)
+	return applyShade(img, config, func(x, y int) float64 {
+		u, _ := rotated(x, y, config.Angle)
+		return (u - lo) / span
+	})
+}
+
+// ApplyNoise overlays per-pixel random noise onto img in place. Shades
+// scatter around the midpoint by config.Amplitude (0.5 spans the full
+// range). The result is deterministic for a seed.
+func ApplyNoise(img *image.RGBA, config Config) error {
+	if err := checkPattern(img, config, false); err != nil {
+		return err
+	}
+	return applyShade(img, config, func(x, y int) float64 {
+		n := latticeHash(int64(x), int64(y), config.Seed)*2 - 1
+		return 0.5 + n*config.Amplitude
+	})
+}
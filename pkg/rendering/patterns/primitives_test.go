@@ -0,0 +1,82 @@
+package patterns
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+)
+
+// primitiveConfig returns an opaque alpha-blended config of the given type.
+func primitiveConfig(patternType PatternType) Config {
+	config := DefaultConfig()
+	config.Type = patternType
+	config.Frequency = 8
+	config.Opacity = 1
+	config.BlendMode = BlendAlpha
+	config.Seed = 3
+	return config
+}
+
+func TestApplyPattern_AllTypes(t *testing.T) {
+	fill := color.RGBA{R: 128, G: 128, B: 128, A: 255}
+	for _, patternType := range []PatternType{
+		PatternStripes, PatternDots, PatternGradient, PatternNoise,
+		PatternCheckerboard, PatternCircles, PatternVoronoi, PatternFractalNoise,
+	} {
+		t.Run(patternType.String(), func(t *testing.T) {
+			a := filledImage(32, 32, fill)
+			b := filledImage(32, 32, fill)
+			if err := ApplyPattern(a, primitiveConfig(patternType)); err != nil {
+				t.Fatalf("ApplyPattern failed: %v", err)
+			}
+			if err := ApplyPattern(b, primitiveConfig(patternType)); err != nil {
+				t.Fatalf("ApplyPattern failed: %v", err)
+			}
+			if !bytes.Equal(a.Pix, b.Pix) {
+				t.Error("pattern is not deterministic")
+			}
+			if bytes.Equal(a.Pix, filledImage(32, 32, fill).Pix) {
+				t.Error("pattern left the image unchanged")
+			}
+		})
+	}
+}
+
+func TestApplyStripes(t *testing.T) {
+	img := filledImage(16, 4, color.RGBA{A: 255})
+	if err := ApplyStripes(img, primitiveConfig(PatternStripes)); err != nil {
+		t.Fatalf("ApplyStripes failed: %v", err)
+	}
+	// Vertical stripes 4 pixels wide, starting with Color1 (white)
+	for x, want := range []uint8{255, 255, 255, 255, 0, 0, 0, 0, 255} {
+		if got := img.RGBAAt(x, 2).R; got != want {
+			t.Errorf("pixel %d = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestApplyGradient(t *testing.T) {
+	img := filledImage(16, 4, color.RGBA{A: 255})
+	if err := ApplyGradient(img, primitiveConfig(PatternGradient)); err != nil {
+		t.Fatalf("ApplyGradient failed: %v", err)
+	}
+	for x := 1; x < 16; x++ {
+		if img.RGBAAt(x, 0).R <= img.RGBAAt(x-1, 0).R {
+			t.Fatalf("gradient not increasing left to right at x=%d", x)
+		}
+	}
+	if img.RGBAAt(0, 0).R != img.RGBAAt(0, 3).R {
+		t.Error("horizontal gradient should not vary down a column")
+	}
+}
+
+func TestApplyPrimitives_InvalidConfig(t *testing.T) {
+	config := primitiveConfig(PatternCheckerboard)
+	config.Frequency = 0
+	if err := ApplyPattern(filledImage(4, 4, color.RGBA{A: 255}), config); err == nil {
+		t.Error("expected error for zero frequency")
+	}
+	if err := ApplyGradient(nil, primitiveConfig(PatternGradient)); err == nil {
+		t.Error("expected error for nil image")
+	}
+}
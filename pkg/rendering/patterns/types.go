@@ -21,6 +21,10 @@ const (
 	PatternCheckerboard
 	// PatternCircles represents concentric or scattered circles
 	PatternCircles
+	// PatternVoronoi represents cell edges (cracks) between scattered points
+	PatternVoronoi
+	// PatternFractalNoise represents smooth multi-octave gradient noise
+	PatternFractalNoise
 )
 
 // String returns the string representation of a pattern type.
@@ -38,6 +42,10 @@ func (p PatternType) String() string {
 		return "checkerboard"
 	case PatternCircles:
 		return "circles"
+	case PatternVoronoi:
+		return "voronoi"
+	case PatternFractalNoise:
+		return "fractal_noise"
 	default:
 		return "unknown"
 	}
@@ -51,9 +59,15 @@ type Config struct {
 	Seed   int64
 
 	// Pattern-specific parameters
-	Frequency float64 // For stripes, dots, checkerboard (spacing between elements)
+	Frequency float64 // For stripes, dots, checkerboard, voronoi, fractal noise (spacing between elements)
 	Amplitude float64 // For noise intensity, wave amplitude
 	Angle     float64 // Rotation angle in degrees (0-360)
+	Thickness float64 // For voronoi, crack line width in pixels
+
+	// Fractal noise parameters (see NoiseOptions)
+	Octaves     int
+	Persistence float64
+	Lacunarity  float64
 
 	// Color parameters
 	Color1 color.Color // Primary/foreground color
@@ -61,22 +75,26 @@ type Config struct {
 
 	// Blending
 	Opacity   float64 // Pattern opacity (0.0-1.0)
-	BlendMode string  // "overlay", "multiply", "screen", "add"
+	BlendMode string  // "alpha", "overlay", "multiply", "screen", "add" (see BlendAlpha)
 }
 
 // DefaultConfig returns a default pattern configuration.
 func DefaultConfig() Config {
 	return Config{
-		Type:      PatternStripes,
-		Width:     32,
-		Height:    32,
-		Seed:      0,
-		Frequency: 4.0,
-		Amplitude: 0.5,
-		Angle:     0,
-		Color1:    color.RGBA{R: 255, G: 255, B: 255, A: 255},
-		Color2:    color.RGBA{R: 0, G: 0, B: 0, A: 255},
-		Opacity:   0.5,
-		BlendMode: "overlay",
+		Type:        PatternStripes,
+		Width:       32,
+		Height:      32,
+		Seed:        0,
+		Frequency:   4.0,
+		Amplitude:   0.5,
+		Angle:       0,
+		Thickness:   1.0,
+		Octaves:     4,
+		Persistence: 0.5,
+		Lacunarity:  2.0,
+		Color1:      color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		Color2:      color.RGBA{R: 0, G: 0, B: 0, A: 255},
+		Opacity:     0.5,
+		BlendMode:   "overlay",
 	}
 }
@@ -18,6 +18,8 @@ func TestPatternType_String(t *testing.T) {
 		{"Noise pattern", PatternNoise, "noise"},
 		{"Checkerboard pattern", PatternCheckerboard, "checkerboard"},
 		{"Circles pattern", PatternCircles, "circles"},
+		{"Voronoi pattern", PatternVoronoi, "voronoi"},
+		{"Fractal noise pattern", PatternFractalNoise, "fractal_noise"},
 		{"Unknown pattern", PatternType(999), "unknown"},
 	}
 
@@ -153,6 +155,8 @@ func TestPatternType_Constants(t *testing.T) {
 		{"PatternNoise is 3", PatternNoise, 3},
 		{"PatternCheckerboard is 4", PatternCheckerboard, 4},
 		{"PatternCircles is 5", PatternCircles, 5},
+		{"PatternVoronoi is 6", PatternVoronoi, 6},
+		{"PatternFractalNoise is 7", PatternFractalNoise, 7},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,142 @@
+// Package patterns provides the Voronoi crack pattern.
+// This file scatters seed points on a jittered grid and draws the edges
+// between their cells, giving cracked stone, dried mud or scale textures.
+package patterns
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+// ApplyVoronoi overlays Voronoi cell edges (cracks) onto img in place.
+//
+// config.Frequency sets the average cell size in pixels (smaller is denser)
+// and config.Thickness the crack width. Cracks are drawn in config.Color1
+// (black if nil) with config.BlendMode at config.Opacity; cell interiors are
+// left unchanged. Seed points wrap around the image, so the cracks tile
+// seamlessly. The result is deterministic for a seed.
+func ApplyVoronoi(img *image.RGBA, config Config) error {
+	if img == nil {
+		return fmt.Errorf("image is nil")
+	}
+	if config.Frequency <= 0 {
+		return fmt.Errorf("frequency must be positive, got %f", config.Frequency)
+	}
+	if config.Thickness <= 0 {
+		return fmt.Errorf("thickness must be positive, got %f", config.Thickness)
+	}
+	blend, err := lookupBlend(config.BlendMode)
+	if err != nil {
+		return err
+	}
+
+	bounds := img.Bounds()
+	w, h := float64(bounds.Dx()), float64(bounds.Dy())
+	cells := newVoronoiCells(w, h, config.Frequency, rand.New(rand.NewSource(config.Seed)))
+
+	crack := color.RGBA{A: 255}
+	if config.Color1 != nil {
+		crack = color.RGBAModel.Convert(config.Color1).(color.RGBA)
+	}
+	halfWidth := config.Thickness / 2
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			px, py := float64(x-bounds.Min.X)+0.5, float64(y-bounds.Min.Y)+0.5
+
+			// Antialias: full coverage within half the thickness of an edge,
+			// fading out over one more pixel
+			coverage := math.Max(0, math.Min(1, halfWidth+0.5-cells.edgeDistance(px, py)))
+			if coverage == 0 {
+				continue
+			}
+			img.SetRGBA(x, y, blendPixel(img.RGBAAt(x, y), crack, blend, coverage*config.Opacity))
+		}
+	}
+	return nil
+}
+
+// voronoiCells holds one jittered seed point per grid cell, on a grid that
+// wraps around the image.
+type voronoiCells struct {
+	cols, rows    int
+	cellW, cellH  float64
+	width, height float64
+	points        [][2]float64 // Seed point per grid cell, row-major
+}
+
+// newVoronoiCells scatters seed points over a width by height area with
+// roughly spacing pixels between them.
+func newVoronoiCells(width, height, spacing float64, rng *rand.Rand) *voronoiCells {
+	cols := max(1, int(math.Round(width/spacing)))
+	rows := max(1, int(math.Round(height/spacing)))
+	v := &voronoiCells{
+		cols: cols, rows: rows,
+		cellW: width / float64(cols), cellH: height / float64(rows),
+		width: width, height: height,
+		points: make([][2]float64, cols*rows),
+	}
+	for i := range v.points {
+		col, row := i%cols, i/cols
+		v.points[i] = [2]float64{
+			(float64(col) + rng.Float64()) * v.cellW,
+			(float64(row) + rng.Float64()) * v.cellH,
+		}
+	}
+	return v
+}
+
+// edgeDistance returns the distance from (x, y) to the nearest edge of the
+// cell it lies in.
+func (v *voronoiCells) edgeDistance(x, y float64) float64 {
+	col := int(x / v.cellW)
+	row := int(y / v.cellH)
+
+	// Collect seed points from the surrounding cells, shifted by a whole
+	// image when they wrap, so the pattern tiles
+	var near [25][2]float64
+	n := 0
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c, r := col+dx, row+dy
+			wc := ((c % v.cols) + v.cols) % v.cols
+			wr := ((r % v.rows) + v.rows) % v.rows
+			p := v.points[wr*v.cols+wc]
+			near[n] = [2]float64{
+				p[0] + float64(c-wc)/float64(v.cols)*v.width,
+				p[1] + float64(r-wr)/float64(v.rows)*v.height,
+			}
+			n++
+		}
+	}
+
+	nearest, best := 0, math.Inf(1)
+	for i, p := range near[:n] {
+		if d := math.Hypot(p[0]-x, p[1]-y); d < best {
+			nearest, best = i, d
+		}
+	}
+
+	// Distance to the bisector with each other point; the closest bisector
+	// is the cell edge
+	edge := math.Inf(1)
+	a := near[nearest]
+	for i, b := range near[:n] {
+		if i == nearest {
+			continue
+		}
+		dx, dy := b[0]-a[0], b[1]-a[1]
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+		// Signed distance from the midpoint along the a->b direction
+		mx, my := (a[0]+b[0])/2, (a[1]+b[1])/2
+		d := ((mx-x)*dx + (my-y)*dy) / length
+		edge = math.Min(edge, d)
+	}
+	return edge
+}
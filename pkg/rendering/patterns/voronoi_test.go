@@ -0,0 +1,162 @@
+package patterns
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// voronoiConfig returns a config that paints opaque black cracks.
+func voronoiConfig() Config {
+	config := DefaultConfig()
+	config.Type = PatternVoronoi
+	config.Width, config.Height = 64, 64
+	config.Seed = 99
+	config.Frequency = 16
+	config.Thickness = 2
+	config.Color1 = color.RGBA{A: 255}
+	config.Opacity = 1
+	config.BlendMode = ""
+	return config
+}
+
+// filledImage returns a w by h image filled with c.
+func filledImage(w, h int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img
+}
+
+// crackedFraction returns the fraction of pixels darker than the fill.
+func crackedFraction(img *image.RGBA, fill color.RGBA) float64 {
+	cracked := 0
+	for i := 0; i < len(img.Pix); i += 4 {
+		if img.Pix[i] < fill.R {
+			cracked++
+		}
+	}
+	return float64(cracked) / float64(len(img.Pix)/4)
+}
+
+func TestApplyVoronoi(t *testing.T) {
+	fill := color.RGBA{R: 200, G: 180, B: 160, A: 255}
+	img := filledImage(64, 64, fill)
+
+	if err := ApplyVoronoi(img, voronoiConfig()); err != nil {
+		t.Fatalf("ApplyVoronoi() error = %v", err)
+	}
+
+	fraction := crackedFraction(img, fill)
+	if fraction < 0.05 || fraction > 0.5 {
+		t.Errorf("cracked fraction = %.2f, want cracks without covering the image", fraction)
+	}
+}
+
+func TestApplyVoronoi_DensityAndThickness(t *testing.T) {
+	fill := color.RGBA{R: 200, G: 200, B: 200, A: 255}
+	measure := func(frequency, thickness float64) float64 {
+		config := voronoiConfig()
+		config.Frequency, config.Thickness = frequency, thickness
+		img := filledImage(64, 64, fill)
+		if err := ApplyVoronoi(img, config); err != nil {
+			t.Fatal(err)
+		}
+		return crackedFraction(img, fill)
+	}
+
+	if sparse, dense := measure(32, 1), measure(8, 1); dense <= sparse {
+		t.Errorf("denser cells cracked %.2f, sparser %.2f; want more cracks when denser", dense, sparse)
+	}
+	if thin, thick := measure(16, 1), measure(16, 4); thick <= thin {
+		t.Errorf("thick cracks covered %.2f, thin %.2f; want thicker to cover more", thick, thin)
+	}
+}
+
+func TestApplyVoronoi_Deterministic(t *testing.T) {
+	fill := color.RGBA{R: 128, G: 128, B: 128, A: 255}
+	a, b := filledImage(48, 48, fill), filledImage(48, 48, fill)
+	config := voronoiConfig()
+
+	if err := ApplyVoronoi(a, config); err != nil {
+		t.Fatal(err)
+	}
+	_ = ApplyVoronoi(b, config)
+	if !bytes.Equal(a.Pix, b.Pix) {
+		t.Error("same seed produced different cracks")
+	}
+
+	config.Seed++
+	c := filledImage(48, 48, fill)
+	_ = ApplyVoronoi(c, config)
+	if bytes.Equal(a.Pix, c.Pix) {
+		t.Error("different seeds produced identical cracks")
+	}
+}
+
+func TestVoronoiCells_Seamless(t *testing.T) {
+	cells := newVoronoiCells(64, 48, 16, rand.New(rand.NewSource(7)))
+
+	// Edge distance must match across opposite image edges
+	for i := 0; i <= 64; i++ {
+		f := float64(i)
+		if a, b := cells.edgeDistance(0, f*0.75), cells.edgeDistance(64, f*0.75); math.Abs(a-b) > 1e-9 {
+			t.Errorf("left/right edge distance at y=%.2f: %v != %v", f*0.75, a, b)
+		}
+		if a, b := cells.edgeDistance(f, 0), cells.edgeDistance(f, 48); math.Abs(a-b) > 1e-9 {
+			t.Errorf("top/bottom edge distance at x=%.2f: %v != %v", f, a, b)
+		}
+	}
+}
+
+func TestApplyVoronoi_BlendModes(t *testing.T) {
+	fill := color.RGBA{R: 100, G: 100, B: 100, A: 255}
+	for _, mode := range []string{"", "overlay", "multiply", "screen", "add"} {
+		config := voronoiConfig()
+		config.BlendMode = mode
+		config.Color1 = color.RGBA{R: 200, G: 200, B: 200, A: 255}
+		img := filledImage(32, 32, fill)
+		if err := ApplyVoronoi(img, config); err != nil {
+			t.Errorf("blend mode %q: error = %v", mode, err)
+		}
+	}
+
+	// Zero opacity leaves the image untouched
+	config := voronoiConfig()
+	config.Opacity = 0
+	img := filledImage(32, 32, fill)
+	_ = ApplyVoronoi(img, config)
+	if !bytes.Equal(img.Pix, filledImage(32, 32, fill).Pix) {
+		t.Error("zero opacity should not change the image")
+	}
+}
+
+func TestApplyVoronoi_Errors(t *testing.T) {
+	img := filledImage(16, 16, color.RGBA{A: 255})
+
+	if err := ApplyVoronoi(nil, voronoiConfig()); err == nil {
+		t.Error("expected error for nil image")
+	}
+
+	config := voronoiConfig()
+	config.Frequency = 0
+	if err := ApplyVoronoi(img, config); err == nil {
+		t.Error("expected error for zero frequency")
+	}
+
+	config = voronoiConfig()
+	config.Thickness = 0
+	if err := ApplyVoronoi(img, config); err == nil {
+		t.Error("expected error for zero thickness")
+	}
+
+	config = voronoiConfig()
+	config.BlendMode = "dodge"
+	if err := ApplyVoronoi(img, config); err == nil {
+		t.Error("expected error for unknown blend mode")
+	}
+}
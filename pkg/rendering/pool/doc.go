@@ -4,11 +4,14 @@
 // performance by reusing objects instead of creating new ones.
 //
 // Key features:
-//   - Multiple pools for common sprite sizes (28x28, 32x32, 64x64)
+//   - Multiple pools for common sprite sizes (16, 28, 32, 48, 64 and 128 square)
 //   - Automatic size-based pool selection
 //   - Zero-allocation retrieval from pools
 //   - Automatic cleanup and reset on return
 //   - Thread-safe operations via sync.Pool
+//   - Per-size hit/miss statistics and a count of non-pooled sizes requested
+//   - Generic typed Pool[T] with a reset function that runs on Put, plus
+//     ready-made pools for DrawImageOptions and particle buffers
 //
 // Usage:
 //
@@ -23,7 +26,8 @@
 //
 // Performance considerations:
 //   - Always return pooled objects when done
-//   - Prefer pooled sizes (28x28, 32x32, 64x64) for best performance
-//   - Non-standard sizes create new images (not pooled)
+//   - Prefer pooled sizes (see SizeClasses) for best performance
+//   - Non-standard sizes create new images (not pooled); Stats().NonPooledSizes
+//     shows which ones are requested often enough to be worth pooling
 //   - Pool automatically grows/shrinks based on demand
 package pool
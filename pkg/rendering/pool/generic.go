@@ -0,0 +1,90 @@
+package pool
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// maxParticleBufferCap is the largest particle buffer capacity kept for
+// reuse, so one huge burst doesn't pin its memory forever.
+const maxParticleBufferCap = 1 << 16
+
+// Pool is a typed wrapper over sync.Pool.
+type Pool[T any] struct {
+	pool  sync.Pool
+	reset func(T)
+}
+
+// NewPool creates a pool that allocates values with newFn. If reset is
+// non-nil, it runs on every value passed to Put, so no state from one user
+// leaks to the next.
+func NewPool[T any](newFn func() T, reset func(T)) *Pool[T] {
+	p := &Pool[T]{reset: reset}
+	p.pool.New = func() interface{} {
+		return newFn()
+	}
+	return p
+}
+
+// Get returns a pooled value, allocating a new one if the pool is empty.
+func (p *Pool[T]) Get() T {
+	return p.pool.Get().(T)
+}
+
+// Put resets v and returns it to the pool.
+func (p *Pool[T]) Put(v T) {
+	if p.reset != nil {
+		p.reset(v)
+	}
+	p.pool.Put(v)
+}
+
+// drawOptionsPool pools draw options for render loops.
+var drawOptionsPool = NewPool(
+	func() *ebiten.DrawImageOptions { return &ebiten.DrawImageOptions{} },
+	func(op *ebiten.DrawImageOptions) { *op = ebiten.DrawImageOptions{} },
+)
+
+// particleBufferPool pools float64 buffers for particle simulation. Buffers
+// are stored by pointer so Put doesn't allocate.
+var particleBufferPool = NewPool(
+	func() *[]float64 { return new([]float64) },
+	func(buf *[]float64) { *buf = (*buf)[:0] },
+)
+
+// GetDrawImageOptions returns zeroed draw options from the global pool.
+func GetDrawImageOptions() *ebiten.DrawImageOptions {
+	return drawOptionsPool.Get()
+}
+
+// PutDrawImageOptions resets draw options and returns them to the global
+// pool. The options must not be used afterwards.
+func PutDrawImageOptions(op *ebiten.DrawImageOptions) {
+	if op == nil {
+		return
+	}
+	drawOptionsPool.Put(op)
+}
+
+// GetParticleBuffer returns a buffer of n zeroed float64s from the global
+// pool.
+func GetParticleBuffer(n int) *[]float64 {
+	buf := particleBufferPool.Get()
+	if cap(*buf) < n {
+		*buf = make([]float64, n)
+		return buf
+	}
+	*buf = (*buf)[:n]
+	clear(*buf)
+	return buf
+}
+
+// PutParticleBuffer returns a buffer to the global pool. The buffer must not
+// be used afterwards. Very large buffers are dropped instead of pooled.
+func PutParticleBuffer(buf *[]float64) {
+	if buf == nil || cap(*buf) > maxParticleBufferCap {
+		return
+	}
+	particleBufferPool.Put(buf)
+}
@@ -0,0 +1,96 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type pooledCounter struct {
+	count int
+}
+
+func TestPool_GetPut(t *testing.T) {
+	created := 0
+	p := NewPool(
+		func() *pooledCounter { created++; return &pooledCounter{} },
+		func(c *pooledCounter) { c.count = 0 },
+	)
+
+	c := p.Get()
+	if c == nil {
+		t.Fatal("Get returned nil")
+	}
+	if created != 1 {
+		t.Errorf("created = %d, want 1", created)
+	}
+
+	c.count = 42
+	p.Put(c)
+	if c.count != 0 {
+		t.Errorf("count after Put = %d, want reset to 0", c.count)
+	}
+
+	// Whether or not the value is reused, it must come back clean
+	if got := p.Get(); got.count != 0 {
+		t.Errorf("Get after Put returned count %d, want 0", got.count)
+	}
+}
+
+func TestPool_NilReset(t *testing.T) {
+	p := NewPool(func() *pooledCounter { return &pooledCounter{} }, nil)
+	c := p.Get()
+	c.count = 7
+	p.Put(c) // Must not panic without a reset function
+}
+
+func TestDrawImageOptionsPool(t *testing.T) {
+	op := GetDrawImageOptions()
+	op.GeoM.Translate(10, 20)
+	op.ColorScale.ScaleAlpha(0.5)
+	op.Filter = ebiten.FilterLinear
+
+	PutDrawImageOptions(op)
+	if op.GeoM != (ebiten.GeoM{}) || op.Filter != ebiten.FilterNearest {
+		t.Error("PutDrawImageOptions did not reset the options")
+	}
+
+	PutDrawImageOptions(nil) // Must not panic
+}
+
+func TestParticleBufferPool(t *testing.T) {
+	buf := GetParticleBuffer(8)
+	if len(*buf) != 8 {
+		t.Fatalf("len = %d, want 8", len(*buf))
+	}
+	for i := range *buf {
+		(*buf)[i] = float64(i + 1)
+	}
+	PutParticleBuffer(buf)
+
+	// Reused or not, the buffer must be zeroed at the requested length
+	buf = GetParticleBuffer(4)
+	if len(*buf) != 4 {
+		t.Fatalf("len = %d, want 4", len(*buf))
+	}
+	for i, v := range *buf {
+		if v != 0 {
+			t.Errorf("buf[%d] = %v, want 0", i, v)
+		}
+	}
+
+	buf = GetParticleBuffer(32)
+	if len(*buf) != 32 {
+		t.Errorf("len = %d, want 32", len(*buf))
+	}
+
+	PutParticleBuffer(nil) // Must not panic
+}
+
+func BenchmarkParticleBuffer_GetPut(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := GetParticleBuffer(256)
+		PutParticleBuffer(buf)
+	}
+}
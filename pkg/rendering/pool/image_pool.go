@@ -1,31 +1,47 @@
 package pool
 
 import (
+	"image"
 	"sync"
+	"sync/atomic"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 // Common sprite sizes used in the game
 const (
+	SizeTiny   = 16  // Tiny sprites (particles, small icons)
 	SizePlayer = 28  // Player sprite size (fixed)
 	SizeSmall  = 32  // Small sprites (items, particles)
+	SizeIcon   = 48  // UI icons and portraits
 	SizeMedium = 64  // Medium sprites (enemies, objects)
 	SizeLarge  = 128 // Large sprites (bosses, effects)
 )
 
+// SizeClasses lists the square image sizes that are pooled, smallest first.
+var SizeClasses = []int{SizeTiny, SizePlayer, SizeSmall, SizeIcon, SizeMedium, SizeLarge}
+
+// sizeClass is the pool and counters for one pooled image size.
+type sizeClass struct {
+	size   int
+	pool   sync.Pool
+	gets   atomic.Uint64
+	misses atomic.Uint64 // Gets that had to allocate
+}
+
 // ImagePool manages pools of Ebiten images by size.
 type ImagePool struct {
-	// Pools for common sizes
-	pool28  sync.Pool
-	pool32  sync.Pool
-	pool64  sync.Pool
-	pool128 sync.Pool
+	// Pools for common sizes, in SizeClasses order
+	classes []*sizeClass
 
 	// Statistics
-	gets    uint64
-	puts    uint64
-	creates uint64
+	gets    atomic.Uint64
+	puts    atomic.Uint64
+	creates atomic.Uint64
+
+	// Non-pooled requests by size, to find sizes worth pooling
+	mu        sync.Mutex
+	nonPooled map[image.Point]uint64
 }
 
 // globalPool is the default image pool instance.
@@ -33,51 +49,53 @@ var globalPool = NewImagePool()
 
 // NewImagePool creates a new image pool with pre-configured size pools.
 func NewImagePool() *ImagePool {
-	p := &ImagePool{}
+	p := &ImagePool{nonPooled: make(map[image.Point]uint64)}
 
 	// Initialize pools with constructors
-	p.pool28.New = func() interface{} {
-		p.creates++
-		return ebiten.NewImage(SizePlayer, SizePlayer)
-	}
-	p.pool32.New = func() interface{} {
-		p.creates++
-		return ebiten.NewImage(SizeSmall, SizeSmall)
-	}
-	p.pool64.New = func() interface{} {
-		p.creates++
-		return ebiten.NewImage(SizeMedium, SizeMedium)
-	}
-	p.pool128.New = func() interface{} {
-		p.creates++
-		return ebiten.NewImage(SizeLarge, SizeLarge)
+	for _, size := range SizeClasses {
+		class := &sizeClass{size: size}
+		class.pool.New = func() interface{} {
+			p.creates.Add(1)
+			class.misses.Add(1)
+			return ebiten.NewImage(class.size, class.size)
+		}
+		p.classes = append(p.classes, class)
 	}
 
 	return p
 }
 
+// class returns the size class for a width and height, or nil if images of
+// that size aren't pooled.
+func (p *ImagePool) class(width, height int) *sizeClass {
+	// Only square sprites of common sizes are pooled
+	if width != height {
+		return nil
+	}
+	for _, class := range p.classes {
+		if class.size == width {
+			return class
+		}
+	}
+	return nil
+}
+
 // GetImage retrieves an image from the appropriate pool.
-// Returns a pooled image for standard sizes (28, 32, 64, 128),
+// Returns a pooled image for the square sizes in SizeClasses,
 // or creates a new image for non-standard sizes.
 func (p *ImagePool) GetImage(width, height int) *ebiten.Image {
-	p.gets++
-
-	// Use pooled images for square sprites of common sizes
-	if width == height {
-		switch width {
-		case SizePlayer:
-			return p.pool28.Get().(*ebiten.Image)
-		case SizeSmall:
-			return p.pool32.Get().(*ebiten.Image)
-		case SizeMedium:
-			return p.pool64.Get().(*ebiten.Image)
-		case SizeLarge:
-			return p.pool128.Get().(*ebiten.Image)
-		}
+	p.gets.Add(1)
+
+	if class := p.class(width, height); class != nil {
+		class.gets.Add(1)
+		return class.pool.Get().(*ebiten.Image)
 	}
 
 	// Non-standard size: create new image (not pooled)
-	p.creates++
+	p.creates.Add(1)
+	p.mu.Lock()
+	p.nonPooled[image.Pt(width, height)]++
+	p.mu.Unlock()
 	return ebiten.NewImage(width, height)
 }
 
@@ -89,50 +107,83 @@ func (p *ImagePool) PutImage(img *ebiten.Image) {
 		return
 	}
 
-	p.puts++
+	p.puts.Add(1)
 
 	bounds := img.Bounds()
-	width := bounds.Dx()
-	height := bounds.Dy()
 
 	// Clear the image before returning to pool
 	img.Clear()
 
-	// Only pool square images of standard sizes
-	if width == height {
-		switch width {
-		case SizePlayer:
-			p.pool28.Put(img)
-			return
-		case SizeSmall:
-			p.pool32.Put(img)
-			return
-		case SizeMedium:
-			p.pool64.Put(img)
-			return
-		case SizeLarge:
-			p.pool128.Put(img)
-			return
-		}
+	if class := p.class(bounds.Dx(), bounds.Dy()); class != nil {
+		class.pool.Put(img)
 	}
 
 	// Non-standard size: let it be garbage collected
 }
 
+// SizeClassStats holds usage statistics for one pooled size.
+type SizeClassStats struct {
+	Size   int    // Width and height of the pooled images
+	Hits   uint64 // Gets served by reusing a pooled image
+	Misses uint64 // Gets that allocated a new image
+}
+
 // Statistics holds pool usage statistics.
 type Statistics struct {
 	Gets    uint64 // Number of Get calls
 	Puts    uint64 // Number of Put calls
 	Creates uint64 // Number of new allocations
+
+	// SizeClasses holds per-size statistics, in SizeClasses order
+	SizeClasses []SizeClassStats
+
+	// NonPooled counts Get calls for sizes that aren't pooled, and
+	// NonPooledSizes breaks them down by width and height
+	NonPooled      uint64
+	NonPooledSizes map[image.Point]uint64
 }
 
 // Stats returns a copy of the current pool statistics.
 func (p *ImagePool) Stats() Statistics {
-	return Statistics{
-		Gets:    p.gets,
-		Puts:    p.puts,
-		Creates: p.creates,
+	stats := Statistics{
+		Gets:           p.gets.Load(),
+		Puts:           p.puts.Load(),
+		Creates:        p.creates.Load(),
+		NonPooledSizes: make(map[image.Point]uint64),
+	}
+
+	for _, class := range p.classes {
+		gets, misses := class.gets.Load(), class.misses.Load()
+		stats.SizeClasses = append(stats.SizeClasses, SizeClassStats{
+			Size:   class.size,
+			Hits:   gets - min(misses, gets),
+			Misses: misses,
+		})
 	}
+
+	p.mu.Lock()
+	for size, count := range p.nonPooled {
+		stats.NonPooledSizes[size] = count
+		stats.NonPooled += count
+	}
+	p.mu.Unlock()
+
+	return stats
+}
+
+// ResetStats clears the pool statistics. Pooled images are kept.
+func (p *ImagePool) ResetStats() {
+	p.gets.Store(0)
+	p.puts.Store(0)
+	p.creates.Store(0)
+	for _, class := range p.classes {
+		class.gets.Store(0)
+		class.misses.Store(0)
+	}
+
+	p.mu.Lock()
+	p.nonPooled = make(map[image.Point]uint64)
+	p.mu.Unlock()
 }
 
 // ReuseRate returns the percentage of Get calls that were served from pool (0.0 to 1.0).
@@ -163,7 +214,5 @@ func Stats() Statistics {
 
 // ResetStats resets the global pool statistics.
 func ResetStats() {
-	globalPool.gets = 0
-	globalPool.puts = 0
-	globalPool.creates = 0
+	globalPool.ResetStats()
 }
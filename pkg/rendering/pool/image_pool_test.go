@@ -1,6 +1,7 @@
 package pool
 
 import (
+	"image"
 	"image/color"
 	"sync"
 	"testing"
@@ -30,8 +31,10 @@ func TestImagePool_GetImage_StandardSizes(t *testing.T) {
 		height int
 		size   int
 	}{
+		{"tiny size", SizeTiny, SizeTiny, SizeTiny},
 		{"player size", SizePlayer, SizePlayer, SizePlayer},
 		{"small size", SizeSmall, SizeSmall, SizeSmall},
+		{"icon size", SizeIcon, SizeIcon, SizeIcon},
 		{"medium size", SizeMedium, SizeMedium, SizeMedium},
 		{"large size", SizeLarge, SizeLarge, SizeLarge},
 	}
@@ -82,6 +85,65 @@ func TestImagePool_GetImage_NonSquare(t *testing.T) {
 	}
 }
 
+func TestImagePool_SizeClassStats(t *testing.T) {
+	pool := NewImagePool()
+
+	img := pool.GetImage(SizeIcon, SizeIcon)
+	pool.PutImage(img)
+	// May be a hit or a miss, since sync.Pool can drop images at any time
+	_ = pool.GetImage(SizeIcon, SizeIcon)
+	_ = pool.GetImage(SizeTiny, SizeTiny)
+
+	stats := pool.Stats()
+	if len(stats.SizeClasses) != len(SizeClasses) {
+		t.Fatalf("len(SizeClasses) = %d, want %d", len(stats.SizeClasses), len(SizeClasses))
+	}
+	for i, class := range stats.SizeClasses {
+		if class.Size != SizeClasses[i] {
+			t.Errorf("SizeClasses[%d].Size = %d, want %d", i, class.Size, SizeClasses[i])
+		}
+
+		var want uint64
+		switch class.Size {
+		case SizeIcon:
+			want = 2
+		case SizeTiny:
+			want = 1
+		}
+		if class.Hits+class.Misses != want {
+			t.Errorf("size %d: hits+misses = %d, want %d", class.Size, class.Hits+class.Misses, want)
+		}
+	}
+	if stats.NonPooled != 0 {
+		t.Errorf("NonPooled = %d, want 0", stats.NonPooled)
+	}
+}
+
+func TestImagePool_NonPooledStats(t *testing.T) {
+	pool := NewImagePool()
+
+	_ = pool.GetImage(50, 50)
+	_ = pool.GetImage(50, 50)
+	_ = pool.GetImage(32, 64)
+	_ = pool.GetImage(SizeSmall, SizeSmall)
+
+	stats := pool.Stats()
+	if stats.NonPooled != 3 {
+		t.Errorf("NonPooled = %d, want 3", stats.NonPooled)
+	}
+	if got := stats.NonPooledSizes[image.Pt(50, 50)]; got != 2 {
+		t.Errorf("NonPooledSizes[50x50] = %d, want 2", got)
+	}
+	if got := stats.NonPooledSizes[image.Pt(32, 64)]; got != 1 {
+		t.Errorf("NonPooledSizes[32x64] = %d, want 1", got)
+	}
+
+	pool.ResetStats()
+	if stats := pool.Stats(); stats.NonPooled != 0 || len(stats.NonPooledSizes) != 0 {
+		t.Errorf("ResetStats left non-pooled stats: %+v", stats)
+	}
+}
+
 func TestImagePool_PutImage(t *testing.T) {
 	pool := NewImagePool()
 
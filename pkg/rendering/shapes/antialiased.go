@@ -0,0 +1,122 @@
+// Package shapes provides procedural shape generation.
+// This file implements anti-aliased shape masks for UI icons and
+// decorations, built from signed distances so edges stay smooth at icon sizes.
+package shapes
+
+import "math"
+
+// RoundedRect returns an anti-aliased w by h mask of a rectangle whose
+// corners are rounded with radius, in pixels. The radius is clamped to half
+// the shorter side, so a square with a large radius becomes a circle.
+func RoundedRect(w, h int, radius float64) *Mask {
+	if w <= 0 || h <= 0 {
+		return NewMask(0, 0)
+	}
+	radius = math.Max(0, math.Min(radius, math.Min(float64(w), float64(h))/2))
+	halfW, halfH := float64(w)/2, float64(h)/2
+
+	return coverageMask(w, h, func(px, py float64) float64 {
+		// Distance to a rectangle shrunk by radius, minus radius
+		qx := math.Abs(px-halfW) - (halfW - radius)
+		qy := math.Abs(py-halfH) - (halfH - radius)
+		outside := math.Hypot(math.Max(qx, 0), math.Max(qy, 0))
+		inside := math.Min(math.Max(qx, qy), 0)
+		return outside + inside - radius
+	})
+}
+
+// RegularPolygon returns an anti-aliased mask of a regular polygon with the
+// given number of sides (at least 3) whose vertices lie radius pixels from
+// the centre. Rotation is in degrees; at 0 the first vertex points up. The
+// mask is just large enough to hold the circumscribed circle.
+func RegularPolygon(sides int, radius, rotation float64) *Mask {
+	sides = max(sides, 3)
+	vertices := make([][2]float64, sides)
+	for i := range vertices {
+		angle := vertexAngle(i, sides, rotation)
+		vertices[i] = [2]float64{radius * math.Cos(angle), radius * math.Sin(angle)}
+	}
+	return polygonMask(vertices, radius)
+}
+
+// Star returns an anti-aliased mask of a star with the given number of
+// points (at least 2), alternating between outerRadius at the tips and
+// innerRadius at the notches. Rotation is in degrees; at 0 the first tip
+// points up.
+func Star(points int, innerRadius, outerRadius, rotation float64) *Mask {
+	points = max(points, 2)
+	vertices := make([][2]float64, 2*points)
+	for i := range vertices {
+		r := outerRadius
+		if i%2 == 1 {
+			r = innerRadius
+		}
+		angle := vertexAngle(i, 2*points, rotation)
+		vertices[i] = [2]float64{r * math.Cos(angle), r * math.Sin(angle)}
+	}
+	return polygonMask(vertices, math.Max(innerRadius, outerRadius))
+}
+
+// vertexAngle returns the angle in radians of vertex i of n evenly spaced
+// vertices, with vertex 0 pointing up before rotation (in degrees).
+func vertexAngle(i, n int, rotation float64) float64 {
+	return -math.Pi/2 + 2*math.Pi*float64(i)/float64(n) + rotation*math.Pi/180
+}
+
+// polygonMask returns an anti-aliased mask of a closed polygon with
+// vertices relative to its centre, sized to hold a circle of radius.
+func polygonMask(vertices [][2]float64, radius float64) *Mask {
+	if radius <= 0 {
+		return NewMask(0, 0)
+	}
+	// One pixel of padding leaves room for the anti-aliased edge
+	size := int(math.Ceil(2*radius)) + 2
+	centre := float64(size) / 2
+
+	return coverageMask(size, size, func(px, py float64) float64 {
+		return polygonDistance(vertices, px-centre, py-centre)
+	})
+}
+
+// polygonDistance returns the signed distance from (x, y) to a closed
+// polygon: negative inside, positive outside. Works for concave polygons
+// such as stars.
+func polygonDistance(vertices [][2]float64, x, y float64) float64 {
+	best := math.Inf(1)
+	inside := false
+	for i, j := 0, len(vertices)-1; i < len(vertices); j, i = i, i+1 {
+		ax, ay := vertices[j][0], vertices[j][1]
+		bx, by := vertices[i][0], vertices[i][1]
+
+		// Distance to the segment from a to b
+		ex, ey := bx-ax, by-ay
+		t := 0.0
+		if lengthSq := ex*ex + ey*ey; lengthSq > 0 {
+			t = math.Max(0, math.Min(1, ((x-ax)*ex+(y-ay)*ey)/lengthSq))
+		}
+		best = math.Min(best, math.Hypot(x-ax-ex*t, y-ay-ey*t))
+
+		// Even-odd crossing test for the sign
+		if (ay > y) != (by > y) && x < ax+(y-ay)*ex/ey {
+			inside = !inside
+		}
+	}
+	if inside {
+		return -best
+	}
+	return best
+}
+
+// coverageMask builds a w by h mask from a signed distance function
+// evaluated at pixel centres. Pixels within half a pixel of the edge get
+// partial coverage, which anti-aliases the outline.
+func coverageMask(w, h int, distance func(px, py float64) float64) *Mask {
+	mask := NewMask(w, h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			coverage := math.Max(0, math.Min(1, 0.5-distance(float64(x)+0.5, float64(y)+0.5)))
+			mask.Alpha[y*w+x] = uint8(coverage*255 + 0.5)
+		}
+	}
+	return mask
+}
@@ -0,0 +1,172 @@
+package shapes
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+// maskArea returns the covered area of a mask in pixels, counting partial
+// coverage fractionally.
+func maskArea(m *Mask) float64 {
+	total := 0.0
+	for _, a := range m.Alpha {
+		total += float64(a) / 255
+	}
+	return total
+}
+
+// hasPartialAlpha reports whether any pixel is partly covered.
+func hasPartialAlpha(m *Mask) bool {
+	for _, a := range m.Alpha {
+		if a > 0 && a < 255 {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRoundedRect(t *testing.T) {
+	m := RoundedRect(24, 16, 5)
+
+	if m.Width != 24 || m.Height != 16 {
+		t.Fatalf("size = %dx%d, want 24x16", m.Width, m.Height)
+	}
+	if m.At(12, 8) != 255 || m.At(12, 0) != 255 {
+		t.Error("centre and edge midpoints should be covered")
+	}
+	if m.At(0, 0) != 0 {
+		t.Error("corner should be rounded away")
+	}
+	if !hasPartialAlpha(m) {
+		t.Error("rounded corners should be anti-aliased")
+	}
+
+	want := 24*16 - (4-math.Pi)*5*5
+	if got := maskArea(m); math.Abs(got-want) > want*0.02 {
+		t.Errorf("area = %.1f, want %.1f", got, want)
+	}
+}
+
+func TestRoundedRect_Extremes(t *testing.T) {
+	// No radius is a plain, fully covered rectangle
+	for _, a := range RoundedRect(5, 3, 0).Alpha {
+		if a != 255 {
+			t.Fatal("square-cornered rectangle should be fully covered")
+		}
+	}
+
+	// A radius beyond half the side is clamped, giving a circle
+	circle := RoundedRect(20, 20, 100)
+	if got, want := maskArea(circle), math.Pi*100; math.Abs(got-want) > want*0.02 {
+		t.Errorf("clamped area = %.1f, want %.1f", got, want)
+	}
+
+	if m := RoundedRect(0, 10, 2); m.Width != 0 || len(m.Alpha) != 0 {
+		t.Error("empty size should give an empty mask")
+	}
+}
+
+func TestRegularPolygon_Area(t *testing.T) {
+	tests := []struct {
+		name  string
+		sides int
+		want  float64 // Area for radius 1
+	}{
+		{"triangle", 3, 3 * math.Sqrt(3) / 4},
+		{"square", 4, 2},
+		{"hexagon", 6, 3 * math.Sqrt(3) / 2},
+		{"octagon", 8, 2 * math.Sqrt(2)},
+	}
+
+	const radius = 12.0
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := RegularPolygon(tt.sides, radius, 0)
+			want := tt.want * radius * radius
+			if got := maskArea(m); math.Abs(got-want) > want*0.02 {
+				t.Errorf("area = %.1f, want %.1f", got, want)
+			}
+			if !hasPartialAlpha(m) {
+				t.Error("edges should be anti-aliased")
+			}
+		})
+	}
+}
+
+func TestRegularPolygon_Orientation(t *testing.T) {
+	// A triangle points up at rotation 0 and down at 180
+	up := RegularPolygon(3, 10, 0)
+	down := RegularPolygon(3, 10, 180)
+	c := up.Width / 2
+
+	if up.At(c, 2) == 0 || up.At(c, up.Height-3) != 0 {
+		t.Error("unrotated triangle should have its tip at the top")
+	}
+	if down.At(c, down.Height-3) == 0 || down.At(c, 2) != 0 {
+		t.Error("triangle rotated 180 degrees should have its tip at the bottom")
+	}
+
+	// Fewer than 3 sides is treated as a triangle
+	if !bytes.Equal(RegularPolygon(1, 10, 0).Alpha, up.Alpha) {
+		t.Error("sides below 3 should give a triangle")
+	}
+}
+
+func TestStar(t *testing.T) {
+	const inner, outer = 5.0, 12.0
+	m := Star(5, inner, outer, 0)
+
+	// Area of a star is that of 10 triangles between tips and notches
+	want := 10 * 0.5 * inner * outer * math.Sin(math.Pi/5)
+	if got := maskArea(m); math.Abs(got-want) > want*0.03 {
+		t.Errorf("area = %.1f, want %.1f", got, want)
+	}
+
+	c := m.Width / 2
+	if m.At(c, c) != 255 {
+		t.Error("centre should be covered")
+	}
+	// The top tip is covered, but just outside a notch is not
+	if m.At(c, c-int(outer)+2) == 0 {
+		t.Error("top tip should be covered")
+	}
+	notch := vertexAngle(1, 10, 0)
+	nx := c + int(math.Round((inner+3)*math.Cos(notch)))
+	ny := c + int(math.Round((inner+3)*math.Sin(notch)))
+	if m.At(nx, ny) != 0 {
+		t.Errorf("pixel beyond the notch at (%d, %d) should be empty", nx, ny)
+	}
+}
+
+func TestAntialiasedShapes_Deterministic(t *testing.T) {
+	if !bytes.Equal(Star(6, 4, 9, 15).Alpha, Star(6, 4, 9, 15).Alpha) {
+		t.Error("Star is not deterministic")
+	}
+	if !bytes.Equal(RegularPolygon(6, 9, 30).Alpha, RegularPolygon(6, 9, 30).Alpha) {
+		t.Error("RegularPolygon is not deterministic")
+	}
+}
+
+func TestAntialiasedShapes_SmallIcons(t *testing.T) {
+	// Icons as small as 8 pixels still have a solid centre and soft edges
+	for name, m := range map[string]*Mask{
+		"star":    Star(5, 1.6, 4, 0),
+		"hexagon": RegularPolygon(6, 4, 0),
+		"rounded": RoundedRect(8, 8, 2),
+	} {
+		if m.At(m.Width/2, m.Height/2) < 200 {
+			t.Errorf("%s: centre alpha = %d, want solid", name, m.At(m.Width/2, m.Height/2))
+		}
+		if !hasPartialAlpha(m) {
+			t.Errorf("%s: expected anti-aliased edges", name)
+		}
+	}
+
+	if m := Star(5, 2, 0, 0); m.Width == 0 {
+		t.Error("star with only an inner radius should still have a size")
+	}
+	if m := RegularPolygon(5, 0, 0); m.Width != 0 {
+		t.Error("zero radius should give an empty mask")
+	}
+}
@@ -1,3 +1,7 @@
 // Package shapes provides procedural geometric shape generation for sprites and visual elements.
-// Shapes are created using mathematical functions and can be combined to create complex visuals.
+// Shapes are created using mathematical functions and can be combined to create complex visuals:
+// GenerateMask returns a shape's alpha mask, and Union, Intersect, Subtract and Xor combine
+// masks into compound silhouettes. RoundedRect, RegularPolygon and Star build anti-aliased
+// masks for UI icons and decorations, and BezierStroke draws smooth, optionally tapered
+// curves for tentacles, vines and spell beams.
 package shapes
@@ -0,0 +1,149 @@
+// Package shapes provides procedural shape generation.
+// This file implements alpha masks and boolean operations for combining
+// shapes into complex silhouettes.
+package shapes
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Mask is a shape's coverage as one alpha value (0-255) per pixel, stored
+// row by row. Masks are the inputs and outputs of the boolean operations.
+type Mask struct {
+	Width  int
+	Height int
+	Alpha  []uint8
+}
+
+// NewMask creates an empty (fully transparent) mask.
+func NewMask(width, height int) *Mask {
+	return &Mask{
+		Width:  width,
+		Height: height,
+		Alpha:  make([]uint8, width*height),
+	}
+}
+
+// At returns the alpha at (x, y), or 0 outside the mask.
+func (m *Mask) At(x, y int) uint8 {
+	if x < 0 || y < 0 || x >= m.Width || y >= m.Height {
+		return 0
+	}
+	return m.Alpha[y*m.Width+x]
+}
+
+// Coverage returns the number of pixels with non-zero alpha.
+func (m *Mask) Coverage() int {
+	n := 0
+	for _, a := range m.Alpha {
+		if a > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Offset returns a width by height mask with m placed at (x, y). Parts of m
+// outside the new bounds are cropped. Use it to position shapes of
+// different sizes on a shared canvas before combining them.
+func (m *Mask) Offset(width, height, x, y int) *Mask {
+	result := NewMask(width, height)
+	for sy := 0; sy < m.Height; sy++ {
+		dy := sy + y
+		if dy < 0 || dy >= height {
+			continue
+		}
+		for sx := 0; sx < m.Width; sx++ {
+			dx := sx + x
+			if dx < 0 || dx >= width {
+				continue
+			}
+			result.Alpha[dy*width+dx] = m.Alpha[sy*m.Width+sx]
+		}
+	}
+	return result
+}
+
+// ToRGBA returns an image filled with c wherever the mask covers, with c's
+// alpha scaled by the mask.
+func (m *Mask) ToRGBA(c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, m.Width, m.Height))
+	r, g, b, a := c.RGBA()
+	for i, alpha := range m.Alpha {
+		if alpha == 0 {
+			continue
+		}
+		// Premultiplied channels scale with the mask alpha
+		scale := uint32(alpha)
+		img.Pix[4*i] = uint8(r * scale / 255 >> 8)
+		img.Pix[4*i+1] = uint8(g * scale / 255 >> 8)
+		img.Pix[4*i+2] = uint8(b * scale / 255 >> 8)
+		img.Pix[4*i+3] = uint8(a * scale / 255 >> 8)
+	}
+	return img
+}
+
+// ToImage returns the mask filled with c as an ebiten image, ready to use
+// as a sprite base.
+func (m *Mask) ToImage(c color.Color) *ebiten.Image {
+	return ebiten.NewImageFromImage(m.ToRGBA(c))
+}
+
+// GenerateMask creates the alpha mask of the shape described by config,
+// using the same geometry as Generate: 255 inside the shape, 0 outside.
+func (g *Generator) GenerateMask(config Config) *Mask {
+	mask := NewMask(config.Width, config.Height)
+
+	centerX := float64(config.Width) / 2.0
+	centerY := float64(config.Height) / 2.0
+
+	for y := 0; y < config.Height; y++ {
+		for x := 0; x < config.Width; x++ {
+			if g.isInside(config, float64(x)-centerX, float64(y)-centerY, centerX, centerY) {
+				mask.Alpha[y*config.Width+x] = 255
+			}
+		}
+	}
+
+	return mask
+}
+
+// Union returns the pixels covered by a or b (the maximum alpha).
+func Union(a, b *Mask) (*Mask, error) {
+	return combine(a, b, func(x, y uint8) uint8 { return max(x, y) })
+}
+
+// Intersect returns the pixels covered by both a and b (the minimum alpha).
+func Intersect(a, b *Mask) (*Mask, error) {
+	return combine(a, b, func(x, y uint8) uint8 { return min(x, y) })
+}
+
+// Subtract returns the pixels of a not covered by b, cutting b out of a.
+func Subtract(a, b *Mask) (*Mask, error) {
+	return combine(a, b, func(x, y uint8) uint8 { return min(x, 255-y) })
+}
+
+// Xor returns the pixels covered by exactly one of a and b.
+func Xor(a, b *Mask) (*Mask, error) {
+	return combine(a, b, func(x, y uint8) uint8 { return max(x, y) - min(x, y) })
+}
+
+// combine applies op to each pair of alphas of two same-sized masks.
+func combine(a, b *Mask, op func(x, y uint8) uint8) (*Mask, error) {
+	if a == nil || b == nil {
+		return nil, fmt.Errorf("mask is nil")
+	}
+	if a.Width != b.Width || a.Height != b.Height {
+		return nil, fmt.Errorf("mask sizes differ: %dx%d and %dx%d", a.Width, a.Height, b.Width, b.Height)
+	}
+
+	result := NewMask(a.Width, a.Height)
+	for i := range result.Alpha {
+		result.Alpha[i] = op(a.Alpha[i], b.Alpha[i])
+	}
+	return result, nil
+}
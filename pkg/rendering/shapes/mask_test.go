@@ -0,0 +1,182 @@
+package shapes
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+)
+
+// overlappingCircles returns two 20x20 circle masks on a 40x20 canvas,
+// offset horizontally so they overlap in the middle.
+func overlappingCircles(t *testing.T) (a, b *Mask) {
+	t.Helper()
+	gen := NewGenerator()
+	circle := gen.GenerateMask(Config{Type: ShapeCircle, Width: 20, Height: 20})
+	return circle.Offset(40, 20, 5, 0), circle.Offset(40, 20, 15, 0)
+}
+
+func TestGenerateMask_MatchesShape(t *testing.T) {
+	gen := NewGenerator()
+	mask := gen.GenerateMask(Config{Type: ShapeRectangle, Width: 20, Height: 10})
+
+	// The rectangle covers 80% of each axis about the centre
+	if mask.At(10, 5) != 255 {
+		t.Error("centre should be covered")
+	}
+	if mask.At(0, 0) != 0 || mask.At(19, 9) != 0 {
+		t.Error("corners should be empty")
+	}
+	if mask.At(-1, 0) != 0 || mask.At(20, 0) != 0 {
+		t.Error("out of bounds should read as empty")
+	}
+}
+
+func TestMaskOperations_Circles(t *testing.T) {
+	a, b := overlappingCircles(t)
+
+	union, err := Union(a, b)
+	if err != nil {
+		t.Fatalf("Union failed: %v", err)
+	}
+	inter, err := Intersect(a, b)
+	if err != nil {
+		t.Fatalf("Intersect failed: %v", err)
+	}
+	sub, err := Subtract(a, b)
+	if err != nil {
+		t.Fatalf("Subtract failed: %v", err)
+	}
+	xor, err := Xor(a, b)
+	if err != nil {
+		t.Fatalf("Xor failed: %v", err)
+	}
+
+	// Inclusion-exclusion holds for binary masks
+	if union.Coverage() != a.Coverage()+b.Coverage()-inter.Coverage() {
+		t.Errorf("|A∪B| = %d, want %d", union.Coverage(), a.Coverage()+b.Coverage()-inter.Coverage())
+	}
+	if sub.Coverage() != a.Coverage()-inter.Coverage() {
+		t.Errorf("|A-B| = %d, want %d", sub.Coverage(), a.Coverage()-inter.Coverage())
+	}
+	if xor.Coverage() != union.Coverage()-inter.Coverage() {
+		t.Errorf("|A^B| = %d, want %d", xor.Coverage(), union.Coverage()-inter.Coverage())
+	}
+	if inter.Coverage() == 0 || inter.Coverage() >= a.Coverage() {
+		t.Errorf("circles should partly overlap, intersection = %d", inter.Coverage())
+	}
+
+	// Spot checks: left centre only in A, middle in both, right centre only in B
+	tests := []struct {
+		name                        string
+		x                           int
+		union, inter, sub, xorAlpha uint8
+	}{
+		{"left", 8, 255, 0, 255, 255},
+		{"middle", 20, 255, 255, 0, 0},
+		{"right", 32, 255, 0, 0, 255},
+		{"outside", 0, 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := union.At(tt.x, 10); got != tt.union {
+				t.Errorf("union = %d, want %d", got, tt.union)
+			}
+			if got := inter.At(tt.x, 10); got != tt.inter {
+				t.Errorf("intersect = %d, want %d", got, tt.inter)
+			}
+			if got := sub.At(tt.x, 10); got != tt.sub {
+				t.Errorf("subtract = %d, want %d", got, tt.sub)
+			}
+			if got := xor.At(tt.x, 10); got != tt.xorAlpha {
+				t.Errorf("xor = %d, want %d", got, tt.xorAlpha)
+			}
+		})
+	}
+}
+
+func TestMaskOperations_RectangleCutout(t *testing.T) {
+	gen := NewGenerator()
+	body := gen.GenerateMask(Config{Type: ShapeRectangle, Width: 20, Height: 20})
+	hole := gen.GenerateMask(Config{Type: ShapeRectangle, Width: 10, Height: 10}).Offset(20, 20, 5, 5)
+
+	cut, err := Subtract(body, hole)
+	if err != nil {
+		t.Fatalf("Subtract failed: %v", err)
+	}
+	if cut.At(10, 10) != 0 {
+		t.Error("centre should be cut out")
+	}
+	if cut.At(3, 10) != 255 {
+		t.Error("frame should remain")
+	}
+
+	// Subtracting then adding back restores the original
+	restored, err := Union(cut, hole)
+	if err != nil {
+		t.Fatalf("Union failed: %v", err)
+	}
+	if !bytes.Equal(restored.Alpha, body.Alpha) {
+		t.Error("cut plus hole should equal the original rectangle")
+	}
+}
+
+func TestMaskOperations_PartialAlpha(t *testing.T) {
+	a := &Mask{Width: 2, Height: 1, Alpha: []uint8{200, 50}}
+	b := &Mask{Width: 2, Height: 1, Alpha: []uint8{100, 255}}
+
+	ops := []struct {
+		name string
+		fn   func(a, b *Mask) (*Mask, error)
+		want []uint8
+	}{
+		{"union", Union, []uint8{200, 255}},
+		{"intersect", Intersect, []uint8{100, 50}},
+		{"subtract", Subtract, []uint8{155, 0}},
+		{"xor", Xor, []uint8{100, 205}},
+	}
+	for _, op := range ops {
+		t.Run(op.name, func(t *testing.T) {
+			got, err := op.fn(a, b)
+			if err != nil {
+				t.Fatalf("%s failed: %v", op.name, err)
+			}
+			if !bytes.Equal(got.Alpha, op.want) {
+				t.Errorf("%s = %v, want %v", op.name, got.Alpha, op.want)
+			}
+		})
+	}
+}
+
+func TestMaskOperations_Errors(t *testing.T) {
+	a := NewMask(4, 4)
+	if _, err := Union(a, NewMask(4, 5)); err == nil {
+		t.Error("expected error for mismatched sizes")
+	}
+	if _, err := Intersect(a, nil); err == nil {
+		t.Error("expected error for nil mask")
+	}
+}
+
+func TestMaskOperations_Deterministic(t *testing.T) {
+	a1, b1 := overlappingCircles(t)
+	a2, b2 := overlappingCircles(t)
+
+	x1, _ := Xor(a1, b1)
+	x2, _ := Xor(a2, b2)
+	if !bytes.Equal(x1.Alpha, x2.Alpha) {
+		t.Error("same inputs produced different masks")
+	}
+}
+
+func TestMask_ToRGBA(t *testing.T) {
+	m := &Mask{Width: 2, Height: 1, Alpha: []uint8{255, 128}}
+	img := m.ToRGBA(color.RGBA{R: 200, G: 100, A: 255})
+
+	if got := img.RGBAAt(0, 0); got != (color.RGBA{R: 200, G: 100, A: 255}) {
+		t.Errorf("full pixel = %v", got)
+	}
+	// Half coverage halves the premultiplied channels
+	if got := img.RGBAAt(1, 0); got.A < 127 || got.A > 128 || got.R < 99 || got.R > 101 {
+		t.Errorf("half pixel = %v, want about {100 50 0 128}", got)
+	}
+}
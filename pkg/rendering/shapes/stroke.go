@@ -0,0 +1,127 @@
+// Package shapes provides procedural shape generation.
+// This file implements curved strokes for tentacles, vines, lightning and
+// spell beams.
+package shapes
+
+import (
+	"image"
+	"math"
+)
+
+// strokeSegmentLength is the approximate length in pixels of the straight
+// pieces a curve is flattened into before drawing.
+const strokeSegmentLength = 1.5
+
+// BezierStroke returns an anti-aliased mask of a smooth curve through
+// points, thickness pixels wide. Consecutive points are joined by cubic
+// Bézier segments with Catmull-Rom tangents, so the curve passes through
+// every point. With taper, the width narrows linearly along the curve to a
+// point at the last control point.
+//
+// Points are in mask coordinates: the mask spans from the origin to just
+// past the furthest reach of the stroke, including where the curve swings
+// beyond its control points, and anything at negative coordinates is
+// cropped. A single point draws a dot; no points gives an
+// empty mask.
+func BezierStroke(points []image.Point, thickness float64, taper bool) *Mask {
+	if len(points) == 0 || thickness <= 0 {
+		return NewMask(0, 0)
+	}
+
+	path := flattenCurve(points)
+	radius := thickness / 2
+
+	// The curve can swing past its control points, so size the mask from
+	// the flattened path, with a pixel to spare for anti-aliasing
+	width, height := 0, 0
+	for _, p := range path {
+		width = max(width, int(math.Ceil(p[0]+radius))+1)
+		height = max(height, int(math.Ceil(p[1]+radius))+1)
+	}
+	mask := NewMask(width, height)
+
+	// Radius at each path vertex, by distance travelled along the path
+	radii := make([]float64, len(path))
+	total := 0.0
+	for i := 1; i < len(path); i++ {
+		total += math.Hypot(path[i][0]-path[i-1][0], path[i][1]-path[i-1][1])
+	}
+	travelled := 0.0
+	for i := range path {
+		if i > 0 {
+			travelled += math.Hypot(path[i][0]-path[i-1][0], path[i][1]-path[i-1][1])
+		}
+		radii[i] = radius
+		if taper && total > 0 {
+			radii[i] = radius * (1 - travelled/total)
+		}
+	}
+
+	if len(path) == 1 {
+		drawCapsule(mask, path[0], path[0], radii[0], radii[0])
+	}
+	for i := 1; i < len(path); i++ {
+		drawCapsule(mask, path[i-1], path[i], radii[i-1], radii[i])
+	}
+	return mask
+}
+
+// flattenCurve returns points along the Catmull-Rom curve through points,
+// at pixel centres, spaced about strokeSegmentLength apart.
+func flattenCurve(points []image.Point) [][2]float64 {
+	at := func(i int) [2]float64 {
+		i = max(0, min(i, len(points)-1))
+		return [2]float64{float64(points[i].X) + 0.5, float64(points[i].Y) + 0.5}
+	}
+
+	path := [][2]float64{at(0)}
+	for i := 0; i+1 < len(points); i++ {
+		// Bézier control points from the Catmull-Rom tangents
+		p0, p1, p2, p3 := at(i-1), at(i), at(i+1), at(i+2)
+		c1 := [2]float64{p1[0] + (p2[0]-p0[0])/6, p1[1] + (p2[1]-p0[1])/6}
+		c2 := [2]float64{p2[0] - (p3[0]-p1[0])/6, p2[1] - (p3[1]-p1[1])/6}
+
+		// The control polygon bounds the curve length
+		length := math.Hypot(c1[0]-p1[0], c1[1]-p1[1]) +
+			math.Hypot(c2[0]-c1[0], c2[1]-c1[1]) +
+			math.Hypot(p2[0]-c2[0], p2[1]-c2[1])
+		steps := max(1, int(math.Ceil(length/strokeSegmentLength)))
+
+		for s := 1; s <= steps; s++ {
+			t := float64(s) / float64(steps)
+			u := 1 - t
+			a, b, c, d := u*u*u, 3*u*u*t, 3*u*t*t, t*t*t
+			path = append(path, [2]float64{
+				a*p1[0] + b*c1[0] + c*c2[0] + d*p2[0],
+				a*p1[1] + b*c1[1] + c*c2[1] + d*p2[1],
+			})
+		}
+	}
+	return path
+}
+
+// drawCapsule adds a segment from a to b, with radius ra at a and rb at b
+// and round ends, to mask, keeping the higher coverage where strokes meet.
+func drawCapsule(mask *Mask, a, b [2]float64, ra, rb float64) {
+	r := math.Max(ra, rb)
+	minX := max(0, int(math.Floor(math.Min(a[0], b[0])-r-1)))
+	maxX := min(mask.Width-1, int(math.Ceil(math.Max(a[0], b[0])+r+1)))
+	minY := max(0, int(math.Floor(math.Min(a[1], b[1])-r-1)))
+	maxY := min(mask.Height-1, int(math.Ceil(math.Max(a[1], b[1])+r+1)))
+
+	ex, ey := b[0]-a[0], b[1]-a[1]
+	lengthSq := ex*ex + ey*ey
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			px, py := float64(x)+0.5, float64(y)+0.5
+			t := 0.0
+			if lengthSq > 0 {
+				t = math.Max(0, math.Min(1, ((px-a[0])*ex+(py-a[1])*ey)/lengthSq))
+			}
+			dist := math.Hypot(px-a[0]-ex*t, py-a[1]-ey*t) - (ra + (rb-ra)*t)
+			coverage := math.Max(0, math.Min(1, 0.5-dist))
+			i := y*mask.Width + x
+			mask.Alpha[i] = max(mask.Alpha[i], uint8(coverage*255+0.5))
+		}
+	}
+}
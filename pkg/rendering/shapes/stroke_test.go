@@ -0,0 +1,103 @@
+package shapes
+
+import (
+	"bytes"
+	"image"
+	"testing"
+)
+
+func TestBezierStroke_PassesThroughControlPoints(t *testing.T) {
+	points := []image.Point{{4, 20}, {12, 6}, {24, 14}, {34, 4}}
+	m := BezierStroke(points, 4, false)
+
+	for _, p := range points {
+		if m.At(p.X, p.Y) != 255 {
+			t.Errorf("control point %v alpha = %d, want 255", p, m.At(p.X, p.Y))
+		}
+	}
+	if !hasPartialAlpha(m) {
+		t.Error("stroke edges should be anti-aliased")
+	}
+	// Far from the curve stays empty
+	if m.At(34, 20) != 0 {
+		t.Error("pixel away from the curve should be empty")
+	}
+}
+
+func TestBezierStroke_Smooth(t *testing.T) {
+	// The curve bends smoothly through the middle point rather than
+	// following the straight legs of the control polygon
+	m := BezierStroke([]image.Point{{2, 20}, {20, 2}, {38, 20}}, 2, false)
+	if m.At(11, 11) == 255 {
+		t.Error("curve should not follow the straight leg between control points")
+	}
+	if m.At(20, 2) != 255 {
+		t.Error("curve should pass through the apex")
+	}
+}
+
+func TestBezierStroke_Taper(t *testing.T) {
+	points := []image.Point{{4, 10}, {40, 10}}
+	full := BezierStroke(points, 8, false)
+	tapered := BezierStroke(points, 8, true)
+
+	// Width of the stroke in column x
+	width := func(m *Mask, x int) float64 {
+		total := 0.0
+		for y := 0; y < m.Height; y++ {
+			total += float64(m.At(x, y)) / 255
+		}
+		return total
+	}
+
+	if got := width(full, 36); got < 7.5 || got > 8.5 {
+		t.Errorf("untapered width near the end = %.1f, want 8", got)
+	}
+	start, end := width(tapered, 8), width(tapered, 36)
+	if start < 6 || end > 2.5 || end >= start {
+		t.Errorf("tapered widths = %.1f at start, %.1f at end; want narrowing", start, end)
+	}
+	if maskArea(tapered) >= maskArea(full) {
+		t.Error("tapered stroke should cover less than the full stroke")
+	}
+}
+
+func TestBezierStroke_Deterministic(t *testing.T) {
+	points := []image.Point{{3, 3}, {15, 25}, {27, 3}}
+	if !bytes.Equal(BezierStroke(points, 3, true).Alpha, BezierStroke(points, 3, true).Alpha) {
+		t.Error("BezierStroke is not deterministic")
+	}
+}
+
+func TestBezierStroke_BoundsFollowOvershoot(t *testing.T) {
+	// The turn at (30, 2) swings the curve right of x = 30
+	m := BezierStroke([]image.Point{{2, 2}, {30, 2}, {30, 30}, {2, 30}}, 2, false)
+	if m.Width <= 30+2 {
+		t.Fatalf("mask width = %d, want room for the overshoot", m.Width)
+	}
+	for y := 0; y < m.Height; y++ {
+		if m.At(m.Width-1, y) != 0 {
+			t.Fatalf("stroke clipped at the right edge (row %d)", y)
+		}
+	}
+	for x := 0; x < m.Width; x++ {
+		if m.At(x, m.Height-1) != 0 {
+			t.Fatalf("stroke clipped at the bottom edge (column %d)", x)
+		}
+	}
+}
+
+func TestBezierStroke_EdgeCases(t *testing.T) {
+	if m := BezierStroke(nil, 4, false); m.Width != 0 || len(m.Alpha) != 0 {
+		t.Error("no points should give an empty mask")
+	}
+	if m := BezierStroke([]image.Point{{2, 2}, {8, 8}}, 0, false); len(m.Alpha) != 0 {
+		t.Error("zero thickness should give an empty mask")
+	}
+
+	// A single point is a dot of the stroke's width
+	dot := BezierStroke([]image.Point{{5, 5}}, 4, false)
+	if dot.At(5, 5) != 255 || dot.At(5, 9) != 0 {
+		t.Error("single point should draw a dot")
+	}
+}
@@ -3,6 +3,8 @@
 package sprites
 
 import (
+	"math"
+
 	"github.com/opd-ai/venture/pkg/rendering/shapes"
 )
 
@@ -46,8 +48,33 @@ const (
 	DirLeft Direction = "left"
 	// DirRight represents facing right
 	DirRight Direction = "right"
+	// DirUpLeft represents facing up and to the left
+	DirUpLeft Direction = "up-left"
+	// DirUpRight represents facing up and to the right
+	DirUpRight Direction = "up-right"
+	// DirDownLeft represents facing down and to the left
+	DirDownLeft Direction = "down-left"
+	// DirDownRight represents facing down and to the right
+	DirDownRight Direction = "down-right"
 )
 
+// cardinals splits a diagonal direction into its vertical and horizontal
+// cardinal components. Reports false for cardinal directions.
+func (d Direction) cardinals() (vertical, horizontal Direction, ok bool) {
+	switch d {
+	case DirUpLeft:
+		return DirUp, DirLeft, true
+	case DirUpRight:
+		return DirUp, DirRight, true
+	case DirDownLeft:
+		return DirDown, DirLeft, true
+	case DirDownRight:
+		return DirDown, DirRight, true
+	default:
+		return d, d, false
+	}
+}
+
 // String returns the string representation of a body part.
 func (b BodyPart) String() string {
 	switch b {
@@ -104,6 +131,8 @@ type PartSpec struct {
 type AnatomicalTemplate struct {
 	// Name identifies this template
 	Name string
+	// BodyPlan is the proportion system the template follows (empty for humanoid)
+	BodyPlan BodyPlan
 	// BodyPartLayout maps body parts to their specifications
 	BodyPartLayout map[BodyPart]PartSpec
 }
@@ -1074,6 +1103,7 @@ func SelectHumanoidTemplate(genre, entityType string, direction Direction) Anato
 func HumanoidAerialTemplate(direction Direction) AnatomicalTemplate {
 	template := AnatomicalTemplate{
 		Name:           "humanoid_aerial_" + string(direction),
+		BodyPlan:       BodyPlanHumanoid,
 		BodyPartLayout: make(map[BodyPart]PartSpec),
 	}
 
@@ -1426,20 +1456,50 @@ func SelectAerialTemplate(entityType, genre string, direction Direction) Anatomi
 // 2.5× larger than normal entities while maintaining the same visual structure.
 // Directional asymmetry remains intact (head offsets scale proportionally).
 //
+// Scaling follows the template's BodyPlan. Humanoids scale about the sprite
+// centre as above. Quadruped, amorphous and mechanical templates scale about
+// their torso, and the scale is capped so the whole silhouette stays on the
+// canvas: a quadruped clipped to its torso reads as a blob. Use
+// BossAerialTemplateWithScale to learn the scale applied and
+// BossCanvasSize to draw those bosses at their full size.
+//
 // Example usage:
 //
 //	base := FantasyHumanoidAerial(DirDown)
 //	boss := BossAerialTemplate(base, 2.5)
 func BossAerialTemplate(base AnatomicalTemplate, scale float64) AnatomicalTemplate {
+	boss, _ := BossAerialTemplateWithScale(base, scale)
+	return boss
+}
+
+// BossAerialTemplateWithScale is BossAerialTemplate that also returns the
+// scale actually applied, which is below scale when a creature's silhouette
+// had to be kept on the canvas.
+//
+// Example usage:
+//
+//	boss, applied := BossAerialTemplateWithScale(QuadrupedAerial(DirLeft, "fantasy"), 2.5)
+//	size := BossCanvasSize(28, 2.5, applied)
+func BossAerialTemplateWithScale(base AnatomicalTemplate, scale float64) (AnatomicalTemplate, float64) {
 	if scale <= 0 {
 		scale = 1.0 // Safety: prevent invalid scaling
 	}
 
 	boss := AnatomicalTemplate{
 		Name:           base.Name + "_boss",
+		BodyPlan:       base.BodyPlan,
 		BodyPartLayout: make(map[BodyPart]PartSpec),
 	}
 
+	anchorX, anchorY := 0.5, 0.5
+	switch base.BodyPlan {
+	case BodyPlanQuadruped, BodyPlanAmorphous, BodyPlanMechanical:
+		if torso, ok := base.BodyPartLayout[PartTorso]; ok {
+			anchorX, anchorY = torso.RelativeX, torso.RelativeY
+		}
+		scale = bossCreatureScale(base, scale, anchorX, anchorY)
+	}
+
 	// Scale all body parts proportionally
 	for part, spec := range base.BodyPartLayout {
 		scaledSpec := spec
@@ -1448,14 +1508,14 @@ func BossAerialTemplate(base AnatomicalTemplate, scale float64) AnatomicalTempla
 		scaledSpec.RelativeWidth *= scale
 		scaledSpec.RelativeHeight *= scale
 
-		// Scale position offsets from center (0.5, 0.5)
+		// Scale position offsets from the anchor (the centre for humanoids)
 		// This maintains directional asymmetry:
 		// - Left head (X=0.42) becomes further left
 		// - Right head (X=0.58) becomes further right
-		offsetX := spec.RelativeX - 0.5
-		offsetY := spec.RelativeY - 0.5
-		scaledSpec.RelativeX = 0.5 + (offsetX * scale)
-		scaledSpec.RelativeY = 0.5 + (offsetY * scale)
+		offsetX := spec.RelativeX - anchorX
+		offsetY := spec.RelativeY - anchorY
+		scaledSpec.RelativeX = anchorX + (offsetX * scale)
+		scaledSpec.RelativeY = anchorY + (offsetY * scale)
 
 		// Keep color roles, shapes, opacity, rotation, and Z-index unchanged
 		// These define the visual character and should not scale
@@ -1463,5 +1523,57 @@ func BossAerialTemplate(base AnatomicalTemplate, scale float64) AnatomicalTempla
 		boss.BodyPartLayout[part] = scaledSpec
 	}
 
-	return boss
+	return boss, scale
+}
+
+// BossCanvasSize returns the canvas size, in pixels, on which a boss
+// template scaled by applied (see BossAerialTemplateWithScale) appears as
+// large as the requested scale would on a canvas of size pixels.
+func BossCanvasSize(size int, requested, applied float64) int {
+	if applied <= 0 || requested <= applied {
+		return size
+	}
+	return int(math.Ceil(float64(size) * requested / applied))
+}
+
+// InterpolateTemplates blends two templates, typically the cardinal poses
+// either side of a diagonal facing. Positions, sizes and opacity are
+// interpolated linearly and rotation along the shorter arc, with t=0
+// giving a and t=1 giving b. Draw order, shapes and color roles come from
+// a; parts present in only one template are kept unchanged.
+func InterpolateTemplates(a, b AnatomicalTemplate, t float64) AnatomicalTemplate {
+	result := AnatomicalTemplate{
+		Name:           a.Name + "_" + b.Name,
+		BodyPlan:       a.BodyPlan,
+		BodyPartLayout: make(map[BodyPart]PartSpec, len(a.BodyPartLayout)),
+	}
+
+	lerp := func(x, y float64) float64 { return x + (y-x)*t }
+	for part, specA := range a.BodyPartLayout {
+		specB, ok := b.BodyPartLayout[part]
+		if !ok {
+			result.BodyPartLayout[part] = specA
+			continue
+		}
+
+		spec := specA
+		spec.RelativeX = lerp(specA.RelativeX, specB.RelativeX)
+		spec.RelativeY = lerp(specA.RelativeY, specB.RelativeY)
+		spec.RelativeWidth = lerp(specA.RelativeWidth, specB.RelativeWidth)
+		spec.RelativeHeight = lerp(specA.RelativeHeight, specB.RelativeHeight)
+		spec.Opacity = lerp(specA.Opacity, specB.Opacity)
+
+		// Rotate along the shorter arc so 0 and 270 meet at 315, not 135
+		delta := math.Mod(specB.Rotation-specA.Rotation+540, 360) - 180
+		spec.Rotation = math.Mod(specA.Rotation+delta*t+360, 360)
+
+		result.BodyPartLayout[part] = spec
+	}
+	for part, specB := range b.BodyPartLayout {
+		if _, ok := a.BodyPartLayout[part]; !ok {
+			result.BodyPartLayout[part] = specB
+		}
+	}
+
+	return result
 }
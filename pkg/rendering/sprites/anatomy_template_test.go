@@ -1,6 +1,7 @@
 package sprites
 
 import (
+	"math"
 	"testing"
 
 	"github.com/opd-ai/venture/pkg/rendering/shapes"
@@ -1340,6 +1341,86 @@ func BenchmarkAerialTemplates(b *testing.B) {
 	}
 }
 
+// TestInterpolateTemplates tests blending cardinal poses into a diagonal.
+func TestInterpolateTemplates(t *testing.T) {
+	up := HumanoidDirectionalTemplate(DirUp)
+	left := HumanoidDirectionalTemplate(DirLeft)
+
+	mid := InterpolateTemplates(up, left, 0.5)
+
+	arms := mid.BodyPartLayout[PartArms]
+	upArms, leftArms := up.BodyPartLayout[PartArms], left.BodyPartLayout[PartArms]
+	if want := (upArms.RelativeX + leftArms.RelativeX) / 2; math.Abs(arms.RelativeX-want) > 1e-9 {
+		t.Errorf("arms RelativeX = %v, want %v", arms.RelativeX, want)
+	}
+	// Up is 0 degrees and left 270, so the shorter arc passes through 315
+	if math.Abs(arms.Rotation-315) > 1e-9 {
+		t.Errorf("arms Rotation = %v, want 315", arms.Rotation)
+	}
+	// Draw order follows the first template
+	if arms.ZIndex != upArms.ZIndex {
+		t.Errorf("arms ZIndex = %d, want %d", arms.ZIndex, upArms.ZIndex)
+	}
+
+	// The endpoints reproduce the inputs
+	if got := InterpolateTemplates(up, left, 0).BodyPartLayout[PartHead]; got.RelativeX != up.BodyPartLayout[PartHead].RelativeX {
+		t.Errorf("t=0 head RelativeX = %v, want %v", got.RelativeX, up.BodyPartLayout[PartHead].RelativeX)
+	}
+	if got := InterpolateTemplates(up, left, 1).BodyPartLayout[PartArms]; got.Rotation != leftArms.Rotation {
+		t.Errorf("t=1 arms Rotation = %v, want %v", got.Rotation, leftArms.Rotation)
+	}
+}
+
+// TestInterpolateTemplates_UnmatchedParts tests parts present in only one template.
+func TestInterpolateTemplates_UnmatchedParts(t *testing.T) {
+	a := AnatomicalTemplate{Name: "a", BodyPartLayout: map[BodyPart]PartSpec{
+		PartTorso: {RelativeX: 0.4, Opacity: 1},
+		PartTail:  {RelativeX: 0.1, Opacity: 1},
+	}}
+	b := AnatomicalTemplate{Name: "b", BodyPartLayout: map[BodyPart]PartSpec{
+		PartTorso: {RelativeX: 0.6, Opacity: 1},
+		PartWings: {RelativeX: 0.9, Opacity: 0.5},
+	}}
+
+	mid := InterpolateTemplates(a, b, 0.5)
+
+	if len(mid.BodyPartLayout) != 3 {
+		t.Fatalf("got %d parts, want 3", len(mid.BodyPartLayout))
+	}
+	if mid.BodyPartLayout[PartTail].RelativeX != 0.1 {
+		t.Error("part only in a should be kept unchanged")
+	}
+	if wings := mid.BodyPartLayout[PartWings]; wings.RelativeX != 0.9 || wings.Opacity != 0.5 {
+		t.Error("part only in b should be kept unchanged")
+	}
+}
+
+// TestDirection_Cardinals tests splitting diagonals into cardinal components.
+func TestDirection_Cardinals(t *testing.T) {
+	tests := []struct {
+		dir        Direction
+		vertical   Direction
+		horizontal Direction
+		diagonal   bool
+	}{
+		{DirUpLeft, DirUp, DirLeft, true},
+		{DirUpRight, DirUp, DirRight, true},
+		{DirDownLeft, DirDown, DirLeft, true},
+		{DirDownRight, DirDown, DirRight, true},
+		{DirUp, DirUp, DirUp, false},
+		{DirRight, DirRight, DirRight, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.dir), func(t *testing.T) {
+			v, h, ok := tt.dir.cardinals()
+			if v != tt.vertical || h != tt.horizontal || ok != tt.diagonal {
+				t.Errorf("cardinals() = %v, %v, %v; want %v, %v, %v", v, h, ok, tt.vertical, tt.horizontal, tt.diagonal)
+			}
+		})
+	}
+}
+
 // BenchmarkAerialGenreTemplates benchmarks genre-specific aerial template generation.
 func BenchmarkAerialGenreTemplates(b *testing.B) {
 	genres := []struct {
@@ -0,0 +1,420 @@
+// Package sprites - aerial templates for non-humanoid creatures.
+// This file adds top-down quadruped, amorphous and mechanical templates with
+// their own proportion systems, which BossAerialTemplate scales per body plan.
+package sprites
+
+import (
+	"math"
+
+	"github.com/opd-ai/venture/pkg/rendering/shapes"
+)
+
+// BodyPlan names the proportion system an aerial template follows.
+// Templates that leave BodyPlan empty are treated as humanoid.
+type BodyPlan string
+
+const (
+	// BodyPlanHumanoid is an upright figure: head 35%, torso 50%, legs 15%
+	BodyPlanHumanoid BodyPlan = "humanoid"
+	// BodyPlanQuadruped is a four-legged body seen from above, lying along
+	// its facing: head 25%, torso 55%, tail 20% of its length
+	BodyPlanQuadruped BodyPlan = "quadruped"
+	// BodyPlanAmorphous is a single mass (slimes, oozes) with no head or
+	// legs, only a core offset toward its facing
+	BodyPlanAmorphous BodyPlan = "amorphous"
+	// BodyPlanMechanical is an upright construct with a small sensor head
+	// and heavy legs: head 20%, torso 45%, legs 35%
+	BodyPlanMechanical BodyPlan = "mechanical"
+)
+
+// Proportions gives the share of a body's length taken by each section,
+// head to feet for upright plans and nose to tail for quadrupeds.
+type Proportions struct {
+	Head  float64
+	Torso float64
+	Lower float64 // Legs, or the tail of a quadruped
+}
+
+// Proportions returns the documented proportions of a body plan.
+func (p BodyPlan) Proportions() Proportions {
+	switch p {
+	case BodyPlanQuadruped:
+		return Proportions{Head: 0.25, Torso: 0.55, Lower: 0.20}
+	case BodyPlanAmorphous:
+		return Proportions{Torso: 1.0}
+	case BodyPlanMechanical:
+		return Proportions{Head: 0.20, Torso: 0.45, Lower: 0.35}
+	default:
+		return Proportions{Head: 0.35, Torso: 0.50, Lower: 0.15}
+	}
+}
+
+const (
+	// quadrupedLength is the nose-to-tail length of an aerial quadruped as a
+	// fraction of the sprite, centred on it
+	quadrupedLength = 0.80
+	// mechanicalHeight is the head-to-feet height of an aerial construct as a
+	// fraction of the sprite
+	mechanicalHeight = 0.90
+	// amorphousCoreOffset is how far a blob's core sits toward its facing
+	amorphousCoreOffset = 0.08
+)
+
+// alongFacing places a part on a body lying along direction. t runs from
+// the tail end (0) to the nose (1) of a body length long, centred on the
+// sprite; length and width are measured along and across the body.
+func alongFacing(direction Direction, t, length, width float64) (x, y, w, h float64) {
+	pos := 0.5 + (t-0.5)*quadrupedLength
+	switch direction {
+	case DirUp:
+		return 0.5, 1 - pos, width, length
+	case DirLeft:
+		return 1 - pos, 0.5, length, width
+	case DirRight:
+		return pos, 0.5, length, width
+	default:
+		return 0.5, pos, width, length
+	}
+}
+
+// facingRotation returns the rotation that points a part toward direction,
+// matching the rotations used by the humanoid templates.
+func facingRotation(direction Direction) float64 {
+	switch direction {
+	case DirUp:
+		return 0
+	case DirLeft:
+		return 270
+	case DirRight:
+		return 90
+	default:
+		return 180
+	}
+}
+
+// QuadrupedAerial returns a top-down template for four-legged creatures,
+// lying along the facing direction with the head in front. Proportions are
+// head 25%, torso 55% and tail 20% of a body 80% of the sprite long; the legs
+// splay wider than the torso on both sides. genre selects a variant
+// ("fantasy", "scifi", "horror", "cyberpunk", "postapoc"); others use the
+// base template.
+func QuadrupedAerial(direction Direction, genre string) AnatomicalTemplate {
+	prop := BodyPlanQuadruped.Proportions()
+	template := AnatomicalTemplate{
+		Name:           "quadruped_aerial_" + string(direction),
+		BodyPlan:       BodyPlanQuadruped,
+		BodyPartLayout: make(map[BodyPart]PartSpec),
+	}
+
+	place := func(t, share, width float64) PartSpec {
+		x, y, w, h := alongFacing(direction, t, share, width)
+		return PartSpec{RelativeX: x, RelativeY: y, RelativeWidth: w, RelativeHeight: h, Opacity: 1.0}
+	}
+	tailCentre := prop.Lower / 2
+	torsoCentre := prop.Lower + prop.Torso/2
+	headCentre := prop.Lower + prop.Torso + prop.Head/2
+
+	shadow := place(0.5, quadrupedLength*0.9, 0.50)
+	shadow.ShapeTypes = []shapes.ShapeType{shapes.ShapeEllipse}
+	shadow.ColorRole = "shadow"
+	shadow.Opacity = 0.3
+	template.BodyPartLayout[PartShadow] = shadow
+
+	tail := place(tailCentre, prop.Lower*quadrupedLength, 0.12)
+	tail.ShapeTypes = []shapes.ShapeType{shapes.ShapeCapsule, shapes.ShapeWedge}
+	tail.ZIndex = 4
+	tail.ColorRole = "primary"
+	tail.Rotation = facingRotation(direction)
+	template.BodyPartLayout[PartTail] = tail
+
+	legs := place(torsoCentre, prop.Torso*quadrupedLength*0.8, 0.60)
+	legs.ShapeTypes = []shapes.ShapeType{shapes.ShapeCapsule, shapes.ShapeRectangle}
+	legs.ZIndex = 5
+	legs.ColorRole = "secondary"
+	template.BodyPartLayout[PartLegs] = legs
+
+	torso := place(torsoCentre, prop.Torso*quadrupedLength, 0.40)
+	torso.ShapeTypes = []shapes.ShapeType{shapes.ShapeEllipse, shapes.ShapeBean}
+	torso.ZIndex = 10
+	torso.ColorRole = "primary"
+	template.BodyPartLayout[PartTorso] = torso
+
+	head := place(headCentre, prop.Head*quadrupedLength, 0.28)
+	head.ShapeTypes = []shapes.ShapeType{shapes.ShapeEllipse, shapes.ShapeWedge}
+	head.ZIndex = 15
+	head.ColorRole = "secondary"
+	head.Rotation = facingRotation(direction)
+	template.BodyPartLayout[PartHead] = head
+
+	switch genre {
+	case "fantasy":
+		// Maned beasts with a heavier head
+		setShapes(&template, PartHead, shapes.ShapeOrganic, shapes.ShapeEllipse)
+	case "scifi", "sci-fi":
+		// Armored hounds with plated backs
+		setShapes(&template, PartTorso, shapes.ShapeHexagon, shapes.ShapeOctagon)
+	case "horror":
+		// Gaunt, skull-headed creatures with a faint shadow
+		setShapes(&template, PartHead, shapes.ShapeSkull, shapes.ShapeWedge)
+		scaleWidth(&template, PartTorso, 0.8)
+		setOpacity(&template, PartShadow, 0.2)
+	case "cyberpunk":
+		// Cybernetic beasts with glowing spines
+		template.BodyPartLayout[PartArmor] = spineSpec(torso, direction, "accent3")
+	case "postapoc", "post-apocalyptic":
+		// Mutated, lumpy bodies
+		setShapes(&template, PartTorso, shapes.ShapeOrganic, shapes.ShapeBean)
+		setShapes(&template, PartTail, shapes.ShapeLightning)
+	default:
+		return template
+	}
+	template.Name = genre + "_" + template.Name
+	return template
+}
+
+// AmorphousAerial returns a top-down template for shapeless creatures
+// (slimes, oozes). The mass covers 80% of the sprite with a core 30% of its
+// size offset toward the facing direction; there is no head or legs. genre
+// selects a variant as for QuadrupedAerial.
+func AmorphousAerial(direction Direction, genre string) AnatomicalTemplate {
+	template := AnatomicalTemplate{
+		Name:           "amorphous_aerial_" + string(direction),
+		BodyPlan:       BodyPlanAmorphous,
+		BodyPartLayout: make(map[BodyPart]PartSpec),
+	}
+
+	template.BodyPartLayout[PartShadow] = PartSpec{
+		RelativeX:      0.5,
+		RelativeY:      0.58,
+		RelativeWidth:  0.80,
+		RelativeHeight: 0.70,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeEllipse},
+		ZIndex:         0,
+		ColorRole:      "shadow",
+		Opacity:        0.4,
+	}
+	template.BodyPartLayout[PartTorso] = PartSpec{
+		RelativeX:      0.5,
+		RelativeY:      0.5,
+		RelativeWidth:  0.80,
+		RelativeHeight: 0.80,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeOrganic, shapes.ShapeCircle, shapes.ShapeBean},
+		ZIndex:         10,
+		ColorRole:      "primary",
+		Opacity:        0.9, // Slightly translucent
+	}
+
+	// The core drifts toward the facing so the mass reads as moving
+	coreX, coreY := 0.5, 0.5
+	switch direction {
+	case DirUp:
+		coreY -= amorphousCoreOffset
+	case DirLeft:
+		coreX -= amorphousCoreOffset
+	case DirRight:
+		coreX += amorphousCoreOffset
+	default:
+		coreY += amorphousCoreOffset
+	}
+	template.BodyPartLayout[PartHead] = PartSpec{
+		RelativeX:      coreX,
+		RelativeY:      coreY,
+		RelativeWidth:  0.24,
+		RelativeHeight: 0.24,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeCircle},
+		ZIndex:         15,
+		ColorRole:      "accent1",
+		Opacity:        0.8,
+	}
+
+	switch genre {
+	case "fantasy":
+		// Gelatinous cubes
+		setShapes(&template, PartTorso, shapes.ShapeRectangle, shapes.ShapeOctagon)
+	case "scifi", "sci-fi":
+		// Nanite swarms with a crystalline core
+		setShapes(&template, PartHead, shapes.ShapeCrystal, shapes.ShapeHexagon)
+	case "horror":
+		// Flesh masses with a skull at the core
+		setShapes(&template, PartHead, shapes.ShapeSkull)
+		setOpacity(&template, PartTorso, 0.8)
+	case "cyberpunk":
+		// Data-slime with a pulsing core
+		setShapes(&template, PartHead, shapes.ShapeRing, shapes.ShapeGear)
+	case "postapoc", "post-apocalyptic":
+		// Toxic sludge spreading wide and low
+		scaleWidth(&template, PartTorso, 1.1)
+		setShapes(&template, PartTorso, shapes.ShapeOrganic, shapes.ShapeWave)
+	default:
+		return template
+	}
+	template.Name = genre + "_" + template.Name
+	return template
+}
+
+// MechanicalAerial returns a top-down template for robots and constructs.
+// Proportions are head 20%, torso 45% and legs 35% of a body 90% of the
+// sprite tall: a small sensor head, a broad chassis and heavy legs, with
+// shoulder-mounted arms turned toward the facing. genre selects a variant as
+// for QuadrupedAerial.
+func MechanicalAerial(direction Direction, genre string) AnatomicalTemplate {
+	prop := BodyPlanMechanical.Proportions()
+	template := AnatomicalTemplate{
+		Name:           "mechanical_aerial_" + string(direction),
+		BodyPlan:       BodyPlanMechanical,
+		BodyPartLayout: make(map[BodyPart]PartSpec),
+	}
+
+	top := (1 - mechanicalHeight) / 2
+	headHeight := prop.Head * mechanicalHeight
+	torsoHeight := prop.Torso * mechanicalHeight
+	legsHeight := prop.Lower * mechanicalHeight
+
+	// Sensor head and arms shift toward a sideways facing
+	offsetX, armsRotation := 0.0, 0.0
+	switch direction {
+	case DirLeft:
+		offsetX, armsRotation = -0.06, 270
+	case DirRight:
+		offsetX, armsRotation = 0.06, 90
+	}
+
+	template.BodyPartLayout[PartShadow] = PartSpec{
+		RelativeX:      0.5,
+		RelativeY:      0.92,
+		RelativeWidth:  0.55,
+		RelativeHeight: 0.12,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeEllipse},
+		ZIndex:         0,
+		ColorRole:      "shadow",
+		Opacity:        0.3,
+	}
+	template.BodyPartLayout[PartLegs] = PartSpec{
+		RelativeX:      0.5,
+		RelativeY:      top + headHeight + torsoHeight + legsHeight/2,
+		RelativeWidth:  0.45,
+		RelativeHeight: legsHeight,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeRectangle, shapes.ShapeCapsule},
+		ZIndex:         5,
+		ColorRole:      "secondary",
+		Opacity:        1.0,
+	}
+	template.BodyPartLayout[PartTorso] = PartSpec{
+		RelativeX:      0.5,
+		RelativeY:      top + headHeight + torsoHeight/2,
+		RelativeWidth:  0.65,
+		RelativeHeight: torsoHeight,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeRectangle, shapes.ShapeHexagon, shapes.ShapeOctagon},
+		ZIndex:         10,
+		ColorRole:      "primary",
+		Opacity:        1.0,
+	}
+	template.BodyPartLayout[PartArms] = PartSpec{
+		RelativeX:      0.5 + offsetX,
+		RelativeY:      top + headHeight + torsoHeight*0.3,
+		RelativeWidth:  0.80,
+		RelativeHeight: 0.18,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeRectangle, shapes.ShapeCapsule},
+		ZIndex:         12,
+		ColorRole:      "secondary",
+		Opacity:        1.0,
+		Rotation:       armsRotation,
+	}
+	template.BodyPartLayout[PartHead] = PartSpec{
+		RelativeX:      0.5 + offsetX,
+		RelativeY:      top + headHeight/2,
+		RelativeWidth:  0.28,
+		RelativeHeight: headHeight,
+		ShapeTypes:     []shapes.ShapeType{shapes.ShapeOctagon, shapes.ShapeRectangle},
+		ZIndex:         15,
+		ColorRole:      "accent1",
+		Opacity:        1.0,
+	}
+
+	switch genre {
+	case "fantasy":
+		// Stone golems
+		setShapes(&template, PartTorso, shapes.ShapeOrganic, shapes.ShapeOctagon)
+		setShapes(&template, PartHead, shapes.ShapeCircle)
+	case "scifi", "sci-fi":
+		// Sleek drones with a visor head
+		setShapes(&template, PartTorso, shapes.ShapeHexagon, shapes.ShapeCapsule)
+		setShapes(&template, PartHead, shapes.ShapeEllipse)
+	case "horror":
+		// Patchwork constructs
+		setShapes(&template, PartTorso, shapes.ShapeOrganic, shapes.ShapeBean)
+		setShapes(&template, PartHead, shapes.ShapeSkull)
+	case "cyberpunk":
+		// Combat frames with a glowing spine
+		template.BodyPartLayout[PartArmor] = spineSpec(template.BodyPartLayout[PartTorso], DirDown, "accent3")
+	case "postapoc", "post-apocalyptic":
+		// Scrap-built walkers
+		setShapes(&template, PartTorso, shapes.ShapeGear, shapes.ShapeOctagon)
+		scaleWidth(&template, PartArms, 0.85)
+	default:
+		return template
+	}
+	template.Name = genre + "_" + template.Name
+	return template
+}
+
+// spineSpec returns a thin glowing strip along the middle of torso, running
+// along direction's axis.
+func spineSpec(torso PartSpec, direction Direction, role string) PartSpec {
+	spine := torso
+	if direction == DirLeft || direction == DirRight {
+		spine.RelativeHeight *= 0.2
+	} else {
+		spine.RelativeWidth *= 0.2
+	}
+	spine.ShapeTypes = []shapes.ShapeType{shapes.ShapeRectangle}
+	spine.ZIndex = torso.ZIndex + 1
+	spine.ColorRole = role
+	spine.Opacity = 0.8
+	return spine
+}
+
+// setShapes replaces the allowed shapes of a template part.
+func setShapes(template *AnatomicalTemplate, part BodyPart, types ...shapes.ShapeType) {
+	spec := template.BodyPartLayout[part]
+	spec.ShapeTypes = types
+	template.BodyPartLayout[part] = spec
+}
+
+// scaleWidth scales the width of a template part.
+func scaleWidth(template *AnatomicalTemplate, part BodyPart, factor float64) {
+	spec := template.BodyPartLayout[part]
+	spec.RelativeWidth *= factor
+	template.BodyPartLayout[part] = spec
+}
+
+// setOpacity sets the opacity of a template part.
+func setOpacity(template *AnatomicalTemplate, part BodyPart, opacity float64) {
+	spec := template.BodyPartLayout[part]
+	spec.Opacity = opacity
+	template.BodyPartLayout[part] = spec
+}
+
+// bossCreatureScale returns the scale actually applied to a non-humanoid
+// boss: at most scale, but no more than keeps every part of base on the
+// canvas when scaled about (ax, ay). A clipped quadruped loses its head and
+// tail and reads as a blob, so the silhouette is kept whole; a base that is
+// already off the canvas is never shrunk.
+func bossCreatureScale(base AnatomicalTemplate, scale, ax, ay float64) float64 {
+	fit := math.Inf(1)
+	limit := func(edge, anchor float64) {
+		switch {
+		case edge > anchor:
+			fit = math.Min(fit, (1-anchor)/(edge-anchor))
+		case edge < anchor:
+			fit = math.Min(fit, anchor/(anchor-edge))
+		}
+	}
+	for _, spec := range base.BodyPartLayout {
+		limit(spec.RelativeX-spec.RelativeWidth/2, ax)
+		limit(spec.RelativeX+spec.RelativeWidth/2, ax)
+		limit(spec.RelativeY-spec.RelativeHeight/2, ay)
+		limit(spec.RelativeY+spec.RelativeHeight/2, ay)
+	}
+	return math.Min(scale, math.Max(1, fit))
+}
@@ -0,0 +1,236 @@
+package sprites
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+var cardinalDirections = []Direction{DirUp, DirDown, DirLeft, DirRight}
+
+// alongLength returns a part's size along a body facing direction.
+func alongLength(spec PartSpec, direction Direction) float64 {
+	if direction == DirLeft || direction == DirRight {
+		return spec.RelativeWidth
+	}
+	return spec.RelativeHeight
+}
+
+func TestQuadrupedAerial_Proportions(t *testing.T) {
+	want := BodyPlanQuadruped.Proportions()
+
+	for _, dir := range cardinalDirections {
+		t.Run(string(dir), func(t *testing.T) {
+			layout := QuadrupedAerial(dir, "").BodyPartLayout
+			head := alongLength(layout[PartHead], dir)
+			torso := alongLength(layout[PartTorso], dir)
+			tail := alongLength(layout[PartTail], dir)
+			total := head + torso + tail
+
+			if math.Abs(total-quadrupedLength) > 1e-9 {
+				t.Errorf("body length = %.3f, want %.3f", total, quadrupedLength)
+			}
+			if math.Abs(head/total-want.Head) > 1e-9 || math.Abs(torso/total-want.Torso) > 1e-9 || math.Abs(tail/total-want.Lower) > 1e-9 {
+				t.Errorf("proportions = %.2f/%.2f/%.2f, want %.2f/%.2f/%.2f",
+					head/total, torso/total, tail/total, want.Head, want.Torso, want.Lower)
+			}
+		})
+	}
+}
+
+func TestQuadrupedAerial_HeadLeads(t *testing.T) {
+	for _, dir := range cardinalDirections {
+		t.Run(string(dir), func(t *testing.T) {
+			layout := QuadrupedAerial(dir, "").BodyPartLayout
+			head, torso, tail := layout[PartHead], layout[PartTorso], layout[PartTail]
+
+			var lead, trail float64
+			switch dir {
+			case DirUp:
+				lead, trail = torso.RelativeY-head.RelativeY, tail.RelativeY-torso.RelativeY
+			case DirDown:
+				lead, trail = head.RelativeY-torso.RelativeY, torso.RelativeY-tail.RelativeY
+			case DirLeft:
+				lead, trail = torso.RelativeX-head.RelativeX, tail.RelativeX-torso.RelativeX
+			case DirRight:
+				lead, trail = head.RelativeX-torso.RelativeX, torso.RelativeX-tail.RelativeX
+			}
+			if lead <= 0 || trail <= 0 {
+				t.Errorf("head should lead and tail trail the torso, got lead %.2f trail %.2f", lead, trail)
+			}
+		})
+	}
+}
+
+func TestMechanicalAerial_Proportions(t *testing.T) {
+	want := BodyPlanMechanical.Proportions()
+	layout := MechanicalAerial(DirDown, "").BodyPartLayout
+
+	head := layout[PartHead].RelativeHeight
+	torso := layout[PartTorso].RelativeHeight
+	legs := layout[PartLegs].RelativeHeight
+	total := head + torso + legs
+
+	if math.Abs(head/total-want.Head) > 1e-9 || math.Abs(torso/total-want.Torso) > 1e-9 || math.Abs(legs/total-want.Lower) > 1e-9 {
+		t.Errorf("proportions = %.2f/%.2f/%.2f, want %.2f/%.2f/%.2f",
+			head/total, torso/total, legs/total, want.Head, want.Torso, want.Lower)
+	}
+	// Stacked head to feet without gaps
+	if layout[PartHead].RelativeY >= layout[PartTorso].RelativeY || layout[PartTorso].RelativeY >= layout[PartLegs].RelativeY {
+		t.Error("mechanical parts should stack head, torso, legs from the top")
+	}
+}
+
+func TestAmorphousAerial_CoreFacing(t *testing.T) {
+	tests := []struct {
+		dir    Direction
+		dx, dy float64
+	}{
+		{DirUp, 0, -1},
+		{DirDown, 0, 1},
+		{DirLeft, -1, 0},
+		{DirRight, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.dir), func(t *testing.T) {
+			template := AmorphousAerial(tt.dir, "")
+			if _, hasLegs := template.BodyPartLayout[PartLegs]; hasLegs {
+				t.Error("amorphous template should have no legs")
+			}
+			core := template.BodyPartLayout[PartHead]
+			if (core.RelativeX-0.5)*tt.dx+(core.RelativeY-0.5)*tt.dy <= 0 {
+				t.Errorf("core at (%.2f, %.2f) should sit toward %s", core.RelativeX, core.RelativeY, tt.dir)
+			}
+		})
+	}
+}
+
+func TestCreatureAerial_GenreVariants(t *testing.T) {
+	templates := []struct {
+		name string
+		fn   func(Direction, string) AnatomicalTemplate
+		plan BodyPlan
+	}{
+		{"quadruped", QuadrupedAerial, BodyPlanQuadruped},
+		{"amorphous", AmorphousAerial, BodyPlanAmorphous},
+		{"mechanical", MechanicalAerial, BodyPlanMechanical},
+	}
+	genres := []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc"}
+
+	for _, tt := range templates {
+		base := tt.fn(DirRight, "")
+		if base.Name != tt.name+"_aerial_right" {
+			t.Errorf("base name = %s", base.Name)
+		}
+		for _, genre := range genres {
+			t.Run(tt.name+"_"+genre, func(t *testing.T) {
+				variant := tt.fn(DirRight, genre)
+				if variant.Name != genre+"_"+base.Name {
+					t.Errorf("name = %s, want %s", variant.Name, genre+"_"+base.Name)
+				}
+				if variant.BodyPlan != tt.plan {
+					t.Errorf("body plan = %s, want %s", variant.BodyPlan, tt.plan)
+				}
+				for part := range base.BodyPartLayout {
+					if _, ok := variant.BodyPartLayout[part]; !ok {
+						t.Errorf("variant missing part %s", part)
+					}
+				}
+				if reflect.DeepEqual(variant.BodyPartLayout, base.BodyPartLayout) {
+					t.Error("variant should differ from the base template")
+				}
+				if !reflect.DeepEqual(variant, tt.fn(DirRight, genre)) {
+					t.Error("variant is not deterministic")
+				}
+			})
+		}
+	}
+}
+
+func TestBossAerialTemplate_CreatureKeepsSilhouette(t *testing.T) {
+	bases := []AnatomicalTemplate{
+		QuadrupedAerial(DirRight, "fantasy"),
+		QuadrupedAerial(DirUp, ""),
+		AmorphousAerial(DirDown, "horror"),
+		MechanicalAerial(DirLeft, "scifi"),
+	}
+
+	for _, base := range bases {
+		t.Run(base.Name, func(t *testing.T) {
+			boss := BossAerialTemplate(base, 2.5)
+			if boss.BodyPlan != base.BodyPlan {
+				t.Errorf("body plan = %s, want %s", boss.BodyPlan, base.BodyPlan)
+			}
+
+			// The torso stays put and every part stays on the canvas
+			baseTorso, bossTorso := base.BodyPartLayout[PartTorso], boss.BodyPartLayout[PartTorso]
+			if math.Abs(bossTorso.RelativeX-baseTorso.RelativeX) > 1e-9 || math.Abs(bossTorso.RelativeY-baseTorso.RelativeY) > 1e-9 {
+				t.Error("boss torso moved")
+			}
+			scale := bossTorso.RelativeWidth / baseTorso.RelativeWidth
+			if scale <= 1 || scale > 2.5 {
+				t.Errorf("applied scale = %.2f, want in (1, 2.5]", scale)
+			}
+			for part, spec := range boss.BodyPartLayout {
+				if spec.RelativeX-spec.RelativeWidth/2 < -1e-9 || spec.RelativeX+spec.RelativeWidth/2 > 1+1e-9 ||
+					spec.RelativeY-spec.RelativeHeight/2 < -1e-9 || spec.RelativeY+spec.RelativeHeight/2 > 1+1e-9 {
+					t.Errorf("part %s leaves the canvas", part)
+				}
+				// All parts scale alike, so proportions are unchanged
+				if ratio := spec.RelativeHeight / base.BodyPartLayout[part].RelativeHeight; math.Abs(ratio-scale) > 1e-9 {
+					t.Errorf("part %s scaled %.3f, want %.3f", part, ratio, scale)
+				}
+			}
+		})
+	}
+}
+
+func TestBossAerialTemplate_CreatureSmallScale(t *testing.T) {
+	// A scale that fits the canvas is applied exactly
+	base := QuadrupedAerial(DirDown, "")
+	boss := BossAerialTemplate(base, 1.1)
+
+	got := boss.BodyPartLayout[PartHead].RelativeWidth / base.BodyPartLayout[PartHead].RelativeWidth
+	if math.Abs(got-1.1) > 1e-9 {
+		t.Errorf("applied scale = %.3f, want 1.1", got)
+	}
+}
+
+func TestBossAerialTemplateWithScale_ReportsAppliedScale(t *testing.T) {
+	base := QuadrupedAerial(DirRight, "fantasy")
+	boss, applied := BossAerialTemplateWithScale(base, 2.5)
+
+	got := boss.BodyPartLayout[PartTorso].RelativeWidth / base.BodyPartLayout[PartTorso].RelativeWidth
+	if math.Abs(got-applied) > 1e-9 {
+		t.Errorf("reported scale %.3f, applied %.3f", applied, got)
+	}
+	if applied >= 2.5 {
+		t.Fatalf("applied scale = %.3f, want the canvas to cap it below 2.5", applied)
+	}
+
+	// On the larger canvas the boss is drawn at the requested size
+	size := BossCanvasSize(28, 2.5, applied)
+	if drawn := float64(size) * boss.BodyPartLayout[PartTorso].RelativeWidth; drawn < 28*base.BodyPartLayout[PartTorso].RelativeWidth*2.5-1e-9 {
+		t.Errorf("torso drawn %.1f px on a %d px canvas, want at least 2.5x the normal %.1f px",
+			drawn, size, 28*base.BodyPartLayout[PartTorso].RelativeWidth)
+	}
+
+	// Humanoids scale fully and need no larger canvas
+	_, humanoid := BossAerialTemplateWithScale(FantasyHumanoidAerial(DirDown), 2.5)
+	if humanoid != 2.5 || BossCanvasSize(28, 2.5, humanoid) != 28 {
+		t.Errorf("humanoid applied scale = %.3f, canvas %d", humanoid, BossCanvasSize(28, 2.5, humanoid))
+	}
+}
+
+func TestBodyPlan_Proportions(t *testing.T) {
+	for _, plan := range []BodyPlan{"", BodyPlanHumanoid, BodyPlanQuadruped, BodyPlanAmorphous, BodyPlanMechanical} {
+		p := plan.Proportions()
+		if sum := p.Head + p.Torso + p.Lower; math.Abs(sum-1) > 1e-9 {
+			t.Errorf("%q proportions sum to %.2f", plan, sum)
+		}
+	}
+	if BodyPlan("").Proportions() != BodyPlanHumanoid.Proportions() {
+		t.Error("empty body plan should use humanoid proportions")
+	}
+}
@@ -0,0 +1,175 @@
+package sprites
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/venture/pkg/procgen"
+)
+
+const (
+	// deathDesaturation is how much color a fully defeated sprite loses (0-1)
+	deathDesaturation = 0.7
+	// deathDarkening is how much a fully defeated sprite darkens (0-1)
+	deathDarkening = 0.35
+	// deathCrackWidth is the width of cracks in a fully defeated sprite,
+	// as a fraction of its size
+	deathCrackWidth = 0.04
+	// deathSeparation is how far the two halves drift apart, as a fraction
+	// of the sprite size
+	deathSeparation = 0.08
+)
+
+// deathEffect holds the seeded choices that shape a death pose.
+type deathEffect struct {
+	tip    float64      // +1 topples clockwise, -1 counterclockwise
+	cracks [][3]float64 // Crack lines as (x, y, angle), in sprite fractions
+}
+
+// newDeathEffect picks a topple direction and crack lines from rng.
+func newDeathEffect(rng *rand.Rand) deathEffect {
+	effect := deathEffect{tip: 1}
+	if rng.Intn(2) == 0 {
+		effect.tip = -1
+	}
+	for i := 0; i < 2+rng.Intn(2); i++ {
+		effect.cracks = append(effect.cracks, [3]float64{
+			0.35 + 0.3*rng.Float64(),
+			0.35 + 0.3*rng.Float64(),
+			rng.Float64() * math.Pi,
+		})
+	}
+	return effect
+}
+
+// GenerateDeathSprite generates the defeated pose of the sprite described by
+// config: the living sprite toppled onto its side, desaturated and broken
+// into two fragments along a crack. It uses the same seed as the living
+// sprite, so the two match, and is deterministic for a config.
+func (g *Generator) GenerateDeathSprite(config Config) (*ebiten.Image, error) {
+	frames, err := g.GenerateDeathAnimation(config, 1)
+	if err != nil {
+		return nil, err
+	}
+	return frames[0], nil
+}
+
+// GenerateDeathAnimation generates frameCount frames of the sprite falling
+// into its defeated pose, for a brief death animation before the entity is
+// removed. The last frame is the GenerateDeathSprite pose.
+func (g *Generator) GenerateDeathAnimation(config Config, frameCount int) ([]*ebiten.Image, error) {
+	if frameCount <= 0 {
+		return nil, fmt.Errorf("frame count must be positive, got %d", frameCount)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("sprite size must be positive, got %dx%d", config.Width, config.Height)
+	}
+
+	living, err := g.Generate(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate living sprite: %w", err)
+	}
+
+	bounds := living.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	pixels := make([]byte, 4*w*h)
+	living.ReadPixels(pixels)
+
+	seedGen := procgen.NewSeedGenerator(config.Seed)
+	effect := newDeathEffect(rand.New(rand.NewSource(seedGen.GetSeed("death", config.Variation))))
+
+	frames := make([]*ebiten.Image, frameCount)
+	for i := range frames {
+		frame := ebiten.NewImage(w, h)
+		frame.WritePixels(deathPixels(pixels, w, h, float64(i+1)/float64(frameCount), effect))
+		frames[i] = frame
+	}
+	return frames, nil
+}
+
+// deathPixels applies a death effect to premultiplied RGBA pixels of a w by
+// h sprite at progress (0 is the living sprite, 1 the defeated pose) and
+// returns the new pixels.
+func deathPixels(src []byte, w, h int, progress float64, effect deathEffect) []byte {
+	dst := make([]byte, len(src))
+	size := float64(min(w, h))
+	cx, cy := float64(w)/2, float64(h)/2
+
+	angle := effect.tip * progress * math.Pi / 2
+	sin, cos := math.Sin(angle), math.Cos(angle)
+	crackHalfWidth := deathCrackWidth * size * progress / 2
+	separation := deathSeparation * size * progress
+
+	// The first crack splits the sprite into two drifting fragments
+	var split [3]float64
+	if len(effect.cracks) > 0 {
+		split = effect.cracks[0]
+	}
+	nx, ny := -math.Sin(split[2]), math.Cos(split[2])
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			// Undo the topple rotation about the centre
+			dx, dy := float64(x)+0.5-cx, float64(y)+0.5-cy
+			px, py := cx+dx*cos+dy*sin, cy-dx*sin+dy*cos
+
+			// Each side of the split samples its own shifted fragment
+			for _, side := range []float64{1, -1} {
+				sx, sy := px-side*nx*separation/2, py-side*ny*separation/2
+				if distanceToLine(sx, sy, split, w, h)*side < 0 {
+					continue
+				}
+				if crackedAt(sx, sy, effect, w, h, crackHalfWidth) {
+					continue
+				}
+				ix, iy := int(math.Floor(sx)), int(math.Floor(sy))
+				if ix < 0 || iy < 0 || ix >= w || iy >= h {
+					continue
+				}
+				i := 4 * (iy*w + ix)
+				if src[i+3] == 0 {
+					continue
+				}
+				copy(dst[4*(y*w+x):], fadeDeathColor(src[i:i+4], progress))
+				break
+			}
+		}
+	}
+	return dst
+}
+
+// distanceToLine returns the signed distance from (x, y) to a crack line
+// given in sprite fractions.
+func distanceToLine(x, y float64, line [3]float64, w, h int) float64 {
+	lx, ly := line[0]*float64(w), line[1]*float64(h)
+	return (x-lx)*-math.Sin(line[2]) + (y-ly)*math.Cos(line[2])
+}
+
+// crackedAt reports whether (x, y) lies within halfWidth of any crack.
+func crackedAt(x, y float64, effect deathEffect, w, h int, halfWidth float64) bool {
+	if halfWidth <= 0 {
+		return false
+	}
+	for _, crack := range effect.cracks {
+		if math.Abs(distanceToLine(x, y, crack, w, h)) < halfWidth {
+			return true
+		}
+	}
+	return false
+}
+
+// fadeDeathColor desaturates and darkens a premultiplied pixel by progress.
+func fadeDeathColor(p []byte, progress float64) []byte {
+	c := color.RGBA{R: p[0], G: p[1], B: p[2], A: p[3]}
+	grey := 0.299*float64(c.R) + 0.587*float64(c.G) + 0.114*float64(c.B)
+	desat := deathDesaturation * progress
+	dark := 1 - deathDarkening*progress
+
+	channel := func(v uint8) uint8 {
+		return uint8((float64(v) + (grey-float64(v))*desat) * dark)
+	}
+	return []byte{channel(c.R), channel(c.G), channel(c.B), c.A}
+}
@@ -0,0 +1,113 @@
+package sprites
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+// solidSprite returns premultiplied pixels of a w by h sprite with an
+// opaque red rectangle in its middle third.
+func solidSprite(w, h int) []byte {
+	pixels := make([]byte, 4*w*h)
+	for y := h / 3; y < 2*h/3; y++ {
+		for x := w / 3; x < 2*w/3; x++ {
+			copy(pixels[4*(y*w+x):], []byte{200, 40, 40, 255})
+		}
+	}
+	return pixels
+}
+
+// opaqueCount returns the number of visible pixels.
+func opaqueCount(pixels []byte) int {
+	n := 0
+	for i := 3; i < len(pixels); i += 4 {
+		if pixels[i] > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDeathPixels_ZeroProgress(t *testing.T) {
+	src := solidSprite(32, 32)
+	effect := newDeathEffect(rand.New(rand.NewSource(1)))
+
+	if got := deathPixels(src, 32, 32, 0, effect); !bytes.Equal(got, src) {
+		t.Error("zero progress should leave the sprite unchanged")
+	}
+}
+
+func TestDeathPixels_Defeated(t *testing.T) {
+	const w, h = 32, 32
+	src := solidSprite(w, h)
+	effect := newDeathEffect(rand.New(rand.NewSource(1)))
+
+	got := deathPixels(src, w, h, 1, effect)
+
+	// Cracks remove some pixels, but most of the body survives
+	before, after := opaqueCount(src), opaqueCount(got)
+	if after >= before || after < before/2 {
+		t.Errorf("opaque pixels %d -> %d, want a cracked but mostly intact body", before, after)
+	}
+
+	// Colors are desaturated and darkened
+	for i := 0; i < len(got); i += 4 {
+		if got[i+3] == 0 {
+			continue
+		}
+		if got[i] >= 200 || int(got[i])-int(got[i+1]) >= 160 {
+			t.Fatalf("pixel %v should be darker and less saturated than the living red", got[i:i+4])
+		}
+	}
+}
+
+func TestDeathPixels_Topples(t *testing.T) {
+	// A tall thin bar becomes a wide flat one
+	const w, h = 32, 32
+	src := make([]byte, 4*w*h)
+	for y := 4; y < 28; y++ {
+		for x := 14; x < 18; x++ {
+			copy(src[4*(y*w+x):], []byte{100, 100, 100, 255})
+		}
+	}
+	effect := deathEffect{tip: 1}
+
+	got := deathPixels(src, w, h, 1, effect)
+
+	minX, maxX, minY, maxY := w, 0, h, 0
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if got[4*(y*w+x)+3] > 0 {
+				minX, maxX = min(minX, x), max(maxX, x)
+				minY, maxY = min(minY, y), max(maxY, y)
+			}
+		}
+	}
+	if maxX-minX <= maxY-minY {
+		t.Errorf("toppled bounds %dx%d, want wider than tall", maxX-minX+1, maxY-minY+1)
+	}
+}
+
+func TestDeathPixels_Deterministic(t *testing.T) {
+	src := solidSprite(24, 24)
+	a := deathPixels(src, 24, 24, 0.5, newDeathEffect(rand.New(rand.NewSource(9))))
+	b := deathPixels(src, 24, 24, 0.5, newDeathEffect(rand.New(rand.NewSource(9))))
+	if !bytes.Equal(a, b) {
+		t.Error("same seed produced different death poses")
+	}
+}
+
+func TestGenerateDeathAnimation_InvalidInput(t *testing.T) {
+	gen := NewGenerator()
+	config := DefaultConfig()
+
+	if _, err := gen.GenerateDeathAnimation(config, 0); err == nil {
+		t.Error("expected error for zero frames")
+	}
+
+	config.Width = 0
+	if _, err := gen.GenerateDeathSprite(config); err == nil {
+		t.Error("expected error for zero width")
+	}
+}
@@ -46,6 +46,10 @@
 //	// sprites is map[Direction]*ebiten.Image
 //	// Access by direction: sprites[DirUp], sprites[DirDown], etc.
 //
+// Setting Directions to 8 adds the diagonals (DirUpLeft, DirUpRight,
+// DirDownLeft, DirDownRight at keys 4-7), each posed halfway between its two
+// adjacent cardinal templates via InterpolateTemplates.
+//
 // # Aerial-View Templates
 //
 // Aerial templates provide top-down character perspectives with consistent
@@ -76,6 +80,16 @@
 //	}
 //	sprites, err := gen.GenerateDirectionalSprites(config)
 //
+// Non-humanoid bosses use QuadrupedAerial (head 25%, torso 55%, tail 20%),
+// AmorphousAerial (a single mass with a core) or MechanicalAerial (head 20%,
+// torso 45%, legs 35%), each with genre variants. BossAerialTemplate reads
+// the template's BodyPlan and scales these about their torso, capped so the
+// silhouette stays on the canvas. BossAerialTemplateWithScale reports the
+// scale applied, and BossCanvasSize the canvas that shows the full size:
+//
+//	wolfBoss, applied := sprites.BossAerialTemplateWithScale(sprites.QuadrupedAerial(sprites.DirLeft, "fantasy"), 2.5)
+//	size := sprites.BossCanvasSize(28, 2.5, applied)
+//
 // # Using with Movement System
 //
 // The movement system automatically updates entity facing direction based
@@ -120,6 +134,27 @@
 // - Traditional vertical proportions
 // - Suitable for side-scrolling gameplay
 //
+// # Post-Processing
+//
+// Generated sprites can be varied without regenerating them:
+//
+//	outlined := sprites.AddOutline(sprite, color.RGBA{255, 220, 0, 255}, 1) // Selection highlight
+//	blueTeam := sprites.TintByTeam(sprite, color.RGBA{30, 80, 230, 255})   // Team color
+//	elite := sprites.RecolorPalette(sprite, map[color.RGBA]color.RGBA{red: gold})
+//
+// AddOutlineToSet outlines every frame of a directional sprite set.
+//
+// GenerateEquipmentLayer draws an equipped item as a transparent overlay
+// aligned to the aerial humanoid anatomy for the sprite's facing:
+//
+//	overlay, err := gen.GenerateEquipmentLayer(config, sprites.SlotMainHand, sword)
+//
+// GenerateDeathSprite produces the defeated pose of a sprite from the same
+// config (toppled, desaturated and cracked), and GenerateDeathAnimation the
+// frames leading up to it:
+//
+//	frames, err := gen.GenerateDeathAnimation(config, 6)
+//
 // # Performance Characteristics
 //
 // Sprite generation is optimized for runtime efficiency:
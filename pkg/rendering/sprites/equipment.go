@@ -0,0 +1,213 @@
+package sprites
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/venture/pkg/procgen/item"
+	"github.com/opd-ai/venture/pkg/rendering/shapes"
+)
+
+// EquipmentSlot identifies the body slot an equipment overlay is drawn for.
+// Values match engine.EquipmentSlot, so engine slots convert directly.
+type EquipmentSlot int
+
+const (
+	// SlotMainHand is the primary weapon slot
+	SlotMainHand EquipmentSlot = iota
+	// SlotOffHand is the secondary weapon or shield slot
+	SlotOffHand
+	// SlotHead is the helmet slot
+	SlotHead
+	// SlotChest is the body armor slot
+	SlotChest
+	// SlotLegs is the leg armor slot
+	SlotLegs
+	// SlotBoots is the footwear slot
+	SlotBoots
+	// SlotGloves is the hand armor slot
+	SlotGloves
+	// SlotAccessory1 is the first accessory slot
+	SlotAccessory1
+	// SlotAccessory2 is the second accessory slot
+	SlotAccessory2
+	// SlotAccessory3 is the third accessory slot
+	SlotAccessory3
+)
+
+// String returns the string representation of an equipment slot.
+func (s EquipmentSlot) String() string {
+	switch s {
+	case SlotMainHand:
+		return "main_hand"
+	case SlotOffHand:
+		return "off_hand"
+	case SlotHead:
+		return "head"
+	case SlotChest:
+		return "chest"
+	case SlotLegs:
+		return "legs"
+	case SlotBoots:
+		return "boots"
+	case SlotGloves:
+		return "gloves"
+	case SlotAccessory1:
+		return "accessory1"
+	case SlotAccessory2:
+		return "accessory2"
+	case SlotAccessory3:
+		return "accessory3"
+	default:
+		return "unknown"
+	}
+}
+
+// GenerateEquipmentLayer generates a transparent overlay, the same size as
+// the sprite described by config, showing item worn in slot. The overlay is
+// positioned on the aerial humanoid anatomy for the facing in
+// Config.Custom["facing"] (default "down"), so drawing it over the matching
+// directional sprite lines it up in every direction.
+//
+// Shape and colors come from the item's seed, type and rarity, so an item
+// looks the same on every wearer and in every direction. Accessory slots
+// have no overlay and return an error.
+func (g *Generator) GenerateEquipmentLayer(config Config, slot EquipmentSlot, itm *item.Item) (*ebiten.Image, error) {
+	if itm == nil {
+		return nil, fmt.Errorf("item is nil")
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("sprite size must be positive, got %dx%d", config.Width, config.Height)
+	}
+
+	direction := DirDown
+	if dir, ok := config.Custom["facing"].(string); ok {
+		direction = Direction(dir)
+	}
+
+	spec, err := equipmentSpec(direction, slot, itm)
+	if err != nil {
+		return nil, err
+	}
+
+	pal, err := g.paletteGen.Generate(config.GenreID, itm.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate item palette: %w", err)
+	}
+
+	img := ebiten.NewImage(config.Width, config.Height)
+	partWidth := int(float64(config.Width) * spec.RelativeWidth)
+	partHeight := int(float64(config.Height) * spec.RelativeHeight)
+	if partWidth <= 0 || partHeight <= 0 {
+		return img, nil
+	}
+
+	// The shape is picked from the item seed alone so it matches across directions
+	rng := rand.New(rand.NewSource(itm.Seed))
+	shape, err := g.shapeGen.Generate(shapes.Config{
+		Type:      spec.ShapeTypes[rng.Intn(len(spec.ShapeTypes))],
+		Width:     partWidth,
+		Height:    partHeight,
+		Color:     g.getColorForRole(spec.ColorRole, pal),
+		Seed:      itm.Seed,
+		Smoothing: 0.15,
+		Rotation:  spec.Rotation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s overlay: %w", slot, err)
+	}
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(
+		float64(config.Width)*spec.RelativeX-float64(partWidth)/2,
+		float64(config.Height)*spec.RelativeY-float64(partHeight)/2,
+	)
+	img.DrawImage(shape, opts)
+
+	return img, nil
+}
+
+// equipmentSpec returns where and how an item in slot is drawn for a facing
+// direction, in the same relative units as the anatomical templates.
+func equipmentSpec(direction Direction, slot EquipmentSlot, itm *item.Item) (PartSpec, error) {
+	var template AnatomicalTemplate
+	if vertical, horizontal, ok := direction.cardinals(); ok {
+		template = InterpolateTemplates(equipmentAnchors(vertical), equipmentAnchors(horizontal), 0.5)
+	} else {
+		template = equipmentAnchors(direction)
+	}
+	layout := template.BodyPartLayout
+
+	var spec PartSpec
+	switch slot {
+	case SlotMainHand:
+		spec = layout[PartWeapon]
+		spec.ShapeTypes = weaponShapes(itm.WeaponType)
+	case SlotOffHand:
+		spec = layout[PartShield]
+		spec.ShapeTypes = []shapes.ShapeType{shapes.ShapeShield, shapes.ShapeCircle}
+		if itm.Type == item.TypeWeapon {
+			// An off-hand weapon is held where a shield would be
+			spec.ShapeTypes = weaponShapes(itm.WeaponType)
+		}
+	case SlotHead:
+		// A cap over the top of the head
+		spec = layout[PartHead]
+		spec.RelativeY -= spec.RelativeHeight * 0.2
+		spec.RelativeWidth *= 1.05
+		spec.RelativeHeight *= 0.6
+		spec.ShapeTypes = []shapes.ShapeType{shapes.ShapeEllipse, shapes.ShapeOctagon}
+	case SlotChest:
+		spec = layout[PartTorso]
+		spec.RelativeWidth *= 0.9
+		spec.RelativeHeight *= 0.8
+		spec.ShapeTypes = []shapes.ShapeType{shapes.ShapeRectangle, shapes.ShapeBean, shapes.ShapeOctagon}
+	case SlotLegs:
+		spec = layout[PartLegs]
+		spec.ShapeTypes = []shapes.ShapeType{shapes.ShapeCapsule, shapes.ShapeRectangle}
+	case SlotBoots:
+		// The lower half of the legs
+		spec = layout[PartLegs]
+		spec.RelativeY += spec.RelativeHeight * 0.25
+		spec.RelativeWidth *= 1.05
+		spec.RelativeHeight *= 0.5
+		spec.ShapeTypes = []shapes.ShapeType{shapes.ShapeEllipse, shapes.ShapeCapsule}
+	case SlotGloves:
+		spec = layout[PartArms]
+		spec.RelativeHeight *= 0.5
+		spec.ShapeTypes = []shapes.ShapeType{shapes.ShapeCapsule}
+	default:
+		return PartSpec{}, fmt.Errorf("no equipment overlay for slot %s", slot)
+	}
+
+	spec.ColorRole = GetRarityColorRole(ItemRarity(itm.Rarity))
+	spec.Opacity = 1.0
+	return spec, nil
+}
+
+// equipmentAnchors returns the aerial humanoid template for a cardinal
+// direction with the weapon and shield positions of HumanoidWithEquipment.
+func equipmentAnchors(direction Direction) AnatomicalTemplate {
+	template := HumanoidAerialTemplate(direction)
+	equipped := HumanoidWithEquipment(direction, true, true)
+	template.BodyPartLayout[PartWeapon] = equipped.BodyPartLayout[PartWeapon]
+	template.BodyPartLayout[PartShield] = equipped.BodyPartLayout[PartShield]
+	return template
+}
+
+// weaponShapes returns the shapes a held weapon of the given type may take.
+func weaponShapes(weaponType item.WeaponType) []shapes.ShapeType {
+	switch weaponType {
+	case item.WeaponSword, item.WeaponDagger:
+		return []shapes.ShapeType{shapes.ShapeBlade}
+	case item.WeaponAxe:
+		return []shapes.ShapeType{shapes.ShapeWedge, shapes.ShapeBlade}
+	case item.WeaponBow, item.WeaponCrossbow:
+		return []shapes.ShapeType{shapes.ShapeCrescent}
+	case item.WeaponStaff, item.WeaponWand:
+		return []shapes.ShapeType{shapes.ShapeCapsule}
+	default:
+		return []shapes.ShapeType{shapes.ShapeRectangle}
+	}
+}
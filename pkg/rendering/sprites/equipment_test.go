@@ -0,0 +1,129 @@
+package sprites
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/venture/pkg/procgen/item"
+)
+
+// TestEquipmentSpec_AlignsWithAnatomy tests that overlays follow the aerial
+// template's body parts in every direction.
+func TestEquipmentSpec_AlignsWithAnatomy(t *testing.T) {
+	helmet := &item.Item{Type: item.TypeArmor, ArmorType: item.ArmorHelmet, Seed: 7}
+	chest := &item.Item{Type: item.TypeArmor, ArmorType: item.ArmorChest, Seed: 7}
+	sword := &item.Item{Type: item.TypeWeapon, WeaponType: item.WeaponSword, Seed: 7}
+
+	for _, dir := range []Direction{DirUp, DirDown, DirLeft, DirRight} {
+		t.Run(string(dir), func(t *testing.T) {
+			anatomy := HumanoidAerialTemplate(dir).BodyPartLayout
+
+			spec, err := equipmentSpec(dir, SlotHead, helmet)
+			if err != nil {
+				t.Fatalf("equipmentSpec(head) failed: %v", err)
+			}
+			if spec.RelativeX != anatomy[PartHead].RelativeX {
+				t.Errorf("helmet X = %v, want head X %v", spec.RelativeX, anatomy[PartHead].RelativeX)
+			}
+			if spec.RelativeY >= anatomy[PartHead].RelativeY {
+				t.Errorf("helmet Y = %v, want above head centre %v", spec.RelativeY, anatomy[PartHead].RelativeY)
+			}
+
+			spec, err = equipmentSpec(dir, SlotChest, chest)
+			if err != nil {
+				t.Fatalf("equipmentSpec(chest) failed: %v", err)
+			}
+			if spec.RelativeX != anatomy[PartTorso].RelativeX || spec.RelativeY != anatomy[PartTorso].RelativeY {
+				t.Errorf("chest at (%v, %v), want torso centre", spec.RelativeX, spec.RelativeY)
+			}
+
+			spec, err = equipmentSpec(dir, SlotMainHand, sword)
+			if err != nil {
+				t.Fatalf("equipmentSpec(main hand) failed: %v", err)
+			}
+			weapon := HumanoidWithEquipment(dir, true, false).BodyPartLayout[PartWeapon]
+			if spec.RelativeX != weapon.RelativeX || spec.Rotation != weapon.Rotation {
+				t.Errorf("sword at X %v rotated %v, want %v rotated %v", spec.RelativeX, spec.Rotation, weapon.RelativeX, weapon.Rotation)
+			}
+		})
+	}
+}
+
+// TestEquipmentSpec_Diagonal tests that diagonal overlays sit between the
+// adjacent cardinal positions.
+func TestEquipmentSpec_Diagonal(t *testing.T) {
+	sword := &item.Item{Type: item.TypeWeapon, WeaponType: item.WeaponSword}
+
+	up, _ := equipmentSpec(DirUp, SlotMainHand, sword)
+	left, _ := equipmentSpec(DirLeft, SlotMainHand, sword)
+	diag, err := equipmentSpec(DirUpLeft, SlotMainHand, sword)
+	if err != nil {
+		t.Fatalf("equipmentSpec(up-left) failed: %v", err)
+	}
+
+	if want := (up.RelativeX + left.RelativeX) / 2; math.Abs(diag.RelativeX-want) > 1e-9 {
+		t.Errorf("up-left sword X = %v, want %v", diag.RelativeX, want)
+	}
+}
+
+// TestEquipmentSpec_ItemAppearance tests shape and color selection from the item.
+func TestEquipmentSpec_ItemAppearance(t *testing.T) {
+	bow := &item.Item{Type: item.TypeWeapon, WeaponType: item.WeaponBow, Rarity: item.RarityLegendary}
+
+	spec, err := equipmentSpec(DirDown, SlotMainHand, bow)
+	if err != nil {
+		t.Fatalf("equipmentSpec failed: %v", err)
+	}
+	if len(spec.ShapeTypes) != 1 || spec.ShapeTypes[0] != weaponShapes(item.WeaponBow)[0] {
+		t.Errorf("bow shapes = %v, want %v", spec.ShapeTypes, weaponShapes(item.WeaponBow))
+	}
+	if spec.ColorRole != GetRarityColorRole(RarityLegendary) {
+		t.Errorf("color role = %q, want %q", spec.ColorRole, GetRarityColorRole(RarityLegendary))
+	}
+
+	// A weapon in the off hand keeps its weapon shape
+	spec, err = equipmentSpec(DirDown, SlotOffHand, bow)
+	if err != nil {
+		t.Fatalf("equipmentSpec(off hand) failed: %v", err)
+	}
+	if spec.ShapeTypes[0] != weaponShapes(item.WeaponBow)[0] {
+		t.Errorf("off-hand bow shapes = %v, want weapon shapes", spec.ShapeTypes)
+	}
+}
+
+// TestEquipmentSpec_UnsupportedSlot tests that accessory slots are rejected.
+func TestEquipmentSpec_UnsupportedSlot(t *testing.T) {
+	ring := &item.Item{Type: item.TypeAccessory}
+	for _, slot := range []EquipmentSlot{SlotAccessory1, SlotAccessory2, SlotAccessory3, EquipmentSlot(99)} {
+		if _, err := equipmentSpec(DirDown, slot, ring); err == nil {
+			t.Errorf("expected error for slot %s", slot)
+		}
+	}
+}
+
+// TestGenerateEquipmentLayer_InvalidInput tests argument validation.
+func TestGenerateEquipmentLayer_InvalidInput(t *testing.T) {
+	gen := NewGenerator()
+	config := DefaultConfig()
+
+	if _, err := gen.GenerateEquipmentLayer(config, SlotMainHand, nil); err == nil {
+		t.Error("expected error for nil item")
+	}
+
+	sword := &item.Item{Type: item.TypeWeapon, WeaponType: item.WeaponSword}
+	if _, err := gen.GenerateEquipmentLayer(config, SlotAccessory1, sword); err == nil {
+		t.Error("expected error for accessory slot")
+	}
+
+	config.Height = 0
+	if _, err := gen.GenerateEquipmentLayer(config, SlotMainHand, sword); err == nil {
+		t.Error("expected error for zero height")
+	}
+}
+
+// TestEquipmentSlot_String tests slot names.
+func TestEquipmentSlot_String(t *testing.T) {
+	if SlotMainHand.String() != "main_hand" || SlotGloves.String() != "gloves" || EquipmentSlot(99).String() != "unknown" {
+		t.Error("unexpected slot names")
+	}
+}
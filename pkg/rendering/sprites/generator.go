@@ -4,6 +4,7 @@
 package sprites
 
 import (
+	"fmt"
 	"image/color"
 	"math/rand"
 
@@ -240,9 +241,6 @@ func (g *Generator) generateEntityWithTemplate(config Config, entityType string,
 		}
 	}
 
-	// Select appropriate template based on entity type, genre, direction, and equipment
-	var template AnatomicalTemplate
-
 	// Check if humanoid with equipment
 	isHumanoid := false
 	switch entityType {
@@ -250,22 +248,33 @@ func (g *Generator) generateEntityWithTemplate(config Config, entityType string,
 		isHumanoid = true
 	}
 
-	// Phase 4: Use aerial template if useAerial flag is set
-	if useAerial && isHumanoid {
-		// Use SelectAerialTemplate which provides 35/50/15 proportions
-		template = SelectAerialTemplate(entityType, genre, direction)
-	} else if isHumanoid && (hasWeapon || hasShield) {
-		// Use equipment template
-		template = HumanoidWithEquipment(direction, hasWeapon, hasShield)
-	} else if isHumanoid && genre != "" {
-		// Use genre-specific humanoid template
-		template = SelectHumanoidTemplate(genre, entityType, direction)
-	} else if isHumanoid {
-		// Use directional template
-		template = HumanoidDirectionalTemplate(direction)
-	} else {
+	// Select appropriate template based on entity type, genre, direction, and equipment
+	selectTemplate := func(direction Direction) AnatomicalTemplate {
+		// Phase 4: Use aerial template if useAerial flag is set
+		if useAerial && isHumanoid {
+			// Use SelectAerialTemplate which provides 35/50/15 proportions
+			return SelectAerialTemplate(entityType, genre, direction)
+		} else if isHumanoid && (hasWeapon || hasShield) {
+			// Use equipment template
+			return HumanoidWithEquipment(direction, hasWeapon, hasShield)
+		} else if isHumanoid && genre != "" {
+			// Use genre-specific humanoid template
+			return SelectHumanoidTemplate(genre, entityType, direction)
+		} else if isHumanoid {
+			// Use directional template
+			return HumanoidDirectionalTemplate(direction)
+		}
 		// Use basic template for non-humanoids
-		template = SelectTemplate(entityType)
+		return SelectTemplate(entityType)
+	}
+
+	// Diagonal facings blend the two adjacent cardinal poses, keeping the
+	// vertical pose's draw order (arms behind when facing away)
+	var template AnatomicalTemplate
+	if vertical, horizontal, ok := direction.cardinals(); ok {
+		template = InterpolateTemplates(selectTemplate(vertical), selectTemplate(horizontal), 0.5)
+	} else {
+		template = selectTemplate(direction)
 	}
 
 	// Apply boss scaling if needed (Phase 5.3)
@@ -607,9 +616,19 @@ func (g *Generator) generateUI(config Config, rng *rand.Rand) (*ebiten.Image, er
 	return result, nil
 }
 
-// GenerateDirectionalSprites generates a 4-directional sprite sheet (Phase 4).
-// Returns map[int]*ebiten.Image where keys are Direction constants (0-3).
+// GenerateDirectionalSprites generates a 4- or 8-directional sprite sheet (Phase 4).
+// Returns map[int]*ebiten.Image where keys are Direction constants: 0-3 for
+// up, down, left and right, and with Config.Directions set to 8 also 4-7 for
+// up-left, up-right, down-left and down-right.
 func (g *Generator) GenerateDirectionalSprites(config Config) (map[int]*ebiten.Image, error) {
+	directionCount := config.Directions
+	if directionCount == 0 {
+		directionCount = 4
+	}
+	if directionCount != 4 && directionCount != 8 {
+		return nil, fmt.Errorf("directions must be 4 or 8, got %d", config.Directions)
+	}
+
 	if g.logger != nil && g.logger.Logger.GetLevel() >= logrus.DebugLevel {
 		g.logger.WithFields(logrus.Fields{
 			"type":       config.Type,
@@ -638,9 +657,13 @@ func (g *Generator) GenerateDirectionalSprites(config Config) (map[int]*ebiten.I
 		{1, "down"},
 		{2, "left"},
 		{3, "right"},
+		{4, string(DirUpLeft)},
+		{5, string(DirUpRight)},
+		{6, string(DirDownLeft)},
+		{7, string(DirDownRight)},
 	}
 
-	for _, dir := range directions {
+	for _, dir := range directions[:directionCount] {
 		// Create config for this direction
 		dirConfig := config
 		if dirConfig.Custom == nil {
@@ -2,6 +2,7 @@
 package sprites
 
 import (
+	"bytes"
 	"image/color"
 	"testing"
 
@@ -255,6 +256,66 @@ func TestGenerateDirectionalSprites_InvalidConfig(t *testing.T) {
 	}
 }
 
+// TestGenerateDirectionalSprites_EightDirections tests 8-directional sprite sheet generation.
+func TestGenerateDirectionalSprites_EightDirections(t *testing.T) {
+	gen := NewGenerator()
+
+	config := Config{
+		Type:       SpriteEntity,
+		Width:      28,
+		Height:     28,
+		Seed:       12345,
+		GenreID:    "fantasy",
+		Complexity: 0.7,
+		Directions: 8,
+		Custom: map[string]interface{}{
+			"entityType": "humanoid",
+			"useAerial":  true,
+		},
+	}
+
+	sprites, err := gen.GenerateDirectionalSprites(config)
+	if err != nil {
+		t.Fatalf("GenerateDirectionalSprites failed: %v", err)
+	}
+
+	if len(sprites) != 8 {
+		t.Fatalf("Expected 8 sprites, got %d", len(sprites))
+	}
+	for dir := 0; dir < 8; dir++ {
+		if sprites[dir] == nil {
+			t.Errorf("Missing sprite for direction %d", dir)
+		}
+	}
+
+	// Diagonals are deterministic too
+	again, err := gen.GenerateDirectionalSprites(config)
+	if err != nil {
+		t.Fatalf("GenerateDirectionalSprites failed: %v", err)
+	}
+	for dir := 4; dir < 8; dir++ {
+		pixels1 := make([]byte, 4*config.Width*config.Height)
+		pixels2 := make([]byte, len(pixels1))
+		sprites[dir].ReadPixels(pixels1)
+		again[dir].ReadPixels(pixels2)
+		if !bytes.Equal(pixels1, pixels2) {
+			t.Errorf("Direction %d differs between runs with the same seed", dir)
+		}
+	}
+}
+
+// TestGenerateDirectionalSprites_InvalidDirections tests the direction count check.
+func TestGenerateDirectionalSprites_InvalidDirections(t *testing.T) {
+	gen := NewGenerator()
+
+	config := DefaultConfig()
+	config.Directions = 6
+
+	if _, err := gen.GenerateDirectionalSprites(config); err == nil {
+		t.Error("Expected error for 6 directions")
+	}
+}
+
 // TestGenerateEntityWithTemplate_UseAerial tests useAerial flag in template selection.
 func TestGenerateEntityWithTemplate_UseAerial(t *testing.T) {
 	gen := NewGenerator()
@@ -0,0 +1,203 @@
+package sprites
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	// teamHueBins is the number of hue buckets used to find a sprite's
+	// dominant hue
+	teamHueBins = 36
+	// teamHueRange is how far (in degrees) a pixel's hue may be from the
+	// dominant hue and still be tinted
+	teamHueRange = 20.0
+	// teamMinSaturation is the saturation below which pixels count as grey
+	// and are left alone
+	teamMinSaturation = 0.15
+)
+
+// RecolorPalette returns a copy of img with every pixel whose color is a key
+// of mapping replaced by its value. Colors are compared exactly, as
+// alpha-premultiplied RGBA like color.RGBA itself. The result depends only
+// on the inputs, so it can be cached by sprite and mapping.
+func RecolorPalette(img *ebiten.Image, mapping map[color.RGBA]color.RGBA) *ebiten.Image {
+	if img == nil {
+		return nil
+	}
+	return mapPixels(img, func(pixels []byte) { recolorPixels(pixels, mapping) })
+}
+
+// TintByTeam returns a copy of img with its dominant hue shifted to
+// teamColor's hue, for team colors and palette-swapped variants. Pixels near
+// the dominant hue are rotated to the team hue, keeping their saturation
+// and brightness so shading survives; grey pixels and other hues (skin,
+// outlines, accents) are left alone. The result depends only on the inputs,
+// so it can be cached by sprite and team color.
+func TintByTeam(img *ebiten.Image, teamColor color.RGBA) *ebiten.Image {
+	if img == nil {
+		return nil
+	}
+	return mapPixels(img, func(pixels []byte) { tintPixels(pixels, teamColor) })
+}
+
+// mapPixels reads img's pixels, lets fn edit them in place, and returns a
+// new image with the result.
+func mapPixels(img *ebiten.Image, fn func(pixels []byte)) *ebiten.Image {
+	bounds := img.Bounds()
+	pixels := make([]byte, 4*bounds.Dx()*bounds.Dy())
+	img.ReadPixels(pixels)
+	fn(pixels)
+
+	result := ebiten.NewImage(bounds.Dx(), bounds.Dy())
+	result.WritePixels(pixels)
+	return result
+}
+
+// recolorPixels replaces mapped colors in premultiplied RGBA pixels.
+func recolorPixels(pixels []byte, mapping map[color.RGBA]color.RGBA) {
+	if len(mapping) == 0 {
+		return
+	}
+	for i := 0; i+3 < len(pixels); i += 4 {
+		c := color.RGBA{R: pixels[i], G: pixels[i+1], B: pixels[i+2], A: pixels[i+3]}
+		if to, ok := mapping[c]; ok {
+			pixels[i], pixels[i+1], pixels[i+2], pixels[i+3] = to.R, to.G, to.B, to.A
+		}
+	}
+}
+
+// tintPixels rotates the dominant hue of premultiplied RGBA pixels to
+// teamColor's hue in place.
+func tintPixels(pixels []byte, teamColor color.RGBA) {
+	teamHue, teamSat, _ := rgbToHSV(unpremultiply(teamColor))
+	if teamSat < teamMinSaturation {
+		return
+	}
+
+	dominant, ok := dominantHue(pixels)
+	if !ok {
+		return
+	}
+
+	for i := 0; i+3 < len(pixels); i += 4 {
+		c := color.RGBA{R: pixels[i], G: pixels[i+1], B: pixels[i+2], A: pixels[i+3]}
+		if c.A == 0 {
+			continue
+		}
+		h, s, v := rgbToHSV(unpremultiply(c))
+		if s < teamMinSaturation || hueDistance(h, dominant) > teamHueRange {
+			continue
+		}
+
+		// Keep the pixel's offset from the dominant hue so gradients survive
+		r, g, b := hsvToRGB(math.Mod(h-dominant+teamHue+360, 360), s, v)
+		a := float64(c.A) / 255
+		pixels[i] = uint8(r*a + 0.5)
+		pixels[i+1] = uint8(g*a + 0.5)
+		pixels[i+2] = uint8(b*a + 0.5)
+	}
+}
+
+// dominantHue returns the mean hue of the most common hue bucket among
+// saturated, visible pixels, weighted by saturation and alpha. Reports
+// false if no pixel is saturated.
+func dominantHue(pixels []byte) (float64, bool) {
+	// Hues are summed as unit vectors so means wrap correctly around 0
+	var weights, sumX, sumY [teamHueBins]float64
+	for i := 0; i+3 < len(pixels); i += 4 {
+		c := color.RGBA{R: pixels[i], G: pixels[i+1], B: pixels[i+2], A: pixels[i+3]}
+		if c.A == 0 {
+			continue
+		}
+		h, s, _ := rgbToHSV(unpremultiply(c))
+		if s < teamMinSaturation {
+			continue
+		}
+		bin := int(h/360*teamHueBins) % teamHueBins
+		weight := s * float64(c.A) / 255
+		weights[bin] += weight
+		sumX[bin] += weight * math.Cos(h*math.Pi/180)
+		sumY[bin] += weight * math.Sin(h*math.Pi/180)
+	}
+
+	best := -1
+	for i, weight := range weights {
+		if weight > 0 && (best < 0 || weight > weights[best]) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return 0, false
+	}
+	return math.Mod(math.Atan2(sumY[best], sumX[best])*180/math.Pi+360, 360), true
+}
+
+// unpremultiply returns c's straight (non-premultiplied) channels, 0-255.
+func unpremultiply(c color.RGBA) (r, g, b float64) {
+	if c.A == 0 {
+		return 0, 0, 0
+	}
+	a := float64(c.A) / 255
+	return math.Min(255, float64(c.R)/a), math.Min(255, float64(c.G)/a), math.Min(255, float64(c.B)/a)
+}
+
+// rgbToHSV converts straight RGB (0-255) to hue (0-360), saturation and
+// value (0-1).
+func rgbToHSV(r, g, b float64) (h, s, v float64) {
+	r, g, b = r/255, g/255, b/255
+	hi := math.Max(r, math.Max(g, b))
+	lo := math.Min(r, math.Min(g, b))
+	v = hi
+	delta := hi - lo
+	if hi == 0 || delta == 0 {
+		return 0, 0, v
+	}
+	s = delta / hi
+
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/delta, 6)
+	case g:
+		h = (b-r)/delta + 2
+	default:
+		h = (r-g)/delta + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h, s, v
+}
+
+// hsvToRGB converts hue (0-360), saturation and value (0-1) to straight RGB
+// (0-255).
+func hsvToRGB(h, s, v float64) (r, g, b float64) {
+	c := v * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := v - c
+
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	return (r + m) * 255, (g + m) * 255, (b + m) * 255
+}
+
+// hueDistance returns the angle between two hues, 0-180.
+func hueDistance(a, b float64) float64 {
+	d := math.Abs(a - b)
+	return math.Min(d, 360-d)
+}
@@ -0,0 +1,126 @@
+package sprites
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+// pixelBuffer returns premultiplied RGBA pixels for colors.
+func pixelBuffer(colors ...color.RGBA) []byte {
+	pixels := make([]byte, 0, 4*len(colors))
+	for _, c := range colors {
+		pixels = append(pixels, c.R, c.G, c.B, c.A)
+	}
+	return pixels
+}
+
+// pixelAt returns the i-th pixel of a buffer.
+func pixelAt(pixels []byte, i int) color.RGBA {
+	return color.RGBA{R: pixels[4*i], G: pixels[4*i+1], B: pixels[4*i+2], A: pixels[4*i+3]}
+}
+
+func TestRecolorPixels(t *testing.T) {
+	red := color.RGBA{R: 200, A: 255}
+	blue := color.RGBA{B: 200, A: 255}
+	gold := color.RGBA{R: 220, G: 180, B: 40, A: 255}
+	pixels := pixelBuffer(red, blue, red, color.RGBA{})
+
+	recolorPixels(pixels, map[color.RGBA]color.RGBA{red: gold})
+
+	want := []color.RGBA{gold, blue, gold, {}}
+	for i, w := range want {
+		if got := pixelAt(pixels, i); got != w {
+			t.Errorf("pixel %d = %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestTintPixels(t *testing.T) {
+	// A red body in three shades, a grey outline and a skin-toned face
+	shades := []color.RGBA{
+		{R: 220, G: 40, B: 40, A: 255},
+		{R: 160, G: 30, B: 30, A: 255},
+		{R: 90, G: 15, B: 15, A: 255},
+	}
+	grey := color.RGBA{R: 60, G: 60, B: 60, A: 255}
+	skin := color.RGBA{R: 230, G: 190, B: 150, A: 255}
+	pixels := pixelBuffer(shades[0], shades[1], shades[2], shades[0], grey, skin, color.RGBA{})
+	original := append([]byte(nil), pixels...)
+
+	teamBlue := color.RGBA{R: 30, G: 80, B: 230, A: 255}
+	tintPixels(pixels, teamBlue)
+
+	teamHue, _, _ := rgbToHSV(unpremultiply(teamBlue))
+	for i := range shades {
+		h, s, v := rgbToHSV(unpremultiply(pixelAt(pixels, i)))
+		_, s0, v0 := rgbToHSV(unpremultiply(pixelAt(original, i)))
+		if hueDistance(h, teamHue) > 10 {
+			t.Errorf("shade %d hue = %.0f, want near team hue %.0f", i, h, teamHue)
+		}
+		// Shading is preserved
+		if math.Abs(s-s0) > 0.02 || math.Abs(v-v0) > 0.02 {
+			t.Errorf("shade %d saturation/value = %.2f/%.2f, want %.2f/%.2f", i, s, v, s0, v0)
+		}
+	}
+
+	for _, i := range []int{4, 5, 6} {
+		if pixelAt(pixels, i) != pixelAt(original, i) {
+			t.Errorf("pixel %d = %v, want unchanged %v", i, pixelAt(pixels, i), pixelAt(original, i))
+		}
+	}
+}
+
+func TestTintPixels_GreyInputs(t *testing.T) {
+	grey := color.RGBA{R: 120, G: 120, B: 120, A: 255}
+
+	// A sprite with no saturated pixels is unchanged
+	pixels := pixelBuffer(grey, grey)
+	tintPixels(pixels, color.RGBA{B: 255, A: 255})
+	if pixelAt(pixels, 0) != grey {
+		t.Errorf("grey sprite tinted to %v", pixelAt(pixels, 0))
+	}
+
+	// A grey team color has no hue to shift to
+	red := color.RGBA{R: 200, G: 20, B: 20, A: 255}
+	pixels = pixelBuffer(red)
+	tintPixels(pixels, grey)
+	if pixelAt(pixels, 0) != red {
+		t.Errorf("grey team color changed pixel to %v", pixelAt(pixels, 0))
+	}
+}
+
+func TestTintPixels_PremultipliedAlpha(t *testing.T) {
+	// Half-transparent red, premultiplied
+	pixels := pixelBuffer(color.RGBA{R: 100, G: 10, B: 10, A: 128})
+	tintPixels(pixels, color.RGBA{G: 200, A: 255})
+
+	got := pixelAt(pixels, 0)
+	if got.A != 128 {
+		t.Errorf("alpha = %d, want 128", got.A)
+	}
+	if got.G <= got.R || got.G > got.A {
+		t.Errorf("tinted pixel = %v, want premultiplied green", got)
+	}
+}
+
+func TestHSVRoundTrip(t *testing.T) {
+	for _, c := range []color.RGBA{
+		{R: 255, A: 255}, {G: 255, A: 255}, {B: 255, A: 255},
+		{R: 12, G: 200, B: 99, A: 255}, {R: 250, G: 250, B: 10, A: 255}, {A: 255},
+	} {
+		r, g, b := hsvToRGB(rgbToHSV(float64(c.R), float64(c.G), float64(c.B)))
+		if math.Abs(r-float64(c.R)) > 0.5 || math.Abs(g-float64(c.G)) > 0.5 || math.Abs(b-float64(c.B)) > 0.5 {
+			t.Errorf("round trip of %v = %.1f %.1f %.1f", c, r, g, b)
+		}
+	}
+}
+
+func TestRecolor_NilImage(t *testing.T) {
+	if RecolorPalette(nil, nil) != nil {
+		t.Error("RecolorPalette(nil) should return nil")
+	}
+	if TintByTeam(nil, color.RGBA{}) != nil {
+		t.Error("TintByTeam(nil) should return nil")
+	}
+}
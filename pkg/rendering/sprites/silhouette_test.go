@@ -3,6 +3,8 @@ package sprites
 import (
 	"image/color"
 	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
 )
 
 // Note: The silhouette analyzer functions that require Ebiten image operations
@@ -326,3 +328,85 @@ func TestSilhouetteMetrics_Ranges(t *testing.T) {
 		})
 	}
 }
+
+// TestOutlinePixels tests outline placement on raw pixel buffers.
+func TestOutlinePixels(t *testing.T) {
+	// 5x5 sprite with one opaque red pixel in the centre
+	const w, h = 5, 5
+	pixels := make([]byte, 4*w*h)
+	copy(pixels[4*(2*w+2):], []byte{255, 0, 0, 255})
+	outlineColor := color.RGBA{R: 0, G: 0, B: 255, A: 255}
+
+	got := outlinePixels(pixels, w, h, outlineColor, 1)
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			p := got[4*(y*w+x) : 4*(y*w+x)+4]
+			switch {
+			case x == 2 && y == 2:
+				if p[0] != 255 || p[2] != 0 || p[3] != 255 {
+					t.Errorf("sprite pixel changed to %v", p)
+				}
+			case x >= 1 && x <= 3 && y >= 1 && y <= 3:
+				if p[2] != 255 || p[3] != 255 {
+					t.Errorf("pixel (%d, %d) = %v, want outline", x, y, p)
+				}
+			default:
+				if p[3] != 0 {
+					t.Errorf("pixel (%d, %d) = %v, want transparent", x, y, p)
+				}
+			}
+		}
+	}
+
+	// The input buffer is not modified
+	if pixels[4*(1*w+1)+3] != 0 {
+		t.Error("outlinePixels modified its input")
+	}
+}
+
+// TestOutlinePixels_ThicknessAndClipping tests thicker outlines at sprite borders.
+func TestOutlinePixels_ThicknessAndClipping(t *testing.T) {
+	// 4x1 sprite with an opaque pixel at the left border
+	const w, h = 4, 1
+	pixels := make([]byte, 4*w*h)
+	copy(pixels, []byte{10, 10, 10, 255})
+
+	got := outlinePixels(pixels, w, h, color.RGBA{A: 255}, 2)
+
+	wantAlpha := []byte{255, 255, 255, 0}
+	for x, want := range wantAlpha {
+		if got[4*x+3] != want {
+			t.Errorf("pixel %d alpha = %d, want %d", x, got[4*x+3], want)
+		}
+	}
+}
+
+// TestOutlinePixels_Deterministic tests that the same input gives the same output.
+func TestOutlinePixels_Deterministic(t *testing.T) {
+	const w, h = 8, 8
+	pixels := make([]byte, 4*w*h)
+	for i := 0; i < len(pixels); i += 4 {
+		if (i/4)%3 == 0 {
+			copy(pixels[i:], []byte{100, 50, 25, 255})
+		}
+	}
+
+	a := outlinePixels(pixels, w, h, color.Black, 1)
+	b := outlinePixels(pixels, w, h, color.Black, 1)
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("byte %d differs: %d != %d", i, a[i], b[i])
+		}
+	}
+}
+
+// TestAddOutline_NoOp tests that nil sprites and non-positive thickness pass through.
+func TestAddOutline_NoOp(t *testing.T) {
+	if AddOutline(nil, color.Black, 1) != nil {
+		t.Error("AddOutline(nil) should return nil")
+	}
+	if got := AddOutlineToSet(map[int]*ebiten.Image{0: nil}, color.Black, 1); len(got) != 1 || got[0] != nil {
+		t.Errorf("AddOutlineToSet with a nil frame = %v", got)
+	}
+}
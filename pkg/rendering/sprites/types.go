@@ -66,6 +66,10 @@ type Config struct {
 	// Variation index for creating different sprites from same config
 	Variation int
 
+	// Directions is the number of facings GenerateDirectionalSprites
+	// produces: 4 (cardinal) or 8 (with diagonals). Zero means 4.
+	Directions int
+
 	// Custom parameters for specific sprite types
 	Custom map[string]interface{}
 }
@@ -80,6 +84,7 @@ func DefaultConfig() Config {
 		GenreID:    "fantasy",
 		Complexity: 0.5,
 		Variation:  0,
+		Directions: 4,
 		Custom:     make(map[string]interface{}),
 	}
 }
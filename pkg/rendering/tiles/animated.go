@@ -0,0 +1,155 @@
+// Package tiles provides animated tile generation.
+// This file generates looping frame sequences for flowing tiles (water and
+// lava) by scrolling periodic noise, so every frame tiles seamlessly with its
+// neighbours and the last frame flows back into the first.
+package tiles
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+// DefaultAnimationFrames is a frame count that loops smoothly at typical
+// animation speeds (about 8 frames per second).
+const DefaultAnimationFrames = 16
+
+// GenerateAnimated creates a looping animation of frameCount frames for an
+// animated tile type (TileWater or TileLava). Each frame tiles seamlessly
+// and the sequence loops cleanly; the result is deterministic per seed.
+func (g *Generator) GenerateAnimated(config Config, frameCount int) ([]*image.RGBA, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if frameCount <= 0 {
+		return nil, fmt.Errorf("frame count must be positive, got %d", frameCount)
+	}
+	if !IsAnimated(config.Type) {
+		return nil, fmt.Errorf("tile type %s is not animated", config.Type)
+	}
+
+	rng := rand.New(rand.NewSource(config.Seed))
+	// Both layers are drawn for every type so the RNG sequence is the same
+	coarse := newPeriodicNoise(4, rng)
+	fine := newPeriodicNoise(8, rng)
+
+	frames := make([]*image.RGBA, frameCount)
+	for f := range frames {
+		phase := float64(f) / float64(frameCount)
+		img := image.NewRGBA(image.Rect(0, 0, config.Width, config.Height))
+
+		for y := 0; y < config.Height; y++ {
+			v := float64(y) / float64(config.Height)
+			for x := 0; x < config.Width; x++ {
+				u := float64(x) / float64(config.Width)
+
+				// Each layer scrolls exactly one tile per loop, so the
+				// sequence wraps back to the first frame
+				var c color.RGBA
+				switch config.Type {
+				case TileWater:
+					n := 0.6*coarse.at(u+phase, v) + 0.4*fine.at(u, v+phase)
+					c = waterColor(n, config.Variant)
+				case TileLava:
+					n := 0.7*coarse.at(u+phase, v+phase) + 0.3*fine.at(u-phase, v)
+					glow := 0.9 + 0.1*math.Cos(2*math.Pi*phase)
+					c = lavaColor(n, glow, config.Variant)
+				}
+				img.SetRGBA(x, y, c)
+			}
+		}
+		frames[f] = img
+	}
+
+	if g.logger != nil {
+		g.logger.WithField("type", config.Type).WithField("frames", frameCount).Debug("animated tile generated")
+	}
+
+	return frames, nil
+}
+
+// IsAnimated reports whether GenerateAnimated supports a tile type.
+func IsAnimated(tileType TileType) bool {
+	return tileType == TileWater || tileType == TileLava
+}
+
+// waterColor shades water by noise value n (0-1), with light crests on the
+// highest ripples. variant strengthens the ripples.
+func waterColor(n, variant float64) color.RGBA {
+	shade := 0.8 + (n-0.5)*(0.3+0.4*variant)
+	c := color.RGBA{
+		R: clampByte(30 * shade),
+		G: clampByte(100 * shade),
+		B: clampByte(180 * shade),
+		A: 255,
+	}
+	if n > 0.75 {
+		crest := (n - 0.75) * 2
+		c.R = clampByte(float64(c.R) + (200-float64(c.R))*crest)
+		c.G = clampByte(float64(c.G) + (230-float64(c.G))*crest)
+		c.B = clampByte(float64(c.B) + (255-float64(c.B))*crest)
+	}
+	return c
+}
+
+// lavaColor blends lava from dark crust to molten yellow by noise value n
+// (0-1), scaled by glow. variant sharpens the contrast.
+func lavaColor(n, glow, variant float64) color.RGBA {
+	heat := math.Max(0, math.Min(1, 0.5+(n-0.5)*(1.5+variant)))
+	return color.RGBA{
+		R: clampByte((90 + 165*heat) * glow),
+		G: clampByte((20 + 160*heat*heat) * glow),
+		B: clampByte(40 * heat * heat * heat * glow),
+		A: 255,
+	}
+}
+
+// clampByte converts v to a byte, clamping to [0, 255].
+func clampByte(v float64) uint8 {
+	return uint8(math.Max(0, math.Min(255, v)))
+}
+
+// periodicNoise is smooth value noise on a square lattice that wraps around,
+// so it repeats with period 1 in both coordinates.
+type periodicNoise struct {
+	cells  int
+	values []float64
+}
+
+// newPeriodicNoise creates noise with cells lattice cells per period.
+func newPeriodicNoise(cells int, rng *rand.Rand) *periodicNoise {
+	values := make([]float64, cells*cells)
+	for i := range values {
+		values[i] = rng.Float64()
+	}
+	return &periodicNoise{cells: cells, values: values}
+}
+
+// at returns the noise value (0-1) at (u, v), wrapped to the unit square.
+func (p *periodicNoise) at(u, v float64) float64 {
+	x := (u - math.Floor(u)) * float64(p.cells)
+	y := (v - math.Floor(v)) * float64(p.cells)
+	x0, y0 := int(x), int(y)
+	tx, ty := smoothstep(x-float64(x0)), smoothstep(y-float64(y0))
+
+	top := lerp(p.value(x0, y0), p.value(x0+1, y0), tx)
+	bottom := lerp(p.value(x0, y0+1), p.value(x0+1, y0+1), tx)
+	return lerp(top, bottom, ty)
+}
+
+// value returns the lattice value at (x, y), wrapping around.
+func (p *periodicNoise) value(x, y int) float64 {
+	return p.values[(y%p.cells)*p.cells+x%p.cells]
+}
+
+// smoothstep eases t (0-1) so noise has no visible lattice creases.
+func smoothstep(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// lerp linearly interpolates from a to b by t.
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
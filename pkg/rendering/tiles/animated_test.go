@@ -0,0 +1,147 @@
+package tiles
+
+import (
+	"image"
+	"testing"
+)
+
+func animatedConfig(tileType TileType) Config {
+	config := DefaultConfig()
+	config.Type = tileType
+	config.Seed = 12345
+	return config
+}
+
+// pixelDiff returns the summed absolute RGB difference of two pixels.
+func pixelDiff(a, b *image.RGBA, ax, ay, bx, by int) int {
+	pa, pb := a.RGBAAt(ax, ay), b.RGBAAt(bx, by)
+	return absDiff(pa.R, pb.R) + absDiff(pa.G, pb.G) + absDiff(pa.B, pb.B)
+}
+
+func absDiff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
+
+// frameDiff returns the largest pixel difference between two frames.
+func frameDiff(a, b *image.RGBA) int {
+	largest := 0
+	bounds := a.Bounds()
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			largest = max(largest, pixelDiff(a, b, x, y, x, y))
+		}
+	}
+	return largest
+}
+
+func TestGenerateAnimated(t *testing.T) {
+	gen := NewGenerator()
+
+	for _, tileType := range []TileType{TileWater, TileLava} {
+		t.Run(tileType.String(), func(t *testing.T) {
+			config := animatedConfig(tileType)
+			frames, err := gen.GenerateAnimated(config, DefaultAnimationFrames)
+			if err != nil {
+				t.Fatalf("GenerateAnimated() error = %v", err)
+			}
+			if len(frames) != DefaultAnimationFrames {
+				t.Fatalf("len(frames) = %d, want %d", len(frames), DefaultAnimationFrames)
+			}
+			for i, frame := range frames {
+				if frame.Bounds().Dx() != config.Width || frame.Bounds().Dy() != config.Height {
+					t.Errorf("frame %d size = %v, want %dx%d", i, frame.Bounds(), config.Width, config.Height)
+				}
+			}
+			if frameDiff(frames[0], frames[DefaultAnimationFrames/2]) == 0 {
+				t.Error("frames should change over the animation")
+			}
+		})
+	}
+}
+
+func TestGenerateAnimated_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	config := animatedConfig(TileWater)
+
+	a, err := gen.GenerateAnimated(config, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := gen.GenerateAnimated(config, 4)
+	for i := range a {
+		if frameDiff(a[i], b[i]) != 0 {
+			t.Errorf("frame %d differs between runs with the same seed", i)
+		}
+	}
+
+	config.Seed++
+	c, _ := gen.GenerateAnimated(config, 4)
+	if frameDiff(a[0], c[0]) == 0 {
+		t.Error("different seeds produced identical frames")
+	}
+}
+
+// TestGenerateAnimated_Seamless verifies that opposite edges of each frame
+// meet as smoothly as neighbouring pixels inside it, and that the last
+// frame flows into the first as smoothly as any other pair.
+func TestGenerateAnimated_Seamless(t *testing.T) {
+	gen := NewGenerator()
+
+	for _, tileType := range []TileType{TileWater, TileLava} {
+		t.Run(tileType.String(), func(t *testing.T) {
+			config := animatedConfig(tileType)
+			frames, err := gen.GenerateAnimated(config, DefaultAnimationFrames)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w, h := config.Width, config.Height
+			for i, frame := range frames {
+				interior, seam := 0, 0
+				for y := 0; y < h; y++ {
+					for x := 0; x < w-1; x++ {
+						interior = max(interior, pixelDiff(frame, frame, x, y, x+1, y))
+					}
+					seam = max(seam, pixelDiff(frame, frame, w-1, y, 0, y))
+				}
+				for x := 0; x < w; x++ {
+					for y := 0; y < h-1; y++ {
+						interior = max(interior, pixelDiff(frame, frame, x, y, x, y+1))
+					}
+					seam = max(seam, pixelDiff(frame, frame, x, h-1, x, 0))
+				}
+				if seam > interior {
+					t.Errorf("frame %d: seam difference %d exceeds interior %d", i, seam, interior)
+				}
+			}
+
+			step := 0
+			for i := 0; i < len(frames)-1; i++ {
+				step = max(step, frameDiff(frames[i], frames[i+1]))
+			}
+			if wrap := frameDiff(frames[len(frames)-1], frames[0]); wrap > step {
+				t.Errorf("loop wrap difference %d exceeds frame step %d", wrap, step)
+			}
+		})
+	}
+}
+
+func TestGenerateAnimated_Errors(t *testing.T) {
+	gen := NewGenerator()
+
+	if _, err := gen.GenerateAnimated(animatedConfig(TileWall), 4); err == nil {
+		t.Error("expected error for a non-animated tile type")
+	}
+	if _, err := gen.GenerateAnimated(animatedConfig(TileWater), 0); err == nil {
+		t.Error("expected error for zero frames")
+	}
+
+	config := animatedConfig(TileWater)
+	config.Width = 0
+	if _, err := gen.GenerateAnimated(config, 4); err == nil {
+		t.Error("expected error for invalid config")
+	}
+}
@@ -0,0 +1,140 @@
+// Package tiles provides bitmask autotiling.
+// This file generates the 16 edge variants of a tile, one for each
+// combination of cardinal neighbours that share its type, so walls and floors
+// blend into matching neighbours and get shaded edges against everything else.
+package tiles
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+)
+
+// NeighborMask records which neighbours of a tile have the same type.
+type NeighborMask uint8
+
+// Neighbour bits. Only the cardinal bits select an autotile variant; the
+// diagonal bits are accepted so 8-neighbour masks can be passed as is.
+const (
+	NeighborNorth NeighborMask = 1 << iota
+	NeighborEast
+	NeighborSouth
+	NeighborWest
+	NeighborNorthEast
+	NeighborSouthEast
+	NeighborSouthWest
+	NeighborNorthWest
+)
+
+// cardinalNeighbors masks the bits that select an autotile variant.
+const cardinalNeighbors = NeighborNorth | NeighborEast | NeighborSouth | NeighborWest
+
+// AutotileVariants is the number of variants in an AutotileSet.
+const AutotileVariants = 16
+
+// edgeShade is how much an exposed edge is darkened at its outermost pixel.
+const edgeShade = 0.45
+
+// AutotileSet holds every edge variant of one tile, indexed by the cardinal
+// bits of a NeighborMask.
+type AutotileSet struct {
+	Type     TileType
+	Variants [AutotileVariants]*image.RGBA
+}
+
+// GenerateAutotileSet creates the 16 edge variants of a tile. Every variant
+// starts from the same genre-styled tile; sides without a matching
+// neighbour get a shaded edge, and corners where two such sides meet are
+// rounded. The result is deterministic for a config.
+func (g *Generator) GenerateAutotileSet(config Config) (*AutotileSet, error) {
+	base, err := g.Generate(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate base tile: %w", err)
+	}
+
+	set := &AutotileSet{Type: config.Type}
+	for mask := range set.Variants {
+		set.Variants[mask] = shadeEdges(base, NeighborMask(mask))
+	}
+	return set, nil
+}
+
+// SelectTile returns the variant for a tile whose same-type neighbours are
+// given by neighbors. Diagonal bits are ignored.
+func (s *AutotileSet) SelectTile(neighbors NeighborMask) *image.RGBA {
+	return s.Variants[neighbors&cardinalNeighbors]
+}
+
+// shadeEdges returns a copy of base with the sides missing from neighbors
+// darkened toward the tile border.
+func shadeEdges(base *image.RGBA, neighbors NeighborMask) *image.RGBA {
+	bounds := base.Bounds()
+	img := image.NewRGBA(bounds)
+	copy(img.Pix, base.Pix)
+
+	w, h := bounds.Dx(), bounds.Dy()
+	band := math.Max(2, float64(min(w, h))/8)
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			d := edgeDistance(float64(x)+0.5, float64(y)+0.5, float64(w), float64(h), band, neighbors)
+			if d >= band {
+				continue
+			}
+			shade := 1 - edgeShade*(1-d/band)
+			c := img.RGBAAt(bounds.Min.X+x, bounds.Min.Y+y)
+			img.SetRGBA(bounds.Min.X+x, bounds.Min.Y+y, color.RGBA{
+				R: uint8(float64(c.R) * shade),
+				G: uint8(float64(c.G) * shade),
+				B: uint8(float64(c.B) * shade),
+				A: c.A,
+			})
+		}
+	}
+	return img
+}
+
+// edgeDistance returns the distance from pixel centre (x, y) to the nearest
+// exposed side of a w by h tile. Where two exposed sides meet, the distance
+// is measured from a rounded corner of radius band.
+func edgeDistance(x, y, w, h, band float64, neighbors NeighborMask) float64 {
+	d := math.Inf(1)
+	north := neighbors&NeighborNorth == 0
+	east := neighbors&NeighborEast == 0
+	south := neighbors&NeighborSouth == 0
+	west := neighbors&NeighborWest == 0
+
+	if north {
+		d = math.Min(d, y)
+	}
+	if south {
+		d = math.Min(d, h-y)
+	}
+	if west {
+		d = math.Min(d, x)
+	}
+	if east {
+		d = math.Min(d, w-x)
+	}
+
+	// Round outer corners: inside the corner square, measure from the arc
+	corner := func(cx, cy float64) {
+		if math.Abs(x-cx) < band && math.Abs(y-cy) < band {
+			d = math.Max(0, band-math.Hypot(band-math.Abs(x-cx), band-math.Abs(y-cy)))
+		}
+	}
+	if north && west {
+		corner(0, 0)
+	}
+	if north && east {
+		corner(w, 0)
+	}
+	if south && west {
+		corner(0, h)
+	}
+	if south && east {
+		corner(w, h)
+	}
+	return d
+}
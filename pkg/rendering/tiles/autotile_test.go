@@ -0,0 +1,107 @@
+package tiles
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGenerateAutotileSet(t *testing.T) {
+	gen := NewGenerator()
+	config := DefaultConfig()
+	config.Type = TileWall
+	config.Seed = 42
+
+	set, err := gen.GenerateAutotileSet(config)
+	if err != nil {
+		t.Fatalf("GenerateAutotileSet() error = %v", err)
+	}
+	if set.Type != TileWall {
+		t.Errorf("Type = %v, want %v", set.Type, TileWall)
+	}
+
+	base, _ := gen.Generate(config)
+	for mask, variant := range set.Variants {
+		if variant == nil {
+			t.Fatalf("variant %d is nil", mask)
+		}
+		if variant.Bounds() != base.Bounds() {
+			t.Errorf("variant %d bounds = %v, want %v", mask, variant.Bounds(), base.Bounds())
+		}
+	}
+
+	// Surrounded on all sides: no edges to shade
+	if !bytes.Equal(set.Variants[cardinalNeighbors].Pix, base.Pix) {
+		t.Error("fully surrounded variant should match the base tile")
+	}
+
+	// Isolated: borders darkened, centre untouched
+	isolated := set.Variants[0]
+	w, h := config.Width, config.Height
+	if isolated.RGBAAt(w/2, 0).R >= base.RGBAAt(w/2, 0).R && base.RGBAAt(w/2, 0).R > 0 {
+		t.Error("exposed north edge should be darkened")
+	}
+	if isolated.RGBAAt(w/2, h/2) != base.RGBAAt(w/2, h/2) {
+		t.Error("tile centre should not be shaded")
+	}
+
+	// Only the exposed side is shaded
+	northOpen := set.Variants[NeighborEast|NeighborSouth|NeighborWest]
+	if northOpen.RGBAAt(w/2, h-1) != base.RGBAAt(w/2, h-1) {
+		t.Error("south edge with a matching neighbour should not be shaded")
+	}
+	if northOpen.RGBAAt(w/2, 0) == base.RGBAAt(w/2, 0) && base.RGBAAt(w/2, 0).R > 0 {
+		t.Error("north edge without a matching neighbour should be shaded")
+	}
+}
+
+func TestAutotileSet_SelectTile(t *testing.T) {
+	gen := NewGenerator()
+	set, err := gen.GenerateAutotileSet(DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		neighbors NeighborMask
+		want      NeighborMask
+	}{
+		{"isolated", 0, 0},
+		{"north and south", NeighborNorth | NeighborSouth, NeighborNorth | NeighborSouth},
+		{"diagonals ignored", NeighborEast | NeighborNorthEast | NeighborSouthWest, NeighborEast},
+		{"all eight", 0xFF, cardinalNeighbors},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := set.SelectTile(tt.neighbors); got != set.Variants[tt.want] {
+				t.Errorf("SelectTile(%08b) did not return variant %04b", tt.neighbors, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateAutotileSet_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	config := DefaultConfig()
+	config.Seed = 7
+
+	a, err := gen.GenerateAutotileSet(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := gen.GenerateAutotileSet(config)
+	for mask := range a.Variants {
+		if !bytes.Equal(a.Variants[mask].Pix, b.Variants[mask].Pix) {
+			t.Errorf("variant %d differs between runs", mask)
+		}
+	}
+}
+
+func TestGenerateAutotileSet_InvalidConfig(t *testing.T) {
+	config := DefaultConfig()
+	config.Width = 0
+	if _, err := NewGenerator().GenerateAutotileSet(config); err == nil {
+		t.Error("expected error for invalid config")
+	}
+}
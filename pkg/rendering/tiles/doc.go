@@ -10,6 +10,9 @@
 //   - Pattern variations for visual diversity
 //   - Integration with terrain generation
 //   - Configurable tile sizes
+//   - Seamlessly looping animated water and lava tiles (GenerateAnimated)
+//   - Bitmask autotiling with shaded, rounded edges (GenerateAutotileSet)
+//   - Transition tiles blending two tile types and genres (GenerateTransition)
 //
 // Example Usage:
 //
@@ -0,0 +1,74 @@
+// Package tiles provides transition tiles between tile types.
+// This file blends two tiles, each styled by its own genre palette, along a
+// noisy seam so biome boundaries (grass meeting stone, say) fade into each
+// other instead of ending abruptly.
+package tiles
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+// transitionBand is the fraction of the tile size over which the blend
+// fades out from a bordering side.
+const transitionBand = 0.5
+
+// transitionJitter is how far, as a fraction of the band, the noisy seam
+// wanders from a straight line.
+const transitionJitter = 0.35
+
+// GenerateTransition creates a border tile of type from that blends into
+// type to along the sides in toward (the sides where the to tile lies; only
+// cardinal bits count). Each config is rendered with its own genre palette,
+// and both must have the same size. The result is deterministic for the
+// two configs.
+func (g *Generator) GenerateTransition(from, to Config, toward NeighborMask) (*image.RGBA, error) {
+	if from.Width != to.Width || from.Height != to.Height {
+		return nil, fmt.Errorf("tile sizes differ: %dx%d and %dx%d", from.Width, from.Height, to.Width, to.Height)
+	}
+
+	fromImg, err := g.Generate(from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s tile: %w", from.Type, err)
+	}
+	toImg, err := g.Generate(to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s tile: %w", to.Type, err)
+	}
+
+	rng := rand.New(rand.NewSource(from.Seed*31 + to.Seed))
+	seam := newPeriodicNoise(4, rng)
+
+	w, h := from.Width, from.Height
+	band := transitionBand * float64(min(w, h))
+	// edgeDistance measures from the sides whose bit is clear
+	neighbors := cardinalNeighbors &^ toward
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			fx, fy := float64(x)+0.5, float64(y)+0.5
+			d := edgeDistance(fx, fy, float64(w), float64(h), band, neighbors)
+			// Periodic noise keeps the seam continuous across neighbouring
+			// transition tiles
+			d += (seam.at(fx/float64(w), fy/float64(h)) - 0.5) * 2 * transitionJitter * band
+
+			weight := 1 - smoothstep(math.Max(0, math.Min(1, d/band)))
+			img.SetRGBA(x, y, blendRGBA(fromImg.RGBAAt(x, y), toImg.RGBAAt(x, y), weight))
+		}
+	}
+	return img, nil
+}
+
+// blendRGBA mixes a toward b by t (0-1).
+func blendRGBA(a, b color.RGBA, t float64) color.RGBA {
+	return color.RGBA{
+		R: uint8(lerp(float64(a.R), float64(b.R), t) + 0.5),
+		G: uint8(lerp(float64(a.G), float64(b.G), t) + 0.5),
+		B: uint8(lerp(float64(a.B), float64(b.B), t) + 0.5),
+		A: uint8(lerp(float64(a.A), float64(b.A), t) + 0.5),
+	}
+}
@@ -0,0 +1,97 @@
+package tiles
+
+import (
+	"bytes"
+	"testing"
+)
+
+func transitionConfigs() (Config, Config) {
+	from := DefaultConfig()
+	from.Type = TileFloor
+	from.GenreID = "fantasy"
+	from.Seed = 11
+
+	to := DefaultConfig()
+	to.Type = TileWater
+	to.GenreID = "scifi"
+	to.Seed = 22
+	return from, to
+}
+
+func TestGenerateTransition(t *testing.T) {
+	gen := NewGenerator()
+	from, to := transitionConfigs()
+	fromImg, _ := gen.Generate(from)
+	toImg, _ := gen.Generate(to)
+
+	img, err := gen.GenerateTransition(from, to, NeighborNorth)
+	if err != nil {
+		t.Fatalf("GenerateTransition() error = %v", err)
+	}
+	if img.Bounds() != fromImg.Bounds() {
+		t.Fatalf("bounds = %v, want %v", img.Bounds(), fromImg.Bounds())
+	}
+
+	// The north edge blends to the to tile and the south edge is the from tile
+	w, h := from.Width, from.Height
+	toDiff, fromDiff := 0, 0
+	for x := 0; x < w; x++ {
+		toDiff += pixelDiff(img, toImg, x, 0, x, 0)
+		fromDiff += pixelDiff(img, fromImg, x, 0, x, 0)
+	}
+	if toDiff >= fromDiff {
+		t.Errorf("north edge differs by %d from the %s tile and %d from the %s tile, want closer to %s",
+			toDiff, to.Type, fromDiff, from.Type, to.Type)
+	}
+	for x := 0; x < w; x++ {
+		if img.RGBAAt(x, h-1) != fromImg.RGBAAt(x, h-1) {
+			t.Errorf("south edge pixel %d = %v, want the %s tile's %v", x, img.RGBAAt(x, h-1), from.Type, fromImg.RGBAAt(x, h-1))
+			break
+		}
+	}
+}
+
+func TestGenerateTransition_NoBorder(t *testing.T) {
+	gen := NewGenerator()
+	from, to := transitionConfigs()
+	fromImg, _ := gen.Generate(from)
+
+	// Diagonal-only masks select no sides, leaving the from tile unchanged
+	img, err := gen.GenerateTransition(from, to, NeighborNorthEast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(img.Pix, fromImg.Pix) {
+		t.Error("transition with no bordering sides should match the from tile")
+	}
+}
+
+func TestGenerateTransition_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	from, to := transitionConfigs()
+
+	a, err := gen.GenerateTransition(from, to, NeighborEast|NeighborSouth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := gen.GenerateTransition(from, to, NeighborEast|NeighborSouth)
+	if !bytes.Equal(a.Pix, b.Pix) {
+		t.Error("same configs produced different transitions")
+	}
+}
+
+func TestGenerateTransition_Errors(t *testing.T) {
+	gen := NewGenerator()
+	from, to := transitionConfigs()
+
+	to.Width = 16
+	if _, err := gen.GenerateTransition(from, to, NeighborNorth); err == nil {
+		t.Error("expected error for mismatched sizes")
+	}
+
+	from, to = transitionConfigs()
+	to.GenreID = ""
+	if _, err := gen.GenerateTransition(from, to, NeighborNorth); err == nil {
+		t.Error("expected error for invalid to config")
+	}
+}
@@ -13,6 +13,7 @@
 //   - Label: Text labels with backgrounds
 //   - Icon: Small iconic UI elements
 //   - Frame: Decorative frames and borders
+//   - Tooltip: Rounded panels with a pointer arrow (see Config.ArrowSide and ArrowSize)
 //
 // # Basic Usage
 //
@@ -30,6 +31,55 @@
 //	    log.Fatal(err)
 //	}
 //
+// # Resizable Frames (9-Slice)
+//
+// Generate9Slice decomposes an element into four corners, four edges, and a
+// center. Render the result at any size without regenerating; corners stay
+// crisp while edges and center stretch:
+//
+//	ns, err := gen.Generate9Slice(config)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	panel, err := ns.Render(320, 180)
+//
+// # Icon Sheets
+//
+// GenerateIconSheet packs many icons into one atlas image so a renderer can
+// cache and draw them from a single texture. Rects maps each config index
+// to its region, and SubImage returns a view of one icon:
+//
+//	sheet, err := gen.GenerateIconSheet(inventoryIconConfigs)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	sword := sheet.SubImage(0)
+//
+// # Text Labels
+//
+// MeasureText reports the size of text in the UI font. Set AutoFit on a
+// label to size its background to Text plus Padding; MaxWidth wraps long
+// text onto multiple lines. LayoutLabel returns the wrapped lines and text
+// origin so renderers can draw the text over the generated background:
+//
+//	config.Type = ui.ElementLabel
+//	config.AutoFit = true
+//	config.MaxWidth = 240
+//	config.Text = questDescription
+//	layout := ui.LayoutLabel(config)
+//	background, err := gen.Generate(config)
+//
+// # Animated Bars
+//
+// NewHealthBar returns a bar whose fill slides toward new values instead of
+// snapping. Damage leaves a pale "ghost" segment that holds briefly and then
+// drains, showing how much was just lost:
+//
+//	bar, err := gen.NewHealthBar(config)
+//	bar.SetValue(current, max, 1.5) // 1.5 bar-widths per second
+//	bar.Update(dt)                  // once per frame
+//	img, err := bar.Render()
+//
 // # Genre-Aware Styling
 //
 // UI elements automatically adapt to different game genres:
@@ -58,6 +58,12 @@ func (g *Generator) Generate(config Config) (*image.RGBA, error) {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
+	// Size auto-fit labels to their text
+	if config.isAutoFitLabel() {
+		layout := LayoutLabel(config)
+		config.Width, config.Height = layout.Width, layout.Height
+	}
+
 	// Create RNG from seed
 	rng := rand.New(rand.NewSource(config.Seed))
 
@@ -87,6 +93,8 @@ func (g *Generator) Generate(config Config) (*image.RGBA, error) {
 		g.generateIcon(img, pal, rng, config)
 	case ElementFrame:
 		g.generateFrame(img, pal, rng, config)
+	case ElementTooltip:
+		g.generateTooltip(img, pal, config)
 	default:
 		err := fmt.Errorf("unknown element type: %d", config.Type)
 		if g.logger != nil {
@@ -146,7 +154,20 @@ func (g *Generator) generateButton(img *image.RGBA, pal *palette.Palette, rng *r
 
 // generatePanel creates a panel UI element.
 func (g *Generator) generatePanel(img *image.RGBA, pal *palette.Palette, rng *rand.Rand, config Config) {
-	// Get alpha from custom config, default to 200
+	// Semi-transparent background
+	semiTransparent := g.panelBackground(pal, config)
+
+	// Fill background
+	g.fillRect(img, 0, 0, config.Width, config.Height, semiTransparent)
+
+	// Draw border
+	borderColor := g.lightenColor(pal.Background, 0.3)
+	g.drawBorder(img, borderColor, BorderSolid, 1)
+}
+
+// panelBackground returns the semi-transparent panel fill color.
+// The alpha comes from Custom["alpha"] (int, clamped to 0-255), default 200.
+func (g *Generator) panelBackground(pal *palette.Palette, config Config) color.RGBA {
 	alpha := 200
 	if customAlpha, ok := config.Custom["alpha"].(int); ok {
 		// Clamp to valid range [0, 255]
@@ -159,22 +180,13 @@ func (g *Generator) generatePanel(img *image.RGBA, pal *palette.Palette, rng *ra
 		}
 	}
 
-	// Semi-transparent background
-	bgColor := pal.Background
-	r, gr, b, _ := bgColor.RGBA()
-	semiTransparent := color.RGBA{
+	r, gr, b, _ := pal.Background.RGBA()
+	return color.RGBA{
 		R: uint8(r >> 8),
 		G: uint8(gr >> 8),
 		B: uint8(b >> 8),
 		A: uint8(alpha),
 	}
-
-	// Fill background
-	g.fillRect(img, 0, 0, config.Width, config.Height, semiTransparent)
-
-	// Draw border
-	borderColor := g.lightenColor(pal.Background, 0.3)
-	g.drawBorder(img, borderColor, BorderSolid, 1)
 }
 
 // generateHealthBar creates a health/progress bar.
@@ -17,6 +17,7 @@ func TestElementType_String(t *testing.T) {
 		{"Label", ElementLabel, "label"},
 		{"Icon", ElementIcon, "icon"},
 		{"Frame", ElementFrame, "frame"},
+		{"Tooltip", ElementTooltip, "tooltip"},
 		{"Unknown", ElementType(999), "unknown"},
 	}
 
@@ -551,7 +552,7 @@ func TestGenerator_AllElementTypes(t *testing.T) {
 	gen := NewGenerator()
 	elementTypes := []ElementType{
 		ElementButton, ElementPanel, ElementHealthBar,
-		ElementLabel, ElementIcon, ElementFrame,
+		ElementLabel, ElementIcon, ElementFrame, ElementTooltip,
 	}
 
 	for _, eType := range elementTypes {
@@ -0,0 +1,198 @@
+// Package ui provides animated progress bars.
+// This file implements HealthBar, which slides its fill toward a target
+// value over time and optionally shows a trailing "ghost" bar for recent
+// damage, rendered with the same genre-themed style as ElementHealthBar.
+package ui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	"github.com/opd-ai/venture/pkg/rendering/palette"
+)
+
+const (
+	// DefaultGhostDelay is how long the ghost bar holds before draining (seconds)
+	DefaultGhostDelay = 0.5
+	// DefaultGhostSpeed is how fast the ghost bar drains (fill fraction per second)
+	DefaultGhostSpeed = 0.6
+)
+
+// HealthBar is a health/mana bar with animated fill transitions.
+// Call SetValue when the underlying stat changes, Update once per frame,
+// and Render to draw the current state. All fill values are fractions
+// of the full bar (0.0-1.0).
+type HealthBar struct {
+	// Ghost enables the trailing bar that shows recent damage
+	Ghost bool
+
+	// GhostDelay is how long the ghost holds after damage (seconds)
+	GhostDelay float64
+
+	// GhostSpeed is how fast the ghost drains once the delay ends
+	GhostSpeed float64
+
+	gen        *Generator
+	config     Config
+	ghostColor color.Color
+
+	target     float64
+	displayed  float64
+	ghost      float64
+	animSpeed  float64
+	ghostTimer float64
+}
+
+// NewHealthBar creates an animated bar styled by the given configuration.
+// config.Value sets the initial fill; config.Type is forced to ElementHealthBar.
+func (g *Generator) NewHealthBar(config Config) (*HealthBar, error) {
+	config.Type = ElementHealthBar
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	pal, err := g.paletteGen.Generate(config.GenreID, config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate palette: %w", err)
+	}
+
+	return &HealthBar{
+		Ghost:      true,
+		GhostDelay: DefaultGhostDelay,
+		GhostSpeed: DefaultGhostSpeed,
+		gen:        g,
+		config:     config,
+		ghostColor: g.ghostBarColor(pal),
+		target:     config.Value,
+		displayed:  config.Value,
+		ghost:      config.Value,
+	}, nil
+}
+
+// SetValue sets the bar's target to current/max. The displayed fill moves
+// toward the target at animSpeed (fill fraction per second); an animSpeed
+// of zero or less snaps immediately. Damage leaves a ghost at the previous
+// fill that drains after GhostDelay.
+func (h *HealthBar) SetValue(current, max, animSpeed float64) {
+	target := 0.0
+	if max > 0 {
+		target = clampUnit(current / max)
+	}
+
+	if target < h.displayed {
+		// Damage: hold the ghost at the highest recent fill
+		if h.displayed > h.ghost {
+			h.ghost = h.displayed
+		}
+		h.ghostTimer = h.GhostDelay
+	}
+
+	h.target = target
+	h.animSpeed = animSpeed
+	if animSpeed <= 0 {
+		h.displayed = target
+	}
+	if h.ghost < h.displayed {
+		h.ghost = h.displayed
+	}
+}
+
+// Update advances the fill and ghost animations by dt seconds.
+func (h *HealthBar) Update(dt float64) {
+	if dt <= 0 {
+		return
+	}
+
+	if h.displayed != h.target {
+		h.displayed = approach(h.displayed, h.target, h.animSpeed*dt)
+	}
+
+	if h.ghostTimer > 0 {
+		h.ghostTimer -= dt
+		if h.ghostTimer > 0 {
+			return
+		}
+		// Spend the remainder of the frame draining
+		dt = -h.ghostTimer
+		h.ghostTimer = 0
+	}
+	h.ghost = approach(h.ghost, h.displayed, h.GhostSpeed*dt)
+	if h.ghost < h.displayed {
+		h.ghost = h.displayed
+	}
+}
+
+// Target returns the fill the bar is animating toward.
+func (h *HealthBar) Target() float64 {
+	return h.target
+}
+
+// Displayed returns the fill currently drawn.
+func (h *HealthBar) Displayed() float64 {
+	return h.displayed
+}
+
+// GhostValue returns the end of the trailing damage bar.
+func (h *HealthBar) GhostValue() float64 {
+	return h.ghost
+}
+
+// IsAnimating reports whether the fill or ghost is still moving.
+func (h *HealthBar) IsAnimating() bool {
+	return h.displayed != h.target || (h.Ghost && h.ghost > h.displayed)
+}
+
+// Render draws the bar at its current animation state.
+func (h *HealthBar) Render() (*image.RGBA, error) {
+	config := h.config
+	config.Value = h.displayed
+
+	img, err := h.gen.Generate(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.Ghost && h.ghost > h.displayed {
+		// Same inner geometry as generateHealthBar
+		inner := config.Width - 4
+		fillEnd := int(float64(inner) * h.displayed)
+		ghostEnd := int(float64(inner) * h.ghost)
+		h.gen.fillRect(img, 2+fillEnd, 2, ghostEnd-fillEnd, config.Height-4, h.ghostColor)
+	}
+
+	return img, nil
+}
+
+// ghostBarColor returns a pale tint of the genre's danger color.
+func (g *Generator) ghostBarColor(pal *palette.Palette) color.Color {
+	return g.lightenColor(pal.Danger, 0.5)
+}
+
+// approach moves value toward target by at most step.
+func approach(value, target, step float64) float64 {
+	if step <= 0 {
+		return value
+	}
+	if value < target {
+		if value+step > target {
+			return target
+		}
+		return value + step
+	}
+	if value-step < target {
+		return target
+	}
+	return value - step
+}
+
+// clampUnit clamps v to the range [0, 1].
+func clampUnit(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func newTestHealthBar(t *testing.T) *HealthBar {
+	t.Helper()
+	config := DefaultConfig()
+	config.Width = 104
+	config.Height = 12
+	config.Seed = 7
+	bar, err := NewGenerator().NewHealthBar(config)
+	if err != nil {
+		t.Fatalf("NewHealthBar failed: %v", err)
+	}
+	return bar
+}
+
+func nearlyEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestHealthBar_SlidesTowardTarget(t *testing.T) {
+	bar := newTestHealthBar(t)
+
+	bar.SetValue(50, 100, 1.0)
+	if bar.Displayed() != 1.0 {
+		t.Fatalf("fill should not jump on SetValue, got %f", bar.Displayed())
+	}
+
+	bar.Update(0.25)
+	if !nearlyEqual(bar.Displayed(), 0.75) {
+		t.Errorf("after 0.25s fill = %f, want 0.75", bar.Displayed())
+	}
+
+	bar.Update(1.0)
+	if bar.Displayed() != 0.5 {
+		t.Errorf("fill should stop at target, got %f", bar.Displayed())
+	}
+
+	// Healing slides up
+	bar.SetValue(80, 100, 2.0)
+	bar.Update(0.1)
+	if !nearlyEqual(bar.Displayed(), 0.7) {
+		t.Errorf("heal fill = %f, want 0.7", bar.Displayed())
+	}
+}
+
+func TestHealthBar_SnapAndClamp(t *testing.T) {
+	bar := newTestHealthBar(t)
+
+	bar.SetValue(30, 100, 0)
+	if bar.Displayed() != 0.3 {
+		t.Errorf("animSpeed 0 should snap, got %f", bar.Displayed())
+	}
+
+	bar.SetValue(150, 100, 0)
+	if bar.Target() != 1.0 {
+		t.Errorf("overheal target = %f, want 1.0", bar.Target())
+	}
+	bar.SetValue(10, 0, 0)
+	if bar.Target() != 0 {
+		t.Errorf("zero max target = %f, want 0", bar.Target())
+	}
+}
+
+func TestHealthBar_GhostTrailsDamage(t *testing.T) {
+	bar := newTestHealthBar(t)
+
+	bar.SetValue(40, 100, 0)
+	if bar.GhostValue() != 1.0 {
+		t.Fatalf("ghost = %f, want previous fill 1.0", bar.GhostValue())
+	}
+
+	// Ghost holds during the delay
+	bar.Update(bar.GhostDelay / 2)
+	if bar.GhostValue() != 1.0 {
+		t.Errorf("ghost moved during delay: %f", bar.GhostValue())
+	}
+	if !bar.IsAnimating() {
+		t.Error("bar with pending ghost should be animating")
+	}
+
+	// Then drains down to the fill
+	bar.Update(bar.GhostDelay)
+	if bar.GhostValue() >= 1.0 {
+		t.Errorf("ghost should drain after delay, got %f", bar.GhostValue())
+	}
+	for i := 0; i < 100; i++ {
+		bar.Update(0.1)
+	}
+	if bar.GhostValue() != bar.Displayed() || bar.IsAnimating() {
+		t.Errorf("ghost %f should settle at fill %f", bar.GhostValue(), bar.Displayed())
+	}
+
+	// Healing never leaves a ghost
+	bar.SetValue(90, 100, 1.0)
+	bar.Update(0.1)
+	if bar.GhostValue() != bar.Displayed() {
+		t.Errorf("heal ghost = %f, want fill %f", bar.GhostValue(), bar.Displayed())
+	}
+}
+
+func TestHealthBar_Render(t *testing.T) {
+	bar := newTestHealthBar(t)
+	bar.SetValue(25, 100, 0)
+
+	img, err := bar.Render()
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// Pixels between the fill and the ghost end use the ghost color
+	y := bar.config.Height / 2
+	ghostPixel := img.RGBAAt(2+int(float64(bar.config.Width-4)*0.6), y)
+	if want := color.RGBAModel.Convert(bar.ghostColor); ghostPixel != want {
+		t.Errorf("ghost region pixel = %v, want %v", ghostPixel, want)
+	}
+
+	bar.Ghost = false
+	plain, err := bar.Render()
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if plain.RGBAAt(2+int(float64(bar.config.Width-4)*0.6), y) == ghostPixel {
+		t.Error("ghost drawn while disabled")
+	}
+}
+
+func TestGenerator_NewHealthBar_Invalid(t *testing.T) {
+	config := DefaultConfig()
+	config.GenreID = ""
+	if _, err := NewGenerator().NewHealthBar(config); err == nil {
+		t.Error("expected error for invalid config")
+	}
+}
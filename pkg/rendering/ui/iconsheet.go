@@ -0,0 +1,136 @@
+// Package ui provides icon sheet (texture atlas) generation.
+// This file packs many generated icons into a single image so renderers can
+// upload one texture, cache one sprite, and draw every icon from it.
+package ui
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// IconSheetPadding is the transparent gap in pixels kept between packed
+// icons so that filtered sampling never bleeds a neighbor into an icon.
+const IconSheetPadding = 1
+
+// IconSheet is a set of UI elements packed into one atlas image.
+type IconSheet struct {
+	// Image is the packed atlas
+	Image *image.RGBA
+
+	// Rects maps each config index passed to GenerateIconSheet to the
+	// region of Image holding that element
+	Rects []image.Rectangle
+}
+
+// Len returns the number of icons in the sheet.
+func (s *IconSheet) Len() int {
+	return len(s.Rects)
+}
+
+// Rect returns the atlas region of the icon at index.
+func (s *IconSheet) Rect(index int) (image.Rectangle, bool) {
+	if index < 0 || index >= len(s.Rects) {
+		return image.Rectangle{}, false
+	}
+	return s.Rects[index], true
+}
+
+// SubImage returns the icon at index as a view into the atlas; it shares
+// pixels with Image. Returns nil if index is out of range.
+func (s *IconSheet) SubImage(index int) *image.RGBA {
+	rect, ok := s.Rect(index)
+	if !ok {
+		return nil
+	}
+	return s.Image.SubImage(rect).(*image.RGBA)
+}
+
+// GenerateIconSheet generates every config and packs the results into one
+// atlas. Icons are usually ElementIcon, but any element type may be packed.
+// Packing is deterministic: the same configs always produce the same image
+// and rects. Icons are placed on shelves in order of decreasing height
+// (ties keep config order) in a power-of-two wide sheet.
+func (g *Generator) GenerateIconSheet(configs []Config) (*IconSheet, error) {
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("icon sheet requires at least one config")
+	}
+
+	icons := make([]*image.RGBA, len(configs))
+	for i, config := range configs {
+		icon, err := g.Generate(config)
+		if err != nil {
+			return nil, fmt.Errorf("icon %d: %w", i, err)
+		}
+		icons[i] = icon
+	}
+
+	rects, width, height := packShelves(icons)
+	sheet := &IconSheet{
+		Image: image.NewRGBA(image.Rect(0, 0, width, height)),
+		Rects: rects,
+	}
+	for i, icon := range icons {
+		draw.Draw(sheet.Image, rects[i], icon, icon.Bounds().Min, draw.Src)
+	}
+
+	if g.logger != nil {
+		g.logger.WithFields(logrus.Fields{
+			"icons":  len(icons),
+			"width":  width,
+			"height": height,
+		}).Debug("icon sheet generated")
+	}
+
+	return sheet, nil
+}
+
+// packShelves assigns each image a rect in a shelf-packed atlas and returns
+// the rects (in input order) with the atlas size.
+func packShelves(icons []*image.RGBA) ([]image.Rectangle, int, int) {
+	order := make([]int, len(icons))
+	area := 0
+	widest := 0
+	for i, icon := range icons {
+		order[i] = i
+		b := icon.Bounds()
+		area += (b.Dx() + IconSheetPadding) * (b.Dy() + IconSheetPadding)
+		widest = maxInt(widest, b.Dx()+2*IconSheetPadding)
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return icons[order[a]].Bounds().Dy() > icons[order[b]].Bounds().Dy()
+	})
+
+	// Aim for a roughly square sheet, never narrower than the widest icon
+	width := nextPowerOfTwo(maxInt(widest, int(math.Ceil(math.Sqrt(float64(area))))))
+
+	rects := make([]image.Rectangle, len(icons))
+	x, y := IconSheetPadding, IconSheetPadding
+	shelfHeight := 0
+	for _, i := range order {
+		b := icons[i].Bounds()
+		if x+b.Dx()+IconSheetPadding > width {
+			x = IconSheetPadding
+			y += shelfHeight + IconSheetPadding
+			shelfHeight = 0
+		}
+		rects[i] = image.Rect(x, y, x+b.Dx(), y+b.Dy())
+		x += b.Dx() + IconSheetPadding
+		shelfHeight = maxInt(shelfHeight, b.Dy())
+	}
+
+	return rects, width, y + shelfHeight + IconSheetPadding
+}
+
+// nextPowerOfTwo returns the smallest power of two >= n (minimum 1).
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+)
+
+func iconSheetTestConfigs(n int) []Config {
+	configs := make([]Config, n)
+	for i := range configs {
+		config := DefaultConfig()
+		config.Type = ElementIcon
+		config.Width = 32
+		config.Height = 32
+		config.GenreID = "fantasy"
+		config.Seed = int64(1000 + i)
+		configs[i] = config
+	}
+	return configs
+}
+
+func TestGenerator_GenerateIconSheet(t *testing.T) {
+	gen := NewGenerator()
+	configs := iconSheetTestConfigs(30)
+
+	sheet, err := gen.GenerateIconSheet(configs)
+	if err != nil {
+		t.Fatalf("GenerateIconSheet failed: %v", err)
+	}
+	if sheet.Len() != len(configs) {
+		t.Fatalf("Len = %d, want %d", sheet.Len(), len(configs))
+	}
+
+	bounds := sheet.Image.Bounds()
+	for i, config := range configs {
+		rect, ok := sheet.Rect(i)
+		if !ok {
+			t.Fatalf("missing rect for icon %d", i)
+		}
+		if !rect.In(bounds) {
+			t.Errorf("icon %d rect %v outside sheet %v", i, rect, bounds)
+		}
+		for j := 0; j < i; j++ {
+			if rect.Overlaps(sheet.Rects[j]) {
+				t.Errorf("icon %d overlaps icon %d", i, j)
+			}
+		}
+
+		// Each sub-image matches the icon generated on its own
+		want, err := gen.Generate(config)
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		sub := sheet.SubImage(i)
+		for y := 0; y < rect.Dy(); y++ {
+			for x := 0; x < rect.Dx(); x++ {
+				if sub.RGBAAt(rect.Min.X+x, rect.Min.Y+y) != want.RGBAAt(x, y) {
+					t.Fatalf("icon %d pixel (%d,%d) differs from standalone icon", i, x, y)
+				}
+			}
+		}
+	}
+
+	// 30 icons of 33x33 padded area pack into a 256-wide sheet
+	if bounds.Dx() != 256 {
+		t.Errorf("sheet width = %d, want 256", bounds.Dx())
+	}
+}
+
+func TestGenerator_GenerateIconSheet_MixedSizes(t *testing.T) {
+	configs := iconSheetTestConfigs(3)
+	configs[1].Width, configs[1].Height = 16, 16
+	configs[2].Width, configs[2].Height = 48, 48
+
+	sheet, err := NewGenerator().GenerateIconSheet(configs)
+	if err != nil {
+		t.Fatalf("GenerateIconSheet failed: %v", err)
+	}
+	for i, config := range configs {
+		rect := sheet.Rects[i]
+		if rect.Dx() != config.Width || rect.Dy() != config.Height {
+			t.Errorf("icon %d rect %v, want %dx%d", i, rect, config.Width, config.Height)
+		}
+	}
+
+	// The tallest icon is placed first
+	if sheet.Rects[2].Min.X != IconSheetPadding || sheet.Rects[2].Min.Y != IconSheetPadding {
+		t.Errorf("tallest icon at %v, want top-left", sheet.Rects[2].Min)
+	}
+}
+
+func TestGenerator_GenerateIconSheet_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	configs := iconSheetTestConfigs(12)
+	configs[5].Width = 20
+
+	a, err := gen.GenerateIconSheet(configs)
+	if err != nil {
+		t.Fatalf("GenerateIconSheet failed: %v", err)
+	}
+	b, _ := gen.GenerateIconSheet(configs)
+
+	if !bytes.Equal(a.Image.Pix, b.Image.Pix) {
+		t.Error("sheet images differ between runs")
+	}
+	for i := range a.Rects {
+		if a.Rects[i] != b.Rects[i] {
+			t.Errorf("rect %d differs: %v vs %v", i, a.Rects[i], b.Rects[i])
+		}
+	}
+}
+
+func TestGenerator_GenerateIconSheet_Invalid(t *testing.T) {
+	gen := NewGenerator()
+
+	if _, err := gen.GenerateIconSheet(nil); err == nil {
+		t.Error("expected error for empty config list")
+	}
+
+	configs := iconSheetTestConfigs(3)
+	configs[1].GenreID = ""
+	if _, err := gen.GenerateIconSheet(configs); err == nil {
+		t.Error("expected error for invalid config")
+	}
+}
+
+func TestIconSheet_SubImage_OutOfRange(t *testing.T) {
+	sheet, err := NewGenerator().GenerateIconSheet(iconSheetTestConfigs(2))
+	if err != nil {
+		t.Fatalf("GenerateIconSheet failed: %v", err)
+	}
+	if sheet.SubImage(-1) != nil || sheet.SubImage(2) != nil {
+		t.Error("SubImage should return nil for out-of-range indices")
+	}
+}
+
+func BenchmarkGenerator_GenerateIconSheet(b *testing.B) {
+	gen := NewGenerator()
+	configs := iconSheetTestConfigs(30)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := gen.GenerateIconSheet(configs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package ui provides 9-slice decomposition of UI elements.
+// This file splits a generated panel, button, or frame into four corners,
+// four edges, and a center so it can be scaled to any size by stretching
+// the edges and center while keeping the genre-styled corners crisp.
+package ui
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+)
+
+// Slice indices into NineSlice.Patches, in row-major order.
+const (
+	SliceTopLeft = iota
+	SliceTop
+	SliceTopRight
+	SliceLeft
+	SliceCenter
+	SliceRight
+	SliceBottomLeft
+	SliceBottom
+	SliceBottomRight
+)
+
+// NineSlice is a UI element decomposed into a 3x3 grid of patches.
+// Corners are drawn unscaled, top/bottom edges stretch horizontally,
+// left/right edges stretch vertically, and the center stretches both ways.
+type NineSlice struct {
+	// Source is the reference image the patches were cut from
+	Source *image.RGBA
+
+	// Insets are the border widths in pixels cut from each side
+	Left, Top, Right, Bottom int
+
+	// Patches holds the nine regions, indexed by the Slice* constants
+	Patches [9]*image.RGBA
+}
+
+// MinSize returns the smallest size the element can be rendered at
+// without overlapping corners.
+func (n *NineSlice) MinSize() (width, height int) {
+	return n.Left + n.Right, n.Top + n.Bottom
+}
+
+// Render composes the element at the given size. Corners are copied
+// pixel-for-pixel; edges and center are stretched with nearest-neighbor
+// sampling to preserve the pixel-art look. Rendering at the source size
+// reproduces the source image exactly.
+func (n *NineSlice) Render(width, height int) (*image.RGBA, error) {
+	minW, minH := n.MinSize()
+	if width < minW || height < minH {
+		return nil, fmt.Errorf("size %dx%d below minimum %dx%d", width, height, minW, minH)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	centerW := width - n.Left - n.Right
+	centerH := height - n.Top - n.Bottom
+
+	// Destination rectangles, matching the Slice* order
+	cols := [3][2]int{{0, n.Left}, {n.Left, centerW}, {width - n.Right, n.Right}}
+	rows := [3][2]int{{0, n.Top}, {n.Top, centerH}, {height - n.Bottom, n.Bottom}}
+
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			dst := image.Rect(cols[col][0], rows[row][0],
+				cols[col][0]+cols[col][1], rows[row][0]+rows[row][1])
+			stretchInto(img, dst, n.Patches[row*3+col])
+		}
+	}
+	return img, nil
+}
+
+// stretchInto draws src scaled to fill dst using nearest-neighbor sampling.
+func stretchInto(dst *image.RGBA, rect image.Rectangle, src *image.RGBA) {
+	if rect.Empty() || src == nil || src.Bounds().Empty() {
+		return
+	}
+
+	sb := src.Bounds()
+	if rect.Dx() == sb.Dx() && rect.Dy() == sb.Dy() {
+		draw.Draw(dst, rect, src, sb.Min, draw.Src)
+		return
+	}
+
+	for y := 0; y < rect.Dy(); y++ {
+		sy := sb.Min.Y + y*sb.Dy()/rect.Dy()
+		for x := 0; x < rect.Dx(); x++ {
+			sx := sb.Min.X + x*sb.Dx()/rect.Dx()
+			dst.SetRGBA(rect.Min.X+x, rect.Min.Y+y, src.RGBAAt(sx, sy))
+		}
+	}
+}
+
+// Generate9Slice generates a UI element at the configured reference size and
+// decomposes it into a NineSlice. The border inset follows the genre's
+// border style (thicker for ornate and glowing borders) and can be
+// overridden with Custom["sliceInset"] (int). Config.Width and Height must
+// leave at least one pixel of center after removing the insets.
+func (g *Generator) Generate9Slice(config Config) (*NineSlice, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	inset := g.sliceInset(config)
+	if config.Width < 2*inset+1 || config.Height < 2*inset+1 {
+		return nil, fmt.Errorf("element %dx%d too small for %dpx 9-slice inset (min %dx%d)",
+			config.Width, config.Height, inset, 2*inset+1, 2*inset+1)
+	}
+
+	source, err := g.Generate(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ns := &NineSlice{
+		Source: source,
+		Left:   inset,
+		Top:    inset,
+		Right:  inset,
+		Bottom: inset,
+	}
+
+	xs := [4]int{0, inset, config.Width - inset, config.Width}
+	ys := [4]int{0, inset, config.Height - inset, config.Height}
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			rect := image.Rect(xs[col], ys[row], xs[col+1], ys[row+1])
+			patch := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+			draw.Draw(patch, patch.Bounds(), source, rect.Min, draw.Src)
+			ns.Patches[row*3+col] = patch
+		}
+	}
+
+	if g.logger != nil {
+		g.logger.WithField("type", config.Type).WithField("inset", inset).Debug("9-slice generated")
+	}
+
+	return ns, nil
+}
+
+// sliceInset returns the border inset for 9-slicing an element, wide enough
+// to keep every border decoration (ornate corners, glow falloff, button
+// highlight) inside the unscaled corner and edge patches.
+func (g *Generator) sliceInset(config Config) int {
+	if inset, ok := config.Custom["sliceInset"].(int); ok && inset > 0 {
+		return inset
+	}
+
+	switch g.selectBorderStyle(config.GenreID) {
+	case BorderOrnate:
+		return 5 // 4px corner embellishment plus 1px margin
+	case BorderGlow:
+		return 6 // 5px glow falloff plus 1px margin
+	default:
+		return g.selectBorderThickness(config.GenreID, config.Type) + 1
+	}
+}
@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+)
+
+func nineSliceTestConfig(genreID string) Config {
+	config := DefaultConfig()
+	config.Type = ElementPanel
+	config.Width = 32
+	config.Height = 24
+	config.GenreID = genreID
+	config.Seed = 4242
+	return config
+}
+
+func TestGenerator_Generate9Slice(t *testing.T) {
+	gen := NewGenerator()
+
+	for _, genre := range []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc"} {
+		t.Run(genre, func(t *testing.T) {
+			config := nineSliceTestConfig(genre)
+			ns, err := gen.Generate9Slice(config)
+			if err != nil {
+				t.Fatalf("Generate9Slice failed: %v", err)
+			}
+
+			for i, patch := range ns.Patches {
+				if patch == nil || patch.Bounds().Empty() {
+					t.Fatalf("patch %d is empty", i)
+				}
+			}
+
+			corner := ns.Patches[SliceTopLeft].Bounds()
+			if corner.Dx() != ns.Left || corner.Dy() != ns.Top {
+				t.Errorf("corner = %v, want %dx%d", corner, ns.Left, ns.Top)
+			}
+			center := ns.Patches[SliceCenter].Bounds()
+			if center.Dx() != config.Width-ns.Left-ns.Right || center.Dy() != config.Height-ns.Top-ns.Bottom {
+				t.Errorf("center = %v, want remainder of %dx%d", center, config.Width, config.Height)
+			}
+		})
+	}
+}
+
+func TestGenerator_Generate9Slice_GenreInsets(t *testing.T) {
+	gen := NewGenerator()
+
+	fantasy, _ := gen.Generate9Slice(nineSliceTestConfig("fantasy"))
+	scifi, _ := gen.Generate9Slice(nineSliceTestConfig("scifi"))
+	if fantasy.Left <= 3 || scifi.Left <= 5 {
+		t.Errorf("insets too small for genre borders: fantasy=%d scifi=%d", fantasy.Left, scifi.Left)
+	}
+
+	config := nineSliceTestConfig("fantasy")
+	config.Custom["sliceInset"] = 2
+	custom, err := gen.Generate9Slice(config)
+	if err != nil {
+		t.Fatalf("Generate9Slice failed: %v", err)
+	}
+	if custom.Left != 2 || custom.Bottom != 2 {
+		t.Errorf("custom inset = %d, want 2", custom.Left)
+	}
+}
+
+func TestNineSlice_Render_SourceSizeRoundTrip(t *testing.T) {
+	config := nineSliceTestConfig("fantasy")
+	config.Type = ElementButton
+
+	ns, err := NewGenerator().Generate9Slice(config)
+	if err != nil {
+		t.Fatalf("Generate9Slice failed: %v", err)
+	}
+
+	img, err := ns.Render(config.Width, config.Height)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if !bytes.Equal(img.Pix, ns.Source.Pix) {
+		t.Error("rendering at source size should reproduce the source image")
+	}
+}
+
+func TestNineSlice_Render_KeepsCornersCrisp(t *testing.T) {
+	config := nineSliceTestConfig("fantasy")
+	config.Type = ElementFrame
+
+	ns, err := NewGenerator().Generate9Slice(config)
+	if err != nil {
+		t.Fatalf("Generate9Slice failed: %v", err)
+	}
+
+	width, height := 200, 90
+	img, err := ns.Render(width, height)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// Every corner pixel matches the source corners exactly
+	for y := 0; y < ns.Top; y++ {
+		for x := 0; x < ns.Left; x++ {
+			if img.RGBAAt(x, y) != ns.Source.RGBAAt(x, y) {
+				t.Fatalf("top-left corner pixel (%d,%d) changed", x, y)
+			}
+			srcX := config.Width - ns.Right + x
+			dstX := width - ns.Right + x
+			srcY := config.Height - ns.Bottom + y
+			dstY := height - ns.Bottom + y
+			if img.RGBAAt(dstX, dstY) != ns.Source.RGBAAt(srcX, srcY) {
+				t.Fatalf("bottom-right corner pixel (%d,%d) changed", dstX, dstY)
+			}
+		}
+	}
+
+	// The top edge stretches: its first row is the source edge row repeated
+	srcEdge := ns.Source.RGBAAt(ns.Left, 0)
+	if got := img.RGBAAt(width/2, 0); got != srcEdge {
+		t.Errorf("stretched top edge = %v, want %v", got, srcEdge)
+	}
+}
+
+func TestNineSlice_Render_TooSmall(t *testing.T) {
+	ns, err := NewGenerator().Generate9Slice(nineSliceTestConfig("fantasy"))
+	if err != nil {
+		t.Fatalf("Generate9Slice failed: %v", err)
+	}
+	minW, minH := ns.MinSize()
+	if _, err := ns.Render(minW-1, minH); err == nil {
+		t.Error("expected error rendering below minimum size")
+	}
+	if _, err := ns.Render(minW, minH); err != nil {
+		t.Errorf("rendering at minimum size failed: %v", err)
+	}
+}
+
+func TestGenerator_Generate9Slice_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	config := nineSliceTestConfig("cyberpunk")
+
+	a, err := gen.Generate9Slice(config)
+	if err != nil {
+		t.Fatalf("Generate9Slice failed: %v", err)
+	}
+	b, _ := gen.Generate9Slice(config)
+
+	for i := range a.Patches {
+		if !bytes.Equal(a.Patches[i].Pix, b.Patches[i].Pix) {
+			t.Fatalf("patch %d differs between runs", i)
+		}
+	}
+}
+
+func TestGenerator_Generate9Slice_Invalid(t *testing.T) {
+	gen := NewGenerator()
+
+	config := nineSliceTestConfig("fantasy")
+	config.Width = 8 // Too narrow for the ornate inset
+	if _, err := gen.Generate9Slice(config); err == nil {
+		t.Error("expected error for element smaller than the insets")
+	}
+
+	config = nineSliceTestConfig("")
+	if _, err := gen.Generate9Slice(config); err == nil {
+		t.Error("expected error for invalid config")
+	}
+}
+
+func BenchmarkNineSlice_Render(b *testing.B) {
+	ns, err := NewGenerator().Generate9Slice(nineSliceTestConfig("fantasy"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ns.Render(300, 200); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Package ui provides text measurement and label layout.
+// This file measures text using the metrics of the game's bitmap font
+// (basicfont.Face7x13), wraps text to a maximum width, and computes the
+// background size for auto-fitting labels. Layout is purely arithmetic,
+// so it is deterministic across platforms.
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/image/font/basicfont"
+)
+
+// Glyph metrics of the bitmap font used to draw UI text, in pixels at scale 1.
+var (
+	glyphAdvance = float64(basicfont.Face7x13.Advance)
+	glyphHeight  = float64(basicfont.Face7x13.Height)
+)
+
+// DefaultLabelPadding is the padding around label text when Padding is unset.
+const DefaultLabelPadding = 4
+
+// MeasureText returns the pixel size of text drawn with the UI font at the
+// given scale. Newlines start new lines; the width is that of the longest
+// line. A scale of zero or less is treated as 1.
+func MeasureText(text string, scale float64) (w, h int) {
+	if text == "" {
+		return 0, 0
+	}
+	if scale <= 0 {
+		scale = 1
+	}
+
+	lines := strings.Split(text, "\n")
+	longest := 0
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line); n > longest {
+			longest = n
+		}
+	}
+	w = int(math.Ceil(float64(longest) * glyphAdvance * scale))
+	h = int(math.Ceil(float64(len(lines)) * glyphHeight * scale))
+	return w, h
+}
+
+// WrapText breaks text into lines no wider than maxWidth pixels at the
+// given scale, splitting on whitespace and keeping explicit newlines.
+// Words longer than a line are split across lines. A maxWidth of zero or
+// less disables wrapping.
+func WrapText(text string, maxWidth int, scale float64) []string {
+	if scale <= 0 {
+		scale = 1
+	}
+	paragraphs := strings.Split(text, "\n")
+	if maxWidth <= 0 {
+		return paragraphs
+	}
+
+	maxChars := int(float64(maxWidth) / (glyphAdvance * scale))
+	if maxChars < 1 {
+		maxChars = 1
+	}
+
+	var lines []string
+	for _, paragraph := range paragraphs {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		current := ""
+		for _, word := range words {
+			// Split words that cannot fit on any line
+			for utf8.RuneCountInString(word) > maxChars {
+				if current != "" {
+					lines = append(lines, current)
+					current = ""
+				}
+				runes := []rune(word)
+				lines = append(lines, string(runes[:maxChars]))
+				word = string(runes[maxChars:])
+			}
+			if word == "" {
+				continue
+			}
+
+			switch {
+			case current == "":
+				current = word
+			case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= maxChars:
+				current += " " + word
+			default:
+				lines = append(lines, current)
+				current = word
+			}
+		}
+		if current != "" {
+			lines = append(lines, current)
+		}
+	}
+	return lines
+}
+
+// TextLayout is the computed placement of a label's text.
+type TextLayout struct {
+	// Lines of text after wrapping
+	Lines []string
+
+	// Width and Height of the label background in pixels
+	Width, Height int
+
+	// TextX and TextY are the top-left corner of the first line
+	TextX, TextY int
+
+	// LineHeight is the vertical distance between lines in pixels
+	LineHeight int
+}
+
+// LayoutLabel computes the wrapped lines and background size for a label.
+// The background fits the text plus Padding on every side; if MaxWidth is
+// set, text wraps so the background is no wider than MaxWidth.
+func LayoutLabel(config Config) TextLayout {
+	padding := config.Padding
+	if padding <= 0 {
+		padding = DefaultLabelPadding
+	}
+
+	lines := WrapText(config.Text, config.MaxWidth-2*padding, config.TextScale)
+	textW, textH := MeasureText(strings.Join(lines, "\n"), config.TextScale)
+	if config.Text == "" {
+		lines = nil
+	}
+
+	scale := config.TextScale
+	if scale <= 0 {
+		scale = 1
+	}
+	return TextLayout{
+		Lines:      lines,
+		Width:      textW + 2*padding,
+		Height:     textH + 2*padding,
+		TextX:      padding,
+		TextY:      padding,
+		LineHeight: int(math.Ceil(glyphHeight * scale)),
+	}
+}
+
+// isAutoFitLabel reports whether the config describes a label sized to its text.
+func (c Config) isAutoFitLabel() bool {
+	return c.Type == ElementLabel && c.AutoFit
+}
+
+// validateAutoFit checks the text layout settings of an auto-fit label.
+func (c Config) validateAutoFit() error {
+	if c.Text == "" {
+		return fmt.Errorf("auto-fit label requires text")
+	}
+	if c.Padding < 0 {
+		return fmt.Errorf("padding must be non-negative, got %d", c.Padding)
+	}
+	if c.TextScale < 0 {
+		return fmt.Errorf("text scale must be non-negative, got %f", c.TextScale)
+	}
+	if c.MaxWidth < 0 {
+		return fmt.Errorf("max width must be non-negative, got %d", c.MaxWidth)
+	}
+	if c.MaxWidth > 0 {
+		padding := c.Padding
+		if padding <= 0 {
+			padding = DefaultLabelPadding
+		}
+		glyphW, _ := MeasureText("W", c.TextScale)
+		if minWidth := 2*padding + glyphW; c.MaxWidth < minWidth {
+			return fmt.Errorf("max width %d cannot fit one character (min %d)", c.MaxWidth, minWidth)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMeasureText(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		scale float64
+		wantW int
+		wantH int
+	}{
+		{"empty", "", 1, 0, 0},
+		{"single line", "Hello", 1, 35, 13},
+		{"scaled", "Hello", 2, 70, 26},
+		{"zero scale", "Hi", 0, 14, 13},
+		{"multi line", "Hi\nThere", 1, 35, 26},
+		{"unicode", "héllo", 1, 35, 13},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := MeasureText(tt.text, tt.scale)
+			if w != tt.wantW || h != tt.wantH {
+				t.Errorf("MeasureText(%q, %v) = %d,%d want %d,%d", tt.text, tt.scale, w, h, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		maxWidth int
+		want     []string
+	}{
+		{"no limit", "the quick brown fox", 0, []string{"the quick brown fox"}},
+		{"wraps words", "the quick brown fox", 70, []string{"the quick", "brown fox"}},
+		{"keeps newlines", "a b\nc", 70, []string{"a b", "c"}},
+		{"splits long word", "abcdefghijkl", 35, []string{"abcde", "fghij", "kl"}},
+		{"collapses spaces", "a    b", 70, []string{"a b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WrapText(tt.text, tt.maxWidth, 1); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WrapText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayoutLabel(t *testing.T) {
+	config := DefaultConfig()
+	config.Type = ElementLabel
+	config.AutoFit = true
+	config.Text = "Find the lost amulet in the eastern ruins"
+	config.Padding = 3
+	config.MaxWidth = 120
+
+	layout := LayoutLabel(config)
+	if layout.Width > config.MaxWidth {
+		t.Errorf("layout width %d exceeds MaxWidth %d", layout.Width, config.MaxWidth)
+	}
+	if len(layout.Lines) < 2 {
+		t.Fatalf("expected wrapped text, got %q", layout.Lines)
+	}
+	if joined := strings.Join(layout.Lines, " "); joined != config.Text {
+		t.Errorf("wrapped lines %q lose text", layout.Lines)
+	}
+	if layout.Height != len(layout.Lines)*13+6 {
+		t.Errorf("layout height = %d, want %d", layout.Height, len(layout.Lines)*13+6)
+	}
+	if layout.TextX != 3 || layout.TextY != 3 || layout.LineHeight != 13 {
+		t.Errorf("unexpected text origin %+v", layout)
+	}
+}
+
+func TestGenerator_AutoFitLabel(t *testing.T) {
+	gen := NewGenerator()
+	config := DefaultConfig()
+	config.Type = ElementLabel
+	config.AutoFit = true
+	config.Width, config.Height = 0, 0 // Ignored when auto-fitting
+	config.Text = "Quest complete!"
+
+	img, err := gen.Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	textW, textH := MeasureText(config.Text, 1)
+	if img.Bounds().Dx() != textW+2*DefaultLabelPadding || img.Bounds().Dy() != textH+2*DefaultLabelPadding {
+		t.Errorf("label size = %v, want text %dx%d plus padding", img.Bounds(), textW, textH)
+	}
+
+	// Wrapped label stays within MaxWidth and grows taller
+	config.MaxWidth = 60
+	wrapped, err := gen.Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if wrapped.Bounds().Dx() > 60 || wrapped.Bounds().Dy() <= img.Bounds().Dy() {
+		t.Errorf("wrapped label size = %v", wrapped.Bounds())
+	}
+}
+
+func TestConfig_Validate_AutoFit(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+	}{
+		{"no text", func(c *Config) { c.Text = "" }},
+		{"negative padding", func(c *Config) { c.Padding = -1 }},
+		{"negative scale", func(c *Config) { c.TextScale = -1 }},
+		{"max width too small", func(c *Config) { c.MaxWidth = 10 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Type = ElementLabel
+			config.AutoFit = true
+			config.Text = "Hello"
+			tt.modify(&config)
+			if err := config.Validate(); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
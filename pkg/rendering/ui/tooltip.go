@@ -0,0 +1,221 @@
+// Package ui provides tooltip generation.
+// This file draws tooltip backgrounds: a rounded panel with a triangular
+// pointer arrow on one side, outlined as a single shape so the arrow joins
+// the panel without a seam.
+package ui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	"github.com/opd-ai/venture/pkg/rendering/palette"
+)
+
+const (
+	// tooltipMaxRadius is the largest corner radius used for tooltips
+	tooltipMaxRadius = 4
+	// tooltipMinBody is the minimum panel width/height excluding the arrow
+	tooltipMinBody = 8
+)
+
+// validateTooltip checks that the arrow fits on the tooltip.
+func (c Config) validateTooltip() error {
+	if c.ArrowSide < ArrowBottom || c.ArrowSide > ArrowRight {
+		return fmt.Errorf("invalid arrow side: %d", c.ArrowSide)
+	}
+	if c.ArrowSize < 0 {
+		return fmt.Errorf("arrow size must be non-negative, got %d", c.ArrowSize)
+	}
+
+	bodyW, bodyH := c.Width, c.Height
+	sideLength := bodyW
+	switch c.ArrowSide {
+	case ArrowTop, ArrowBottom:
+		bodyH -= c.ArrowSize
+	case ArrowLeft, ArrowRight:
+		bodyW -= c.ArrowSize
+		sideLength = bodyH
+	}
+	if bodyW < tooltipMinBody || bodyH < tooltipMinBody {
+		return fmt.Errorf("tooltip body %dx%d too small (min %dx%d)", bodyW, bodyH, tooltipMinBody, tooltipMinBody)
+	}
+	if 2*c.ArrowSize+2*tooltipMaxRadius > sideLength {
+		return fmt.Errorf("arrow size %d too large for %s side of length %d", c.ArrowSize, c.ArrowSide, sideLength)
+	}
+	return nil
+}
+
+// tooltipShape describes the tooltip outline: a rounded body rectangle
+// plus a 45-degree arrow centered on one side.
+type tooltipShape struct {
+	body      image.Rectangle
+	radius    float64
+	side      ArrowSide
+	arrowSize float64
+}
+
+// newTooltipShape lays out the body and arrow within the element bounds.
+func newTooltipShape(config Config, radius int) tooltipShape {
+	body := image.Rect(0, 0, config.Width, config.Height)
+	switch config.ArrowSide {
+	case ArrowBottom:
+		body.Max.Y -= config.ArrowSize
+	case ArrowTop:
+		body.Min.Y += config.ArrowSize
+	case ArrowLeft:
+		body.Min.X += config.ArrowSize
+	case ArrowRight:
+		body.Max.X -= config.ArrowSize
+	}
+	return tooltipShape{
+		body:      body,
+		radius:    float64(radius),
+		side:      config.ArrowSide,
+		arrowSize: float64(config.ArrowSize),
+	}
+}
+
+// contains reports whether the pixel at (x, y) is inside the tooltip.
+func (s tooltipShape) contains(x, y int) bool {
+	px, py := float64(x)+0.5, float64(y)+0.5
+	return s.inBody(px, py) || s.inArrow(px, py)
+}
+
+// inBody tests a pixel center against the rounded body rectangle.
+func (s tooltipShape) inBody(px, py float64) bool {
+	minX, minY := float64(s.body.Min.X), float64(s.body.Min.Y)
+	maxX, maxY := float64(s.body.Max.X), float64(s.body.Max.Y)
+	if px < minX || px >= maxX || py < minY || py >= maxY {
+		return false
+	}
+
+	// Outside the corner squares the rectangle is solid
+	cx, cy := px, py
+	if px < minX+s.radius {
+		cx = minX + s.radius
+	} else if px > maxX-s.radius {
+		cx = maxX - s.radius
+	}
+	if py < minY+s.radius {
+		cy = minY + s.radius
+	} else if py > maxY-s.radius {
+		cy = maxY - s.radius
+	}
+	dx, dy := px-cx, py-cy
+	return dx*dx+dy*dy <= s.radius*s.radius
+}
+
+// inArrow tests a pixel center against the arrow triangle.
+func (s tooltipShape) inArrow(px, py float64) bool {
+	if s.arrowSize <= 0 {
+		return false
+	}
+
+	midX := float64(s.body.Min.X+s.body.Max.X) / 2
+	midY := float64(s.body.Min.Y+s.body.Max.Y) / 2
+
+	// Distance outward from the body edge and offset along it
+	var depth, along float64
+	switch s.side {
+	case ArrowBottom:
+		depth, along = py-float64(s.body.Max.Y), px-midX
+	case ArrowTop:
+		depth, along = float64(s.body.Min.Y)-py, px-midX
+	case ArrowLeft:
+		depth, along = float64(s.body.Min.X)-px, py-midY
+	case ArrowRight:
+		depth, along = px-float64(s.body.Max.X), py-midY
+	}
+	if depth <= 0 || depth >= s.arrowSize {
+		return false
+	}
+	if along < 0 {
+		along = -along
+	}
+	return along <= s.arrowSize-depth
+}
+
+// tooltipMask is a rasterized tooltip shape, so border detection can
+// sample neighbors without re-evaluating the geometry.
+type tooltipMask struct {
+	width, height int
+	inside        []bool
+}
+
+// rasterize evaluates the shape once per pixel.
+func (s tooltipShape) rasterize(width, height int) tooltipMask {
+	mask := tooltipMask{width: width, height: height, inside: make([]bool, width*height)}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mask.inside[y*width+x] = s.contains(x, y)
+		}
+	}
+	return mask
+}
+
+// at reports whether (x, y) is inside; pixels off the image are outside.
+func (m tooltipMask) at(x, y int) bool {
+	if x < 0 || y < 0 || x >= m.width || y >= m.height {
+		return false
+	}
+	return m.inside[y*m.width+x]
+}
+
+// edgeDistance returns how many pixels (1..maxDist) separate an inside
+// pixel from the outside of the shape, or 0 if it is deeper than maxDist.
+func (m tooltipMask) edgeDistance(x, y, maxDist int) int {
+	for d := 1; d <= maxDist; d++ {
+		if !m.at(x-d, y) || !m.at(x+d, y) || !m.at(x, y-d) || !m.at(x, y+d) {
+			return d
+		}
+	}
+	return 0
+}
+
+// generateTooltip creates a tooltip background with a pointer arrow.
+// It uses the panel background and the genre's border style: ornate genres
+// get a thick primary-colored outline, glowing genres a soft double outline.
+func (g *Generator) generateTooltip(img *image.RGBA, pal *palette.Palette, config Config) {
+	radius := tooltipMaxRadius
+	if g.isTechGenre(config.GenreID) {
+		radius = 2 // Tech genres use tighter corners
+	}
+	mask := newTooltipShape(config, radius).rasterize(config.Width, config.Height)
+
+	fill := g.panelBackground(pal, config)
+	rings := g.tooltipBorderRings(pal, config.GenreID)
+
+	for y := 0; y < config.Height; y++ {
+		for x := 0; x < config.Width; x++ {
+			if !mask.at(x, y) {
+				continue
+			}
+			if d := mask.edgeDistance(x, y, len(rings)); d > 0 {
+				img.SetRGBA(x, y, rings[d-1])
+			} else {
+				img.SetRGBA(x, y, fill)
+			}
+		}
+	}
+}
+
+// tooltipBorderRings returns the outline colors from the outer edge inward.
+func (g *Generator) tooltipBorderRings(pal *palette.Palette, genreID string) []color.RGBA {
+	toRGBA := func(c color.Color) color.RGBA {
+		return color.RGBAModel.Convert(c).(color.RGBA)
+	}
+
+	switch g.selectBorderStyle(genreID) {
+	case BorderOrnate:
+		primary := toRGBA(pal.Primary)
+		return []color.RGBA{primary, toRGBA(g.darkenColor(primary, 0.3))}
+	case BorderGlow:
+		glow := toRGBA(g.lightenColor(pal.Primary, 0.2))
+		soft := glow
+		soft.A = 128
+		return []color.RGBA{glow, soft}
+	default:
+		return []color.RGBA{toRGBA(g.lightenColor(pal.Background, 0.3))}
+	}
+}
@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func tooltipTestConfig(side ArrowSide) Config {
+	config := DefaultConfig()
+	config.Type = ElementTooltip
+	config.Width = 120
+	config.Height = 40
+	config.Seed = 99
+	config.ArrowSide = side
+	config.ArrowSize = 6
+	return config
+}
+
+func TestArrowSide_String(t *testing.T) {
+	tests := []struct {
+		side     ArrowSide
+		expected string
+	}{
+		{ArrowBottom, "bottom"},
+		{ArrowTop, "top"},
+		{ArrowLeft, "left"},
+		{ArrowRight, "right"},
+		{ArrowSide(99), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.side.String(); got != tt.expected {
+			t.Errorf("ArrowSide(%d).String() = %q, want %q", tt.side, got, tt.expected)
+		}
+	}
+}
+
+func TestGenerator_Tooltip_ArrowSides(t *testing.T) {
+	gen := NewGenerator()
+
+	// Pixel just inside the arrow tip and the matching spot on the opposite edge
+	tests := []struct {
+		side        ArrowSide
+		tipX, tipY  int
+		farX, farY  int
+		cornerX, cY int
+	}{
+		{ArrowBottom, 60, 38, 60, 0, 0, 0},
+		{ArrowTop, 60, 1, 60, 39, 0, 39},
+		{ArrowLeft, 1, 20, 119, 20, 119, 0},
+		{ArrowRight, 118, 20, 0, 20, 0, 39},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.side.String(), func(t *testing.T) {
+			img, err := gen.Generate(tooltipTestConfig(tt.side))
+			if err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			if img.RGBAAt(tt.tipX, tt.tipY).A == 0 {
+				t.Errorf("arrow tip at (%d,%d) is transparent", tt.tipX, tt.tipY)
+			}
+			if img.RGBAAt(tt.farX, tt.farY).A == 0 {
+				t.Errorf("panel edge at (%d,%d) is transparent", tt.farX, tt.farY)
+			}
+			// Beside the arrow tip is empty space
+			if tt.side == ArrowBottom || tt.side == ArrowTop {
+				if img.RGBAAt(10, tt.tipY).A != 0 {
+					t.Error("area beside the arrow should be transparent")
+				}
+			} else if img.RGBAAt(tt.tipX, 5).A != 0 {
+				t.Error("area beside the arrow should be transparent")
+			}
+			// Rounded corners leave the outermost pixel empty
+			if img.RGBAAt(tt.cornerX, tt.cY).A != 0 {
+				t.Errorf("corner (%d,%d) should be rounded off", tt.cornerX, tt.cY)
+			}
+		})
+	}
+}
+
+func TestGenerator_Tooltip_BorderSharedWithArrow(t *testing.T) {
+	img, err := NewGenerator().Generate(tooltipTestConfig(ArrowBottom))
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	// Where the arrow joins the body there is no border seam
+	border := img.RGBAAt(30, 33) // bottom body edge away from the arrow
+	seam := img.RGBAAt(60, 33)   // same row, directly above the arrow
+	if seam == border {
+		t.Error("body border should not cross the arrow opening")
+	}
+}
+
+func TestGenerator_Tooltip_NoArrow(t *testing.T) {
+	config := tooltipTestConfig(ArrowBottom)
+	config.ArrowSize = 0
+	img, err := NewGenerator().Generate(config)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if img.RGBAAt(60, 39).A == 0 {
+		t.Error("without an arrow the body should fill the full height")
+	}
+}
+
+func TestGenerator_Tooltip_Deterministic(t *testing.T) {
+	gen := NewGenerator()
+	for _, genre := range []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc"} {
+		config := tooltipTestConfig(ArrowLeft)
+		config.GenreID = genre
+		a, err := gen.Generate(config)
+		if err != nil {
+			t.Fatalf("%s: Generate failed: %v", genre, err)
+		}
+		b, _ := gen.Generate(config)
+		if !bytes.Equal(a.Pix, b.Pix) {
+			t.Errorf("%s: tooltip generation not deterministic", genre)
+		}
+	}
+}
+
+func TestConfig_Validate_Tooltip(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+	}{
+		{"negative arrow", func(c *Config) { c.ArrowSize = -1 }},
+		{"invalid side", func(c *Config) { c.ArrowSide = ArrowSide(7) }},
+		{"arrow too wide", func(c *Config) { c.ArrowSide = ArrowLeft; c.ArrowSize = 17 }},
+		{"body too small", func(c *Config) { c.Height = 12 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tooltipTestConfig(ArrowBottom)
+			tt.modify(&config)
+			if err := config.Validate(); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
+
+func TestGenerator_Tooltip_Performance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping performance test in short mode")
+	}
+	gen := NewGenerator()
+	config := tooltipTestConfig(ArrowBottom)
+	config.Width, config.Height = 200, 80
+
+	const runs = 50
+	start := time.Now()
+	for i := 0; i < runs; i++ {
+		if _, err := gen.Generate(config); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if avg := time.Since(start) / runs; avg > time.Millisecond {
+		t.Errorf("tooltip generation took %v, want under 1ms", avg)
+	}
+}
+
+func BenchmarkGenerator_GenerateTooltip(b *testing.B) {
+	gen := NewGenerator()
+	config := tooltipTestConfig(ArrowBottom)
+	config.Width, config.Height = 200, 80
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := gen.Generate(config); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
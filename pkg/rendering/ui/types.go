@@ -23,6 +23,8 @@ const (
 	ElementIcon
 	// ElementFrame represents a decorative frame
 	ElementFrame
+	// ElementTooltip represents a tooltip panel with a pointer arrow
+	ElementTooltip
 )
 
 // String returns the string representation of an element type.
@@ -40,6 +42,8 @@ func (e ElementType) String() string {
 		return "icon"
 	case ElementFrame:
 		return "frame"
+	case ElementTooltip:
+		return "tooltip"
 	default:
 		return "unknown"
 	}
@@ -69,10 +73,59 @@ type Config struct {
 	// State of the element (normal, hover, pressed, disabled)
 	State ElementState
 
+	// ArrowSide is the tooltip edge the pointer arrow extends from
+	ArrowSide ArrowSide
+
+	// ArrowSize is the tooltip arrow length in pixels (0 = no arrow)
+	ArrowSize int
+
+	// AutoFit sizes a label's background to its Text, ignoring Width/Height
+	AutoFit bool
+
+	// Padding around auto-fit label text in pixels (0 = DefaultLabelPadding)
+	Padding int
+
+	// MaxWidth wraps auto-fit label text onto multiple lines (0 = no limit)
+	MaxWidth int
+
+	// TextScale is the font scale used to measure label text (0 = 1.0)
+	TextScale float64
+
 	// Custom parameters for specific element types
 	Custom map[string]interface{}
 }
 
+// ArrowSide represents which edge of a tooltip its pointer arrow extends from.
+// The arrow points away from the tooltip toward the hovered element.
+type ArrowSide int
+
+const (
+	// ArrowBottom places the arrow below the tooltip (tooltip above the element)
+	ArrowBottom ArrowSide = iota
+	// ArrowTop places the arrow above the tooltip (tooltip below the element)
+	ArrowTop
+	// ArrowLeft places the arrow left of the tooltip
+	ArrowLeft
+	// ArrowRight places the arrow right of the tooltip
+	ArrowRight
+)
+
+// String returns the string representation of an arrow side.
+func (a ArrowSide) String() string {
+	switch a {
+	case ArrowBottom:
+		return "bottom"
+	case ArrowTop:
+		return "top"
+	case ArrowLeft:
+		return "left"
+	case ArrowRight:
+		return "right"
+	default:
+		return "unknown"
+	}
+}
+
 // ElementState represents the current state of a UI element.
 type ElementState int
 
@@ -106,25 +159,33 @@ func (s ElementState) String() string {
 // DefaultConfig returns a default UI element configuration.
 func DefaultConfig() Config {
 	return Config{
-		Type:    ElementButton,
-		Width:   100,
-		Height:  30,
-		GenreID: "fantasy",
-		Seed:    0,
-		Text:    "",
-		Value:   1.0,
-		State:   StateNormal,
-		Custom:  make(map[string]interface{}),
+		Type:      ElementButton,
+		Width:     100,
+		Height:    30,
+		GenreID:   "fantasy",
+		Seed:      0,
+		Text:      "",
+		Value:     1.0,
+		State:     StateNormal,
+		ArrowSide: ArrowBottom,
+		ArrowSize: 6,
+		Custom:    make(map[string]interface{}),
 	}
 }
 
 // Validate checks if the configuration is valid.
 func (c Config) Validate() error {
-	if c.Width <= 0 {
-		return fmt.Errorf("width must be positive, got %d", c.Width)
-	}
-	if c.Height <= 0 {
-		return fmt.Errorf("height must be positive, got %d", c.Height)
+	if c.isAutoFitLabel() {
+		if err := c.validateAutoFit(); err != nil {
+			return err
+		}
+	} else {
+		if c.Width <= 0 {
+			return fmt.Errorf("width must be positive, got %d", c.Width)
+		}
+		if c.Height <= 0 {
+			return fmt.Errorf("height must be positive, got %d", c.Height)
+		}
 	}
 	if c.GenreID == "" {
 		return fmt.Errorf("genreID cannot be empty")
@@ -132,6 +193,11 @@ func (c Config) Validate() error {
 	if c.Value < 0.0 || c.Value > 1.0 {
 		return fmt.Errorf("value must be between 0.0 and 1.0, got %f", c.Value)
 	}
+	if c.Type == ElementTooltip {
+		if err := c.validateTooltip(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
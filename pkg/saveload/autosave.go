@@ -0,0 +1,156 @@
+// Package saveload provides autosave rotation.
+// This file implements periodic autosaves written to a fixed ring of
+// "autosave_N" slots, so recovery points never grow disk usage unbounded.
+package saveload
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// AutoSavePrefix is the save name prefix of autosave slots.
+const AutoSavePrefix = "autosave_"
+
+// AutoSaveConfig configures autosave rotation.
+type AutoSaveConfig struct {
+	// MaxSlots is the number of autosave slots kept before the oldest is
+	// overwritten
+	MaxSlots int
+
+	// Interval between periodic autosaves
+	Interval time.Duration
+}
+
+// DefaultAutoSaveConfig keeps three autosaves taken five minutes apart.
+func DefaultAutoSaveConfig() AutoSaveConfig {
+	return AutoSaveConfig{
+		MaxSlots: 3,
+		Interval: 5 * time.Minute,
+	}
+}
+
+// Validate checks that the configuration is usable.
+func (c AutoSaveConfig) Validate() error {
+	if c.MaxSlots < 1 {
+		return fmt.Errorf("autosave slots must be at least 1, got %d", c.MaxSlots)
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("autosave interval must be positive, got %v", c.Interval)
+	}
+	return nil
+}
+
+// Due reports whether an autosave is due at now given the time of the last
+// one. A zero lastSave means no autosave has been taken yet.
+func (c AutoSaveConfig) Due(lastSave, now time.Time) bool {
+	return lastSave.IsZero() || now.Sub(lastSave) >= c.Interval
+}
+
+// AutoSaveSlotName returns the save name of autosave slot index.
+func AutoSaveSlotName(index int) string {
+	return AutoSavePrefix + strconv.Itoa(index)
+}
+
+// SetAutoSaveConfig replaces the autosave configuration. Slots beyond a
+// reduced MaxSlots are left on disk but no longer written.
+func (m *SaveManager) SetAutoSaveConfig(config AutoSaveConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.autoSave = config
+	return nil
+}
+
+// AutoSaveConfig returns the autosave configuration.
+func (m *SaveManager) AutoSaveConfig() AutoSaveConfig {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.autoSave
+}
+
+// AutoSave writes save to the next autosave slot: the first unused slot,
+// or else the one holding the oldest autosave. It is safe to call
+// concurrently with SaveGame and other AutoSave calls.
+func (m *SaveManager) AutoSave(save *GameSave) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	name := m.nextAutoSaveSlot()
+	if err := m.saveGameLocked(name, save); err != nil {
+		return fmt.Errorf("autosave failed: %w", err)
+	}
+
+	m.logDebug("autosave written", logrus.Fields{"slot": name})
+	return nil
+}
+
+// ListAutoSaves returns metadata for the existing autosave slots, newest
+// first.
+func (m *SaveManager) ListAutoSaves() ([]*SaveMetadata, error) {
+	entries, err := os.ReadDir(m.saveDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read save directory: %w", err)
+	}
+
+	var saves []*SaveMetadata
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name, ok := strings.CutSuffix(entry.Name(), ".sav")
+		if !ok || !isAutoSaveName(name) {
+			continue
+		}
+
+		metadata, err := m.GetSaveMetadata(name)
+		if err != nil {
+			// Skip files that can't be read
+			continue
+		}
+		saves = append(saves, metadata)
+	}
+
+	sort.Slice(saves, func(i, j int) bool {
+		return saves[i].Timestamp.After(saves[j].Timestamp)
+	})
+
+	return saves, nil
+}
+
+// nextAutoSaveSlot picks the slot the next autosave overwrites; the caller
+// must hold m.mu.
+func (m *SaveManager) nextAutoSaveSlot() string {
+	oldest := 0
+	var oldestTime time.Time
+	for i := 0; i < m.autoSave.MaxSlots; i++ {
+		name := AutoSaveSlotName(i)
+		metadata, err := m.GetSaveMetadata(name)
+		if err != nil {
+			// Missing or unreadable slots are free
+			return name
+		}
+		if i == 0 || metadata.Timestamp.Before(oldestTime) {
+			oldest = i
+			oldestTime = metadata.Timestamp
+		}
+	}
+	return AutoSaveSlotName(oldest)
+}
+
+// isAutoSaveName reports whether name is an autosave slot name.
+func isAutoSaveName(name string) bool {
+	index, ok := strings.CutPrefix(name, AutoSavePrefix)
+	if !ok {
+		return false
+	}
+	n, err := strconv.Atoi(index)
+	return err == nil && n >= 0
+}
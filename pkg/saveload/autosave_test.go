@@ -0,0 +1,175 @@
+package saveload
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSaveManager_AutoSaveRotation(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := manager.SetAutoSaveConfig(AutoSaveConfig{MaxSlots: 3, Interval: time.Minute}); err != nil {
+		t.Fatalf("SetAutoSaveConfig failed: %v", err)
+	}
+
+	// Five autosaves into three slots: levels 3, 4, 5 survive
+	for level := 1; level <= 5; level++ {
+		if err := manager.AutoSave(metadataTestSave(level, "fantasy")); err != nil {
+			t.Fatalf("AutoSave %d failed: %v", level, err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	saves, err := manager.ListAutoSaves()
+	if err != nil {
+		t.Fatalf("ListAutoSaves failed: %v", err)
+	}
+	if len(saves) != 3 {
+		t.Fatalf("ListAutoSaves returned %d saves, want 3", len(saves))
+	}
+	for i, want := range []int{5, 4, 3} {
+		if saves[i].PlayerLevel != want {
+			t.Errorf("saves[%d] level = %d, want %d (newest first)", i, saves[i].PlayerLevel, want)
+		}
+	}
+
+	// Level 4 went to slot 0 after slots 0-2 were filled by levels 1-3
+	loaded, err := manager.LoadGame(AutoSaveSlotName(0))
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if loaded.PlayerState.Level != 4 {
+		t.Errorf("autosave_0 level = %d, want 4", loaded.PlayerState.Level)
+	}
+	if manager.SaveExists(AutoSaveSlotName(3)) {
+		t.Error("autosave wrote beyond MaxSlots")
+	}
+}
+
+func TestSaveManager_ListAutoSavesIgnoresManualSaves(t *testing.T) {
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := manager.SaveGame("quicksave", metadataTestSave(1, "scifi")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := manager.SaveGame("autosave_notes", metadataTestSave(1, "scifi")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := manager.AutoSave(metadataTestSave(2, "scifi")); err != nil {
+		t.Fatalf("AutoSave failed: %v", err)
+	}
+
+	saves, err := manager.ListAutoSaves()
+	if err != nil {
+		t.Fatalf("ListAutoSaves failed: %v", err)
+	}
+	if len(saves) != 1 || saves[0].Name != "autosave_0" {
+		t.Errorf("ListAutoSaves = %v, want only autosave_0", saves)
+	}
+}
+
+func TestSaveManager_ConcurrentAutoSaveAndManualSave(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	manager.SetCompression(CompressGzip)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(level int) {
+			defer wg.Done()
+			errs <- manager.AutoSave(metadataTestSave(level, "horror"))
+		}(i + 1)
+		go func(level int) {
+			defer wg.Done()
+			errs <- manager.SaveGame("manual", metadataTestSave(level, "horror"))
+		}(i + 1)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent save failed: %v", err)
+		}
+	}
+
+	// Every slot must still decode, and no temp files may be left behind
+	names := []string{"manual"}
+	for i := 0; i < DefaultAutoSaveConfig().MaxSlots; i++ {
+		names = append(names, AutoSaveSlotName(i))
+	}
+	for _, name := range names {
+		if _, err := manager.LoadGame(name); err != nil {
+			t.Errorf("LoadGame(%s) after concurrent saves: %v", name, err)
+		}
+	}
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	for _, entry := range entries {
+		if strings.Contains(entry.Name(), ".tmp") {
+			t.Errorf("temporary file left behind: %s", entry.Name())
+		}
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, AutoSaveSlotName(3)+".sav")); !os.IsNotExist(err) {
+		t.Error("autosave wrote beyond the default slot count")
+	}
+}
+
+func TestAutoSaveConfig_Validate(t *testing.T) {
+	tests := []struct {
+		config  AutoSaveConfig
+		wantErr bool
+	}{
+		{DefaultAutoSaveConfig(), false},
+		{AutoSaveConfig{MaxSlots: 0, Interval: time.Minute}, true},
+		{AutoSaveConfig{MaxSlots: 2, Interval: 0}, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%+v", tt.config), func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := manager.SetAutoSaveConfig(AutoSaveConfig{}); err == nil {
+		t.Error("SetAutoSaveConfig should reject an invalid config")
+	}
+	if manager.AutoSaveConfig() != DefaultAutoSaveConfig() {
+		t.Error("rejected config should not replace the current one")
+	}
+}
+
+func TestAutoSaveConfig_Due(t *testing.T) {
+	config := AutoSaveConfig{MaxSlots: 1, Interval: time.Minute}
+	now := time.Now()
+	if !config.Due(time.Time{}, now) {
+		t.Error("first autosave should be due immediately")
+	}
+	if config.Due(now.Add(-30*time.Second), now) {
+		t.Error("autosave should not be due before the interval")
+	}
+	if !config.Due(now.Add(-time.Minute), now) {
+		t.Error("autosave should be due once the interval has passed")
+	}
+}
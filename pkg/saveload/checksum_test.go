@@ -0,0 +1,119 @@
+package saveload
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSaveManager_ChecksumRoundTrip(t *testing.T) {
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	save := metadataTestSave(7, "fantasy")
+	if err := manager.SaveGame("slot", save); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if len(save.Checksum) != 64 {
+		t.Errorf("Checksum = %q, want a hex SHA-256", save.Checksum)
+	}
+
+	loaded, err := manager.LoadGame("slot")
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if loaded.Checksum != save.Checksum {
+		t.Errorf("loaded checksum %q, want %q", loaded.Checksum, save.Checksum)
+	}
+}
+
+func TestSaveManager_TamperedSaveIsCorrupted(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := manager.SaveGame("slot", metadataTestSave(7, "fantasy")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	path := filepath.Join(tmpDir, "slot.sav")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	tampered := strings.Replace(string(data), `"level": 7`, `"level": 99`, 1)
+	if tampered == string(data) {
+		t.Fatal("test setup: player level not found in save")
+	}
+	if err := os.WriteFile(path, []byte(tampered), 0o644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	if _, err := manager.LoadGame("slot"); !errors.Is(err, ErrSaveCorrupted) {
+		t.Errorf("LoadGame of tampered save: err = %v, want ErrSaveCorrupted", err)
+	}
+}
+
+func TestSaveManager_TruncatedSaveIsCorrupted(t *testing.T) {
+	for _, mode := range []CompressionMode{CompressNone, CompressGzip} {
+		t.Run(mode.String(), func(t *testing.T) {
+			tmpDir := t.TempDir()
+			manager, err := NewSaveManager(tmpDir)
+			if err != nil {
+				t.Fatalf("NewSaveManager failed: %v", err)
+			}
+			manager.SetCompression(mode)
+			if err := manager.SaveGame("slot", metadataTestSave(7, "fantasy")); err != nil {
+				t.Fatalf("SaveGame failed: %v", err)
+			}
+
+			path := filepath.Join(tmpDir, "slot.sav")
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("ReadFile failed: %v", err)
+			}
+			if err := os.WriteFile(path, data[:len(data)/2], 0o644); err != nil {
+				t.Fatalf("WriteFile failed: %v", err)
+			}
+
+			if _, err := manager.LoadGame("slot"); !errors.Is(err, ErrSaveCorrupted) {
+				t.Errorf("LoadGame of truncated save: err = %v, want ErrSaveCorrupted", err)
+			}
+		})
+	}
+}
+
+func TestSaveManager_LegacySaveWithoutChecksumLoads(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	save := metadataTestSave(4, "scifi")
+	save.Version = SaveVersion
+	data, err := json.Marshal(save)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if strings.Contains(string(data), "checksum") {
+		t.Fatal("test setup: legacy save should have no checksum")
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "legacy.sav"), data, 0o644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	loaded, err := manager.LoadGame("legacy")
+	if err != nil {
+		t.Fatalf("legacy save should load without a checksum: %v", err)
+	}
+	if loaded.PlayerState.Level != 4 {
+		t.Errorf("Level = %d, want 4", loaded.PlayerState.Level)
+	}
+}
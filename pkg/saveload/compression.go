@@ -0,0 +1,103 @@
+// Package saveload provides save file compression.
+// This file implements optional gzip compression of the JSON save payload.
+// Compressed files are recognized by the gzip magic header, so saves written
+// without compression (including older saves) continue to load unchanged.
+package saveload
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// CompressionMode selects how save payloads are written to disk.
+type CompressionMode int
+
+const (
+	// CompressNone writes plain JSON (the default)
+	CompressNone CompressionMode = iota
+	// CompressGzip writes gzip-compressed JSON
+	CompressGzip
+)
+
+// String returns the string representation of a compression mode.
+func (c CompressionMode) String() string {
+	switch c {
+	case CompressNone:
+		return "none"
+	case CompressGzip:
+		return "gzip"
+	default:
+		return "unknown"
+	}
+}
+
+// gzipMagic is the two-byte header that starts every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// isGzipped reports whether data starts with the gzip magic header.
+// Plain JSON saves always start with '{' or whitespace, never 0x1f.
+func isGzipped(data []byte) bool {
+	return bytes.HasPrefix(data, gzipMagic)
+}
+
+// compressPayload encodes data according to the compression mode.
+func compressPayload(data []byte, mode CompressionMode) ([]byte, error) {
+	switch mode {
+	case CompressNone:
+		return data, nil
+	case CompressGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to compress save data: %w", err)
+		}
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("failed to compress save data: %w", err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown compression mode: %d", mode)
+	}
+}
+
+// decompressPayload returns the JSON payload, decompressing it if it carries
+// the gzip header and returning it unchanged otherwise.
+func decompressPayload(data []byte) ([]byte, error) {
+	if !isGzipped(data) {
+		return data, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress save data: %w", err)
+	}
+	defer reader.Close()
+
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress save data: %w", err)
+	}
+	return payload, nil
+}
+
+// payloadReader wraps r so reads return the JSON payload whether or not the
+// underlying stream is gzip-compressed.
+func payloadReader(r io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(r)
+	header, err := buffered.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read save header: %w", err)
+	}
+	if !isGzipped(header) {
+		return buffered, nil
+	}
+
+	reader, err := gzip.NewReader(buffered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress save data: %w", err)
+	}
+	return reader, nil
+}
@@ -0,0 +1,169 @@
+package saveload
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// compressionTestSave builds a save with enough repeated data to compress well.
+func compressionTestSave() *GameSave {
+	save := NewGameSave()
+	save.PlayerState.Level = 7
+	save.PlayerState.X = 321.5
+	save.WorldState.Seed = 98765
+	save.WorldState.GenreID = "scifi"
+	save.WorldState.Width = 80
+	save.WorldState.Height = 50
+	for i := 0; i < 50; i++ {
+		save.PlayerState.Items = append(save.PlayerState.Items, ItemData{
+			Name:   "Plasma Cell",
+			Type:   "consumable",
+			Rarity: "common",
+		})
+	}
+	return save
+}
+
+func TestCompressionMode_String(t *testing.T) {
+	tests := map[CompressionMode]string{
+		CompressNone:        "none",
+		CompressGzip:        "gzip",
+		CompressionMode(99): "unknown",
+	}
+	for mode, want := range tests {
+		if got := mode.String(); got != want {
+			t.Errorf("CompressionMode(%d).String() = %q, want %q", mode, got, want)
+		}
+	}
+}
+
+func TestSaveManager_CompressionRoundTrip(t *testing.T) {
+	for _, mode := range []CompressionMode{CompressNone, CompressGzip} {
+		t.Run(mode.String(), func(t *testing.T) {
+			tmpDir := t.TempDir()
+			manager, err := NewSaveManager(tmpDir)
+			if err != nil {
+				t.Fatalf("NewSaveManager failed: %v", err)
+			}
+			if err := manager.SetCompression(mode); err != nil {
+				t.Fatalf("SetCompression failed: %v", err)
+			}
+
+			if err := manager.SaveGame("slot", compressionTestSave()); err != nil {
+				t.Fatalf("SaveGame failed: %v", err)
+			}
+
+			raw, err := os.ReadFile(filepath.Join(tmpDir, "slot.sav"))
+			if err != nil {
+				t.Fatalf("failed to read save file: %v", err)
+			}
+			if isGzipped(raw) != (mode == CompressGzip) {
+				t.Errorf("gzip header present = %v for mode %s", isGzipped(raw), mode)
+			}
+
+			loaded, err := manager.LoadGame("slot")
+			if err != nil {
+				t.Fatalf("LoadGame failed: %v", err)
+			}
+			if loaded.Version != SaveVersion {
+				t.Errorf("Version = %s, want %s", loaded.Version, SaveVersion)
+			}
+			if loaded.PlayerState.Level != 7 || loaded.PlayerState.X != 321.5 {
+				t.Errorf("player state not preserved: %+v", loaded.PlayerState)
+			}
+			if loaded.WorldState.Seed != 98765 || loaded.WorldState.GenreID != "scifi" {
+				t.Errorf("world state not preserved: %+v", loaded.WorldState)
+			}
+			if len(loaded.PlayerState.Items) != 50 {
+				t.Errorf("inventory size = %d, want 50", len(loaded.PlayerState.Items))
+			}
+
+			metadata, err := manager.GetSaveMetadata("slot")
+			if err != nil {
+				t.Fatalf("GetSaveMetadata failed: %v", err)
+			}
+			if metadata.PlayerLevel != 7 || metadata.GenreID != "scifi" {
+				t.Errorf("metadata = %+v, want level 7 scifi", metadata)
+			}
+		})
+	}
+}
+
+func TestSaveManager_CompressionShrinksSave(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	save := compressionTestSave()
+	if err := manager.SaveGame("plain", save); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	manager.SetCompression(CompressGzip)
+	if err := manager.SaveGame("packed", save); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	plain, _ := os.Stat(filepath.Join(tmpDir, "plain.sav"))
+	packed, _ := os.Stat(filepath.Join(tmpDir, "packed.sav"))
+	if packed.Size() >= plain.Size()/2 {
+		t.Errorf("compressed size %d not much smaller than plain %d", packed.Size(), plain.Size())
+	}
+}
+
+func TestSaveManager_LoadsUncompressedWithGzipMode(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	// A save written before compression existed
+	if err := manager.SaveGame("old", compressionTestSave()); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	manager.SetCompression(CompressGzip)
+	loaded, err := manager.LoadGame("old")
+	if err != nil {
+		t.Fatalf("LoadGame of uncompressed save failed: %v", err)
+	}
+	if loaded.WorldState.Seed != 98765 {
+		t.Errorf("Seed = %d, want 98765", loaded.WorldState.Seed)
+	}
+}
+
+func TestSaveManager_CorruptedCompressedSave(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	// Gzip header followed by garbage
+	data := append([]byte{0x1f, 0x8b}, []byte("not really gzip")...)
+	if err := os.WriteFile(filepath.Join(tmpDir, "broken.sav"), data, 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	_, err = manager.LoadGame("broken")
+	if err == nil || !strings.Contains(err.Error(), "decompress") {
+		t.Errorf("expected decompression error, got %v", err)
+	}
+}
+
+func TestSaveManager_SetCompressionInvalid(t *testing.T) {
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := manager.SetCompression(CompressionMode(5)); err == nil {
+		t.Error("expected error for unknown compression mode")
+	}
+	if manager.Compression() != CompressNone {
+		t.Errorf("Compression = %s, want none", manager.Compression())
+	}
+}
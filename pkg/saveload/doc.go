@@ -18,13 +18,45 @@
 // Save files use JSON format with the following structure:
 //
 //	{
-//	  "version": "1.0.0",
+//	  "version": "1.1.0",
 //	  "timestamp": "2025-10-22T17:30:00Z",
 //	  "player": { ... },
 //	  "world": { ... },
 //	  "settings": { ... }
 //	}
 //
+// SetCompression(CompressGzip) gzips the JSON payload of subsequent saves.
+// Loading checks for the gzip magic header, so compressed and plain saves
+// (including saves written before compression existed) load the same way.
+//
+// Each save also gets a small "<name>.meta" JSON sidecar holding its
+// SaveMetadata (level, genre, play time, thumbnail path). ListSaves and
+// GetSaveMetadata read the sidecar so save menus never decode full saves;
+// saves without a sidecar fall back to reading the save file.
+//
+// Every save embeds a SHA-256 "checksum" of its contents. LoadGame verifies
+// it and returns an error wrapping ErrSaveCorrupted for truncated, unreadable
+// or tampered saves, so callers can use errors.Is to offer a backup. Saves
+// without a checksum are legacy saves and load unchecked.
+//
+// For cloud sync, each save carries SyncMetadata: the writing device
+// (SetDeviceID), a per-slot revision that only increases, the last-modified
+// time, and a version vector of saves per device. DetectConflict compares
+// two copies of a save: an ancestor can simply be replaced, while copies
+// that diverged from a shared base are reported as a conflict.
+//
+// Non-player entities (enemies, dropped items, merchants, crafting
+// stations) are saved in GameSave.EntitiesState. SnapshotEntities and
+// RestoreEntities work against the EntityWorld interface, which the engine
+// implements; respawned entities get new IDs and saved references between
+// entities are remapped to them.
+//
+// AutoSave rotates through a fixed ring of "autosave_0" .. "autosave_N-1"
+// slots (AutoSaveConfig.MaxSlots), overwriting the oldest, and
+// ListAutoSaves returns them newest-first. Writes go to a temporary file
+// that is renamed into place, and the manager serializes writes, so
+// concurrent autosaves and manual saves never leave a torn file.
+//
 // # Usage Example
 //
 //	// Create a save manager
@@ -47,6 +79,13 @@
 // # Version Compatibility
 //
 // The package supports save file versioning to handle format changes across
-// game versions. Older save files are automatically migrated to the current
-// format when loaded.
+// game versions. Each format bump registers a MigrationFunc with
+// RegisterMigration that transforms the decoded JSON from one version to the
+// next; LoadGame chains them until the save reaches SaveVersion and records
+// the steps in GameSave.AppliedMigrations:
+//
+//	manager.RegisterMigration("1.1.0", "1.2.0", func(data map[string]interface{}) error {
+//	    data["settings"].(map[string]interface{})["ui_scale"] = 1.0
+//	    return nil
+//	})
 package saveload
@@ -0,0 +1,191 @@
+// Package saveload provides world entity serialization.
+// This file implements the EntitiesState save section and the SaveManager
+// helpers that snapshot non-player entities from a world and restore them,
+// remapping entity IDs so saved references stay valid.
+package saveload
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// EntityKind identifies what a saved entity represents.
+type EntityKind string
+
+const (
+	// EntityKindEnemy is a hostile creature
+	EntityKindEnemy EntityKind = "enemy"
+	// EntityKindItem is an item lying in the world waiting to be picked up
+	EntityKindItem EntityKind = "item"
+	// EntityKindMerchant is a merchant NPC and their stock
+	EntityKindMerchant EntityKind = "merchant"
+	// EntityKindStation is a crafting station
+	EntityKindStation EntityKind = "station"
+)
+
+// ReferenceTarget is the EntityData reference key for an AI's current target.
+const ReferenceTarget = "target"
+
+// EntitiesState is the saved state of every non-player entity in the level.
+type EntitiesState struct {
+	Entities []EntityData `json:"entities"`
+}
+
+// EntityData is the saved state of one non-player entity.
+type EntityData struct {
+	// ID the entity had when saved; restored entities may get a new one
+	ID uint64 `json:"id"`
+
+	// Kind of entity
+	Kind EntityKind `json:"kind"`
+
+	// Position
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+
+	// Health
+	CurrentHealth float64 `json:"current_health,omitempty"`
+	MaxHealth     float64 `json:"max_health,omitempty"`
+
+	// Combat stats (enemies)
+	Attack  float64 `json:"attack,omitempty"`
+	Defense float64 `json:"defense,omitempty"`
+
+	// EliteAffixes rolled onto an elite enemy
+	EliteAffixes []int `json:"elite_affixes,omitempty"`
+
+	// Shield left on an enemy (e.g. a shielded elite)
+	ShieldAmount    float64 `json:"shield_amount,omitempty"`
+	ShieldMaxAmount float64 `json:"shield_max_amount,omitempty"`
+
+	// Generated identity of an enemy: type and size names (e.g. "boss",
+	// "large"), and the spawn zone and genre it was spawned for. Empty for
+	// enemies that were not generated.
+	EnemyType string `json:"enemy_type,omitempty"`
+	EnemySize string `json:"enemy_size,omitempty"`
+	ZoneID    int    `json:"zone_id,omitempty"`
+	GenreID   string `json:"genre_id,omitempty"`
+
+	// Name of a merchant or generated enemy
+	Name string `json:"name,omitempty"`
+
+	// Inventory carried by the entity (merchant stock)
+	Inventory []ItemData `json:"inventory,omitempty"`
+
+	// Merchant terms
+	MerchantType      int     `json:"merchant_type,omitempty"`
+	PriceMultiplier   float64 `json:"price_multiplier,omitempty"`
+	BuyBackPercentage float64 `json:"buy_back_percentage,omitempty"`
+
+	// Item lying in the world (items)
+	Item *ItemData `json:"item,omitempty"`
+
+	// Crafting station type, tier and fuel charges
+	StationType       int    `json:"station_type,omitempty"`
+	StationTier       int    `json:"station_tier,omitempty"`
+	StationMaxCharges int    `json:"station_max_charges,omitempty"`
+	StationCharges    int    `json:"station_charges,omitempty"`
+	StationFuelItem   string `json:"station_fuel_item,omitempty"`
+
+	// References to other entities by saved ID, keyed by role
+	// (e.g. ReferenceTarget)
+	References map[string]uint64 `json:"references,omitempty"`
+}
+
+// EntityWorld is the game world as seen by entity save and restore. The
+// engine adapts its ECS world to it, keeping saveload free of engine types.
+type EntityWorld interface {
+	// SavedEntities returns the state of every savable non-player entity
+	SavedEntities() []EntityData
+
+	// UpdateSavedEntity applies data to the live entity with data.ID and
+	// data.Kind, returning false if no such entity exists
+	UpdateSavedEntity(data EntityData) bool
+
+	// SpawnSavedEntity creates a new entity from data and returns its ID
+	SpawnSavedEntity(data EntityData) (uint64, error)
+
+	// RemoveSavedEntity removes the entity with id
+	RemoveSavedEntity(id uint64)
+
+	// LinkSavedEntity points the references of entity id at the entities
+	// with the given (already remapped) IDs
+	LinkSavedEntity(id uint64, references map[string]uint64)
+}
+
+// SnapshotEntities captures the non-player entities of world for saving.
+func (m *SaveManager) SnapshotEntities(world EntityWorld) *EntitiesState {
+	state := &EntitiesState{Entities: world.SavedEntities()}
+	if state.Entities == nil {
+		state.Entities = make([]EntityData, 0)
+	}
+	m.logDebug("entities snapshot", logrus.Fields{"count": len(state.Entities)})
+	return state
+}
+
+// RestoreEntities makes the non-player entities of world match state.
+// Entities still alive are updated in place and keep their IDs; saved
+// entities missing from the world (killed or picked up since the save) are
+// respawned with new IDs; live entities absent from the save are removed.
+// References between saved entities are then remapped to the new IDs, and
+// references to unsaved entities such as the player are kept as is.
+// Returns the mapping from saved to live entity IDs.
+func (m *SaveManager) RestoreEntities(world EntityWorld, state *EntitiesState) (map[uint64]uint64, error) {
+	idMap := make(map[uint64]uint64)
+	if state == nil {
+		return idMap, nil
+	}
+
+	type entityKey struct {
+		id   uint64
+		kind EntityKind
+	}
+	saved := make(map[entityKey]bool, len(state.Entities))
+	for _, data := range state.Entities {
+		saved[entityKey{data.ID, data.Kind}] = true
+	}
+	for _, live := range world.SavedEntities() {
+		if !saved[entityKey{live.ID, live.Kind}] {
+			world.RemoveSavedEntity(live.ID)
+		}
+	}
+
+	// Update survivors before spawning so a new ID can never be mistaken
+	// for a saved one
+	var missing []EntityData
+	for _, data := range state.Entities {
+		if world.UpdateSavedEntity(data) {
+			idMap[data.ID] = data.ID
+		} else {
+			missing = append(missing, data)
+		}
+	}
+	for _, data := range missing {
+		id, err := world.SpawnSavedEntity(data)
+		if err != nil {
+			return idMap, fmt.Errorf("failed to respawn %s entity %d: %w", data.Kind, data.ID, err)
+		}
+		idMap[data.ID] = id
+	}
+
+	for _, data := range state.Entities {
+		if len(data.References) == 0 {
+			continue
+		}
+		references := make(map[string]uint64, len(data.References))
+		for role, ref := range data.References {
+			if id, ok := idMap[ref]; ok {
+				ref = id
+			}
+			references[role] = ref
+		}
+		world.LinkSavedEntity(idMap[data.ID], references)
+	}
+
+	m.logDebug("entities restored", logrus.Fields{
+		"count":     len(state.Entities),
+		"respawned": len(missing),
+	})
+	return idMap, nil
+}
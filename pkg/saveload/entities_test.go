@@ -0,0 +1,172 @@
+package saveload
+
+import (
+	"fmt"
+	"testing"
+)
+
+// fakeEntityWorld is an in-memory EntityWorld that hands out sequential IDs.
+type fakeEntityWorld struct {
+	entities map[uint64]EntityData
+	nextID   uint64
+	links    map[uint64]map[string]uint64
+	failKind EntityKind
+}
+
+func newFakeEntityWorld(entities ...EntityData) *fakeEntityWorld {
+	w := &fakeEntityWorld{entities: map[uint64]EntityData{}, links: map[uint64]map[string]uint64{}}
+	for _, e := range entities {
+		w.entities[e.ID] = e
+		if e.ID >= w.nextID {
+			w.nextID = e.ID + 1
+		}
+	}
+	return w
+}
+
+func (w *fakeEntityWorld) SavedEntities() []EntityData {
+	var out []EntityData
+	for id := uint64(0); id < w.nextID; id++ {
+		if e, ok := w.entities[id]; ok {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func (w *fakeEntityWorld) UpdateSavedEntity(data EntityData) bool {
+	live, ok := w.entities[data.ID]
+	if !ok || live.Kind != data.Kind {
+		return false
+	}
+	w.entities[data.ID] = data
+	return true
+}
+
+func (w *fakeEntityWorld) SpawnSavedEntity(data EntityData) (uint64, error) {
+	if data.Kind == w.failKind {
+		return 0, fmt.Errorf("cannot spawn %s", data.Kind)
+	}
+	data.ID = w.nextID
+	w.nextID++
+	w.entities[data.ID] = data
+	return data.ID, nil
+}
+
+func (w *fakeEntityWorld) RemoveSavedEntity(id uint64) {
+	delete(w.entities, id)
+}
+
+func (w *fakeEntityWorld) LinkSavedEntity(id uint64, references map[string]uint64) {
+	w.links[id] = references
+}
+
+func TestSaveManager_RestoreEntitiesRemapsIDs(t *testing.T) {
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	const playerID = 1
+	world := newFakeEntityWorld(
+		EntityData{ID: 2, Kind: EntityKindEnemy, CurrentHealth: 40, MaxHealth: 50},
+		EntityData{ID: 3, Kind: EntityKindEnemy, CurrentHealth: 50, MaxHealth: 50,
+			References: map[string]uint64{ReferenceTarget: playerID}},
+		EntityData{ID: 4, Kind: EntityKindItem, Item: &ItemData{Name: "Sword"}},
+	)
+	state := manager.SnapshotEntities(world)
+
+	// After the save: enemy 2 dies, the item is picked up, an item drops
+	world.RemoveSavedEntity(2)
+	world.RemoveSavedEntity(4)
+	world.SpawnSavedEntity(EntityData{Kind: EntityKindItem, Item: &ItemData{Name: "Loot"}})
+	world.entities[3] = EntityData{ID: 3, Kind: EntityKindEnemy, CurrentHealth: 5, MaxHealth: 50}
+
+	// Give the surviving enemy a reference to the dead one
+	state.Entities[1].References = map[string]uint64{ReferenceTarget: 2}
+
+	idMap, err := manager.RestoreEntities(world, state)
+	if err != nil {
+		t.Fatalf("RestoreEntities failed: %v", err)
+	}
+
+	if idMap[3] != 3 {
+		t.Errorf("surviving enemy remapped to %d, want 3", idMap[3])
+	}
+	if idMap[2] == 2 || idMap[4] == 4 {
+		t.Errorf("respawned entities kept stale IDs: %v", idMap)
+	}
+	if got := world.entities[3].CurrentHealth; got != 50 {
+		t.Errorf("surviving enemy health = %v, want 50", got)
+	}
+	if e := world.entities[idMap[4]]; e.Item == nil || e.Item.Name != "Sword" {
+		t.Errorf("picked up item not restored: %+v", e)
+	}
+	if len(world.entities) != 3 {
+		t.Errorf("world has %d entities, want 3 (loot dropped after save removed)", len(world.entities))
+	}
+	if got := world.links[3][ReferenceTarget]; got != idMap[2] {
+		t.Errorf("target reference = %d, want remapped %d", got, idMap[2])
+	}
+}
+
+func TestSaveManager_RestoreEntitiesKeepsUnsavedReferences(t *testing.T) {
+	manager, _ := NewSaveManager(t.TempDir())
+	world := newFakeEntityWorld()
+	world.nextID = 10
+	state := &EntitiesState{Entities: []EntityData{
+		{ID: 5, Kind: EntityKindEnemy, References: map[string]uint64{ReferenceTarget: 1}},
+	}}
+
+	idMap, err := manager.RestoreEntities(world, state)
+	if err != nil {
+		t.Fatalf("RestoreEntities failed: %v", err)
+	}
+	if got := world.links[idMap[5]][ReferenceTarget]; got != 1 {
+		t.Errorf("player reference = %d, want 1", got)
+	}
+}
+
+func TestSaveManager_RestoreEntitiesSpawnFailure(t *testing.T) {
+	manager, _ := NewSaveManager(t.TempDir())
+	world := newFakeEntityWorld()
+	world.failKind = EntityKindStation
+	state := &EntitiesState{Entities: []EntityData{{ID: 7, Kind: EntityKindStation}}}
+
+	if _, err := manager.RestoreEntities(world, state); err == nil {
+		t.Error("expected an error when an entity cannot be respawned")
+	}
+	if idMap, err := manager.RestoreEntities(world, nil); err != nil || len(idMap) != 0 {
+		t.Errorf("nil state: idMap %v, err %v", idMap, err)
+	}
+}
+
+func TestSaveManager_EntitiesStateRoundTrip(t *testing.T) {
+	manager, _ := NewSaveManager(t.TempDir())
+	save := NewGameSave()
+	save.EntitiesState = &EntitiesState{Entities: []EntityData{
+		{ID: 9, Kind: EntityKindMerchant, X: 10, Y: 20, Name: "Trader",
+			Inventory: []ItemData{{Name: "Potion"}}},
+		{ID: 11, Kind: EntityKindEnemy, EliteAffixes: []int{0, 2},
+			References: map[string]uint64{ReferenceTarget: 1}},
+	}}
+
+	if err := manager.SaveGame("entities", save); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	loaded, err := manager.LoadGame("entities")
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+
+	entities := loaded.EntitiesState.Entities
+	if len(entities) != 2 {
+		t.Fatalf("loaded %d entities, want 2", len(entities))
+	}
+	if entities[0].Name != "Trader" || len(entities[0].Inventory) != 1 || entities[0].Y != 20 {
+		t.Errorf("merchant = %+v", entities[0])
+	}
+	if len(entities[1].EliteAffixes) != 2 || entities[1].References[ReferenceTarget] != 1 {
+		t.Errorf("enemy = %+v", entities[1])
+	}
+}
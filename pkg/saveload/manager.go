@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -20,6 +21,17 @@ import (
 type SaveManager struct {
 	// Directory where save files are stored
 	saveDir string
+	// Compression applied to new saves; loading detects it automatically
+	compression CompressionMode
+	// Autosave slot rotation settings
+	autoSave AutoSaveConfig
+	// Device ID recorded in the sync metadata of new saves
+	deviceID string
+	// Registered format migrations, keyed by source version
+	migrations map[string]migration
+	// Serializes writes so concurrent autosaves and manual saves never
+	// interleave on the same slot
+	mu sync.Mutex
 	// Logger for save/load operations
 	logger *logrus.Entry
 }
@@ -52,15 +64,59 @@ func NewSaveManagerWithLogger(saveDir string, logger *logrus.Logger) (*SaveManag
 		logEntry.Info("save manager initialized")
 	}
 
-	return &SaveManager{
-		saveDir: saveDir,
-		logger:  logEntry,
-	}, nil
+	manager := &SaveManager{
+		saveDir:  saveDir,
+		autoSave: DefaultAutoSaveConfig(),
+		deviceID: DefaultDeviceID,
+		logger:   logEntry,
+	}
+	manager.registerDefaultMigrations()
+	return manager, nil
+}
+
+// SetCompression sets the compression used by subsequent SaveGame calls.
+// LoadGame reads both compressed and uncompressed saves regardless of mode.
+func (m *SaveManager) SetCompression(mode CompressionMode) error {
+	if mode != CompressNone && mode != CompressGzip {
+		return fmt.Errorf("unknown compression mode: %d", mode)
+	}
+	m.compression = mode
+	return nil
+}
+
+// Compression returns the compression used for new saves.
+func (m *SaveManager) Compression() CompressionMode {
+	return m.compression
+}
+
+// SetDeviceID sets the device ID recorded in the sync metadata of
+// subsequent saves. An empty ID resets it to DefaultDeviceID.
+func (m *SaveManager) SetDeviceID(deviceID string) {
+	if deviceID == "" {
+		deviceID = DefaultDeviceID
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deviceID = deviceID
+}
+
+// DeviceID returns the device ID recorded in new saves.
+func (m *SaveManager) DeviceID() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.deviceID
 }
 
 // SaveGame saves the game state to a file with the specified name.
 // The .sav extension is added automatically if not present.
 func (m *SaveManager) SaveGame(name string, save *GameSave) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.saveGameLocked(name, save)
+}
+
+// saveGameLocked implements SaveGame; the caller must hold m.mu.
+func (m *SaveManager) saveGameLocked(name string, save *GameSave) error {
 	m.logDebug("saving game", logrus.Fields{"name": name})
 
 	if save == nil {
@@ -74,20 +130,42 @@ func (m *SaveManager) SaveGame(name string, save *GameSave) error {
 
 	save.Version = SaveVersion
 	save.Timestamp = time.Now()
+	save.Sync = m.nextSyncMetadata(name, save)
+
+	checksum, err := computeChecksum(save)
+	if err != nil {
+		m.logError("failed to checksum save data", err, logrus.Fields{"name": name})
+		return err
+	}
+	save.Checksum = checksum
 
 	data, err := m.marshalSave(save, name)
 	if err != nil {
 		return err
 	}
 
+	data, err = compressPayload(data, m.compression)
+	if err != nil {
+		m.logError("failed to compress save data", err, logrus.Fields{"name": name})
+		return err
+	}
+
 	if err := m.writeSaveFile(name, data); err != nil {
 		return err
 	}
 
+	// The sidecar lets save menus list slots without reading the payload;
+	// a missing sidecar only slows listing, so failures are not fatal
+	metadata := newSaveMetadata(name, save, int64(len(data)))
+	if err := m.writeMetaFile(name, metadata); err != nil {
+		m.logWarn("failed to write save metadata", err, logrus.Fields{"name": name})
+	}
+
 	m.logInfo("game saved successfully", logrus.Fields{
-		"name":      name,
-		"size":      len(data),
-		"timestamp": save.Timestamp,
+		"name":        name,
+		"size":        len(data),
+		"compression": m.compression,
+		"timestamp":   save.Timestamp,
 	})
 
 	return nil
@@ -107,11 +185,42 @@ func (m *SaveManager) LoadGame(name string) (*GameSave, error) {
 		return nil, err
 	}
 
-	save, err := m.unmarshalSave(data, name)
+	data, err = decompressPayload(data)
 	if err != nil {
+		m.logError("failed to decompress save file", err, logrus.Fields{"name": name})
+		return nil, fmt.Errorf("%w: %w", ErrSaveCorrupted, err)
+	}
+
+	doc, err := decodeSaveDocument(data)
+	if err != nil {
+		m.logError("failed to parse save file", err, logrus.Fields{"name": name})
+		return nil, fmt.Errorf("%w: %w", ErrSaveCorrupted, err)
+	}
+
+	if err := verifyChecksum(doc); err != nil {
+		m.logError("save file failed integrity check", err, logrus.Fields{"name": name})
 		return nil, err
 	}
 
+	applied, err := m.runMigrations(doc)
+	if err != nil {
+		m.logError("failed to migrate save", err, logrus.Fields{"name": name})
+		return nil, fmt.Errorf("failed to validate/migrate save: %w", err)
+	}
+	if len(applied) > 0 {
+		m.logInfo("save migrated", logrus.Fields{"name": name, "migrations": applied})
+		data, err = json.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to re-encode migrated save: %w", err)
+		}
+	}
+
+	save, err := m.unmarshalSave(data, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSaveCorrupted, err)
+	}
+	save.AppliedMigrations = applied
+
 	if err := m.validateAndMigrate(save); err != nil {
 		m.logError("failed to validate/migrate save", err, logrus.Fields{"name": name})
 		return nil, fmt.Errorf("failed to validate/migrate save: %w", err)
@@ -141,9 +250,52 @@ func (m *SaveManager) DeleteSave(name string) error {
 		return fmt.Errorf("failed to delete save file: %w", err)
 	}
 
+	// Remove the metadata sidecar if present
+	if err := os.Remove(m.getMetaPath(name)); err != nil && !os.IsNotExist(err) {
+		m.logWarn("failed to delete save metadata", err, logrus.Fields{"name": name})
+	}
+
 	return nil
 }
 
+// DeleteCharacterSaves deletes every save slot of the character with the
+// given ID, including autosaves, and returns the deleted slot names.
+// Hardcore deaths use it to wipe the character; saves of other characters,
+// even in the same world, are kept. Sidecars written before the character
+// ID was recorded fall back to loading the save.
+func (m *SaveManager) DeleteCharacterSaves(characterID string) ([]string, error) {
+	if characterID == "" {
+		return nil, fmt.Errorf("character ID cannot be empty")
+	}
+
+	saves, err := m.ListSaves()
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []string
+	for _, metadata := range saves {
+		saveCharacterID := metadata.CharacterID
+		if saveCharacterID == "" {
+			save, err := m.LoadGame(metadata.Name)
+			if err != nil || save.PlayerState == nil {
+				continue
+			}
+			saveCharacterID = save.PlayerState.CharacterID
+		}
+		if saveCharacterID != characterID {
+			continue
+		}
+
+		if err := m.DeleteSave(metadata.Name); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, metadata.Name)
+	}
+
+	return deleted, nil
+}
+
 // ListSaves returns metadata for all save files in the save directory.
 func (m *SaveManager) ListSaves() ([]*SaveMetadata, error) {
 	// Read directory
@@ -182,7 +334,9 @@ func (m *SaveManager) ListSaves() ([]*SaveMetadata, error) {
 	return saves, nil
 }
 
-// GetSaveMetadata reads metadata from a save file without loading the entire save.
+// GetSaveMetadata reads metadata for a save without loading the entire save.
+// It reads the small .meta sidecar written by SaveGame, falling back to
+// decoding the save file itself for saves written without one.
 func (m *SaveManager) GetSaveMetadata(name string) (*SaveMetadata, error) {
 	// Validate save name
 	if err := m.validateSaveName(name); err != nil {
@@ -200,6 +354,12 @@ func (m *SaveManager) GetSaveMetadata(name string) (*SaveMetadata, error) {
 		return nil, fmt.Errorf("failed to stat save file: %w", err)
 	}
 
+	if metadata, err := m.readMetaFile(name); err == nil {
+		metadata.Name = strings.TrimSuffix(name, ".sav")
+		metadata.FileSize = fileInfo.Size()
+		return metadata, nil
+	}
+
 	// Open and read file
 	file, err := os.Open(filename)
 	if err != nil {
@@ -207,9 +367,14 @@ func (m *SaveManager) GetSaveMetadata(name string) (*SaveMetadata, error) {
 	}
 	defer file.Close()
 
+	payload, err := payloadReader(file)
+	if err != nil {
+		return nil, err
+	}
+
 	// Decode just enough to get metadata
 	var save GameSave
-	decoder := json.NewDecoder(file)
+	decoder := json.NewDecoder(payload)
 	if err := decoder.Decode(&save); err != nil {
 		if err == io.EOF {
 			return nil, fmt.Errorf("empty save file: %s", name)
@@ -217,24 +382,53 @@ func (m *SaveManager) GetSaveMetadata(name string) (*SaveMetadata, error) {
 		return nil, fmt.Errorf("failed to decode save file: %w", err)
 	}
 
-	// Build metadata
+	return newSaveMetadata(name, &save, fileInfo.Size()), nil
+}
+
+// newSaveMetadata builds the summary of a save shown in save menus.
+func newSaveMetadata(name string, save *GameSave, fileSize int64) *SaveMetadata {
 	metadata := &SaveMetadata{
-		Name:      name,
-		Version:   save.Version,
-		Timestamp: save.Timestamp,
-		FileSize:  fileInfo.Size(),
+		Name:          strings.TrimSuffix(name, ".sav"),
+		Version:       save.Version,
+		Timestamp:     save.Timestamp,
+		FileSize:      fileSize,
+		ThumbnailPath: save.ThumbnailPath,
+		Sync:          save.Sync.Clone(),
 	}
 
 	// Add player and world info if available
 	if save.PlayerState != nil {
 		metadata.PlayerLevel = save.PlayerState.Level
+		metadata.CharacterID = save.PlayerState.CharacterID
 	}
 	if save.WorldState != nil {
 		metadata.GenreID = save.WorldState.GenreID
+		metadata.WorldSeed = save.WorldState.Seed
 		metadata.GameTime = save.WorldState.GameTime
 	}
 
-	return metadata, nil
+	return metadata
+}
+
+// nextSyncMetadata returns the sync metadata for writing save to the named
+// slot. The history continues from the save's own metadata, or from the
+// slot's current save when the caller built a fresh GameSave, and the
+// revision always exceeds the one already on disk.
+func (m *SaveManager) nextSyncMetadata(name string, save *GameSave) *SyncMetadata {
+	var existing *SyncMetadata
+	if metadata, err := m.GetSaveMetadata(name); err == nil {
+		existing = metadata.Sync
+	}
+
+	base := save.Sync
+	if base == nil {
+		base = existing
+	}
+	next := base.Next(m.deviceID, save.Timestamp)
+	if existing != nil && next.Revision <= existing.Revision {
+		next.Revision = existing.Revision + 1
+	}
+	return next
 }
 
 // SaveExists checks if a save file exists.
@@ -257,6 +451,36 @@ func (m *SaveManager) getFilePath(name string) string {
 	return filepath.Join(m.saveDir, name)
 }
 
+// getMetaPath returns the full path to a save's metadata sidecar.
+func (m *SaveManager) getMetaPath(name string) string {
+	return filepath.Join(m.saveDir, strings.TrimSuffix(name, ".sav")+".meta")
+}
+
+// writeMetaFile writes a save's metadata sidecar as plain JSON.
+func (m *SaveManager) writeMetaFile(name string, metadata *SaveMetadata) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal save metadata: %w", err)
+	}
+	if err := writeFileAtomic(m.getMetaPath(name), data); err != nil {
+		return fmt.Errorf("failed to write save metadata: %w", err)
+	}
+	return nil
+}
+
+// readMetaFile reads a save's metadata sidecar.
+func (m *SaveManager) readMetaFile(name string) (*SaveMetadata, error) {
+	data, err := os.ReadFile(m.getMetaPath(name))
+	if err != nil {
+		return nil, err
+	}
+	var metadata SaveMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse save metadata: %w", err)
+	}
+	return &metadata, nil
+}
+
 // validateSaveName validates that a save name is acceptable.
 func (m *SaveManager) validateSaveName(name string) error {
 	if name == "" {
@@ -279,7 +503,7 @@ func (m *SaveManager) validateSaveName(name string) error {
 	return nil
 }
 
-// validateAndMigrate validates a save file and migrates it if necessary.
+// validateAndMigrate validates a save file once LoadGame has migrated it.
 func (m *SaveManager) validateAndMigrate(save *GameSave) error {
 	if save == nil {
 		return fmt.Errorf("save cannot be nil")
@@ -290,16 +514,9 @@ func (m *SaveManager) validateAndMigrate(save *GameSave) error {
 		return fmt.Errorf("save file has no version")
 	}
 
-	// For now, we only support the current version
-	// Future versions can add migration logic here
+	// LoadGame has already run the registered migrations; anything still at
+	// another version has no migration path
 	if save.Version != SaveVersion {
-		// Example migration logic (not needed yet):
-		// if save.Version == "0.9.0" {
-		//     migrateSaveFrom090To100(save)
-		// }
-
-		// For now, just warn about version mismatch
-		// In production, you might want to reject or migrate
 		return fmt.Errorf("save file version %s is not supported (current version: %s)", save.Version, SaveVersion)
 	}
 
@@ -330,7 +547,7 @@ func (m *SaveManager) marshalSave(save *GameSave, name string) ([]byte, error) {
 // writeSaveFile writes save data to file.
 func (m *SaveManager) writeSaveFile(name string, data []byte) error {
 	filename := m.getFilePath(name)
-	if err := os.WriteFile(filename, data, 0o644); err != nil {
+	if err := writeFileAtomic(filename, data); err != nil {
 		m.logError("failed to write save file", err, logrus.Fields{
 			"name":     name,
 			"filename": filename,
@@ -340,6 +557,35 @@ func (m *SaveManager) writeSaveFile(name string, data []byte) error {
 	return nil
 }
 
+// writeFileAtomic writes data to a temporary file in the same directory and
+// renames it over path, so readers never observe a partially written file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	if err := os.Chmod(tmpName, 0o644); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return nil
+}
+
 // readSaveFile reads save data from file.
 func (m *SaveManager) readSaveFile(name string) ([]byte, error) {
 	filename := m.getFilePath(name)
@@ -0,0 +1,175 @@
+package saveload
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func metadataTestSave(level int, genre string) *GameSave {
+	save := NewGameSave()
+	save.PlayerState.Level = level
+	save.WorldState.GenreID = genre
+	save.WorldState.GameTime = 3600.5
+	save.ThumbnailPath = "thumbnails/" + genre + ".png"
+	return save
+}
+
+func TestSaveManager_SaveWritesMetadataSidecar(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	if err := manager.SaveGame("slot1", metadataTestSave(12, "horror")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "slot1.meta")); err != nil {
+		t.Fatalf("metadata sidecar not written: %v", err)
+	}
+
+	// Replace the payload with garbage: metadata must come from the sidecar
+	if err := os.WriteFile(filepath.Join(tmpDir, "slot1.sav"), []byte("not json"), 0o644); err != nil {
+		t.Fatalf("failed to overwrite save: %v", err)
+	}
+
+	metadata, err := manager.GetSaveMetadata("slot1")
+	if err != nil {
+		t.Fatalf("GetSaveMetadata failed: %v", err)
+	}
+	if metadata.Name != "slot1" || metadata.PlayerLevel != 12 || metadata.GenreID != "horror" {
+		t.Errorf("metadata = %+v, want slot1 level 12 horror", metadata)
+	}
+	if metadata.GameTime != 3600.5 {
+		t.Errorf("GameTime = %v, want 3600.5", metadata.GameTime)
+	}
+	if metadata.ThumbnailPath != "thumbnails/horror.png" {
+		t.Errorf("ThumbnailPath = %q, want thumbnails/horror.png", metadata.ThumbnailPath)
+	}
+	if metadata.FileSize != int64(len("not json")) {
+		t.Errorf("FileSize = %d, want size of the current save file", metadata.FileSize)
+	}
+}
+
+func TestSaveManager_ListSavesUsesSidecars(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	manager.SetCompression(CompressGzip)
+
+	if err := manager.SaveGame("a", metadataTestSave(3, "fantasy")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := manager.SaveGame("b", metadataTestSave(9, "scifi")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	saves, err := manager.ListSaves()
+	if err != nil {
+		t.Fatalf("ListSaves failed: %v", err)
+	}
+	if len(saves) != 2 {
+		t.Fatalf("ListSaves returned %d saves, want 2 (sidecars must not be listed)", len(saves))
+	}
+	levels := map[string]int{}
+	for _, save := range saves {
+		levels[save.Name] = save.PlayerLevel
+	}
+	if levels["a"] != 3 || levels["b"] != 9 {
+		t.Errorf("levels = %v, want a:3 b:9", levels)
+	}
+}
+
+func TestSaveManager_MetadataFallbackWithoutSidecar(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	if err := manager.SaveGame("legacy", metadataTestSave(5, "postapoc")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	// Saves from before sidecars existed have only the .sav file
+	if err := os.Remove(filepath.Join(tmpDir, "legacy.meta")); err != nil {
+		t.Fatalf("failed to remove sidecar: %v", err)
+	}
+
+	metadata, err := manager.GetSaveMetadata("legacy")
+	if err != nil {
+		t.Fatalf("GetSaveMetadata failed: %v", err)
+	}
+	if metadata.PlayerLevel != 5 || metadata.GenreID != "postapoc" {
+		t.Errorf("metadata = %+v, want level 5 postapoc", metadata)
+	}
+}
+
+func TestSaveManager_DeleteRemovesSidecar(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	if err := manager.SaveGame("gone", metadataTestSave(1, "fantasy")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := manager.DeleteSave("gone"); err != nil {
+		t.Fatalf("DeleteSave failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "gone.meta")); !os.IsNotExist(err) {
+		t.Error("metadata sidecar should be deleted with the save")
+	}
+}
+
+func TestSaveManager_DeleteCharacterSaves(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	// Two characters playing the same world seed
+	slots := map[string]string{"quicksave": "hero", "autosave_1": "hero", "rival": "rival"}
+	for name, characterID := range slots {
+		save := metadataTestSave(3, "fantasy")
+		save.WorldState.Seed = 42
+		save.PlayerState.CharacterID = characterID
+		if err := manager.SaveGame(name, save); err != nil {
+			t.Fatalf("SaveGame(%s) failed: %v", name, err)
+		}
+	}
+	// Sidecars from before the character ID was recorded fall back to the save
+	if err := os.Remove(filepath.Join(tmpDir, "autosave_1.meta")); err != nil {
+		t.Fatalf("failed to remove sidecar: %v", err)
+	}
+
+	deleted, err := manager.DeleteCharacterSaves("hero")
+	if err != nil {
+		t.Fatalf("DeleteCharacterSaves failed: %v", err)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("deleted %v, want quicksave and autosave_1", deleted)
+	}
+	if manager.SaveExists("quicksave") || manager.SaveExists("autosave_1") {
+		t.Error("saves of the dead character should be deleted")
+	}
+	if !manager.SaveExists("rival") {
+		t.Error("saves of another character on the same seed should be kept")
+	}
+
+	if _, err := manager.DeleteCharacterSaves(""); err == nil {
+		t.Error("DeleteCharacterSaves should reject an empty character ID")
+	}
+}
+
+func TestNewCharacterID_Unique(t *testing.T) {
+	first, second := NewCharacterID(), NewCharacterID()
+	if first == "" || first == second {
+		t.Errorf("NewCharacterID returned %q and %q, want distinct IDs", first, second)
+	}
+}
@@ -0,0 +1,114 @@
+// Package saveload provides save format migrations.
+// This file implements the registry of version-to-version transforms that
+// LoadGame chains to bring older saves up to SaveVersion.
+package saveload
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MigrationFunc transforms a decoded save from one format version to the
+// next. It edits data in place; the version field is updated by the caller.
+// Numbers in data are json.Number values.
+type MigrationFunc func(data map[string]interface{}) error
+
+// migration is a registered transform to a newer version.
+type migration struct {
+	toVersion string
+	fn        MigrationFunc
+}
+
+// RegisterMigration registers fn to migrate saves at fromVersion to
+// toVersion. LoadGame chains registered migrations until a save reaches
+// SaveVersion. Each version may have only one outgoing migration.
+func (m *SaveManager) RegisterMigration(fromVersion, toVersion string, fn MigrationFunc) error {
+	if fromVersion == "" || toVersion == "" {
+		return fmt.Errorf("migration versions cannot be empty")
+	}
+	if fromVersion == toVersion {
+		return fmt.Errorf("migration from %s cannot target the same version", fromVersion)
+	}
+	if fn == nil {
+		return fmt.Errorf("migration function cannot be nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.migrations[fromVersion]; ok {
+		return fmt.Errorf("migration from %s already registered (to %s)", fromVersion, existing.toVersion)
+	}
+	if m.migrations == nil {
+		m.migrations = make(map[string]migration)
+	}
+	m.migrations[fromVersion] = migration{toVersion: toVersion, fn: fn}
+	return nil
+}
+
+// runMigrations migrates doc in place to SaveVersion and returns the steps
+// applied, formatted as "from->to". Saves already at SaveVersion are left
+// unchanged; saves with no migration path are left for validation to reject.
+func (m *SaveManager) runMigrations(doc saveDocument) ([]string, error) {
+	version, _ := doc["version"].(string)
+	if version == "" {
+		return nil, nil
+	}
+
+	m.mu.Lock()
+	chain := make(map[string]migration, len(m.migrations))
+	for from, step := range m.migrations {
+		chain[from] = step
+	}
+	m.mu.Unlock()
+
+	var applied []string
+	for version != SaveVersion {
+		step, ok := chain[version]
+		if !ok {
+			break
+		}
+		// Each step is used once, so a cyclic registry cannot loop forever
+		delete(chain, version)
+
+		if err := step.fn(doc); err != nil {
+			return applied, fmt.Errorf("migration %s->%s failed: %w", version, step.toVersion, err)
+		}
+		applied = append(applied, version+"->"+step.toVersion)
+		version = step.toVersion
+		doc["version"] = version
+	}
+	return applied, nil
+}
+
+// registerDefaultMigrations registers the migrations for every past
+// format version.
+func (m *SaveManager) registerDefaultMigrations() {
+	m.migrations = map[string]migration{
+		"1.0.0": {toVersion: "1.1.0", fn: migrateSaveFrom100To110},
+	}
+}
+
+// migrateSaveFrom100To110 fills in the settings and world defaults that
+// 1.0.0 saves could omit, which would otherwise load as zero (muted audio,
+// depth 0).
+func migrateSaveFrom100To110(data map[string]interface{}) error {
+	if world, ok := data["world"].(map[string]interface{}); ok {
+		setDefault(world, "depth", json.Number("1"))
+		setDefault(world, "difficulty", json.Number("0.5"))
+	}
+	if settings, ok := data["settings"].(map[string]interface{}); ok {
+		setDefault(settings, "master_volume", json.Number("1"))
+		setDefault(settings, "music_volume", json.Number("0.7"))
+		setDefault(settings, "sfx_volume", json.Number("0.8"))
+		setDefault(settings, "key_bindings", map[string]interface{}{})
+	}
+	return nil
+}
+
+// setDefault sets obj[key] to value if the key is missing or null.
+func setDefault(obj map[string]interface{}, key string, value interface{}) {
+	if existing, ok := obj[key]; !ok || existing == nil {
+		obj[key] = value
+	}
+}
@@ -0,0 +1,166 @@
+package saveload
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// legacySave100 is a 1.0.0 save without the settings and world fields 1.1.0
+// guarantees.
+const legacySave100 = `{
+  "version": "1.0.0",
+  "timestamp": "2025-01-01T00:00:00Z",
+  "player": {"entity_id": 1, "x": 10, "y": 20, "level": 3, "items": [], "gold": 5},
+  "world": {"seed": 9007199254740993, "genre_id": "fantasy", "width": 80, "height": 50},
+  "settings": {"screen_width": 800, "screen_height": 600}
+}`
+
+func TestSaveManager_MigratesVersion100ToCurrent(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "old.sav"), []byte(legacySave100), 0o644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	save, err := manager.LoadGame("old")
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if save.Version != SaveVersion {
+		t.Errorf("Version = %s, want %s", save.Version, SaveVersion)
+	}
+	if want := []string{"1.0.0->1.1.0"}; !reflect.DeepEqual(save.AppliedMigrations, want) {
+		t.Errorf("AppliedMigrations = %v, want %v", save.AppliedMigrations, want)
+	}
+
+	// Defaults added by the migration
+	if save.WorldState.Depth != 1 || save.WorldState.Difficulty != 0.5 {
+		t.Errorf("world defaults = depth %d difficulty %v, want 1 and 0.5", save.WorldState.Depth, save.WorldState.Difficulty)
+	}
+	if save.Settings.MasterVolume != 1 || save.Settings.MusicVolume != 0.7 || save.Settings.SFXVolume != 0.8 {
+		t.Errorf("volume defaults = %v/%v/%v, want 1/0.7/0.8",
+			save.Settings.MasterVolume, save.Settings.MusicVolume, save.Settings.SFXVolume)
+	}
+	if save.Settings.KeyBindings == nil {
+		t.Error("key bindings default not added")
+	}
+
+	// Existing data survives the round trip exactly
+	if save.PlayerState.Level != 3 || save.PlayerState.Gold != 5 || save.Settings.ScreenWidth != 800 {
+		t.Errorf("existing fields changed: %+v", save.PlayerState)
+	}
+	if save.WorldState.Seed != 9007199254740993 {
+		t.Errorf("Seed = %d, lost precision during migration", save.WorldState.Seed)
+	}
+}
+
+func TestSaveManager_CurrentSaveRunsNoMigrations(t *testing.T) {
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := manager.SaveGame("slot", metadataTestSave(2, "scifi")); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	save, err := manager.LoadGame("slot")
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if len(save.AppliedMigrations) != 0 {
+		t.Errorf("AppliedMigrations = %v, want none", save.AppliedMigrations)
+	}
+}
+
+func TestSaveManager_RegisterMigrationChain(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+
+	// 0.8.0 -> 0.9.0 -> 1.0.0, then the built-in 1.0.0 -> 1.1.0
+	err = manager.RegisterMigration("0.9.0", "1.0.0", func(data map[string]interface{}) error {
+		data["player"].(map[string]interface{})["gold"] = 100
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RegisterMigration failed: %v", err)
+	}
+	err = manager.RegisterMigration("0.8.0", "0.9.0", func(data map[string]interface{}) error {
+		data["player"].(map[string]interface{})["level"] = 8
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RegisterMigration failed: %v", err)
+	}
+
+	old := `{"version":"0.8.0","timestamp":"2025-01-01T00:00:00Z","player":{},"world":{},"settings":{}}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "ancient.sav"), []byte(old), 0o644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	save, err := manager.LoadGame("ancient")
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	want := []string{"0.8.0->0.9.0", "0.9.0->1.0.0", "1.0.0->1.1.0"}
+	if !reflect.DeepEqual(save.AppliedMigrations, want) {
+		t.Errorf("AppliedMigrations = %v, want %v", save.AppliedMigrations, want)
+	}
+	if save.PlayerState.Level != 8 || save.PlayerState.Gold != 100 {
+		t.Errorf("player = level %d gold %d, want 8 and 100", save.PlayerState.Level, save.PlayerState.Gold)
+	}
+}
+
+func TestSaveManager_MigrationFailure(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager, err := NewSaveManager(tmpDir)
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	if err := manager.RegisterMigration("0.9.0", "1.0.0", func(map[string]interface{}) error {
+		return fmt.Errorf("unsupported layout")
+	}); err != nil {
+		t.Fatalf("RegisterMigration failed: %v", err)
+	}
+
+	old := `{"version":"0.9.0","timestamp":"2025-01-01T00:00:00Z","player":{},"world":{},"settings":{}}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "bad.sav"), []byte(old), 0o644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	if _, err := manager.LoadGame("bad"); err == nil {
+		t.Error("expected error when a migration fails")
+	}
+}
+
+func TestSaveManager_RegisterMigrationErrors(t *testing.T) {
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	noop := func(map[string]interface{}) error { return nil }
+
+	tests := []struct {
+		name     string
+		from, to string
+		fn       MigrationFunc
+	}{
+		{"empty_from", "", "1.0.0", noop},
+		{"same_version", "1.0.0", "1.0.0", noop},
+		{"nil_func", "0.1.0", "0.2.0", nil},
+		{"duplicate_builtin", "1.0.0", "1.2.0", noop},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := manager.RegisterMigration(tt.from, tt.to, tt.fn); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
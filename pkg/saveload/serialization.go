@@ -163,6 +163,10 @@ func parseItemType(s string) item.ItemType {
 		return item.TypeConsumable
 	case "accessory":
 		return item.TypeAccessory
+	case "material":
+		return item.TypeMaterial
+	case "currency":
+		return item.TypeCurrency
 	default:
 		return item.TypeWeapon
 	}
@@ -286,6 +286,8 @@ func TestParseItemType(t *testing.T) {
 		{"armor", item.TypeArmor},
 		{"consumable", item.TypeConsumable},
 		{"accessory", item.TypeAccessory},
+		{"material", item.TypeMaterial},
+		{"currency", item.TypeCurrency},
 		{"invalid", item.TypeWeapon}, // Default fallback
 	}
 
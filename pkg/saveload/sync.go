@@ -0,0 +1,153 @@
+// Package saveload provides sync metadata for saves.
+// This file implements the device, revision and version-vector metadata
+// each save carries so a future cloud sync can tell whether two copies of
+// a save descend from one another or diverged and need resolving.
+package saveload
+
+import (
+	"time"
+)
+
+// DefaultDeviceID identifies saves written by a manager with no device ID.
+const DefaultDeviceID = "local"
+
+// SyncMetadata records where and when a save was last written.
+type SyncMetadata struct {
+	// DeviceID of the device that wrote this revision
+	DeviceID string `json:"device_id"`
+
+	// Revision increases with every save of the slot on any device
+	Revision uint64 `json:"revision"`
+
+	// LastModified is when this revision was written
+	LastModified time.Time `json:"last_modified"`
+
+	// Versions counts the saves each device contributed to this save's
+	// history (a version vector); it decides ancestry between copies
+	Versions map[string]uint64 `json:"versions,omitempty"`
+}
+
+// Clone returns a deep copy of the metadata. Nil returns nil.
+func (s *SyncMetadata) Clone() *SyncMetadata {
+	if s == nil {
+		return nil
+	}
+	clone := *s
+	clone.Versions = make(map[string]uint64, len(s.Versions))
+	for device, count := range s.Versions {
+		clone.Versions[device] = count
+	}
+	return &clone
+}
+
+// Next returns the metadata for a new revision written on deviceID at now,
+// descending from s (which may be nil for a slot's first save).
+func (s *SyncMetadata) Next(deviceID string, now time.Time) *SyncMetadata {
+	next := s.Clone()
+	if next == nil {
+		next = &SyncMetadata{Versions: make(map[string]uint64)}
+	}
+	next.DeviceID = deviceID
+	next.Revision++
+	next.LastModified = now
+	next.Versions[deviceID]++
+	return next
+}
+
+// SaveRelation describes how two copies of a save relate.
+type SaveRelation int
+
+const (
+	// RelationEqual means both copies are the same revision
+	RelationEqual SaveRelation = iota
+	// RelationAncestor means the first copy is an ancestor of the second
+	RelationAncestor
+	// RelationDescendant means the first copy descends from the second
+	RelationDescendant
+	// RelationDiverged means the copies were saved independently from a
+	// shared base and neither contains the other's progress
+	RelationDiverged
+)
+
+// String returns the string representation of a relation.
+func (r SaveRelation) String() string {
+	switch r {
+	case RelationEqual:
+		return "equal"
+	case RelationAncestor:
+		return "ancestor"
+	case RelationDescendant:
+		return "descendant"
+	case RelationDiverged:
+		return "diverged"
+	default:
+		return "unknown"
+	}
+}
+
+// ConflictInfo is the result of comparing two copies of a save.
+type ConflictInfo struct {
+	// Conflict is true if the copies diverged and must be resolved by the
+	// player rather than by keeping the newer one
+	Conflict bool
+
+	// Relation of the first copy to the second
+	Relation SaveRelation
+
+	// RevisionA and RevisionB are the revisions of the two copies
+	RevisionA uint64
+	RevisionB uint64
+}
+
+// DetectConflict compares two copies of a save by their version vectors.
+// A copy whose history is contained in the other's is an ancestor and can
+// be replaced safely; copies with progress the other lacks conflict. Saves
+// without sync metadata have an empty history and are ancestors of any
+// synced save.
+func DetectConflict(a, b *GameSave) ConflictInfo {
+	var syncA, syncB *SyncMetadata
+	if a != nil {
+		syncA = a.Sync
+	}
+	if b != nil {
+		syncB = b.Sync
+	}
+
+	info := ConflictInfo{}
+	var versionsA, versionsB map[string]uint64
+	if syncA != nil {
+		info.RevisionA = syncA.Revision
+		versionsA = syncA.Versions
+	}
+	if syncB != nil {
+		info.RevisionB = syncB.Revision
+		versionsB = syncB.Versions
+	}
+
+	aBehind := hasVersionsMissing(versionsB, versionsA) // b has progress a lacks
+	bBehind := hasVersionsMissing(versionsA, versionsB) // a has progress b lacks
+
+	switch {
+	case aBehind && bBehind:
+		info.Relation = RelationDiverged
+		info.Conflict = true
+	case aBehind:
+		info.Relation = RelationAncestor
+	case bBehind:
+		info.Relation = RelationDescendant
+	default:
+		info.Relation = RelationEqual
+	}
+	return info
+}
+
+// hasVersionsMissing reports whether from counts any device's saves beyond
+// what other has seen.
+func hasVersionsMissing(from, other map[string]uint64) bool {
+	for device, count := range from {
+		if count > other[device] {
+			return true
+		}
+	}
+	return false
+}
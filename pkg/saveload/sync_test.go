@@ -0,0 +1,118 @@
+package saveload
+
+import (
+	"testing"
+	"time"
+)
+
+// syncTestSave returns a copy of base saved once more on device.
+func syncTestSave(base *GameSave, device string) *GameSave {
+	save := NewGameSave()
+	if base != nil {
+		save.Sync = base.Sync
+	}
+	save.Sync = save.Sync.Next(device, time.Now())
+	return save
+}
+
+func TestDetectConflict_DivergedFromSameBase(t *testing.T) {
+	base := syncTestSave(syncTestSave(nil, "desktop"), "desktop")
+	laptop := syncTestSave(base, "laptop")
+	desktop := syncTestSave(base, "desktop")
+
+	info := DetectConflict(laptop, desktop)
+	if !info.Conflict || info.Relation != RelationDiverged {
+		t.Errorf("diverged saves: %+v, want conflict", info)
+	}
+	if info.RevisionA != 3 || info.RevisionB != 3 {
+		t.Errorf("revisions = %d/%d, want 3/3", info.RevisionA, info.RevisionB)
+	}
+}
+
+func TestDetectConflict_StrictAncestor(t *testing.T) {
+	base := syncTestSave(nil, "desktop")
+	descendant := syncTestSave(syncTestSave(base, "laptop"), "desktop")
+
+	info := DetectConflict(base, descendant)
+	if info.Conflict || info.Relation != RelationAncestor {
+		t.Errorf("ancestor vs descendant: %+v, want ancestor without conflict", info)
+	}
+	info = DetectConflict(descendant, base)
+	if info.Conflict || info.Relation != RelationDescendant {
+		t.Errorf("descendant vs ancestor: %+v, want descendant without conflict", info)
+	}
+	if info := DetectConflict(base, base); info.Conflict || info.Relation != RelationEqual {
+		t.Errorf("save vs itself: %+v, want equal", info)
+	}
+}
+
+func TestDetectConflict_LegacySaves(t *testing.T) {
+	legacy := NewGameSave()
+	synced := syncTestSave(nil, "desktop")
+
+	if info := DetectConflict(legacy, synced); info.Conflict || info.Relation != RelationAncestor {
+		t.Errorf("legacy vs synced: %+v, want ancestor", info)
+	}
+	if info := DetectConflict(legacy, NewGameSave()); info.Conflict || info.Relation != RelationEqual {
+		t.Errorf("legacy vs legacy: %+v, want equal", info)
+	}
+}
+
+func TestSaveManager_SaveRecordsSyncMetadata(t *testing.T) {
+	manager, err := NewSaveManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSaveManager failed: %v", err)
+	}
+	manager.SetDeviceID("deck")
+
+	// A fresh GameSave each time still continues the slot's history
+	for i := 0; i < 3; i++ {
+		if err := manager.SaveGame("slot", metadataTestSave(i+1, "fantasy")); err != nil {
+			t.Fatalf("SaveGame failed: %v", err)
+		}
+	}
+
+	loaded, err := manager.LoadGame("slot")
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	sync := loaded.Sync
+	if sync == nil || sync.DeviceID != "deck" || sync.Revision != 3 || sync.Versions["deck"] != 3 {
+		t.Fatalf("Sync = %+v, want device deck at revision 3", sync)
+	}
+	if sync.LastModified.IsZero() {
+		t.Error("LastModified not recorded")
+	}
+
+	metadata, err := manager.GetSaveMetadata("slot")
+	if err != nil {
+		t.Fatalf("GetSaveMetadata failed: %v", err)
+	}
+	if metadata.Sync == nil || metadata.Sync.Revision != 3 {
+		t.Errorf("metadata Sync = %+v, want revision 3", metadata.Sync)
+	}
+
+	// Saving a loaded copy elsewhere keeps its history
+	manager.SetDeviceID("")
+	if err := manager.SaveGame("other", loaded); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if loaded.Sync.Revision != 4 || loaded.Sync.DeviceID != DefaultDeviceID {
+		t.Errorf("Sync = %+v, want revision 4 on %s", loaded.Sync, DefaultDeviceID)
+	}
+}
+
+func TestSaveRelation_String(t *testing.T) {
+	relations := map[SaveRelation]string{
+		RelationEqual:      "equal",
+		RelationAncestor:   "ancestor",
+		RelationDescendant: "descendant",
+		RelationDiverged:   "diverged",
+		SaveRelation(9):    "unknown",
+	}
+	for relation, want := range relations {
+		if got := relation.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", relation, got, want)
+		}
+	}
+}
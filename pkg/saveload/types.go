@@ -4,11 +4,14 @@
 package saveload
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"time"
 )
 
 // SaveVersion represents the save file format version.
-const SaveVersion = "1.0.0"
+// 1.1.0 guarantees world and settings defaults (see migrateSaveFrom100To110).
+const SaveVersion = "1.1.0"
 
 // GameSave represents a complete save file with all game state.
 type GameSave struct {
@@ -26,6 +29,24 @@ type GameSave struct {
 
 	// Game settings
 	Settings *GameSettings `json:"settings"`
+
+	// EntitiesState holds the non-player entities (enemies, dropped items,
+	// merchants, stations) so mid-level progress survives a reload
+	EntitiesState *EntitiesState `json:"entities,omitempty"`
+
+	// ThumbnailPath is an optional screenshot shown in the load menu
+	ThumbnailPath string `json:"thumbnail_path,omitempty"`
+
+	// Sync records the device and revision history for cloud sync
+	Sync *SyncMetadata `json:"sync,omitempty"`
+
+	// Checksum is the SHA-256 of the save with this field empty; saves
+	// written before integrity checks have none
+	Checksum string `json:"checksum,omitempty"`
+
+	// AppliedMigrations lists the migrations LoadGame ran on this save,
+	// formatted as "from->to"; it is not saved
+	AppliedMigrations []string `json:"-"`
 }
 
 // PlayerState represents all player-related state that needs to be saved.
@@ -33,6 +54,10 @@ type PlayerState struct {
 	// Entity ID of the player
 	EntityID uint64 `json:"entity_id"`
 
+	// CharacterID identifies the character across all of its save slots
+	// (see NewCharacterID); empty in saves written before it was recorded
+	CharacterID string `json:"character_id,omitempty"`
+
 	// Position
 	X float64 `json:"x"`
 	Y float64 `json:"y"`
@@ -78,6 +103,52 @@ type PlayerState struct {
 
 	// Phase 7.2: Animation state persistence
 	AnimationState *AnimationStateData `json:"animation_state,omitempty"`
+
+	// Active buffs and debuffs, shield and ability cooldowns, so a reload
+	// mid-fight resumes them with their remaining durations. Saved stats
+	// already include the effects' stat modifiers.
+	StatusEffects []StatusEffectData `json:"status_effects,omitempty"`
+	Shield        *ShieldData        `json:"shield,omitempty"`
+	Cooldowns     []CooldownData     `json:"cooldowns,omitempty"`
+}
+
+// StatusEffectData represents a saved active status effect.
+type StatusEffectData struct {
+	EffectType   string  `json:"effect_type"`
+	Duration     float64 `json:"duration"` // Remaining seconds
+	MaxDuration  float64 `json:"max_duration"`
+	Stacks       int     `json:"stacks,omitempty"`
+	Magnitude    float64 `json:"magnitude"`
+	TickInterval float64 `json:"tick_interval,omitempty"`
+	NextTick     float64 `json:"next_tick,omitempty"`
+
+	// StackPolicy names how reapplications stack (refresh, add, independent,
+	// ignore); empty uses the effect type's default
+	StackPolicy  string                        `json:"stack_policy,omitempty"`
+	Applications []StatusEffectApplicationData `json:"applications,omitempty"`
+}
+
+// StatusEffectApplicationData represents one independently tracked
+// application of a saved status effect.
+type StatusEffectApplicationData struct {
+	Magnitude float64 `json:"magnitude"`
+	Duration  float64 `json:"duration"` // Remaining seconds
+}
+
+// ShieldData represents a saved damage-absorbing shield.
+type ShieldData struct {
+	Amount      float64 `json:"amount"`
+	MaxAmount   float64 `json:"max_amount"`
+	Duration    float64 `json:"duration"` // Remaining seconds
+	MaxDuration float64 `json:"max_duration"`
+}
+
+// CooldownData represents a saved ability cooldown.
+type CooldownData struct {
+	// Ability name ("attack", "spell_1".."spell_5", "hotbar_1".."hotbar_6")
+	Ability   string  `json:"ability"`
+	Remaining float64 `json:"remaining"` // Seconds until ready
+	Total     float64 `json:"total"`
 }
 
 // TutorialStateData represents saved tutorial progress
@@ -238,11 +309,34 @@ type SaveMetadata struct {
 	// World genre
 	GenreID string `json:"genre_id"`
 
+	// WorldSeed identifies the world the save was written in
+	WorldSeed int64 `json:"world_seed,omitempty"`
+
+	// CharacterID identifies the character that wrote the save, so that
+	// characters sharing a world seed can be told apart
+	CharacterID string `json:"character_id,omitempty"`
+
 	// Game time
 	GameTime float64 `json:"game_time"`
 
 	// File size in bytes
 	FileSize int64 `json:"file_size,omitempty"`
+
+	// ThumbnailPath is an optional screenshot shown in the load menu
+	ThumbnailPath string `json:"thumbnail_path,omitempty"`
+
+	// Sync is the save's sync metadata, if any
+	Sync *SyncMetadata `json:"sync,omitempty"`
+}
+
+// NewCharacterID returns a random identifier for a new character, stored
+// in PlayerState.CharacterID of every save the character writes.
+func NewCharacterID() string {
+	var id [8]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(id[:])
 }
 
 // NewGameSave creates a new GameSave with default values.